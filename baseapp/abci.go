@@ -254,6 +254,8 @@ func (app *BaseApp) CheckTxSync(req abci.RequestCheckTx) ocabci.ResponseCheckTx
 	return ocabci.ResponseCheckTx{
 		GasWanted: int64(gInfo.GasWanted), // TODO: Should type accept unsigned ints?
 		GasUsed:   int64(gInfo.GasUsed),   // TODO: Should type accept unsigned ints?
+		Priority:  app.checkTxPolicy.Priority(tx),
+		Sender:    app.checkTxPolicy.Sender(tx),
 	}
 }
 
@@ -343,6 +345,15 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	header := app.deliverState.ctx.BlockHeader()
 	retainHeight := app.GetBlockRetentionHeight(header.Height)
 
+	// the streaming services may hold back the commit, e.g. until the block has been acknowledged
+	for _, streamingListener := range app.abciListeners {
+		if listener, ok := streamingListener.(PreCommitListener); ok {
+			if err := listener.ListenPreCommit(app.deliverState.ctx); err != nil {
+				panic(fmt.Errorf("PreCommit listening hook failed at height %d: %w", header.Height, err))
+			}
+		}
+	}
+
 	// Write the DeliverTx state into branched storage and commit the MultiStore.
 	// The write to the DeliverTx state writes all state transitions to the root
 	// MultiStore (app.cms) so when Commit() is called is persists those values.
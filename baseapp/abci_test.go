@@ -2,6 +2,7 @@ package baseapp
 
 import (
 	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -244,3 +245,46 @@ func (ps *paramStore) Get(_ sdk.Context, key []byte, ptr interface{}) {
 		panic(err)
 	}
 }
+
+type mockPreCommitListener struct {
+	refused int64
+}
+
+func (l *mockPreCommitListener) ListenBeginBlock(sdk.Context, ocabci.RequestBeginBlock, abci.ResponseBeginBlock) error {
+	return nil
+}
+
+func (l *mockPreCommitListener) ListenEndBlock(sdk.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	return nil
+}
+
+func (l *mockPreCommitListener) ListenDeliverTx(sdk.Context, abci.RequestDeliverTx, abci.ResponseDeliverTx) error {
+	return nil
+}
+
+func (l *mockPreCommitListener) ListenPreCommit(ctx sdk.Context) error {
+	if ctx.BlockHeight() == l.refused {
+		return fmt.Errorf("block %d refused", l.refused)
+	}
+	return nil
+}
+
+// Test and ensure that the block is not committed if a PreCommitListener refuses it.
+func TestBaseAppPreCommitListener(t *testing.T) {
+	t.Parallel()
+
+	logger := defaultLogger()
+	db := dbm.NewMemDB()
+	name := t.Name()
+	app := NewBaseApp(name, logger, db, nil)
+	app.init()
+	app.abciListeners = append(app.abciListeners, &mockPreCommitListener{refused: 2})
+
+	app.BeginBlock(ocabci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	app.Commit()
+	require.Equal(t, int64(1), app.LastBlockHeight())
+
+	app.BeginBlock(ocabci.RequestBeginBlock{Header: tmproto.Header{Height: 2}})
+	require.Panics(t, func() { app.Commit() })
+	require.Equal(t, int64(1), app.LastBlockHeight())
+}
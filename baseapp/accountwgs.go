@@ -7,31 +7,50 @@ import (
 )
 
 type AccountWGs struct {
-	mtx sync.Mutex
-	wgs map[string]*sync.WaitGroup
+	mtx      sync.Mutex
+	wgs      map[string]*sync.WaitGroup
+	inFlight map[string]int // the number of the registered txs of each signer which are not done
 }
 
 func NewAccountWGs() *AccountWGs {
 	return &AccountWGs{
-		wgs: make(map[string]*sync.WaitGroup),
+		wgs:      make(map[string]*sync.WaitGroup),
+		inFlight: make(map[string]int),
 	}
 }
 
 func (aw *AccountWGs) Register(tx sdk.Tx) (waits []*sync.WaitGroup, signals []*AccountWG) {
+	waits, signals, _ = aw.RegisterWithLimit(tx, nil)
+	return waits, signals
+}
+
+// RegisterWithLimit registers the tx like Register, unless any of its signers already has as many registered
+// txs which are not done as the limit returns for it, in which case it registers nothing and returns the signer.
+// A limit of zero, or a nil limit, is no limit.
+func (aw *AccountWGs) RegisterWithLimit(tx sdk.Tx, limit func(signer sdk.AccAddress) int) (waits []*sync.WaitGroup, signals []*AccountWG, exceeded sdk.AccAddress) {
 	signers := getUniqSigners(tx)
 
 	aw.mtx.Lock()
 	defer aw.mtx.Unlock()
+	if limit != nil {
+		for _, signer := range signers {
+			if n := limit(sdk.AccAddress(signer)); n > 0 && aw.inFlight[signer] >= n {
+				return nil, nil, sdk.AccAddress(signer)
+			}
+		}
+	}
+
 	for _, signer := range signers {
 		if wg := aw.wgs[signer]; wg != nil {
 			waits = append(waits, wg)
 		}
 		sig := waitGroup1()
 		aw.wgs[signer] = sig
+		aw.inFlight[signer]++
 		signals = append(signals, NewAccountWG(signer, sig))
 	}
 
-	return waits, signals
+	return waits, signals, nil
 }
 
 func (aw *AccountWGs) Wait(waits []*sync.WaitGroup) {
@@ -49,6 +68,10 @@ func (aw *AccountWGs) Done(signals []*AccountWG) {
 		if aw.wgs[signal.acc] == signal.wg {
 			delete(aw.wgs, signal.acc)
 		}
+		aw.inFlight[signal.acc]--
+		if aw.inFlight[signal.acc] <= 0 {
+			delete(aw.inFlight, signal.acc)
+		}
 	}
 }
 
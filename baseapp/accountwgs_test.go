@@ -101,3 +101,39 @@ func newTestTx(privs []*secp256k1.PrivKey) sdk.Tx {
 	}
 	return AccountLockTestTx{Msgs: msgs}
 }
+
+func TestRegisterWithLimit(t *testing.T) {
+	app := setupBaseApp(t)
+
+	privs := newTestPrivKeys(2)
+	addrs := getAddrs(privs)
+	limit := func(signer sdk.AccAddress) int {
+		if signer.Equals(addrs[0]) {
+			return 1
+		}
+		return 0
+	}
+
+	_, signals, exceeded := app.checkAccountWGs.RegisterWithLimit(newTestTx(privs), limit)
+	require.Nil(t, exceeded)
+	require.Equal(t, 2, len(signals))
+
+	// the second signer has no limit
+	waits, others, exceeded := app.checkAccountWGs.RegisterWithLimit(newTestTx(privs[1:]), limit)
+	require.Nil(t, exceeded)
+	require.Equal(t, 1, len(waits))
+	app.checkAccountWGs.Done(others)
+
+	// the first signer has reached its limit, so nothing is registered
+	_, _, exceeded = app.checkAccountWGs.RegisterWithLimit(newTestTx(privs), limit)
+	require.Equal(t, addrs[0], exceeded)
+	require.Equal(t, 1, app.checkAccountWGs.inFlight[string(addrs[1])])
+
+	// the signers are registered again once the txs are done
+	app.checkAccountWGs.Done(signals)
+	require.Empty(t, app.checkAccountWGs.inFlight)
+	_, signals, exceeded = app.checkAccountWGs.RegisterWithLimit(newTestTx(privs), limit)
+	require.Nil(t, exceeded)
+	app.checkAccountWGs.Done(signals)
+	require.Empty(t, app.checkAccountWGs.wgs)
+}
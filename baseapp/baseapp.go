@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"runtime"
 	"strings"
 	"sync"
 
@@ -67,6 +68,10 @@ type BaseApp struct { //nolint: maligned
 	chCheckTx       chan *RequestCheckTxAsync
 	chCheckTxSize   uint // chCheckTxSize is the initial size for chCheckTx
 
+	checkTxPolicy      CheckTxPolicy // checkTxPolicy prioritizes and limits the txs of CheckTx
+	checkTxConcurrency uint          // checkTxConcurrency is the number of txs checked at once by CheckTxAsync
+	checkTxSlots       *checkTxSlots
+
 	// paramStore is used to query for ABCI consensus parameters from an
 	// application parameter store.
 	paramStore ParamStore
@@ -184,6 +189,7 @@ func NewBaseApp(
 		},
 		txDecoder:       txDecoder,
 		checkAccountWGs: NewAccountWGs(),
+		checkTxPolicy:   DefaultCheckTxPolicy{},
 	}
 
 	for _, option := range options {
@@ -196,6 +202,12 @@ func NewBaseApp(
 	}
 	app.chCheckTx = make(chan *RequestCheckTxAsync, chCheckTxSize)
 
+	checkTxConcurrency := app.checkTxConcurrency
+	if checkTxConcurrency == 0 {
+		checkTxConcurrency = uint(runtime.NumCPU())
+	}
+	app.checkTxSlots = newCheckTxSlots(int(checkTxConcurrency))
+
 	if app.interBlockCache != nil {
 		app.cms.SetInterBlockCache(app.interBlockCache)
 	}
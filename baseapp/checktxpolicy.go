@@ -0,0 +1,111 @@
+package baseapp
+
+import (
+	"math"
+	"math/big"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+)
+
+// CheckTxPolicy decides how CheckTx and the mempool of Ostracon treat the txs.
+// Its methods are called concurrently, so it must be safe for concurrent use.
+type CheckTxPolicy interface {
+	// Priority returns the priority of the tx. CheckTxAsync checks the txs of higher priority first,
+	// and the priority is reported by ResponseCheckTx to the prioritized mempool of Ostracon (v1),
+	// which reaps the txs of higher priority first and evicts the txs of lower priority when it is full.
+	Priority(tx sdk.Tx) int64
+
+	// Sender returns the sender of the tx reported by ResponseCheckTx. The prioritized mempool of
+	// Ostracon keeps at most one tx of each non-empty sender.
+	Sender(tx sdk.Tx) string
+
+	// MaxInFlight returns the maximum number of new txs signed by the signer which CheckTxAsync
+	// checks at once, zero for no limit. The txs beyond it are rejected.
+	MaxInFlight(signer sdk.AccAddress) int
+}
+
+var _ CheckTxPolicy = DefaultCheckTxPolicy{}
+
+// GasPricePrecision is the precision of the gas prices of DefaultCheckTxPolicy. The priority of a tx is
+// its gas price multiplied by it, e.g. 25000 for a gas price of 0.025.
+const GasPricePrecision = 1000000
+
+// DefaultCheckTxPolicy prioritizes the txs by their gas price, and applies the same in-flight limit to all the signers.
+type DefaultCheckTxPolicy struct {
+	// FeeDenom is the denom of the fee coins giving the gas price. The txs are not prioritized if it is empty.
+	FeeDenom string
+
+	// MaxTxsInFlight is the maximum number of new txs of a signer which CheckTxAsync checks at once, zero for no limit
+	MaxTxsInFlight int
+
+	// ReportSender reports the fee payer of the txs as their sender. It is disabled by default, as the
+	// prioritized mempool of Ostracon would then keep at most one tx of each fee payer.
+	ReportSender bool
+}
+
+// Priority returns the gas price of the tx in FeeDenom multiplied by GasPricePrecision, capped to the max int64.
+// It is zero for the txs without fee in FeeDenom or gas limit.
+func (p DefaultCheckTxPolicy) Priority(tx sdk.Tx) int64 {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || feeTx.GetGas() == 0 || p.FeeDenom == "" {
+		return 0
+	}
+
+	amount := feeTx.GetFee().AmountOf(p.FeeDenom)
+	if !amount.IsPositive() {
+		return 0
+	}
+
+	price := new(big.Int).Mul(amount.BigInt(), big.NewInt(GasPricePrecision))
+	price.Quo(price, new(big.Int).SetUint64(feeTx.GetGas()))
+	if !price.IsInt64() {
+		return math.MaxInt64
+	}
+	return price.Int64()
+}
+
+// Sender returns the fee payer of the tx if ReportSender is enabled, and an empty sender otherwise.
+func (p DefaultCheckTxPolicy) Sender(tx sdk.Tx) string {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !p.ReportSender || !ok {
+		return ""
+	}
+	return feeTx.FeePayer().String()
+}
+
+// MaxInFlight returns MaxTxsInFlight for all the signers.
+func (p DefaultCheckTxPolicy) MaxInFlight(signer sdk.AccAddress) int {
+	return p.MaxTxsInFlight
+}
+
+// msgPriorityPolicy gives a fixed priority to the txs containing any of its messages
+type msgPriorityPolicy struct {
+	CheckTxPolicy
+	priority int64
+	msgs     map[string]bool
+}
+
+// PrioritizeMsgs returns a CheckTxPolicy which gives the provided priority to the txs containing any message
+// of the provided type URLs, e.g. the messages of x/foundation or of the operators, and otherwise follows the
+// provided policy.
+func PrioritizeMsgs(policy CheckTxPolicy, priority int64, msgTypeURLs ...string) CheckTxPolicy {
+	msgs := make(map[string]bool, len(msgTypeURLs))
+	for _, url := range msgTypeURLs {
+		msgs[url] = true
+	}
+
+	return msgPriorityPolicy{
+		CheckTxPolicy: policy,
+		priority:      priority,
+		msgs:          msgs,
+	}
+}
+
+func (p msgPriorityPolicy) Priority(tx sdk.Tx) int64 {
+	for _, msg := range tx.GetMsgs() {
+		if p.msgs[sdk.MsgTypeURL(msg)] {
+			return p.priority
+		}
+	}
+	return p.CheckTxPolicy.Priority(tx)
+}
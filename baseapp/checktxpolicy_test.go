@@ -0,0 +1,96 @@
+package baseapp
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/testutil/testdata"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/auth/legacy/legacytx"
+)
+
+func newFeeTestTx(addr sdk.AccAddress, gas uint64, fee sdk.Coins) sdk.Tx {
+	return legacytx.NewStdTx([]sdk.Msg{testdata.NewTestMsg(addr)}, legacytx.NewStdFee(gas, fee), nil, "")
+}
+
+func TestDefaultCheckTxPolicy(t *testing.T) {
+	addr := getAddrs(newTestPrivKeys(1))[0]
+
+	testCases := map[string]struct {
+		policy   DefaultCheckTxPolicy
+		tx       sdk.Tx
+		priority int64
+	}{
+		"gas price": {
+			policy:   DefaultCheckTxPolicy{FeeDenom: "stake"},
+			tx:       newFeeTestTx(addr, 10, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))),
+			priority: 10 * GasPricePrecision,
+		},
+		"fractional gas price": {
+			policy:   DefaultCheckTxPolicy{FeeDenom: "stake"},
+			tx:       newFeeTestTx(addr, 1000, sdk.NewCoins(sdk.NewInt64Coin("stake", 25))),
+			priority: 25000,
+		},
+		"gas price below the precision": {
+			policy: DefaultCheckTxPolicy{FeeDenom: "stake"},
+			tx:     newFeeTestTx(addr, 2*GasPricePrecision, sdk.NewCoins(sdk.NewInt64Coin("stake", 1))),
+		},
+		"the other denoms are ignored": {
+			policy:   DefaultCheckTxPolicy{FeeDenom: "stake"},
+			tx:       newFeeTestTx(addr, 10, sdk.NewCoins(sdk.NewInt64Coin("atom", 1000), sdk.NewInt64Coin("stake", 50))),
+			priority: 5 * GasPricePrecision,
+		},
+		"no fee in the fee denom": {
+			policy: DefaultCheckTxPolicy{FeeDenom: "stake"},
+			tx:     newFeeTestTx(addr, 10, sdk.NewCoins(sdk.NewInt64Coin("atom", 100))),
+		},
+		"no fee denom": {
+			tx: newFeeTestTx(addr, 10, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))),
+		},
+		"gas price beyond int64": {
+			policy:   DefaultCheckTxPolicy{FeeDenom: "stake"},
+			tx:       newFeeTestTx(addr, 1, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewIntFromUint64(math.MaxUint64)))),
+			priority: math.MaxInt64,
+		},
+		"no fee": {
+			policy: DefaultCheckTxPolicy{FeeDenom: "stake"},
+			tx:     newFeeTestTx(addr, 10, nil),
+		},
+		"no gas": {
+			policy: DefaultCheckTxPolicy{FeeDenom: "stake"},
+			tx:     newFeeTestTx(addr, 0, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))),
+		},
+		"not a fee tx": {
+			policy: DefaultCheckTxPolicy{FeeDenom: "stake"},
+			tx:     newTxCounter(0, 0),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.priority, tc.policy.Priority(tc.tx))
+		})
+	}
+
+	tx := newFeeTestTx(addr, 10, nil)
+	require.Empty(t, DefaultCheckTxPolicy{}.Sender(tx))
+	require.Equal(t, addr.String(), DefaultCheckTxPolicy{ReportSender: true}.Sender(tx))
+	require.Empty(t, DefaultCheckTxPolicy{ReportSender: true}.Sender(newTxCounter(0, 0)))
+
+	require.Equal(t, 0, DefaultCheckTxPolicy{}.MaxInFlight(addr))
+	require.Equal(t, 3, DefaultCheckTxPolicy{MaxTxsInFlight: 3}.MaxInFlight(addr))
+}
+
+func TestPrioritizeMsgs(t *testing.T) {
+	addr := getAddrs(newTestPrivKeys(1))[0]
+	policy := PrioritizeMsgs(DefaultCheckTxPolicy{MaxTxsInFlight: 3}, math.MaxInt64, sdk.MsgTypeURL(&testdata.TestMsg{}))
+
+	// the fee is irrelevant to the prioritized messages
+	require.Equal(t, int64(math.MaxInt64), policy.Priority(newFeeTestTx(addr, 10, nil)))
+
+	// the other txs follow the underlying policy
+	require.Equal(t, int64(0), policy.Priority(newTxCounter(0, 0)))
+	require.Equal(t, 3, policy.MaxInFlight(addr))
+}
@@ -93,6 +93,16 @@ func SetChanCheckTxSize(size uint) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetChanCheckTxSize(size) }
 }
 
+// SetCheckTxPolicy sets the policy prioritizing and limiting the txs of CheckTx.
+func SetCheckTxPolicy(policy CheckTxPolicy) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetCheckTxPolicy(policy) }
+}
+
+// SetCheckTxConcurrency sets the number of txs checked at once by CheckTxAsync.
+func SetCheckTxConcurrency(n uint) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetCheckTxConcurrency(n) }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -260,6 +270,23 @@ func (app *BaseApp) SetChanCheckTxSize(chanCheckTxSize uint) {
 	app.chCheckTxSize = chanCheckTxSize
 }
 
+// SetCheckTxPolicy sets the policy prioritizing and limiting the txs of CheckTx.
+func (app *BaseApp) SetCheckTxPolicy(policy CheckTxPolicy) {
+	if app.sealed {
+		panic("SetCheckTxPolicy() on sealed BaseApp")
+	}
+	app.checkTxPolicy = policy
+}
+
+// SetCheckTxConcurrency sets the number of txs checked at once by CheckTxAsync.
+// Zero sets it to the number of CPUs.
+func (app *BaseApp) SetCheckTxConcurrency(n uint) {
+	if app.sealed {
+		panic("SetCheckTxConcurrency() on sealed BaseApp")
+	}
+	app.checkTxConcurrency = n
+}
+
 func MetricsProvider(prometheus bool) cache.MetricsProvider {
 	namespace := "app"
 	if prometheus {
@@ -1,6 +1,7 @@
 package baseapp
 
 import (
+	"container/heap"
 	"sync"
 
 	ocabci "github.com/Finschia/ostracon/abci/types"
@@ -20,6 +21,8 @@ type RequestCheckTxAsync struct {
 	prepare  *sync.WaitGroup
 	tx       sdk.Tx
 	err      error
+	priority int64
+	sender   string
 }
 
 func (app *BaseApp) checkTxAsyncReactor() {
@@ -30,7 +33,17 @@ func (app *BaseApp) checkTxAsyncReactor() {
 			continue
 		}
 
-		waits, signals := app.checkAccountWGs.Register(req.tx)
+		// the txs in the mempool are rechecked regardless of the in-flight limit, lest they be evicted
+		var limit func(sdk.AccAddress) int
+		if !req.recheck {
+			limit = app.checkTxPolicy.MaxInFlight
+		}
+		waits, signals, exceeded := app.checkAccountWGs.RegisterWithLimit(req.tx, limit)
+		if exceeded != nil {
+			err := sdkerrors.Wrapf(sdkerrors.ErrMempoolIsFull, "too many txs of %s being checked", exceeded)
+			req.callback(sdkerrors.ResponseCheckTx(err, 0, 0, app.trace))
+			continue
+		}
 
 		go app.checkTxAsync(req, waits, signals)
 	}
@@ -39,13 +52,19 @@ func (app *BaseApp) checkTxAsyncReactor() {
 func (app *BaseApp) prepareCheckTx(req *RequestCheckTxAsync) {
 	defer req.prepare.Done()
 	req.tx, req.err = app.preCheckTx(req.txBytes)
+	if req.err == nil {
+		req.priority = app.checkTxPolicy.Priority(req.tx)
+		req.sender = app.checkTxPolicy.Sender(req.tx)
+	}
 }
 
 func (app *BaseApp) checkTxAsync(req *RequestCheckTxAsync, waits []*sync.WaitGroup, signals []*AccountWG) {
 	app.checkAccountWGs.Wait(waits)
 	defer app.checkAccountWGs.Done(signals)
 
+	app.checkTxSlots.Acquire(req.priority)
 	gInfo, err := app.checkTx(req.txBytes, req.tx, req.recheck)
+	app.checkTxSlots.Release()
 
 	if err != nil {
 		req.callback(sdkerrors.ResponseCheckTx(err, gInfo.GasWanted, gInfo.GasUsed, app.trace))
@@ -55,5 +74,79 @@ func (app *BaseApp) checkTxAsync(req *RequestCheckTxAsync, waits []*sync.WaitGro
 	req.callback(ocabci.ResponseCheckTx{
 		GasWanted: int64(gInfo.GasWanted), // TODO: Should type accept unsigned ints?
 		GasUsed:   int64(gInfo.GasUsed),   // TODO: Should type accept unsigned ints?
+		Priority:  req.priority,
+		Sender:    req.sender,
 	})
 }
+
+// checkTxSlots limits the number of txs checked at once by CheckTxAsync.
+// The txs waiting for a slot get it in the order of their priority, then of their arrival.
+type checkTxSlots struct {
+	mtx     sync.Mutex
+	free    int
+	seq     uint64
+	waiting slotWaiters
+}
+
+func newCheckTxSlots(n int) *checkTxSlots {
+	return &checkTxSlots{free: n}
+}
+
+// Acquire blocks until it gets a slot for a tx of the priority
+func (s *checkTxSlots) Acquire(priority int64) {
+	s.mtx.Lock()
+	if s.free > 0 {
+		s.free--
+		s.mtx.Unlock()
+		return
+	}
+	w := &slotWaiter{priority: priority, seq: s.seq, ready: make(chan struct{})}
+	s.seq++
+	heap.Push(&s.waiting, w)
+	s.mtx.Unlock()
+
+	<-w.ready
+}
+
+// Release hands the slot over to the first waiting tx, or frees it
+func (s *checkTxSlots) Release() {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.waiting.Len() == 0 {
+		s.free++
+		return
+	}
+	close(heap.Pop(&s.waiting).(*slotWaiter).ready)
+}
+
+type slotWaiter struct {
+	priority int64
+	seq      uint64
+	ready    chan struct{}
+}
+
+// slotWaiters is a heap of the waiting txs, implementing heap.Interface
+type slotWaiters []*slotWaiter
+
+func (ws slotWaiters) Len() int { return len(ws) }
+
+func (ws slotWaiters) Less(i, j int) bool {
+	if ws[i].priority != ws[j].priority {
+		return ws[i].priority > ws[j].priority
+	}
+	return ws[i].seq < ws[j].seq
+}
+
+func (ws slotWaiters) Swap(i, j int) { ws[i], ws[j] = ws[j], ws[i] }
+
+func (ws *slotWaiters) Push(x interface{}) { *ws = append(*ws, x.(*slotWaiter)) }
+
+func (ws *slotWaiters) Pop() interface{} {
+	old := *ws
+	n := len(old)
+	w := old[n-1]
+	old[n-1] = nil
+	*ws = old[:n-1]
+	return w
+}
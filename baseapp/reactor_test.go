@@ -0,0 +1,115 @@
+package baseapp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	ocabci "github.com/Finschia/ostracon/abci/types"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+)
+
+func TestCheckTxSlots(t *testing.T) {
+	slots := newCheckTxSlots(1)
+	slots.Acquire(0)
+
+	// the txs waiting for the slot are queued in any order
+	priorities := []int64{1, 3, 2, 3}
+	order := make(chan int, len(priorities))
+	for i, priority := range priorities {
+		i, priority := i, priority
+		go func() {
+			slots.Acquire(priority)
+			order <- i
+			slots.Release()
+		}()
+	}
+	require.Eventually(t, func() bool {
+		slots.mtx.Lock()
+		defer slots.mtx.Unlock()
+		return slots.waiting.Len() == len(priorities)
+	}, time.Second, time.Millisecond)
+
+	// they get it by priority, then by arrival
+	slots.Release()
+	var got []int64
+	for range priorities {
+		got = append(got, priorities[<-order])
+	}
+	require.Equal(t, []int64{3, 3, 2, 1}, got)
+	require.Eventually(t, func() bool {
+		slots.mtx.Lock()
+		defer slots.mtx.Unlock()
+		return slots.free == 1
+	}, time.Second, time.Millisecond)
+}
+
+func TestCheckTxAsyncPolicy(t *testing.T) {
+	addrs := getAddrs(newTestPrivKeys(2))
+	txs := map[string]sdk.Tx{
+		"first":  newFeeTestTx(addrs[0], 10, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))),
+		"second": newFeeTestTx(addrs[0], 10, sdk.NewCoins(sdk.NewInt64Coin("stake", 200))),
+		"other":  newFeeTestTx(addrs[1], 10, nil),
+	}
+	txs["recheck"] = txs["second"]
+	txDecoder := func(txBytes []byte) (sdk.Tx, error) {
+		return txs[string(txBytes)], nil
+	}
+
+	// the ante handler holds the txs until released
+	release := make(chan struct{})
+	anteOpt := func(app *BaseApp) {
+		app.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			<-release
+			return ctx, nil
+		})
+	}
+	policy := DefaultCheckTxPolicy{FeeDenom: "stake", MaxTxsInFlight: 1, ReportSender: true}
+
+	app := NewBaseApp(t.Name(), defaultLogger(), dbm.NewMemDB(), txDecoder, anteOpt, SetCheckTxPolicy(policy))
+	app.MountStores(capKey1)
+	require.NoError(t, app.LoadLatestVersion())
+	app.InitChain(abci.RequestInitChain{})
+
+	responses := make(map[string]chan ocabci.ResponseCheckTx)
+	checkTxAsync := func(name string, typ abci.CheckTxType) {
+		res := make(chan ocabci.ResponseCheckTx, 1)
+		responses[name] = res
+		app.CheckTxAsync(abci.RequestCheckTx{Tx: []byte(name), Type: typ}, func(r ocabci.ResponseCheckTx) { res <- r })
+	}
+
+	checkTxAsync("first", abci.CheckTxType_New)
+	checkTxAsync("second", abci.CheckTxType_New)
+
+	// the second tx of the signer is rejected while the first one is being checked
+	res := <-responses["second"]
+	require.Equal(t, sdkerrors.ErrMempoolIsFull.ABCICode(), res.Code)
+
+	// the other signers and the rechecks are not limited
+	checkTxAsync("other", abci.CheckTxType_New)
+	checkTxAsync("recheck", abci.CheckTxType_Recheck)
+
+	close(release)
+
+	for name, priority := range map[string]int64{"first": 10 * GasPricePrecision, "other": 0, "recheck": 20 * GasPricePrecision} {
+		res := <-responses[name]
+		require.True(t, res.IsOK(), res.Log)
+		require.Equal(t, priority, res.Priority, name)
+		require.Equal(t, txs[name].(sdk.FeeTx).FeePayer().String(), res.Sender, name)
+	}
+	require.Eventually(t, func() bool {
+		app.checkAccountWGs.mtx.Lock()
+		defer app.checkAccountWGs.mtx.Unlock()
+		return len(app.checkAccountWGs.inFlight) == 0
+	}, time.Second, time.Millisecond)
+
+	res = app.CheckTxSync(abci.RequestCheckTx{Tx: []byte("second")})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, int64(20*GasPricePrecision), res.Priority)
+	require.Equal(t, addrs[0].String(), res.Sender)
+}
@@ -22,6 +22,14 @@ type ABCIListener interface {
 	ListenDeliverTx(ctx types.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error
 }
 
+// PreCommitListener is an optional interface of ABCIListener used to hold back the commit of a block
+// The BaseApp calls ListenPreCommit right before committing the block, and refuses to commit it by panicking
+// if an error is returned
+type PreCommitListener interface {
+	// ListenPreCommit is called with the context of the block which is about to be committed
+	ListenPreCommit(ctx types.Context) error
+}
+
 // StreamingService interface for registering WriteListeners with the BaseApp and updating the service with the ABCI messages using the hooks
 type StreamingService interface {
 	// Stream is the streaming service loop, awaits kv pairs and writes them to some destination stream or file
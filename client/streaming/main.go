@@ -0,0 +1,133 @@
+package streaming
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Finschia/finschia-sdk/client"
+	"github.com/Finschia/finschia-sdk/codec"
+	codectypes "github.com/Finschia/finschia-sdk/codec/types"
+	"github.com/Finschia/finschia-sdk/store/streaming/file"
+	"github.com/Finschia/finschia-sdk/version"
+)
+
+const (
+	FlagPrefix     = "prefix"
+	FlagFromHeight = "from-height"
+	FlagToHeight   = "to-height"
+)
+
+// Cmd creates a main CLI command
+func Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "streaming",
+		Short: "Tool for reading the state streamed by your application",
+		RunE:  client.ValidateCmd,
+	}
+
+	cmd.AddCommand(ReplayCmd())
+
+	return cmd
+}
+
+// ReplayCmd prints the records in the files written by the file streaming service.
+func ReplayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay [dir]",
+		Short: "Print the records in the files written by the file streaming service",
+		Long: fmt.Sprintf(`Print the records in the files written by the file streaming service, in the order they were written.
+Each record is the request and response of an ABCI message with the state changes it made, printed as a line of JSON.
+Both the files of the messages and the segments are read, decompressing them if needed.
+
+Example:
+$ %s streaming replay ~/.simapp/data/streaming --prefix mychain --from-height 100 --to-height 200
+			`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			prefix, err := cmd.Flags().GetString(FlagPrefix)
+			if err != nil {
+				return err
+			}
+			fromHeight, err := cmd.Flags().GetInt64(FlagFromHeight)
+			if err != nil {
+				return err
+			}
+			toHeight, err := cmd.Flags().GetInt64(FlagToHeight)
+			if err != nil {
+				return err
+			}
+
+			cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+			reader, err := file.NewReader(args[0], prefix, cdc)
+			if err != nil {
+				return err
+			}
+			defer reader.Close()
+
+			for {
+				record, err := reader.Next()
+				if err == io.EOF {
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+
+				if record.BlockHeight < fromHeight || (toHeight > 0 && record.BlockHeight > toHeight) {
+					continue
+				}
+
+				bz, err := marshalRecordJSON(cdc, record)
+				if err != nil {
+					return err
+				}
+				fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+			}
+		},
+	}
+
+	cmd.Flags().String(FlagPrefix, "", "The prefix of the files, as configured in streamers.file.prefix")
+	cmd.Flags().Int64(FlagFromHeight, 0, "The height of the first block to print")
+	cmd.Flags().Int64(FlagToHeight, 0, "The height of the last block to print, zero for the last one in the files")
+
+	return cmd
+}
+
+// recordJSON is the JSON representation of a file.Record
+type recordJSON struct {
+	Phase       string            `json:"phase"`
+	BlockHeight int64             `json:"block_height"`
+	TxIndex     *int64            `json:"tx_index,omitempty"`
+	Request     json.RawMessage   `json:"request"`
+	Response    json.RawMessage   `json:"response"`
+	ChangeSet   []json.RawMessage `json:"change_set"`
+}
+
+func marshalRecordJSON(cdc codec.JSONCodec, record *file.Record) ([]byte, error) {
+	res := recordJSON{
+		Phase:       record.Phase.String(),
+		BlockHeight: record.BlockHeight,
+		ChangeSet:   make([]json.RawMessage, len(record.ChangeSet)),
+	}
+	if record.Phase == file.DeliverTx {
+		res.TxIndex = &record.TxIndex
+	}
+
+	var err error
+	if res.Request, err = cdc.MarshalJSON(record.Request); err != nil {
+		return nil, err
+	}
+	if res.Response, err = cdc.MarshalJSON(record.Response); err != nil {
+		return nil, err
+	}
+	for i := range record.ChangeSet {
+		if res.ChangeSet[i], err = cdc.MarshalJSON(&record.ChangeSet[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return json.Marshal(res)
+}
@@ -0,0 +1,105 @@
+// Package admin implements the admin of the contracts of x/token and x/collection.
+package admin
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	storetypes "github.com/Finschia/finschia-sdk/store/types"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+)
+
+// CheckAdmin returns an error if the operator is not the admin of the contract.
+// Nobody acts as the admin of a contract without admin, including proposing one,
+// lest a grantee could make itself the admin and grant any permission.
+func CheckAdmin(contractID, admin string, operator sdk.AccAddress) error {
+	if len(admin) == 0 {
+		return fmt.Errorf("%s has no admin", contractID)
+	}
+	if admin != operator.String() {
+		return fmt.Errorf("%s is not the admin of %s", operator, contractID)
+	}
+
+	return nil
+}
+
+// CheckProposed returns an error if the account cannot be proposed as the next admin.
+func CheckProposed(admin string, proposed sdk.AccAddress) error {
+	if admin == proposed.String() {
+		return sdkerrors.ErrInvalidRequest.Wrapf("%s is the admin already", proposed)
+	}
+
+	return nil
+}
+
+// CheckPendingAdmin returns an error if the operator has not been proposed as the next admin.
+func CheckPendingAdmin(contractID, pendingAdmin string, operator sdk.AccAddress) error {
+	if pendingAdmin != operator.String() {
+		return fmt.Errorf("%s has not been proposed as the admin of %s", operator, contractID)
+	}
+
+	return nil
+}
+
+// NextHistorySequence returns the sequence of the next entry of the admin history,
+// whose keys are the prefix followed by the big endian sequences.
+func NextHistorySequence(store storetypes.KVStore, prefix []byte) uint64 {
+	iterator := sdk.KVStoreReversePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0
+	}
+
+	key := iterator.Key()
+	return binary.BigEndian.Uint64(key[len(key)-8:]) + 1
+}
+
+// Grantee is the grantee of a permission on a contract.
+type Grantee struct {
+	ContractID string
+	Address    sdk.AccAddress
+}
+
+// SoleGrantees returns the only grantee of the permission per contract, in the order of
+// the keys of the grants, which are `prefix | len(contractID) | contractID | len(grantee) | grantee | permission`.
+// The contracts with more than one grantee of the permission are left out.
+// The migrations make them the admins of the contracts issued before the admin was introduced.
+func SoleGrantees(store storetypes.KVStore, prefix []byte, permission byte) []Grantee {
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	var grantees []Grantee
+	shared := map[string]bool{}
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		if key[len(key)-1] != permission {
+			continue
+		}
+
+		begin := len(prefix) + 1
+		end := begin + int(key[begin-1])
+		contractID := string(key[begin:end])
+
+		begin = end + 1
+		end = begin + int(key[begin-1])
+		grantee := sdk.AccAddress(key[begin:end])
+
+		// the grants of a contract are contiguous
+		if last := len(grantees) - 1; last >= 0 && grantees[last].ContractID == contractID {
+			shared[contractID] = true
+			continue
+		}
+		grantees = append(grantees, Grantee{ContractID: contractID, Address: grantee})
+	}
+
+	sole := make([]Grantee, 0, len(grantees))
+	for _, grantee := range grantees {
+		if !shared[grantee.ContractID] {
+			sole = append(sole, grantee)
+		}
+	}
+
+	return sole
+}
@@ -0,0 +1,95 @@
+package admin_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/crypto/keys/secp256k1"
+	"github.com/Finschia/finschia-sdk/internal/admin"
+	"github.com/Finschia/finschia-sdk/testutil"
+	sdk "github.com/Finschia/finschia-sdk/types"
+)
+
+func TestCheckAdmin(t *testing.T) {
+	contractID := "deadbeef"
+	adminAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	stranger := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	testCases := map[string]struct {
+		admin    string
+		operator sdk.AccAddress
+		valid    bool
+	}{
+		"valid admin": {
+			admin:    adminAddr.String(),
+			operator: adminAddr,
+			valid:    true,
+		},
+		"not admin": {
+			admin:    adminAddr.String(),
+			operator: stranger,
+		},
+		"no admin": {
+			operator: stranger,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := admin.CheckAdmin(contractID, tc.admin, tc.operator)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestNextHistorySequence(t *testing.T) {
+	key := sdk.NewKVStoreKey("admin")
+	ctx := testutil.DefaultContext(key, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(key)
+
+	prefix := []byte{0x01, 0x02}
+	require.Equal(t, uint64(0), admin.NextHistorySequence(store, prefix))
+
+	store.Set(append(prefix, 0, 0, 0, 0, 0, 0, 1, 0), []byte{})
+	store.Set(append([]byte{0x01, 0x03}, 0, 0, 0, 0, 0, 0, 2, 0), []byte{})
+	require.Equal(t, uint64(257), admin.NextHistorySequence(store, prefix))
+}
+
+func TestSoleGrantees(t *testing.T) {
+	key := sdk.NewKVStoreKey("admin")
+	ctx := testutil.DefaultContext(key, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(key)
+
+	addrs := make([]sdk.AccAddress, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	prefix := []byte{0x02}
+	permission := byte(1)
+	grantKey := func(contractID string, grantee sdk.AccAddress, permission byte) []byte {
+		key := append([]byte{}, prefix...)
+		key = append(key, byte(len(contractID)))
+		key = append(key, contractID...)
+		key = append(key, byte(len(grantee)))
+		key = append(key, grantee...)
+		return append(key, permission)
+	}
+
+	store.Set(grantKey("deadbeef", addrs[0], permission), []byte{})
+	store.Set(grantKey("deadbeef", addrs[1], permission+1), []byte{})
+	store.Set(grantKey("fee1dead", addrs[0], permission), []byte{})
+	store.Set(grantKey("fee1dead", addrs[1], permission), []byte{})
+	store.Set(grantKey("00bab10c", addrs[1], permission), []byte{})
+
+	grantees := admin.SoleGrantees(store, prefix, permission)
+	require.Equal(t, []admin.Grantee{
+		{ContractID: "00bab10c", Address: addrs[1]},
+		{ContractID: "deadbeef", Address: addrs[0]},
+	}, grantees)
+}
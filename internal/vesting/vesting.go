@@ -0,0 +1,40 @@
+// Package vesting implements the vesting schedules of the locks of x/token and x/collection.
+package vesting
+
+import (
+	"math/big"
+	"time"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+)
+
+// MaxLocks is the maximum number of the locks of a holder on a token. The locks of the same schedule
+// are merged, and a new schedule is rejected beyond it, lest a minter would make the sends of the holder
+// iterate over an unbounded number of locks.
+const MaxLocks = 10
+
+// ValidateSchedule validates the vesting schedule between the start time and the end time.
+func ValidateSchedule(startTime, endTime time.Time) error {
+	if endTime.Before(startTime) {
+		return sdkerrors.ErrInvalidRequest.Wrap("end time cannot be before start time")
+	}
+	return nil
+}
+
+// LockedAmount returns the part of the given amount, which is still locked at the given time.
+// The amount is locked until the start time, and unlocked linearly until the end time.
+func LockedAmount(startTime, endTime time.Time, amount sdk.Int, now time.Time) sdk.Int {
+	if !now.Before(endTime) {
+		return sdk.ZeroInt()
+	}
+	if !now.After(startTime) {
+		return amount
+	}
+
+	elapsed := big.NewInt(int64(now.Sub(startTime)))
+	total := big.NewInt(int64(endTime.Sub(startTime)))
+	unlocked := new(big.Int).Quo(new(big.Int).Mul(amount.BigInt(), elapsed), total)
+
+	return amount.Sub(sdk.NewIntFromBigInt(unlocked))
+}
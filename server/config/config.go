@@ -100,6 +100,15 @@ type BaseConfig struct {
 
 	// ChanCheckTxSize is the size of RequestCheckTxAsync of BaseApp
 	ChanCheckTxSize uint `mapstructure:"chan-check-tx-size"`
+
+	// CheckTxConcurrency is the number of txs checked at once by CheckTxAsync of BaseApp, zero for the number of CPUs
+	CheckTxConcurrency uint `mapstructure:"check-tx-concurrency"`
+
+	// CheckTxMaxInFlight is the maximum number of new txs of a signer checked at once by CheckTxAsync of BaseApp, zero for no limit
+	CheckTxMaxInFlight uint `mapstructure:"check-tx-max-in-flight"`
+
+	// CheckTxFeeDenom is the denom of the fees giving the gas price which CheckTxAsync of BaseApp prioritizes the txs by, empty for no priority
+	CheckTxFeeDenom string `mapstructure:"check-tx-fee-denom"`
 }
 
 // APIConfig defines the API listener configuration.
@@ -328,6 +337,9 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			IAVLDisableFastNode: v.GetBool("iavl-disable-fastnode"),
 			IAVLCacheSize:       v.GetUint64("iavl-cache-size"),
 			ChanCheckTxSize:     v.GetUint("chan-check-tx-size"),
+			CheckTxConcurrency:  v.GetUint("check-tx-concurrency"),
+			CheckTxMaxInFlight:  v.GetUint("check-tx-max-in-flight"),
+			CheckTxFeeDenom:     v.GetString("check-tx-fee-denom"),
 		},
 		Telemetry: telemetry.Config{
 			ServiceName:             v.GetString("telemetry.service-name"),
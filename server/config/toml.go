@@ -90,6 +90,20 @@ prometheus = {{ .BaseConfig.Prometheus }}
 # ChanCheckTxSize should be equals to or greater than the mempool size set in config.toml of Ostracon.
 chan-check-tx-size = {{ .BaseConfig.ChanCheckTxSize }}
 
+# CheckTxConcurrency is the number of txs checked at once by BaseApp.
+# The txs waiting to be checked are checked in the order of their priority, i.e. their gas price in check-tx-fee-denom by default.
+# Zero sets it to the number of CPUs.
+check-tx-concurrency = {{ .BaseConfig.CheckTxConcurrency }}
+
+# CheckTxMaxInFlight is the maximum number of new txs of a signer being checked at once by BaseApp.
+# The txs beyond it are rejected, which keeps a flood of txs from one account from delaying the others.
+# Zero disables the limit.
+check-tx-max-in-flight = {{ .BaseConfig.CheckTxMaxInFlight }}
+
+# CheckTxFeeDenom is the denom of the fees giving the gas price of the txs, which BaseApp prioritizes them by.
+# The fees in the other denoms are ignored, and the txs are not prioritized if it is empty.
+check-tx-fee-denom = "{{ .BaseConfig.CheckTxFeeDenom }}"
+
 ###############################################################################
 ###                         Telemetry Configuration                         ###
 ###############################################################################
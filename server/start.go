@@ -53,6 +53,9 @@ const (
 	FlagInvCheckPeriod      = "inv-check-period"
 	FlagPrometheus          = "prometheus"
 	FlagChanCheckTxSize     = "chan-check-tx-size"
+	FlagCheckTxConcurrency  = "check-tx-concurrency"
+	FlagCheckTxMaxInFlight  = "check-tx-max-in-flight"
+	FlagCheckTxFeeDenom     = "check-tx-fee-denom"
 
 	FlagPruning           = "pruning"
 	FlagPruningKeepRecent = "pruning-keep-recent"
@@ -183,6 +186,9 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Bool(FlagPrometheus, false, "Enable prometheus metric for app")
 
 	cmd.Flags().Uint(FlagChanCheckTxSize, serverconfig.DefaultChanCheckTxSize, "The size of the channel check tx")
+	cmd.Flags().Uint(FlagCheckTxConcurrency, 0, "The number of txs checked at once, zero for the number of CPUs")
+	cmd.Flags().Uint(FlagCheckTxMaxInFlight, 0, "The maximum number of new txs of a signer checked at once, zero for no limit")
+	cmd.Flags().String(FlagCheckTxFeeDenom, "", "The denom of the fees giving the gas price which the txs are prioritized by, empty for no priority")
 
 	// add support for all Ostracon-specific command line options
 	ostcmd.AddNodeFlags(cmd)
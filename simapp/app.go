@@ -11,7 +11,6 @@ import (
 	"github.com/rakyll/statik/fs"
 	"github.com/spf13/cast"
 	abci "github.com/tendermint/tendermint/abci/types"
-	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	ocabci "github.com/Finschia/ostracon/abci/types"
@@ -45,7 +44,6 @@ import (
 	"github.com/Finschia/finschia-sdk/x/authz"
 	authzkeeper "github.com/Finschia/finschia-sdk/x/authz/keeper"
 	authzmodule "github.com/Finschia/finschia-sdk/x/authz/module"
-	"github.com/Finschia/finschia-sdk/x/bank"
 	bankkeeper "github.com/Finschia/finschia-sdk/x/bank/keeper"
 	banktypes "github.com/Finschia/finschia-sdk/x/bank/types"
 	"github.com/Finschia/finschia-sdk/x/bankplus"
@@ -92,12 +90,20 @@ import (
 	"github.com/Finschia/finschia-sdk/x/staking"
 	stakingkeeper "github.com/Finschia/finschia-sdk/x/staking/keeper"
 	stakingtypes "github.com/Finschia/finschia-sdk/x/staking/types"
+	"github.com/Finschia/finschia-sdk/x/stakingplus"
+	stakingpluskeeper "github.com/Finschia/finschia-sdk/x/stakingplus/keeper"
 	stakingplusmodule "github.com/Finschia/finschia-sdk/x/stakingplus/module"
+	"github.com/Finschia/finschia-sdk/x/swap"
+	swapkeeper "github.com/Finschia/finschia-sdk/x/swap/keeper"
+	swapmodule "github.com/Finschia/finschia-sdk/x/swap/module"
 	"github.com/Finschia/finschia-sdk/x/token"
 	"github.com/Finschia/finschia-sdk/x/token/class"
 	classkeeper "github.com/Finschia/finschia-sdk/x/token/class/keeper"
 	tokenkeeper "github.com/Finschia/finschia-sdk/x/token/keeper"
 	tokenmodule "github.com/Finschia/finschia-sdk/x/token/module"
+	"github.com/Finschia/finschia-sdk/x/tokenbridge"
+	tokenbridgekeeper "github.com/Finschia/finschia-sdk/x/tokenbridge/keeper"
+	tokenbridgemodule "github.com/Finschia/finschia-sdk/x/tokenbridge/module"
 	"github.com/Finschia/finschia-sdk/x/upgrade"
 	upgradeclient "github.com/Finschia/finschia-sdk/x/upgrade/client"
 	upgradekeeper "github.com/Finschia/finschia-sdk/x/upgrade/keeper"
@@ -119,9 +125,10 @@ var (
 	ModuleBasics = module.NewBasicManager(
 		auth.AppModuleBasic{},
 		genutil.AppModuleBasic{},
-		bank.AppModuleBasic{},
+		bankplus.AppModuleBasic{},
 		capability.AppModuleBasic{},
 		stakingplusmodule.AppModuleBasic{},
+		stakingplusmodule.PlusAppModuleBasic{},
 		mint.AppModuleBasic{},
 		distr.AppModuleBasic{},
 		foundationmodule.AppModuleBasic{},
@@ -141,6 +148,8 @@ var (
 		authzmodule.AppModuleBasic{},
 		vesting.AppModuleBasic{},
 		tokenmodule.AppModuleBasic{},
+		tokenbridgemodule.AppModuleBasic{},
+		swapmodule.AppModuleBasic{},
 		collectionmodule.AppModuleBasic{},
 	)
 
@@ -154,6 +163,8 @@ var (
 		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:            {authtypes.Burner},
+		tokenbridge.ModuleName:         {authtypes.Minter, authtypes.Burner},
+		swap.ModuleName:                nil,
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -183,24 +194,26 @@ type SimApp struct {
 	memKeys map[string]*sdk.MemoryStoreKey
 
 	// keepers
-	AccountKeeper    authkeeper.AccountKeeper
-	BankKeeper       bankkeeper.Keeper
-	CapabilityKeeper *capabilitykeeper.Keeper
-	StakingKeeper    stakingkeeper.Keeper
-	SlashingKeeper   slashingkeeper.Keeper
-	MintKeeper       mintkeeper.Keeper
-	DistrKeeper      distrkeeper.Keeper
-	FoundationKeeper foundationkeeper.Keeper
-	GovKeeper        govkeeper.Keeper
-	CrisisKeeper     crisiskeeper.Keeper
-	UpgradeKeeper    upgradekeeper.Keeper
-	ParamsKeeper     paramskeeper.Keeper
-	AuthzKeeper      authzkeeper.Keeper
-	EvidenceKeeper   evidencekeeper.Keeper
-	FeeGrantKeeper   feegrantkeeper.Keeper
-	ClassKeeper      classkeeper.Keeper
-	TokenKeeper      tokenkeeper.Keeper
-	CollectionKeeper collectionkeeper.Keeper
+	AccountKeeper     authkeeper.AccountKeeper
+	BankKeeper        bankkeeper.Keeper
+	CapabilityKeeper  *capabilitykeeper.Keeper
+	StakingKeeper     stakingkeeper.Keeper
+	SlashingKeeper    slashingkeeper.Keeper
+	MintKeeper        mintkeeper.Keeper
+	DistrKeeper       distrkeeper.Keeper
+	FoundationKeeper  foundationkeeper.Keeper
+	GovKeeper         govkeeper.Keeper
+	CrisisKeeper      crisiskeeper.Keeper
+	UpgradeKeeper     upgradekeeper.Keeper
+	ParamsKeeper      paramskeeper.Keeper
+	AuthzKeeper       authzkeeper.Keeper
+	EvidenceKeeper    evidencekeeper.Keeper
+	FeeGrantKeeper    feegrantkeeper.Keeper
+	ClassKeeper       classkeeper.Keeper
+	TokenKeeper       tokenkeeper.Keeper
+	TokenBridgeKeeper tokenbridgekeeper.Keeper
+	SwapKeeper        swapkeeper.Keeper
+	CollectionKeeper  collectionkeeper.Keeper
 
 	// the module manager
 	mm *module.Manager
@@ -240,6 +253,7 @@ func NewSimApp(
 		authtypes.StoreKey,
 		banktypes.StoreKey,
 		stakingtypes.StoreKey,
+		stakingplus.StoreKey,
 		minttypes.StoreKey,
 		distrtypes.StoreKey,
 		slashingtypes.StoreKey,
@@ -252,7 +266,9 @@ func NewSimApp(
 		foundation.StoreKey,
 		class.StoreKey,
 		token.StoreKey,
+		tokenbridge.StoreKey,
 		collection.StoreKey,
+		swap.StoreKey,
 		authzkeeper.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
@@ -292,8 +308,8 @@ func NewSimApp(
 	app.AccountKeeper = authkeeper.NewAccountKeeper(
 		appCodec, keys[authtypes.StoreKey], app.GetSubspace(authtypes.ModuleName), authtypes.ProtoBaseAccount, maccPerms,
 	)
-	app.BankKeeper = bankpluskeeper.NewBaseKeeper(
-		appCodec, keys[banktypes.StoreKey], app.AccountKeeper, app.GetSubspace(banktypes.ModuleName), app.BlockedAddrs(), false)
+	app.BankKeeper = bankpluskeeper.NewBaseKeeperWithAuthority(
+		appCodec, keys[banktypes.StoreKey], app.AccountKeeper, app.GetSubspace(banktypes.ModuleName), app.BlockedAddrs(), false, foundation.DefaultAuthority().String())
 	stakingKeeper := stakingkeeper.NewKeeper(
 		appCodec, keys[stakingtypes.StoreKey], app.AccountKeeper, app.BankKeeper, app.GetSubspace(stakingtypes.ModuleName),
 	)
@@ -320,7 +336,9 @@ func NewSimApp(
 
 	app.ClassKeeper = classkeeper.NewKeeper(appCodec, keys[class.StoreKey])
 	app.TokenKeeper = tokenkeeper.NewKeeper(appCodec, keys[token.StoreKey], app.ClassKeeper)
-	app.CollectionKeeper = collectionkeeper.NewKeeper(appCodec, keys[collection.StoreKey], app.ClassKeeper)
+	app.TokenBridgeKeeper = tokenbridgekeeper.NewKeeper(appCodec, keys[tokenbridge.StoreKey], app.AccountKeeper, app.BankKeeper, app.TokenKeeper)
+	app.CollectionKeeper = collectionkeeper.NewKeeper(appCodec, keys[collection.StoreKey], app.ClassKeeper, app.BankKeeper)
+	app.SwapKeeper = swapkeeper.NewKeeper(appCodec, keys[swap.StoreKey], app.AccountKeeper, app.BankKeeper, app.TokenKeeper, app.CollectionKeeper)
 
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
@@ -364,6 +382,7 @@ func NewSimApp(
 
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
+	stakingPlusKeeper := stakingpluskeeper.NewKeeper(appCodec, keys[stakingplus.StoreKey])
 	app.mm = module.NewManager(
 		genutil.NewAppModule(
 			app.AccountKeeper, app.StakingKeeper, app.BaseApp.DeliverTx,
@@ -375,17 +394,20 @@ func NewSimApp(
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
-		foundationmodule.NewAppModule(appCodec, app.FoundationKeeper),
+		foundationmodule.NewAppModule(appCodec, app.FoundationKeeper, app.AccountKeeper, app.BankKeeper),
 		gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
 		mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper),
 		slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		distr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
-		stakingplusmodule.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, app.FoundationKeeper),
+		stakingplusmodule.NewAppModule(appCodec, app.StakingKeeper, stakingPlusKeeper, app.AccountKeeper, app.BankKeeper, app.SlashingKeeper, app.FoundationKeeper),
+		stakingplusmodule.NewPlusAppModule(stakingPlusKeeper, keys[stakingtypes.StoreKey]),
 		upgrade.NewAppModule(app.UpgradeKeeper),
 		evidence.NewAppModule(app.EvidenceKeeper),
 		params.NewAppModule(app.ParamsKeeper),
-		tokenmodule.NewAppModule(appCodec, app.TokenKeeper),
-		collectionmodule.NewAppModule(appCodec, app.CollectionKeeper),
+		tokenmodule.NewAppModule(appCodec, app.TokenKeeper, app.AccountKeeper, app.BankKeeper),
+		tokenbridgemodule.NewAppModule(appCodec, app.TokenBridgeKeeper),
+		collectionmodule.NewAppModule(appCodec, app.CollectionKeeper, app.AccountKeeper, app.BankKeeper),
+		swapmodule.NewAppModule(appCodec, app.SwapKeeper),
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 	)
 
@@ -403,6 +425,7 @@ func NewSimApp(
 		slashingtypes.ModuleName,
 		evidencetypes.ModuleName,
 		stakingtypes.ModuleName,
+		stakingplus.ModuleName,
 		authtypes.ModuleName,
 		banktypes.ModuleName,
 		govtypes.ModuleName,
@@ -413,12 +436,15 @@ func NewSimApp(
 		paramstypes.ModuleName,
 		vestingtypes.ModuleName,
 		token.ModuleName,
+		tokenbridge.ModuleName,
 		collection.ModuleName,
+		swap.ModuleName,
 	)
 	app.mm.SetOrderEndBlockers(
 		crisistypes.ModuleName,
 		govtypes.ModuleName,
 		stakingtypes.ModuleName,
+		stakingplus.ModuleName,
 		capabilitytypes.ModuleName,
 		authtypes.ModuleName,
 		banktypes.ModuleName,
@@ -434,7 +460,9 @@ func NewSimApp(
 		vestingtypes.ModuleName,
 		foundation.ModuleName,
 		token.ModuleName,
+		tokenbridge.ModuleName,
 		collection.ModuleName,
+		swap.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -448,6 +476,7 @@ func NewSimApp(
 		banktypes.ModuleName,
 		distrtypes.ModuleName,
 		stakingtypes.ModuleName,
+		stakingplus.ModuleName,
 		slashingtypes.ModuleName,
 		govtypes.ModuleName,
 		minttypes.ModuleName,
@@ -461,7 +490,9 @@ func NewSimApp(
 		upgradetypes.ModuleName,
 		vestingtypes.ModuleName,
 		token.ModuleName,
+		tokenbridge.ModuleName,
 		collection.ModuleName,
+		swap.ModuleName,
 	)
 
 	// Uncomment if you want to set a custom migration order here.
@@ -511,13 +542,12 @@ func NewSimApp(
 	app.SetAnteHandler(anteHandler)
 	app.SetEndBlocker(app.EndBlocker)
 
+	app.RegisterUpgradeHandlers()
+
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
 			ostos.Exit(err.Error())
 		}
-
-		ctx := app.BaseApp.NewUncachedContext(true, tmproto.Header{})
-		app.BankKeeper.(bankpluskeeper.Keeper).InitializeBankPlus(ctx)
 	}
 
 	return app
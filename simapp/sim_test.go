@@ -24,14 +24,17 @@ import (
 	authzkeeper "github.com/Finschia/finschia-sdk/x/authz/keeper"
 	banktypes "github.com/Finschia/finschia-sdk/x/bank/types"
 	capabilitytypes "github.com/Finschia/finschia-sdk/x/capability/types"
+	"github.com/Finschia/finschia-sdk/x/collection"
 	distrtypes "github.com/Finschia/finschia-sdk/x/distribution/types"
 	evidencetypes "github.com/Finschia/finschia-sdk/x/evidence/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
 	govtypes "github.com/Finschia/finschia-sdk/x/gov/types"
 	minttypes "github.com/Finschia/finschia-sdk/x/mint/types"
 	paramtypes "github.com/Finschia/finschia-sdk/x/params/types"
 	"github.com/Finschia/finschia-sdk/x/simulation"
 	slashingtypes "github.com/Finschia/finschia-sdk/x/slashing/types"
 	stakingtypes "github.com/Finschia/finschia-sdk/x/staking/types"
+	"github.com/Finschia/finschia-sdk/x/token"
 )
 
 // Get flags every time the simulator is run
@@ -180,6 +183,9 @@ func TestAppImportExport(t *testing.T) {
 		{app.keys[evidencetypes.StoreKey], newApp.keys[evidencetypes.StoreKey], [][]byte{}},
 		{app.keys[capabilitytypes.StoreKey], newApp.keys[capabilitytypes.StoreKey], [][]byte{}},
 		{app.keys[authzkeeper.StoreKey], newApp.keys[authzkeeper.StoreKey], [][]byte{}},
+		{app.keys[foundation.StoreKey], newApp.keys[foundation.StoreKey], [][]byte{}},
+		{app.keys[token.StoreKey], newApp.keys[token.StoreKey], [][]byte{}},
+		{app.keys[collection.StoreKey], newApp.keys[collection.StoreKey], [][]byte{}},
 	}
 
 	for _, skp := range storeKeysPrefixes {
@@ -22,6 +22,7 @@ import (
 	"github.com/Finschia/finschia-sdk/client/keys"
 	"github.com/Finschia/finschia-sdk/client/pruning"
 	"github.com/Finschia/finschia-sdk/client/rpc"
+	"github.com/Finschia/finschia-sdk/client/streaming"
 	"github.com/Finschia/finschia-sdk/server"
 	serverconfig "github.com/Finschia/finschia-sdk/server/config"
 	servertypes "github.com/Finschia/finschia-sdk/server/types"
@@ -137,6 +138,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		debug.Cmd(),
 		config.Cmd(),
 		pruning.PruningCmd(a.newApp),
+		streaming.Cmd(),
 	)
 
 	server.AddCommands(rootCmd, simapp.DefaultNodeHome, a.newApp, a.appExport, addModuleInitFlags)
@@ -261,6 +263,11 @@ func (a appCreator) newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, a
 		baseapp.SetIAVLCacheSize(cast.ToInt(appOpts.Get(server.FlagIAVLCacheSize))),
 		baseapp.SetIAVLDisableFastNode(cast.ToBool(appOpts.Get(server.FlagIAVLFastNode))),
 		baseapp.SetChanCheckTxSize(cast.ToUint(appOpts.Get(server.FlagChanCheckTxSize))),
+		baseapp.SetCheckTxConcurrency(cast.ToUint(appOpts.Get(server.FlagCheckTxConcurrency))),
+		baseapp.SetCheckTxPolicy(baseapp.DefaultCheckTxPolicy{
+			FeeDenom:       cast.ToString(appOpts.Get(server.FlagCheckTxFeeDenom)),
+			MaxTxsInFlight: cast.ToInt(appOpts.Get(server.FlagCheckTxMaxInFlight)),
+		}),
 	)
 }
 
@@ -19,6 +19,7 @@ import (
 	simtypes "github.com/Finschia/finschia-sdk/types/simulation"
 	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
 	banktypes "github.com/Finschia/finschia-sdk/x/bank/types"
+	bankplustypes "github.com/Finschia/finschia-sdk/x/bankplus/types"
 	stakingtypes "github.com/Finschia/finschia-sdk/x/staking/types"
 )
 
@@ -101,9 +102,11 @@ func AppStateFn(cdc codec.JSONCodec, simManager *module.SimulationManager) simty
 		if !ok {
 			panic("bank genesis state is missing")
 		}
+		// bankplus shares the json object of the bank genesis state, so keep
+		// its state aside
 		bankState := new(banktypes.GenesisState)
-		err = cdc.UnmarshalJSON(bankStateBz, bankState)
-		if err != nil {
+		plusState := new(bankplustypes.GenesisState)
+		if err := module.SplitExtendedGenesis(cdc, bankStateBz, bankState, plusState); err != nil {
 			panic(err)
 		}
 
@@ -114,7 +117,7 @@ func AppStateFn(cdc codec.JSONCodec, simManager *module.SimulationManager) simty
 
 		// change appState back
 		rawState[stakingtypes.ModuleName] = cdc.MustMarshalJSON(stakingState)
-		rawState[banktypes.ModuleName] = cdc.MustMarshalJSON(bankState)
+		rawState[banktypes.ModuleName] = module.MergeExtendedGenesis(cdc, bankState, plusState)
 
 		// replace appstate
 		appState, err = json.Marshal(rawState)
@@ -0,0 +1,39 @@
+package simapp
+
+import (
+	storetypes "github.com/Finschia/finschia-sdk/store/types"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/module"
+	"github.com/Finschia/finschia-sdk/x/stakingplus"
+	upgradetypes "github.com/Finschia/finschia-sdk/x/upgrade/types"
+)
+
+// UpgradeName is the name of the upgrade from v0.47.
+const UpgradeName = "v0.48.0"
+
+// RegisterUpgradeHandlers registers the handler of UpgradeName and, if the
+// upgrade is scheduled, the store upgrades of it. It must be called before
+// the stores are loaded.
+func (app *SimApp) RegisterUpgradeHandlers() {
+	app.UpgradeKeeper.SetUpgradeHandler(UpgradeName, func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		// the stakingplus specific state used to live in the store of the
+		// staking module, which is v1 of the stakingplus module.
+		fromVM[stakingplus.ModuleName] = 1
+
+		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+	})
+
+	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(err)
+	}
+
+	if upgradeInfo.Name == UpgradeName && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		storeUpgrades := storetypes.StoreUpgrades{
+			Added: []string{
+				stakingplus.StoreKey,
+			},
+		}
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/Finschia/finschia-sdk/codec"
 	serverTypes "github.com/Finschia/finschia-sdk/server/types"
 	"github.com/Finschia/finschia-sdk/store/streaming/file"
+	"github.com/Finschia/finschia-sdk/store/streaming/grpc"
 	"github.com/Finschia/finschia-sdk/store/types"
 )
 
@@ -23,6 +24,7 @@ type ServiceType int
 const (
 	Unknown ServiceType = iota
 	File
+	GRPC
 	// add more in the future
 )
 
@@ -31,6 +33,8 @@ func ServiceTypeFromString(name string) ServiceType {
 	switch strings.ToLower(name) {
 	case "file", "f":
 		return File
+	case "grpc", "g":
+		return GRPC
 	default:
 		return Unknown
 	}
@@ -41,6 +45,8 @@ func (sst ServiceType) String() string {
 	switch sst {
 	case File:
 		return "file"
+	case GRPC:
+		return "grpc"
 	default:
 		return "unknown"
 	}
@@ -49,6 +55,7 @@ func (sst ServiceType) String() string {
 // ServiceConstructorLookupTable is a mapping of streaming.ServiceTypes to streaming.ServiceConstructors
 var ServiceConstructorLookupTable = map[ServiceType]ServiceConstructor{
 	File: NewFileStreamingService,
+	GRPC: NewGRPCStreamingService,
 }
 
 // NewServiceConstructor returns the streaming.ServiceConstructor corresponding to the provided name
@@ -67,7 +74,37 @@ func NewServiceConstructor(name string) (ServiceConstructor, error) {
 func NewFileStreamingService(opts serverTypes.AppOptions, keys []types.StoreKey, marshaller codec.BinaryCodec) (baseapp.StreamingService, error) {
 	filePrefix := cast.ToString(opts.Get("streamers.file.prefix"))
 	fileDir := cast.ToString(opts.Get("streamers.file.write_dir"))
-	return file.NewStreamingService(fileDir, filePrefix, keys, marshaller)
+	compression, err := file.CompressionFromString(cast.ToString(opts.Get("streamers.file.compression")))
+	if err != nil {
+		return nil, err
+	}
+	segmentBlocks := cast.ToInt64(opts.Get("streamers.file.segment_blocks"))
+	segmentSize := cast.ToInt64(opts.Get("streamers.file.segment_size"))
+	maxSegments := cast.ToInt(opts.Get("streamers.file.max_segments"))
+	return file.NewStreamingService(fileDir, filePrefix, keys, marshaller,
+		file.WithCompression(compression),
+		file.WithSegmentRotation(segmentBlocks, segmentSize),
+		file.WithRetention(maxSegments),
+	)
+}
+
+// NewGRPCStreamingService is the streaming.ServiceConstructor function for creating a gRPC StreamingService
+func NewGRPCStreamingService(opts serverTypes.AppOptions, keys []types.StoreKey, marshaller codec.BinaryCodec) (baseapp.StreamingService, error) {
+	address := cast.ToString(opts.Get("streamers.grpc.address"))
+	if address == "" {
+		return nil, fmt.Errorf("streamers.grpc.address is required")
+	}
+
+	config := grpc.DefaultConfig()
+	if backPressure := cast.ToString(opts.Get("streamers.grpc.back_pressure")); backPressure != "" {
+		config.BackPressure = grpc.BackPressureFromString(backPressure)
+	}
+	if bufferSize := opts.Get("streamers.grpc.buffer_size"); bufferSize != nil {
+		config.BufferSize = cast.ToInt(bufferSize)
+	}
+	config.AckTimeout = cast.ToDuration(opts.Get("streamers.grpc.ack_timeout"))
+
+	return grpc.NewStreamingService(address, keys, config)
 }
 
 // LoadStreamingServices is a function for loading StreamingServices onto the BaseApp using the provided AppOptions, codec, and keys
@@ -8,6 +8,7 @@ import (
 	"github.com/Finschia/finschia-sdk/codec"
 	codecTypes "github.com/Finschia/finschia-sdk/codec/types"
 	"github.com/Finschia/finschia-sdk/store/streaming/file"
+	"github.com/Finschia/finschia-sdk/store/streaming/grpc"
 	"github.com/Finschia/finschia-sdk/store/types"
 	sdk "github.com/Finschia/finschia-sdk/types"
 )
@@ -41,3 +42,35 @@ func TestStreamingServiceConstructor(t *testing.T) {
 		require.True(t, ok)
 	}
 }
+
+type grpcOptions struct{}
+
+func (f *grpcOptions) Get(key string) interface{} {
+	switch key {
+	case "streamers.grpc.address":
+		return "localhost:0"
+	case "streamers.grpc.back_pressure":
+		return "drop"
+	default:
+		return nil
+	}
+}
+
+func TestGRPCStreamingServiceConstructor(t *testing.T) {
+	constructor, err := NewServiceConstructor("grpc")
+	require.Nil(t, err)
+
+	// the address of the consumer is required
+	_, err = constructor(mockOptions, mockKeys, testMarshaller)
+	require.NotNil(t, err)
+
+	serv, err := constructor(new(grpcOptions), mockKeys, testMarshaller)
+	require.Nil(t, err)
+	defer serv.Close()
+	require.IsType(t, &grpc.StreamingService{}, serv)
+	listeners := serv.Listeners()
+	for _, key := range mockKeys {
+		_, ok := listeners[key]
+		require.True(t, ok)
+	}
+}
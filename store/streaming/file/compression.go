@@ -0,0 +1,108 @@
+package file
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// Compression enum for specifying the compression of the written files
+type Compression int
+
+const (
+	NoCompression Compression = iota
+	Gzip
+	Zstd
+)
+
+// CompressionFromString returns the Compression corresponding to the provided name
+func CompressionFromString(name string) (Compression, error) {
+	switch strings.ToLower(name) {
+	case "", "none":
+		return NoCompression, nil
+	case "gzip":
+		return Gzip, nil
+	case "zstd":
+		return Zstd, nil
+	default:
+		return NoCompression, fmt.Errorf("unrecognized compression %s", name)
+	}
+}
+
+// String returns the string name of a Compression
+func (c Compression) String() string {
+	switch c {
+	case Gzip:
+		return "gzip"
+	case Zstd:
+		return "zstd"
+	default:
+		return "none"
+	}
+}
+
+// Extension returns the file name extension of a Compression
+func (c Compression) Extension() string {
+	switch c {
+	case Gzip:
+		return ".gz"
+	case Zstd:
+		return ".zst"
+	default:
+		return ""
+	}
+}
+
+// compressionFromExtension returns the Compression of a file name and the name without the extension
+func compressionFromExtension(name string) (Compression, string) {
+	for _, c := range []Compression{Gzip, Zstd} {
+		if trimmed := strings.TrimSuffix(name, c.Extension()); trimmed != name {
+			return c, trimmed
+		}
+	}
+	return NoCompression, name
+}
+
+// compressWriter is an io.WriteCloser which compresses the data written to it
+// Close does not close the underlying writer
+type compressWriter interface {
+	io.WriteCloser
+	// Flush writes any pending data to the underlying writer
+	Flush() error
+}
+
+type nopCompressWriter struct {
+	io.Writer
+}
+
+func (nopCompressWriter) Flush() error { return nil }
+func (nopCompressWriter) Close() error { return nil }
+
+func (c Compression) newWriter(w io.Writer) (compressWriter, error) {
+	switch c {
+	case Gzip:
+		return gzip.NewWriter(w), nil
+	case Zstd:
+		return zstd.NewWriter(w)
+	default:
+		return nopCompressWriter{w}, nil
+	}
+}
+
+func (c Compression) newReader(r io.Reader) (io.ReadCloser, error) {
+	switch c {
+	case Gzip:
+		return gzip.NewReader(r)
+	case Zstd:
+		dec, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		return dec.IOReadCloser(), nil
+	default:
+		return io.NopCloser(r), nil
+	}
+}
@@ -0,0 +1,285 @@
+package file
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	ocabci "github.com/Finschia/ostracon/abci/types"
+
+	"github.com/Finschia/finschia-sdk/codec"
+	"github.com/Finschia/finschia-sdk/store/types"
+)
+
+// Phase enum for specifying the ABCI message of a Record
+type Phase int
+
+const (
+	BeginBlock Phase = iota
+	DeliverTx
+	EndBlock
+)
+
+// String returns the string name of a Phase
+func (p Phase) String() string {
+	switch p {
+	case BeginBlock:
+		return "begin"
+	case DeliverTx:
+		return "tx"
+	case EndBlock:
+		return "end"
+	default:
+		return "unknown"
+	}
+}
+
+// Record is an ABCI request and response read back from the files written by the StreamingService,
+// along with the state changes it made
+type Record struct {
+	Phase       Phase
+	BlockHeight int64
+	TxIndex     int64                // the index of the tx in the block, only for DeliverTx
+	Request     codec.ProtoMarshaler // *ocabci.RequestBeginBlock, *abci.RequestDeliverTx or *abci.RequestEndBlock
+	Response    codec.ProtoMarshaler // *abci.ResponseBeginBlock, *abci.ResponseDeliverTx or *abci.ResponseEndBlock
+	ChangeSet   []types.StoreKVPair
+}
+
+// fileInfo is the information parsed from the name of a file written by the StreamingService
+type fileInfo struct {
+	name        string
+	compression Compression
+	segment     bool
+	phase       Phase
+	height      int64 // the block height, or the height of the first block for a segment
+	txIndex     int64
+}
+
+// parseFileName parses the name of a file written by the StreamingService with the provided prefix
+// It returns false if the name does not follow the naming schema
+func parseFileName(prefix, name string) (fileInfo, bool) {
+	info := fileInfo{name: name}
+
+	info.compression, name = compressionFromExtension(name)
+	if prefix != "" {
+		if !strings.HasPrefix(name, prefix+"-") {
+			return info, false
+		}
+		name = strings.TrimPrefix(name, prefix+"-")
+	}
+
+	parts := strings.Split(name, "-")
+	if len(parts) < 2 {
+		return info, false
+	}
+	height, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return info, false
+	}
+	info.height = height
+
+	switch {
+	case len(parts) == 2 && parts[0] == "segment":
+		info.segment = true
+	case len(parts) == 3 && parts[0] == "block" && parts[2] == "begin":
+		info.phase = BeginBlock
+	case len(parts) == 3 && parts[0] == "block" && parts[2] == "end":
+		info.phase = EndBlock
+	case len(parts) == 4 && parts[0] == "block" && parts[2] == "tx":
+		txIndex, err := strconv.ParseInt(parts[3], 10, 64)
+		if err != nil {
+			return info, false
+		}
+		info.phase = DeliverTx
+		info.txIndex = txIndex
+	default:
+		return info, false
+	}
+	return info, true
+}
+
+// listFiles returns the information of the files written by the StreamingService with the provided prefix
+// in the provided directory, ordered as they were written
+func listFiles(dir, prefix string) ([]fileInfo, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []fileInfo
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		if info, ok := parseFileName(prefix, entry.Name()); ok {
+			files = append(files, info)
+		}
+	}
+
+	// a segment starts at the beginning of its first block
+	order := func(info fileInfo) int {
+		if info.segment {
+			return -1
+		}
+		return int(info.phase)
+	}
+	sort.SliceStable(files, func(i, j int) bool {
+		a, b := files[i], files[j]
+		if a.height != b.height {
+			return a.height < b.height
+		}
+		if order(a) != order(b) {
+			return order(a) < order(b)
+		}
+		return a.txIndex < b.txIndex
+	})
+
+	return files, nil
+}
+
+// decodeEntry decodes the contents of a file written by the StreamingService into a Record
+func decodeEntry(c codec.BinaryCodec, info fileInfo, bz []byte) (*Record, error) {
+	var msgs [][]byte
+	for len(bz) > 0 {
+		size, n := binary.Uvarint(bz)
+		if n <= 0 || size > uint64(len(bz)-n) {
+			return nil, fmt.Errorf("invalid length-prefixed message in %s", info.name)
+		}
+		msgs = append(msgs, bz[n:uint64(n)+size])
+		bz = bz[uint64(n)+size:]
+	}
+	if len(msgs) < 2 {
+		return nil, fmt.Errorf("missing ABCI request or response in %s", info.name)
+	}
+
+	record := &Record{
+		Phase:       info.phase,
+		BlockHeight: info.height,
+		TxIndex:     info.txIndex,
+	}
+	switch info.phase {
+	case BeginBlock:
+		record.Request, record.Response = &ocabci.RequestBeginBlock{}, &abci.ResponseBeginBlock{}
+	case DeliverTx:
+		record.Request, record.Response = &abci.RequestDeliverTx{}, &abci.ResponseDeliverTx{}
+	case EndBlock:
+		record.Request, record.Response = &abci.RequestEndBlock{}, &abci.ResponseEndBlock{}
+	}
+
+	if err := c.Unmarshal(msgs[0], record.Request); err != nil {
+		return nil, err
+	}
+	if err := c.Unmarshal(msgs[len(msgs)-1], record.Response); err != nil {
+		return nil, err
+	}
+	record.ChangeSet = make([]types.StoreKVPair, len(msgs)-2)
+	for i, msg := range msgs[1 : len(msgs)-1] {
+		if err := c.Unmarshal(msg, &record.ChangeSet[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return record, nil
+}
+
+// Reader iterates the Records out of the files written by the StreamingService,
+// in the order they were written
+type Reader struct {
+	dir     string
+	codec   codec.BinaryCodec
+	files   []fileInfo     // the files left to read
+	segment *segmentReader // the segment being read
+}
+
+// NewReader creates a new Reader of the files with the provided (optional) filePrefix in the readDir
+func NewReader(readDir, filePrefix string, c codec.BinaryCodec) (*Reader, error) {
+	files, err := listFiles(readDir, filePrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Reader{
+		dir:   readDir,
+		codec: c,
+		files: files,
+	}, nil
+}
+
+// Next returns the next Record, or io.EOF if there are no more Records
+func (r *Reader) Next() (*Record, error) {
+	for {
+		if r.segment != nil {
+			name, body, err := r.segment.next()
+			if err == io.EOF {
+				r.segment.Close()
+				r.segment = nil
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			info, ok := parseFileName("", name)
+			if !ok || info.segment {
+				return nil, fmt.Errorf("invalid segment entry %s", name)
+			}
+			return decodeEntry(r.codec, info, body)
+		}
+
+		if len(r.files) == 0 {
+			return nil, io.EOF
+		}
+		info := r.files[0]
+		r.files = r.files[1:]
+
+		path := filepath.Join(r.dir, info.name)
+		if info.segment {
+			segment, err := openSegmentReader(path, info.compression)
+			if err != nil {
+				return nil, err
+			}
+			r.segment = segment
+			continue
+		}
+
+		bz, err := readFile(path, info.compression)
+		if err != nil {
+			return nil, err
+		}
+		return decodeEntry(r.codec, info, bz)
+	}
+}
+
+// Close closes the segment being read
+func (r *Reader) Close() error {
+	if r.segment != nil {
+		err := r.segment.Close()
+		r.segment = nil
+		return err
+	}
+	return nil
+}
+
+// readFile reads all the contents of a file, decompressing them
+func readFile(path string, compression Compression) ([]byte, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	dr, err := compression.newReader(file)
+	if err != nil {
+		return nil, err
+	}
+	defer dr.Close()
+
+	return io.ReadAll(dr)
+}
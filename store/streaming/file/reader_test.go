@@ -0,0 +1,245 @@
+package file
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	ocabci "github.com/Finschia/ostracon/abci/types"
+
+	"github.com/Finschia/finschia-sdk/store/types"
+)
+
+// the response of the txs written by writeBlocks, which is decoded as it is
+var testReaderDeliverTxRes = abci.ResponseDeliverTx{
+	Code: 1,
+	Log:  "mockLog",
+}
+
+func TestParseFileName(t *testing.T) {
+	testCases := map[string]struct {
+		prefix string
+		name   string
+		valid  bool
+		info   fileInfo
+	}{
+		"begin block": {
+			name:  "block-1-begin",
+			valid: true,
+			info:  fileInfo{phase: BeginBlock, height: 1},
+		},
+		"deliver tx": {
+			name:  "block-1-tx-2",
+			valid: true,
+			info:  fileInfo{phase: DeliverTx, height: 1, txIndex: 2},
+		},
+		"end block with prefix": {
+			prefix: testPrefix,
+			name:   testPrefix + "-block-1-end",
+			valid:  true,
+			info:   fileInfo{phase: EndBlock, height: 1},
+		},
+		"compressed segment": {
+			name:  "segment-3.zst",
+			valid: true,
+			info:  fileInfo{compression: Zstd, segment: true, height: 3},
+		},
+		"other prefix": {
+			prefix: testPrefix,
+			name:   "other-block-1-begin",
+		},
+		"no prefix": {
+			prefix: testPrefix,
+			name:   "block-1-begin",
+		},
+		"invalid height": {
+			name: "block-x-begin",
+		},
+		"unknown phase": {
+			name: "block-1-commit",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			info, ok := parseFileName(tc.prefix, tc.name)
+			require.Equal(t, tc.valid, ok)
+			if !tc.valid {
+				return
+			}
+			tc.info.name = tc.name
+			require.Equal(t, tc.info, info)
+		})
+	}
+}
+
+// writeBlocks streams the blocks of the provided heights, each of which has a tx,
+// making a state change for every ABCI message
+func writeBlocks(t *testing.T, fss *StreamingService, heights ...int64) {
+	listener := fss.Listeners()[mockStoreKey1][0]
+	for _, height := range heights {
+		require.NoError(t, listener.OnWrite(mockStoreKey1, mockKey1, mockValue1, false))
+		req := testBeginBlockReq
+		req.Header = tmproto.Header{Height: height}
+		require.NoError(t, fss.ListenBeginBlock(emptyContext, req, testBeginBlockRes))
+
+		require.NoError(t, listener.OnWrite(mockStoreKey1, mockKey2, nil, true))
+		require.NoError(t, fss.ListenDeliverTx(emptyContext, testDeliverTxReq1, testReaderDeliverTxRes))
+
+		require.NoError(t, listener.OnWrite(mockStoreKey1, mockKey3, mockValue3, false))
+		require.NoError(t, fss.ListenEndBlock(emptyContext, abci.RequestEndBlock{Height: height}, testEndBlockRes))
+	}
+}
+
+// readBlocks reads back all the records, checking them against the ones written by writeBlocks
+// It returns the heights of the blocks read
+func readBlocks(t *testing.T, dir string) []int64 {
+	reader, err := NewReader(dir, testPrefix, testMarshaller)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	var heights []int64
+	for {
+		begin, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		require.Equal(t, BeginBlock, begin.Phase)
+		require.Equal(t, begin.BlockHeight, begin.Request.(*ocabci.RequestBeginBlock).Header.Height)
+		require.Equal(t, &testBeginBlockRes, begin.Response)
+		require.Equal(t, []types.StoreKVPair{
+			{StoreKey: mockStoreKey1.Name(), Key: mockKey1, Value: mockValue1},
+		}, begin.ChangeSet)
+		heights = append(heights, begin.BlockHeight)
+
+		tx, err := reader.Next()
+		require.NoError(t, err)
+		require.Equal(t, DeliverTx, tx.Phase)
+		require.Equal(t, begin.BlockHeight, tx.BlockHeight)
+		require.Equal(t, int64(0), tx.TxIndex)
+		require.Equal(t, &testDeliverTxReq1, tx.Request)
+		require.Equal(t, &testReaderDeliverTxRes, tx.Response)
+		require.Equal(t, []types.StoreKVPair{
+			{StoreKey: mockStoreKey1.Name(), Key: mockKey2, Delete: true},
+		}, tx.ChangeSet)
+
+		end, err := reader.Next()
+		require.NoError(t, err)
+		require.Equal(t, EndBlock, end.Phase)
+		require.Equal(t, begin.BlockHeight, end.BlockHeight)
+		require.Equal(t, &abci.RequestEndBlock{Height: begin.BlockHeight}, end.Request)
+		require.Equal(t, []types.StoreKVPair{
+			{StoreKey: mockStoreKey1.Name(), Key: mockKey3, Value: mockValue3},
+		}, end.ChangeSet)
+	}
+	return heights
+}
+
+func TestReader(t *testing.T) {
+	testCases := map[string]struct {
+		opts     []Option
+		files    int
+		segments bool
+	}{
+		"files": {
+			files: 3 * 3,
+		},
+		"gzip files": {
+			opts:  []Option{WithCompression(Gzip)},
+			files: 3 * 3,
+		},
+		"zstd segments": {
+			opts:     []Option{WithCompression(Zstd), WithSegmentRotation(2, 0)},
+			files:    2,
+			segments: true,
+		},
+		"segments by size": {
+			opts:     []Option{WithSegmentRotation(0, 1)},
+			files:    3,
+			segments: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			fss, err := NewStreamingService(dir, testPrefix, []types.StoreKey{mockStoreKey1}, testMarshaller, tc.opts...)
+			require.NoError(t, err)
+			wg := new(sync.WaitGroup)
+			require.NoError(t, fss.Stream(wg))
+
+			writeBlocks(t, fss, 1, 2, 3)
+
+			// the blocks in the current segment are readable before it is finished
+			require.Equal(t, []int64{1, 2, 3}, readBlocks(t, dir))
+
+			require.NoError(t, fss.Close())
+			wg.Wait()
+
+			require.Equal(t, []int64{1, 2, 3}, readBlocks(t, dir))
+
+			files, err := listFiles(dir, testPrefix)
+			require.NoError(t, err)
+			require.Len(t, files, tc.files)
+			for _, info := range files {
+				require.Equal(t, tc.segments, info.segment)
+			}
+		})
+	}
+}
+
+func TestReaderTruncatedSegment(t *testing.T) {
+	dir := t.TempDir()
+	fss, err := NewStreamingService(dir, testPrefix, []types.StoreKey{mockStoreKey1}, testMarshaller, WithSegmentRotation(10, 0))
+	require.NoError(t, err)
+	wg := new(sync.WaitGroup)
+	require.NoError(t, fss.Stream(wg))
+	writeBlocks(t, fss, 1)
+	require.NoError(t, fss.Close())
+	wg.Wait()
+
+	// cut the segment in the middle of the last entry
+	files, err := listFiles(dir, testPrefix)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	path := filepath.Join(dir, files[0].name)
+	stat, err := os.Stat(path)
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(path, stat.Size()-1))
+
+	reader, err := NewReader(dir, testPrefix, testMarshaller)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	for i := 0; i < 2; i++ {
+		_, err := reader.Next()
+		require.NoError(t, err)
+	}
+	_, err = reader.Next()
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+func TestSegmentRetention(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := NewStreamingService(dir, testPrefix, []types.StoreKey{mockStoreKey1}, testMarshaller, WithRetention(2))
+	require.Error(t, err, "retention requires segment rotation")
+
+	fss, err := NewStreamingService(dir, testPrefix, []types.StoreKey{mockStoreKey1}, testMarshaller, WithSegmentRotation(1, 0), WithRetention(2))
+	require.NoError(t, err)
+	wg := new(sync.WaitGroup)
+	require.NoError(t, fss.Stream(wg))
+	writeBlocks(t, fss, 1, 2, 3, 4)
+	require.NoError(t, fss.Close())
+	wg.Wait()
+
+	// only the latest segments are kept
+	require.Equal(t, []int64{3, 4}, readBlocks(t, dir))
+}
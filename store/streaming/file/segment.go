@@ -0,0 +1,215 @@
+package file
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// segmentWriter appends the entries to a segment file
+// An entry is the length-prefixed name of the file it would be written to without the segments,
+// followed by the length-prefixed contents of that file
+type segmentWriter struct {
+	file        *os.File
+	counter     *countingWriter
+	cw          compressWriter
+	startHeight int64 // the height of the first block in the segment
+}
+
+// countingWriter counts the bytes written to the underlying io.Writer
+type countingWriter struct {
+	io.Writer
+	n int64
+}
+
+// Write satisfies io.Writer
+func (w *countingWriter) Write(b []byte) (int, error) {
+	n, err := w.Writer.Write(b)
+	w.n += int64(n)
+	return n, err
+}
+
+func openSegment(path string, startHeight int64, compression Compression) (*segmentWriter, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	counter := &countingWriter{Writer: file}
+	cw, err := compression.newWriter(counter)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return &segmentWriter{
+		file:        file,
+		counter:     counter,
+		cw:          cw,
+		startHeight: startHeight,
+	}, nil
+}
+
+// WriteEntry appends the entry of the provided name and body to the segment
+func (sw *segmentWriter) WriteEntry(name string, body []byte) error {
+	entry := make([]byte, 0, len(name)+len(body)+2*binary.MaxVarintLen64)
+	entry = binary.AppendUvarint(entry, uint64(len(name)))
+	entry = append(entry, name...)
+	entry = binary.AppendUvarint(entry, uint64(len(body)))
+	entry = append(entry, body...)
+
+	_, err := sw.cw.Write(entry)
+	return err
+}
+
+// Size returns the number of bytes written to the segment file
+func (sw *segmentWriter) Size() int64 {
+	return sw.counter.n
+}
+
+// Flush writes the pending entries out to the segment file
+func (sw *segmentWriter) Flush() error {
+	return sw.cw.Flush()
+}
+
+// Close finishes the segment and closes its file
+func (sw *segmentWriter) Close() error {
+	if err := sw.cw.Close(); err != nil {
+		sw.file.Close()
+		return err
+	}
+	return sw.file.Close()
+}
+
+// segmentReader reads the entries of a segment file
+type segmentReader struct {
+	file *os.File
+	dr   io.ReadCloser
+	br   *bufio.Reader
+}
+
+func openSegmentReader(path string, compression Compression) (*segmentReader, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	dr, err := compression.newReader(file)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return &segmentReader{
+		file: file,
+		dr:   dr,
+		br:   bufio.NewReader(dr),
+	}, nil
+}
+
+// next returns the name and the body of the next entry, or io.EOF at the end of the segment
+// A segment which is still being written (or whose writer crashed) has not been finished, so the
+// unexpected end of it at an entry boundary is also regarded as the end of the segment
+func (sr *segmentReader) next() (string, []byte, error) {
+	if _, err := sr.br.Peek(1); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return "", nil, io.EOF
+		}
+		return "", nil, err
+	}
+
+	name, err := sr.readLengthPrefixed()
+	if err != nil {
+		return "", nil, err
+	}
+	body, err := sr.readLengthPrefixed()
+	if err != nil {
+		return "", nil, err
+	}
+	return string(name), body, nil
+}
+
+func (sr *segmentReader) readLengthPrefixed() ([]byte, error) {
+	size, err := binary.ReadUvarint(sr.br)
+	if err != nil {
+		return nil, truncated(err)
+	}
+	bz := make([]byte, size)
+	if _, err := io.ReadFull(sr.br, bz); err != nil {
+		return nil, truncated(err)
+	}
+	return bz, nil
+}
+
+// truncated turns io.EOF in the middle of an entry into io.ErrUnexpectedEOF
+func truncated(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+func (sr *segmentReader) Close() error {
+	sr.dr.Close()
+	return sr.file.Close()
+}
+
+func (fss *StreamingService) segmentsEnabled() bool {
+	return fss.segmentBlocks > 0 || fss.segmentSize > 0
+}
+
+// rotateSegment starts a new segment at the current block if there is no segment yet or the current one
+// has reached its limits, and removes the oldest segments beyond the retention
+func (fss *StreamingService) rotateSegment() error {
+	if seg := fss.segment; seg != nil {
+		full := (fss.segmentBlocks > 0 && fss.currentBlockNumber-seg.startHeight >= fss.segmentBlocks) ||
+			(fss.segmentSize > 0 && seg.Size() >= fss.segmentSize)
+		if !full {
+			return nil
+		}
+
+		fss.segment = nil
+		if err := seg.Close(); err != nil {
+			return err
+		}
+	}
+
+	name := fss.fileName(fmt.Sprintf("segment-%d", fss.currentBlockNumber))
+	seg, err := openSegment(filepath.Join(fss.writeDir, name), fss.currentBlockNumber, fss.compression)
+	if err != nil {
+		return err
+	}
+	fss.segment = seg
+
+	return fss.pruneSegments()
+}
+
+// pruneSegments removes the oldest segments so that at most maxSegments of them are kept
+func (fss *StreamingService) pruneSegments() error {
+	if fss.maxSegments == 0 {
+		return nil
+	}
+
+	files, err := listFiles(fss.writeDir, fss.filePrefix)
+	if err != nil {
+		return err
+	}
+	var segments []fileInfo
+	for _, info := range files {
+		if info.segment {
+			segments = append(segments, info)
+		}
+	}
+	if len(segments) <= fss.maxSegments {
+		return nil
+	}
+
+	sort.Slice(segments, func(i, j int) bool { return segments[i].height < segments[j].height })
+	for _, info := range segments[:len(segments)-fss.maxSegments] {
+		if err := os.Remove(filepath.Join(fss.writeDir, info.name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
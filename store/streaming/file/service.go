@@ -1,6 +1,7 @@
 package file
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"os"
@@ -23,7 +24,6 @@ var _ baseapp.StreamingService = &StreamingService{}
 // StreamingService is a concrete implementation of StreamingService that writes state changes out to files
 type StreamingService struct {
 	listeners          map[types.StoreKey][]types.WriteListener // the listeners that will be initialized with BaseApp
-	srcChan            <-chan []byte                            // the channel that all the WriteListeners write their data out to
 	filePrefix         string                                   // optional prefix for each of the generated files
 	writeDir           string                                   // directory to write files into
 	codec              codec.BinaryCodec                        // marshaller used for re-marshalling the ABCI messages to write them out to the destination files
@@ -32,6 +32,39 @@ type StreamingService struct {
 	currentBlockNumber int64                                    // the current block number
 	currentTxIndex     int64                                    // the index of the current tx
 	quitChan           chan struct{}                            // channel to synchronize closure
+	compression        Compression                              // compression of the written files
+	segmentBlocks      int64                                    // number of blocks after which a segment is rotated, zero disables it
+	segmentSize        int64                                    // number of bytes after which a segment is rotated, zero disables it
+	maxSegments        int                                      // number of segments to retain, zero retains all of them
+	segment            *segmentWriter                           // the current segment
+}
+
+// Option configures the optional features of the StreamingService
+type Option func(*StreamingService)
+
+// WithCompression makes the StreamingService compress the written files
+func WithCompression(compression Compression) Option {
+	return func(fss *StreamingService) {
+		fss.compression = compression
+	}
+}
+
+// WithSegmentRotation makes the StreamingService append the ABCI messages of the blocks to segment files
+// instead of writing a file per message, starting a new segment once the current one has reached either
+// the provided number of blocks or the provided size in bytes. A zero value disables the respective limit
+func WithSegmentRotation(blocks, size int64) Option {
+	return func(fss *StreamingService) {
+		fss.segmentBlocks = blocks
+		fss.segmentSize = size
+	}
+}
+
+// WithRetention makes the StreamingService remove the oldest segments on rotation, so that at most
+// the provided number of segments are kept. It requires the segment rotation
+func WithRetention(maxSegments int) Option {
+	return func(fss *StreamingService) {
+		fss.maxSegments = maxSegments
+	}
 }
 
 // IntermediateWriter is used so that we do not need to update the underlying io.Writer
@@ -53,30 +86,55 @@ func (iw *IntermediateWriter) Write(b []byte) (int, error) {
 	return len(b), nil
 }
 
+// cacheWriter is an io.Writer which appends the written StoreKVPairs to the state cache of the StreamingService
+// It does so synchronously, so that the state changes are cached before the ABCI message which made them is listened
+type cacheWriter struct {
+	fss *StreamingService
+}
+
+// Write satisfies io.Writer
+func (cw cacheWriter) Write(b []byte) (int, error) {
+	cw.fss.stateCacheLock.Lock()
+	defer cw.fss.stateCacheLock.Unlock()
+
+	cw.fss.stateCache = append(cw.fss.stateCache, b)
+	return len(b), nil
+}
+
 // NewStreamingService creates a new StreamingService for the provided writeDir, (optional) filePrefix, and storeKeys
-func NewStreamingService(writeDir, filePrefix string, storeKeys []types.StoreKey, c codec.BinaryCodec) (*StreamingService, error) {
-	listenChan := make(chan []byte)
-	iw := NewIntermediateWriter(listenChan)
-	listener := types.NewStoreKVPairWriteListener(iw, c)
-	listeners := make(map[types.StoreKey][]types.WriteListener, len(storeKeys))
+func NewStreamingService(writeDir, filePrefix string, storeKeys []types.StoreKey, c codec.BinaryCodec, opts ...Option) (*StreamingService, error) {
+	fss := &StreamingService{
+		filePrefix:     filePrefix,
+		writeDir:       writeDir,
+		codec:          c,
+		stateCache:     make([][]byte, 0),
+		stateCacheLock: new(sync.Mutex),
+	}
+	for _, opt := range opts {
+		opt(fss)
+	}
+	if fss.segmentBlocks < 0 || fss.segmentSize < 0 {
+		return nil, fmt.Errorf("negative segment rotation: %d blocks, %d bytes", fss.segmentBlocks, fss.segmentSize)
+	}
+	if fss.maxSegments < 0 {
+		return nil, fmt.Errorf("negative max segments: %d", fss.maxSegments)
+	}
+	if fss.maxSegments > 0 && !fss.segmentsEnabled() {
+		return nil, errors.New("retention requires segment rotation")
+	}
+
+	listener := types.NewStoreKVPairWriteListener(cacheWriter{fss}, c)
+	fss.listeners = make(map[types.StoreKey][]types.WriteListener, len(storeKeys))
 	// in this case, we are using the same listener for each Store
 	for _, key := range storeKeys {
-		listeners[key] = append(listeners[key], listener)
+		fss.listeners[key] = append(fss.listeners[key], listener)
 	}
 	// check that the writeDir exists and is writeable so that we can catch the error here at initialization if it is not
 	// we don't open a dstFile until we receive our first ABCI message
 	if err := isDirWriteable(writeDir); err != nil {
 		return nil, err
 	}
-	return &StreamingService{
-		listeners:      listeners,
-		srcChan:        listenChan,
-		filePrefix:     filePrefix,
-		writeDir:       writeDir,
-		codec:          c,
-		stateCache:     make([][]byte, 0),
-		stateCacheLock: new(sync.Mutex),
-	}, nil
+	return fss, nil
 }
 
 // Listeners satisfies the baseapp.StreamingService interface
@@ -90,155 +148,109 @@ func (fss *StreamingService) Listeners() map[types.StoreKey][]types.WriteListene
 // It writes the received BeginBlock request and response and the resulting state changes
 // out to a file as described in the above the naming schema
 func (fss *StreamingService) ListenBeginBlock(ctx sdk.Context, req ocabci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
-	// generate the new file
-	dstFile, err := fss.openBeginBlockFile(req)
-	if err != nil {
-		return err
-	}
-	// write req to file
-	lengthPrefixedReqBytes, err := fss.codec.MarshalLengthPrefixed(&req)
-	if err != nil {
-		return err
-	}
-	if _, err = dstFile.Write(lengthPrefixedReqBytes); err != nil {
-		return err
-	}
-	// write all state changes cached for this stage to file
-	fss.stateCacheLock.Lock()
-	for _, stateChange := range fss.stateCache {
-		if _, err = dstFile.Write(stateChange); err != nil {
-			fss.stateCache = nil
-			fss.stateCacheLock.Unlock()
+	fss.currentBlockNumber = req.GetHeader().Height
+	fss.currentTxIndex = 0
+
+	// a segment holds whole blocks, so it is only rotated at the beginning of a block
+	if fss.segmentsEnabled() {
+		if err := fss.rotateSegment(); err != nil {
 			return err
 		}
 	}
-	// reset cache
-	fss.stateCache = nil
-	fss.stateCacheLock.Unlock()
-	// write res to file
-	lengthPrefixedResBytes, err := fss.codec.MarshalLengthPrefixed(&res)
-	if err != nil {
-		return err
-	}
-	if _, err = dstFile.Write(lengthPrefixedResBytes); err != nil {
-		return err
-	}
-	// close file
-	return dstFile.Close()
-}
 
-func (fss *StreamingService) openBeginBlockFile(req ocabci.RequestBeginBlock) (*os.File, error) {
-	fss.currentBlockNumber = req.GetHeader().Height
-	fss.currentTxIndex = 0
-	fileName := fmt.Sprintf("block-%d-begin", fss.currentBlockNumber)
-	if fss.filePrefix != "" {
-		fileName = fmt.Sprintf("%s-%s", fss.filePrefix, fileName)
-	}
-	return os.OpenFile(filepath.Join(fss.writeDir, fileName), os.O_CREATE|os.O_WRONLY, 0o600)
+	return fss.writeEntry(fmt.Sprintf("block-%d-begin", fss.currentBlockNumber), &req, &res)
 }
 
 // ListenDeliverTx satisfies the baseapp.ABCIListener interface
 // It writes the received DeliverTx request and response and the resulting state changes
 // out to a file as described in the above the naming schema
 func (fss *StreamingService) ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
-	// generate the new file
-	dstFile, err := fss.openDeliverTxFile()
-	if err != nil {
+	name := fmt.Sprintf("block-%d-tx-%d", fss.currentBlockNumber, fss.currentTxIndex)
+	fss.currentTxIndex++
+
+	return fss.writeEntry(name, &req, &res)
+}
+
+// ListenEndBlock satisfies the baseapp.ABCIListener interface
+// It writes the received EndBlock request and response and the resulting state changes
+// out to a file as described in the above the naming schema
+func (fss *StreamingService) ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	if err := fss.writeEntry(fmt.Sprintf("block-%d-end", fss.currentBlockNumber), &req, &res); err != nil {
 		return err
 	}
-	// write req to file
-	lengthPrefixedReqBytes, err := fss.codec.MarshalLengthPrefixed(&req)
-	if err != nil {
-		return err
+
+	// make the whole block available to the readers of the segment
+	if fss.segment != nil {
+		return fss.segment.Flush()
 	}
-	if _, err = dstFile.Write(lengthPrefixedReqBytes); err != nil {
+	return nil
+}
+
+// writeEntry writes the length-prefixed protobuf encoded request, the state changes cached for this stage,
+// and the length-prefixed protobuf encoded response out to a file of the provided name, or to the current
+// segment under the provided name
+func (fss *StreamingService) writeEntry(name string, req, res codec.ProtoMarshaler) error {
+	var body bytes.Buffer
+	// write req
+	lengthPrefixedReqBytes, err := fss.codec.MarshalLengthPrefixed(req)
+	if err != nil {
 		return err
 	}
-	// write all state changes cached for this stage to file
+	body.Write(lengthPrefixedReqBytes)
+	// write all state changes cached for this stage
 	fss.stateCacheLock.Lock()
 	for _, stateChange := range fss.stateCache {
-		if _, err = dstFile.Write(stateChange); err != nil {
-			fss.stateCache = nil
-			fss.stateCacheLock.Unlock()
-			return err
-		}
+		body.Write(stateChange)
 	}
 	// reset cache
 	fss.stateCache = nil
 	fss.stateCacheLock.Unlock()
-	// write res to file
-	lengthPrefixedResBytes, err := fss.codec.MarshalLengthPrefixed(&res)
+	// write res
+	lengthPrefixedResBytes, err := fss.codec.MarshalLengthPrefixed(res)
 	if err != nil {
 		return err
 	}
-	if _, err = dstFile.Write(lengthPrefixedResBytes); err != nil {
-		return err
-	}
-	// close file
-	return dstFile.Close()
-}
+	body.Write(lengthPrefixedResBytes)
 
-func (fss *StreamingService) openDeliverTxFile() (*os.File, error) {
-	fileName := fmt.Sprintf("block-%d-tx-%d", fss.currentBlockNumber, fss.currentTxIndex)
-	if fss.filePrefix != "" {
-		fileName = fmt.Sprintf("%s-%s", fss.filePrefix, fileName)
+	if fss.segment != nil {
+		return fss.segment.WriteEntry(name, body.Bytes())
 	}
-	fss.currentTxIndex++
-	return os.OpenFile(filepath.Join(fss.writeDir, fileName), os.O_CREATE|os.O_WRONLY, 0o600)
+	return fss.writeFile(fss.fileName(name), body.Bytes())
 }
 
-// ListenEndBlock satisfies the baseapp.ABCIListener interface
-// It writes the received EndBlock request and response and the resulting state changes
-// out to a file as described in the above the naming schema
-func (fss *StreamingService) ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
-	// generate the new file
-	dstFile, err := fss.openEndBlockFile()
+// writeFile writes the data out to a new file in the write directory, compressing it if configured
+func (fss *StreamingService) writeFile(fileName string, data []byte) error {
+	dstFile, err := os.OpenFile(filepath.Join(fss.writeDir, fileName), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
 	if err != nil {
 		return err
 	}
-	// write req to file
-	lengthPrefixedReqBytes, err := fss.codec.MarshalLengthPrefixed(&req)
+	cw, err := fss.compression.newWriter(dstFile)
 	if err != nil {
+		dstFile.Close()
 		return err
 	}
-	if _, err = dstFile.Write(lengthPrefixedReqBytes); err != nil {
-		return err
-	}
-	// write all state changes cached for this stage to file
-	fss.stateCacheLock.Lock()
-	for _, stateChange := range fss.stateCache {
-		if _, err = dstFile.Write(stateChange); err != nil {
-			fss.stateCache = nil
-			fss.stateCacheLock.Unlock()
-			return err
-		}
-	}
-	// reset cache
-	fss.stateCache = nil
-	fss.stateCacheLock.Unlock()
-	// write res to file
-	lengthPrefixedResBytes, err := fss.codec.MarshalLengthPrefixed(&res)
-	if err != nil {
+	if _, err = cw.Write(data); err != nil {
+		dstFile.Close()
 		return err
 	}
-	if _, err = dstFile.Write(lengthPrefixedResBytes); err != nil {
+	if err = cw.Close(); err != nil {
+		dstFile.Close()
 		return err
 	}
 	// close file
 	return dstFile.Close()
 }
 
-func (fss *StreamingService) openEndBlockFile() (*os.File, error) {
-	fileName := fmt.Sprintf("block-%d-end", fss.currentBlockNumber)
+// fileName returns the name of the file with the prefix and the extension of the compression
+func (fss *StreamingService) fileName(name string) string {
 	if fss.filePrefix != "" {
-		fileName = fmt.Sprintf("%s-%s", fss.filePrefix, fileName)
+		name = fmt.Sprintf("%s-%s", fss.filePrefix, name)
 	}
-	return os.OpenFile(filepath.Join(fss.writeDir, fileName), os.O_CREATE|os.O_WRONLY, 0o600)
+	return name + fss.compression.Extension()
 }
 
 // Stream satisfies the baseapp.StreamingService interface
-// It spins up a goroutine select loop which awaits length-prefixed binary encoded KV pairs
-// and caches them in the order they were received
+// It spins up a goroutine which awaits the closure of the service
 // returns an error if it is called twice
 func (fss *StreamingService) Stream(wg *sync.WaitGroup) error {
 	if fss.quitChan != nil {
@@ -248,17 +260,8 @@ func (fss *StreamingService) Stream(wg *sync.WaitGroup) error {
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		for {
-			select {
-			case <-fss.quitChan:
-				fss.quitChan = nil
-				return
-			case by := <-fss.srcChan:
-				fss.stateCacheLock.Lock()
-				fss.stateCache = append(fss.stateCache, by)
-				fss.stateCacheLock.Unlock()
-			}
-		}
+		<-fss.quitChan
+		fss.quitChan = nil
 	}()
 	return nil
 }
@@ -266,6 +269,11 @@ func (fss *StreamingService) Stream(wg *sync.WaitGroup) error {
 // Close satisfies the io.Closer interface, which satisfies the baseapp.StreamingService interface
 func (fss *StreamingService) Close() error {
 	close(fss.quitChan)
+	if fss.segment != nil {
+		err := fss.segment.Close()
+		fss.segment = nil
+		return err
+	}
 	return nil
 }
 
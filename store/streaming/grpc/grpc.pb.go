@@ -0,0 +1,1787 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lbm/store/streaming/v1/grpc.proto
+
+package grpc
+
+import (
+	context "context"
+	fmt "fmt"
+	types2 "github.com/Finschia/finschia-sdk/store/types"
+	types "github.com/Finschia/ostracon/abci/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	types1 "github.com/tendermint/tendermint/abci/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ListenRequest is a single message streamed to the consumer.
+type ListenRequest struct {
+	// block_height is the height of the block which the message belongs to.
+	BlockHeight int64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Types that are valid to be assigned to Sum:
+	//	*ListenRequest_BeginBlock
+	//	*ListenRequest_DeliverTx
+	//	*ListenRequest_EndBlock
+	Sum isListenRequest_Sum `protobuf_oneof:"sum"`
+}
+
+func (m *ListenRequest) Reset()         { *m = ListenRequest{} }
+func (m *ListenRequest) String() string { return proto.CompactTextString(m) }
+func (*ListenRequest) ProtoMessage()    {}
+func (*ListenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2444bffcfde2846, []int{0}
+}
+func (m *ListenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListenRequest.Merge(m, src)
+}
+func (m *ListenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListenRequest proto.InternalMessageInfo
+
+type isListenRequest_Sum interface {
+	isListenRequest_Sum()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type ListenRequest_BeginBlock struct {
+	BeginBlock *ListenBeginBlock `protobuf:"bytes,2,opt,name=begin_block,json=beginBlock,proto3,oneof" json:"begin_block,omitempty"`
+}
+type ListenRequest_DeliverTx struct {
+	DeliverTx *ListenDeliverTx `protobuf:"bytes,3,opt,name=deliver_tx,json=deliverTx,proto3,oneof" json:"deliver_tx,omitempty"`
+}
+type ListenRequest_EndBlock struct {
+	EndBlock *ListenEndBlock `protobuf:"bytes,4,opt,name=end_block,json=endBlock,proto3,oneof" json:"end_block,omitempty"`
+}
+
+func (*ListenRequest_BeginBlock) isListenRequest_Sum() {}
+func (*ListenRequest_DeliverTx) isListenRequest_Sum()  {}
+func (*ListenRequest_EndBlock) isListenRequest_Sum()   {}
+
+func (m *ListenRequest) GetSum() isListenRequest_Sum {
+	if m != nil {
+		return m.Sum
+	}
+	return nil
+}
+
+func (m *ListenRequest) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *ListenRequest) GetBeginBlock() *ListenBeginBlock {
+	if x, ok := m.GetSum().(*ListenRequest_BeginBlock); ok {
+		return x.BeginBlock
+	}
+	return nil
+}
+
+func (m *ListenRequest) GetDeliverTx() *ListenDeliverTx {
+	if x, ok := m.GetSum().(*ListenRequest_DeliverTx); ok {
+		return x.DeliverTx
+	}
+	return nil
+}
+
+func (m *ListenRequest) GetEndBlock() *ListenEndBlock {
+	if x, ok := m.GetSum().(*ListenRequest_EndBlock); ok {
+		return x.EndBlock
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ListenRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ListenRequest_BeginBlock)(nil),
+		(*ListenRequest_DeliverTx)(nil),
+		(*ListenRequest_EndBlock)(nil),
+	}
+}
+
+// ListenBeginBlock is the BeginBlock request and response, with the state changes it made.
+type ListenBeginBlock struct {
+	Request   types.RequestBeginBlock   `protobuf:"bytes,1,opt,name=request,proto3" json:"request"`
+	Response  types1.ResponseBeginBlock `protobuf:"bytes,2,opt,name=response,proto3" json:"response"`
+	ChangeSet []*types2.StoreKVPair     `protobuf:"bytes,3,rep,name=change_set,json=changeSet,proto3" json:"change_set,omitempty"`
+}
+
+func (m *ListenBeginBlock) Reset()         { *m = ListenBeginBlock{} }
+func (m *ListenBeginBlock) String() string { return proto.CompactTextString(m) }
+func (*ListenBeginBlock) ProtoMessage()    {}
+func (*ListenBeginBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2444bffcfde2846, []int{1}
+}
+func (m *ListenBeginBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListenBeginBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListenBeginBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListenBeginBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListenBeginBlock.Merge(m, src)
+}
+func (m *ListenBeginBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListenBeginBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListenBeginBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListenBeginBlock proto.InternalMessageInfo
+
+func (m *ListenBeginBlock) GetRequest() types.RequestBeginBlock {
+	if m != nil {
+		return m.Request
+	}
+	return types.RequestBeginBlock{}
+}
+
+func (m *ListenBeginBlock) GetResponse() types1.ResponseBeginBlock {
+	if m != nil {
+		return m.Response
+	}
+	return types1.ResponseBeginBlock{}
+}
+
+func (m *ListenBeginBlock) GetChangeSet() []*types2.StoreKVPair {
+	if m != nil {
+		return m.ChangeSet
+	}
+	return nil
+}
+
+// ListenDeliverTx is the DeliverTx request and response, with the state changes it made.
+type ListenDeliverTx struct {
+	// tx_index is the index of the tx in the block.
+	TxIndex   int64                    `protobuf:"varint,1,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	Request   types1.RequestDeliverTx  `protobuf:"bytes,2,opt,name=request,proto3" json:"request"`
+	Response  types1.ResponseDeliverTx `protobuf:"bytes,3,opt,name=response,proto3" json:"response"`
+	ChangeSet []*types2.StoreKVPair    `protobuf:"bytes,4,rep,name=change_set,json=changeSet,proto3" json:"change_set,omitempty"`
+}
+
+func (m *ListenDeliverTx) Reset()         { *m = ListenDeliverTx{} }
+func (m *ListenDeliverTx) String() string { return proto.CompactTextString(m) }
+func (*ListenDeliverTx) ProtoMessage()    {}
+func (*ListenDeliverTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2444bffcfde2846, []int{2}
+}
+func (m *ListenDeliverTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListenDeliverTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListenDeliverTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListenDeliverTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListenDeliverTx.Merge(m, src)
+}
+func (m *ListenDeliverTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListenDeliverTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListenDeliverTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListenDeliverTx proto.InternalMessageInfo
+
+func (m *ListenDeliverTx) GetTxIndex() int64 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+func (m *ListenDeliverTx) GetRequest() types1.RequestDeliverTx {
+	if m != nil {
+		return m.Request
+	}
+	return types1.RequestDeliverTx{}
+}
+
+func (m *ListenDeliverTx) GetResponse() types1.ResponseDeliverTx {
+	if m != nil {
+		return m.Response
+	}
+	return types1.ResponseDeliverTx{}
+}
+
+func (m *ListenDeliverTx) GetChangeSet() []*types2.StoreKVPair {
+	if m != nil {
+		return m.ChangeSet
+	}
+	return nil
+}
+
+// ListenEndBlock is the EndBlock request and response, with the state changes it made.
+// It is the last message of a block.
+type ListenEndBlock struct {
+	Request   types1.RequestEndBlock  `protobuf:"bytes,1,opt,name=request,proto3" json:"request"`
+	Response  types1.ResponseEndBlock `protobuf:"bytes,2,opt,name=response,proto3" json:"response"`
+	ChangeSet []*types2.StoreKVPair   `protobuf:"bytes,3,rep,name=change_set,json=changeSet,proto3" json:"change_set,omitempty"`
+}
+
+func (m *ListenEndBlock) Reset()         { *m = ListenEndBlock{} }
+func (m *ListenEndBlock) String() string { return proto.CompactTextString(m) }
+func (*ListenEndBlock) ProtoMessage()    {}
+func (*ListenEndBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2444bffcfde2846, []int{3}
+}
+func (m *ListenEndBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListenEndBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListenEndBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListenEndBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListenEndBlock.Merge(m, src)
+}
+func (m *ListenEndBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListenEndBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListenEndBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListenEndBlock proto.InternalMessageInfo
+
+func (m *ListenEndBlock) GetRequest() types1.RequestEndBlock {
+	if m != nil {
+		return m.Request
+	}
+	return types1.RequestEndBlock{}
+}
+
+func (m *ListenEndBlock) GetResponse() types1.ResponseEndBlock {
+	if m != nil {
+		return m.Response
+	}
+	return types1.ResponseEndBlock{}
+}
+
+func (m *ListenEndBlock) GetChangeSet() []*types2.StoreKVPair {
+	if m != nil {
+		return m.ChangeSet
+	}
+	return nil
+}
+
+// ListenResponse is the acknowledgement sent by the consumer.
+type ListenResponse struct {
+	// block_height is the height of the last block the consumer has processed.
+	BlockHeight int64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *ListenResponse) Reset()         { *m = ListenResponse{} }
+func (m *ListenResponse) String() string { return proto.CompactTextString(m) }
+func (*ListenResponse) ProtoMessage()    {}
+func (*ListenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2444bffcfde2846, []int{4}
+}
+func (m *ListenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListenResponse.Merge(m, src)
+}
+func (m *ListenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListenResponse proto.InternalMessageInfo
+
+func (m *ListenResponse) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ListenRequest)(nil), "lbm.store.streaming.v1.ListenRequest")
+	proto.RegisterType((*ListenBeginBlock)(nil), "lbm.store.streaming.v1.ListenBeginBlock")
+	proto.RegisterType((*ListenDeliverTx)(nil), "lbm.store.streaming.v1.ListenDeliverTx")
+	proto.RegisterType((*ListenEndBlock)(nil), "lbm.store.streaming.v1.ListenEndBlock")
+	proto.RegisterType((*ListenResponse)(nil), "lbm.store.streaming.v1.ListenResponse")
+}
+
+func init() { proto.RegisterFile("lbm/store/streaming/v1/grpc.proto", fileDescriptor_a2444bffcfde2846) }
+
+var fileDescriptor_a2444bffcfde2846 = []byte{
+	// 585 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcb, 0x6e, 0x13, 0x31,
+	0x14, 0x9d, 0x69, 0x4a, 0x1f, 0x0e, 0x2f, 0x19, 0x84, 0xd2, 0x22, 0x0d, 0x49, 0x10, 0x25, 0x2c,
+	0xf0, 0x90, 0x56, 0xec, 0xe9, 0xb4, 0x41, 0xad, 0x8a, 0x04, 0x9a, 0x22, 0x16, 0xb0, 0x88, 0xe6,
+	0x71, 0x99, 0x58, 0xcd, 0xd8, 0xc1, 0x76, 0xa3, 0xf0, 0x17, 0xec, 0xf8, 0xa5, 0x2e, 0xbb, 0x64,
+	0x55, 0xa1, 0xf4, 0x37, 0x58, 0xa0, 0xb1, 0x67, 0x52, 0x98, 0x3c, 0x40, 0x88, 0x9d, 0x7d, 0x7d,
+	0xee, 0x99, 0x73, 0xee, 0x19, 0x1b, 0x35, 0xfa, 0x61, 0xea, 0x4a, 0xc5, 0x05, 0xb8, 0x52, 0x09,
+	0x08, 0x52, 0xca, 0x12, 0x77, 0xd8, 0x76, 0x13, 0x31, 0x88, 0xc8, 0x40, 0x70, 0xc5, 0xf1, 0xbd,
+	0x7e, 0x98, 0x12, 0x0d, 0x21, 0x13, 0x08, 0x19, 0xb6, 0x37, 0xef, 0x26, 0x3c, 0xe1, 0x1a, 0xe2,
+	0x66, 0x2b, 0x83, 0xde, 0xdc, 0xe0, 0x52, 0x89, 0x20, 0xe2, 0xcc, 0x0d, 0xc2, 0x88, 0xba, 0xea,
+	0xf3, 0x00, 0x64, 0x7e, 0x74, 0x5f, 0x01, 0x8b, 0x41, 0xa4, 0x94, 0xa9, 0xe9, 0xc3, 0x27, 0x11,
+	0x97, 0x29, 0x97, 0x6e, 0x18, 0x48, 0xc8, 0x05, 0x0d, 0xdb, 0x21, 0xa8, 0xa0, 0xed, 0xf6, 0xa9,
+	0x54, 0xc0, 0xb2, 0xaf, 0x6a, 0x68, 0xf3, 0xeb, 0x12, 0xba, 0xf1, 0x4a, 0xd7, 0x7c, 0xf8, 0x74,
+	0x0a, 0x52, 0xe1, 0x06, 0xba, 0x1e, 0xf6, 0x79, 0x74, 0xd2, 0xed, 0x01, 0x4d, 0x7a, 0xaa, 0x66,
+	0xd7, 0xed, 0x56, 0xc5, 0xaf, 0xea, 0xda, 0x81, 0x2e, 0xe1, 0x23, 0x54, 0x0d, 0x21, 0xa1, 0xac,
+	0xab, 0x8b, 0xb5, 0xa5, 0xba, 0xdd, 0xaa, 0x6e, 0xb7, 0xc8, 0x6c, 0x6f, 0xc4, 0xd0, 0x7b, 0x59,
+	0x83, 0xa7, 0x49, 0x2c, 0x1f, 0x85, 0x93, 0x1d, 0x3e, 0x40, 0x28, 0x86, 0x3e, 0x1d, 0x82, 0xe8,
+	0xaa, 0x51, 0xad, 0xa2, 0xb9, 0x1e, 0x2f, 0xe6, 0xda, 0x37, 0xf8, 0xb7, 0xa3, 0x03, 0xcb, 0x5f,
+	0x8f, 0x8b, 0x0d, 0xee, 0xa0, 0x75, 0x60, 0x71, 0x2e, 0x6a, 0x59, 0x13, 0x6d, 0x2d, 0x26, 0xea,
+	0xb0, 0xb8, 0x90, 0xb4, 0x06, 0xf9, 0xda, 0xbb, 0x86, 0x2a, 0xf2, 0x34, 0x6d, 0x8e, 0x6d, 0x74,
+	0xbb, 0x2c, 0x1d, 0xbf, 0x40, 0xab, 0xc2, 0xcc, 0x49, 0xcf, 0xa5, 0xba, 0x5d, 0x27, 0x45, 0x46,
+	0x24, 0x8b, 0x81, 0xe4, 0x53, 0xbc, 0x6a, 0xf1, 0x96, 0xcf, 0x2e, 0x1e, 0x58, 0x7e, 0xd1, 0x86,
+	0x3b, 0x68, 0x4d, 0x80, 0x1c, 0x70, 0x26, 0x21, 0x1f, 0xdc, 0x43, 0x72, 0x95, 0x65, 0x41, 0x62,
+	0x00, 0x53, 0x2c, 0x93, 0x56, 0xdc, 0x41, 0x28, 0xea, 0x05, 0x2c, 0x81, 0xae, 0x04, 0x55, 0xab,
+	0xd4, 0x2b, 0xda, 0xac, 0xc9, 0x9d, 0x64, 0xb9, 0xe7, 0xa6, 0xf3, 0xdc, 0xc9, 0x71, 0xb6, 0x3b,
+	0x7a, 0xf7, 0x26, 0xa0, 0xc2, 0x5f, 0x37, 0x9d, 0xc7, 0xa0, 0x9a, 0x3f, 0x6c, 0x74, 0xab, 0x34,
+	0x53, 0xbc, 0x81, 0xd6, 0xd4, 0xa8, 0x4b, 0x59, 0x0c, 0xa3, 0x3c, 0xfc, 0x55, 0x35, 0x3a, 0xcc,
+	0xb6, 0x78, 0xf7, 0xca, 0xbe, 0xd1, 0xde, 0x98, 0xa1, 0x5d, 0x9f, 0x4f, 0xe8, 0xca, 0xfe, 0xf7,
+	0x7f, 0xf1, 0x6f, 0xc2, 0x6e, 0xce, 0xf5, 0x5f, 0x26, 0x99, 0x67, 0x7f, 0xf9, 0x5f, 0xed, 0x5f,
+	0xd8, 0xe8, 0xe6, 0xef, 0x7f, 0xc2, 0xac, 0x84, 0xe7, 0x58, 0x2c, 0x5a, 0xca, 0x0e, 0xf7, 0xa6,
+	0x12, 0x6e, 0xcc, 0x75, 0x58, 0xe2, 0xf8, 0xef, 0xf9, 0xee, 0x14, 0xfe, 0x8a, 0x0f, 0xfe, 0xc5,
+	0xf5, 0xde, 0x16, 0xe8, 0xce, 0xae, 0xb7, 0x77, 0x68, 0x1a, 0x41, 0x1c, 0x83, 0x18, 0xd2, 0x08,
+	0xf0, 0x07, 0xb4, 0x62, 0x4a, 0xf8, 0xd1, 0xe2, 0x5b, 0x95, 0xcf, 0x67, 0x73, 0xeb, 0x4f, 0x30,
+	0x23, 0xa9, 0x65, 0x3f, 0xb3, 0xbd, 0xd7, 0x67, 0x63, 0xc7, 0x3e, 0x1f, 0x3b, 0xf6, 0xf7, 0xb1,
+	0x63, 0x7f, 0xb9, 0x74, 0xac, 0xf3, 0x4b, 0xc7, 0xfa, 0x76, 0xe9, 0x58, 0xef, 0x9f, 0x27, 0x54,
+	0xf5, 0x4e, 0x43, 0x12, 0xf1, 0xd4, 0x7d, 0x49, 0x99, 0x8c, 0x7a, 0x34, 0x70, 0x3f, 0xe6, 0x8b,
+	0xa7, 0x32, 0x3e, 0x99, 0x7a, 0x72, 0xb3, 0xf7, 0x36, 0x5c, 0xd1, 0xef, 0xdb, 0xce, 0xcf, 0x01,
+	0x00, 0x84, 0x43, 0x08, 0xb9, 0x95, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ABCIListenerServiceClient is the client API for ABCIListenerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ABCIListenerServiceClient interface {
+	// Listen streams the ABCI messages of each block and the resulting state changes
+	// to the consumer, which acknowledges the blocks it has processed.
+	Listen(ctx context.Context, opts ...grpc.CallOption) (ABCIListenerService_ListenClient, error)
+}
+
+type aBCIListenerServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewABCIListenerServiceClient(cc grpc1.ClientConn) ABCIListenerServiceClient {
+	return &aBCIListenerServiceClient{cc}
+}
+
+func (c *aBCIListenerServiceClient) Listen(ctx context.Context, opts ...grpc.CallOption) (ABCIListenerService_ListenClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ABCIListenerService_serviceDesc.Streams[0], "/lbm.store.streaming.v1.ABCIListenerService/Listen", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aBCIListenerServiceListenClient{stream}
+	return x, nil
+}
+
+type ABCIListenerService_ListenClient interface {
+	Send(*ListenRequest) error
+	Recv() (*ListenResponse, error)
+	grpc.ClientStream
+}
+
+type aBCIListenerServiceListenClient struct {
+	grpc.ClientStream
+}
+
+func (x *aBCIListenerServiceListenClient) Send(m *ListenRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *aBCIListenerServiceListenClient) Recv() (*ListenResponse, error) {
+	m := new(ListenResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ABCIListenerServiceServer is the server API for ABCIListenerService service.
+type ABCIListenerServiceServer interface {
+	// Listen streams the ABCI messages of each block and the resulting state changes
+	// to the consumer, which acknowledges the blocks it has processed.
+	Listen(ABCIListenerService_ListenServer) error
+}
+
+// UnimplementedABCIListenerServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedABCIListenerServiceServer struct {
+}
+
+func (*UnimplementedABCIListenerServiceServer) Listen(srv ABCIListenerService_ListenServer) error {
+	return status.Errorf(codes.Unimplemented, "method Listen not implemented")
+}
+
+func RegisterABCIListenerServiceServer(s grpc1.Server, srv ABCIListenerServiceServer) {
+	s.RegisterService(&_ABCIListenerService_serviceDesc, srv)
+}
+
+func _ABCIListenerService_Listen_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ABCIListenerServiceServer).Listen(&aBCIListenerServiceListenServer{stream})
+}
+
+type ABCIListenerService_ListenServer interface {
+	Send(*ListenResponse) error
+	Recv() (*ListenRequest, error)
+	grpc.ServerStream
+}
+
+type aBCIListenerServiceListenServer struct {
+	grpc.ServerStream
+}
+
+func (x *aBCIListenerServiceListenServer) Send(m *ListenResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *aBCIListenerServiceListenServer) Recv() (*ListenRequest, error) {
+	m := new(ListenRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+var _ABCIListenerService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lbm.store.streaming.v1.ABCIListenerService",
+	HandlerType: (*ABCIListenerServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Listen",
+			Handler:       _ABCIListenerService_Listen_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "lbm/store/streaming/v1/grpc.proto",
+}
+
+func (m *ListenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sum != nil {
+		{
+			size := m.Sum.Size()
+			i -= size
+			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintGrpc(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListenRequest_BeginBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListenRequest_BeginBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BeginBlock != nil {
+		{
+			size, err := m.BeginBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ListenRequest_DeliverTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListenRequest_DeliverTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DeliverTx != nil {
+		{
+			size, err := m.DeliverTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ListenRequest_EndBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListenRequest_EndBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.EndBlock != nil {
+		{
+			size, err := m.EndBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ListenBeginBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListenBeginBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListenBeginBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChangeSet) > 0 {
+		for iNdEx := len(m.ChangeSet) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChangeSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGrpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGrpc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGrpc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ListenDeliverTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListenDeliverTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListenDeliverTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChangeSet) > 0 {
+		for iNdEx := len(m.ChangeSet) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChangeSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGrpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGrpc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGrpc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.TxIndex != 0 {
+		i = encodeVarintGrpc(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListenEndBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListenEndBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListenEndBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChangeSet) > 0 {
+		for iNdEx := len(m.ChangeSet) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChangeSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGrpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGrpc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGrpc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ListenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintGrpc(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGrpc(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGrpc(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ListenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovGrpc(uint64(m.BlockHeight))
+	}
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *ListenRequest_BeginBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BeginBlock != nil {
+		l = m.BeginBlock.Size()
+		n += 1 + l + sovGrpc(uint64(l))
+	}
+	return n
+}
+func (m *ListenRequest_DeliverTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DeliverTx != nil {
+		l = m.DeliverTx.Size()
+		n += 1 + l + sovGrpc(uint64(l))
+	}
+	return n
+}
+func (m *ListenRequest_EndBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EndBlock != nil {
+		l = m.EndBlock.Size()
+		n += 1 + l + sovGrpc(uint64(l))
+	}
+	return n
+}
+func (m *ListenBeginBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Request.Size()
+	n += 1 + l + sovGrpc(uint64(l))
+	l = m.Response.Size()
+	n += 1 + l + sovGrpc(uint64(l))
+	if len(m.ChangeSet) > 0 {
+		for _, e := range m.ChangeSet {
+			l = e.Size()
+			n += 1 + l + sovGrpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListenDeliverTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxIndex != 0 {
+		n += 1 + sovGrpc(uint64(m.TxIndex))
+	}
+	l = m.Request.Size()
+	n += 1 + l + sovGrpc(uint64(l))
+	l = m.Response.Size()
+	n += 1 + l + sovGrpc(uint64(l))
+	if len(m.ChangeSet) > 0 {
+		for _, e := range m.ChangeSet {
+			l = e.Size()
+			n += 1 + l + sovGrpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListenEndBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Request.Size()
+	n += 1 + l + sovGrpc(uint64(l))
+	l = m.Response.Size()
+	n += 1 + l + sovGrpc(uint64(l))
+	if len(m.ChangeSet) > 0 {
+		for _, e := range m.ChangeSet {
+			l = e.Size()
+			n += 1 + l + sovGrpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovGrpc(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func sovGrpc(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGrpc(x uint64) (n int) {
+	return sovGrpc(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ListenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ListenBeginBlock{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ListenRequest_BeginBlock{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliverTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ListenDeliverTx{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ListenRequest_DeliverTx{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ListenEndBlock{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ListenRequest_EndBlock{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListenBeginBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListenBeginBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListenBeginBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangeSet = append(m.ChangeSet, &types2.StoreKVPair{})
+			if err := m.ChangeSet[len(m.ChangeSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListenDeliverTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListenDeliverTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListenDeliverTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangeSet = append(m.ChangeSet, &types2.StoreKVPair{})
+			if err := m.ChangeSet[len(m.ChangeSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListenEndBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListenEndBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListenEndBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangeSet = append(m.ChangeSet, &types2.StoreKVPair{})
+			if err := m.ChangeSet[len(m.ChangeSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGrpc(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGrpc
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGrpc
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGrpc
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGrpc
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGrpc        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGrpc          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGrpc = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,394 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
+
+	ocabci "github.com/Finschia/ostracon/abci/types"
+
+	"github.com/Finschia/finschia-sdk/baseapp"
+	"github.com/Finschia/finschia-sdk/store/types"
+	sdk "github.com/Finschia/finschia-sdk/types"
+)
+
+var (
+	_ baseapp.StreamingService  = &StreamingService{}
+	_ baseapp.PreCommitListener = &StreamingService{}
+)
+
+// retryInterval is the interval between the attempts to deliver a message to the consumer
+const retryInterval = time.Second
+
+// BackPressure specifies what to do when the consumer cannot keep up with the node
+type BackPressure int
+
+const (
+	UnknownBackPressure BackPressure = iota
+	// Block blocks the node until the buffer has room for the message
+	Block
+	// Drop drops the message and keeps going
+	Drop
+	// Halt drops the message and refuses to commit the block
+	Halt
+)
+
+// BackPressureFromString returns the BackPressure corresponding to the provided name
+func BackPressureFromString(name string) BackPressure {
+	switch strings.ToLower(name) {
+	case "block":
+		return Block
+	case "drop":
+		return Drop
+	case "halt":
+		return Halt
+	default:
+		return UnknownBackPressure
+	}
+}
+
+// String returns the string name of a BackPressure
+func (bp BackPressure) String() string {
+	switch bp {
+	case Block:
+		return "block"
+	case Drop:
+		return "drop"
+	case Halt:
+		return "halt"
+	default:
+		return "unknown"
+	}
+}
+
+// Config is the configuration of the StreamingService
+type Config struct {
+	BackPressure BackPressure  // what to do when the buffer is full
+	BufferSize   int           // number of the messages buffered for the consumer
+	AckTimeout   time.Duration // how long to wait for the acknowledgement of a block before committing it, zero disables it
+}
+
+// DefaultConfig returns the default configuration of the StreamingService
+func DefaultConfig() Config {
+	return Config{
+		BackPressure: Block,
+		BufferSize:   1000,
+	}
+}
+
+// ValidateBasic performs basic validation of the configuration
+func (c Config) ValidateBasic() error {
+	if c.BackPressure == UnknownBackPressure {
+		return errors.New("unknown back pressure")
+	}
+	if c.BufferSize < 0 {
+		return fmt.Errorf("negative buffer size: %d", c.BufferSize)
+	}
+	if c.AckTimeout < 0 {
+		return fmt.Errorf("negative ack timeout: %s", c.AckTimeout)
+	}
+	return nil
+}
+
+// StreamingService is a concrete implementation of StreamingService that pushes the ABCI messages and
+// the resulting state changes to an out-of-process consumer, which implements ABCIListenerService
+type StreamingService struct {
+	listeners          map[types.StoreKey][]types.WriteListener // the listeners that will be initialized with BaseApp
+	conn               *grpc.ClientConn                         // connection to the consumer
+	client             ABCIListenerServiceClient                // client of the consumer
+	config             Config                                   // configuration of the service
+	stateCache         []*types.StoreKVPair                     // cache the StoreKVPairs in the order they are received
+	stateCacheLock     *sync.Mutex                              // mutex for the state cache
+	currentBlockNumber int64                                    // the current block number
+	currentTxIndex     int64                                    // the index of the current tx
+	outChan            chan *ListenRequest                      // buffer of the messages to the consumer
+	ackLock            *sync.Mutex                              // mutex for the acknowledgement states below
+	ackedHeight        int64                                    // the last block height acknowledged by the consumer
+	ackChan            chan struct{}                            // closed on every acknowledgement
+	lastErr            error                                    // the last error of the delivery to the consumer
+	haltErr            error                                    // the error which makes the node refuse to commit
+	started            bool                                     // whether Stream has been called
+	quitChan           chan struct{}                            // channel to synchronize closure
+	cancel             context.CancelFunc                       // cancels the stream to the consumer
+}
+
+// NewStreamingService creates a new StreamingService which streams the provided storeKeys to the consumer
+// listening on the target
+func NewStreamingService(target string, storeKeys []types.StoreKey, config Config, opts ...grpc.DialOption) (*StreamingService, error) {
+	if err := config.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	// the connection is established lazily, so the consumer may come up after the node
+	conn, err := grpc.Dial(target, append([]grpc.DialOption{grpc.WithInsecure()}, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	ss := &StreamingService{
+		conn:           conn,
+		client:         NewABCIListenerServiceClient(conn),
+		config:         config,
+		stateCacheLock: new(sync.Mutex),
+		outChan:        make(chan *ListenRequest, config.BufferSize),
+		ackLock:        new(sync.Mutex),
+		ackChan:        make(chan struct{}),
+		quitChan:       make(chan struct{}),
+	}
+
+	listener := &storeKVPairCacheListener{ss}
+	ss.listeners = make(map[types.StoreKey][]types.WriteListener, len(storeKeys))
+	// in this case, we are using the same listener for each Store
+	for _, key := range storeKeys {
+		ss.listeners[key] = append(ss.listeners[key], listener)
+	}
+
+	return ss, nil
+}
+
+// storeKVPairCacheListener is a WriteListener which caches the state changes into the StreamingService
+type storeKVPairCacheListener struct {
+	ss *StreamingService
+}
+
+// OnWrite satisfies the WriteListener interface
+func (wl *storeKVPairCacheListener) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	wl.ss.stateCacheLock.Lock()
+	defer wl.ss.stateCacheLock.Unlock()
+
+	wl.ss.stateCache = append(wl.ss.stateCache, &types.StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Delete:   delete,
+		Key:      key,
+		Value:    value,
+	})
+	return nil
+}
+
+// Listeners satisfies the baseapp.StreamingService interface
+// It returns the StreamingService's underlying WriteListeners
+// Use for registering the underlying WriteListeners with the BaseApp
+func (gss *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	return gss.listeners
+}
+
+// ListenBeginBlock satisfies the baseapp.ABCIListener interface
+// It pushes the received BeginBlock request and response and the resulting state changes to the consumer
+func (gss *StreamingService) ListenBeginBlock(ctx sdk.Context, req ocabci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	gss.currentBlockNumber = req.GetHeader().Height
+	gss.currentTxIndex = 0
+
+	return gss.enqueue(&ListenRequest{
+		BlockHeight: gss.currentBlockNumber,
+		Sum: &ListenRequest_BeginBlock{
+			BeginBlock: &ListenBeginBlock{
+				Request:   req,
+				Response:  res,
+				ChangeSet: gss.flushStateCache(),
+			},
+		},
+	})
+}
+
+// ListenDeliverTx satisfies the baseapp.ABCIListener interface
+// It pushes the received DeliverTx request and response and the resulting state changes to the consumer
+func (gss *StreamingService) ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	txIndex := gss.currentTxIndex
+	gss.currentTxIndex++
+
+	return gss.enqueue(&ListenRequest{
+		BlockHeight: gss.currentBlockNumber,
+		Sum: &ListenRequest_DeliverTx{
+			DeliverTx: &ListenDeliverTx{
+				TxIndex:   txIndex,
+				Request:   req,
+				Response:  res,
+				ChangeSet: gss.flushStateCache(),
+			},
+		},
+	})
+}
+
+// ListenEndBlock satisfies the baseapp.ABCIListener interface
+// It pushes the received EndBlock request and response and the resulting state changes to the consumer
+func (gss *StreamingService) ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	return gss.enqueue(&ListenRequest{
+		BlockHeight: gss.currentBlockNumber,
+		Sum: &ListenRequest_EndBlock{
+			EndBlock: &ListenEndBlock{
+				Request:   req,
+				Response:  res,
+				ChangeSet: gss.flushStateCache(),
+			},
+		},
+	})
+}
+
+// ListenPreCommit satisfies the baseapp.PreCommitListener interface
+// It refuses to commit the block if a message has been dropped under the halt back pressure, or
+// if the consumer does not acknowledge the block within the ack timeout
+func (gss *StreamingService) ListenPreCommit(ctx sdk.Context) error {
+	height := ctx.BlockHeight()
+
+	timeout := time.NewTimer(gss.config.AckTimeout)
+	defer timeout.Stop()
+
+	for {
+		gss.ackLock.Lock()
+		haltErr, lastErr := gss.haltErr, gss.lastErr
+		acked := gss.ackedHeight >= height
+		ackChan := gss.ackChan
+		gss.ackLock.Unlock()
+
+		if haltErr != nil {
+			return haltErr
+		}
+		if gss.config.AckTimeout == 0 || acked {
+			return nil
+		}
+
+		select {
+		case <-ackChan:
+		case <-timeout.C:
+			err := fmt.Errorf("block %d not acknowledged within %s", height, gss.config.AckTimeout)
+			if lastErr != nil {
+				err = fmt.Errorf("%w: %s", err, lastErr)
+			}
+			return err
+		case <-gss.quitChan:
+			return errors.New("streaming service closed")
+		}
+	}
+}
+
+func (gss *StreamingService) flushStateCache() []*types.StoreKVPair {
+	gss.stateCacheLock.Lock()
+	defer gss.stateCacheLock.Unlock()
+
+	changeSet := gss.stateCache
+	gss.stateCache = nil
+	return changeSet
+}
+
+// enqueue puts the message into the buffer, applying the back pressure if it is full
+func (gss *StreamingService) enqueue(req *ListenRequest) error {
+	if gss.config.BackPressure == Block {
+		select {
+		case gss.outChan <- req:
+			return nil
+		case <-gss.quitChan:
+			return errors.New("streaming service closed")
+		}
+	}
+
+	select {
+	case gss.outChan <- req:
+		return nil
+	default:
+	}
+
+	err := fmt.Errorf("buffer full, dropped a message of block %d", req.BlockHeight)
+	if gss.config.BackPressure == Halt {
+		gss.ackLock.Lock()
+		if gss.haltErr == nil {
+			gss.haltErr = err
+		}
+		gss.ackLock.Unlock()
+	}
+	return err
+}
+
+// Stream satisfies the baseapp.StreamingService interface
+// It spins up a goroutine which delivers the buffered messages to the consumer, re-establishing
+// the stream on failure, and another one per stream which receives the acknowledgements
+// returns an error if it is called twice
+func (gss *StreamingService) Stream(wg *sync.WaitGroup) error {
+	if gss.started {
+		return errors.New("`Stream` has already been called. The stream needs to be closed before it can be started again")
+	}
+	gss.started = true
+
+	ctx, cancel := context.WithCancel(context.Background())
+	gss.cancel = cancel
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		var stream ABCIListenerService_ListenClient
+		for {
+			select {
+			case <-gss.quitChan:
+				return
+			case req := <-gss.outChan:
+				for {
+					var err error
+					if stream == nil {
+						if stream, err = gss.client.Listen(ctx); err == nil {
+							wg.Add(1)
+							go func(stream ABCIListenerService_ListenClient) {
+								defer wg.Done()
+								gss.receive(stream)
+							}(stream)
+						}
+					}
+					if err == nil {
+						if err = stream.Send(req); err == nil {
+							break
+						}
+						stream = nil
+					}
+					gss.setLastErr(err)
+
+					select {
+					case <-gss.quitChan:
+						return
+					case <-time.After(retryInterval):
+					}
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// receive records the acknowledgements from the consumer until the stream breaks
+func (gss *StreamingService) receive(stream ABCIListenerService_ListenClient) {
+	for {
+		res, err := stream.Recv()
+		if err != nil {
+			gss.setLastErr(err)
+			return
+		}
+
+		gss.ackLock.Lock()
+		if res.BlockHeight > gss.ackedHeight {
+			gss.ackedHeight = res.BlockHeight
+			close(gss.ackChan)
+			gss.ackChan = make(chan struct{})
+		}
+		gss.ackLock.Unlock()
+	}
+}
+
+func (gss *StreamingService) setLastErr(err error) {
+	gss.ackLock.Lock()
+	defer gss.ackLock.Unlock()
+
+	gss.lastErr = err
+}
+
+// Close satisfies the io.Closer interface, which satisfies the baseapp.StreamingService interface
+func (gss *StreamingService) Close() error {
+	close(gss.quitChan)
+	if gss.cancel != nil {
+		gss.cancel()
+	}
+	return gss.conn.Close()
+}
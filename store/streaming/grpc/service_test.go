@@ -0,0 +1,263 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+
+	ocabci "github.com/Finschia/ostracon/abci/types"
+
+	"github.com/Finschia/finschia-sdk/store/types"
+	sdk "github.com/Finschia/finschia-sdk/types"
+)
+
+var (
+	// test abci message types
+	testBeginBlockReq = ocabci.RequestBeginBlock{
+		Header: tmproto.Header{
+			Height: 1,
+		},
+	}
+	testBeginBlockRes = abci.ResponseBeginBlock{
+		Events: []abci.Event{{Type: "testEventType1"}},
+	}
+	testDeliverTxReq = abci.RequestDeliverTx{
+		Tx: []byte{9, 8, 7, 6, 5, 4, 3, 2, 1},
+	}
+	testDeliverTxRes = abci.ResponseDeliverTx{
+		Code: 1,
+		Log:  "mockLog",
+	}
+	testEndBlockReq = abci.RequestEndBlock{
+		Height: 1,
+	}
+	testEndBlockRes = abci.ResponseEndBlock{
+		Events: []abci.Event{{Type: "testEventType2"}},
+	}
+
+	// mock store keys
+	mockStoreKey1 = sdk.NewKVStoreKey("mockStore1")
+	mockStoreKey2 = sdk.NewKVStoreKey("mockStore2")
+
+	// mock state changes
+	mockKey1   = []byte{1, 2, 3}
+	mockValue1 = []byte{3, 2, 1}
+	mockKey2   = []byte{2, 3, 4}
+	mockValue2 = []byte{4, 3, 2}
+)
+
+// mockConsumer records the received messages, and acknowledges the blocks on their EndBlock
+type mockConsumer struct {
+	ack      bool
+	received chan *ListenRequest
+}
+
+func (c *mockConsumer) Listen(stream ABCIListenerService_ListenServer) error {
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		c.received <- req
+
+		if _, ok := req.Sum.(*ListenRequest_EndBlock); ok && c.ack {
+			if err := stream.Send(&ListenResponse{BlockHeight: req.BlockHeight}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func setupConsumer(t *testing.T, ack bool) (*mockConsumer, grpc.DialOption) {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	consumer := &mockConsumer{
+		ack:      ack,
+		received: make(chan *ListenRequest, 10),
+	}
+	RegisterABCIListenerServiceServer(server, consumer)
+
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	dialer := func(context.Context, string) (net.Conn, error) { return listener.Dial() }
+	return consumer, grpc.WithContextDialer(dialer)
+}
+
+func newContext(height int64) sdk.Context {
+	return sdk.Context{}.WithBlockHeight(height)
+}
+
+func TestBackPressureFromString(t *testing.T) {
+	for _, bp := range []BackPressure{Block, Drop, Halt} {
+		require.Equal(t, bp, BackPressureFromString(bp.String()))
+	}
+	require.Equal(t, UnknownBackPressure, BackPressureFromString("unexpectedName"))
+}
+
+func TestNewStreamingService(t *testing.T) {
+	testCases := map[string]struct {
+		config Config
+		valid  bool
+	}{
+		"valid config": {
+			config: DefaultConfig(),
+			valid:  true,
+		},
+		"unknown back pressure": {
+			config: Config{BufferSize: 1},
+		},
+		"negative buffer size": {
+			config: Config{BackPressure: Block, BufferSize: -1},
+		},
+		"negative ack timeout": {
+			config: Config{BackPressure: Block, AckTimeout: -time.Second},
+		},
+	}
+
+	testKeys := []types.StoreKey{mockStoreKey1, mockStoreKey2}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ss, err := NewStreamingService("localhost:0", testKeys, tc.config)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			defer ss.Close()
+
+			listeners := ss.Listeners()
+			for _, key := range testKeys {
+				require.Len(t, listeners[key], 1)
+			}
+		})
+	}
+}
+
+func TestGRPCStreamingService(t *testing.T) {
+	consumer, dialOpt := setupConsumer(t, true)
+
+	config := DefaultConfig()
+	config.AckTimeout = 10 * time.Second
+	testKeys := []types.StoreKey{mockStoreKey1, mockStoreKey2}
+	ss, err := NewStreamingService("bufnet", testKeys, config, dialOpt)
+	require.NoError(t, err)
+
+	wg := new(sync.WaitGroup)
+	require.NoError(t, ss.Stream(wg))
+	require.Error(t, ss.Stream(wg))
+
+	listener1 := ss.Listeners()[mockStoreKey1][0]
+	listener2 := ss.Listeners()[mockStoreKey2][0]
+
+	// begin block
+	require.NoError(t, listener1.OnWrite(mockStoreKey1, mockKey1, mockValue1, false))
+	require.NoError(t, ss.ListenBeginBlock(newContext(1), testBeginBlockReq, testBeginBlockRes))
+
+	req := <-consumer.received
+	require.Equal(t, int64(1), req.BlockHeight)
+	beginBlock := req.GetBeginBlock()
+	require.NotNil(t, beginBlock)
+	require.Equal(t, testBeginBlockReq, beginBlock.Request)
+	require.Equal(t, testBeginBlockRes, beginBlock.Response)
+	require.Equal(t, []*types.StoreKVPair{
+		{StoreKey: mockStoreKey1.Name(), Key: mockKey1, Value: mockValue1},
+	}, beginBlock.ChangeSet)
+
+	// deliver tx
+	require.NoError(t, listener2.OnWrite(mockStoreKey2, mockKey2, nil, true))
+	require.NoError(t, ss.ListenDeliverTx(newContext(1), testDeliverTxReq, testDeliverTxRes))
+
+	req = <-consumer.received
+	deliverTx := req.GetDeliverTx()
+	require.NotNil(t, deliverTx)
+	require.Equal(t, int64(0), deliverTx.TxIndex)
+	require.Equal(t, testDeliverTxReq, deliverTx.Request)
+	require.Equal(t, testDeliverTxRes, deliverTx.Response)
+	require.Equal(t, []*types.StoreKVPair{
+		{StoreKey: mockStoreKey2.Name(), Delete: true, Key: mockKey2},
+	}, deliverTx.ChangeSet)
+
+	// end block, which the consumer acknowledges
+	require.NoError(t, listener1.OnWrite(mockStoreKey1, mockKey2, mockValue2, false))
+	require.NoError(t, ss.ListenEndBlock(newContext(1), testEndBlockReq, testEndBlockRes))
+
+	req = <-consumer.received
+	endBlock := req.GetEndBlock()
+	require.NotNil(t, endBlock)
+	require.Equal(t, testEndBlockReq, endBlock.Request)
+	require.Equal(t, testEndBlockRes, endBlock.Response)
+	require.Len(t, endBlock.ChangeSet, 1)
+
+	require.NoError(t, ss.ListenPreCommit(newContext(1)))
+
+	require.NoError(t, ss.Close())
+	wg.Wait()
+}
+
+func TestGRPCStreamingServiceUnacknowledged(t *testing.T) {
+	consumer, dialOpt := setupConsumer(t, false)
+
+	config := DefaultConfig()
+	config.AckTimeout = 100 * time.Millisecond
+	ss, err := NewStreamingService("bufnet", []types.StoreKey{mockStoreKey1}, config, dialOpt)
+	require.NoError(t, err)
+
+	wg := new(sync.WaitGroup)
+	require.NoError(t, ss.Stream(wg))
+
+	require.NoError(t, ss.ListenEndBlock(newContext(1), testEndBlockReq, testEndBlockRes))
+	<-consumer.received
+
+	// the consumer never acknowledges the block
+	require.Error(t, ss.ListenPreCommit(newContext(1)))
+
+	require.NoError(t, ss.Close())
+	wg.Wait()
+}
+
+func TestGRPCStreamingServiceBackPressure(t *testing.T) {
+	testCases := map[string]struct {
+		backPressure BackPressure
+		halt         bool
+	}{
+		"drop": {
+			backPressure: Drop,
+		},
+		"halt": {
+			backPressure: Halt,
+			halt:         true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			config := Config{
+				BackPressure: tc.backPressure,
+				BufferSize:   1,
+			}
+			// the service is not streaming, so the buffer is never drained
+			ss, err := NewStreamingService("localhost:0", []types.StoreKey{mockStoreKey1}, config)
+			require.NoError(t, err)
+			defer ss.Close()
+
+			require.NoError(t, ss.ListenBeginBlock(newContext(1), testBeginBlockReq, testBeginBlockRes))
+			require.Error(t, ss.ListenEndBlock(newContext(1), testEndBlockReq, testEndBlockRes))
+
+			err = ss.ListenPreCommit(newContext(1))
+			if tc.halt {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
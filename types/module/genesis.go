@@ -0,0 +1,87 @@
+package module
+
+import (
+	"encoding/json"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/Finschia/finschia-sdk/codec"
+)
+
+// The genesis state of a module extending another module (e.g. bankplus) may share
+// the json object of the genesis state of the extended module, so the existing
+// genesis keeps valid. The fields of the extension are omitted if they are empty,
+// so the tools which are not aware of the extension would work with the genesis
+// as long as the extension is not used.
+
+// SplitExtendedGenesis unmarshals the json object into the genesis state of the
+// extended module and the one of the extension.
+func SplitExtendedGenesis(cdc codec.JSONCodec, bz json.RawMessage, state, extension proto.Message) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return err
+	}
+
+	extensionFields := map[string]json.RawMessage{}
+	for key := range fieldKeys(cdc, extension) {
+		if value, ok := fields[key]; ok {
+			extensionFields[key] = value
+			delete(fields, key)
+		}
+	}
+
+	if err := unmarshalFields(cdc, fields, state); err != nil {
+		return err
+	}
+	return unmarshalFields(cdc, extensionFields, extension)
+}
+
+func unmarshalFields(cdc codec.JSONCodec, fields map[string]json.RawMessage, msg proto.Message) error {
+	bz, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	return cdc.UnmarshalJSON(bz, msg)
+}
+
+// MergeExtendedGenesis marshals the genesis state of the extended module and the
+// one of the extension into a json object. The extension is omitted if it is empty.
+func MergeExtendedGenesis(cdc codec.JSONCodec, state, extension proto.Message) json.RawMessage {
+	bz := cdc.MustMarshalJSON(state)
+	if proto.Equal(extension, emptyMessage(extension)) {
+		return bz
+	}
+
+	fields := map[string]json.RawMessage{}
+	for _, bz := range [][]byte{bz, cdc.MustMarshalJSON(extension)} {
+		if err := json.Unmarshal(bz, &fields); err != nil {
+			panic(err)
+		}
+	}
+
+	bz, err := json.Marshal(fields)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// fieldKeys returns the json keys of the message.
+func fieldKeys(cdc codec.JSONCodec, msg proto.Message) map[string]bool {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(cdc.MustMarshalJSON(emptyMessage(msg)), &fields); err != nil {
+		panic(err)
+	}
+
+	keys := make(map[string]bool, len(fields))
+	for key := range fields {
+		keys[key] = true
+	}
+	return keys
+}
+
+func emptyMessage(msg proto.Message) proto.Message {
+	empty := proto.Clone(msg)
+	empty.Reset()
+	return empty
+}
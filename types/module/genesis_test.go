@@ -0,0 +1,38 @@
+package module_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/codec"
+	"github.com/Finschia/finschia-sdk/codec/types"
+	"github.com/Finschia/finschia-sdk/testutil/testdata"
+	"github.com/Finschia/finschia-sdk/types/module"
+)
+
+func TestExtendedGenesis(t *testing.T) {
+	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
+
+	state := &testdata.Dog{Size_: "small", Name: "spot"}
+	extension := &testdata.Cat{Moniker: "garfield", Lives: 9}
+
+	// round trip
+	bz := module.MergeExtendedGenesis(cdc, state, extension)
+	var splitState testdata.Dog
+	var splitExtension testdata.Cat
+	require.NoError(t, module.SplitExtendedGenesis(cdc, bz, &splitState, &splitExtension))
+	require.Equal(t, *state, splitState)
+	require.Equal(t, *extension, splitExtension)
+
+	// the genesis of the extended module keeps valid
+	bz = module.MergeExtendedGenesis(cdc, state, &testdata.Cat{})
+	require.Equal(t, cdc.MustMarshalJSON(state), []byte(bz))
+	splitState, splitExtension = testdata.Dog{}, testdata.Cat{}
+	require.NoError(t, module.SplitExtendedGenesis(cdc, bz, &splitState, &splitExtension))
+	require.Equal(t, *state, splitState)
+	require.Equal(t, testdata.Cat{}, splitExtension)
+
+	// unknown fields are not allowed
+	require.Error(t, module.SplitExtendedGenesis(cdc, []byte(`{"unknown":[]}`), &splitState, &splitExtension))
+}
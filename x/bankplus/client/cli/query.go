@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Finschia/finschia-sdk/client"
+	"github.com/Finschia/finschia-sdk/client/flags"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	bankcli "github.com/Finschia/finschia-sdk/x/bank/client/cli"
+	"github.com/Finschia/finschia-sdk/x/bankplus/types"
+)
+
+// NewQueryCmd returns the query commands of the bank module,
+// including the ones of bankplus.
+func NewQueryCmd() *cobra.Command {
+	queryCmd := bankcli.GetQueryCmd()
+
+	queryCmd.AddCommand(
+		NewQueryCmdInactiveAddrs(),
+		NewQueryCmdIsInactiveAddr(),
+	)
+
+	return queryCmd
+}
+
+func NewQueryCmdInactiveAddrs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inactive-addrs",
+		Args:  cobra.NoArgs,
+		Short: "Query all the addresses blocked from receiving funds",
+		Long: `Query all the addresses blocked from receiving funds
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := types.QueryInactiveAddrsRequest{Pagination: pageReq}
+			res, err := queryClient.InactiveAddrs(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "inactive addresses")
+	return cmd
+}
+
+func NewQueryCmdIsInactiveAddr() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "is-inactive-addr [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query whether an address is blocked from receiving funds",
+		Long: `Query whether an address is blocked from receiving funds
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			address := args[0]
+			if _, err := sdk.AccAddressFromBech32(address); err != nil {
+				return err
+			}
+
+			req := types.QueryIsInactiveAddrRequest{Address: address}
+			res, err := queryClient.IsInactiveAddr(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
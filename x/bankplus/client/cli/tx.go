@@ -0,0 +1,101 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Finschia/finschia-sdk/client"
+	"github.com/Finschia/finschia-sdk/client/flags"
+	"github.com/Finschia/finschia-sdk/client/tx"
+	bankcli "github.com/Finschia/finschia-sdk/x/bank/client/cli"
+	"github.com/Finschia/finschia-sdk/x/bankplus/types"
+)
+
+func validateGenerateOnly(cmd *cobra.Command) error {
+	generateOnly, err := cmd.Flags().GetBool(flags.FlagGenerateOnly)
+	if err != nil {
+		return err
+	}
+	if !generateOnly {
+		return fmt.Errorf("you must use it with the flag --%s", flags.FlagGenerateOnly)
+	}
+	return nil
+}
+
+// NewTxCmd returns the transaction commands of the bank module,
+// including the ones of bankplus.
+func NewTxCmd() *cobra.Command {
+	txCmd := bankcli.NewTxCmd()
+
+	txCmd.AddCommand(
+		NewTxCmdAddInactiveAddr(),
+		NewTxCmdDeleteInactiveAddr(),
+	)
+
+	return txCmd
+}
+
+func NewTxCmdAddInactiveAddr() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-inactive-addr [authority] [address]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Block an address from receiving funds",
+		Long: `Block an address from receiving funds
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateGenerateOnly(cmd); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgAddInactiveAddr{
+				Authority: args[0],
+				Address:   args[1],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdDeleteInactiveAddr() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-inactive-addr [authority] [address]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Allow a blocked address to receive funds again",
+		Long: `Allow a blocked address to receive funds again
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateGenerateOnly(cmd); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgDeleteInactiveAddr{
+				Authority: args[0],
+				Address:   args[1],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,30 @@
+package bankplus
+
+import (
+	"encoding/json"
+
+	"github.com/Finschia/finschia-sdk/codec"
+	"github.com/Finschia/finschia-sdk/types/module"
+	banktypes "github.com/Finschia/finschia-sdk/x/bank/types"
+	"github.com/Finschia/finschia-sdk/x/bankplus/types"
+)
+
+// The bankplus specific genesis state shares the json object of the bank
+// genesis state. See module.SplitExtendedGenesis for the details.
+
+// splitGenesis splits the json object into the bank genesis state and the
+// bankplus specific one.
+func splitGenesis(cdc codec.JSONCodec, bz json.RawMessage) (*banktypes.GenesisState, *types.GenesisState, error) {
+	var bankState banktypes.GenesisState
+	var plusState types.GenesisState
+	if err := module.SplitExtendedGenesis(cdc, bz, &bankState, &plusState); err != nil {
+		return nil, nil, err
+	}
+	return &bankState, &plusState, nil
+}
+
+// mergeGenesis merges the bank genesis state and the bankplus specific one
+// into a json object.
+func mergeGenesis(cdc codec.JSONCodec, bankState *banktypes.GenesisState, plusState *types.GenesisState) json.RawMessage {
+	return module.MergeExtendedGenesis(cdc, bankState, plusState)
+}
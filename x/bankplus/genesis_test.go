@@ -0,0 +1,78 @@
+package bankplus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/codec"
+	codectypes "github.com/Finschia/finschia-sdk/codec/types"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	banktypes "github.com/Finschia/finschia-sdk/x/bank/types"
+	"github.com/Finschia/finschia-sdk/x/bankplus/types"
+)
+
+func TestSplitGenesis(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	bankState := banktypes.DefaultGenesisState()
+	bankState.Supply = sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	plusState := &types.GenesisState{
+		InactiveAddrs: []types.InactiveAddr{{Address: sdk.AccAddress("inactive").String()}},
+	}
+
+	// round trip
+	bz := mergeGenesis(cdc, bankState, plusState)
+	splitBank, splitPlus, err := splitGenesis(cdc, bz)
+	require.NoError(t, err)
+	require.Equal(t, bankState, splitBank)
+	require.Equal(t, plusState, splitPlus)
+
+	// the genesis of the bank module keeps valid
+	bz = mergeGenesis(cdc, bankState, types.DefaultGenesisState())
+	require.Equal(t, cdc.MustMarshalJSON(bankState), []byte(bz))
+	splitBank, splitPlus, err = splitGenesis(cdc, bz)
+	require.NoError(t, err)
+	require.Equal(t, bankState, splitBank)
+	require.Empty(t, splitPlus.InactiveAddrs)
+
+	// unknown fields are not allowed
+	_, _, err = splitGenesis(cdc, []byte(`{"unknown":[]}`))
+	require.Error(t, err)
+}
+
+func TestValidateGenesis(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	addr := sdk.AccAddress("inactive").String()
+
+	testCases := map[string]struct {
+		inactiveAddrs []types.InactiveAddr
+		valid         bool
+	}{
+		"default genesis": {
+			valid: true,
+		},
+		"valid inactive addrs": {
+			inactiveAddrs: []types.InactiveAddr{{Address: addr}},
+			valid:         true,
+		},
+		"invalid address": {
+			inactiveAddrs: []types.InactiveAddr{{Address: "invalid"}},
+		},
+		"duplicate address": {
+			inactiveAddrs: []types.InactiveAddr{{Address: addr}, {Address: addr}},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			bz := mergeGenesis(cdc, banktypes.DefaultGenesisState(), &types.GenesisState{InactiveAddrs: tc.inactiveAddrs})
+			err := AppModuleBasic{}.ValidateGenesis(cdc, nil, bz)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
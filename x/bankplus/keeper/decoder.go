@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/Finschia/finschia-sdk/codec"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/kv"
+	banktypes "github.com/Finschia/finschia-sdk/x/bank/types"
+	"github.com/Finschia/finschia-sdk/x/bankplus/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding bank or bankplus type, as bankplus shares the
+// store of bank.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], banktypes.SupplyKey):
+			var amountA, amountB sdk.Int
+			if err := amountA.Unmarshal(kvA.Value); err != nil {
+				panic(err)
+			}
+			if err := amountB.Unmarshal(kvB.Value); err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%v\n%v", amountA, amountB)
+
+		case bytes.Equal(kvA.Key[:1], banktypes.DenomMetadataPrefix):
+			var metadataA, metadataB banktypes.Metadata
+			cdc.MustUnmarshal(kvA.Value, &metadataA)
+			cdc.MustUnmarshal(kvB.Value, &metadataB)
+			return fmt.Sprintf("%v\n%v", metadataA, metadataB)
+
+		case bytes.Equal(kvA.Key[:1], banktypes.BalancesPrefix):
+			var balanceA, balanceB sdk.Coin
+			cdc.MustUnmarshal(kvA.Value, &balanceA)
+			cdc.MustUnmarshal(kvB.Value, &balanceB)
+			return fmt.Sprintf("%v\n%v", balanceA, balanceB)
+
+		case bytes.Equal(kvA.Key[:1], inactiveAddrsKeyPrefix):
+			var inactiveAddrA, inactiveAddrB types.InactiveAddr
+			cdc.MustUnmarshal(kvA.Value, &inactiveAddrA)
+			cdc.MustUnmarshal(kvB.Value, &inactiveAddrB)
+			return fmt.Sprintf("%v\n%v", inactiveAddrA, inactiveAddrB)
+
+		default:
+			panic(fmt.Sprintf("invalid bankplus key %X", kvA.Key))
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/Finschia/finschia-sdk/simapp"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/kv"
+	"github.com/Finschia/finschia-sdk/x/bank/types"
+	bankpluskeeper "github.com/Finschia/finschia-sdk/x/bankplus/keeper"
+)
+
+func TestDecodeStore(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	dec := bankpluskeeper.NewDecodeStore(app.AppCodec())
+
+	holder := sdk.AccAddress("holder")
+	require.NoError(t, simapp.FundAccount(app, ctx, holder, initCoins))
+	app.BankKeeper.SetDenomMetaData(ctx, types.Metadata{
+		Base:    sdk.DefaultBondDenom,
+		Display: sdk.DefaultBondDenom,
+	})
+	app.BankKeeper.(bankpluskeeper.Keeper).AddToInactiveAddr(ctx, sdk.AccAddress("inactive"))
+
+	// every entry in the store must be decodable
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		pair := kv.Pair{Key: iterator.Key(), Value: iterator.Value()}
+		require.NotPanics(t, func() { dec(pair, pair) }, "%X", pair.Key)
+	}
+
+	invalid := kv.Pair{Key: []byte{0x99}, Value: []byte{0x99}}
+	require.Panics(t, func() { dec(invalid, invalid) })
+}
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/bankplus/types"
+)
+
+// InitBankPlusGenesis initializes the bankplus specific state from the genesis.
+func (keeper BaseKeeper) InitBankPlusGenesis(ctx sdk.Context, data *types.GenesisState) {
+	for _, inactiveAddr := range data.InactiveAddrs {
+		addr := sdk.MustAccAddressFromBech32(inactiveAddr.Address)
+		keeper.AddToInactiveAddr(ctx, addr)
+	}
+}
+
+// ExportBankPlusGenesis returns the bankplus specific state for the genesis.
+func (keeper BaseKeeper) ExportBankPlusGenesis(ctx sdk.Context) *types.GenesisState {
+	var inactiveAddrs []types.InactiveAddr
+	keeper.iterateInactiveAddrs(ctx, func(inactiveAddr types.InactiveAddr) (stop bool) {
+		inactiveAddrs = append(inactiveAddrs, inactiveAddr)
+		return false
+	})
+
+	return &types.GenesisState{
+		InactiveAddrs: inactiveAddrs,
+	}
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Finschia/finschia-sdk/store/prefix"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/types/query"
+	"github.com/Finschia/finschia-sdk/x/bankplus/types"
+)
+
+type queryServer struct {
+	keeper BaseKeeper
+}
+
+// NewQueryServer returns an implementation of the bankplus QueryServer interface
+// for the provided Keeper.
+func NewQueryServer(keeper BaseKeeper) types.QueryServer {
+	return &queryServer{
+		keeper: keeper,
+	}
+}
+
+var _ types.QueryServer = queryServer{}
+
+// InactiveAddrs queries all the inactive addresses.
+func (s queryServer) InactiveAddrs(c context.Context, req *types.QueryInactiveAddrsRequest) (*types.QueryInactiveAddrsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var inactiveAddrs []types.InactiveAddr
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	inactiveAddrStore := prefix.NewStore(store, inactiveAddrsKeyPrefix)
+	pageRes, err := query.Paginate(inactiveAddrStore, req.Pagination, func(key []byte, value []byte) error {
+		var inactiveAddr types.InactiveAddr
+		s.keeper.cdc.MustUnmarshal(value, &inactiveAddr)
+		inactiveAddrs = append(inactiveAddrs, inactiveAddr)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryInactiveAddrsResponse{InactiveAddrs: inactiveAddrs, Pagination: pageRes}, nil
+}
+
+// IsInactiveAddr queries whether the address is inactive or not.
+func (s queryServer) IsInactiveAddr(c context.Context, req *types.QueryIsInactiveAddrRequest) (*types.QueryIsInactiveAddrResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, sdkerrors.ErrInvalidAddress.Wrap(req.Address).Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	inactive := s.keeper.isStoredInactiveAddr(ctx, addr)
+
+	return &types.QueryIsInactiveAddrResponse{Inactive: inactive}, nil
+}
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/query"
+	banktypes "github.com/Finschia/finschia-sdk/x/bank/types"
+	"github.com/Finschia/finschia-sdk/x/bankplus/types"
+)
+
+func TestQueryInactiveAddrs(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(banktypes.StoreKey)
+	bankKeeper := setupKeeper(storeKey)
+	ctx := setupContext(t, storeKey)
+	queryServer := NewQueryServer(bankKeeper)
+
+	numAddrs := 3
+	for i := 0; i < numAddrs; i++ {
+		bankKeeper.AddToInactiveAddr(ctx, genAddress())
+	}
+
+	// nil request
+	_, err := queryServer.InactiveAddrs(sdk.WrapSDKContext(ctx), nil)
+	require.Error(t, err)
+
+	res, err := queryServer.InactiveAddrs(sdk.WrapSDKContext(ctx), &types.QueryInactiveAddrsRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.InactiveAddrs, 2)
+	require.EqualValues(t, numAddrs, res.Pagination.Total)
+
+	res, err = queryServer.InactiveAddrs(sdk.WrapSDKContext(ctx), &types.QueryInactiveAddrsRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.InactiveAddrs, numAddrs-2)
+}
+
+func TestQueryIsInactiveAddr(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(banktypes.StoreKey)
+	bankKeeper := setupKeeper(storeKey)
+	ctx := setupContext(t, storeKey)
+	queryServer := NewQueryServer(bankKeeper)
+
+	inactiveAddr := genAddress()
+	bankKeeper.AddToInactiveAddr(ctx, inactiveAddr)
+
+	testCases := map[string]struct {
+		address  string
+		valid    bool
+		inactive bool
+	}{
+		"inactive address": {
+			address:  inactiveAddr.String(),
+			valid:    true,
+			inactive: true,
+		},
+		"active address": {
+			address: genAddress().String(),
+			valid:   true,
+		},
+		"invalid address": {},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := &types.QueryIsInactiveAddrRequest{Address: tc.address}
+			res, err := queryServer.IsInactiveAddr(sdk.WrapSDKContext(ctx), req)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.inactive, res.Inactive)
+		})
+	}
+}
@@ -14,12 +14,9 @@ func inactiveAddrKey(addr sdk.AccAddress) []byte {
 }
 
 // isStoredInactiveAddr checks if the address is stored or not as blocked address
-//
-//nolint:deadcode,unused
 func (keeper BaseKeeper) isStoredInactiveAddr(ctx sdk.Context, address sdk.AccAddress) bool {
 	store := ctx.KVStore(keeper.storeKey)
-	bz := store.Get(inactiveAddrKey(address))
-	return bz != nil
+	return store.Has(inactiveAddrKey(address))
 }
 
 // addToInactiveAddr adds a blocked address to the store.
@@ -36,10 +33,8 @@ func (keeper BaseKeeper) deleteFromInactiveAddr(ctx sdk.Context, address sdk.Acc
 	store.Delete(inactiveAddrKey(address))
 }
 
-// loadAllInactiveAddrs loads all blocked address and set to `inactiveAddr`.
-// This function is executed when the app is initiated and save all inactive address in caches
-// in order to prevent to query to store in every time to send
-func (keeper BaseKeeper) loadAllInactiveAddrs(ctx sdk.Context) {
+// iterateInactiveAddrs iterates over all the blocked addresses in the store.
+func (keeper BaseKeeper) iterateInactiveAddrs(ctx sdk.Context, fn func(inactiveAddr types.InactiveAddr) (stop bool)) {
 	store := ctx.KVStore(keeper.storeKey)
 	iterator := sdk.KVStorePrefixIterator(store, inactiveAddrsKeyPrefix)
 
@@ -48,6 +43,8 @@ func (keeper BaseKeeper) loadAllInactiveAddrs(ctx sdk.Context) {
 		var bAddr types.InactiveAddr
 		keeper.cdc.MustUnmarshal(iterator.Value(), &bAddr)
 
-		keeper.inactiveAddrs[bAddr.Address] = true
+		if fn(bAddr) {
+			break
+		}
 	}
 }
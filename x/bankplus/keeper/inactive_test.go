@@ -13,13 +13,17 @@ import (
 	"github.com/Finschia/finschia-sdk/codec"
 	codectypes "github.com/Finschia/finschia-sdk/codec/types"
 	"github.com/Finschia/finschia-sdk/store"
+	storetypes "github.com/Finschia/finschia-sdk/store/types"
 	sdk "github.com/Finschia/finschia-sdk/types"
 	accountkeeper "github.com/Finschia/finschia-sdk/x/auth/keeper"
 	accounttypes "github.com/Finschia/finschia-sdk/x/auth/types"
 	banktypes "github.com/Finschia/finschia-sdk/x/bank/types"
+	"github.com/Finschia/finschia-sdk/x/bankplus/types"
 	paramtypes "github.com/Finschia/finschia-sdk/x/params/types"
 )
 
+var testAuthority = accounttypes.NewModuleAddress("authority")
+
 func genAddress() sdk.AccAddress {
 	b := make([]byte, 20)
 	rand.Read(b)
@@ -37,7 +41,7 @@ func setupKeeper(storeKey *sdk.KVStoreKey) BaseKeeper {
 	accountKeeper := accountkeeper.NewAccountKeeper(cdc, accountStoreKey, accountSubspace, accounttypes.ProtoBaseAccount, nil)
 
 	bankSubspace := paramtypes.NewSubspace(cdc, amino, storeKey, testTransientStoreKey, banktypes.StoreKey)
-	return NewBaseKeeper(cdc, storeKey, accountKeeper, bankSubspace, nil, false)
+	return NewBaseKeeperWithAuthority(cdc, storeKey, accountKeeper, bankSubspace, nil, false, testAuthority.String())
 }
 
 func setupContext(t *testing.T, storeKey *sdk.KVStoreKey) sdk.Context {
@@ -56,8 +60,6 @@ func TestInactiveAddr(t *testing.T) {
 
 	addr := genAddress()
 
-	require.Equal(t, 0, len(bankKeeper.inactiveAddrs))
-
 	bankKeeper.addToInactiveAddr(ctx, addr)
 	require.True(t, bankKeeper.isStoredInactiveAddr(ctx, addr))
 
@@ -74,10 +76,98 @@ func TestInactiveAddr(t *testing.T) {
 	// expect no error
 	bankKeeper.deleteFromInactiveAddr(ctx, addr2)
 
-	// test loadAllInactiveAddrs
+	// test iterateInactiveAddrs
 	bankKeeper.addToInactiveAddr(ctx, addr)
 	bankKeeper.addToInactiveAddr(ctx, addr2)
-	require.Equal(t, 0, len(bankKeeper.inactiveAddrs))
-	bankKeeper.loadAllInactiveAddrs(ctx)
-	require.Equal(t, 2, len(bankKeeper.inactiveAddrs))
+	var inactiveAddrs []types.InactiveAddr
+	bankKeeper.iterateInactiveAddrs(ctx, func(inactiveAddr types.InactiveAddr) (stop bool) {
+		inactiveAddrs = append(inactiveAddrs, inactiveAddr)
+		return false
+	})
+	require.Equal(t, 2, len(inactiveAddrs))
+}
+
+func TestInactiveAddrContexts(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(banktypes.StoreKey)
+	bankKeeper := setupKeeper(storeKey)
+	ctx := setupContext(t, storeKey)
+
+	addr := genAddress()
+
+	// the changes on CheckTx must not affect the other contexts
+	checkCtx, _ := ctx.WithIsCheckTx(true).CacheContext()
+	bankKeeper.AddToInactiveAddr(checkCtx, addr)
+	require.True(t, bankKeeper.IsInactive(checkCtx, addr))
+	require.False(t, bankKeeper.IsInactive(ctx, addr))
+
+	// neither do the changes of the reverted context
+	revertedCtx, _ := ctx.CacheContext()
+	bankKeeper.AddToInactiveAddr(revertedCtx, addr)
+	require.True(t, bankKeeper.IsInactive(revertedCtx, addr))
+	require.False(t, bankKeeper.IsInactive(ctx, addr))
+
+	// the changes are applied on commit
+	committedCtx, commit := ctx.CacheContext()
+	bankKeeper.AddToInactiveAddr(committedCtx, addr)
+	require.False(t, bankKeeper.IsInactive(ctx, addr))
+	commit()
+	require.True(t, bankKeeper.IsInactive(ctx, addr))
+
+	// the changes made without the keeper are visible at once
+	bankKeeper.deleteFromInactiveAddr(ctx, addr)
+	require.False(t, bankKeeper.IsInactive(ctx, addr))
+}
+
+func TestInactiveAddrGas(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(banktypes.StoreKey)
+	bankKeeper := setupKeeper(storeKey)
+	ctx := setupContext(t, storeKey)
+
+	addr := genAddress()
+	bankKeeper.addToInactiveAddr(ctx, addr)
+
+	// the check of the transfers is metered like any other read
+	ctx = ctx.WithGasMeter(sdk.NewGasMeter(1000000))
+	require.True(t, bankKeeper.IsInactive(ctx, addr))
+	require.False(t, bankKeeper.IsInactive(ctx, genAddress()))
+	require.Equal(t, 2*storetypes.KVGasConfig().HasCost, ctx.GasMeter().GasConsumed())
+}
+
+func TestDeprecatedInactiveAddr(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(banktypes.StoreKey)
+	bankKeeper := setupKeeper(storeKey)
+	ctx := setupContext(t, storeKey)
+
+	addr := genAddress()
+	bankKeeper.addToInactiveAddr(ctx, addr)
+	require.False(t, bankKeeper.IsInactiveAddr(addr))
+
+	bankKeeper.InitializeBankPlus(ctx)
+	require.True(t, bankKeeper.IsInactiveAddr(addr))
+
+	bankKeeper.DeleteFromInactiveAddr(ctx, addr)
+	require.False(t, bankKeeper.IsInactiveAddr(addr))
+
+	bankKeeper.AddToInactiveAddr(ctx, addr)
+	require.True(t, bankKeeper.IsInactiveAddr(addr))
+}
+
+func TestBankPlusGenesis(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(banktypes.StoreKey)
+	bankKeeper := setupKeeper(storeKey)
+	ctx := setupContext(t, storeKey)
+
+	addrs := []sdk.AccAddress{genAddress(), genAddress()}
+	genesis := &types.GenesisState{}
+	for _, addr := range addrs {
+		genesis.InactiveAddrs = append(genesis.InactiveAddrs, types.InactiveAddr{Address: addr.String()})
+	}
+
+	bankKeeper.InitBankPlusGenesis(ctx, genesis)
+	for _, addr := range addrs {
+		require.True(t, bankKeeper.IsInactive(ctx, addr))
+	}
+
+	exported := bankKeeper.ExportBankPlusGenesis(ctx)
+	require.ElementsMatch(t, genesis.InactiveAddrs, exported.InactiveAddrs)
 }
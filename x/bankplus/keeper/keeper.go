@@ -6,6 +6,8 @@ import (
 	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 	bankkeeper "github.com/Finschia/finschia-sdk/x/bank/keeper"
 	"github.com/Finschia/finschia-sdk/x/bank/types"
+	bankplustypes "github.com/Finschia/finschia-sdk/x/bankplus/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
 	paramtypes "github.com/Finschia/finschia-sdk/x/params/types"
 )
 
@@ -16,9 +18,12 @@ type Keeper interface {
 
 	AddToInactiveAddr(ctx sdk.Context, address sdk.AccAddress)
 	DeleteFromInactiveAddr(ctx sdk.Context, address sdk.AccAddress)
+	IsInactive(ctx sdk.Context, address sdk.AccAddress) bool
 	IsInactiveAddr(address sdk.AccAddress) bool
 
 	InitializeBankPlus(ctx sdk.Context)
+	InitBankPlusGenesis(ctx sdk.Context, data *bankplustypes.GenesisState)
+	ExportBankPlusGenesis(ctx sdk.Context) *bankplustypes.GenesisState
 }
 
 type BaseKeeper struct {
@@ -29,11 +34,23 @@ type BaseKeeper struct {
 	storeKey       sdk.StoreKey
 	inactiveAddrs  map[string]bool
 	deactMultiSend bool
+	authority      string
 }
 
+// NewBaseKeeper returns a new BaseKeeper whose `inactiveAddr` is managed by the foundation.
+//
+// Deprecated: use NewBaseKeeperWithAuthority, which takes the authority explicitly.
 func NewBaseKeeper(
 	cdc codec.Codec, storeKey sdk.StoreKey, ak types.AccountKeeper, paramSpace paramtypes.Subspace,
 	blockedAddr map[string]bool, deactMultiSend bool,
+) BaseKeeper {
+	return NewBaseKeeperWithAuthority(cdc, storeKey, ak, paramSpace, blockedAddr, deactMultiSend, foundation.DefaultAuthority().String())
+}
+
+// NewBaseKeeperWithAuthority returns a new BaseKeeper whose `inactiveAddr` is managed by the authority.
+func NewBaseKeeperWithAuthority(
+	cdc codec.Codec, storeKey sdk.StoreKey, ak types.AccountKeeper, paramSpace paramtypes.Subspace,
+	blockedAddr map[string]bool, deactMultiSend bool, authority string,
 ) BaseKeeper {
 	return BaseKeeper{
 		BaseKeeper:     bankkeeper.NewBaseKeeper(cdc, storeKey, ak, paramSpace, blockedAddr),
@@ -42,11 +59,23 @@ func NewBaseKeeper(
 		storeKey:       storeKey,
 		inactiveAddrs:  map[string]bool{},
 		deactMultiSend: deactMultiSend,
+		authority:      authority,
 	}
 }
 
+// InitializeBankPlus loads `inactiveAddr` into the cache of IsInactiveAddr.
+//
+// Deprecated: the transfers read `inactiveAddr` from the store, so it is only required by IsInactiveAddr.
 func (keeper BaseKeeper) InitializeBankPlus(ctx sdk.Context) {
-	keeper.loadAllInactiveAddrs(ctx)
+	keeper.iterateInactiveAddrs(ctx, func(inactiveAddr bankplustypes.InactiveAddr) (stop bool) {
+		keeper.inactiveAddrs[inactiveAddr.Address] = true
+		return false
+	})
+}
+
+// GetAuthority returns the address which is able to manage `inactiveAddr`.
+func (keeper BaseKeeper) GetAuthority() string {
+	return keeper.authority
 }
 
 // SendCoinsFromModuleToAccount transfers coins from a ModuleAccount to an AccAddress.
@@ -97,16 +126,12 @@ func (keeper BaseKeeper) SendCoinsFromAccountToModule(
 	return keeper.SendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
 }
 
-func (keeper BaseKeeper) isInactiveAddr(addr sdk.AccAddress) bool {
-	return keeper.inactiveAddrs[addr.String()]
-}
-
 // SendCoins transfers amt coins from a sending account to a receiving account.
 // This is wrapped bank the `SendKeeper` interface of `bank` module,
 // and checks if `toAddr` is a inactiveAddr managed by the module.
 func (keeper BaseKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
 	// if toAddr is smart contract, check the status of contract.
-	if keeper.isInactiveAddr(toAddr) {
+	if keeper.isStoredInactiveAddr(ctx, toAddr) {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", toAddr)
 	}
 
@@ -115,23 +140,29 @@ func (keeper BaseKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toA
 
 // AddToInactiveAddr adds the address to `inactiveAddr`.
 func (keeper BaseKeeper) AddToInactiveAddr(ctx sdk.Context, address sdk.AccAddress) {
-	if !keeper.inactiveAddrs[address.String()] {
-		keeper.inactiveAddrs[address.String()] = true
-
+	if !keeper.isStoredInactiveAddr(ctx, address) {
 		keeper.addToInactiveAddr(ctx, address)
 	}
+	keeper.inactiveAddrs[address.String()] = true
 }
 
 // DeleteFromInactiveAddr removes the address from `inactiveAddr`.
 func (keeper BaseKeeper) DeleteFromInactiveAddr(ctx sdk.Context, address sdk.AccAddress) {
-	if keeper.inactiveAddrs[address.String()] {
-		delete(keeper.inactiveAddrs, address.String())
-
+	if keeper.isStoredInactiveAddr(ctx, address) {
 		keeper.deleteFromInactiveAddr(ctx, address)
 	}
+	delete(keeper.inactiveAddrs, address.String())
 }
 
-// IsInactiveAddr returns if the address is added in inactiveAddr.
+// IsInactive returns if the address is added in inactiveAddr.
+func (keeper BaseKeeper) IsInactive(ctx sdk.Context, address sdk.AccAddress) bool {
+	return keeper.isStoredInactiveAddr(ctx, address)
+}
+
+// IsInactiveAddr returns if the address is added in inactiveAddr, according to the cache
+// loaded by InitializeBankPlus. The cache is not reverted along with failed transactions.
+//
+// Deprecated: use IsInactive, which reads the store of the context.
 func (keeper BaseKeeper) IsInactiveAddr(address sdk.AccAddress) bool {
 	return keeper.inactiveAddrs[address.String()]
 }
@@ -142,7 +173,11 @@ func (keeper BaseKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 	}
 
 	for _, out := range outputs {
-		if keeper.inactiveAddrs[out.Address] {
+		outAddress, err := sdk.AccAddressFromBech32(out.Address)
+		if err != nil {
+			return err
+		}
+		if keeper.isStoredInactiveAddr(ctx, outAddress) {
 			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", out.Address)
 		}
 	}
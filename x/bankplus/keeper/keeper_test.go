@@ -1,18 +1,27 @@
 package keeper_test
 
 import (
+	"sync"
 	"testing"
 
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	ocabci "github.com/Finschia/ostracon/abci/types"
+
+	"github.com/Finschia/finschia-sdk/crypto/keys/secp256k1"
 	"github.com/Finschia/finschia-sdk/simapp"
+	"github.com/Finschia/finschia-sdk/simapp/helpers"
+	simappparams "github.com/Finschia/finschia-sdk/simapp/params"
 	sdk "github.com/Finschia/finschia-sdk/types"
 	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 	authkeeper "github.com/Finschia/finschia-sdk/x/auth/keeper"
 	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
 	"github.com/Finschia/finschia-sdk/x/bank/types"
 	bankpluskeeper "github.com/Finschia/finschia-sdk/x/bankplus/keeper"
+	"github.com/Finschia/finschia-sdk/x/foundation"
 	minttypes "github.com/Finschia/finschia-sdk/x/mint/types"
 )
 
@@ -61,9 +70,9 @@ func (suite *IntegrationTestSuite) TestSupply_SendCoins() {
 		appCodec, app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName),
 		authtypes.ProtoBaseAccount, maccPerms,
 	)
-	keeper := bankpluskeeper.NewBaseKeeper(
+	keeper := bankpluskeeper.NewBaseKeeperWithAuthority(
 		appCodec, app.GetKey(types.StoreKey), authKeeper,
-		app.GetSubspace(types.ModuleName), make(map[string]bool), false,
+		app.GetSubspace(types.ModuleName), make(map[string]bool), false, foundation.DefaultAuthority().String(),
 	)
 
 	baseAcc := authKeeper.NewAccountWithAddress(ctx, authtypes.NewModuleAddress("baseAcc"))
@@ -132,9 +141,9 @@ func (suite *IntegrationTestSuite) TestInactiveAddrOfSendCoins() {
 		authtypes.ProtoBaseAccount, maccPerms,
 	)
 
-	keeper := bankpluskeeper.NewBaseKeeper(
+	keeper := bankpluskeeper.NewBaseKeeperWithAuthority(
 		appCodec, app.GetKey(types.StoreKey), authKeeper,
-		app.GetSubspace(types.ModuleName), make(map[string]bool), false,
+		app.GetSubspace(types.ModuleName), make(map[string]bool), false, foundation.DefaultAuthority().String(),
 	)
 
 	// set initial balances
@@ -149,11 +158,11 @@ func (suite *IntegrationTestSuite) TestInactiveAddrOfSendCoins() {
 	suite.Require().NoError(keeper.MintCoins(ctx, minttypes.ModuleName, initCoins))
 	suite.Require().Equal(initCoins, keeper.GetAllBalances(ctx, holderAcc.GetAddress()))
 
-	suite.Require().False(keeper.IsInactiveAddr(blockedAcc.GetAddress()))
+	suite.Require().False(keeper.IsInactive(ctx, blockedAcc.GetAddress()))
 
 	// add blocked address
 	keeper.AddToInactiveAddr(ctx, blockedAcc.GetAddress())
-	suite.Require().True(keeper.IsInactiveAddr(blockedAcc.GetAddress()))
+	suite.Require().True(keeper.IsInactive(ctx, blockedAcc.GetAddress()))
 
 	err := keeper.SendCoins(ctx, holderAcc.GetAddress(), blockedAcc.GetAddress(), initCoins)
 	suite.Require().Contains(err.Error(), "is not allowed to receive funds")
@@ -161,13 +170,13 @@ func (suite *IntegrationTestSuite) TestInactiveAddrOfSendCoins() {
 
 	// delete blocked address
 	keeper.DeleteFromInactiveAddr(ctx, blockedAcc.GetAddress())
-	suite.Require().False(keeper.IsInactiveAddr(blockedAcc.GetAddress()))
+	suite.Require().False(keeper.IsInactive(ctx, blockedAcc.GetAddress()))
 
 	suite.Require().NoError(keeper.SendCoins(ctx, holderAcc.GetAddress(), blockedAcc.GetAddress(), initCoins))
 	suite.Require().Equal(sdk.NewCoins().String(), keeper.GetAllBalances(ctx, holderAcc.GetAddress()).String())
 }
 
-func (suite *IntegrationTestSuite) TestInitializeBankPlus() {
+func (suite *IntegrationTestSuite) TestInactiveAddrAcrossKeepers() {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
 	appCodec := app.AppCodec()
@@ -184,23 +193,22 @@ func (suite *IntegrationTestSuite) TestInitializeBankPlus() {
 	)
 
 	{
-		keeper := bankpluskeeper.NewBaseKeeper(
+		keeper := bankpluskeeper.NewBaseKeeperWithAuthority(
 			appCodec, app.GetKey(types.StoreKey), authKeeper,
-			app.GetSubspace(types.ModuleName), make(map[string]bool), false,
+			app.GetSubspace(types.ModuleName), make(map[string]bool), false, foundation.DefaultAuthority().String(),
 		)
 
 		// add blocked address
 		keeper.AddToInactiveAddr(ctx, blockedAcc.GetAddress())
-		suite.Require().True(keeper.IsInactiveAddr(blockedAcc.GetAddress()))
+		suite.Require().True(keeper.IsInactive(ctx, blockedAcc.GetAddress()))
 	}
 
 	{
-		keeper := bankpluskeeper.NewBaseKeeper(
+		keeper := bankpluskeeper.NewBaseKeeperWithAuthority(
 			appCodec, app.GetKey(types.StoreKey), authKeeper,
-			app.GetSubspace(types.ModuleName), make(map[string]bool), false,
+			app.GetSubspace(types.ModuleName), make(map[string]bool), false, foundation.DefaultAuthority().String(),
 		)
-		keeper.InitializeBankPlus(ctx)
-		suite.Require().True(keeper.IsInactiveAddr(blockedAcc.GetAddress()))
+		suite.Require().True(keeper.IsInactive(ctx, blockedAcc.GetAddress()))
 	}
 }
 
@@ -221,9 +229,9 @@ func (suite *IntegrationTestSuite) TestSendCoinsFromModuleToAccount_Blacklist()
 		appCodec, app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName),
 		authtypes.ProtoBaseAccount, maccPerms,
 	)
-	keeper := bankpluskeeper.NewBaseKeeper(
+	keeper := bankpluskeeper.NewBaseKeeperWithAuthority(
 		appCodec, app.GetKey(types.StoreKey), authKeeper,
-		app.GetSubspace(types.ModuleName), map[string]bool{addr1.String(): true}, false)
+		app.GetSubspace(types.ModuleName), map[string]bool{addr1.String(): true}, false, foundation.DefaultAuthority().String())
 
 	suite.Require().NoError(keeper.MintCoins(ctx, minttypes.ModuleName, initCoins))
 	suite.Require().Error(keeper.SendCoinsFromModuleToAccount(
@@ -246,9 +254,9 @@ func (suite *IntegrationTestSuite) TestInputOutputCoins() {
 		appCodec, app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName),
 		authtypes.ProtoBaseAccount, maccPerms,
 	)
-	keeper := bankpluskeeper.NewBaseKeeper(
+	keeper := bankpluskeeper.NewBaseKeeperWithAuthority(
 		appCodec, app.GetKey(types.StoreKey), authKeeper,
-		app.GetSubspace(types.ModuleName), make(map[string]bool), false,
+		app.GetSubspace(types.ModuleName), make(map[string]bool), false, foundation.DefaultAuthority().String(),
 	)
 
 	baseAcc := authKeeper.NewAccountWithAddress(ctx, authtypes.NewModuleAddress("baseAcc"))
@@ -274,9 +282,9 @@ func (suite *IntegrationTestSuite) TestInputOutputCoins() {
 	output := []types.Output{types.NewOutput(burnerAcc.GetAddress(), initCoins), types.NewOutput(burnerAcc.GetAddress(), initCoins)}
 
 	targetKeeper := func(isDeact bool) bankpluskeeper.BaseKeeper {
-		return bankpluskeeper.NewBaseKeeper(
+		return bankpluskeeper.NewBaseKeeperWithAuthority(
 			appCodec, app.GetKey(types.StoreKey), authKeeper,
-			app.GetSubspace(types.ModuleName), make(map[string]bool), isDeact,
+			app.GetSubspace(types.ModuleName), make(map[string]bool), isDeact, foundation.DefaultAuthority().String(),
 		)
 	}
 	tcs := map[string]struct {
@@ -306,6 +314,61 @@ func (suite *IntegrationTestSuite) TestInputOutputCoins() {
 	}
 }
 
+// TestCheckTxConcurrentWithBlock runs CheckTx concurrently with the blocks changing the inactive addresses,
+// which Ostracon does with the async CheckTx. Run it with -race.
+func TestCheckTxConcurrentWithBlock(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	app := simapp.SetupWithGenesisAccounts(
+		[]authtypes.GenesisAccount{&authtypes.BaseAccount{Address: addr.String()}},
+		types.Balance{Address: addr.String(), Coins: initCoins},
+	)
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	// the fee of the txs is sent by the keeper on CheckTx
+	txGen := simappparams.MakeTestEncodingConfig().TxConfig
+	msg := types.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))
+	tx, err := helpers.GenTx(txGen, []sdk.Msg{msg}, fee, helpers.DefaultGenTxGas, "", []uint64{0}, []uint64{0}, priv)
+	require.NoError(t, err)
+	txBytes, err := txGen.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	inactive := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	for height := app.LastBlockHeight() + 1; height < 10; height++ {
+		header := tmproto.Header{Height: height}
+		app.BeginBlock(ocabci.RequestBeginBlock{Header: header})
+
+		var wg sync.WaitGroup
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				app.CheckTxSync(abci.RequestCheckTx{Tx: txBytes, Type: abci.CheckTxType_New})
+			}()
+		}
+
+		ctx := app.BaseApp.NewContext(false, header)
+		if height%2 == 0 {
+			app.BankKeeper.(bankpluskeeper.Keeper).AddToInactiveAddr(ctx, inactive)
+		} else {
+			app.BankKeeper.(bankpluskeeper.Keeper).DeleteFromInactiveAddr(ctx, inactive)
+		}
+		require.Equal(t, height%2 == 0, app.BankKeeper.(bankpluskeeper.Keeper).IsInactive(ctx, inactive))
+
+		// Ostracon does not run CheckTx on Commit
+		wg.Wait()
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+		app.BeginRecheckTx(ocabci.RequestBeginRecheckTx{Header: header})
+		app.EndRecheckTx(ocabci.RequestEndRecheckTx{})
+
+		checkCtx := app.BaseApp.NewContext(true, header)
+		require.Equal(t, height%2 == 0, app.BankKeeper.(bankpluskeeper.Keeper).IsInactive(checkCtx, inactive))
+	}
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/bankplus/types"
+)
+
+type msgServer struct {
+	keeper BaseKeeper
+}
+
+// NewMsgServerImpl returns an implementation of the bankplus MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper BaseKeeper) types.MsgServer {
+	return &msgServer{
+		keeper: keeper,
+	}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (s msgServer) validateAuthority(authority string) error {
+	if authority != s.keeper.authority {
+		return sdkerrors.ErrUnauthorized.Wrapf("invalid authority; expected %s, got %s", s.keeper.authority, authority)
+	}
+
+	return nil
+}
+
+// AddInactiveAddr adds an address to the inactive addresses.
+func (s msgServer) AddInactiveAddr(c context.Context, req *types.MsgAddInactiveAddr) (*types.MsgAddInactiveAddrResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := s.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	addr := sdk.MustAccAddressFromBech32(req.Address)
+	if s.keeper.isStoredInactiveAddr(ctx, addr) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s is already inactive", req.Address)
+	}
+
+	s.keeper.AddToInactiveAddr(ctx, addr)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAddInactiveAddr{
+		Address: req.Address,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &types.MsgAddInactiveAddrResponse{}, nil
+}
+
+// DeleteInactiveAddr deletes an address from the inactive addresses.
+func (s msgServer) DeleteInactiveAddr(c context.Context, req *types.MsgDeleteInactiveAddr) (*types.MsgDeleteInactiveAddrResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := s.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	addr := sdk.MustAccAddressFromBech32(req.Address)
+	if !s.keeper.isStoredInactiveAddr(ctx, addr) {
+		return nil, sdkerrors.ErrNotFound.Wrapf("%s is not inactive", req.Address)
+	}
+
+	s.keeper.DeleteFromInactiveAddr(ctx, addr)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDeleteInactiveAddr{
+		Address: req.Address,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &types.MsgDeleteInactiveAddrResponse{}, nil
+}
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	banktypes "github.com/Finschia/finschia-sdk/x/bank/types"
+	"github.com/Finschia/finschia-sdk/x/bankplus/types"
+)
+
+func TestMsgAddInactiveAddr(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(banktypes.StoreKey)
+	bankKeeper := setupKeeper(storeKey)
+	ctx := setupContext(t, storeKey)
+	msgServer := NewMsgServerImpl(bankKeeper)
+
+	inactiveAddr := genAddress()
+	bankKeeper.AddToInactiveAddr(ctx, inactiveAddr)
+
+	testCases := map[string]struct {
+		authority sdk.AccAddress
+		address   sdk.AccAddress
+		err       error
+	}{
+		"valid request": {
+			authority: testAuthority,
+			address:   genAddress(),
+		},
+		"invalid authority": {
+			authority: genAddress(),
+			address:   genAddress(),
+			err:       sdkerrors.ErrUnauthorized,
+		},
+		"already inactive": {
+			authority: testAuthority,
+			address:   inactiveAddr,
+			err:       sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+
+			req := &types.MsgAddInactiveAddr{
+				Authority: tc.authority.String(),
+				Address:   tc.address.String(),
+			}
+			res, err := msgServer.AddInactiveAddr(sdk.WrapSDKContext(ctx), req)
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.NotNil(t, res)
+			require.True(t, bankKeeper.isStoredInactiveAddr(ctx, tc.address))
+			require.True(t, bankKeeper.IsInactive(ctx, tc.address))
+		})
+	}
+}
+
+func TestMsgDeleteInactiveAddr(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(banktypes.StoreKey)
+	bankKeeper := setupKeeper(storeKey)
+	ctx := setupContext(t, storeKey)
+	msgServer := NewMsgServerImpl(bankKeeper)
+
+	inactiveAddr := genAddress()
+	bankKeeper.AddToInactiveAddr(ctx, inactiveAddr)
+
+	testCases := map[string]struct {
+		authority sdk.AccAddress
+		address   sdk.AccAddress
+		err       error
+	}{
+		"valid request": {
+			authority: testAuthority,
+			address:   inactiveAddr,
+		},
+		"invalid authority": {
+			authority: genAddress(),
+			address:   inactiveAddr,
+			err:       sdkerrors.ErrUnauthorized,
+		},
+		"not inactive": {
+			authority: testAuthority,
+			address:   genAddress(),
+			err:       sdkerrors.ErrNotFound,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+
+			req := &types.MsgDeleteInactiveAddr{
+				Authority: tc.authority.String(),
+				Address:   tc.address.String(),
+			}
+			res, err := msgServer.DeleteInactiveAddr(sdk.WrapSDKContext(ctx), req)
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.NotNil(t, res)
+			require.False(t, bankKeeper.isStoredInactiveAddr(ctx, tc.address))
+			require.False(t, bankKeeper.IsInactive(ctx, tc.address))
+		})
+	}
+}
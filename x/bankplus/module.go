@@ -1,31 +1,101 @@
 package bankplus
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/Finschia/finschia-sdk/client"
 	"github.com/Finschia/finschia-sdk/codec"
+	codectypes "github.com/Finschia/finschia-sdk/codec/types"
+	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/types/module"
 	accountkeeper "github.com/Finschia/finschia-sdk/x/auth/keeper"
 	"github.com/Finschia/finschia-sdk/x/bank"
 	bankkeeper "github.com/Finschia/finschia-sdk/x/bank/keeper"
 	banktypes "github.com/Finschia/finschia-sdk/x/bank/types"
+	"github.com/Finschia/finschia-sdk/x/bankplus/client/cli"
 	"github.com/Finschia/finschia-sdk/x/bankplus/keeper"
+	"github.com/Finschia/finschia-sdk/x/bankplus/simulation"
+	"github.com/Finschia/finschia-sdk/x/bankplus/types"
 )
 
 var (
+	_ module.AppModuleBasic      = AppModuleBasic{}
 	_ module.AppModule           = AppModule{}
 	_ module.AppModuleSimulation = AppModule{}
 )
 
+// AppModuleBasic defines the basic application module used by the bankplus module.
+// It extends the one of the bank module.
+type AppModuleBasic struct {
+	bank.AppModuleBasic
+}
+
+// RegisterLegacyAminoCodec registers the bankplus module's types on the LegacyAmino codec.
+func (b AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	b.AppModuleBasic.RegisterLegacyAminoCodec(cdc)
+	types.RegisterLegacyAminoCodec(cdc)
+}
+
+// RegisterInterfaces registers interfaces and implementations of the bankplus module.
+func (b AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	b.AppModuleBasic.RegisterInterfaces(registry)
+	types.RegisterInterfaces(registry)
+}
+
+// DefaultGenesis returns default genesis state as raw bytes for the bankplus
+// module.
+func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return mergeGenesis(cdc, banktypes.DefaultGenesisState(), types.DefaultGenesisState())
+}
+
+// ValidateGenesis performs genesis state validation for the bankplus module.
+func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
+	bankState, plusState, err := splitGenesis(cdc, bz)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", banktypes.ModuleName, err)
+	}
+
+	if err := bankState.Validate(); err != nil {
+		return err
+	}
+	return plusState.Validate()
+}
+
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the bankplus module.
+func (b AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	b.AppModuleBasic.RegisterGRPCGatewayRoutes(clientCtx, mux)
+	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
+
+// GetTxCmd returns the root tx command for the bankplus module.
+func (AppModuleBasic) GetTxCmd() *cobra.Command {
+	return cli.NewTxCmd()
+}
+
+// GetQueryCmd returns the root query command for the bankplus module.
+func (AppModuleBasic) GetQueryCmd() *cobra.Command {
+	return cli.NewQueryCmd()
+}
+
 type AppModule struct {
 	bank.AppModule
 
+	cdc        codec.Codec
 	bankKeeper bankkeeper.Keeper
 }
 
 func NewAppModule(cdc codec.Codec, keeper bankkeeper.Keeper, accountKeeper accountkeeper.AccountKeeper) AppModule {
 	return AppModule{
 		AppModule:  bank.NewAppModule(cdc, keeper, accountKeeper),
+		cdc:        cdc,
 		bankKeeper: keeper,
 	}
 }
@@ -34,8 +104,63 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	banktypes.RegisterMsgServer(cfg.MsgServer(), bankkeeper.NewMsgServerImpl(am.bankKeeper))
 	banktypes.RegisterQueryServer(cfg.QueryServer(), am.bankKeeper)
 
-	m := bankkeeper.NewMigrator(am.bankKeeper.(keeper.BaseKeeper).BaseKeeper)
+	plusKeeper := am.bankKeeper.(keeper.BaseKeeper)
+	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(plusKeeper))
+	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServer(plusKeeper))
+
+	m := bankkeeper.NewMigrator(plusKeeper.BaseKeeper)
 	if err := cfg.RegisterMigration(banktypes.ModuleName, 1, m.Migrate1to2); err != nil {
 		panic(fmt.Sprintf("failed to migrate x/bank from version 1 to 2: %v", err))
 	}
 }
+
+// DefaultGenesis returns default genesis state as raw bytes for the bankplus
+// module.
+func (AppModule) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return AppModuleBasic{}.DefaultGenesis(cdc)
+}
+
+// ValidateGenesis performs genesis state validation for the bankplus module.
+func (AppModule) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage) error {
+	return AppModuleBasic{}.ValidateGenesis(cdc, config, bz)
+}
+
+// InitGenesis performs genesis initialization for the bankplus module. It returns
+// no validator updates.
+func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
+	bankState, plusState, err := splitGenesis(cdc, data)
+	if err != nil {
+		panic(err)
+	}
+
+	am.bankKeeper.InitGenesis(ctx, bankState)
+	am.bankKeeper.(keeper.Keeper).InitBankPlusGenesis(ctx, plusState)
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis returns the exported genesis state as raw bytes for the bankplus
+// module.
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	bankState := am.bankKeeper.ExportGenesis(ctx)
+	plusState := am.bankKeeper.(keeper.Keeper).ExportBankPlusGenesis(ctx)
+	return mergeGenesis(cdc, bankState, plusState)
+}
+
+// AppModuleSimulation functions
+
+// GenerateGenesisState creates a randomized GenState of the bankplus module,
+// which extends the one of the bank module with the inactive addresses.
+func (am AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	am.AppModule.GenerateGenesisState(simState)
+
+	var bankState banktypes.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[banktypes.ModuleName], &bankState)
+	plusState := simulation.RandomizedGenState(simState)
+
+	simState.GenState[banktypes.ModuleName] = mergeGenesis(simState.Cdc, &bankState, plusState)
+}
+
+// RegisterStoreDecoder registers a decoder for bankplus module's types
+func (am AppModule) RegisterStoreDecoder(sdr sdk.StoreDecoderRegistry) {
+	sdr[banktypes.StoreKey] = keeper.NewDecodeStore(am.cdc)
+}
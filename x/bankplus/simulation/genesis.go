@@ -0,0 +1,42 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/Finschia/finschia-sdk/types/module"
+	simtypes "github.com/Finschia/finschia-sdk/types/simulation"
+	"github.com/Finschia/finschia-sdk/x/bankplus/types"
+)
+
+// Simulation parameter constants
+const (
+	InactiveAddrs = "inactive_addrs"
+)
+
+// GenInactiveAddrs returns randomized inactive addresses. They are not the
+// simulation accounts, so the bank operations would not send coins to them.
+func GenInactiveAddrs(r *rand.Rand) []types.InactiveAddr {
+	accs := simtypes.RandomAccounts(r, r.Intn(4))
+
+	inactiveAddrs := make([]types.InactiveAddr, len(accs))
+	for i, acc := range accs {
+		inactiveAddrs[i] = types.InactiveAddr{Address: acc.Address.String()}
+	}
+
+	return inactiveAddrs
+}
+
+// RandomizedGenState generates a random bankplus specific GenesisState, which
+// is merged into the bank genesis state by the module.
+func RandomizedGenState(simState *module.SimulationState) *types.GenesisState {
+	var inactiveAddrs []types.InactiveAddr
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, InactiveAddrs, &inactiveAddrs, simState.Rand,
+		func(r *rand.Rand) { inactiveAddrs = GenInactiveAddrs(r) },
+	)
+
+	genesis := types.DefaultGenesisState()
+	genesis.InactiveAddrs = inactiveAddrs
+
+	return genesis
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+)
+
+// DefaultGenesisState returns a default bankplus genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate performs basic validation of the inactive addresses.
+func (gs GenesisState) Validate() error {
+	seen := map[string]bool{}
+	for _, inactiveAddr := range gs.InactiveAddrs {
+		if _, err := sdk.AccAddressFromBech32(inactiveAddr.Address); err != nil {
+			return err
+		}
+
+		if seen[inactiveAddr.Address] {
+			return fmt.Errorf("duplicate inactive address: %s", inactiveAddr.Address)
+		}
+		seen[inactiveAddr.Address] = true
+	}
+
+	return nil
+}
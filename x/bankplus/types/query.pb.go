@@ -0,0 +1,1050 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lbm/bankplus/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/Finschia/finschia-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryInactiveAddrsRequest is the Query/InactiveAddrs request type.
+type QueryInactiveAddrsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInactiveAddrsRequest) Reset()         { *m = QueryInactiveAddrsRequest{} }
+func (m *QueryInactiveAddrsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInactiveAddrsRequest) ProtoMessage()    {}
+func (*QueryInactiveAddrsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ca08475e4ace696, []int{0}
+}
+func (m *QueryInactiveAddrsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInactiveAddrsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInactiveAddrsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInactiveAddrsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInactiveAddrsRequest.Merge(m, src)
+}
+func (m *QueryInactiveAddrsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInactiveAddrsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInactiveAddrsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInactiveAddrsRequest proto.InternalMessageInfo
+
+func (m *QueryInactiveAddrsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryInactiveAddrsResponse is the Query/InactiveAddrs response type.
+type QueryInactiveAddrsResponse struct {
+	// inactive_addrs are the inactive addresses.
+	InactiveAddrs []InactiveAddr `protobuf:"bytes,1,rep,name=inactive_addrs,json=inactiveAddrs,proto3" json:"inactive_addrs"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInactiveAddrsResponse) Reset()         { *m = QueryInactiveAddrsResponse{} }
+func (m *QueryInactiveAddrsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInactiveAddrsResponse) ProtoMessage()    {}
+func (*QueryInactiveAddrsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ca08475e4ace696, []int{1}
+}
+func (m *QueryInactiveAddrsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInactiveAddrsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInactiveAddrsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInactiveAddrsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInactiveAddrsResponse.Merge(m, src)
+}
+func (m *QueryInactiveAddrsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInactiveAddrsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInactiveAddrsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInactiveAddrsResponse proto.InternalMessageInfo
+
+func (m *QueryInactiveAddrsResponse) GetInactiveAddrs() []InactiveAddr {
+	if m != nil {
+		return m.InactiveAddrs
+	}
+	return nil
+}
+
+func (m *QueryInactiveAddrsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryIsInactiveAddrRequest is the Query/IsInactiveAddr request type.
+type QueryIsInactiveAddrRequest struct {
+	// address is the address to query.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryIsInactiveAddrRequest) Reset()         { *m = QueryIsInactiveAddrRequest{} }
+func (m *QueryIsInactiveAddrRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIsInactiveAddrRequest) ProtoMessage()    {}
+func (*QueryIsInactiveAddrRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ca08475e4ace696, []int{2}
+}
+func (m *QueryIsInactiveAddrRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIsInactiveAddrRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIsInactiveAddrRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIsInactiveAddrRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIsInactiveAddrRequest.Merge(m, src)
+}
+func (m *QueryIsInactiveAddrRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIsInactiveAddrRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIsInactiveAddrRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIsInactiveAddrRequest proto.InternalMessageInfo
+
+func (m *QueryIsInactiveAddrRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryIsInactiveAddrResponse is the Query/IsInactiveAddr response type.
+type QueryIsInactiveAddrResponse struct {
+	// inactive is true if the address is not allowed to receive funds.
+	Inactive bool `protobuf:"varint,1,opt,name=inactive,proto3" json:"inactive,omitempty"`
+}
+
+func (m *QueryIsInactiveAddrResponse) Reset()         { *m = QueryIsInactiveAddrResponse{} }
+func (m *QueryIsInactiveAddrResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIsInactiveAddrResponse) ProtoMessage()    {}
+func (*QueryIsInactiveAddrResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ca08475e4ace696, []int{3}
+}
+func (m *QueryIsInactiveAddrResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIsInactiveAddrResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIsInactiveAddrResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIsInactiveAddrResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIsInactiveAddrResponse.Merge(m, src)
+}
+func (m *QueryIsInactiveAddrResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIsInactiveAddrResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIsInactiveAddrResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIsInactiveAddrResponse proto.InternalMessageInfo
+
+func (m *QueryIsInactiveAddrResponse) GetInactive() bool {
+	if m != nil {
+		return m.Inactive
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*QueryInactiveAddrsRequest)(nil), "lbm.bankplus.v1.QueryInactiveAddrsRequest")
+	proto.RegisterType((*QueryInactiveAddrsResponse)(nil), "lbm.bankplus.v1.QueryInactiveAddrsResponse")
+	proto.RegisterType((*QueryIsInactiveAddrRequest)(nil), "lbm.bankplus.v1.QueryIsInactiveAddrRequest")
+	proto.RegisterType((*QueryIsInactiveAddrResponse)(nil), "lbm.bankplus.v1.QueryIsInactiveAddrResponse")
+}
+
+func init() { proto.RegisterFile("lbm/bankplus/v1/query.proto", fileDescriptor_9ca08475e4ace696) }
+
+var fileDescriptor_9ca08475e4ace696 = []byte{
+	// 454 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x3d, 0x6f, 0xd4, 0x30,
+	0x1c, 0xc6, 0xe3, 0xe3, 0xad, 0xb8, 0x6a, 0x91, 0x2c, 0x86, 0x23, 0x85, 0xb4, 0x64, 0xa0, 0xa5,
+	0x05, 0x5b, 0x39, 0x24, 0x24, 0x46, 0x3a, 0x14, 0x01, 0x0b, 0x64, 0x64, 0x41, 0x4e, 0xce, 0xa4,
+	0x56, 0x13, 0x3b, 0x3d, 0x3b, 0x11, 0x15, 0x62, 0xe1, 0x13, 0x80, 0xd8, 0xf9, 0x0e, 0xec, 0x7c,
+	0x80, 0x8e, 0x95, 0x58, 0x98, 0x10, 0xba, 0xe3, 0x83, 0xa0, 0x38, 0x4e, 0x9b, 0x1c, 0x77, 0xba,
+	0xdb, 0xec, 0x3c, 0x7e, 0xf2, 0xfc, 0xfe, 0x8f, 0x13, 0xb8, 0x91, 0x46, 0x19, 0x89, 0xa8, 0x38,
+	0xca, 0xd3, 0x42, 0x91, 0x32, 0x20, 0xc7, 0x05, 0x1b, 0x9d, 0xe0, 0x7c, 0x24, 0xb5, 0x44, 0x37,
+	0xd2, 0x28, 0xc3, 0x8d, 0x88, 0xcb, 0xc0, 0xdd, 0x8d, 0xa5, 0xca, 0xa4, 0x22, 0x11, 0x55, 0xac,
+	0x3e, 0x49, 0xca, 0x20, 0x62, 0x9a, 0x06, 0x24, 0xa7, 0x09, 0x17, 0x54, 0x73, 0x29, 0x6a, 0xb3,
+	0x7b, 0x3b, 0x91, 0x32, 0x49, 0x19, 0xa1, 0x39, 0x27, 0x54, 0x08, 0xa9, 0x8d, 0xa8, 0xac, 0x7a,
+	0x33, 0x91, 0x89, 0x34, 0x4b, 0x52, 0xad, 0xec, 0x53, 0x6f, 0x9a, 0xe6, 0x3c, 0xdc, 0xe8, 0x7e,
+	0x0c, 0x6f, 0xbd, 0xae, 0x52, 0x9f, 0x0b, 0x1a, 0x6b, 0x5e, 0xb2, 0xa7, 0xc3, 0xe1, 0x48, 0x85,
+	0xec, 0xb8, 0x60, 0x4a, 0xa3, 0x03, 0x08, 0x2f, 0x20, 0xfa, 0x60, 0x0b, 0xec, 0xac, 0x0e, 0xee,
+	0xe1, 0x9a, 0x18, 0x57, 0xc4, 0xb8, 0x9e, 0xcd, 0x12, 0xe3, 0x57, 0x34, 0x61, 0xd6, 0x1b, 0xb6,
+	0x9c, 0xfe, 0x77, 0x00, 0xdd, 0x59, 0x29, 0x2a, 0x97, 0x42, 0x31, 0xf4, 0x02, 0xae, 0x73, 0x2b,
+	0xbc, 0xa5, 0x95, 0xd2, 0x07, 0x5b, 0x97, 0x76, 0x56, 0x07, 0x77, 0xf0, 0x54, 0x5b, 0xb8, 0xed,
+	0xdf, 0xbf, 0x7c, 0xfa, 0x7b, 0xd3, 0x09, 0xd7, 0x78, 0xfb, 0x9d, 0xe8, 0x59, 0x07, 0xb9, 0x67,
+	0x90, 0xb7, 0x17, 0x22, 0xd7, 0x20, 0x1d, 0xe6, 0xc7, 0x0d, 0xb2, 0x6a, 0x87, 0x36, 0xcd, 0xf4,
+	0xe1, 0xb5, 0x8a, 0x94, 0x29, 0x65, 0x6a, 0xb9, 0x1e, 0x36, 0x5b, 0xff, 0x09, 0xdc, 0x98, 0xe9,
+	0xb3, 0xb3, 0xba, 0x70, 0xa5, 0x01, 0x36, 0xce, 0x95, 0xf0, 0x7c, 0x3f, 0xf8, 0xd1, 0x83, 0x57,
+	0x8c, 0x17, 0x7d, 0x01, 0x70, 0xad, 0xd3, 0x15, 0xda, 0xfd, 0xaf, 0x8b, 0xb9, 0xd7, 0xe6, 0xee,
+	0x2d, 0x75, 0xb6, 0x06, 0xf2, 0xb7, 0x3f, 0xfd, 0xfc, 0xfb, 0xb5, 0x77, 0x17, 0x6d, 0x92, 0xe9,
+	0x2f, 0xa5, 0x7b, 0x27, 0xe8, 0x1b, 0x80, 0xeb, 0xdd, 0xa1, 0xd0, 0xbc, 0xa0, 0x59, 0x95, 0xb9,
+	0x0f, 0x96, 0x3b, 0x6c, 0xb1, 0x02, 0x83, 0xb5, 0x87, 0xee, 0x2f, 0xc0, 0x22, 0x1f, 0x6c, 0xf1,
+	0x1f, 0xf7, 0x5f, 0x9e, 0x8e, 0x3d, 0x70, 0x36, 0xf6, 0xc0, 0x9f, 0xb1, 0x07, 0x3e, 0x4f, 0x3c,
+	0xe7, 0x6c, 0xe2, 0x39, 0xbf, 0x26, 0x9e, 0xf3, 0x26, 0x48, 0xb8, 0x3e, 0x2c, 0x22, 0x1c, 0xcb,
+	0x8c, 0x1c, 0x70, 0xa1, 0xe2, 0x43, 0x4e, 0xc9, 0x3b, 0xbb, 0x78, 0xa8, 0x86, 0x47, 0xe4, 0xfd,
+	0x45, 0x84, 0x3e, 0xc9, 0x99, 0x8a, 0xae, 0x9a, 0xdf, 0xe3, 0xd1, 0xbf, 0x01, 0x00, 0x91, 0x34,
+	0xaa, 0x9c, 0xce, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// InactiveAddrs queries all the inactive addresses.
+	InactiveAddrs(ctx context.Context, in *QueryInactiveAddrsRequest, opts ...grpc.CallOption) (*QueryInactiveAddrsResponse, error)
+	// IsInactiveAddr queries whether the address is inactive or not.
+	IsInactiveAddr(ctx context.Context, in *QueryIsInactiveAddrRequest, opts ...grpc.CallOption) (*QueryIsInactiveAddrResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) InactiveAddrs(ctx context.Context, in *QueryInactiveAddrsRequest, opts ...grpc.CallOption) (*QueryInactiveAddrsResponse, error) {
+	out := new(QueryInactiveAddrsResponse)
+	err := c.cc.Invoke(ctx, "/lbm.bankplus.v1.Query/InactiveAddrs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IsInactiveAddr(ctx context.Context, in *QueryIsInactiveAddrRequest, opts ...grpc.CallOption) (*QueryIsInactiveAddrResponse, error) {
+	out := new(QueryIsInactiveAddrResponse)
+	err := c.cc.Invoke(ctx, "/lbm.bankplus.v1.Query/IsInactiveAddr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// InactiveAddrs queries all the inactive addresses.
+	InactiveAddrs(context.Context, *QueryInactiveAddrsRequest) (*QueryInactiveAddrsResponse, error)
+	// IsInactiveAddr queries whether the address is inactive or not.
+	IsInactiveAddr(context.Context, *QueryIsInactiveAddrRequest) (*QueryIsInactiveAddrResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) InactiveAddrs(ctx context.Context, req *QueryInactiveAddrsRequest) (*QueryInactiveAddrsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InactiveAddrs not implemented")
+}
+func (*UnimplementedQueryServer) IsInactiveAddr(ctx context.Context, req *QueryIsInactiveAddrRequest) (*QueryIsInactiveAddrResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsInactiveAddr not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_InactiveAddrs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInactiveAddrsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InactiveAddrs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.bankplus.v1.Query/InactiveAddrs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InactiveAddrs(ctx, req.(*QueryInactiveAddrsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IsInactiveAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIsInactiveAddrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IsInactiveAddr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.bankplus.v1.Query/IsInactiveAddr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IsInactiveAddr(ctx, req.(*QueryIsInactiveAddrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lbm.bankplus.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InactiveAddrs",
+			Handler:    _Query_InactiveAddrs_Handler,
+		},
+		{
+			MethodName: "IsInactiveAddr",
+			Handler:    _Query_IsInactiveAddr_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lbm/bankplus/v1/query.proto",
+}
+
+func (m *QueryInactiveAddrsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInactiveAddrsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInactiveAddrsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInactiveAddrsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInactiveAddrsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInactiveAddrsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InactiveAddrs) > 0 {
+		for iNdEx := len(m.InactiveAddrs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InactiveAddrs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsInactiveAddrRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsInactiveAddrRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsInactiveAddrRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsInactiveAddrResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsInactiveAddrResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsInactiveAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Inactive {
+		i--
+		if m.Inactive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryInactiveAddrsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInactiveAddrsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.InactiveAddrs) > 0 {
+		for _, e := range m.InactiveAddrs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsInactiveAddrRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsInactiveAddrResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Inactive {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryInactiveAddrsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInactiveAddrsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInactiveAddrsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInactiveAddrsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInactiveAddrsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInactiveAddrsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactiveAddrs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InactiveAddrs = append(m.InactiveAddrs, InactiveAddr{})
+			if err := m.InactiveAddrs[len(m.InactiveAddrs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIsInactiveAddrRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIsInactiveAddrRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIsInactiveAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIsInactiveAddrResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIsInactiveAddrResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIsInactiveAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inactive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Inactive = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
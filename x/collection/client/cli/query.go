@@ -29,6 +29,8 @@ func NewQueryCmd() *cobra.Command {
 
 	queryCmd.AddCommand(
 		NewQueryCmdBalances(),
+		NewQueryCmdSpendable(),
+		NewQueryCmdLocked(),
 		NewQueryCmdFTSupply(),
 		NewQueryCmdFTMinted(),
 		NewQueryCmdFTBurnt(),
@@ -36,11 +38,19 @@ func NewQueryCmd() *cobra.Command {
 		NewQueryCmdNFTMinted(),
 		NewQueryCmdNFTBurnt(),
 		NewQueryCmdContract(),
+		NewQueryCmdContracts(),
+		NewQueryCmdTokenClasses(),
+		NewQueryCmdNFTsByType(),
+		NewQueryCmdFTHolders(),
+		NewQueryCmdOwnerNFTs(),
+		NewQueryCmdNFTsByTrait(),
 		NewQueryCmdToken(),
 		NewQueryCmdTokenType(),
 		NewQueryCmdRoot(),
 		NewQueryCmdParent(),
 		NewQueryCmdChildren(),
+		NewQueryCmdRoyalty(),
+		NewQueryCmdNFTHistory(),
 		NewQueryCmdGranteeGrants(),
 		NewQueryCmdIsOperatorFor(),
 		NewQueryCmdHoldersByOperator(),
@@ -119,6 +129,96 @@ func NewQueryCmdBalances() *cobra.Command {
 	return cmd
 }
 
+func NewQueryCmdSpendable() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "spendable [contract-id] [address] [token-id]",
+		Args:    cobra.ExactArgs(3),
+		Short:   "query for spendable token balance by a given address",
+		Example: fmt.Sprintf(`$ %s query %s spendable [contract-id] [address] [token-id]`, version.AppName, collection.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractID := args[0]
+			if err := collection.ValidateContractID(contractID); err != nil {
+				return err
+			}
+
+			address := args[1]
+			if _, err := sdk.AccAddressFromBech32(address); err != nil {
+				return err
+			}
+
+			tokenID := args[2]
+			if err := collection.ValidateTokenID(tokenID); err != nil {
+				return err
+			}
+
+			queryClient := collection.NewQueryClient(clientCtx)
+			req := &collection.QuerySpendableRequest{
+				ContractId: contractID,
+				Address:    address,
+				TokenId:    tokenID,
+			}
+			res, err := queryClient.Spendable(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewQueryCmdLocked() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "locked [contract-id] [address] [token-id]",
+		Args:    cobra.ExactArgs(3),
+		Short:   "query for locked token balance by a given address",
+		Example: fmt.Sprintf(`$ %s query %s locked [contract-id] [address] [token-id]`, version.AppName, collection.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractID := args[0]
+			if err := collection.ValidateContractID(contractID); err != nil {
+				return err
+			}
+
+			address := args[1]
+			if _, err := sdk.AccAddressFromBech32(address); err != nil {
+				return err
+			}
+
+			tokenID := args[2]
+			if err := collection.ValidateTokenID(tokenID); err != nil {
+				return err
+			}
+
+			queryClient := collection.NewQueryClient(clientCtx)
+			req := &collection.QueryLockedRequest{
+				ContractId: contractID,
+				Address:    address,
+				TokenId:    tokenID,
+			}
+			res, err := queryClient.Locked(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func NewQueryCmdFTSupply() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "ft-supply [contract-id] [token-id]",
@@ -386,6 +486,258 @@ func NewQueryCmdContract() *cobra.Command {
 	return cmd
 }
 
+func NewQueryCmdContracts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "contracts",
+		Args:    cobra.NoArgs,
+		Short:   "query all the contracts",
+		Example: fmt.Sprintf(`$ %s query %s contracts`, version.AppName, collection.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := collection.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &collection.QueryContractsRequest{
+				Pagination: pageReq,
+			}
+			res, err := queryClient.Contracts(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "contracts")
+	return cmd
+}
+
+func NewQueryCmdTokenClasses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "token-classes [contract-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "query all the token classes of a contract",
+		Example: fmt.Sprintf(`$ %s query %s token-classes [contract-id]`, version.AppName, collection.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractID := args[0]
+			if err := collection.ValidateContractID(contractID); err != nil {
+				return err
+			}
+
+			queryClient := collection.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &collection.QueryTokenClassesRequest{
+				ContractId: contractID,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.TokenClasses(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "token classes")
+	return cmd
+}
+
+func NewQueryCmdNFTsByType() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "nfts-by-type [contract-id] [token-type]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "query all the nfts of a token type",
+		Example: fmt.Sprintf(`$ %s query %s nfts-by-type [contract-id] [token-type]`, version.AppName, collection.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractID := args[0]
+			if err := collection.ValidateContractID(contractID); err != nil {
+				return err
+			}
+
+			classID := args[1]
+			if err := collection.ValidateClassID(classID); err != nil {
+				return err
+			}
+
+			queryClient := collection.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &collection.QueryNFTsByTypeRequest{
+				ContractId: contractID,
+				TokenType:  classID,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.NFTsByType(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "nfts by type")
+	return cmd
+}
+
+func NewQueryCmdNFTsByTrait() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "nfts-by-trait [contract-id] [key] [value]",
+		Args:    cobra.ExactArgs(3),
+		Short:   "query all the nfts which have a trait value",
+		Example: fmt.Sprintf(`$ %s query %s nfts-by-trait [contract-id] [key] [value]`, version.AppName, collection.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractID := args[0]
+			if err := collection.ValidateContractID(contractID); err != nil {
+				return err
+			}
+
+			key := args[1]
+			if err := collection.ValidateTraitKey(key); err != nil {
+				return err
+			}
+
+			value := args[2]
+			if err := collection.ValidateTraitValue(value); err != nil {
+				return err
+			}
+
+			queryClient := collection.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &collection.QueryNFTsByTraitRequest{
+				ContractId: contractID,
+				Key:        key,
+				Value:      value,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.NFTsByTrait(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "nfts by trait")
+	return cmd
+}
+
+func NewQueryCmdFTHolders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "ft-holders [contract-id] [token-id]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "query all the holders of a fungible token",
+		Example: fmt.Sprintf(`$ %s query %s ft-holders [contract-id] [token-id]`, version.AppName, collection.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractID := args[0]
+			if err := collection.ValidateContractID(contractID); err != nil {
+				return err
+			}
+
+			tokenID := args[1]
+			if err := collection.ValidateFTID(tokenID); err != nil {
+				return err
+			}
+
+			queryClient := collection.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &collection.QueryFTHoldersRequest{
+				ContractId: contractID,
+				TokenId:    tokenID,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.FTHolders(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "ft holders")
+	return cmd
+}
+
+func NewQueryCmdOwnerNFTs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "owner-nfts [owner]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "query all the nfts held by an address across the contracts",
+		Example: fmt.Sprintf(`$ %s query %s owner-nfts [owner]`, version.AppName, collection.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner := args[0]
+			if _, err := sdk.AccAddressFromBech32(owner); err != nil {
+				return err
+			}
+
+			queryClient := collection.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &collection.QueryOwnerNFTsRequest{
+				Owner:      owner,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.OwnerNFTs(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "owner nfts")
+	return cmd
+}
+
 func NewQueryCmdTokenType() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "token-type [contract-id] [token-type]",
@@ -587,6 +939,96 @@ func NewQueryCmdChildren() *cobra.Command {
 	return cmd
 }
 
+func NewQueryCmdRoyalty() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "royalty [contract-id] [token-id] [sale-price]",
+		Args:    cobra.ExactArgs(3),
+		Short:   "query royalty paid on a sale of an nft",
+		Example: fmt.Sprintf(`$ %s query %s royalty [contract-id] [token-id] [sale-price]`, version.AppName, collection.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractID := args[0]
+			if err := collection.ValidateContractID(contractID); err != nil {
+				return err
+			}
+
+			tokenID := args[1]
+			if err := collection.ValidateNFTID(tokenID); err != nil {
+				return err
+			}
+
+			salePrice, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			queryClient := collection.NewQueryClient(clientCtx)
+			req := &collection.QueryRoyaltyRequest{
+				ContractId: contractID,
+				TokenId:    tokenID,
+				SalePrice:  salePrice,
+			}
+			res, err := queryClient.Royalty(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewQueryCmdNFTHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "nft-history [contract-id] [token-id]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "query history of an nft",
+		Example: fmt.Sprintf(`$ %s query %s nft-history [contract-id] [token-id]`, version.AppName, collection.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractID := args[0]
+			if err := collection.ValidateContractID(contractID); err != nil {
+				return err
+			}
+
+			tokenID := args[1]
+			if err := collection.ValidateNFTID(tokenID); err != nil {
+				return err
+			}
+
+			queryClient := collection.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &collection.QueryNFTHistoryRequest{
+				ContractId: contractID,
+				TokenId:    tokenID,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.NFTHistory(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "nft history")
+	return cmd
+}
+
 func NewQueryCmdGranteeGrants() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "grantee-grants [contract-id] [grantee]",
@@ -2,7 +2,9 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -29,6 +31,18 @@ const (
 	FlagTo       = "to"
 	FlagSupply   = "supply"
 
+	// flags for the vesting schedule of the minted tokens
+	FlagVestingStartTime = "vesting-start-time"
+	FlagVestingEndTime   = "vesting-end-time"
+
+	// flags for the royalty of non-fungible token classes
+	FlagRoyaltyRecipient   = "royalty-recipient"
+	FlagRoyaltyBasisPoints = "royalty-basis-points"
+
+	// flags for the traits of non-fungible tokens
+	FlagTraitSchema = "trait-schema"
+	FlagTrait       = "trait"
+
 	DefaultDecimals = 8
 	DefaultSupply   = "0"
 )
@@ -48,6 +62,9 @@ func NewTxCmd() *cobra.Command {
 		NewTxCmdOperatorSendFT(),
 		NewTxCmdSendNFT(),
 		NewTxCmdOperatorSendNFT(),
+		NewTxCmdMultiSendFT(),
+		NewTxCmdMultiSendNFT(),
+		NewTxCmdSellNFT(),
 		NewTxCmdCreateContract(),
 		NewTxCmdIssueFT(),
 		NewTxCmdIssueNFT(),
@@ -62,6 +79,10 @@ func NewTxCmd() *cobra.Command {
 		NewTxCmdAuthorizeOperator(),
 		NewTxCmdRevokeOperator(),
 		NewTxCmdModify(),
+		NewTxCmdSetNFTHistoryEnabled(),
+		NewTxCmdProposeAdmin(),
+		NewTxCmdAcceptAdmin(),
+		NewTxCmdRevokeGrant(),
 	)
 
 	return txCmd
@@ -188,6 +209,150 @@ func NewTxCmdSendNFT() *cobra.Command {
 	return cmd
 }
 
+func NewTxCmdMultiSendFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multi-send-ft [contract-id] [from] [to] [amount] [[to] [amount]...]",
+		Args:  validatePairArgs(2),
+		Short: "send fungible tokens to multiple recipients",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s multi-send-ft [contract-id] [from] [to1] [amount1] [to2] [amount2]`, version.AppName, collection.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			from := args[1]
+			if err := cmd.Flags().Set(flags.FlagFrom, from); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var outputs []collection.FTOutput
+			for i := 2; i < len(args); i += 2 {
+				amount, err := collection.ParseCoins(args[i+1])
+				if err != nil {
+					return err
+				}
+				outputs = append(outputs, collection.FTOutput{
+					To:     args[i],
+					Amount: amount,
+				})
+			}
+
+			msg := &collection.MsgMultiSendFT{
+				ContractId: args[0],
+				From:       from,
+				Outputs:    outputs,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdMultiSendNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multi-send-nft [contract-id] [from] [to] [token-id] [[to] [token-id]...]",
+		Args:  validatePairArgs(2),
+		Short: "send non-fungible tokens to multiple recipients",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s multi-send-nft [contract-id] [from] [to1] [token-id1] [to2] [token-id2]`, version.AppName, collection.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			from := args[1]
+			if err := cmd.Flags().Set(flags.FlagFrom, from); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var outputs []collection.NFTOutput
+			for i := 2; i < len(args); i += 2 {
+				outputs = append(outputs, collection.NFTOutput{
+					To:       args[i],
+					TokenIds: []string{args[i+1]},
+				})
+			}
+
+			msg := &collection.MsgMultiSendNFT{
+				ContractId: args[0],
+				From:       from,
+				Outputs:    outputs,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// validatePairArgs checks the positional arguments consist of the given
+// number of leading arguments followed by one or more pairs.
+func validatePairArgs(numLeading int) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		if len(args) < numLeading+2 || (len(args)-numLeading)%2 != 0 {
+			return fmt.Errorf("requires %d args followed by pairs of args, received %d", numLeading, len(args))
+		}
+		return nil
+	}
+}
+
+func NewTxCmdSellNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sell-nft [contract-id] [seller] [buyer] [token-id] [price]",
+		Args:  cobra.ExactArgs(5),
+		Short: "sell a non-fungible token in exchange for coins",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s sell-nft [contract-id] [seller] [buyer] [token-id] [price]
+Note: both of the seller and the buyer must sign the transaction.`, version.AppName, collection.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			seller := args[1]
+			if err := cmd.Flags().Set(flags.FlagFrom, seller); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			price, err := sdk.ParseCoinNormalized(args[4])
+			if err != nil {
+				return err
+			}
+
+			msg := &collection.MsgSellNFT{
+				ContractId: args[0],
+				Seller:     seller,
+				Buyer:      args[2],
+				TokenId:    args[3],
+				Price:      price,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func NewTxCmdOperatorSendNFT() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "operator-send-nft [contract-id] [operator] [from] [to] [amount]",
@@ -259,11 +424,17 @@ func NewTxCmdCreateContract() *cobra.Command {
 				return err
 			}
 
+			traitSchema, err := parseTraitSchema(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := collection.MsgCreateContract{
-				Owner: creator,
-				Name:  name,
-				Uri:   baseImgURI,
-				Meta:  meta,
+				Owner:       creator,
+				Name:        name,
+				Uri:         baseImgURI,
+				Meta:        meta,
+				TraitSchema: traitSchema,
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -276,6 +447,7 @@ func NewTxCmdCreateContract() *cobra.Command {
 	cmd.Flags().String(FlagName, "", "set name")
 	cmd.Flags().String(FlagBaseImgURI, "", "set base-img-uri")
 	cmd.Flags().String(FlagMeta, "", "set meta")
+	cmd.Flags().StringArray(FlagTraitSchema, nil, "declare a trait of the non-fungible tokens in the form of key:type[:required], where type is one of string, integer and boolean (repeatable)")
 
 	return cmd
 }
@@ -392,11 +564,33 @@ func NewTxCmdIssueNFT() *cobra.Command {
 				return err
 			}
 
+			royaltyRecipient, err := cmd.Flags().GetString(FlagRoyaltyRecipient)
+			if err != nil {
+				return err
+			}
+
+			royaltyBasisPoints, err := cmd.Flags().GetUint32(FlagRoyaltyBasisPoints)
+			if err != nil {
+				return err
+			}
+
+			traitSchema, err := parseTraitSchema(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := collection.MsgIssueNFT{
-				ContractId: args[0],
-				Owner:      operator,
-				Name:       name,
-				Meta:       meta,
+				ContractId:  args[0],
+				Owner:       operator,
+				Name:        name,
+				Meta:        meta,
+				TraitSchema: traitSchema,
+			}
+			if len(royaltyRecipient) != 0 || royaltyBasisPoints != 0 {
+				msg.Royalty = &collection.Royalty{
+					Recipient:   royaltyRecipient,
+					BasisPoints: royaltyBasisPoints,
+				}
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -408,6 +602,9 @@ func NewTxCmdIssueNFT() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().String(FlagName, "", "set name")
 	cmd.Flags().String(FlagMeta, "", "set meta")
+	cmd.Flags().String(FlagRoyaltyRecipient, "", "set the recipient of the royalty")
+	cmd.Flags().Uint32(FlagRoyaltyBasisPoints, 0, "set the royalty rate in basis points (1/10000) of the sale price")
+	cmd.Flags().StringArray(FlagTraitSchema, nil, "declare a trait of the tokens in the form of key:type[:required], where type is one of string, integer and boolean (repeatable)")
 
 	return cmd
 }
@@ -437,12 +634,18 @@ func NewTxCmdMintFT() *cobra.Command {
 				return sdkerrors.ErrInvalidType.Wrapf("failed to set amount: %s", amountStr)
 			}
 
+			vesting, err := parseVestingSchedule(cmd)
+			if err != nil {
+				return err
+			}
+
 			coins := collection.NewCoins(collection.NewFTCoin(args[3], amount))
 			msg := collection.MsgMintFT{
 				ContractId: args[0],
 				From:       args[1],
 				To:         args[2],
 				Amount:     coins,
+				Vesting:    vesting,
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -452,6 +655,8 @@ func NewTxCmdMintFT() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagVestingStartTime, "", "time (RFC3339) from which the minted tokens start to be unlocked (defaults to the end time)")
+	cmd.Flags().String(FlagVestingEndTime, "", "time (RFC3339) at which all the minted tokens are unlocked; the tokens are not locked if empty")
 	return cmd
 }
 
@@ -484,17 +689,29 @@ func NewTxCmdMintNFT() *cobra.Command {
 				return err
 			}
 
+			traits, err := parseTraits(cmd)
+			if err != nil {
+				return err
+			}
+
 			params := []collection.MintNFTParam{{
 				TokenType: args[3],
 				Name:      name,
 				Meta:      meta,
+				Traits:    traits,
 			}}
 
+			vesting, err := parseVestingSchedule(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := collection.MsgMintNFT{
 				ContractId: args[0],
 				From:       args[1],
 				To:         args[2],
 				Params:     params,
+				Vesting:    vesting,
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -506,11 +723,102 @@ func NewTxCmdMintNFT() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().String(FlagName, "", "set name")
 	cmd.Flags().String(FlagMeta, "", "set meta")
+	cmd.Flags().String(FlagVestingStartTime, "", "time (RFC3339) from which the minted tokens start to be unlocked (defaults to the end time)")
+	cmd.Flags().String(FlagVestingEndTime, "", "time (RFC3339) at which all the minted tokens are unlocked; the tokens are not locked if empty")
+	cmd.Flags().StringArray(FlagTrait, nil, "set a trait in the form of key=value (repeatable)")
 	cmd.MarkFlagRequired(FlagName)
 
 	return cmd
 }
 
+// parseVestingSchedule returns the vesting schedule from the flags, or nil if no schedule is provided.
+func parseVestingSchedule(cmd *cobra.Command) (*collection.VestingSchedule, error) {
+	endTimeStr, err := cmd.Flags().GetString(FlagVestingEndTime)
+	if err != nil {
+		return nil, err
+	}
+	startTimeStr, err := cmd.Flags().GetString(FlagVestingStartTime)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(endTimeStr) == 0 {
+		if len(startTimeStr) != 0 {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s requires %s", FlagVestingStartTime, FlagVestingEndTime)
+		}
+		return nil, nil
+	}
+
+	endTime, err := time.Parse(time.RFC3339, endTimeStr)
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := endTime
+	if len(startTimeStr) != 0 {
+		if startTime, err = time.Parse(time.RFC3339, startTimeStr); err != nil {
+			return nil, err
+		}
+	}
+
+	return &collection.VestingSchedule{
+		StartTime: startTime,
+		EndTime:   endTime,
+	}, nil
+}
+
+// parseTraitSchema returns the trait schema from the flags, each of which is in the form of key:type[:required].
+func parseTraitSchema(cmd *cobra.Command) ([]collection.TraitDefinition, error) {
+	definitionStrs, err := cmd.Flags().GetStringArray(FlagTraitSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	schema := make([]collection.TraitDefinition, 0, len(definitionStrs))
+	for _, definitionStr := range definitionStrs {
+		fields := strings.Split(definitionStr, ":")
+		if len(fields) < 2 || len(fields) > 3 || (len(fields) == 3 && fields[2] != "required") {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("trait definition must be in the form of key:type[:required]: %s", definitionStr)
+		}
+
+		traitType := collection.TraitType(collection.TraitType_value["TRAIT_TYPE_"+strings.ToUpper(fields[1])])
+		if err := traitType.ValidateBasic(); err != nil {
+			return nil, err
+		}
+
+		schema = append(schema, collection.TraitDefinition{
+			Key:      fields[0],
+			Type:     traitType,
+			Required: len(fields) == 3,
+		})
+	}
+
+	return schema, nil
+}
+
+// parseTraits returns the traits from the flags, each of which is in the form of key=value.
+func parseTraits(cmd *cobra.Command) ([]collection.Attribute, error) {
+	traitStrs, err := cmd.Flags().GetStringArray(FlagTrait)
+	if err != nil {
+		return nil, err
+	}
+
+	traits := make([]collection.Attribute, 0, len(traitStrs))
+	for _, traitStr := range traitStrs {
+		key, value, ok := strings.Cut(traitStr, "=")
+		if !ok {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("trait must be in the form of key=value: %s", traitStr)
+		}
+
+		traits = append(traits, collection.Attribute{
+			Key:   key,
+			Value: value,
+		})
+	}
+
+	return traits, nil
+}
+
 func NewTxCmdBurnFT() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "burn-ft [contract-id] [from] [amount]",
@@ -990,3 +1298,148 @@ func NewTxCmdRevokeOperator() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func NewTxCmdSetNFTHistoryEnabled() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-nft-history-enabled [contract-id] [operator] [enabled]",
+		Args:  cobra.ExactArgs(3),
+		Short: "enable or disable recording the history of the nfts of a contract",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s set-nft-history-enabled [contract-id] [operator] [enabled]`, version.AppName, collection.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			operator := args[1]
+			if err := cmd.Flags().Set(flags.FlagFrom, operator); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := collection.MsgSetNFTHistoryEnabled{
+				ContractId: args[0],
+				Operator:   operator,
+				Enabled:    enabled,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdProposeAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-admin [contract-id] [admin] [proposed-admin]",
+		Args:  cobra.ExactArgs(3),
+		Short: "propose the next admin of a contract",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s propose-admin [contract-id] [admin] [proposed-admin]`, version.AppName, collection.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			admin := args[1]
+			if err := cmd.Flags().Set(flags.FlagFrom, admin); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := collection.MsgProposeAdmin{
+				ContractId: args[0],
+				From:       admin,
+				To:         args[2],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdAcceptAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-admin [contract-id] [proposed-admin]",
+		Args:  cobra.ExactArgs(2),
+		Short: "accept the admin role of a contract",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s accept-admin [contract-id] [proposed-admin]`, version.AppName, collection.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proposed := args[1]
+			if err := cmd.Flags().Set(flags.FlagFrom, proposed); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := collection.MsgAcceptAdmin{
+				ContractId: args[0],
+				From:       proposed,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdRevokeGrant() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-grant [contract-id] [admin] [grantee] [permission]",
+		Args:  cobra.ExactArgs(4),
+		Short: "revoke a permission of a grantee by the admin",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s revoke-grant [contract-id] [admin] [grantee] [permission]`, version.AppName, collection.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			admin := args[1]
+			if err := cmd.Flags().Set(flags.FlagFrom, admin); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := collection.MsgRevokeGrant{
+				ContractId: args[0],
+				From:       admin,
+				Grantee:    args[2],
+				Permission: args[3],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -18,6 +18,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgOperatorSendFT{}, "lbm-sdk/MsgOperatorSendFT")
 	legacy.RegisterAminoMsg(cdc, &MsgSendNFT{}, "lbm-sdk/MsgSendNFT")
 	legacy.RegisterAminoMsg(cdc, &MsgOperatorSendNFT{}, "lbm-sdk/MsgOperatorSendNFT")
+	legacy.RegisterAminoMsg(cdc, &MsgMultiSendFT{}, "lbm-sdk/MsgMultiSendFT")
+	legacy.RegisterAminoMsg(cdc, &MsgMultiSendNFT{}, "lbm-sdk/MsgMultiSendNFT")
+	legacy.RegisterAminoMsg(cdc, &MsgSellNFT{}, "lbm-sdk/MsgSellNFT")
 	legacy.RegisterAminoMsg(cdc, &MsgAuthorizeOperator{}, "lbm-sdk/collection/MsgAuthorizeOperator") // Changed msgName due to conflict with `x/token`
 	legacy.RegisterAminoMsg(cdc, &MsgRevokeOperator{}, "lbm-sdk/collection/MsgRevokeOperator")       // Changed msgName due to conflict with `x/token`
 	legacy.RegisterAminoMsg(cdc, &MsgCreateContract{}, "lbm-sdk/MsgCreateContract")
@@ -36,6 +39,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgDetach{}, "lbm-sdk/MsgDetach")
 	legacy.RegisterAminoMsg(cdc, &MsgOperatorAttach{}, "lbm-sdk/MsgOperatorAttach")
 	legacy.RegisterAminoMsg(cdc, &MsgOperatorDetach{}, "lbm-sdk/MsgOperatorDetach")
+	legacy.RegisterAminoMsg(cdc, &MsgSetNFTHistoryEnabled{}, "lbm-sdk/MsgSetNFTHistoryEnabled")
+	legacy.RegisterAminoMsg(cdc, &MsgProposeAdmin{}, "lbm-sdk/collection/MsgProposeAdmin") // Changed msgName due to conflict with `x/token`
+	legacy.RegisterAminoMsg(cdc, &MsgAcceptAdmin{}, "lbm-sdk/collection/MsgAcceptAdmin")   // Changed msgName due to conflict with `x/token`
+	legacy.RegisterAminoMsg(cdc, &MsgRevokeGrant{}, "lbm-sdk/collection/MsgRevokeGrant")   // Changed msgName due to conflict with `x/token`
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -51,6 +58,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgOperatorSendFT{},
 		&MsgSendNFT{},
 		&MsgOperatorSendNFT{},
+		&MsgMultiSendFT{},
+		&MsgMultiSendNFT{},
+		&MsgSellNFT{},
 		&MsgAuthorizeOperator{},
 		&MsgRevokeOperator{},
 		&MsgBurnFT{},
@@ -62,6 +72,10 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgRevokePermission{},
 		&MsgOperatorAttach{},
 		&MsgOperatorDetach{},
+		&MsgSetNFTHistoryEnabled{},
+		&MsgProposeAdmin{},
+		&MsgAcceptAdmin{},
+		&MsgRevokeGrant{},
 	)
 
 	registry.RegisterInterface(
@@ -4,11 +4,14 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	proto "github.com/gogo/protobuf/proto"
 
 	codectypes "github.com/Finschia/finschia-sdk/codec/types"
+	"github.com/Finschia/finschia-sdk/internal/vesting"
 	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 )
 
 const (
@@ -35,7 +38,13 @@ func DefaultNextClassIDs(contractID string) NextClassIDs {
 }
 
 func validateParams(params Params) error {
-	// limits are uint32, so no need to validate them.
+	// limits are uint32, so no need to validate their signs.
+	if params.MaxBatchSize == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("max batch size cannot be zero")
+	}
+	if params.NftHistoryRetention < 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("nft history retention cannot be negative: %s", params.NftHistoryRetention)
+	}
 	return nil
 }
 
@@ -140,7 +149,236 @@ func (c NFTClass) ValidateBasic() error {
 	if err := validateMeta(c.Meta); err != nil {
 		return err
 	}
+	if c.Royalty != nil {
+		if err := c.Royalty.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	if err := validateTraitSchema(c.TraitSchema); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Royalty
+const MaxRoyaltyBasisPoints = 10000
+
+func (r Royalty) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(r.Recipient); err != nil {
+		return ErrInvalidRoyalty.Wrapf("invalid recipient address: %s", r.Recipient)
+	}
+	if r.BasisPoints == 0 || r.BasisPoints > MaxRoyaltyBasisPoints {
+		return ErrInvalidRoyalty.Wrapf("basis points must be in the range of 1 ~ %d: %d", MaxRoyaltyBasisPoints, r.BasisPoints)
+	}
+	return nil
+}
+
+// Amount returns the royalty paid out of the given sale price.
+func (r Royalty) Amount(price sdk.Coin) sdk.Coin {
+	amount := price.Amount.MulRaw(int64(r.BasisPoints)).QuoRaw(MaxRoyaltyBasisPoints)
+	return sdk.NewCoin(price.Denom, amount)
+}
+
+// ----------------------------------------------------------------------------
+// Trait
+const (
+	// TraitChangeKeyPrefix is the prefix of the change keys on the traits of a non-fungible token.
+	TraitChangeKeyPrefix = "trait."
+
+	traitSchemaLimit = 32
+	// the value is limited in bytes, not in runes, so it fits in the store key.
+	traitValueByteLimit = 128
+)
+
+var reTraitKey = regexp.MustCompile(`^[a-z0-9_]{1,32}$`)
+
+func ValidateTraitKey(key string) error {
+	if !reTraitKey.MatchString(key) {
+		return ErrInvalidTrait.Wrapf("invalid key: %s", key)
+	}
+	return nil
+}
+
+func (x TraitType) ValidateBasic() error {
+	if _, ok := TraitType_name[int32(x)]; !ok || x == TraitTypeUnspecified {
+		return ErrInvalidTrait.Wrapf("invalid trait type: %s", x)
+	}
+	return nil
+}
+
+// ValidateValue checks the value is of the type.
+func (x TraitType) ValidateValue(value string) error {
+	switch x {
+	case TraitTypeInteger:
+		parsed, ok := sdk.NewIntFromString(value)
+		if !ok || parsed.String() != value {
+			return ErrInvalidTrait.Wrapf("not an integer in the canonical form: %s", value)
+		}
+	case TraitTypeBoolean:
+		if value != "true" && value != "false" {
+			return ErrInvalidTrait.Wrapf("not a boolean: %s", value)
+		}
+	}
+	return nil
+}
+
+func (d TraitDefinition) ValidateBasic() error {
+	if err := ValidateTraitKey(d.Key); err != nil {
+		return err
+	}
+	return d.Type.ValidateBasic()
+}
+
+func validateTraitSchema(schema []TraitDefinition) error {
+	if len(schema) > traitSchemaLimit {
+		return ErrInvalidTrait.Wrapf("the number of traits exceeds the limit: %d > %d", len(schema), traitSchemaLimit)
+	}
+
+	seenKeys := map[string]bool{}
+	for _, definition := range schema {
+		if err := definition.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if seenKeys[definition.Key] {
+			return ErrInvalidTrait.Wrapf("duplicate key: %s", definition.Key)
+		}
+		seenKeys[definition.Key] = true
+	}
+
+	return nil
+}
+
+func ValidateTraitValue(value string) error {
+	if len(value) == 0 {
+		return ErrInvalidTrait.Wrap("empty value")
+	}
+	if length := len(value); length > traitValueByteLimit {
+		return ErrInvalidTrait.Wrapf("value cannot exceed %d bytes in length: current %d", traitValueByteLimit, length)
+	}
+	return nil
+}
+
+// validateTraits checks the traits without their schema.
+func validateTraits(traits []Attribute) error {
+	if len(traits) > traitSchemaLimit {
+		return ErrInvalidTrait.Wrapf("the number of traits exceeds the limit: %d > %d", len(traits), traitSchemaLimit)
+	}
+
+	seenKeys := map[string]bool{}
+	for _, trait := range traits {
+		if err := ValidateTraitKey(trait.Key); err != nil {
+			return err
+		}
+		if err := ValidateTraitValue(trait.Value); err != nil {
+			return err
+		}
+
+		if seenKeys[trait.Key] {
+			return ErrInvalidTrait.Wrapf("duplicate key: %s", trait.Key)
+		}
+		seenKeys[trait.Key] = true
+	}
+
+	return nil
+}
+
+// ValidateTraits checks the traits conform to the schema.
+func ValidateTraits(schema []TraitDefinition, traits []Attribute) error {
+	if err := validateTraits(traits); err != nil {
+		return err
+	}
+
+	definitions := make(map[string]TraitDefinition, len(schema))
+	for _, definition := range schema {
+		definitions[definition.Key] = definition
+	}
+
+	seenKeys := map[string]bool{}
+	for _, trait := range traits {
+		definition, ok := definitions[trait.Key]
+		if !ok {
+			return ErrInvalidTrait.Wrapf("not in the schema: %s", trait.Key)
+		}
+		if err := definition.Type.ValidateValue(trait.Value); err != nil {
+			return err
+		}
+		seenKeys[trait.Key] = true
+	}
+
+	for _, definition := range schema {
+		if definition.Required && !seenKeys[definition.Key] {
+			return ErrInvalidTrait.Wrapf("missing required trait: %s", definition.Key)
+		}
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Lock
+func (s VestingSchedule) ValidateBasic() error {
+	return vesting.ValidateSchedule(s.StartTime, s.EndTime)
+}
+
+// LockedAmount returns the part of the given amount, which is still locked at the given time.
+func (s VestingSchedule) LockedAmount(amount sdk.Int, now time.Time) sdk.Int {
+	return vesting.LockedAmount(s.StartTime, s.EndTime, amount, now)
+}
+
+func (l Lock) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(l.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", l.Address)
+	}
+	coin := Coin{
+		TokenId: l.TokenId,
+		Amount:  l.Amount,
+	}
+	if err := coin.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := l.Schedule.ValidateBasic(); err != nil {
+		return err
+	}
+	return nil
+}
 
+// ----------------------------------------------------------------------------
+// NFT history
+func (x NFTAction) ValidateBasic() error {
+	if _, ok := NFTAction_name[int32(x)]; !ok || x == NFTActionUnspecified {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid nft action: %s", x)
+	}
+	return nil
+}
+
+func (e NFTHistoryEntry) ValidateBasic() error {
+	if err := ValidateNFTID(e.TokenId); err != nil {
+		return err
+	}
+	if err := e.Action.ValidateBasic(); err != nil {
+		return err
+	}
+
+	for _, addr := range []string{e.From, e.To} {
+		if len(addr) == 0 {
+			continue
+		}
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", addr)
+		}
+	}
+	if len(e.Parent) != 0 {
+		if err := ValidateNFTID(e.Parent); err != nil {
+			return err
+		}
+	}
+
+	if e.Height < 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("height cannot be negative: %d", e.Height)
+	}
 	return nil
 }
 
@@ -8,16 +8,21 @@ import (
 	github_com_Finschia_finschia_sdk_types "github.com/Finschia/finschia-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,6 +30,86 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TraitType enumerates the types of the trait values.
+type TraitType int32
+
+const (
+	// TRAIT_TYPE_UNSPECIFIED defines the default type.
+	TraitTypeUnspecified TraitType = 0
+	// TRAIT_TYPE_STRING defines the type of arbitrary strings.
+	TraitTypeString TraitType = 1
+	// TRAIT_TYPE_INTEGER defines the type of integers in the canonical decimal form (e.g. "-42").
+	TraitTypeInteger TraitType = 2
+	// TRAIT_TYPE_BOOLEAN defines the type of booleans ("true" or "false").
+	TraitTypeBoolean TraitType = 3
+)
+
+var TraitType_name = map[int32]string{
+	0: "TRAIT_TYPE_UNSPECIFIED",
+	1: "TRAIT_TYPE_STRING",
+	2: "TRAIT_TYPE_INTEGER",
+	3: "TRAIT_TYPE_BOOLEAN",
+}
+
+var TraitType_value = map[string]int32{
+	"TRAIT_TYPE_UNSPECIFIED": 0,
+	"TRAIT_TYPE_STRING":      1,
+	"TRAIT_TYPE_INTEGER":     2,
+	"TRAIT_TYPE_BOOLEAN":     3,
+}
+
+func (x TraitType) String() string {
+	return proto.EnumName(TraitType_name, int32(x))
+}
+
+func (TraitType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_bb15fea9f4c37044, []int{0}
+}
+
+// NFTAction enumerates the actions recorded in the history of non-fungible tokens.
+type NFTAction int32
+
+const (
+	// NFT_ACTION_UNSPECIFIED defines the default action.
+	NFTActionUnspecified NFTAction = 0
+	// NFT_ACTION_MINT defines the action of minting the token.
+	NFTActionMint NFTAction = 1
+	// NFT_ACTION_TRANSFER defines the action of transferring the token (or its root).
+	NFTActionTransfer NFTAction = 2
+	// NFT_ACTION_ATTACH defines the action of attaching the token to another token.
+	NFTActionAttach NFTAction = 3
+	// NFT_ACTION_DETACH defines the action of detaching the token from its parent.
+	NFTActionDetach NFTAction = 4
+	// NFT_ACTION_BURN defines the action of burning the token (or its root).
+	NFTActionBurn NFTAction = 5
+)
+
+var NFTAction_name = map[int32]string{
+	0: "NFT_ACTION_UNSPECIFIED",
+	1: "NFT_ACTION_MINT",
+	2: "NFT_ACTION_TRANSFER",
+	3: "NFT_ACTION_ATTACH",
+	4: "NFT_ACTION_DETACH",
+	5: "NFT_ACTION_BURN",
+}
+
+var NFTAction_value = map[string]int32{
+	"NFT_ACTION_UNSPECIFIED": 0,
+	"NFT_ACTION_MINT":        1,
+	"NFT_ACTION_TRANSFER":    2,
+	"NFT_ACTION_ATTACH":      3,
+	"NFT_ACTION_DETACH":      4,
+	"NFT_ACTION_BURN":        5,
+}
+
+func (x NFTAction) String() string {
+	return proto.EnumName(NFTAction_name, int32(x))
+}
+
+func (NFTAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_bb15fea9f4c37044, []int{1}
+}
+
 // Permission enumerates the valid permissions on a contract.
 type Permission int32
 
@@ -62,7 +147,7 @@ func (x Permission) String() string {
 }
 
 func (Permission) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_bb15fea9f4c37044, []int{0}
+	return fileDescriptor_bb15fea9f4c37044, []int{2}
 }
 
 // Deprecated: use Permission
@@ -100,13 +185,18 @@ var LegacyPermission_value = map[string]int32{
 }
 
 func (LegacyPermission) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_bb15fea9f4c37044, []int{1}
+	return fileDescriptor_bb15fea9f4c37044, []int{3}
 }
 
 // Params defines the parameters for the collection module.
 type Params struct {
 	DepthLimit uint32 `protobuf:"varint,1,opt,name=depth_limit,json=depthLimit,proto3" json:"depth_limit,omitempty"`
 	WidthLimit uint32 `protobuf:"varint,2,opt,name=width_limit,json=widthLimit,proto3" json:"width_limit,omitempty"`
+	// max number of the outputs in a batch transfer.
+	MaxBatchSize uint32 `protobuf:"varint,3,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
+	// retention of the nft history entries, after which the entries are pruned.
+	// Note: zero means the entries are kept forever.
+	NftHistoryRetention time.Duration `protobuf:"bytes,4,opt,name=nft_history_retention,json=nftHistoryRetention,proto3,stdduration" json:"nft_history_retention"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -152,6 +242,14 @@ type Contract struct {
 	Meta string `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
 	// uri for the contract image stored off chain.
 	Uri string `protobuf:"bytes,4,opt,name=uri,proto3" json:"uri,omitempty"`
+	// schema of the traits, which applies to all the non-fungible tokens of the contract.
+	TraitSchema []TraitDefinition `protobuf:"bytes,5,rep,name=trait_schema,json=traitSchema,proto3" json:"trait_schema"`
+	// address of the admin, which can grant or revoke any permission on the contract.
+	// Note: it would be empty for the contracts created before the admin was introduced,
+	// which had more than one grantee of the modify permission.
+	Admin string `protobuf:"bytes,6,opt,name=admin,proto3" json:"admin,omitempty"`
+	// address of the account proposed as the next admin, which must accept the role (optional).
+	PendingAdmin string `protobuf:"bytes,7,opt,name=pending_admin,json=pendingAdmin,proto3" json:"pending_admin,omitempty"`
 }
 
 func (m *Contract) Reset()         { *m = Contract{} }
@@ -284,6 +382,10 @@ type NFTClass struct {
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	// meta is a brief description of the token class.
 	Meta string `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
+	// royalty paid on the sales of the tokens of the class (optional).
+	Royalty *Royalty `protobuf:"bytes,4,opt,name=royalty,proto3" json:"royalty,omitempty"`
+	// schema of the traits, which applies to the tokens of the class in addition to the schema of the contract.
+	TraitSchema []TraitDefinition `protobuf:"bytes,5,rep,name=trait_schema,json=traitSchema,proto3" json:"trait_schema"`
 }
 
 func (m *NFTClass) Reset()         { *m = NFTClass{} }
@@ -340,6 +442,106 @@ func (m *NFTClass) GetMeta() string {
 	return ""
 }
 
+func (m *NFTClass) GetRoyalty() *Royalty {
+	if m != nil {
+		return m.Royalty
+	}
+	return nil
+}
+
+func (m *NFTClass) GetTraitSchema() []TraitDefinition {
+	if m != nil {
+		return m.TraitSchema
+	}
+	return nil
+}
+
+// Royalty defines the royalty paid to the recipient on the sales of non-fungible tokens.
+type Royalty struct {
+	// address which receives the royalty.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// royalty rate in basis points (1/10000) of the sale price.
+	// Note: it must be in the range of 1 ~ 10000.
+	BasisPoints uint32 `protobuf:"varint,2,opt,name=basis_points,json=basisPoints,proto3" json:"basis_points,omitempty"`
+}
+
+func (m *Royalty) Reset()         { *m = Royalty{} }
+func (m *Royalty) String() string { return proto.CompactTextString(m) }
+func (*Royalty) ProtoMessage()    {}
+func (*Royalty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb15fea9f4c37044, []int{4}
+}
+func (m *Royalty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Royalty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Royalty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Royalty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Royalty.Merge(m, src)
+}
+func (m *Royalty) XXX_Size() int {
+	return m.Size()
+}
+func (m *Royalty) XXX_DiscardUnknown() {
+	xxx_messageInfo_Royalty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Royalty proto.InternalMessageInfo
+
+// TraitDefinition defines a trait in the trait schema.
+type TraitDefinition struct {
+	// key of the trait.
+	// Note: it consists of lowercase alphanumerics and underscores, up to 32 in length.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// type of the trait value.
+	Type TraitType `protobuf:"varint,2,opt,name=type,proto3,enum=lbm.collection.v1.TraitType" json:"type,omitempty"`
+	// required represents whether every non-fungible token must have the trait.
+	Required bool `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+}
+
+func (m *TraitDefinition) Reset()         { *m = TraitDefinition{} }
+func (m *TraitDefinition) String() string { return proto.CompactTextString(m) }
+func (*TraitDefinition) ProtoMessage()    {}
+func (*TraitDefinition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb15fea9f4c37044, []int{5}
+}
+func (m *TraitDefinition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TraitDefinition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TraitDefinition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TraitDefinition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraitDefinition.Merge(m, src)
+}
+func (m *TraitDefinition) XXX_Size() int {
+	return m.Size()
+}
+func (m *TraitDefinition) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraitDefinition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TraitDefinition proto.InternalMessageInfo
+
 // NFT defines the information of non-fungible token.
 //
 // Since: 0.46.0 (finschia)
@@ -350,13 +552,15 @@ type NFT struct {
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	// meta is a brief description of the token.
 	Meta string `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
+	// traits of the token, which conform to the trait schema of the contract and the class.
+	Traits []Attribute `protobuf:"bytes,4,rep,name=traits,proto3" json:"traits"`
 }
 
 func (m *NFT) Reset()         { *m = NFT{} }
 func (m *NFT) String() string { return proto.CompactTextString(m) }
 func (*NFT) ProtoMessage()    {}
 func (*NFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb15fea9f4c37044, []int{4}
+	return fileDescriptor_bb15fea9f4c37044, []int{6}
 }
 func (m *NFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -385,6 +589,188 @@ func (m *NFT) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NFT proto.InternalMessageInfo
 
+// Holder defines the balance of a token held by an address.
+type Holder struct {
+	// address of the holder.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// amount of the token held.
+	Amount github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"amount"`
+}
+
+func (m *Holder) Reset()         { *m = Holder{} }
+func (m *Holder) String() string { return proto.CompactTextString(m) }
+func (*Holder) ProtoMessage()    {}
+func (*Holder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb15fea9f4c37044, []int{7}
+}
+func (m *Holder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Holder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Holder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Holder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Holder.Merge(m, src)
+}
+func (m *Holder) XXX_Size() int {
+	return m.Size()
+}
+func (m *Holder) XXX_DiscardUnknown() {
+	xxx_messageInfo_Holder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Holder proto.InternalMessageInfo
+
+// OwnedNFT defines a non-fungible token along with the contract it belongs to.
+type OwnedNFT struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// information of the token.
+	Nft NFT `protobuf:"bytes,2,opt,name=nft,proto3" json:"nft"`
+}
+
+func (m *OwnedNFT) Reset()         { *m = OwnedNFT{} }
+func (m *OwnedNFT) String() string { return proto.CompactTextString(m) }
+func (*OwnedNFT) ProtoMessage()    {}
+func (*OwnedNFT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb15fea9f4c37044, []int{8}
+}
+func (m *OwnedNFT) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OwnedNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OwnedNFT.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OwnedNFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OwnedNFT.Merge(m, src)
+}
+func (m *OwnedNFT) XXX_Size() int {
+	return m.Size()
+}
+func (m *OwnedNFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_OwnedNFT.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OwnedNFT proto.InternalMessageInfo
+
+// NFTHistoryEntry defines an entry of the history of a non-fungible token.
+type NFTHistoryEntry struct {
+	// token id associated with the non-fungible token.
+	TokenId string `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// action applied to the token.
+	Action NFTAction `protobuf:"varint,2,opt,name=action,proto3,enum=lbm.collection.v1.NFTAction" json:"action,omitempty"`
+	// address of the previous owner.
+	// Note: it would be empty for NFT_ACTION_MINT, NFT_ACTION_ATTACH and NFT_ACTION_DETACH.
+	From string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// address of the new owner.
+	// Note: it would be empty for NFT_ACTION_BURN, NFT_ACTION_ATTACH and NFT_ACTION_DETACH.
+	To string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	// token id of the parent which the token is attached to or detached from.
+	// Note: it would be empty for the other actions.
+	Parent string `protobuf:"bytes,5,opt,name=parent,proto3" json:"parent,omitempty"`
+	// height of the block at which the action was applied.
+	Height int64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	// time of the block at which the action was applied.
+	Time time.Time `protobuf:"bytes,7,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *NFTHistoryEntry) Reset()         { *m = NFTHistoryEntry{} }
+func (m *NFTHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*NFTHistoryEntry) ProtoMessage()    {}
+func (*NFTHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb15fea9f4c37044, []int{9}
+}
+func (m *NFTHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NFTHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NFTHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NFTHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NFTHistoryEntry.Merge(m, src)
+}
+func (m *NFTHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *NFTHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_NFTHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NFTHistoryEntry proto.InternalMessageInfo
+
+// AdminHistoryEntry defines an entry of the admin history of a contract.
+type AdminHistoryEntry struct {
+	// address of the previous admin.
+	// Note: it would be empty for the entry recorded on the creation.
+	PreviousAdmin string `protobuf:"bytes,1,opt,name=previous_admin,json=previousAdmin,proto3" json:"previous_admin,omitempty"`
+	// address of the new admin.
+	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+	// height of the block at which the admin has changed.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// time of the block at which the admin has changed.
+	Time time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *AdminHistoryEntry) Reset()         { *m = AdminHistoryEntry{} }
+func (m *AdminHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*AdminHistoryEntry) ProtoMessage()    {}
+func (*AdminHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb15fea9f4c37044, []int{10}
+}
+func (m *AdminHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminHistoryEntry.Merge(m, src)
+}
+func (m *AdminHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminHistoryEntry proto.InternalMessageInfo
+
 // Deprecated: use NFT
 //
 // OwnerNFT defines the information of non-fungible token.
@@ -405,7 +791,7 @@ func (m *OwnerNFT) Reset()         { *m = OwnerNFT{} }
 func (m *OwnerNFT) String() string { return proto.CompactTextString(m) }
 func (*OwnerNFT) ProtoMessage()    {}
 func (*OwnerNFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb15fea9f4c37044, []int{5}
+	return fileDescriptor_bb15fea9f4c37044, []int{11}
 }
 func (m *OwnerNFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -456,7 +842,7 @@ func (m *FT) Reset()         { *m = FT{} }
 func (m *FT) String() string { return proto.CompactTextString(m) }
 func (*FT) ProtoMessage()    {}
 func (*FT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb15fea9f4c37044, []int{6}
+	return fileDescriptor_bb15fea9f4c37044, []int{12}
 }
 func (m *FT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -507,7 +893,7 @@ func (m *TokenType) Reset()         { *m = TokenType{} }
 func (m *TokenType) String() string { return proto.CompactTextString(m) }
 func (*TokenType) ProtoMessage()    {}
 func (*TokenType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb15fea9f4c37044, []int{7}
+	return fileDescriptor_bb15fea9f4c37044, []int{13}
 }
 func (m *TokenType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -547,7 +933,7 @@ type Coin struct {
 func (m *Coin) Reset()      { *m = Coin{} }
 func (*Coin) ProtoMessage() {}
 func (*Coin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb15fea9f4c37044, []int{8}
+	return fileDescriptor_bb15fea9f4c37044, []int{14}
 }
 func (m *Coin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -576,6 +962,95 @@ func (m *Coin) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Coin proto.InternalMessageInfo
 
+// VestingSchedule defines a schedule on which the locked tokens are unlocked.
+// The tokens are locked until start_time, and then unlocked linearly until
+// end_time. If start_time equals to end_time, the tokens are unlocked at once
+// on end_time.
+type VestingSchedule struct {
+	// start_time is the time from which the tokens start to be unlocked.
+	StartTime time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time is the time at which all the tokens are unlocked.
+	EndTime time.Time `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *VestingSchedule) Reset()         { *m = VestingSchedule{} }
+func (m *VestingSchedule) String() string { return proto.CompactTextString(m) }
+func (*VestingSchedule) ProtoMessage()    {}
+func (*VestingSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb15fea9f4c37044, []int{15}
+}
+func (m *VestingSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingSchedule.Merge(m, src)
+}
+func (m *VestingSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingSchedule proto.InternalMessageInfo
+
+// Lock defines tokens of a holder, which cannot be sent until unlocked.
+type Lock struct {
+	// address of the token holder.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// token id associated with the token.
+	TokenId string `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// amount of the tokens locked at the beginning of the schedule.
+	Amount github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"amount"`
+	// schedule on which the tokens are unlocked.
+	Schedule VestingSchedule `protobuf:"bytes,4,opt,name=schedule,proto3" json:"schedule"`
+}
+
+func (m *Lock) Reset()         { *m = Lock{} }
+func (m *Lock) String() string { return proto.CompactTextString(m) }
+func (*Lock) ProtoMessage()    {}
+func (*Lock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb15fea9f4c37044, []int{16}
+}
+func (m *Lock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Lock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Lock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Lock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Lock.Merge(m, src)
+}
+func (m *Lock) XXX_Size() int {
+	return m.Size()
+}
+func (m *Lock) XXX_DiscardUnknown() {
+	xxx_messageInfo_Lock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Lock proto.InternalMessageInfo
+
 // Grant defines permission given to a grantee.
 //
 // Since: 0.46.0 (finschia)
@@ -590,7 +1065,7 @@ func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb15fea9f4c37044, []int{9}
+	return fileDescriptor_bb15fea9f4c37044, []int{17}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -633,7 +1108,7 @@ func (m *Authorization) Reset()         { *m = Authorization{} }
 func (m *Authorization) String() string { return proto.CompactTextString(m) }
 func (*Authorization) ProtoMessage()    {}
 func (*Authorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb15fea9f4c37044, []int{10}
+	return fileDescriptor_bb15fea9f4c37044, []int{18}
 }
 func (m *Authorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -674,7 +1149,7 @@ func (m *Attribute) Reset()         { *m = Attribute{} }
 func (m *Attribute) String() string { return proto.CompactTextString(m) }
 func (*Attribute) ProtoMessage()    {}
 func (*Attribute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb15fea9f4c37044, []int{11}
+	return fileDescriptor_bb15fea9f4c37044, []int{19}
 }
 func (m *Attribute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -704,17 +1179,27 @@ func (m *Attribute) XXX_DiscardUnknown() {
 var xxx_messageInfo_Attribute proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("lbm.collection.v1.TraitType", TraitType_name, TraitType_value)
+	proto.RegisterEnum("lbm.collection.v1.NFTAction", NFTAction_name, NFTAction_value)
 	proto.RegisterEnum("lbm.collection.v1.Permission", Permission_name, Permission_value)
 	proto.RegisterEnum("lbm.collection.v1.LegacyPermission", LegacyPermission_name, LegacyPermission_value)
 	proto.RegisterType((*Params)(nil), "lbm.collection.v1.Params")
 	proto.RegisterType((*Contract)(nil), "lbm.collection.v1.Contract")
 	proto.RegisterType((*FTClass)(nil), "lbm.collection.v1.FTClass")
 	proto.RegisterType((*NFTClass)(nil), "lbm.collection.v1.NFTClass")
+	proto.RegisterType((*Royalty)(nil), "lbm.collection.v1.Royalty")
+	proto.RegisterType((*TraitDefinition)(nil), "lbm.collection.v1.TraitDefinition")
 	proto.RegisterType((*NFT)(nil), "lbm.collection.v1.NFT")
+	proto.RegisterType((*Holder)(nil), "lbm.collection.v1.Holder")
+	proto.RegisterType((*OwnedNFT)(nil), "lbm.collection.v1.OwnedNFT")
+	proto.RegisterType((*NFTHistoryEntry)(nil), "lbm.collection.v1.NFTHistoryEntry")
+	proto.RegisterType((*AdminHistoryEntry)(nil), "lbm.collection.v1.AdminHistoryEntry")
 	proto.RegisterType((*OwnerNFT)(nil), "lbm.collection.v1.OwnerNFT")
 	proto.RegisterType((*FT)(nil), "lbm.collection.v1.FT")
 	proto.RegisterType((*TokenType)(nil), "lbm.collection.v1.TokenType")
 	proto.RegisterType((*Coin)(nil), "lbm.collection.v1.Coin")
+	proto.RegisterType((*VestingSchedule)(nil), "lbm.collection.v1.VestingSchedule")
+	proto.RegisterType((*Lock)(nil), "lbm.collection.v1.Lock")
 	proto.RegisterType((*Grant)(nil), "lbm.collection.v1.Grant")
 	proto.RegisterType((*Authorization)(nil), "lbm.collection.v1.Authorization")
 	proto.RegisterType((*Attribute)(nil), "lbm.collection.v1.Attribute")
@@ -725,62 +1210,110 @@ func init() {
 }
 
 var fileDescriptor_bb15fea9f4c37044 = []byte{
-	// 875 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xb6, 0xf3, 0xab, 0xc9, 0x5b, 0x6d, 0xd7, 0x6b, 0x4a, 0x49, 0x8d, 0xea, 0x58, 0xbe, 0xb0,
-	0x14, 0x35, 0xd1, 0xee, 0x02, 0x42, 0x95, 0x38, 0x34, 0xd9, 0x64, 0xf1, 0xaa, 0x4d, 0x2b, 0x27,
-	0x45, 0x5a, 0x2e, 0xc1, 0xb1, 0xa7, 0xc9, 0xa8, 0xb6, 0x27, 0xb2, 0x27, 0x2d, 0xe1, 0x2f, 0x58,
-	0x45, 0x42, 0x70, 0xe4, 0x12, 0xa9, 0x12, 0x1c, 0x56, 0xe2, 0xba, 0x67, 0xce, 0x3d, 0xae, 0xf6,
-	0x84, 0x38, 0xac, 0xa0, 0xbd, 0x70, 0xe7, 0x1f, 0x40, 0x33, 0x76, 0x12, 0x93, 0x86, 0xdd, 0x15,
-	0x48, 0xdc, 0xde, 0x7b, 0xf3, 0x7d, 0xdf, 0x7b, 0xfe, 0x9e, 0xed, 0x01, 0xdd, 0xed, 0x7a, 0x15,
-	0x9b, 0xb8, 0x2e, 0xb2, 0x29, 0x26, 0x7e, 0xe5, 0xf4, 0x6e, 0x22, 0x2b, 0x0f, 0x02, 0x42, 0x89,
-	0x7c, 0xdb, 0xed, 0x7a, 0xe5, 0x44, 0xf5, 0xf4, 0xae, 0xb2, 0xd6, 0x23, 0x3d, 0xc2, 0x4f, 0x2b,
-	0x2c, 0x8a, 0x80, 0xca, 0x86, 0x4d, 0x42, 0x8f, 0x84, 0x9d, 0xe8, 0x20, 0x4a, 0xa2, 0x23, 0xfd,
-	0x11, 0xe4, 0x0e, 0xad, 0xc0, 0xf2, 0x42, 0xb9, 0x04, 0x37, 0x1c, 0x34, 0xa0, 0xfd, 0x8e, 0x8b,
-	0x3d, 0x4c, 0x8b, 0xa2, 0x26, 0xde, 0xb9, 0x69, 0x02, 0x2f, 0xed, 0xb1, 0x0a, 0x03, 0x9c, 0x61,
-	0x67, 0x06, 0x48, 0x45, 0x00, 0x5e, 0xe2, 0x00, 0xbd, 0x0d, 0xf9, 0x1a, 0xf1, 0x69, 0x60, 0xd9,
-	0x54, 0x5e, 0x85, 0x14, 0x76, 0xb8, 0x48, 0xc1, 0x4c, 0x61, 0x47, 0x96, 0x21, 0xe3, 0x5b, 0x1e,
-	0xe2, 0xac, 0x82, 0xc9, 0x63, 0x56, 0xf3, 0x10, 0xb5, 0x8a, 0xe9, 0xa8, 0xc6, 0x62, 0x59, 0x82,
-	0xf4, 0x30, 0xc0, 0xc5, 0x0c, 0x2f, 0xb1, 0x50, 0xff, 0x46, 0x84, 0x95, 0x46, 0xbb, 0xe6, 0x5a,
-	0x61, 0xf8, 0xaf, 0x55, 0x15, 0xc8, 0x3b, 0xc8, 0xc6, 0x9e, 0xe5, 0x86, 0x5c, 0x3a, 0x6b, 0xce,
-	0x72, 0x76, 0xe6, 0x61, 0x9f, 0x5a, 0x5d, 0x17, 0x15, 0xb3, 0x9a, 0x78, 0x27, 0x6f, 0xce, 0xf2,
-	0x1d, 0xf9, 0xc9, 0x79, 0x49, 0x7c, 0xf1, 0x6c, 0x1b, 0xda, 0xe4, 0x04, 0xf9, 0x7c, 0x06, 0xfd,
-	0x73, 0xc8, 0x37, 0xff, 0xe3, 0x3c, 0x4b, 0x75, 0x3f, 0x83, 0x74, 0xb3, 0xd1, 0x96, 0x37, 0x20,
-	0x4f, 0x59, 0xb1, 0x33, 0x13, 0x5e, 0xe1, 0xb9, 0xf1, 0xc6, 0xea, 0xfa, 0xb7, 0x22, 0xe4, 0x0f,
-	0xce, 0x7c, 0x14, 0x30, 0xbd, 0x12, 0xdc, 0xb0, 0xe3, 0xa5, 0xcc, 0x25, 0x61, 0x5a, 0x32, 0x9c,
-	0xbf, 0x35, 0x4c, 0x2d, 0x6f, 0x98, 0x5e, 0xd2, 0x30, 0x93, 0xb0, 0x77, 0x0d, 0xb2, 0x84, 0xf5,
-	0xe3, 0xfe, 0x15, 0xcc, 0x28, 0xd9, 0x29, 0xbc, 0x78, 0xb6, 0x9d, 0xe5, 0x0f, 0xa8, 0xff, 0x24,
-	0x42, 0xea, 0x7f, 0x9a, 0x25, 0xb9, 0xea, 0xec, 0x2b, 0x56, 0x9d, 0x5b, 0x58, 0x75, 0x62, 0xda,
-	0x10, 0x0a, 0x3c, 0x68, 0x8f, 0x06, 0xe8, 0xf5, 0x33, 0x6f, 0x02, 0x44, 0x33, 0xd3, 0xd1, 0x60,
-	0xba, 0x9b, 0x02, 0x9d, 0xf1, 0xdf, 0x70, 0x6e, 0xfd, 0x0c, 0x32, 0x35, 0x82, 0xfd, 0x57, 0xed,
-	0xff, 0x11, 0xe4, 0x2c, 0x8f, 0x0c, 0xfd, 0xe8, 0xdb, 0x2b, 0x54, 0xef, 0x5d, 0xbc, 0x2c, 0x09,
-	0xbf, 0xbe, 0x2c, 0x6d, 0xf5, 0x30, 0xed, 0x0f, 0xbb, 0x65, 0x9b, 0x78, 0x95, 0x06, 0xf6, 0x43,
-	0xbb, 0x8f, 0xad, 0xca, 0x71, 0x1c, 0x6c, 0x87, 0xce, 0x49, 0x85, 0x8d, 0x16, 0x96, 0x0d, 0x9f,
-	0x9a, 0xb1, 0xc2, 0x4e, 0xfe, 0xfb, 0xf3, 0x92, 0xf0, 0xc7, 0x79, 0x49, 0xd4, 0xbf, 0x84, 0xec,
-	0xc3, 0xc0, 0xf2, 0xa9, 0x5c, 0x84, 0x95, 0x1e, 0x0b, 0x10, 0x9a, 0x36, 0x8e, 0x53, 0xf9, 0x53,
-	0x80, 0x01, 0x0a, 0x3c, 0x1c, 0x86, 0x98, 0xf8, 0xbc, 0xf9, 0xea, 0xbd, 0xcd, 0xf2, 0xb5, 0xbf,
-	0x4f, 0xf9, 0x70, 0x06, 0x32, 0x13, 0x04, 0xbd, 0x06, 0x37, 0x77, 0x87, 0xb4, 0x4f, 0x02, 0xfc,
-	0xb5, 0xc5, 0xa0, 0xf2, 0x3a, 0xe4, 0xfa, 0xc4, 0x75, 0x50, 0x10, 0x37, 0x8a, 0x33, 0xb6, 0x1f,
-	0x32, 0x40, 0x81, 0x45, 0x49, 0x10, 0x1b, 0x39, 0xcb, 0xf5, 0xfb, 0x50, 0xd8, 0xa5, 0x34, 0xc0,
-	0xdd, 0x21, 0x45, 0xec, 0x2f, 0x71, 0x82, 0x46, 0x31, 0x9b, 0x85, 0xec, 0x15, 0x3c, 0xb5, 0xdc,
-	0xe1, 0x74, 0x01, 0x51, 0xb2, 0xf5, 0xa7, 0x08, 0x30, 0x1f, 0x4a, 0xfe, 0x08, 0xd6, 0x0f, 0xeb,
-	0xe6, 0xbe, 0xd1, 0x6a, 0x19, 0x07, 0xcd, 0xce, 0x51, 0xb3, 0x75, 0x58, 0xaf, 0x19, 0x0d, 0xa3,
-	0xfe, 0x40, 0x12, 0x94, 0x8d, 0xf1, 0x44, 0x7b, 0x7b, 0x8e, 0x3d, 0xf2, 0xc3, 0x01, 0xb2, 0xf1,
-	0x31, 0x46, 0x8e, 0xfc, 0x3e, 0x48, 0x09, 0x9a, 0xd1, 0x6a, 0x1d, 0xd5, 0x25, 0x51, 0x79, 0x6b,
-	0x3c, 0xd1, 0x6e, 0xcd, 0x09, 0x46, 0x18, 0x0e, 0x91, 0xfc, 0x01, 0xdc, 0x4e, 0x40, 0xf7, 0x0f,
-	0x1e, 0x18, 0x8d, 0xc7, 0x52, 0x4a, 0x59, 0x1b, 0x4f, 0x34, 0x69, 0x8e, 0xdd, 0x27, 0x0e, 0x3e,
-	0x1e, 0xc9, 0xef, 0xc1, 0xad, 0x24, 0xd8, 0x68, 0xb6, 0xa5, 0xb4, 0x22, 0x8f, 0x27, 0xda, 0x6a,
-	0x02, 0x8a, 0x7d, 0xba, 0x00, 0xac, 0x1e, 0x99, 0x4d, 0x29, 0xb3, 0x08, 0xac, 0x0e, 0x03, 0x5f,
-	0xc9, 0x3c, 0xf9, 0x41, 0x15, 0xb6, 0x7e, 0x4e, 0x81, 0xb4, 0x87, 0x7a, 0x96, 0x3d, 0x4a, 0x3c,
-	0x7b, 0x15, 0x36, 0xf7, 0xea, 0x0f, 0x77, 0x6b, 0x8f, 0x3b, 0xff, 0x68, 0x41, 0x69, 0x3c, 0xd1,
-	0xde, 0x5d, 0x24, 0x26, 0x8d, 0xf8, 0x18, 0xde, 0xb9, 0xae, 0x31, 0xf5, 0x83, 0x1b, 0xb8, 0xc8,
-	0x8e, 0x5c, 0xf9, 0x04, 0x8a, 0xd7, 0x79, 0x33, 0x73, 0x94, 0xf1, 0x44, 0x5b, 0x5f, 0x24, 0xc6,
-	0x16, 0x7d, 0x08, 0xeb, 0x4b, 0x98, 0x91, 0x53, 0xc5, 0xf1, 0x44, 0x5b, 0xbb, 0xc6, 0x63, 0x7e,
-	0x2d, 0x65, 0xc5, 0xb6, 0x2d, 0x65, 0x71, 0xf3, 0xf2, 0xcc, 0xbc, 0xa7, 0x3f, 0xaa, 0x42, 0xf5,
-	0xe0, 0xe2, 0x77, 0x55, 0x78, 0x7a, 0xa9, 0x0a, 0x17, 0x97, 0xaa, 0xf8, 0xfc, 0x52, 0x15, 0x7f,
-	0xbb, 0x54, 0xc5, 0xef, 0xae, 0x54, 0xe1, 0xf9, 0x95, 0x2a, 0xfc, 0x72, 0xa5, 0x0a, 0x5f, 0x6c,
-	0xbf, 0xf6, 0x93, 0xfb, 0x2a, 0x71, 0x5f, 0x77, 0x73, 0xfc, 0xb2, 0xbd, 0xff, 0x57, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x6e, 0xca, 0xf4, 0x49, 0xd6, 0x07, 0x00, 0x00,
+	// 1636 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcf, 0x6f, 0x23, 0x49,
+	0x15, 0x76, 0xfb, 0xb7, 0x5f, 0x26, 0x89, 0x53, 0x93, 0x09, 0x1e, 0xb3, 0x63, 0x9b, 0xe6, 0xd7,
+	0x30, 0x30, 0x36, 0x9b, 0x0d, 0x68, 0x15, 0x09, 0x21, 0xdb, 0xb1, 0x33, 0x5e, 0x12, 0x27, 0x6a,
+	0x77, 0x40, 0x03, 0x07, 0xd3, 0x76, 0x97, 0xed, 0x52, 0xdc, 0xd5, 0xde, 0xee, 0x72, 0x66, 0x3c,
+	0x67, 0x0e, 0x2b, 0x4b, 0x88, 0xbd, 0xb1, 0x17, 0x8b, 0x11, 0x70, 0x58, 0x89, 0xeb, 0x9e, 0x39,
+	0x0f, 0x07, 0xc4, 0x6a, 0x4f, 0x80, 0xd0, 0x02, 0x99, 0x0b, 0x47, 0x24, 0xfe, 0x01, 0x54, 0xd5,
+	0xe5, 0x76, 0x8f, 0xe3, 0xcd, 0xce, 0xb0, 0x2b, 0x6e, 0xf5, 0x5e, 0x7d, 0xaf, 0xfa, 0x7d, 0x5f,
+	0x55, 0x7d, 0x65, 0x19, 0xd4, 0x61, 0xc7, 0x2a, 0x75, 0xed, 0xe1, 0x10, 0x77, 0x19, 0xb1, 0x69,
+	0xe9, 0xe2, 0xf5, 0x40, 0x54, 0x1c, 0x39, 0x36, 0xb3, 0xd1, 0xd6, 0xb0, 0x63, 0x15, 0x03, 0xd9,
+	0x8b, 0xd7, 0xb3, 0xdb, 0x7d, 0xbb, 0x6f, 0x8b, 0xd9, 0x12, 0x1f, 0x79, 0xc0, 0xec, 0xed, 0xae,
+	0xed, 0x5a, 0xb6, 0xdb, 0xf6, 0x26, 0xbc, 0x40, 0x4e, 0xe5, 0xfa, 0xb6, 0xdd, 0x1f, 0xe2, 0x92,
+	0x88, 0x3a, 0xe3, 0x5e, 0xc9, 0x1c, 0x3b, 0xc6, 0xe2, 0x1b, 0xd9, 0xfc, 0xf2, 0x3c, 0x23, 0x16,
+	0x76, 0x99, 0x61, 0x8d, 0x3c, 0x80, 0xfa, 0x07, 0x05, 0xe2, 0xa7, 0x86, 0x63, 0x58, 0x2e, 0xca,
+	0xc3, 0x9a, 0x89, 0x47, 0x6c, 0xd0, 0x1e, 0x12, 0x8b, 0xb0, 0x8c, 0x52, 0x50, 0xee, 0xae, 0x6b,
+	0x20, 0x52, 0x47, 0x3c, 0xc3, 0x01, 0x8f, 0x88, 0xe9, 0x03, 0xc2, 0x1e, 0x40, 0xa4, 0x3c, 0xc0,
+	0x57, 0x60, 0xc3, 0x32, 0x1e, 0xb7, 0x3b, 0x06, 0xeb, 0x0e, 0xda, 0x2e, 0x79, 0x82, 0x33, 0x11,
+	0x81, 0xb9, 0x61, 0x19, 0x8f, 0x2b, 0x3c, 0xd9, 0x22, 0x4f, 0x30, 0xfa, 0x11, 0xdc, 0xa2, 0x3d,
+	0xd6, 0x1e, 0x10, 0x97, 0xd9, 0xce, 0xa4, 0xed, 0x60, 0x86, 0x29, 0x6f, 0x39, 0x13, 0x2d, 0x28,
+	0x77, 0xd7, 0x76, 0x6f, 0x17, 0xbd, 0x9e, 0x8b, 0xf3, 0x9e, 0x8b, 0x07, 0x92, 0x53, 0x25, 0xf9,
+	0xec, 0xe3, 0x7c, 0xe8, 0xbd, 0xbf, 0xe7, 0x15, 0xed, 0x26, 0xed, 0xb1, 0x07, 0xde, 0x02, 0xda,
+	0xbc, 0x5e, 0xfd, 0x9b, 0x02, 0xc9, 0xaa, 0x4d, 0x99, 0x63, 0x74, 0x19, 0xda, 0x80, 0x30, 0x31,
+	0x05, 0x89, 0x94, 0x16, 0x26, 0x26, 0x42, 0x10, 0xa5, 0x86, 0x85, 0x45, 0xd7, 0x29, 0x4d, 0x8c,
+	0x79, 0xce, 0xc2, 0xcc, 0x10, 0x5d, 0xa6, 0x34, 0x31, 0x46, 0x69, 0x88, 0x8c, 0x1d, 0x22, 0x7a,
+	0x49, 0x69, 0x7c, 0x88, 0x7e, 0x00, 0x37, 0x98, 0x63, 0x10, 0xd6, 0x76, 0xbb, 0x03, 0x6c, 0x19,
+	0x99, 0x58, 0x21, 0x72, 0x77, 0x6d, 0x57, 0x2d, 0x5e, 0xd9, 0xbe, 0xa2, 0xce, 0x61, 0x07, 0xb8,
+	0x47, 0x28, 0x11, 0xfd, 0x46, 0x79, 0xbf, 0xda, 0x9a, 0xa8, 0x6e, 0x89, 0x62, 0xb4, 0x0d, 0x31,
+	0xc3, 0xb4, 0x08, 0xcd, 0xc4, 0xc5, 0x07, 0xbc, 0x00, 0x7d, 0x19, 0xd6, 0x47, 0x98, 0x9a, 0x84,
+	0xf6, 0xdb, 0xde, 0x6c, 0x42, 0xcc, 0xde, 0x90, 0xc9, 0x32, 0xcf, 0xa9, 0x3f, 0x57, 0x20, 0x51,
+	0xd7, 0xab, 0x43, 0xc3, 0x75, 0xff, 0x67, 0x76, 0x59, 0x48, 0x9a, 0xb8, 0x4b, 0x2c, 0x63, 0xe8,
+	0x0a, 0x8a, 0x31, 0xcd, 0x8f, 0xf9, 0x9c, 0x45, 0x28, 0x33, 0x3a, 0x43, 0x9c, 0x89, 0x15, 0x94,
+	0xbb, 0x49, 0xcd, 0x8f, 0xf7, 0xd1, 0x3b, 0x4f, 0xf3, 0xca, 0x47, 0x1f, 0xdc, 0x07, 0xdd, 0x3e,
+	0xc7, 0x54, 0xf4, 0xa0, 0xfe, 0x45, 0x81, 0x64, 0xf3, 0xb3, 0x36, 0xb4, 0x07, 0x09, 0xc7, 0x9e,
+	0x18, 0x43, 0x36, 0x91, 0xdb, 0x9f, 0x5d, 0xa1, 0xab, 0xe6, 0x21, 0xb4, 0x39, 0xf4, 0x73, 0xdd,
+	0x92, 0x95, 0xdc, 0xde, 0x82, 0x84, 0xfc, 0x28, 0x7a, 0x0d, 0x52, 0x0e, 0xee, 0x92, 0x11, 0xc1,
+	0x94, 0x49, 0x82, 0x8b, 0x04, 0xfa, 0x12, 0xdc, 0xe8, 0x18, 0x2e, 0x71, 0xdb, 0x23, 0x9b, 0x50,
+	0xe6, 0xca, 0x4b, 0xb1, 0x26, 0x72, 0xa7, 0x22, 0xa5, 0xbe, 0x0d, 0x9b, 0x4b, 0x5d, 0xf0, 0x43,
+	0x76, 0x8e, 0x27, 0x72, 0x35, 0x3e, 0x44, 0xdf, 0x86, 0x28, 0x9b, 0x8c, 0x3c, 0xbd, 0x36, 0x76,
+	0x5f, 0xfb, 0x24, 0x26, 0xfa, 0x64, 0x84, 0x35, 0x81, 0xe4, 0xdb, 0xe5, 0xe0, 0xb7, 0xc7, 0xc4,
+	0xc1, 0xa6, 0x50, 0x34, 0xa9, 0xf9, 0xb1, 0xfa, 0x33, 0x05, 0x22, 0xcd, 0xba, 0x8e, 0x6e, 0x43,
+	0x92, 0x71, 0x52, 0x6d, 0x7f, 0x6f, 0x12, 0x22, 0x6e, 0xbc, 0xfc, 0x06, 0xed, 0x43, 0x5c, 0x88,
+	0xc5, 0xcf, 0x0b, 0x17, 0x79, 0x55, 0x6b, 0x65, 0xc6, 0x1c, 0xd2, 0x19, 0x33, 0x2c, 0xe5, 0x95,
+	0x15, 0x2a, 0x85, 0xf8, 0x03, 0x7b, 0x68, 0x62, 0x07, 0x65, 0x20, 0x61, 0x98, 0xa6, 0x83, 0x5d,
+	0x77, 0xde, 0x87, 0x0c, 0xd1, 0x5b, 0x10, 0x37, 0x2c, 0x7b, 0x4c, 0x3d, 0x3f, 0x49, 0x55, 0x76,
+	0xf9, 0x0a, 0x7f, 0xfd, 0x38, 0x7f, 0xaf, 0x4f, 0xd8, 0x60, 0xdc, 0x29, 0x76, 0x6d, 0xab, 0x54,
+	0x27, 0xd4, 0xed, 0x0e, 0x88, 0x51, 0xea, 0xc9, 0xc1, 0x7d, 0xd7, 0x3c, 0x2f, 0x71, 0x15, 0xdc,
+	0x62, 0x83, 0x32, 0x4d, 0xae, 0xa0, 0xfe, 0x04, 0x92, 0x27, 0x8f, 0x28, 0x36, 0x39, 0xf5, 0x3c,
+	0xac, 0x75, 0xa5, 0x17, 0x2c, 0xd8, 0xc3, 0x3c, 0xd5, 0x30, 0x51, 0x11, 0x22, 0xb4, 0xe7, 0x7d,
+	0x75, 0x6d, 0x77, 0x67, 0x05, 0xab, 0x66, 0x5d, 0x97, 0x7c, 0x38, 0x50, 0xfd, 0xb7, 0x02, 0x9b,
+	0xcd, 0xba, 0x2e, 0x5d, 0xa7, 0x46, 0x99, 0x33, 0xb9, 0x4e, 0xdf, 0x3d, 0x88, 0x1b, 0x62, 0xa9,
+	0x6b, 0xb6, 0xb4, 0x59, 0xd7, 0xcb, 0x22, 0xd0, 0x24, 0x96, 0xef, 0x40, 0xcf, 0xb1, 0xad, 0xf9,
+	0x0e, 0xf0, 0x31, 0xbf, 0x5a, 0xcc, 0x96, 0x86, 0x14, 0x66, 0x36, 0xda, 0x81, 0xf8, 0xc8, 0x70,
+	0xf8, 0x69, 0x8c, 0x89, 0x9c, 0x8c, 0x78, 0x7e, 0x80, 0x49, 0x7f, 0xc0, 0x84, 0xb7, 0x44, 0x34,
+	0x19, 0xa1, 0x37, 0x21, 0xca, 0x5d, 0x3f, 0x93, 0x90, 0xf7, 0x6b, 0xd9, 0x5e, 0xf5, 0xf9, 0x93,
+	0xe0, 0xf9, 0xeb, 0xbb, 0xdc, 0x5f, 0x45, 0x85, 0xfa, 0x6b, 0x05, 0xb6, 0x84, 0xf7, 0xbc, 0x40,
+	0xfa, 0xab, 0xb0, 0x31, 0x72, 0xf0, 0x05, 0xb1, 0xc7, 0xae, 0x74, 0x2b, 0x8f, 0xfa, 0xfa, 0x3c,
+	0x2b, 0x4a, 0x16, 0x4e, 0x17, 0x0e, 0x3a, 0xdd, 0xa2, 0xc9, 0xc8, 0xca, 0x26, 0xa3, 0xaf, 0xdc,
+	0xe4, 0x2f, 0x14, 0x6f, 0xd7, 0x9d, 0x97, 0xda, 0xf5, 0xe0, 0x8e, 0x85, 0x57, 0xdf, 0x88, 0xc8,
+	0x8a, 0x1b, 0x11, 0x0d, 0xdc, 0x88, 0x6d, 0x88, 0xd9, 0xfc, 0x7b, 0x52, 0x7e, 0x2f, 0xd8, 0x4f,
+	0x7d, 0xf4, 0xc1, 0xfd, 0x98, 0x70, 0x10, 0xf5, 0x77, 0x0a, 0x84, 0xff, 0x4f, 0xbd, 0x04, 0xfd,
+	0x3c, 0x76, 0x8d, 0x9f, 0xc7, 0x97, 0xfc, 0x3c, 0xd0, 0xad, 0x0b, 0x29, 0x31, 0xe0, 0xd6, 0xf2,
+	0xe9, 0x3d, 0xdf, 0x01, 0xf0, 0x7a, 0xf6, 0xdd, 0x2a, 0xa5, 0xa5, 0x98, 0x5f, 0xff, 0x92, 0x7d,
+	0xab, 0x8f, 0x20, 0x5a, 0xb5, 0x09, 0xbd, 0xee, 0x02, 0x7d, 0x8e, 0xc6, 0xb0, 0x9f, 0x7c, 0xef,
+	0x69, 0x3e, 0xf4, 0xaf, 0xa7, 0x79, 0x45, 0xfd, 0xa5, 0x02, 0x9b, 0x3f, 0xc4, 0x2e, 0x23, 0xb4,
+	0xcf, 0xed, 0xdf, 0x1c, 0x0f, 0x31, 0xaa, 0x02, 0xb8, 0xcc, 0x70, 0x58, 0x5b, 0x9c, 0x40, 0xe5,
+	0x15, 0x4e, 0x60, 0x4a, 0xd4, 0xf1, 0x19, 0xf4, 0x7d, 0x48, 0x62, 0x6a, 0x7a, 0x4b, 0x84, 0x5f,
+	0x61, 0x89, 0x04, 0xa6, 0x26, 0xcf, 0xab, 0x7f, 0x54, 0x20, 0x7a, 0x64, 0x77, 0xcf, 0xaf, 0xf1,
+	0xca, 0x6b, 0x0e, 0xcc, 0x42, 0xad, 0xc8, 0x67, 0x55, 0x0b, 0x1d, 0x40, 0xd2, 0x95, 0xda, 0xc8,
+	0xfb, 0xb8, 0xea, 0x65, 0x5d, 0x52, 0x51, 0x5a, 0xa5, 0x5f, 0xa9, 0xfe, 0x14, 0x62, 0x87, 0x8e,
+	0x41, 0x19, 0xe7, 0xd3, 0xe7, 0x03, 0x8c, 0xe7, 0x7c, 0x64, 0x88, 0xbe, 0x07, 0x30, 0xc2, 0x8e,
+	0x45, 0x5c, 0x77, 0xe1, 0x93, 0x77, 0x56, 0x7c, 0xea, 0xd4, 0x07, 0x69, 0x81, 0x02, 0xb5, 0x0a,
+	0xeb, 0xe5, 0x31, 0x1b, 0xd8, 0x0e, 0x79, 0x22, 0x7e, 0x1f, 0x0a, 0x73, 0x11, 0xef, 0x8d, 0xfc,
+	0x90, 0x8c, 0xf8, 0x4d, 0xb0, 0x47, 0xd8, 0x31, 0x98, 0xed, 0x48, 0xdd, 0xfc, 0x58, 0x7d, 0x03,
+	0x52, 0xfe, 0xf3, 0xb5, 0xe2, 0x5d, 0xde, 0x86, 0xd8, 0x85, 0x31, 0x1c, 0xcf, 0x8f, 0xba, 0x17,
+	0xdc, 0xfb, 0x93, 0x02, 0x29, 0xff, 0x3d, 0x46, 0x7b, 0xb0, 0xa3, 0x6b, 0xe5, 0x86, 0xde, 0xd6,
+	0x1f, 0x9e, 0xd6, 0xda, 0x67, 0xcd, 0xd6, 0x69, 0xad, 0xda, 0xa8, 0x37, 0x6a, 0x07, 0xe9, 0x50,
+	0x36, 0x33, 0x9d, 0x15, 0xb6, 0x7d, 0xe8, 0x19, 0x75, 0x47, 0xb8, 0x4b, 0x7a, 0x04, 0x9b, 0xe8,
+	0x1e, 0x6c, 0x05, 0xaa, 0x5a, 0xba, 0xd6, 0x68, 0x1e, 0xa6, 0x95, 0xec, 0xcd, 0xe9, 0xac, 0xb0,
+	0xe9, 0x17, 0xb4, 0x98, 0x43, 0x68, 0x1f, 0x7d, 0x0b, 0x50, 0x00, 0xdb, 0x68, 0xea, 0xb5, 0xc3,
+	0x9a, 0x96, 0x0e, 0x67, 0xb7, 0xa7, 0xb3, 0x42, 0xda, 0x07, 0x37, 0x28, 0xc3, 0x7d, 0xec, 0x2c,
+	0xa1, 0x2b, 0x27, 0x27, 0x47, 0xb5, 0x72, 0x33, 0x1d, 0x59, 0x42, 0x57, 0x6c, 0x7b, 0x88, 0x0d,
+	0x9a, 0x8d, 0xbe, 0xf3, 0x9b, 0x5c, 0xe8, 0xde, 0xaf, 0xc2, 0x90, 0xf2, 0x9f, 0x23, 0xce, 0xa8,
+	0x59, 0xd7, 0xdb, 0xe5, 0xaa, 0xde, 0x38, 0x69, 0xae, 0x62, 0xe4, 0x43, 0x83, 0x8c, 0xbe, 0x06,
+	0x9b, 0x81, 0xaa, 0xe3, 0x46, 0x53, 0x4f, 0x2b, 0xd9, 0xad, 0xe9, 0xac, 0xb0, 0xee, 0xc3, 0x8f,
+	0x09, 0x65, 0xa8, 0x08, 0x37, 0x03, 0x38, 0x5d, 0x2b, 0x37, 0x5b, 0x75, 0x41, 0xe7, 0xd6, 0x74,
+	0x56, 0xd8, 0xf2, 0xb1, 0xba, 0x63, 0x50, 0xb7, 0x87, 0x1d, 0xae, 0x54, 0x00, 0x5f, 0xd6, 0xf5,
+	0x72, 0xf5, 0x41, 0x3a, 0xe2, 0x29, 0xe5, 0xa3, 0xcb, 0x8c, 0x19, 0xdd, 0xc1, 0x12, 0xf6, 0xa0,
+	0x26, 0xb0, 0xd1, 0x25, 0xec, 0x01, 0x16, 0xd8, 0x17, 0xfb, 0xad, 0x9c, 0x69, 0xcd, 0x74, 0x6c,
+	0xa9, 0xdf, 0xca, 0xd8, 0x99, 0x2b, 0xf4, 0x1f, 0x05, 0x60, 0x71, 0x10, 0xd1, 0x77, 0x60, 0xe7,
+	0xb4, 0xa6, 0x1d, 0x37, 0x5a, 0xad, 0xab, 0x12, 0xdd, 0x9e, 0xce, 0x0a, 0xb7, 0x16, 0xd8, 0xa0,
+	0x46, 0xdf, 0x80, 0x74, 0xa0, 0xac, 0xd1, 0x6a, 0x9d, 0xd5, 0xe6, 0x9b, 0xbe, 0x28, 0x68, 0xb8,
+	0xee, 0x18, 0xa3, 0x6f, 0xc2, 0x56, 0x00, 0x7a, 0x7c, 0x72, 0xd0, 0xa8, 0x3f, 0x9c, 0xef, 0xf9,
+	0x02, 0x7b, 0x6c, 0x9b, 0xa4, 0x37, 0x41, 0x5f, 0x87, 0xcd, 0x20, 0x98, 0x6b, 0x1f, 0xc9, 0xa2,
+	0xe9, 0xac, 0xb0, 0x11, 0x80, 0x72, 0xf1, 0x5f, 0x04, 0x0a, 0xd2, 0xd1, 0x65, 0x60, 0x80, 0xf5,
+	0xef, 0xc3, 0x90, 0x3e, 0xc2, 0x7d, 0xa3, 0x3b, 0x09, 0x70, 0xaf, 0xc0, 0x9d, 0xa3, 0xda, 0x61,
+	0xb9, 0xfa, 0xb0, 0xfd, 0x89, 0x12, 0xe4, 0xa7, 0xb3, 0xc2, 0x17, 0x97, 0x0b, 0x83, 0x42, 0x7c,
+	0x17, 0xbe, 0x70, 0x75, 0x8d, 0xb9, 0x1e, 0x42, 0xc0, 0xe5, 0x6a, 0x4f, 0x95, 0x37, 0x21, 0x73,
+	0xb5, 0xce, 0x17, 0x27, 0x3b, 0x9d, 0x15, 0x76, 0x96, 0x0b, 0xa5, 0x44, 0x7b, 0xb0, 0xb3, 0xa2,
+	0xd2, 0x53, 0x4a, 0x1c, 0xea, 0x2b, 0x75, 0x5c, 0xaf, 0x95, 0x55, 0x52, 0xb6, 0x95, 0x55, 0x42,
+	0xbc, 0x24, 0x17, 0xef, 0xfd, 0xdf, 0xe6, 0x42, 0x95, 0x93, 0x67, 0xff, 0xcc, 0x85, 0xde, 0xbf,
+	0xcc, 0x85, 0x9e, 0x5d, 0xe6, 0x94, 0x0f, 0x2f, 0x73, 0xca, 0x3f, 0x2e, 0x73, 0xca, 0xbb, 0xcf,
+	0x73, 0xa1, 0x0f, 0x9f, 0xe7, 0x42, 0x7f, 0x7e, 0x9e, 0x0b, 0xfd, 0xf8, 0xfe, 0xa7, 0x5a, 0xf4,
+	0xe3, 0xc0, 0x9f, 0x07, 0x9d, 0xb8, 0x78, 0x4e, 0xde, 0xf8, 0xef, 0x00, 0xd5, 0x72, 0xa3, 0x61,
+	0x63, 0x10, 0x00, 0x00,
 }
 
 func (this *Coin) Equal(that interface{}) bool {
@@ -830,6 +1363,19 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.NftHistoryRetention, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.NftHistoryRetention):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintCollection(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if m.MaxBatchSize != 0 {
+		i = encodeVarintCollection(dAtA, i, uint64(m.MaxBatchSize))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.WidthLimit != 0 {
 		i = encodeVarintCollection(dAtA, i, uint64(m.WidthLimit))
 		i--
@@ -863,6 +1409,34 @@ func (m *Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingAdmin) > 0 {
+		i -= len(m.PendingAdmin)
+		copy(dAtA[i:], m.PendingAdmin)
+		i = encodeVarintCollection(dAtA, i, uint64(len(m.PendingAdmin)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintCollection(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TraitSchema) > 0 {
+		for iNdEx := len(m.TraitSchema) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TraitSchema[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCollection(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Uri) > 0 {
 		i -= len(m.Uri)
 		copy(dAtA[i:], m.Uri)
@@ -973,6 +1547,32 @@ func (m *NFTClass) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TraitSchema) > 0 {
+		for iNdEx := len(m.TraitSchema) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TraitSchema[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCollection(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Royalty != nil {
+		{
+			size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCollection(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Meta) > 0 {
 		i -= len(m.Meta)
 		copy(dAtA[i:], m.Meta)
@@ -997,7 +1597,7 @@ func (m *NFTClass) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *NFT) Marshal() (dAtA []byte, err error) {
+func (m *Royalty) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1007,18 +1607,112 @@ func (m *NFT) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NFT) MarshalTo(dAtA []byte) (int, error) {
+func (m *Royalty) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Royalty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Meta) > 0 {
-		i -= len(m.Meta)
+	if m.BasisPoints != 0 {
+		i = encodeVarintCollection(dAtA, i, uint64(m.BasisPoints))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintCollection(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TraitDefinition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TraitDefinition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TraitDefinition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Required {
+		i--
+		if m.Required {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Type != 0 {
+		i = encodeVarintCollection(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintCollection(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NFT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NFT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Traits) > 0 {
+		for iNdEx := len(m.Traits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Traits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCollection(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Meta) > 0 {
+		i -= len(m.Meta)
 		copy(dAtA[i:], m.Meta)
 		i = encodeVarintCollection(dAtA, i, uint64(len(m.Meta)))
 		i--
@@ -1041,6 +1735,205 @@ func (m *NFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Holder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Holder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Holder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCollection(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintCollection(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OwnedNFT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OwnedNFT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OwnedNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Nft.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCollection(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintCollection(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NFTHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NFTHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NFTHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintCollection(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x3a
+	if m.Height != 0 {
+		i = encodeVarintCollection(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Parent) > 0 {
+		i -= len(m.Parent)
+		copy(dAtA[i:], m.Parent)
+		i = encodeVarintCollection(dAtA, i, uint64(len(m.Parent)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintCollection(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintCollection(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Action != 0 {
+		i = encodeVarintCollection(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintCollection(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintCollection(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintCollection(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintCollection(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PreviousAdmin) > 0 {
+		i -= len(m.PreviousAdmin)
+		copy(dAtA[i:], m.PreviousAdmin)
+		i = encodeVarintCollection(dAtA, i, uint64(len(m.PreviousAdmin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *OwnerNFT) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1256,7 +2149,7 @@ func (m *Coin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Grant) Marshal() (dAtA []byte, err error) {
+func (m *VestingSchedule) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1266,32 +2159,36 @@ func (m *Grant) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Grant) MarshalTo(dAtA []byte) (int, error) {
+func (m *VestingSchedule) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *VestingSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Permission != 0 {
-		i = encodeVarintCollection(dAtA, i, uint64(m.Permission))
-		i--
-		dAtA[i] = 0x10
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err6 != nil {
+		return 0, err6
 	}
-	if len(m.Grantee) > 0 {
-		i -= len(m.Grantee)
-		copy(dAtA[i:], m.Grantee)
-		i = encodeVarintCollection(dAtA, i, uint64(len(m.Grantee)))
-		i--
-		dAtA[i] = 0xa
+	i -= n6
+	i = encodeVarintCollection(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err7 != nil {
+		return 0, err7
 	}
+	i -= n7
+	i = encodeVarintCollection(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Authorization) Marshal() (dAtA []byte, err error) {
+func (m *Lock) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1301,33 +2198,125 @@ func (m *Authorization) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Authorization) MarshalTo(dAtA []byte) (int, error) {
+func (m *Lock) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Authorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Lock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintCollection(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Holder) > 0 {
-		i -= len(m.Holder)
-		copy(dAtA[i:], m.Holder)
-		i = encodeVarintCollection(dAtA, i, uint64(len(m.Holder)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCollection(dAtA, i, uint64(size))
 	}
-	return len(dAtA) - i, nil
-}
-
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCollection(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintCollection(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintCollection(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Grant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Grant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Permission != 0 {
+		i = encodeVarintCollection(dAtA, i, uint64(m.Permission))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintCollection(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Authorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Authorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Authorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintCollection(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintCollection(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Attribute) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1388,6 +2377,11 @@ func (m *Params) Size() (n int) {
 	if m.WidthLimit != 0 {
 		n += 1 + sovCollection(uint64(m.WidthLimit))
 	}
+	if m.MaxBatchSize != 0 {
+		n += 1 + sovCollection(uint64(m.MaxBatchSize))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.NftHistoryRetention)
+	n += 1 + l + sovCollection(uint64(l))
 	return n
 }
 
@@ -1413,6 +2407,20 @@ func (m *Contract) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCollection(uint64(l))
 	}
+	if len(m.TraitSchema) > 0 {
+		for _, e := range m.TraitSchema {
+			l = e.Size()
+			n += 1 + l + sovCollection(uint64(l))
+		}
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovCollection(uint64(l))
+	}
+	l = len(m.PendingAdmin)
+	if l > 0 {
+		n += 1 + l + sovCollection(uint64(l))
+	}
 	return n
 }
 
@@ -1461,6 +2469,51 @@ func (m *NFTClass) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCollection(uint64(l))
 	}
+	if m.Royalty != nil {
+		l = m.Royalty.Size()
+		n += 1 + l + sovCollection(uint64(l))
+	}
+	if len(m.TraitSchema) > 0 {
+		for _, e := range m.TraitSchema {
+			l = e.Size()
+			n += 1 + l + sovCollection(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Royalty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovCollection(uint64(l))
+	}
+	if m.BasisPoints != 0 {
+		n += 1 + sovCollection(uint64(m.BasisPoints))
+	}
+	return n
+}
+
+func (m *TraitDefinition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCollection(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovCollection(uint64(m.Type))
+	}
+	if m.Required {
+		n += 2
+	}
 	return n
 }
 
@@ -1482,6 +2535,97 @@ func (m *NFT) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCollection(uint64(l))
 	}
+	if len(m.Traits) > 0 {
+		for _, e := range m.Traits {
+			l = e.Size()
+			n += 1 + l + sovCollection(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Holder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCollection(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovCollection(uint64(l))
+	return n
+}
+
+func (m *OwnedNFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovCollection(uint64(l))
+	}
+	l = m.Nft.Size()
+	n += 1 + l + sovCollection(uint64(l))
+	return n
+}
+
+func (m *NFTHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovCollection(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovCollection(uint64(m.Action))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovCollection(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovCollection(uint64(l))
+	}
+	l = len(m.Parent)
+	if l > 0 {
+		n += 1 + l + sovCollection(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovCollection(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovCollection(uint64(l))
+	return n
+}
+
+func (m *AdminHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PreviousAdmin)
+	if l > 0 {
+		n += 1 + l + sovCollection(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovCollection(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovCollection(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovCollection(uint64(l))
 	return n
 }
 
@@ -1585,6 +2729,40 @@ func (m *Coin) Size() (n int) {
 	return n
 }
 
+func (m *VestingSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovCollection(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovCollection(uint64(l))
+	return n
+}
+
+func (m *Lock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCollection(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovCollection(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovCollection(uint64(l))
+	l = m.Schedule.Size()
+	n += 1 + l + sovCollection(uint64(l))
+	return n
+}
+
 func (m *Grant) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1708,17 +2886,69 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCollection(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCollection
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchSize", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.MaxBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBatchSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftHistoryRetention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.NftHistoryRetention, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCollection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -1755,12 +2985,1091 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Contract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Contract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Contract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Meta = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraitSchema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraitSchema = append(m.TraitSchema, TraitDefinition{})
+			if err := m.TraitSchema[len(m.TraitSchema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCollection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FTClass) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCollection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FTClass: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FTClass: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Meta = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mintable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Mintable = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCollection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NFTClass) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCollection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NFTClass: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NFTClass: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Meta = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Royalty == nil {
+				m.Royalty = &Royalty{}
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraitSchema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraitSchema = append(m.TraitSchema, TraitDefinition{})
+			if err := m.TraitSchema[len(m.TraitSchema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCollection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Royalty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCollection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Royalty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Royalty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasisPoints", wireType)
+			}
+			m.BasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BasisPoints |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCollection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TraitDefinition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCollection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TraitDefinition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TraitDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= TraitType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Required", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Required = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCollection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NFT) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCollection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NFT: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NFT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Meta = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Traits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Traits = append(m.Traits, Attribute{})
+			if err := m.Traits[len(m.Traits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCollection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Holder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCollection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Holder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Holder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1788,11 +4097,11 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1820,11 +4129,63 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCollection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OwnedNFT) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCollection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OwnedNFT: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OwnedNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1852,13 +4213,13 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Meta = string(dAtA[iNdEx:postIndex])
+			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nft", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCollection
@@ -1868,23 +4229,24 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCollection
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCollection
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uri = string(dAtA[iNdEx:postIndex])
+			if err := m.Nft.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1907,7 +4269,7 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FTClass) Unmarshal(dAtA []byte) error {
+func (m *NFTHistoryEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1930,15 +4292,15 @@ func (m *FTClass) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FTClass: wiretype end group for non-group")
+			return fmt.Errorf("proto: NFTHistoryEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FTClass: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NFTHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1966,11 +4328,30 @@ func (m *FTClass) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.TokenId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= NFTAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1998,11 +4379,11 @@ func (m *FTClass) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2030,13 +4411,13 @@ func (m *FTClass) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Meta = string(dAtA[iNdEx:postIndex])
+			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
 			}
-			m.Decimals = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCollection
@@ -2046,16 +4427,29 @@ func (m *FTClass) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Decimals |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mintable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var v int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCollection
@@ -2065,12 +4459,44 @@ func (m *FTClass) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Mintable = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCollection(dAtA[iNdEx:])
@@ -2092,7 +4518,7 @@ func (m *FTClass) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NFTClass) Unmarshal(dAtA []byte) error {
+func (m *AdminHistoryEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2115,15 +4541,15 @@ func (m *NFTClass) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NFTClass: wiretype end group for non-group")
+			return fmt.Errorf("proto: AdminHistoryEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NFTClass: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AdminHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAdmin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2151,11 +4577,11 @@ func (m *NFTClass) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.PreviousAdmin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2183,13 +4609,32 @@ func (m *NFTClass) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCollection
@@ -2199,23 +4644,24 @@ func (m *NFTClass) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCollection
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCollection
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Meta = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2238,7 +4684,7 @@ func (m *NFTClass) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NFT) Unmarshal(dAtA []byte) error {
+func (m *OwnerNFT) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2261,15 +4707,79 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NFT: wiretype end group for non-group")
+			return fmt.Errorf("proto: OwnerNFT: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NFT: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OwnerNFT: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2297,11 +4807,11 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenId = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2329,11 +4839,11 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Meta = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2361,7 +4871,7 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Meta = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2384,7 +4894,7 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OwnerNFT) Unmarshal(dAtA []byte) error {
+func (m *FT) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2407,10 +4917,10 @@ func (m *OwnerNFT) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OwnerNFT: wiretype end group for non-group")
+			return fmt.Errorf("proto: FT: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OwnerNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FT: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2542,10 +5052,10 @@ func (m *OwnerNFT) Unmarshal(dAtA []byte) error {
 			m.Meta = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
 			}
-			var stringLen uint64
+			m.Decimals = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCollection
@@ -2555,24 +5065,31 @@ func (m *OwnerNFT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Decimals |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCollection
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCollection
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mintable", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Mintable = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCollection(dAtA[iNdEx:])
@@ -2594,7 +5111,7 @@ func (m *OwnerNFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FT) Unmarshal(dAtA []byte) error {
+func (m *TokenType) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2617,10 +5134,10 @@ func (m *FT) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FT: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenType: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FT: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenType: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2657,7 +5174,7 @@ func (m *FT) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2685,7 +5202,7 @@ func (m *FT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenId = string(dAtA[iNdEx:postIndex])
+			m.TokenType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -2751,45 +5268,6 @@ func (m *FT) Unmarshal(dAtA []byte) error {
 			}
 			m.Meta = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
-			}
-			m.Decimals = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCollection
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Decimals |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mintable", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCollection
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Mintable = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCollection(dAtA[iNdEx:])
@@ -2811,7 +5289,7 @@ func (m *FT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TokenType) Unmarshal(dAtA []byte) error {
+func (m *Coin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2834,15 +5312,15 @@ func (m *TokenType) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TokenType: wiretype end group for non-group")
+			return fmt.Errorf("proto: Coin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TokenType: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Coin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2870,11 +5348,11 @@ func (m *TokenType) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractId = string(dAtA[iNdEx:postIndex])
+			m.TokenId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2902,13 +5380,65 @@ func (m *TokenType) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenType = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCollection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VestingSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCollection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCollection
@@ -2918,29 +5448,30 @@ func (m *TokenType) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCollection
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCollection
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCollection
@@ -2950,23 +5481,24 @@ func (m *TokenType) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCollection
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCollection
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Meta = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2989,7 +5521,7 @@ func (m *TokenType) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Coin) Unmarshal(dAtA []byte) error {
+func (m *Lock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3012,13 +5544,45 @@ func (m *Coin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Coin: wiretype end group for non-group")
+			return fmt.Errorf("proto: Lock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Coin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Lock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
 			}
@@ -3050,7 +5614,7 @@ func (m *Coin) Unmarshal(dAtA []byte) error {
 			}
 			m.TokenId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -3084,6 +5648,39 @@ func (m *Coin) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCollection(dAtA[iNdEx:])
@@ -53,4 +53,8 @@ var (
 	ErrCompositionTooDeep            = sdkerrors.Register(collectionCodespace, 45, "cannot attach token (composition too deep)")
 	ErrCompositionTooWide            = sdkerrors.Register(collectionCodespace, 46, "cannot attach token (composition too wide)")
 	ErrBurnNonRootNFT                = sdkerrors.Register(collectionCodespace, 47, "cannot burn non-root NFTs")
+	ErrInvalidRoyalty                = sdkerrors.Register(collectionCodespace, 48, "invalid royalty")
+	ErrBatchTooLarge                 = sdkerrors.Register(collectionCodespace, 49, "batch size exceeds the limit")
+	ErrInvalidTrait                  = sdkerrors.Register(collectionCodespace, 50, "invalid trait")
+	ErrTooManyLocks                  = sdkerrors.Register(collectionCodespace, 51, "too many locks")
 )
@@ -5,6 +5,7 @@ package collection
 
 import (
 	fmt "fmt"
+	types "github.com/Finschia/finschia-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -31,8 +32,10 @@ const (
 	AttributeKeyName        AttributeKey = 1
 	AttributeKeyMeta        AttributeKey = 2
 	// deprecated: use ATTRIBUTE_KEY_URI
-	AttributeKeyBaseImgURI AttributeKey = 8
-	AttributeKeyURI        AttributeKey = 20
+	AttributeKeyBaseImgURI         AttributeKey = 8
+	AttributeKeyURI                AttributeKey = 20
+	AttributeKeyRoyaltyRecipient   AttributeKey = 21
+	AttributeKeyRoyaltyBasisPoints AttributeKey = 22
 )
 
 var AttributeKey_name = map[int32]string{
@@ -41,14 +44,18 @@ var AttributeKey_name = map[int32]string{
 	2:  "ATTRIBUTE_KEY_META",
 	8:  "ATTRIBUTE_KEY_BASE_IMG_URI",
 	20: "ATTRIBUTE_KEY_URI",
+	21: "ATTRIBUTE_KEY_ROYALTY_RECIPIENT",
+	22: "ATTRIBUTE_KEY_ROYALTY_BASIS_POINTS",
 }
 
 var AttributeKey_value = map[string]int32{
-	"ATTRIBUTE_KEY_UNSPECIFIED":  0,
-	"ATTRIBUTE_KEY_NAME":         1,
-	"ATTRIBUTE_KEY_META":         2,
-	"ATTRIBUTE_KEY_BASE_IMG_URI": 8,
-	"ATTRIBUTE_KEY_URI":          20,
+	"ATTRIBUTE_KEY_UNSPECIFIED":          0,
+	"ATTRIBUTE_KEY_NAME":                 1,
+	"ATTRIBUTE_KEY_META":                 2,
+	"ATTRIBUTE_KEY_BASE_IMG_URI":         8,
+	"ATTRIBUTE_KEY_URI":                  20,
+	"ATTRIBUTE_KEY_ROYALTY_RECIPIENT":    21,
+	"ATTRIBUTE_KEY_ROYALTY_BASIS_POINTS": 22,
 }
 
 func (AttributeKey) EnumDescriptor() ([]byte, []int) {
@@ -285,6 +292,8 @@ type EventCreatedContract struct {
 	Meta string `protobuf:"bytes,4,opt,name=meta,proto3" json:"meta,omitempty"`
 	// uri for the contract image stored off chain.
 	Uri string `protobuf:"bytes,5,opt,name=uri,proto3" json:"uri,omitempty"`
+	// schema of the traits of the contract.
+	TraitSchema []TraitDefinition `protobuf:"bytes,6,rep,name=trait_schema,json=traitSchema,proto3" json:"trait_schema"`
 }
 
 func (m *EventCreatedContract) Reset()         { *m = EventCreatedContract{} }
@@ -355,6 +364,13 @@ func (m *EventCreatedContract) GetUri() string {
 	return ""
 }
 
+func (m *EventCreatedContract) GetTraitSchema() []TraitDefinition {
+	if m != nil {
+		return m.TraitSchema
+	}
+	return nil
+}
+
 // EventCreatedFTClass is emitted when a new fungible token class is created.
 //
 // Since: 0.46.0 (finschia)
@@ -472,6 +488,10 @@ type EventCreatedNFTClass struct {
 	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
 	// metadata of the token class.
 	Meta string `protobuf:"bytes,5,opt,name=meta,proto3" json:"meta,omitempty"`
+	// royalty of the token class.
+	Royalty *Royalty `protobuf:"bytes,6,opt,name=royalty,proto3" json:"royalty,omitempty"`
+	// schema of the traits of the token class.
+	TraitSchema []TraitDefinition `protobuf:"bytes,7,rep,name=trait_schema,json=traitSchema,proto3" json:"trait_schema"`
 }
 
 func (m *EventCreatedNFTClass) Reset()         { *m = EventCreatedNFTClass{} }
@@ -542,6 +562,20 @@ func (m *EventCreatedNFTClass) GetMeta() string {
 	return ""
 }
 
+func (m *EventCreatedNFTClass) GetRoyalty() *Royalty {
+	if m != nil {
+		return m.Royalty
+	}
+	return nil
+}
+
+func (m *EventCreatedNFTClass) GetTraitSchema() []TraitDefinition {
+	if m != nil {
+		return m.TraitSchema
+	}
+	return nil
+}
+
 // EventGranted is emitted when a granter grants its permission to a grantee.
 //
 // Info: `granter` would be empty if the permission is granted by an issuance.
@@ -835,6 +869,61 @@ func (m *EventMintedNFT) GetTokens() []NFT {
 	return nil
 }
 
+// EventLocked is emitted when minted tokens are locked on a vesting schedule.
+type EventLocked struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// locks of the minted tokens.
+	Locks []Lock `protobuf:"bytes,2,rep,name=locks,proto3" json:"locks"`
+}
+
+func (m *EventLocked) Reset()         { *m = EventLocked{} }
+func (m *EventLocked) String() string { return proto.CompactTextString(m) }
+func (*EventLocked) ProtoMessage()    {}
+func (*EventLocked) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478cfab12ea1b00e, []int{10}
+}
+func (m *EventLocked) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventLocked) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventLocked.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventLocked) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventLocked.Merge(m, src)
+}
+func (m *EventLocked) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventLocked) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventLocked.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventLocked proto.InternalMessageInfo
+
+func (m *EventLocked) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *EventLocked) GetLocks() []Lock {
+	if m != nil {
+		return m.Locks
+	}
+	return nil
+}
+
 // EventBurned is emitted when tokens are burnt.
 //
 // Since: 0.46.0 (finschia)
@@ -853,7 +942,7 @@ func (m *EventBurned) Reset()         { *m = EventBurned{} }
 func (m *EventBurned) String() string { return proto.CompactTextString(m) }
 func (*EventBurned) ProtoMessage()    {}
 func (*EventBurned) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{10}
+	return fileDescriptor_478cfab12ea1b00e, []int{11}
 }
 func (m *EventBurned) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -928,7 +1017,7 @@ func (m *EventModifiedContract) Reset()         { *m = EventModifiedContract{} }
 func (m *EventModifiedContract) String() string { return proto.CompactTextString(m) }
 func (*EventModifiedContract) ProtoMessage()    {}
 func (*EventModifiedContract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{11}
+	return fileDescriptor_478cfab12ea1b00e, []int{12}
 }
 func (m *EventModifiedContract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1000,7 +1089,7 @@ func (m *EventModifiedTokenClass) Reset()         { *m = EventModifiedTokenClass
 func (m *EventModifiedTokenClass) String() string { return proto.CompactTextString(m) }
 func (*EventModifiedTokenClass) ProtoMessage()    {}
 func (*EventModifiedTokenClass) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{12}
+	return fileDescriptor_478cfab12ea1b00e, []int{13}
 }
 func (m *EventModifiedTokenClass) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1083,7 +1172,7 @@ func (m *EventModifiedNFT) Reset()         { *m = EventModifiedNFT{} }
 func (m *EventModifiedNFT) String() string { return proto.CompactTextString(m) }
 func (*EventModifiedNFT) ProtoMessage()    {}
 func (*EventModifiedNFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{13}
+	return fileDescriptor_478cfab12ea1b00e, []int{14}
 }
 func (m *EventModifiedNFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1160,7 +1249,7 @@ func (m *EventAttached) Reset()         { *m = EventAttached{} }
 func (m *EventAttached) String() string { return proto.CompactTextString(m) }
 func (*EventAttached) ProtoMessage()    {}
 func (*EventAttached) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{14}
+	return fileDescriptor_478cfab12ea1b00e, []int{15}
 }
 func (m *EventAttached) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1244,7 +1333,7 @@ func (m *EventDetached) Reset()         { *m = EventDetached{} }
 func (m *EventDetached) String() string { return proto.CompactTextString(m) }
 func (*EventDetached) ProtoMessage()    {}
 func (*EventDetached) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{15}
+	return fileDescriptor_478cfab12ea1b00e, []int{16}
 }
 func (m *EventDetached) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1326,7 +1415,7 @@ func (m *EventOwnerChanged) Reset()         { *m = EventOwnerChanged{} }
 func (m *EventOwnerChanged) String() string { return proto.CompactTextString(m) }
 func (*EventOwnerChanged) ProtoMessage()    {}
 func (*EventOwnerChanged) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{16}
+	return fileDescriptor_478cfab12ea1b00e, []int{17}
 }
 func (m *EventOwnerChanged) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1401,7 +1490,7 @@ func (m *EventRootChanged) Reset()         { *m = EventRootChanged{} }
 func (m *EventRootChanged) String() string { return proto.CompactTextString(m) }
 func (*EventRootChanged) ProtoMessage()    {}
 func (*EventRootChanged) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{17}
+	return fileDescriptor_478cfab12ea1b00e, []int{18}
 }
 func (m *EventRootChanged) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1458,237 +1547,545 @@ func (m *EventRootChanged) GetTo() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("lbm.collection.v1.AttributeKey", AttributeKey_name, AttributeKey_value)
-	proto.RegisterType((*EventSent)(nil), "lbm.collection.v1.EventSent")
-	proto.RegisterType((*EventAuthorizedOperator)(nil), "lbm.collection.v1.EventAuthorizedOperator")
-	proto.RegisterType((*EventRevokedOperator)(nil), "lbm.collection.v1.EventRevokedOperator")
-	proto.RegisterType((*EventCreatedContract)(nil), "lbm.collection.v1.EventCreatedContract")
-	proto.RegisterType((*EventCreatedFTClass)(nil), "lbm.collection.v1.EventCreatedFTClass")
-	proto.RegisterType((*EventCreatedNFTClass)(nil), "lbm.collection.v1.EventCreatedNFTClass")
-	proto.RegisterType((*EventGranted)(nil), "lbm.collection.v1.EventGranted")
-	proto.RegisterType((*EventRenounced)(nil), "lbm.collection.v1.EventRenounced")
-	proto.RegisterType((*EventMintedFT)(nil), "lbm.collection.v1.EventMintedFT")
-	proto.RegisterType((*EventMintedNFT)(nil), "lbm.collection.v1.EventMintedNFT")
-	proto.RegisterType((*EventBurned)(nil), "lbm.collection.v1.EventBurned")
-	proto.RegisterType((*EventModifiedContract)(nil), "lbm.collection.v1.EventModifiedContract")
-	proto.RegisterType((*EventModifiedTokenClass)(nil), "lbm.collection.v1.EventModifiedTokenClass")
-	proto.RegisterType((*EventModifiedNFT)(nil), "lbm.collection.v1.EventModifiedNFT")
-	proto.RegisterType((*EventAttached)(nil), "lbm.collection.v1.EventAttached")
-	proto.RegisterType((*EventDetached)(nil), "lbm.collection.v1.EventDetached")
-	proto.RegisterType((*EventOwnerChanged)(nil), "lbm.collection.v1.EventOwnerChanged")
-	proto.RegisterType((*EventRootChanged)(nil), "lbm.collection.v1.EventRootChanged")
+// EventSold is emitted when a non-fungible token is sold.
+type EventSold struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// address which sold the token.
+	Seller string `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	// address which bought the token.
+	Buyer string `protobuf:"bytes,3,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// token id of the token sold.
+	TokenId string `protobuf:"bytes,4,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// sale price of the token.
+	Price types.Coin `protobuf:"bytes,5,opt,name=price,proto3" json:"price"`
+	// address which received the royalty.
+	// Note: it would be empty if the token class has no royalty.
+	RoyaltyRecipient string `protobuf:"bytes,6,opt,name=royalty_recipient,json=royaltyRecipient,proto3" json:"royalty_recipient,omitempty"`
+	// royalty paid out of the sale price.
+	Royalty types.Coin `protobuf:"bytes,7,opt,name=royalty,proto3" json:"royalty"`
+}
+
+func (m *EventSold) Reset()         { *m = EventSold{} }
+func (m *EventSold) String() string { return proto.CompactTextString(m) }
+func (*EventSold) ProtoMessage()    {}
+func (*EventSold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478cfab12ea1b00e, []int{19}
+}
+func (m *EventSold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSold.Merge(m, src)
+}
+func (m *EventSold) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSold) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSold.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("lbm/collection/v1/event.proto", fileDescriptor_478cfab12ea1b00e) }
+var xxx_messageInfo_EventSold proto.InternalMessageInfo
 
-var fileDescriptor_478cfab12ea1b00e = []byte{
-	// 987 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x97, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xc0, 0xbd, 0xb6, 0xe3, 0x3f, 0x2f, 0x25, 0xdd, 0x6c, 0x42, 0xb2, 0xdd, 0x12, 0xd7, 0xda,
-	0x0b, 0x51, 0x45, 0x6d, 0xb5, 0xc0, 0xa5, 0x82, 0x83, 0xed, 0x3a, 0xd1, 0xaa, 0xb2, 0x1b, 0x6d,
-	0xec, 0x03, 0x5c, 0xac, 0xf5, 0x7a, 0x62, 0x2f, 0xf1, 0xce, 0x58, 0xb3, 0xb3, 0x86, 0xf0, 0x09,
-	0x90, 0xb9, 0x20, 0x2a, 0x38, 0x20, 0xe5, 0x42, 0x2b, 0xd1, 0x8f, 0xd2, 0x0b, 0x52, 0x8e, 0x9c,
-	0x10, 0x4a, 0xbe, 0x08, 0xda, 0xf1, 0x8e, 0xb3, 0x8e, 0x2d, 0x12, 0xe3, 0x06, 0x6e, 0xf3, 0xde,
-	0xbc, 0x37, 0xf3, 0x7b, 0x6f, 0xde, 0xbc, 0xd9, 0x85, 0x9d, 0x7e, 0xdb, 0x2d, 0xda, 0xa4, 0xdf,
-	0x47, 0x36, 0x73, 0x08, 0x2e, 0x0e, 0x1f, 0x17, 0xd1, 0x10, 0x61, 0x56, 0x18, 0x50, 0xc2, 0x88,
-	0xb2, 0xde, 0x6f, 0xbb, 0x85, 0xcb, 0xe9, 0xc2, 0xf0, 0xb1, 0xb6, 0xd9, 0x25, 0x5d, 0xc2, 0x67,
-	0x8b, 0xc1, 0x68, 0x6c, 0xa8, 0xe9, 0xb3, 0xeb, 0x44, 0xdc, 0xb8, 0x8d, 0xfe, 0x4a, 0x82, 0x6c,
-	0x35, 0x58, 0xfc, 0x10, 0x61, 0xa6, 0x3c, 0x80, 0x55, 0x9b, 0x60, 0x46, 0x2d, 0x9b, 0xb5, 0x9c,
-	0x8e, 0x2a, 0xe5, 0xa5, 0xdd, 0xac, 0x09, 0x42, 0x65, 0x74, 0x14, 0x0d, 0x32, 0x64, 0x80, 0xa8,
-	0xc5, 0x08, 0x55, 0xe3, 0x7c, 0x76, 0x22, 0x2b, 0x0a, 0x24, 0x8f, 0x28, 0x71, 0xd5, 0x04, 0xd7,
-	0xf3, 0xb1, 0xb2, 0x06, 0x71, 0x46, 0xd4, 0x24, 0xd7, 0xc4, 0x19, 0x51, 0x3e, 0x85, 0x94, 0xe5,
-	0x12, 0x1f, 0x33, 0x75, 0x25, 0x9f, 0xd8, 0x5d, 0x7d, 0xb2, 0x5d, 0x98, 0x09, 0xa6, 0x50, 0x21,
-	0x0e, 0x2e, 0x27, 0xdf, 0xfe, 0xf9, 0x20, 0x66, 0x86, 0xc6, 0x3a, 0x86, 0x6d, 0x0e, 0x59, 0xf2,
-	0x59, 0x8f, 0x50, 0xe7, 0x5b, 0xd4, 0x79, 0x21, 0x76, 0xbd, 0x16, 0x79, 0x0b, 0x52, 0x3d, 0xd2,
-	0xef, 0x20, 0x01, 0x1c, 0x4a, 0x53, 0xa1, 0x24, 0xa6, 0x43, 0xd1, 0x8f, 0x61, 0x93, 0xef, 0x67,
-	0xa2, 0x21, 0x39, 0xbe, 0xed, 0xcd, 0xbe, 0x97, 0xc2, 0xdd, 0x2a, 0x14, 0x59, 0x0c, 0x75, 0x2a,
-	0xe1, 0x72, 0x8a, 0x0a, 0x69, 0x3b, 0x50, 0x11, 0x1a, 0xee, 0x24, 0xc4, 0xab, 0x1c, 0xf1, 0x19,
-	0x0e, 0x05, 0x92, 0xd8, 0x72, 0x91, 0x38, 0x8b, 0x60, 0x1c, 0xe8, 0x5c, 0xc4, 0xac, 0xf0, 0x34,
-	0xf8, 0x58, 0x91, 0x21, 0xe1, 0x53, 0x47, 0x5d, 0xe1, 0xaa, 0x60, 0xa8, 0xff, 0x2e, 0xc1, 0x46,
-	0x94, 0x66, 0xaf, 0x51, 0xe9, 0x5b, 0x9e, 0xb7, 0x5c, 0x69, 0xdc, 0x83, 0x0c, 0x23, 0xc7, 0x08,
-	0x07, 0x9e, 0x63, 0xa4, 0x34, 0x97, 0x23, 0xa4, 0xc9, 0x39, 0xa4, 0x2b, 0x11, 0x52, 0x0d, 0x32,
-	0x1d, 0x64, 0x3b, 0xae, 0xd5, 0xf7, 0xd4, 0x54, 0x5e, 0xda, 0x5d, 0x31, 0x27, 0x72, 0x30, 0xe7,
-	0x3a, 0x98, 0x59, 0xed, 0x3e, 0x52, 0xd3, 0x79, 0x69, 0x37, 0x63, 0x4e, 0x64, 0xfd, 0x97, 0x2b,
-	0xd9, 0xad, 0xbf, 0x93, 0x80, 0x76, 0x00, 0xc6, 0x01, 0xb1, 0x93, 0x81, 0xc8, 0x72, 0x96, 0x6b,
-	0x1a, 0x27, 0x03, 0x74, 0xd3, 0xa0, 0xf4, 0x5f, 0x25, 0xb8, 0xc3, 0xe1, 0xf6, 0xa9, 0x85, 0x19,
-	0xea, 0x5c, 0x0f, 0xa5, 0x42, 0xba, 0xcb, 0x6d, 0x05, 0x93, 0x10, 0x2f, 0x67, 0x04, 0x8f, 0x10,
-	0x95, 0xcf, 0x01, 0x06, 0x88, 0xba, 0x8e, 0xe7, 0x39, 0x04, 0x73, 0xa6, 0xb5, 0x27, 0x3b, 0x73,
-	0x2e, 0xde, 0xc1, 0xc4, 0xc8, 0x8c, 0x38, 0xe8, 0x23, 0x09, 0xd6, 0xc2, 0xdb, 0x80, 0x89, 0x8f,
-	0xed, 0x85, 0x30, 0xd1, 0x34, 0xe6, 0x55, 0x98, 0xc4, 0xa2, 0x30, 0x2f, 0x25, 0x78, 0x8f, 0xc3,
-	0xd4, 0x1c, 0xcc, 0xab, 0x73, 0xb9, 0x73, 0x1c, 0xf7, 0xa7, 0xc4, 0x9c, 0xfe, 0x94, 0x5c, 0xa4,
-	0x3f, 0xbd, 0x14, 0x29, 0x1a, 0x53, 0xd5, 0xdf, 0x35, 0xd6, 0x27, 0x90, 0xe2, 0xc5, 0xe5, 0x85,
-	0x58, 0x5b, 0x73, 0xb0, 0xea, 0x7b, 0x0d, 0x41, 0x35, 0xb6, 0xd5, 0x7f, 0x92, 0x60, 0x95, 0x53,
-	0x95, 0x7d, 0x8a, 0x6f, 0x72, 0x6a, 0x8b, 0x76, 0xf7, 0x7f, 0x99, 0xad, 0x1f, 0x25, 0x78, 0x7f,
-	0x9c, 0x2d, 0xd2, 0x71, 0x8e, 0x9c, 0x48, 0xc7, 0x5b, 0x8a, 0xf0, 0x33, 0x48, 0xdb, 0x3d, 0x0b,
-	0x77, 0x91, 0xa7, 0x26, 0x38, 0xce, 0x07, 0x73, 0x70, 0x4a, 0x8c, 0x51, 0xa7, 0xed, 0x33, 0x14,
-	0x32, 0x09, 0x17, 0xfd, 0x4c, 0x0a, 0xdf, 0x18, 0x01, 0xd5, 0x08, 0x92, 0x78, 0xfb, 0xad, 0x22,
-	0x42, 0x9d, 0x5c, 0x98, 0x5a, 0xb9, 0x0f, 0xd9, 0x60, 0xd9, 0x16, 0xef, 0x36, 0xe3, 0xce, 0x92,
-	0x09, 0x14, 0x75, 0xcb, 0x45, 0xfa, 0x1b, 0x09, 0xe4, 0xa9, 0x90, 0x96, 0xae, 0xcb, 0x7f, 0xe8,
-	0xe3, 0x4b, 0xc5, 0xa1, 0xff, 0x2c, 0xae, 0x75, 0x89, 0x31, 0xcb, 0xee, 0x2d, 0x5b, 0xac, 0x97,
-	0xcf, 0x70, 0x62, 0xea, 0x19, 0x56, 0x21, 0xed, 0xf9, 0xed, 0xaf, 0x90, 0xcd, 0xc2, 0xd6, 0x2c,
-	0xc4, 0xc0, 0x83, 0x59, 0xb4, 0x8b, 0x58, 0x98, 0xc5, 0x50, 0xd2, 0x7f, 0x13, 0x60, 0xcf, 0xd0,
-	0xff, 0x03, 0xf6, 0x21, 0xdc, 0x1d, 0x50, 0x34, 0x74, 0x88, 0xef, 0xb5, 0x06, 0x16, 0x45, 0x58,
-	0x10, 0xae, 0x09, 0xf5, 0x01, 0xd7, 0xea, 0x1e, 0xac, 0x73, 0xd0, 0x17, 0x5f, 0x63, 0x44, 0x2b,
-	0x3c, 0xaf, 0x37, 0x80, 0x8d, 0x9e, 0x68, 0x7c, 0xe6, 0x65, 0xbe, 0xee, 0x7b, 0x4e, 0xa7, 0x61,
-	0x85, 0x99, 0x84, 0xb0, 0xff, 0x68, 0xcf, 0x87, 0xaf, 0xe3, 0x70, 0x67, 0x52, 0x48, 0xcf, 0xd1,
-	0x89, 0xf2, 0x14, 0xee, 0x95, 0x1a, 0x0d, 0xd3, 0x28, 0x37, 0x1b, 0xd5, 0xd6, 0xf3, 0xea, 0x17,
-	0xad, 0x66, 0xfd, 0xf0, 0xa0, 0x5a, 0x31, 0xf6, 0x8c, 0xea, 0x33, 0x39, 0xa6, 0xdd, 0x1f, 0x9d,
-	0xe6, 0xb7, 0xa3, 0x0e, 0x4d, 0xec, 0x0d, 0x90, 0xcd, 0x6f, 0x84, 0xf2, 0x11, 0x28, 0xd3, 0xbe,
-	0xf5, 0x52, 0xad, 0x2a, 0x4b, 0xda, 0xe6, 0xe8, 0x34, 0x2f, 0x47, 0x9d, 0x82, 0x1b, 0x35, 0x6b,
-	0x5d, 0xab, 0x36, 0x4a, 0x72, 0x7c, 0xd6, 0xba, 0x16, 0x7c, 0xb2, 0x3c, 0x05, 0x6d, 0xda, 0xba,
-	0x5c, 0x3a, 0xac, 0xb6, 0x8c, 0xda, 0x7e, 0xab, 0x69, 0x1a, 0x72, 0x46, 0xd3, 0x46, 0xa7, 0xf9,
-	0xad, 0xa8, 0x57, 0xd9, 0xf2, 0x90, 0xe1, 0x76, 0x9b, 0xa6, 0xa1, 0x3c, 0x84, 0xf5, 0x2b, 0x31,
-	0x99, 0x86, 0xbc, 0xa9, 0x6d, 0x8c, 0x4e, 0xf3, 0x77, 0xa7, 0x62, 0x31, 0x0d, 0x2d, 0xf3, 0xdd,
-	0xab, 0x5c, 0xec, 0xcd, 0xeb, 0x5c, 0x4c, 0x4f, 0x66, 0x12, 0x72, 0x5a, 0x4f, 0x66, 0xb2, 0xf2,
-	0x46, 0x79, 0xff, 0xed, 0x79, 0x4e, 0x3a, 0x3b, 0xcf, 0x49, 0x7f, 0x9d, 0xe7, 0xa4, 0x1f, 0x2e,
-	0x72, 0xb1, 0xb3, 0x8b, 0x5c, 0xec, 0x8f, 0x8b, 0x5c, 0xec, 0xcb, 0x47, 0x5d, 0x87, 0xf5, 0xfc,
-	0x76, 0xc1, 0x26, 0x6e, 0x71, 0xcf, 0xc1, 0x9e, 0xdd, 0x73, 0xac, 0xe2, 0x51, 0x38, 0x78, 0xe4,
-	0x75, 0x8e, 0x8b, 0xdf, 0x44, 0x7e, 0x14, 0xda, 0x29, 0xfe, 0xa7, 0xf0, 0xf1, 0xdf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x7f, 0x69, 0x1a, 0x22, 0x97, 0x0c, 0x00, 0x00,
+func (m *EventSold) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
 }
 
-func (m *EventSent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventSold) GetSeller() string {
+	if m != nil {
+		return m.Seller
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventSent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventSold) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
 }
 
-func (m *EventSent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvent(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.To) > 0 {
-		i -= len(m.To)
-		copy(dAtA[i:], m.To)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.To)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0x1a
+func (m *EventSold) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *EventSold) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
 	}
-	if len(m.ContractId) > 0 {
-		i -= len(m.ContractId)
-		copy(dAtA[i:], m.ContractId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ContractId)))
-		i--
-		dAtA[i] = 0xa
+	return types.Coin{}
+}
+
+func (m *EventSold) GetRoyaltyRecipient() string {
+	if m != nil {
+		return m.RoyaltyRecipient
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventAuthorizedOperator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventSold) GetRoyalty() types.Coin {
+	if m != nil {
+		return m.Royalty
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *EventAuthorizedOperator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventSetNFTHistoryEnabled is emitted when the recording of the nft history is enabled or disabled.
+type EventSetNFTHistoryEnabled struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// address of the grantee which has the modify permission.
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// whether the history of the non-fungible tokens is recorded.
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
 
-func (m *EventAuthorizedOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0x1a
+func (m *EventSetNFTHistoryEnabled) Reset()         { *m = EventSetNFTHistoryEnabled{} }
+func (m *EventSetNFTHistoryEnabled) String() string { return proto.CompactTextString(m) }
+func (*EventSetNFTHistoryEnabled) ProtoMessage()    {}
+func (*EventSetNFTHistoryEnabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478cfab12ea1b00e, []int{20}
+}
+func (m *EventSetNFTHistoryEnabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetNFTHistoryEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetNFTHistoryEnabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Holder) > 0 {
-		i -= len(m.Holder)
-		copy(dAtA[i:], m.Holder)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Holder)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventSetNFTHistoryEnabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetNFTHistoryEnabled.Merge(m, src)
+}
+func (m *EventSetNFTHistoryEnabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetNFTHistoryEnabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetNFTHistoryEnabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetNFTHistoryEnabled proto.InternalMessageInfo
+
+func (m *EventSetNFTHistoryEnabled) GetContractId() string {
+	if m != nil {
+		return m.ContractId
 	}
-	if len(m.ContractId) > 0 {
-		i -= len(m.ContractId)
-		copy(dAtA[i:], m.ContractId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ContractId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventSetNFTHistoryEnabled) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventRevokedOperator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventSetNFTHistoryEnabled) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *EventRevokedOperator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventProposedAdmin is emitted when the next admin of a contract is proposed.
+type EventProposedAdmin struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// address of the current admin.
+	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+	// address of the account proposed as the next admin.
+	ProposedAdmin string `protobuf:"bytes,3,opt,name=proposed_admin,json=proposedAdmin,proto3" json:"proposed_admin,omitempty"`
 }
 
-func (m *EventRevokedOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventProposedAdmin) Reset()         { *m = EventProposedAdmin{} }
+func (m *EventProposedAdmin) String() string { return proto.CompactTextString(m) }
+func (*EventProposedAdmin) ProtoMessage()    {}
+func (*EventProposedAdmin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478cfab12ea1b00e, []int{21}
+}
+func (m *EventProposedAdmin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventProposedAdmin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventProposedAdmin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventProposedAdmin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventProposedAdmin.Merge(m, src)
+}
+func (m *EventProposedAdmin) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventProposedAdmin) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventProposedAdmin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventProposedAdmin proto.InternalMessageInfo
+
+func (m *EventProposedAdmin) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *EventProposedAdmin) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *EventProposedAdmin) GetProposedAdmin() string {
+	if m != nil {
+		return m.ProposedAdmin
+	}
+	return ""
+}
+
+// EventAcceptedAdmin is emitted when the proposed admin accepts the admin role of a contract.
+type EventAcceptedAdmin struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// address of the previous admin.
+	PreviousAdmin string `protobuf:"bytes,2,opt,name=previous_admin,json=previousAdmin,proto3" json:"previous_admin,omitempty"`
+	// address of the new admin.
+	Admin string `protobuf:"bytes,3,opt,name=admin,proto3" json:"admin,omitempty"`
+}
+
+func (m *EventAcceptedAdmin) Reset()         { *m = EventAcceptedAdmin{} }
+func (m *EventAcceptedAdmin) String() string { return proto.CompactTextString(m) }
+func (*EventAcceptedAdmin) ProtoMessage()    {}
+func (*EventAcceptedAdmin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478cfab12ea1b00e, []int{22}
+}
+func (m *EventAcceptedAdmin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAcceptedAdmin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAcceptedAdmin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAcceptedAdmin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAcceptedAdmin.Merge(m, src)
+}
+func (m *EventAcceptedAdmin) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAcceptedAdmin) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAcceptedAdmin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAcceptedAdmin proto.InternalMessageInfo
+
+func (m *EventAcceptedAdmin) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *EventAcceptedAdmin) GetPreviousAdmin() string {
+	if m != nil {
+		return m.PreviousAdmin
+	}
+	return ""
+}
+
+func (m *EventAcceptedAdmin) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+// EventRevokedPermission is emitted when the admin revokes a permission of a grantee.
+type EventRevokedPermission struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// address of the admin.
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// address of the grantee.
+	Grantee string `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// permission revoked.
+	Permission Permission `protobuf:"varint,4,opt,name=permission,proto3,enum=lbm.collection.v1.Permission" json:"permission,omitempty"`
+}
+
+func (m *EventRevokedPermission) Reset()         { *m = EventRevokedPermission{} }
+func (m *EventRevokedPermission) String() string { return proto.CompactTextString(m) }
+func (*EventRevokedPermission) ProtoMessage()    {}
+func (*EventRevokedPermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478cfab12ea1b00e, []int{23}
+}
+func (m *EventRevokedPermission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRevokedPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRevokedPermission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRevokedPermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRevokedPermission.Merge(m, src)
+}
+func (m *EventRevokedPermission) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRevokedPermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRevokedPermission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRevokedPermission proto.InternalMessageInfo
+
+func (m *EventRevokedPermission) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *EventRevokedPermission) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *EventRevokedPermission) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *EventRevokedPermission) GetPermission() Permission {
+	if m != nil {
+		return m.Permission
+	}
+	return PermissionUnspecified
+}
+
+func init() {
+	proto.RegisterEnum("lbm.collection.v1.AttributeKey", AttributeKey_name, AttributeKey_value)
+	proto.RegisterType((*EventSent)(nil), "lbm.collection.v1.EventSent")
+	proto.RegisterType((*EventAuthorizedOperator)(nil), "lbm.collection.v1.EventAuthorizedOperator")
+	proto.RegisterType((*EventRevokedOperator)(nil), "lbm.collection.v1.EventRevokedOperator")
+	proto.RegisterType((*EventCreatedContract)(nil), "lbm.collection.v1.EventCreatedContract")
+	proto.RegisterType((*EventCreatedFTClass)(nil), "lbm.collection.v1.EventCreatedFTClass")
+	proto.RegisterType((*EventCreatedNFTClass)(nil), "lbm.collection.v1.EventCreatedNFTClass")
+	proto.RegisterType((*EventGranted)(nil), "lbm.collection.v1.EventGranted")
+	proto.RegisterType((*EventRenounced)(nil), "lbm.collection.v1.EventRenounced")
+	proto.RegisterType((*EventMintedFT)(nil), "lbm.collection.v1.EventMintedFT")
+	proto.RegisterType((*EventMintedNFT)(nil), "lbm.collection.v1.EventMintedNFT")
+	proto.RegisterType((*EventLocked)(nil), "lbm.collection.v1.EventLocked")
+	proto.RegisterType((*EventBurned)(nil), "lbm.collection.v1.EventBurned")
+	proto.RegisterType((*EventModifiedContract)(nil), "lbm.collection.v1.EventModifiedContract")
+	proto.RegisterType((*EventModifiedTokenClass)(nil), "lbm.collection.v1.EventModifiedTokenClass")
+	proto.RegisterType((*EventModifiedNFT)(nil), "lbm.collection.v1.EventModifiedNFT")
+	proto.RegisterType((*EventAttached)(nil), "lbm.collection.v1.EventAttached")
+	proto.RegisterType((*EventDetached)(nil), "lbm.collection.v1.EventDetached")
+	proto.RegisterType((*EventOwnerChanged)(nil), "lbm.collection.v1.EventOwnerChanged")
+	proto.RegisterType((*EventRootChanged)(nil), "lbm.collection.v1.EventRootChanged")
+	proto.RegisterType((*EventSold)(nil), "lbm.collection.v1.EventSold")
+	proto.RegisterType((*EventSetNFTHistoryEnabled)(nil), "lbm.collection.v1.EventSetNFTHistoryEnabled")
+	proto.RegisterType((*EventProposedAdmin)(nil), "lbm.collection.v1.EventProposedAdmin")
+	proto.RegisterType((*EventAcceptedAdmin)(nil), "lbm.collection.v1.EventAcceptedAdmin")
+	proto.RegisterType((*EventRevokedPermission)(nil), "lbm.collection.v1.EventRevokedPermission")
+}
+
+func init() { proto.RegisterFile("lbm/collection/v1/event.proto", fileDescriptor_478cfab12ea1b00e) }
+
+var fileDescriptor_478cfab12ea1b00e = []byte{
+	// 1369 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4f, 0x6f, 0xdb, 0xc6,
+	0x12, 0x17, 0xf5, 0xdf, 0xe3, 0xc4, 0x91, 0x19, 0xc7, 0xa6, 0x99, 0x44, 0x11, 0x08, 0x04, 0xcf,
+	0xc8, 0x7b, 0x91, 0x60, 0x27, 0x39, 0xbc, 0xa0, 0x3d, 0x48, 0x8a, 0x9c, 0xb2, 0x89, 0x65, 0x81,
+	0x92, 0x0f, 0xe9, 0x45, 0xa0, 0xa8, 0xb5, 0xb4, 0x35, 0xc9, 0x25, 0x96, 0x2b, 0xb7, 0xea, 0x27,
+	0x28, 0x74, 0x2a, 0x9a, 0xb6, 0x37, 0x5d, 0x9a, 0x02, 0xcd, 0xa9, 0xd7, 0x7e, 0x85, 0x5c, 0x0a,
+	0xe4, 0x98, 0x53, 0x51, 0x24, 0x5f, 0xa4, 0xe0, 0x92, 0x94, 0xa9, 0x48, 0x8d, 0xed, 0x2a, 0x69,
+	0x6f, 0x3b, 0xb3, 0xf3, 0xe7, 0x37, 0x33, 0xcb, 0xd9, 0x59, 0xc2, 0x75, 0xb3, 0x63, 0x95, 0x0c,
+	0x62, 0x9a, 0xc8, 0x60, 0x98, 0xd8, 0xa5, 0xe3, 0xed, 0x12, 0x3a, 0x46, 0x36, 0x2b, 0x3a, 0x94,
+	0x30, 0x22, 0xae, 0x9a, 0x1d, 0xab, 0x78, 0xb2, 0x5d, 0x3c, 0xde, 0x96, 0xd7, 0x7a, 0xa4, 0x47,
+	0xf8, 0x6e, 0xc9, 0x5b, 0xf9, 0x82, 0x72, 0xde, 0x20, 0xae, 0x45, 0xdc, 0x52, 0x47, 0x77, 0x51,
+	0xe9, 0x78, 0xbb, 0x83, 0x98, 0xbe, 0x5d, 0x32, 0x08, 0xb6, 0x83, 0x7d, 0x65, 0xd6, 0x4f, 0xc4,
+	0x2c, 0x97, 0x51, 0x9e, 0x09, 0xb0, 0x54, 0xf3, 0x9c, 0x37, 0x91, 0xcd, 0xc4, 0x1b, 0xb0, 0x6c,
+	0x10, 0x9b, 0x51, 0xdd, 0x60, 0x6d, 0xdc, 0x95, 0x84, 0x82, 0xb0, 0xb5, 0xa4, 0x41, 0xc8, 0x52,
+	0xbb, 0xa2, 0x0c, 0x59, 0xe2, 0x20, 0xaa, 0x33, 0x42, 0xa5, 0x38, 0xdf, 0x9d, 0xd0, 0xa2, 0x08,
+	0xc9, 0x43, 0x4a, 0x2c, 0x29, 0xc1, 0xf9, 0x7c, 0x2d, 0xae, 0x40, 0x9c, 0x11, 0x29, 0xc9, 0x39,
+	0x71, 0x46, 0xc4, 0x7b, 0x90, 0xd6, 0x2d, 0x32, 0xb0, 0x99, 0x94, 0x2a, 0x24, 0xb6, 0x96, 0x77,
+	0x36, 0x8a, 0x33, 0xc1, 0x16, 0xab, 0x04, 0xdb, 0x95, 0xe4, 0x8b, 0xdf, 0x6f, 0xc4, 0xb4, 0x40,
+	0x58, 0xb1, 0x61, 0x83, 0x83, 0x2c, 0x0f, 0x58, 0x9f, 0x50, 0xfc, 0x15, 0xea, 0xee, 0x87, 0x5e,
+	0x4f, 0x85, 0xbc, 0x0e, 0xe9, 0x3e, 0x31, 0xbb, 0x28, 0x04, 0x1c, 0x50, 0x53, 0xa1, 0x24, 0xa6,
+	0x43, 0x51, 0x8e, 0x60, 0x8d, 0xfb, 0xd3, 0xd0, 0x31, 0x39, 0xfa, 0xd0, 0xce, 0x5e, 0x09, 0x81,
+	0xb7, 0x2a, 0x45, 0x3a, 0x43, 0xdd, 0x6a, 0x60, 0x4e, 0x94, 0x20, 0x63, 0x78, 0x2c, 0x42, 0x03,
+	0x4f, 0x21, 0xf9, 0x36, 0x8e, 0xf8, 0x0c, 0x0e, 0x11, 0x92, 0xb6, 0x6e, 0xa1, 0xb0, 0x16, 0xde,
+	0xda, 0xe3, 0x59, 0x88, 0xe9, 0x41, 0x35, 0xf8, 0x5a, 0xcc, 0x41, 0x62, 0x40, 0xb1, 0x94, 0xe2,
+	0x2c, 0x6f, 0x29, 0x3e, 0x82, 0x0b, 0x8c, 0xea, 0x98, 0xb5, 0x5d, 0xa3, 0x8f, 0x2c, 0x5d, 0x4a,
+	0xf3, 0x3a, 0x29, 0x73, 0xea, 0xd4, 0xf2, 0xc4, 0x1e, 0xa0, 0x43, 0x6c, 0x63, 0x8f, 0x15, 0x94,
+	0x6c, 0x99, 0x6b, 0x37, 0xb9, 0xb2, 0xf2, 0x9b, 0x00, 0x97, 0xa3, 0xa1, 0xed, 0xb6, 0xaa, 0xa6,
+	0xee, 0xba, 0x8b, 0x9d, 0xb3, 0x4d, 0xc8, 0x32, 0x72, 0x84, 0x6c, 0x4f, 0xd3, 0x8f, 0x2f, 0xc3,
+	0xe9, 0x48, 0xd8, 0xc9, 0x39, 0x61, 0xa7, 0x22, 0x61, 0xcb, 0x90, 0xed, 0x22, 0x03, 0x5b, 0xba,
+	0xe9, 0x4a, 0xe9, 0x82, 0xb0, 0x95, 0xd2, 0x26, 0xb4, 0xb7, 0x67, 0x61, 0x9b, 0xe9, 0x1d, 0x13,
+	0x49, 0x99, 0x82, 0xb0, 0x95, 0xd5, 0x26, 0xb4, 0x32, 0x8e, 0x4f, 0x97, 0xaa, 0xfe, 0x5e, 0x02,
+	0xba, 0x0e, 0xe0, 0x07, 0xc4, 0x86, 0x4e, 0x58, 0xb2, 0x25, 0xce, 0x69, 0x0d, 0x1d, 0x74, 0xe6,
+	0xa0, 0xee, 0x42, 0x86, 0x92, 0xa1, 0x6e, 0xb2, 0x21, 0x8f, 0x69, 0x79, 0x47, 0x9e, 0x53, 0x34,
+	0xcd, 0x97, 0xd0, 0x42, 0xd1, 0x99, 0x7a, 0x67, 0x16, 0xa9, 0xf7, 0x8f, 0x02, 0x5c, 0xe0, 0xf9,
+	0x79, 0x48, 0x75, 0x9b, 0xa1, 0xee, 0xe9, 0x79, 0x91, 0x20, 0xd3, 0xe3, 0xb2, 0x61, 0x5a, 0x42,
+	0xf2, 0x64, 0x27, 0x4c, 0x49, 0x48, 0x8a, 0x1f, 0x03, 0x38, 0x88, 0x5a, 0xd8, 0x75, 0x31, 0xb1,
+	0x79, 0x5a, 0x56, 0x76, 0xae, 0xcf, 0x01, 0xdc, 0x98, 0x08, 0x69, 0x11, 0x05, 0x65, 0x24, 0xc0,
+	0x4a, 0xf0, 0x75, 0xdb, 0x64, 0x60, 0x1b, 0xe7, 0x82, 0x89, 0xa4, 0xf8, 0xbb, 0xc0, 0x24, 0xce,
+	0x0b, 0xe6, 0xa9, 0x00, 0x17, 0x39, 0x98, 0x3d, 0x6c, 0xf3, 0x0f, 0x64, 0xb1, 0xa3, 0xe4, 0xf7,
+	0xdb, 0xc4, 0x9c, 0x7e, 0x9b, 0x3c, 0x4f, 0xbf, 0x7d, 0x1a, 0xa6, 0xc8, 0x47, 0x55, 0x7f, 0xdf,
+	0xb0, 0xee, 0x42, 0x9a, 0x9f, 0x6f, 0x37, 0x80, 0xb5, 0x3e, 0x07, 0x56, 0x7d, 0xb7, 0x15, 0xa2,
+	0xf2, 0x65, 0x15, 0x03, 0x96, 0x39, 0xa8, 0xc7, 0xc4, 0x38, 0x3a, 0x4b, 0xd1, 0xee, 0x40, 0xca,
+	0x24, 0xc6, 0x91, 0x2b, 0xc5, 0xff, 0x32, 0x76, 0xcf, 0x54, 0xe0, 0xc5, 0x97, 0x55, 0xbe, 0x17,
+	0x02, 0x2f, 0x95, 0x01, 0xb5, 0x51, 0x77, 0xb1, 0xb8, 0xe7, 0x5d, 0x89, 0x7f, 0xb3, 0x24, 0xdf,
+	0x0a, 0x70, 0xc5, 0x2f, 0x09, 0xe9, 0xe2, 0x43, 0x1c, 0xb9, 0x26, 0x16, 0x42, 0xf8, 0x11, 0x64,
+	0x8c, 0xbe, 0x6e, 0xf7, 0x90, 0x2b, 0x25, 0x38, 0x9c, 0x6b, 0x73, 0xe0, 0x94, 0x19, 0xa3, 0xb8,
+	0x33, 0x60, 0x28, 0xc0, 0x14, 0xaa, 0x28, 0x2f, 0x05, 0xd8, 0x98, 0x02, 0xd5, 0xf2, 0x2a, 0xf5,
+	0xe1, 0x5b, 0x62, 0x04, 0x75, 0xf2, 0xdc, 0xa8, 0xc5, 0xab, 0xb0, 0xe4, 0x99, 0x6d, 0xf3, 0xae,
+	0xea, 0x77, 0xd0, 0xac, 0xc7, 0xa8, 0xeb, 0x16, 0x52, 0x9e, 0x0b, 0x90, 0x9b, 0x0a, 0x69, 0xe1,
+	0xc3, 0xff, 0x8e, 0xfb, 0x6a, 0xa1, 0x38, 0x94, 0x1f, 0xc2, 0xde, 0x51, 0x66, 0x4c, 0x37, 0xfa,
+	0x8b, 0x1e, 0xd6, 0x93, 0xd9, 0x25, 0x31, 0x35, 0xbb, 0x48, 0x90, 0x71, 0x07, 0x9d, 0xcf, 0x91,
+	0xc1, 0x82, 0x2b, 0x28, 0x24, 0x3d, 0x0d, 0xa6, 0xd3, 0x1e, 0x62, 0x41, 0x16, 0x03, 0x4a, 0xf9,
+	0x39, 0x04, 0xf6, 0x00, 0xfd, 0x3b, 0xc0, 0xfe, 0x03, 0x97, 0x1c, 0x8a, 0x8e, 0x31, 0x19, 0xb8,
+	0x6d, 0x47, 0xa7, 0xc8, 0x0e, 0x11, 0xae, 0x84, 0xec, 0x06, 0xe7, 0x2a, 0x2e, 0xac, 0x72, 0xa0,
+	0xfb, 0x5f, 0xd8, 0x88, 0x56, 0x79, 0x5e, 0xcf, 0x00, 0x36, 0x5a, 0xd1, 0xf8, 0xcc, 0x04, 0x72,
+	0xda, 0x10, 0xac, 0xd0, 0xe0, 0x84, 0x69, 0x84, 0xb0, 0x7f, 0xca, 0xe7, 0x77, 0xf1, 0x70, 0xce,
+	0x27, 0x66, 0xf7, 0x4c, 0x73, 0xac, 0x8b, 0x4c, 0xf3, 0x64, 0x8e, 0xf5, 0x29, 0x71, 0x0d, 0x52,
+	0x9d, 0xc1, 0x70, 0x52, 0x09, 0x9f, 0x98, 0xc2, 0x96, 0x9c, 0xc6, 0x76, 0x0f, 0x52, 0x0e, 0xc5,
+	0x86, 0xff, 0x9d, 0x2d, 0xef, 0x6c, 0x16, 0xfd, 0x37, 0x4b, 0xd1, 0x7b, 0xb3, 0x14, 0x83, 0x37,
+	0x4b, 0xb4, 0xdd, 0xf9, 0xd2, 0xe2, 0x7f, 0x61, 0x35, 0x18, 0x50, 0xda, 0x14, 0x19, 0xd8, 0xc1,
+	0x5e, 0x09, 0xd3, 0xdc, 0x74, 0x2e, 0xd8, 0xd0, 0x42, 0xbe, 0xf8, 0xff, 0x93, 0xc1, 0x27, 0x73,
+	0x36, 0x2f, 0xa1, 0xbc, 0x42, 0x61, 0x33, 0x78, 0xfd, 0xb0, 0xfa, 0x6e, 0xeb, 0x13, 0xec, 0x32,
+	0x42, 0x87, 0x35, 0xdb, 0x1b, 0xf6, 0x16, 0x3c, 0xb4, 0x12, 0x64, 0x90, 0x6f, 0x87, 0xe7, 0x2a,
+	0xab, 0x85, 0xa4, 0x42, 0x41, 0xe4, 0x3e, 0x1b, 0x94, 0x38, 0xc4, 0x45, 0xdd, 0x72, 0xd7, 0xc2,
+	0xf6, 0xe9, 0xce, 0xd6, 0x20, 0xa5, 0x7b, 0x92, 0x81, 0x27, 0x9f, 0x10, 0x6f, 0xc2, 0x8a, 0x13,
+	0xd8, 0x69, 0xfb, 0xdb, 0x7e, 0x65, 0x2e, 0x3a, 0x51, 0xeb, 0x13, 0x9f, 0x65, 0xc3, 0x40, 0x0e,
+	0x3b, 0xb3, 0xcf, 0x9b, 0x30, 0xf9, 0x60, 0xda, 0x51, 0xe7, 0x17, 0x43, 0xae, 0x6f, 0x67, 0x02,
+	0x2d, 0x11, 0x81, 0xa6, 0xfc, 0x22, 0xc0, 0x7a, 0xf4, 0x15, 0x75, 0x32, 0x01, 0x2d, 0x9c, 0xd9,
+	0x0f, 0x32, 0x18, 0xde, 0xfa, 0x35, 0x01, 0x17, 0x26, 0xcd, 0xf6, 0x11, 0x1a, 0x8a, 0xf7, 0x61,
+	0xb3, 0xdc, 0x6a, 0x69, 0x6a, 0xe5, 0xa0, 0x55, 0x6b, 0x3f, 0xaa, 0x3d, 0x69, 0x1f, 0xd4, 0x9b,
+	0x8d, 0x5a, 0x55, 0xdd, 0x55, 0x6b, 0x0f, 0x72, 0x31, 0xf9, 0xea, 0x68, 0x5c, 0xd8, 0x88, 0x2a,
+	0x1c, 0xd8, 0xae, 0x83, 0x0c, 0x7e, 0x6b, 0x88, 0xff, 0x03, 0x71, 0x5a, 0xb7, 0x5e, 0xde, 0xab,
+	0xe5, 0x04, 0x79, 0x6d, 0x34, 0x2e, 0xe4, 0xa2, 0x4a, 0xde, 0xad, 0x33, 0x2b, 0xbd, 0x57, 0x6b,
+	0x95, 0x73, 0xf1, 0x59, 0xe9, 0x3d, 0x6f, 0xd2, 0xbf, 0x0f, 0xf2, 0xb4, 0x74, 0xa5, 0xdc, 0xac,
+	0xb5, 0xd5, 0xbd, 0x87, 0xed, 0x03, 0x4d, 0xcd, 0x65, 0x65, 0x79, 0x34, 0x2e, 0xac, 0x47, 0xb5,
+	0x2a, 0xba, 0x8b, 0x54, 0xab, 0x77, 0xa0, 0xa9, 0xe2, 0x2d, 0x58, 0x7d, 0x2b, 0x26, 0x4d, 0xcd,
+	0xad, 0xc9, 0x97, 0x47, 0xe3, 0xc2, 0xa5, 0xa9, 0x58, 0x34, 0x55, 0xac, 0xc1, 0x8d, 0x69, 0x59,
+	0x6d, 0xff, 0x49, 0xf9, 0x71, 0xeb, 0x49, 0x5b, 0xab, 0x55, 0xd5, 0x86, 0x5a, 0xab, 0xb7, 0x72,
+	0x57, 0xe4, 0xc2, 0x68, 0x5c, 0xb8, 0x16, 0xd5, 0xd4, 0xde, 0xfe, 0x3e, 0x3f, 0x05, 0x65, 0xbe,
+	0x99, 0x4a, 0xb9, 0xa9, 0x36, 0xdb, 0x8d, 0x7d, 0xb5, 0xde, 0x6a, 0xe6, 0xd6, 0x65, 0x65, 0x34,
+	0x2e, 0xe4, 0xe7, 0x58, 0xaa, 0xe8, 0x2e, 0x76, 0x1b, 0x04, 0xdb, 0xcc, 0x95, 0xb3, 0x5f, 0x3f,
+	0xcb, 0xc7, 0x9e, 0xff, 0x94, 0x8f, 0x29, 0xc9, 0x6c, 0x22, 0x97, 0x51, 0x92, 0xd9, 0xa5, 0xdc,
+	0xe5, 0xca, 0xc3, 0x17, 0xaf, 0xf3, 0xc2, 0xcb, 0xd7, 0x79, 0xe1, 0x8f, 0xd7, 0x79, 0xe1, 0x9b,
+	0x37, 0xf9, 0xd8, 0xcb, 0x37, 0xf9, 0xd8, 0xab, 0x37, 0xf9, 0xd8, 0x67, 0xb7, 0x7b, 0x98, 0xf5,
+	0x07, 0x9d, 0xa2, 0x41, 0xac, 0xd2, 0x2e, 0xb6, 0x5d, 0xa3, 0x8f, 0xf5, 0xd2, 0x61, 0xb0, 0xb8,
+	0xed, 0x76, 0x8f, 0x4a, 0x5f, 0x46, 0x7e, 0x8a, 0x74, 0xd2, 0xfc, 0xaf, 0xc8, 0x9d, 0x3f, 0x07,
+	0x00, 0x96, 0xba, 0xc4, 0x7b, 0xa3, 0x11, 0x00, 0x00,
+}
+
+func (m *EventSent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Operator) > 0 {
 		i -= len(m.Operator)
 		copy(dAtA[i:], m.Operator)
 		i = encodeVarintEvent(dAtA, i, uint64(len(m.Operator)))
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Holder) > 0 {
-		i -= len(m.Holder)
-		copy(dAtA[i:], m.Holder)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Holder)))
-		i--
 		dAtA[i] = 0x12
 	}
 	if len(m.ContractId) > 0 {
@@ -1701,7 +2098,7 @@ func (m *EventRevokedOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventCreatedContract) Marshal() (dAtA []byte, err error) {
+func (m *EventAuthorizedOperator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1711,55 +2108,41 @@ func (m *EventCreatedContract) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventCreatedContract) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventAuthorizedOperator) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventCreatedContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventAuthorizedOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Uri) > 0 {
-		i -= len(m.Uri)
-		copy(dAtA[i:], m.Uri)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Uri)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Meta) > 0 {
-		i -= len(m.Meta)
-		copy(dAtA[i:], m.Meta)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Meta)))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Operator)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x1a
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Name)))
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Holder)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 	}
 	if len(m.ContractId) > 0 {
 		i -= len(m.ContractId)
 		copy(dAtA[i:], m.ContractId)
 		i = encodeVarintEvent(dAtA, i, uint64(len(m.ContractId)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Creator)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventCreatedFTClass) Marshal() (dAtA []byte, err error) {
+func (m *EventRevokedOperator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1769,19 +2152,135 @@ func (m *EventCreatedFTClass) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventCreatedFTClass) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventRevokedOperator) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventCreatedFTClass) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventRevokedOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Mintable {
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Operator)))
 		i--
-		if m.Mintable {
+		dAtA[i] = 0x1a
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCreatedContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCreatedContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCreatedContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TraitSchema) > 0 {
+		for iNdEx := len(m.TraitSchema) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TraitSchema[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Uri) > 0 {
+		i -= len(m.Uri)
+		copy(dAtA[i:], m.Uri)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Uri)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Meta) > 0 {
+		i -= len(m.Meta)
+		copy(dAtA[i:], m.Meta)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Meta)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCreatedFTClass) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCreatedFTClass) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCreatedFTClass) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Mintable {
+		i--
+		if m.Mintable {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
@@ -1852,6 +2351,32 @@ func (m *EventCreatedNFTClass) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TraitSchema) > 0 {
+		for iNdEx := len(m.TraitSchema) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TraitSchema[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Royalty != nil {
+		{
+			size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Meta) > 0 {
 		i -= len(m.Meta)
 		copy(dAtA[i:], m.Meta)
@@ -2097,6 +2622,50 @@ func (m *EventMintedNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventLocked) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventLocked) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventLocked) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Locks) > 0 {
+		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventBurned) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2547,57 +3116,319 @@ func (m *EventRootChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventSold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventSent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventSold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	{
+		size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.RoyaltyRecipient) > 0 {
+		i -= len(m.RoyaltyRecipient)
+		copy(dAtA[i:], m.RoyaltyRecipient)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.RoyaltyRecipient)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovEvent(uint64(l))
-		}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventAuthorizedOperator) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSetNFTHistoryEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetNFTHistoryEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetNFTHistoryEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventProposedAdmin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventProposedAdmin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventProposedAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProposedAdmin) > 0 {
+		i -= len(m.ProposedAdmin)
+		copy(dAtA[i:], m.ProposedAdmin)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ProposedAdmin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAcceptedAdmin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAcceptedAdmin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAcceptedAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PreviousAdmin) > 0 {
+		i -= len(m.PreviousAdmin)
+		copy(dAtA[i:], m.PreviousAdmin)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.PreviousAdmin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRevokedPermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRevokedPermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRevokedPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Permission != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Permission))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventSent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventAuthorizedOperator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
 	}
 	l = len(m.Holder)
 	if l > 0 {
@@ -2657,6 +3488,12 @@ func (m *EventCreatedContract) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
+	if len(m.TraitSchema) > 0 {
+		for _, e := range m.TraitSchema {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2721,6 +3558,16 @@ func (m *EventCreatedNFTClass) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
+	if m.Royalty != nil {
+		l = m.Royalty.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.TraitSchema) > 0 {
+		for _, e := range m.TraitSchema {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2822,6 +3669,25 @@ func (m *EventMintedNFT) Size() (n int) {
 	return n
 }
 
+func (m *EventLocked) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *EventBurned) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2927,124 +3793,991 @@ func (m *EventModifiedNFT) Size() (n int) {
 			n += 1 + l + sovEvent(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *EventAttached) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Holder)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Subject)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Target)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	return n
-}
-
-func (m *EventDetached) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Holder)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Subject)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.PreviousParent)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	return n
-}
-
-func (m *EventOwnerChanged) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.TokenId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	return n
-}
+	return n
+}
+
+func (m *EventAttached) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDetached) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.PreviousParent)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOwnerChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRootChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.RoyaltyRecipient)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Royalty.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventSetNFTHistoryEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventProposedAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ProposedAdmin)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAcceptedAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.PreviousAdmin)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRevokedPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Permission != 0 {
+		n += 1 + sovEvent(uint64(m.Permission))
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventSent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAuthorizedOperator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAuthorizedOperator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAuthorizedOperator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRevokedOperator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRevokedOperator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRevokedOperator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCreatedContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreatedContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreatedContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Meta = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraitSchema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraitSchema = append(m.TraitSchema, TraitDefinition{})
+			if err := m.TraitSchema[len(m.TraitSchema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *EventRootChanged) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.TokenId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventSent) Unmarshal(dAtA []byte) error {
+func (m *EventCreatedFTClass) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3067,10 +4800,10 @@ func (m *EventSent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSent: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCreatedFTClass: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCreatedFTClass: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3139,7 +4872,7 @@ func (m *EventSent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3167,11 +4900,11 @@ func (m *EventSent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.TokenId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3199,13 +4932,13 @@ func (m *EventSent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -3215,26 +4948,63 @@ func (m *EventSent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Meta = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mintable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Mintable = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -3256,7 +5026,7 @@ func (m *EventSent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAuthorizedOperator) Unmarshal(dAtA []byte) error {
+func (m *EventCreatedNFTClass) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3279,15 +5049,79 @@ func (m *EventAuthorizedOperator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAuthorizedOperator: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCreatedNFTClass: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAuthorizedOperator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCreatedNFTClass: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3315,11 +5149,11 @@ func (m *EventAuthorizedOperator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractId = string(dAtA[iNdEx:postIndex])
+			m.TokenType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3347,11 +5181,11 @@ func (m *EventAuthorizedOperator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holder = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3379,7 +5213,77 @@ func (m *EventAuthorizedOperator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Meta = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Royalty == nil {
+				m.Royalty = &Royalty{}
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraitSchema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraitSchema = append(m.TraitSchema, TraitDefinition{})
+			if err := m.TraitSchema[len(m.TraitSchema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3402,7 +5306,7 @@ func (m *EventAuthorizedOperator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRevokedOperator) Unmarshal(dAtA []byte) error {
+func (m *EventGranted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3425,10 +5329,10 @@ func (m *EventRevokedOperator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRevokedOperator: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventGranted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRevokedOperator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventGranted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3465,7 +5369,7 @@ func (m *EventRevokedOperator) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3493,11 +5397,11 @@ func (m *EventRevokedOperator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holder = string(dAtA[iNdEx:postIndex])
+			m.Granter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3525,8 +5429,27 @@ func (m *EventRevokedOperator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			}
+			m.Permission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permission |= Permission(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -3548,7 +5471,7 @@ func (m *EventRevokedOperator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCreatedContract) Unmarshal(dAtA []byte) error {
+func (m *EventRenounced) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3571,45 +5494,13 @@ func (m *EventCreatedContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCreatedContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRenounced: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCreatedContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRenounced: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
@@ -3641,41 +5532,9 @@ func (m *EventCreatedContract) Unmarshal(dAtA []byte) error {
 			}
 			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3703,13 +5562,13 @@ func (m *EventCreatedContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Meta = string(dAtA[iNdEx:postIndex])
+			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
 			}
-			var stringLen uint64
+			m.Permission = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -3719,24 +5578,11 @@ func (m *EventCreatedContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Permission |= Permission(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Uri = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -3758,7 +5604,7 @@ func (m *EventCreatedContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCreatedFTClass) Unmarshal(dAtA []byte) error {
+func (m *EventMintedFT) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3781,47 +5627,15 @@ func (m *EventCreatedFTClass) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCreatedFTClass: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMintedFT: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCreatedFTClass: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContractId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			return fmt.Errorf("proto: EventMintedFT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3849,11 +5663,11 @@ func (m *EventCreatedFTClass) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3881,11 +5695,11 @@ func (m *EventCreatedFTClass) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenId = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3913,13 +5727,13 @@ func (m *EventCreatedFTClass) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -3929,63 +5743,26 @@ func (m *EventCreatedFTClass) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Meta = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
-			}
-			m.Decimals = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Decimals |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mintable", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Amount = append(m.Amount, Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Mintable = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -4007,7 +5784,7 @@ func (m *EventCreatedFTClass) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCreatedNFTClass) Unmarshal(dAtA []byte) error {
+func (m *EventMintedNFT) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4030,10 +5807,10 @@ func (m *EventCreatedNFTClass) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCreatedNFTClass: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMintedNFT: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCreatedNFTClass: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMintedNFT: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4102,7 +5879,7 @@ func (m *EventCreatedNFTClass) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4130,13 +5907,13 @@ func (m *EventCreatedNFTClass) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenType = string(dAtA[iNdEx:postIndex])
+			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -4146,27 +5923,79 @@ func (m *EventCreatedNFTClass) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Tokens = append(m.Tokens, NFT{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventLocked) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventLocked: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventLocked: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4194,7 +6023,41 @@ func (m *EventCreatedNFTClass) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Meta = string(dAtA[iNdEx:postIndex])
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locks = append(m.Locks, Lock{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4217,7 +6080,7 @@ func (m *EventCreatedNFTClass) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventGranted) Unmarshal(dAtA []byte) error {
+func (m *EventBurned) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4240,10 +6103,10 @@ func (m *EventGranted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventGranted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventBurned: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventGranted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventBurned: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4280,7 +6143,7 @@ func (m *EventGranted) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4308,11 +6171,11 @@ func (m *EventGranted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Granter = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4340,13 +6203,13 @@ func (m *EventGranted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.Permission = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -4356,11 +6219,26 @@ func (m *EventGranted) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Permission |= Permission(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -4382,7 +6260,7 @@ func (m *EventGranted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRenounced) Unmarshal(dAtA []byte) error {
+func (m *EventModifiedContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4405,10 +6283,10 @@ func (m *EventRenounced) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRenounced: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventModifiedContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRenounced: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventModifiedContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4445,7 +6323,7 @@ func (m *EventRenounced) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4473,13 +6351,13 @@ func (m *EventRenounced) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
 			}
-			m.Permission = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -4489,11 +6367,26 @@ func (m *EventRenounced) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Permission |= Permission(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, Attribute{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -4515,7 +6408,7 @@ func (m *EventRenounced) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMintedFT) Unmarshal(dAtA []byte) error {
+func (m *EventModifiedTokenClass) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4538,10 +6431,10 @@ func (m *EventMintedFT) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMintedFT: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventModifiedTokenClass: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMintedFT: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventModifiedTokenClass: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4610,7 +6503,7 @@ func (m *EventMintedFT) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4638,11 +6531,11 @@ func (m *EventMintedFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			m.TokenType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4669,11 +6562,43 @@ func (m *EventMintedFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Changes = append(m.Changes, Attribute{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -4695,7 +6620,7 @@ func (m *EventMintedFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMintedNFT) Unmarshal(dAtA []byte) error {
+func (m *EventModifiedNFT) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4718,10 +6643,10 @@ func (m *EventMintedNFT) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMintedNFT: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventModifiedNFT: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMintedNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventModifiedNFT: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4790,7 +6715,7 @@ func (m *EventMintedNFT) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4818,11 +6743,11 @@ func (m *EventMintedNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			m.TokenId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4849,8 +6774,8 @@ func (m *EventMintedNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tokens = append(m.Tokens, NFT{})
-			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Changes = append(m.Changes, Attribute{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4875,7 +6800,7 @@ func (m *EventMintedNFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBurned) Unmarshal(dAtA []byte) error {
+func (m *EventAttached) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4898,10 +6823,10 @@ func (m *EventBurned) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBurned: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAttached: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBurned: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAttached: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4970,7 +6895,7 @@ func (m *EventBurned) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4998,13 +6923,13 @@ func (m *EventBurned) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.Holder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -5014,25 +6939,55 @@ func (m *EventBurned) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5055,7 +7010,7 @@ func (m *EventBurned) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventModifiedContract) Unmarshal(dAtA []byte) error {
+func (m *EventDetached) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5078,10 +7033,10 @@ func (m *EventModifiedContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventModifiedContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDetached: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventModifiedContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDetached: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5150,9 +7105,9 @@ func (m *EventModifiedContract) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -5162,25 +7117,87 @@ func (m *EventModifiedContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Changes = append(m.Changes, Attribute{})
-			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousParent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousParent = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5203,7 +7220,7 @@ func (m *EventModifiedContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventModifiedTokenClass) Unmarshal(dAtA []byte) error {
+func (m *EventOwnerChanged) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5226,10 +7243,10 @@ func (m *EventModifiedTokenClass) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventModifiedTokenClass: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventOwnerChanged: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventModifiedTokenClass: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventOwnerChanged: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5266,7 +7283,7 @@ func (m *EventModifiedTokenClass) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5294,11 +7311,11 @@ func (m *EventModifiedTokenClass) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.TokenId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5326,45 +7343,11 @@ func (m *EventModifiedTokenClass) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenType = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Changes = append(m.Changes, Attribute{})
-			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TypeName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5392,7 +7375,7 @@ func (m *EventModifiedTokenClass) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TypeName = string(dAtA[iNdEx:postIndex])
+			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5415,7 +7398,7 @@ func (m *EventModifiedTokenClass) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventModifiedNFT) Unmarshal(dAtA []byte) error {
+func (m *EventRootChanged) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5438,10 +7421,10 @@ func (m *EventModifiedNFT) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventModifiedNFT: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRootChanged: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventModifiedNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRootChanged: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5478,7 +7461,7 @@ func (m *EventModifiedNFT) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5506,11 +7489,11 @@ func (m *EventModifiedNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.TokenId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5538,13 +7521,13 @@ func (m *EventModifiedNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenId = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -5554,25 +7537,23 @@ func (m *EventModifiedNFT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Changes = append(m.Changes, Attribute{})
-			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5595,7 +7576,7 @@ func (m *EventModifiedNFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAttached) Unmarshal(dAtA []byte) error {
+func (m *EventSold) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5618,10 +7599,10 @@ func (m *EventAttached) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAttached: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSold: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAttached: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSold: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5658,7 +7639,7 @@ func (m *EventAttached) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5686,11 +7667,11 @@ func (m *EventAttached) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Seller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5718,11 +7699,11 @@ func (m *EventAttached) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holder = string(dAtA[iNdEx:postIndex])
+			m.Buyer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5750,11 +7731,44 @@ func (m *EventAttached) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subject = string(dAtA[iNdEx:postIndex])
+			m.TokenId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyRecipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5782,7 +7796,40 @@ func (m *EventAttached) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Target = string(dAtA[iNdEx:postIndex])
+			m.RoyaltyRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5805,7 +7852,7 @@ func (m *EventAttached) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDetached) Unmarshal(dAtA []byte) error {
+func (m *EventSetNFTHistoryEnabled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5828,10 +7875,10 @@ func (m *EventDetached) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDetached: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSetNFTHistoryEnabled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDetached: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSetNFTHistoryEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5899,8 +7946,78 @@ func (m *EventDetached) Unmarshal(dAtA []byte) error {
 			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventProposedAdmin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventProposedAdmin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventProposedAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5928,11 +8045,11 @@ func (m *EventDetached) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holder = string(dAtA[iNdEx:postIndex])
+			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5960,11 +8077,11 @@ func (m *EventDetached) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subject = string(dAtA[iNdEx:postIndex])
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousParent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedAdmin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5992,7 +8109,7 @@ func (m *EventDetached) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousParent = string(dAtA[iNdEx:postIndex])
+			m.ProposedAdmin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6015,7 +8132,7 @@ func (m *EventDetached) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOwnerChanged) Unmarshal(dAtA []byte) error {
+func (m *EventAcceptedAdmin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6038,10 +8155,10 @@ func (m *EventOwnerChanged) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOwnerChanged: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAcceptedAdmin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOwnerChanged: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAcceptedAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6078,7 +8195,7 @@ func (m *EventOwnerChanged) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAdmin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6106,43 +8223,11 @@ func (m *EventOwnerChanged) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenId = string(dAtA[iNdEx:postIndex])
+			m.PreviousAdmin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.From = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6170,7 +8255,7 @@ func (m *EventOwnerChanged) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6193,7 +8278,7 @@ func (m *EventOwnerChanged) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRootChanged) Unmarshal(dAtA []byte) error {
+func (m *EventRevokedPermission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6216,10 +8301,10 @@ func (m *EventRootChanged) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRootChanged: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRevokedPermission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRootChanged: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRevokedPermission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6256,7 +8341,7 @@ func (m *EventRootChanged) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6284,11 +8369,11 @@ func (m *EventRootChanged) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenId = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6316,13 +8401,13 @@ func (m *EventRootChanged) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
 			}
-			var stringLen uint64
+			m.Permission = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -6332,24 +8417,11 @@ func (m *EventRootChanged) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Permission |= Permission(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.To = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package collection
 
 import (
 	sdk "github.com/Finschia/finschia-sdk/types"
+	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
 )
 
 type (
@@ -10,4 +11,17 @@ type (
 		NewID(ctx sdk.Context) string
 		HasID(ctx sdk.Context, id string) bool
 	}
+
+	// AccountKeeper defines the auth module interface contract needed by the
+	// collection module simulation.
+	AccountKeeper interface {
+		GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
+	}
+
+	// BankKeeper defines the bank module interface contract needed by the
+	// collection module.
+	BankKeeper interface {
+		SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+		SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	}
 )
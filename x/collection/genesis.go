@@ -10,6 +10,8 @@ import (
 const (
 	DefaultDepthLimit = 1
 	DefaultWidthLimit = 4
+
+	DefaultMaxBatchSize = 100
 )
 
 // ValidateGenesis check the given genesis state has no integrity issues
@@ -32,6 +34,19 @@ func ValidateGenesis(data GenesisState) error {
 		if err := validateMeta(contract.Meta); err != nil {
 			return err
 		}
+		if err := validateTraitSchema(contract.TraitSchema); err != nil {
+			return err
+		}
+		if len(contract.Admin) != 0 {
+			if _, err := sdk.AccAddressFromBech32(contract.Admin); err != nil {
+				return err
+			}
+		}
+		if len(contract.PendingAdmin) != 0 {
+			if _, err := sdk.AccAddressFromBech32(contract.PendingAdmin); err != nil {
+				return err
+			}
+		}
 	}
 
 	for _, nextClassID := range data.NextClassIds {
@@ -108,6 +123,9 @@ func ValidateGenesis(data GenesisState) error {
 			if err := validateMeta(token.Meta); err != nil {
 				return err
 			}
+			if err := validateTraits(token.Traits); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -201,6 +219,62 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	for _, contractLocks := range data.Locks {
+		if err := ValidateContractID(contractLocks.ContractId); err != nil {
+			return err
+		}
+
+		if len(contractLocks.Locks) == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrap("locks cannot be empty")
+		}
+		for _, lock := range contractLocks.Locks {
+			if err := lock.ValidateBasic(); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, contractID := range data.NftHistoryContracts {
+		if err := ValidateContractID(contractID); err != nil {
+			return err
+		}
+	}
+
+	for _, contractHistories := range data.NftHistories {
+		if err := ValidateContractID(contractHistories.ContractId); err != nil {
+			return err
+		}
+
+		if len(contractHistories.Entries) == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrap("entries cannot be empty")
+		}
+		for _, entry := range contractHistories.Entries {
+			if err := entry.ValidateBasic(); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, contractHistory := range data.AdminHistories {
+		if err := ValidateContractID(contractHistory.ContractId); err != nil {
+			return err
+		}
+
+		if len(contractHistory.Entries) == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrap("entries cannot be empty")
+		}
+		for _, entry := range contractHistory.Entries {
+			if len(entry.PreviousAdmin) != 0 {
+				if _, err := sdk.AccAddressFromBech32(entry.PreviousAdmin); err != nil {
+					return err
+				}
+			}
+			if _, err := sdk.AccAddressFromBech32(entry.Admin); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -208,8 +282,9 @@ func ValidateGenesis(data GenesisState) error {
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
 		Params: Params{
-			DepthLimit: DefaultDepthLimit,
-			WidthLimit: DefaultWidthLimit,
+			DepthLimit:   DefaultDepthLimit,
+			WidthLimit:   DefaultWidthLimit,
+			MaxBatchSize: DefaultMaxBatchSize,
 		},
 	}
 }
@@ -52,6 +52,14 @@ type GenesisState struct {
 	Supplies []ContractStatistics `protobuf:"bytes,11,rep,name=supplies,proto3" json:"supplies"`
 	// burnts represents the total amount of burnt tokens.
 	Burnts []ContractStatistics `protobuf:"bytes,12,rep,name=burnts,proto3" json:"burnts"`
+	// locks defines the locked tokens of the holders.
+	Locks []ContractLocks `protobuf:"bytes,13,rep,name=locks,proto3" json:"locks"`
+	// nft_history_contracts defines the contracts recording the history of their nfts.
+	NftHistoryContracts []string `protobuf:"bytes,14,rep,name=nft_history_contracts,json=nftHistoryContracts,proto3" json:"nft_history_contracts,omitempty"`
+	// nft_histories defines the history entries of the nfts.
+	NftHistories []ContractNFTHistories `protobuf:"bytes,15,rep,name=nft_histories,json=nftHistories,proto3" json:"nft_histories"`
+	// admin_histories defines the admin histories of the contracts.
+	AdminHistories []ContractAdminHistory `protobuf:"bytes,16,rep,name=admin_histories,json=adminHistories,proto3" json:"admin_histories"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -171,6 +179,34 @@ func (m *GenesisState) GetBurnts() []ContractStatistics {
 	return nil
 }
 
+func (m *GenesisState) GetLocks() []ContractLocks {
+	if m != nil {
+		return m.Locks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNftHistoryContracts() []string {
+	if m != nil {
+		return m.NftHistoryContracts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNftHistories() []ContractNFTHistories {
+	if m != nil {
+		return m.NftHistories
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAdminHistories() []ContractAdminHistory {
+	if m != nil {
+		return m.AdminHistories
+	}
+	return nil
+}
+
 // ContractBalances defines balances belong to a contract.
 // genesis state.
 type ContractBalances struct {
@@ -548,6 +584,61 @@ func (m *ContractAuthorizations) GetAuthorizations() []Authorization {
 	return nil
 }
 
+// ContractLocks defines locks belong to a contract.
+type ContractLocks struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// locks
+	Locks []Lock `protobuf:"bytes,2,rep,name=locks,proto3" json:"locks"`
+}
+
+func (m *ContractLocks) Reset()         { *m = ContractLocks{} }
+func (m *ContractLocks) String() string { return proto.CompactTextString(m) }
+func (*ContractLocks) ProtoMessage()    {}
+func (*ContractLocks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b8b3f666cffb1ec, []int{8}
+}
+func (m *ContractLocks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractLocks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractLocks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractLocks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractLocks.Merge(m, src)
+}
+func (m *ContractLocks) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractLocks) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractLocks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractLocks proto.InternalMessageInfo
+
+func (m *ContractLocks) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *ContractLocks) GetLocks() []Lock {
+	if m != nil {
+		return m.Locks
+	}
+	return nil
+}
+
 // ContractGrant defines grants belong to a contract.
 type ContractGrants struct {
 	// contract id associated with the contract.
@@ -560,7 +651,7 @@ func (m *ContractGrants) Reset()         { *m = ContractGrants{} }
 func (m *ContractGrants) String() string { return proto.CompactTextString(m) }
 func (*ContractGrants) ProtoMessage()    {}
 func (*ContractGrants) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b8b3f666cffb1ec, []int{8}
+	return fileDescriptor_2b8b3f666cffb1ec, []int{9}
 }
 func (m *ContractGrants) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -617,7 +708,7 @@ func (m *NextClassIDs) Reset()         { *m = NextClassIDs{} }
 func (m *NextClassIDs) String() string { return proto.CompactTextString(m) }
 func (*NextClassIDs) ProtoMessage()    {}
 func (*NextClassIDs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b8b3f666cffb1ec, []int{9}
+	return fileDescriptor_2b8b3f666cffb1ec, []int{10}
 }
 func (m *NextClassIDs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -663,7 +754,7 @@ func (m *ContractNextTokenIDs) Reset()         { *m = ContractNextTokenIDs{} }
 func (m *ContractNextTokenIDs) String() string { return proto.CompactTextString(m) }
 func (*ContractNextTokenIDs) ProtoMessage()    {}
 func (*ContractNextTokenIDs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b8b3f666cffb1ec, []int{10}
+	return fileDescriptor_2b8b3f666cffb1ec, []int{11}
 }
 func (m *ContractNextTokenIDs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -718,7 +809,7 @@ func (m *NextTokenID) Reset()         { *m = NextTokenID{} }
 func (m *NextTokenID) String() string { return proto.CompactTextString(m) }
 func (*NextTokenID) ProtoMessage()    {}
 func (*NextTokenID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b8b3f666cffb1ec, []int{11}
+	return fileDescriptor_2b8b3f666cffb1ec, []int{12}
 }
 func (m *NextTokenID) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -766,7 +857,7 @@ func (m *ContractTokenRelations) Reset()         { *m = ContractTokenRelations{}
 func (m *ContractTokenRelations) String() string { return proto.CompactTextString(m) }
 func (*ContractTokenRelations) ProtoMessage()    {}
 func (*ContractTokenRelations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b8b3f666cffb1ec, []int{12}
+	return fileDescriptor_2b8b3f666cffb1ec, []int{13}
 }
 func (m *ContractTokenRelations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -821,7 +912,7 @@ func (m *TokenRelation) Reset()         { *m = TokenRelation{} }
 func (m *TokenRelation) String() string { return proto.CompactTextString(m) }
 func (*TokenRelation) ProtoMessage()    {}
 func (*TokenRelation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b8b3f666cffb1ec, []int{13}
+	return fileDescriptor_2b8b3f666cffb1ec, []int{14}
 }
 func (m *TokenRelation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -864,6 +955,116 @@ func (m *TokenRelation) GetOther() string {
 	return ""
 }
 
+// ContractNFTHistories defines nft history entries belong to a contract.
+type ContractNFTHistories struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// entries of the history, in chronological order.
+	Entries []NFTHistoryEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *ContractNFTHistories) Reset()         { *m = ContractNFTHistories{} }
+func (m *ContractNFTHistories) String() string { return proto.CompactTextString(m) }
+func (*ContractNFTHistories) ProtoMessage()    {}
+func (*ContractNFTHistories) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b8b3f666cffb1ec, []int{15}
+}
+func (m *ContractNFTHistories) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractNFTHistories) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractNFTHistories.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractNFTHistories) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractNFTHistories.Merge(m, src)
+}
+func (m *ContractNFTHistories) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractNFTHistories) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractNFTHistories.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractNFTHistories proto.InternalMessageInfo
+
+func (m *ContractNFTHistories) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *ContractNFTHistories) GetEntries() []NFTHistoryEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+// ContractAdminHistory defines the admin history of a contract.
+type ContractAdminHistory struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// entries of the history, in chronological order.
+	Entries []AdminHistoryEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *ContractAdminHistory) Reset()         { *m = ContractAdminHistory{} }
+func (m *ContractAdminHistory) String() string { return proto.CompactTextString(m) }
+func (*ContractAdminHistory) ProtoMessage()    {}
+func (*ContractAdminHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b8b3f666cffb1ec, []int{16}
+}
+func (m *ContractAdminHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractAdminHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractAdminHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractAdminHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractAdminHistory.Merge(m, src)
+}
+func (m *ContractAdminHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractAdminHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractAdminHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractAdminHistory proto.InternalMessageInfo
+
+func (m *ContractAdminHistory) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *ContractAdminHistory) GetEntries() []AdminHistoryEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "lbm.collection.v1.GenesisState")
 	proto.RegisterType((*ContractBalances)(nil), "lbm.collection.v1.ContractBalances")
@@ -873,76 +1074,89 @@ func init() {
 	proto.RegisterType((*ContractClasses)(nil), "lbm.collection.v1.ContractClasses")
 	proto.RegisterType((*ContractNFTs)(nil), "lbm.collection.v1.ContractNFTs")
 	proto.RegisterType((*ContractAuthorizations)(nil), "lbm.collection.v1.ContractAuthorizations")
+	proto.RegisterType((*ContractLocks)(nil), "lbm.collection.v1.ContractLocks")
 	proto.RegisterType((*ContractGrants)(nil), "lbm.collection.v1.ContractGrants")
 	proto.RegisterType((*NextClassIDs)(nil), "lbm.collection.v1.NextClassIDs")
 	proto.RegisterType((*ContractNextTokenIDs)(nil), "lbm.collection.v1.ContractNextTokenIDs")
 	proto.RegisterType((*NextTokenID)(nil), "lbm.collection.v1.NextTokenID")
 	proto.RegisterType((*ContractTokenRelations)(nil), "lbm.collection.v1.ContractTokenRelations")
 	proto.RegisterType((*TokenRelation)(nil), "lbm.collection.v1.TokenRelation")
+	proto.RegisterType((*ContractNFTHistories)(nil), "lbm.collection.v1.ContractNFTHistories")
+	proto.RegisterType((*ContractAdminHistory)(nil), "lbm.collection.v1.ContractAdminHistory")
 }
 
 func init() { proto.RegisterFile("lbm/collection/v1/genesis.proto", fileDescriptor_2b8b3f666cffb1ec) }
 
 var fileDescriptor_2b8b3f666cffb1ec = []byte{
-	// 919 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0x8f, 0x13, 0xc7, 0x7f, 0x9e, 0xdd, 0x14, 0x46, 0x51, 0xd9, 0x04, 0xc9, 0x0e, 0x8b, 0x10,
-	0x05, 0x94, 0x5d, 0x9a, 0x4a, 0xa0, 0x22, 0x44, 0x15, 0xbb, 0x24, 0x18, 0xa4, 0x82, 0xdc, 0x00,
-	0x12, 0x17, 0x6b, 0xbc, 0x3b, 0x76, 0x46, 0x5d, 0xcf, 0x98, 0x9d, 0x71, 0x94, 0xf4, 0xd2, 0x33,
-	0x37, 0x3e, 0x02, 0x67, 0xce, 0x7c, 0x88, 0x8a, 0x53, 0x8f, 0x15, 0x87, 0x82, 0x92, 0x0b, 0x1f,
-	0x03, 0xed, 0xcc, 0xec, 0x7a, 0x6d, 0x6f, 0x76, 0x81, 0xde, 0x76, 0x66, 0x7e, 0x7f, 0xde, 0x3c,
-	0xbf, 0xf7, 0xc6, 0xd0, 0x0e, 0x86, 0x13, 0xd7, 0xe3, 0x41, 0x40, 0x3c, 0x49, 0x39, 0x73, 0xcf,
-	0xee, 0xb8, 0x63, 0xc2, 0x88, 0xa0, 0xc2, 0x99, 0x86, 0x5c, 0x72, 0xf4, 0x7a, 0x30, 0x9c, 0x38,
-	0x73, 0x80, 0x73, 0x76, 0x67, 0x77, 0x67, 0xcc, 0xf9, 0x38, 0x20, 0xae, 0x02, 0x0c, 0x67, 0x23,
-	0x17, 0xb3, 0x0b, 0x8d, 0xde, 0xdd, 0x1e, 0xf3, 0x31, 0x57, 0x9f, 0x6e, 0xf4, 0x65, 0x76, 0x77,
-	0x3c, 0x2e, 0x26, 0x5c, 0x0c, 0xf4, 0x81, 0x5e, 0x98, 0x23, 0x7b, 0xd5, 0x3f, 0x65, 0xa6, 0x30,
-	0xf6, 0x55, 0x05, 0x9a, 0xc7, 0x3a, 0xa8, 0x47, 0x12, 0x4b, 0x82, 0x3e, 0x86, 0xca, 0x14, 0x87,
-	0x78, 0x22, 0xac, 0xd2, 0x5e, 0xe9, 0x76, 0xe3, 0x60, 0xc7, 0x59, 0x09, 0xd2, 0xf9, 0x46, 0x01,
-	0x3a, 0xe5, 0x67, 0x2f, 0xdb, 0x6b, 0x7d, 0x03, 0x47, 0xf7, 0xa1, 0xee, 0x71, 0x26, 0x43, 0xec,
-	0x49, 0x61, 0xad, 0xef, 0x6d, 0xdc, 0x6e, 0x1c, 0xbc, 0x99, 0xc1, 0xed, 0x1a, 0x8c, 0x61, 0xcf,
-	0x39, 0xe8, 0x2b, 0xd8, 0x62, 0xe4, 0x5c, 0x0e, 0xbc, 0x00, 0x0b, 0x31, 0xa0, 0xbe, 0xb0, 0x36,
-	0x94, 0x4a, 0x3b, 0x43, 0xe5, 0x21, 0x39, 0x97, 0xdd, 0x08, 0xd7, 0x7b, 0x10, 0xc7, 0xd1, 0x64,
-	0xc9, 0x9e, 0x2f, 0x50, 0x07, 0xaa, 0x4a, 0x87, 0x08, 0xab, 0xac, 0x54, 0xec, 0x9c, 0x58, 0xba,
-	0x1a, 0x69, 0x84, 0x62, 0x22, 0x7a, 0x64, 0x02, 0x92, 0xfc, 0x31, 0x61, 0x2a, 0xa0, 0x4d, 0x25,
-	0xf5, 0x6e, 0x8e, 0x54, 0x14, 0xd8, 0x49, 0x84, 0x5f, 0x0a, 0x4c, 0xef, 0xf9, 0x02, 0x7d, 0x0e,
-	0xb5, 0x21, 0x0e, 0x30, 0xf3, 0x88, 0xb0, 0x2a, 0x4a, 0xee, 0xed, 0xbc, 0x2c, 0x19, 0xa8, 0x91,
-	0x4a, 0xa8, 0xe8, 0x1e, 0x94, 0xd9, 0x48, 0x0a, 0xab, 0x7a, 0x6d, 0x8a, 0x92, 0x88, 0x8e, 0x4e,
-	0x62, 0xba, 0xa2, 0xa0, 0x1e, 0x54, 0xa7, 0x38, 0x24, 0x4c, 0x0a, 0xab, 0xa6, 0xd8, 0xef, 0xe5,
-	0xb0, 0x55, 0xdc, 0x7d, 0x12, 0xe0, 0xe8, 0x20, 0xc9, 0x90, 0xe1, 0xa3, 0xfb, 0x50, 0x19, 0x87,
-	0x38, 0x52, 0xaa, 0x2b, 0xa5, 0xb7, 0x72, 0x94, 0x8e, 0x15, 0x30, 0x2e, 0x1a, 0x4d, 0x43, 0xdf,
-	0xc3, 0x16, 0x9e, 0xc9, 0x53, 0x1e, 0xd2, 0x27, 0xda, 0xc1, 0x82, 0xc2, 0x90, 0x0e, 0x17, 0x08,
-	0x46, 0x70, 0x49, 0x06, 0x1d, 0x43, 0x4d, 0xcc, 0xa6, 0xd3, 0x80, 0x12, 0x61, 0x35, 0x94, 0xe4,
-	0x3b, 0x39, 0x92, 0x51, 0xe9, 0x53, 0x21, 0xa9, 0x97, 0x24, 0x3a, 0x26, 0xa3, 0x2e, 0x54, 0x86,
-	0xb3, 0x30, 0xba, 0x62, 0xf3, 0xbf, 0xcb, 0x18, 0xaa, 0xfd, 0x23, 0xbc, 0xb6, 0xfc, 0x8b, 0xa2,
-	0x36, 0x34, 0xe2, 0xda, 0x1f, 0x50, 0x5f, 0x75, 0x5b, 0xbd, 0x0f, 0xf1, 0x56, 0xcf, 0x47, 0x9f,
-	0xa6, 0x2a, 0x45, 0xf7, 0xd3, 0x6e, 0x86, 0xb7, 0xd1, 0x5b, 0x2e, 0x10, 0xfb, 0x29, 0xa0, 0xd5,
-	0xb0, 0x8a, 0x4d, 0xbf, 0x00, 0x10, 0x09, 0xdc, 0xd8, 0x66, 0xb6, 0x4e, 0xd4, 0x23, 0x2b, 0xf7,
-	0x4d, 0x71, 0xed, 0x73, 0xb8, 0xb9, 0x04, 0x42, 0x3b, 0x50, 0x8b, 0x9b, 0xdb, 0x58, 0xeb, 0x5e,
-	0xeb, 0xf9, 0xe8, 0x4b, 0xa8, 0xe0, 0x09, 0x9f, 0x31, 0x69, 0xad, 0x47, 0x07, 0x9d, 0x83, 0x48,
-	0xef, 0x8f, 0x97, 0xed, 0xf7, 0xc7, 0x54, 0x9e, 0xce, 0x86, 0x8e, 0xc7, 0x27, 0xee, 0x11, 0x65,
-	0xc2, 0x3b, 0xa5, 0xd8, 0x1d, 0x99, 0x8f, 0x7d, 0xe1, 0x3f, 0x76, 0xe5, 0xc5, 0x94, 0x08, 0xa7,
-	0xc7, 0x64, 0xdf, 0x28, 0xd8, 0x14, 0xaa, 0x26, 0x2b, 0xc8, 0x82, 0x2a, 0xf6, 0xfd, 0x90, 0x08,
-	0x11, 0x1b, 0x9a, 0x25, 0xfa, 0x2c, 0x65, 0x18, 0x5d, 0xf2, 0x8d, 0xcc, 0xdf, 0x95, 0xb2, 0xce,
-	0x8d, 0x28, 0x92, 0x5f, 0xff, 0x6c, 0x6f, 0x46, 0x2b, 0x11, 0x9b, 0x7c, 0x52, 0xfe, 0xfb, 0x97,
-	0x76, 0xc9, 0x3e, 0x83, 0x9b, 0x4b, 0x43, 0xa4, 0x38, 0xc5, 0xa9, 0xd1, 0xa4, 0xad, 0xb7, 0x1d,
-	0x3d, 0xf4, 0x9d, 0x78, 0xe8, 0x3b, 0x87, 0xec, 0xa2, 0x83, 0x22, 0xdf, 0xdf, 0x7f, 0xdb, 0x07,
-	0xd5, 0x82, 0x4a, 0x3d, 0x19, 0x4d, 0x36, 0x86, 0x66, 0xba, 0xbf, 0x8b, 0x4d, 0x3f, 0x34, 0xf3,
-	0x42, 0x3b, 0xde, 0xca, 0x1a, 0xa9, 0x47, 0x27, 0xe9, 0x31, 0x61, 0xff, 0x54, 0x82, 0x5b, 0xd9,
-	0x2d, 0x57, 0xec, 0xf6, 0x70, 0xa5, 0xad, 0xb5, 0xef, 0x5e, 0x86, 0xef, 0x82, 0x76, 0x76, 0x37,
-	0xdb, 0x14, 0xb6, 0x16, 0xc7, 0x48, 0x71, 0x08, 0x1f, 0x25, 0xa3, 0x49, 0x5b, 0x5b, 0x19, 0xd6,
-	0x4a, 0x6b, 0x71, 0x22, 0xd9, 0x2f, 0x4a, 0xd0, 0x4c, 0xbf, 0x2e, 0xc5, 0x4e, 0x5f, 0x43, 0x6d,
-	0x34, 0x63, 0x63, 0x3a, 0x0c, 0x88, 0x29, 0xde, 0xbb, 0xa6, 0x78, 0x3f, 0xf8, 0x97, 0xc5, 0xfb,
-	0x2d, 0x65, 0xb2, 0x9f, 0x88, 0xa0, 0xef, 0xa0, 0xc9, 0x38, 0x1b, 0x24, 0xa2, 0x1b, 0xff, 0x5f,
-	0xb4, 0xc1, 0x38, 0x3b, 0x32, 0x3a, 0xf6, 0x13, 0xd8, 0xce, 0x7a, 0xa6, 0x8a, 0x6f, 0x78, 0x08,
-	0xf5, 0xf9, 0x1b, 0xa8, 0xd3, 0xd9, 0xba, 0xe6, 0x51, 0x36, 0xa2, 0xf1, 0x38, 0x92, 0xe6, 0xd9,
-	0xb3, 0x27, 0xd0, 0x48, 0x1d, 0xe7, 0x4d, 0x82, 0x2e, 0xac, 0x53, 0xff, 0x55, 0x12, 0xb9, 0x4e,
-	0x7d, 0xfb, 0xe9, 0xbc, 0x76, 0x17, 0x5f, 0xb0, 0xe2, 0xcb, 0x3e, 0x80, 0x7a, 0x18, 0xa3, 0x73,
-	0xca, 0x76, 0x41, 0x36, 0xfe, 0x33, 0x93, 0x10, 0xed, 0x7b, 0x70, 0x63, 0x01, 0x81, 0x10, 0x94,
-	0x05, 0x09, 0x46, 0xc6, 0x50, 0x7d, 0xa3, 0x6d, 0xd8, 0xe4, 0xf2, 0x94, 0x84, 0xfa, 0xb6, 0x7d,
-	0xbd, 0xe8, 0x1c, 0x3f, 0xbb, 0x6c, 0x95, 0x9e, 0x5f, 0xb6, 0x4a, 0x7f, 0x5d, 0xb6, 0x4a, 0x3f,
-	0x5f, 0xb5, 0xd6, 0x9e, 0x5f, 0xb5, 0xd6, 0x5e, 0x5c, 0xb5, 0xd6, 0x7e, 0xd8, 0x2f, 0x4c, 0xc3,
-	0x79, 0xea, 0x1f, 0xde, 0xb0, 0xa2, 0xe6, 0xc9, 0xdd, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x75,
-	0x7b, 0xf5, 0x84, 0x88, 0x0a, 0x00, 0x00,
+	// 1076 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0x8e, 0x9d, 0xc4, 0x89, 0x9f, 0x9d, 0xa4, 0x0c, 0xa6, 0x6c, 0x8a, 0x64, 0x87, 0x05, 0x44,
+	0x00, 0x65, 0x4d, 0x13, 0x09, 0x54, 0x54, 0x51, 0xc5, 0x6e, 0x93, 0x18, 0x50, 0x41, 0x6e, 0x28,
+	0x12, 0x17, 0x6b, 0xbd, 0x3b, 0xb6, 0x47, 0x59, 0xcf, 0x98, 0x9d, 0x71, 0x14, 0x17, 0xa1, 0x8a,
+	0x23, 0x37, 0x7e, 0x02, 0x67, 0xce, 0xfc, 0x88, 0x8a, 0x53, 0x8f, 0x15, 0x87, 0x82, 0x92, 0x0b,
+	0x3f, 0x03, 0xcd, 0xec, 0xec, 0x7a, 0x6d, 0xaf, 0xbd, 0x01, 0x6e, 0xbb, 0x33, 0xef, 0xfb, 0xbe,
+	0x37, 0x6f, 0x67, 0xbe, 0x37, 0x0b, 0x15, 0xaf, 0xdd, 0xaf, 0x3a, 0xcc, 0xf3, 0xb0, 0x23, 0x08,
+	0xa3, 0xd5, 0xf3, 0xdb, 0xd5, 0x2e, 0xa6, 0x98, 0x13, 0x6e, 0x0d, 0x7c, 0x26, 0x18, 0x7a, 0xc5,
+	0x6b, 0xf7, 0xad, 0x71, 0x80, 0x75, 0x7e, 0xfb, 0xd6, 0x76, 0x97, 0xb1, 0xae, 0x87, 0xab, 0x2a,
+	0xa0, 0x3d, 0xec, 0x54, 0x6d, 0x3a, 0x0a, 0xa2, 0x6f, 0x95, 0xba, 0xac, 0xcb, 0xd4, 0x63, 0x55,
+	0x3e, 0xe9, 0xd1, 0x6d, 0x87, 0xf1, 0x3e, 0xe3, 0xad, 0x60, 0x22, 0x78, 0xd1, 0x53, 0xe6, 0xac,
+	0x7e, 0x4c, 0x4c, 0xc5, 0x98, 0x3f, 0xe6, 0xa1, 0x78, 0x1c, 0x24, 0xf5, 0x48, 0xd8, 0x02, 0xa3,
+	0x8f, 0x21, 0x37, 0xb0, 0x7d, 0xbb, 0xcf, 0x8d, 0xcc, 0x4e, 0x66, 0xb7, 0xb0, 0xbf, 0x6d, 0xcd,
+	0x24, 0x69, 0x7d, 0xa5, 0x02, 0x6a, 0x2b, 0xcf, 0x5e, 0x56, 0x96, 0x9a, 0x3a, 0x1c, 0xdd, 0x83,
+	0xbc, 0xc3, 0xa8, 0xf0, 0x6d, 0x47, 0x70, 0x23, 0xbb, 0xb3, 0xbc, 0x5b, 0xd8, 0x7f, 0x23, 0x01,
+	0x5b, 0xd7, 0x31, 0x1a, 0x3d, 0xc6, 0xa0, 0xcf, 0x61, 0x93, 0xe2, 0x0b, 0xd1, 0x72, 0x3c, 0x9b,
+	0xf3, 0x16, 0x71, 0xb9, 0xb1, 0xac, 0x58, 0x2a, 0x09, 0x2c, 0x0f, 0xf1, 0x85, 0xa8, 0xcb, 0xb8,
+	0xc6, 0xfd, 0x30, 0x8f, 0x22, 0x8d, 0xc6, 0x5c, 0x8e, 0x6a, 0xb0, 0xa6, 0x78, 0x30, 0x37, 0x56,
+	0x14, 0x8b, 0xb9, 0x20, 0x97, 0x7a, 0x10, 0xa9, 0x89, 0x42, 0x20, 0x7a, 0xa4, 0x13, 0x12, 0xec,
+	0x0c, 0x53, 0x95, 0xd0, 0xaa, 0xa2, 0x7a, 0x77, 0x01, 0x95, 0x4c, 0xec, 0x54, 0xc6, 0x4f, 0x25,
+	0x16, 0x8c, 0xb9, 0x1c, 0x3d, 0x80, 0xf5, 0xb6, 0xed, 0xd9, 0xd4, 0xc1, 0xdc, 0xc8, 0x29, 0xba,
+	0xb7, 0x16, 0x55, 0x49, 0x87, 0x6a, 0xaa, 0x08, 0x8a, 0xee, 0xc0, 0x0a, 0xed, 0x08, 0x6e, 0xac,
+	0xcd, 0x2d, 0x51, 0x94, 0xd1, 0xd1, 0x69, 0x08, 0x57, 0x10, 0xd4, 0x80, 0xb5, 0x81, 0xed, 0x63,
+	0x2a, 0xb8, 0xb1, 0xae, 0xd0, 0xef, 0x2d, 0x40, 0xab, 0xbc, 0x9b, 0xd8, 0xb3, 0xe5, 0x44, 0x54,
+	0x21, 0x8d, 0x47, 0xf7, 0x20, 0xd7, 0xf5, 0x6d, 0xc9, 0x94, 0x57, 0x4c, 0x6f, 0x2e, 0x60, 0x3a,
+	0x56, 0x81, 0xe1, 0xa6, 0x09, 0x60, 0xe8, 0x1b, 0xd8, 0xb4, 0x87, 0xa2, 0xc7, 0x7c, 0xf2, 0x24,
+	0x50, 0x30, 0x20, 0x35, 0xa5, 0xc3, 0x09, 0x80, 0x26, 0x9c, 0xa2, 0x41, 0xc7, 0xb0, 0xce, 0x87,
+	0x83, 0x81, 0x47, 0x30, 0x37, 0x0a, 0x8a, 0xf2, 0x9d, 0x05, 0x94, 0x72, 0xeb, 0x13, 0x2e, 0x88,
+	0x13, 0x15, 0x3a, 0x04, 0xa3, 0x3a, 0xe4, 0xda, 0x43, 0x5f, 0x2e, 0xb1, 0xf8, 0xef, 0x69, 0x34,
+	0x14, 0xdd, 0x85, 0x55, 0x8f, 0x39, 0x67, 0xdc, 0xd8, 0x50, 0x1c, 0x3b, 0x0b, 0x38, 0xbe, 0x90,
+	0x71, 0x1a, 0x1e, 0x80, 0xd0, 0x3e, 0xbc, 0x46, 0x3b, 0xa2, 0xd5, 0x23, 0x5c, 0x30, 0x7f, 0xd4,
+	0x1a, 0x9f, 0xb2, 0xcd, 0x9d, 0xe5, 0xdd, 0x7c, 0xf3, 0x55, 0xda, 0x11, 0x27, 0xc1, 0x5c, 0x3d,
+	0x3a, 0x4c, 0x4d, 0xd8, 0x18, 0x63, 0x64, 0x11, 0xb6, 0xd2, 0xb7, 0xee, 0xd1, 0xe9, 0x49, 0x18,
+	0x1e, 0x6d, 0xdd, 0x90, 0x5a, 0x96, 0xe2, 0x31, 0x6c, 0xd9, 0x6e, 0x9f, 0xd0, 0x18, 0xeb, 0x8d,
+	0x54, 0xd6, 0x43, 0x89, 0xd0, 0xe9, 0x45, 0xdf, 0x6a, 0x3c, 0x46, 0x30, 0x37, 0xbf, 0x83, 0x1b,
+	0xd3, 0xfb, 0x1d, 0x55, 0xa0, 0x10, 0xae, 0xb3, 0x45, 0x5c, 0xe5, 0x45, 0xf9, 0x26, 0x84, 0x43,
+	0x0d, 0x17, 0xdd, 0x8d, 0x9d, 0xa3, 0xc0, 0x6d, 0x6e, 0x25, 0x64, 0xa1, 0xf9, 0xa6, 0x8f, 0x8f,
+	0xf9, 0x14, 0xd0, 0xec, 0x47, 0x4b, 0x17, 0x3d, 0x01, 0xe0, 0x51, 0xb8, 0x91, 0x9d, 0x6f, 0x2c,
+	0xd2, 0x41, 0x66, 0x76, 0x43, 0x0c, 0x6b, 0x5e, 0xc0, 0xd6, 0x54, 0x10, 0xda, 0x86, 0xf5, 0xd0,
+	0xfa, 0xb4, 0x74, 0xe0, 0x44, 0x0d, 0x17, 0x7d, 0x06, 0x39, 0xbb, 0xcf, 0x86, 0x54, 0x18, 0x59,
+	0x39, 0x51, 0xdb, 0x97, 0x7c, 0x7f, 0xbc, 0xac, 0xbc, 0xdf, 0x25, 0xa2, 0x37, 0x6c, 0x5b, 0x0e,
+	0xeb, 0x57, 0x8f, 0x08, 0xe5, 0x4e, 0x8f, 0xd8, 0xd5, 0x8e, 0x7e, 0xd8, 0xe3, 0xee, 0x59, 0x55,
+	0x8c, 0x06, 0x98, 0x5b, 0x0d, 0x2a, 0x9a, 0x9a, 0xc1, 0x24, 0xb0, 0xa6, 0xab, 0x82, 0x0c, 0x58,
+	0xb3, 0x5d, 0xd7, 0xc7, 0x9c, 0x87, 0x82, 0xfa, 0x15, 0x7d, 0x1a, 0x13, 0x94, 0x8b, 0x7c, 0x3d,
+	0xf1, 0x0b, 0x13, 0x5a, 0xdb, 0x90, 0x99, 0xfc, 0xfa, 0x67, 0x65, 0x55, 0xbe, 0xf1, 0x50, 0xe4,
+	0x93, 0x95, 0xbf, 0x7f, 0xa9, 0x64, 0xcc, 0x73, 0xd8, 0x9a, 0xb2, 0xd8, 0xf4, 0x12, 0xc7, 0x8c,
+	0x3b, 0x90, 0x2e, 0x59, 0x41, 0x4b, 0xb4, 0xc2, 0x96, 0x68, 0x1d, 0xd2, 0x51, 0x0d, 0x49, 0xdd,
+	0xdf, 0x7f, 0xdb, 0x03, 0x65, 0x50, 0x8a, 0x3d, 0x32, 0x6e, 0xd3, 0x86, 0x62, 0xdc, 0xfd, 0xd2,
+	0x45, 0x3f, 0xd4, 0x6e, 0x1a, 0x28, 0xde, 0x4c, 0x6a, 0x38, 0x47, 0xa7, 0x71, 0x13, 0x35, 0x7f,
+	0xca, 0xc0, 0xcd, 0x64, 0x43, 0x4a, 0x57, 0x7b, 0x38, 0x63, 0x7a, 0xd9, 0xb9, 0xb6, 0x30, 0xc1,
+	0x9d, 0xec, 0x75, 0x26, 0x86, 0x8d, 0x09, 0xf7, 0x48, 0xcf, 0xe0, 0x20, 0xf4, 0xa3, 0xf9, 0x5f,
+	0x57, 0x32, 0x4d, 0xd8, 0x90, 0x49, 0x60, 0x73, 0xd2, 0xcb, 0xd3, 0x75, 0x3e, 0x8a, 0xfa, 0x43,
+	0x20, 0x64, 0x24, 0x08, 0x29, 0xae, 0xc9, 0xb6, 0x60, 0xbe, 0xc8, 0x40, 0x31, 0xde, 0xe2, 0xd3,
+	0x95, 0xbe, 0x84, 0xf5, 0xce, 0x90, 0x76, 0x49, 0xdb, 0xc3, 0xfa, 0x8c, 0x1c, 0xe8, 0x33, 0xf2,
+	0xc1, 0x35, 0xcf, 0xc8, 0xd7, 0x84, 0x8a, 0x66, 0x44, 0x82, 0x1e, 0x43, 0x91, 0x32, 0xda, 0x8a,
+	0x48, 0x97, 0xff, 0x3b, 0x69, 0x81, 0x32, 0x7a, 0xa4, 0x79, 0xcc, 0x27, 0x50, 0x4a, 0xba, 0x2b,
+	0xa4, 0xaf, 0xf0, 0x10, 0xf2, 0xe3, 0x8b, 0x48, 0x50, 0xce, 0xf2, 0x9c, 0x9b, 0x91, 0x26, 0x0d,
+	0x5d, 0x4f, 0xe8, 0xbb, 0x87, 0xd9, 0x87, 0x42, 0x6c, 0x7a, 0x91, 0xe1, 0xd4, 0x21, 0x4b, 0xdc,
+	0xff, 0x53, 0xc8, 0x2c, 0x71, 0xcd, 0xa7, 0xe3, 0x23, 0x32, 0x79, 0x8d, 0x48, 0x5f, 0xec, 0x7d,
+	0xc8, 0xfb, 0x61, 0xf4, 0x82, 0xd3, 0x31, 0x41, 0x1b, 0xde, 0x28, 0x23, 0xa0, 0x79, 0x07, 0x36,
+	0x26, 0x22, 0x10, 0x82, 0x15, 0x8e, 0xbd, 0x8e, 0x16, 0x54, 0xcf, 0xa8, 0x04, 0xab, 0x4c, 0xf4,
+	0xb0, 0x1f, 0xac, 0xb6, 0x19, 0xbc, 0x98, 0xdf, 0x43, 0x29, 0xa9, 0x2f, 0x5e, 0xcb, 0xbf, 0x30,
+	0x15, 0xaa, 0x39, 0xce, 0xef, 0x0f, 0x11, 0xe5, 0xe8, 0x01, 0x15, 0x51, 0x5f, 0x0c, 0x81, 0xe6,
+	0x0f, 0x50, 0x4a, 0x6a, 0x9f, 0xd7, 0x29, 0xdb, 0x94, 0xf8, 0xdb, 0x49, 0x96, 0x12, 0xa3, 0x4c,
+	0x92, 0xaf, 0x1d, 0x3f, 0xbb, 0x2c, 0x67, 0x9e, 0x5f, 0x96, 0x33, 0x7f, 0x5d, 0x96, 0x33, 0x3f,
+	0x5f, 0x95, 0x97, 0x9e, 0x5f, 0x95, 0x97, 0x5e, 0x5c, 0x95, 0x97, 0xbe, 0xdd, 0x4b, 0xdd, 0x02,
+	0x17, 0xb1, 0x5f, 0x8c, 0x76, 0x4e, 0x59, 0xf6, 0xc1, 0x3f, 0x03, 0x00, 0x60, 0x20, 0xa8, 0x15,
+	0x09, 0x0d, 0x00, 0x00,
 }
 
 func (this *Balance) Equal(that interface{}) bool {
@@ -997,6 +1211,59 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AdminHistories) > 0 {
+		for iNdEx := len(m.AdminHistories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdminHistories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.NftHistories) > 0 {
+		for iNdEx := len(m.NftHistories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NftHistories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.NftHistoryContracts) > 0 {
+		for iNdEx := len(m.NftHistoryContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NftHistoryContracts[iNdEx])
+			copy(dAtA[i:], m.NftHistoryContracts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.NftHistoryContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.Locks) > 0 {
+		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
 	if len(m.Burnts) > 0 {
 		for iNdEx := len(m.Burnts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1468,6 +1735,50 @@ func (m *ContractAuthorizations) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ContractLocks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractLocks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractLocks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Locks) > 0 {
+		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ContractGrants) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1727,40 +2038,128 @@ func (m *TokenRelation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ContractNFTHistories) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ContractNFTHistories) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractNFTHistories) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.Contracts) > 0 {
-		for _, e := range m.Contracts {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.NextClassIds) > 0 {
-		for _, e := range m.NextClassIds {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Classes) > 0 {
-		for _, e := range m.Classes {
-			l = e.Size()
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractAdminHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractAdminHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractAdminHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Contracts) > 0 {
+		for _, e := range m.Contracts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NextClassIds) > 0 {
+		for _, e := range m.NextClassIds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Classes) > 0 {
+		for _, e := range m.Classes {
+			l = e.Size()
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
@@ -1812,6 +2211,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NftHistoryContracts) > 0 {
+		for _, s := range m.NftHistoryContracts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NftHistories) > 0 {
+		for _, e := range m.NftHistories {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AdminHistories) > 0 {
+		for _, e := range m.AdminHistories {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1944,6 +2367,25 @@ func (m *ContractAuthorizations) Size() (n int) {
 	return n
 }
 
+func (m *ContractLocks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ContractGrants) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2050,6 +2492,44 @@ func (m *TokenRelation) Size() (n int) {
 	return n
 }
 
+func (m *ContractNFTHistories) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractAdminHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2492,6 +2972,488 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locks = append(m.Locks, ContractLocks{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftHistoryContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NftHistoryContracts = append(m.NftHistoryContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftHistories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NftHistories = append(m.NftHistories, ContractNFTHistories{})
+			if err := m.NftHistories[len(m.NftHistories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminHistories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminHistories = append(m.AdminHistories, ContractAdminHistory{})
+			if err := m.AdminHistories[len(m.AdminHistories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractBalances) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractBalances: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractBalances: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, Balance{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractStatistics) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractStatistics: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractStatistics: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Statistics = append(m.Statistics, ClassStatistics{})
+			if err := m.Statistics[len(m.Statistics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClassStatistics) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassStatistics: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassStatistics: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2513,7 +3475,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractBalances) Unmarshal(dAtA []byte) error {
+func (m *Balance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2536,15 +3498,15 @@ func (m *ContractBalances) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractBalances: wiretype end group for non-group")
+			return fmt.Errorf("proto: Balance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractBalances: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Balance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2572,11 +3534,11 @@ func (m *ContractBalances) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractId = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2603,8 +3565,8 @@ func (m *ContractBalances) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balances = append(m.Balances, Balance{})
-			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2629,7 +3591,7 @@ func (m *ContractBalances) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractStatistics) Unmarshal(dAtA []byte) error {
+func (m *ContractClasses) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2652,10 +3614,10 @@ func (m *ContractStatistics) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractStatistics: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractClasses: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractStatistics: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractClasses: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2692,7 +3654,7 @@ func (m *ContractStatistics) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Classes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2719,8 +3681,8 @@ func (m *ContractStatistics) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Statistics = append(m.Statistics, ClassStatistics{})
-			if err := m.Statistics[len(m.Statistics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Classes = append(m.Classes, types.Any{})
+			if err := m.Classes[len(m.Classes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2745,7 +3707,7 @@ func (m *ContractStatistics) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClassStatistics) Unmarshal(dAtA []byte) error {
+func (m *ContractNFTs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2768,15 +3730,15 @@ func (m *ClassStatistics) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClassStatistics: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractNFTs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClassStatistics: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractNFTs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2804,13 +3766,13 @@ func (m *ClassStatistics) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClassId = string(dAtA[iNdEx:postIndex])
+			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nfts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2820,23 +3782,23 @@ func (m *ClassStatistics) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Nfts = append(m.Nfts, NFT{})
+			if err := m.Nfts[len(m.Nfts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2861,7 +3823,7 @@ func (m *ClassStatistics) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Balance) Unmarshal(dAtA []byte) error {
+func (m *ContractAuthorizations) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2884,15 +3846,15 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Balance: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractAuthorizations: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Balance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractAuthorizations: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2920,11 +3882,11 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorizations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2951,8 +3913,8 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Authorizations = append(m.Authorizations, Authorization{})
+			if err := m.Authorizations[len(m.Authorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2977,7 +3939,7 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractClasses) Unmarshal(dAtA []byte) error {
+func (m *ContractLocks) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3000,10 +3962,10 @@ func (m *ContractClasses) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractClasses: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractLocks: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractClasses: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractLocks: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3040,7 +4002,7 @@ func (m *ContractClasses) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Classes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3067,8 +4029,8 @@ func (m *ContractClasses) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Classes = append(m.Classes, types.Any{})
-			if err := m.Classes[len(m.Classes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Locks = append(m.Locks, Lock{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3093,7 +4055,7 @@ func (m *ContractClasses) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractNFTs) Unmarshal(dAtA []byte) error {
+func (m *ContractGrants) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3116,10 +4078,10 @@ func (m *ContractNFTs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractNFTs: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractGrants: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractNFTs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractGrants: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3156,7 +4118,7 @@ func (m *ContractNFTs) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nfts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3183,8 +4145,8 @@ func (m *ContractNFTs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nfts = append(m.Nfts, NFT{})
-			if err := m.Nfts[len(m.Nfts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Grants = append(m.Grants, Grant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3209,7 +4171,7 @@ func (m *ContractNFTs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractAuthorizations) Unmarshal(dAtA []byte) error {
+func (m *NextClassIDs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3232,10 +4194,10 @@ func (m *ContractAuthorizations) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractAuthorizations: wiretype end group for non-group")
+			return fmt.Errorf("proto: NextClassIDs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractAuthorizations: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NextClassIDs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3272,9 +4234,9 @@ func (m *ContractAuthorizations) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authorizations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fungible", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -3284,23 +4246,57 @@ func (m *ContractAuthorizations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authorizations = append(m.Authorizations, Authorization{})
-			if err := m.Authorizations[len(m.Authorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fungible.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonFungible", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NonFungible.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3325,7 +4321,7 @@ func (m *ContractAuthorizations) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractGrants) Unmarshal(dAtA []byte) error {
+func (m *ContractNextTokenIDs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3348,10 +4344,10 @@ func (m *ContractGrants) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractGrants: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractNextTokenIDs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractGrants: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractNextTokenIDs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3388,7 +4384,7 @@ func (m *ContractGrants) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3415,8 +4411,8 @@ func (m *ContractGrants) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grants = append(m.Grants, Grant{})
-			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TokenIds = append(m.TokenIds, NextTokenID{})
+			if err := m.TokenIds[len(m.TokenIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3441,7 +4437,7 @@ func (m *ContractGrants) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NextClassIDs) Unmarshal(dAtA []byte) error {
+func (m *NextTokenID) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3464,15 +4460,15 @@ func (m *NextClassIDs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NextClassIDs: wiretype end group for non-group")
+			return fmt.Errorf("proto: NextTokenID: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NextClassIDs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NextTokenID: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3500,45 +4496,11 @@ func (m *NextClassIDs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractId = string(dAtA[iNdEx:postIndex])
+			m.ClassId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fungible", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Fungible.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NonFungible", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3566,7 +4528,7 @@ func (m *NextClassIDs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NonFungible.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3591,7 +4553,7 @@ func (m *NextClassIDs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractNextTokenIDs) Unmarshal(dAtA []byte) error {
+func (m *ContractTokenRelations) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3614,10 +4576,10 @@ func (m *ContractNextTokenIDs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractNextTokenIDs: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractTokenRelations: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractNextTokenIDs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractTokenRelations: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3654,7 +4616,7 @@ func (m *ContractNextTokenIDs) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Relations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3681,8 +4643,8 @@ func (m *ContractNextTokenIDs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenIds = append(m.TokenIds, NextTokenID{})
-			if err := m.TokenIds[len(m.TokenIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Relations = append(m.Relations, TokenRelation{})
+			if err := m.Relations[len(m.Relations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3707,7 +4669,7 @@ func (m *ContractNextTokenIDs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NextTokenID) Unmarshal(dAtA []byte) error {
+func (m *TokenRelation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3730,15 +4692,15 @@ func (m *NextTokenID) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NextTokenID: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenRelation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NextTokenID: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenRelation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Self", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3766,11 +4728,11 @@ func (m *NextTokenID) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClassId = string(dAtA[iNdEx:postIndex])
+			m.Self = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Other", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3798,9 +4760,7 @@ func (m *NextTokenID) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Other = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3823,7 +4783,7 @@ func (m *NextTokenID) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractTokenRelations) Unmarshal(dAtA []byte) error {
+func (m *ContractNFTHistories) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3846,10 +4806,10 @@ func (m *ContractTokenRelations) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractTokenRelations: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractNFTHistories: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractTokenRelations: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractNFTHistories: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3886,7 +4846,7 @@ func (m *ContractTokenRelations) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3913,8 +4873,8 @@ func (m *ContractTokenRelations) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relations = append(m.Relations, TokenRelation{})
-			if err := m.Relations[len(m.Relations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, NFTHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3939,7 +4899,7 @@ func (m *ContractTokenRelations) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TokenRelation) Unmarshal(dAtA []byte) error {
+func (m *ContractAdminHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3962,15 +4922,15 @@ func (m *TokenRelation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TokenRelation: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractAdminHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TokenRelation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractAdminHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Self", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3998,13 +4958,13 @@ func (m *TokenRelation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Self = string(dAtA[iNdEx:postIndex])
+			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Other", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -4014,23 +4974,25 @@ func (m *TokenRelation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Other = string(dAtA[iNdEx:postIndex])
+			m.Entries = append(m.Entries, AdminHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2,6 +2,7 @@ package collection_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -13,6 +14,11 @@ import (
 
 func TestValidateGenesis(t *testing.T) {
 	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	schedule := collection.VestingSchedule{
+		StartTime: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	params := collection.DefaultGenesisState().Params
 	testCases := map[string]struct {
 		gs    *collection.GenesisState
 		valid bool
@@ -445,6 +451,271 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			false,
 		},
+		"valid locks": {
+			&collection.GenesisState{
+				Params: params,
+				Locks: []collection.ContractLocks{{
+					ContractId: "deadbeef",
+					Locks: []collection.Lock{{
+						Address:  addr.String(),
+						TokenId:  collection.NewFTID("deadbeef"),
+						Amount:   sdk.OneInt(),
+						Schedule: schedule,
+					}},
+				}},
+			},
+			true,
+		},
+		"locks of invalid contract id": {
+			&collection.GenesisState{
+				Locks: []collection.ContractLocks{{
+					Locks: []collection.Lock{{
+						Address:  addr.String(),
+						TokenId:  collection.NewFTID("deadbeef"),
+						Amount:   sdk.OneInt(),
+						Schedule: schedule,
+					}},
+				}},
+			},
+			false,
+		},
+		"empty locks": {
+			&collection.GenesisState{
+				Locks: []collection.ContractLocks{{
+					ContractId: "deadbeef",
+				}},
+			},
+			false,
+		},
+		"invalid address of lock": {
+			&collection.GenesisState{
+				Locks: []collection.ContractLocks{{
+					ContractId: "deadbeef",
+					Locks: []collection.Lock{{
+						TokenId:  collection.NewFTID("deadbeef"),
+						Amount:   sdk.OneInt(),
+						Schedule: schedule,
+					}},
+				}},
+			},
+			false,
+		},
+		"invalid token id of lock": {
+			&collection.GenesisState{
+				Locks: []collection.ContractLocks{{
+					ContractId: "deadbeef",
+					Locks: []collection.Lock{{
+						Address:  addr.String(),
+						Amount:   sdk.OneInt(),
+						Schedule: schedule,
+					}},
+				}},
+			},
+			false,
+		},
+		"invalid amount of lock": {
+			&collection.GenesisState{
+				Locks: []collection.ContractLocks{{
+					ContractId: "deadbeef",
+					Locks: []collection.Lock{{
+						Address:  addr.String(),
+						TokenId:  collection.NewFTID("deadbeef"),
+						Amount:   sdk.ZeroInt(),
+						Schedule: schedule,
+					}},
+				}},
+			},
+			false,
+		},
+		"invalid schedule of lock": {
+			&collection.GenesisState{
+				Locks: []collection.ContractLocks{{
+					ContractId: "deadbeef",
+					Locks: []collection.Lock{{
+						Address: addr.String(),
+						TokenId: collection.NewFTID("deadbeef"),
+						Amount:  sdk.OneInt(),
+						Schedule: collection.VestingSchedule{
+							StartTime: schedule.EndTime,
+							EndTime:   schedule.StartTime,
+						},
+					}},
+				}},
+			},
+			false,
+		},
+		"negative nft history retention": {
+			&collection.GenesisState{
+				Params: collection.Params{
+					MaxBatchSize:        params.MaxBatchSize,
+					NftHistoryRetention: -time.Hour,
+				},
+			},
+			false,
+		},
+		"zero max batch size": {
+			&collection.GenesisState{
+				Params: collection.Params{},
+			},
+			false,
+		},
+		"valid nft histories": {
+			&collection.GenesisState{
+				Params:              params,
+				NftHistoryContracts: []string{"deadbeef"},
+				NftHistories: []collection.ContractNFTHistories{{
+					ContractId: "deadbeef",
+					Entries: []collection.NFTHistoryEntry{{
+						TokenId: collection.NewNFTID("deadbeef", 1),
+						Action:  collection.NFTActionMint,
+						To:      addr.String(),
+						Height:  1,
+						Time:    schedule.StartTime,
+					}},
+				}},
+			},
+			true,
+		},
+		"nft history contracts of invalid contract id": {
+			&collection.GenesisState{
+				NftHistoryContracts: []string{""},
+			},
+			false,
+		},
+		"nft histories of invalid contract id": {
+			&collection.GenesisState{
+				NftHistories: []collection.ContractNFTHistories{{
+					Entries: []collection.NFTHistoryEntry{{
+						TokenId: collection.NewNFTID("deadbeef", 1),
+						Action:  collection.NFTActionMint,
+						To:      addr.String(),
+					}},
+				}},
+			},
+			false,
+		},
+		"empty nft histories": {
+			&collection.GenesisState{
+				NftHistories: []collection.ContractNFTHistories{{
+					ContractId: "deadbeef",
+				}},
+			},
+			false,
+		},
+		"invalid token id of nft history entry": {
+			&collection.GenesisState{
+				NftHistories: []collection.ContractNFTHistories{{
+					ContractId: "deadbeef",
+					Entries: []collection.NFTHistoryEntry{{
+						TokenId: collection.NewFTID("deadbeef"),
+						Action:  collection.NFTActionMint,
+						To:      addr.String(),
+					}},
+				}},
+			},
+			false,
+		},
+		"invalid action of nft history entry": {
+			&collection.GenesisState{
+				NftHistories: []collection.ContractNFTHistories{{
+					ContractId: "deadbeef",
+					Entries: []collection.NFTHistoryEntry{{
+						TokenId: collection.NewNFTID("deadbeef", 1),
+						To:      addr.String(),
+					}},
+				}},
+			},
+			false,
+		},
+		"invalid address of nft history entry": {
+			&collection.GenesisState{
+				NftHistories: []collection.ContractNFTHistories{{
+					ContractId: "deadbeef",
+					Entries: []collection.NFTHistoryEntry{{
+						TokenId: collection.NewNFTID("deadbeef", 1),
+						Action:  collection.NFTActionTransfer,
+						From:    "invalid",
+						To:      addr.String(),
+					}},
+				}},
+			},
+			false,
+		},
+		"invalid parent of nft history entry": {
+			&collection.GenesisState{
+				NftHistories: []collection.ContractNFTHistories{{
+					ContractId: "deadbeef",
+					Entries: []collection.NFTHistoryEntry{{
+						TokenId: collection.NewNFTID("deadbeef", 1),
+						Action:  collection.NFTActionAttach,
+						Parent:  "invalid",
+					}},
+				}},
+			},
+			false,
+		},
+		"invalid admin of contract": {
+			&collection.GenesisState{
+				Contracts: []collection.Contract{{
+					Id:    "deadbeef",
+					Name:  "tibetian fox",
+					Admin: "invalid",
+				}},
+			},
+			false,
+		},
+		"invalid pending admin of contract": {
+			&collection.GenesisState{
+				Contracts: []collection.Contract{{
+					Id:           "deadbeef",
+					Name:         "tibetian fox",
+					Admin:        addr.String(),
+					PendingAdmin: "invalid",
+				}},
+			},
+			false,
+		},
+		"valid admin histories": {
+			&collection.GenesisState{
+				Params: params,
+				AdminHistories: []collection.ContractAdminHistory{{
+					ContractId: "deadbeef",
+					Entries: []collection.AdminHistoryEntry{{
+						Admin: addr.String(),
+					}},
+				}},
+			},
+			true,
+		},
+		"admin history of invalid contract id": {
+			&collection.GenesisState{
+				AdminHistories: []collection.ContractAdminHistory{{
+					Entries: []collection.AdminHistoryEntry{{
+						Admin: addr.String(),
+					}},
+				}},
+			},
+			false,
+		},
+		"empty admin history": {
+			&collection.GenesisState{
+				AdminHistories: []collection.ContractAdminHistory{{
+					ContractId: "deadbeef",
+				}},
+			},
+			false,
+		},
+		"invalid admin of admin history": {
+			&collection.GenesisState{
+				AdminHistories: []collection.ContractAdminHistory{{
+					ContractId: "deadbeef",
+					Entries: []collection.AdminHistoryEntry{{
+						PreviousAdmin: addr.String(),
+					}},
+				}},
+			},
+			false,
+		},
 	}
 
 	for name, tc := range testCases {
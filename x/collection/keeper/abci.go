@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/Finschia/finschia-sdk/telemetry"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/collection"
+)
+
+// MaxNFTHistoryPrunesPerBlock is the maximum number of the nft history entries
+// pruned in a block. The rest of the expired entries are pruned in the next blocks.
+const MaxNFTHistoryPrunesPerBlock = 1000
+
+// EndBlocker prunes the nft history entries older than the retention.
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	defer telemetry.ModuleMeasureSince(collection.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.PruneNFTHistory(ctx, MaxNFTHistoryPrunesPerBlock)
+}
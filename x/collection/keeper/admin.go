@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	internaladmin "github.com/Finschia/finschia-sdk/internal/admin"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/collection"
+)
+
+// IsAdmin returns whether the address is the admin of the contract.
+func (k Keeper) IsAdmin(ctx sdk.Context, contractID string, addr sdk.AccAddress) bool {
+	contract, err := k.GetContract(ctx, contractID)
+	if err != nil {
+		return false
+	}
+
+	return contract.Admin == addr.String()
+}
+
+// ProposeAdmin proposes the next admin of the contract, which takes the role on accepting it.
+func (k Keeper) ProposeAdmin(ctx sdk.Context, contractID string, admin, proposed sdk.AccAddress) error {
+	contract, err := k.GetContract(ctx, contractID)
+	if err != nil {
+		return err
+	}
+
+	if err := internaladmin.CheckAdmin(contractID, contract.Admin, admin); err != nil {
+		return collection.ErrTokenNoPermission.Wrap(err.Error())
+	}
+
+	if err := internaladmin.CheckProposed(contract.Admin, proposed); err != nil {
+		return err
+	}
+
+	contract.PendingAdmin = proposed.String()
+	k.setContract(ctx, *contract)
+
+	event := collection.EventProposedAdmin{
+		ContractId:    contractID,
+		Admin:         admin.String(),
+		ProposedAdmin: proposed.String(),
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+	return nil
+}
+
+// AcceptAdmin makes the proposed account the admin of the contract.
+// The grants of the previous admin are moved to the new admin.
+func (k Keeper) AcceptAdmin(ctx sdk.Context, contractID string, admin sdk.AccAddress) error {
+	contract, err := k.GetContract(ctx, contractID)
+	if err != nil {
+		return err
+	}
+
+	if err := internaladmin.CheckPendingAdmin(contractID, contract.PendingAdmin, admin); err != nil {
+		return collection.ErrTokenNoPermission.Wrap(err.Error())
+	}
+
+	previous := contract.Admin
+	k.setAdmin(ctx, *contract, admin)
+
+	if len(previous) != 0 {
+		previousAddr := sdk.MustAccAddressFromBech32(previous)
+
+		var permissions []collection.Permission
+		k.iterateGrantsImpl(ctx, grantKeyPrefixByGrantee(contractID, previousAddr), func(_ string, grant collection.Grant) (stop bool) {
+			permissions = append(permissions, grant.Permission)
+			return false
+		})
+
+		for _, permission := range permissions {
+			k.Abandon(ctx, contractID, previousAddr, permission)
+			k.Grant(ctx, contractID, previousAddr, admin, permission)
+		}
+	}
+
+	event := collection.EventAcceptedAdmin{
+		ContractId:    contractID,
+		PreviousAdmin: previous,
+		Admin:         admin.String(),
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+	return nil
+}
+
+// RevokeGrant revokes the permission of the grantee by the admin of the contract.
+func (k Keeper) RevokeGrant(ctx sdk.Context, contractID string, admin, grantee sdk.AccAddress, permission collection.Permission) error {
+	if !k.IsAdmin(ctx, contractID, admin) {
+		return collection.ErrTokenNoPermission.Wrapf("%s is not the admin of %s", admin, contractID)
+	}
+
+	if _, err := k.GetGrant(ctx, contractID, grantee, permission); err != nil {
+		return err
+	}
+	k.deleteGrant(ctx, contractID, grantee, permission)
+
+	event := collection.EventRevokedPermission{
+		ContractId: contractID,
+		Operator:   admin.String(),
+		Grantee:    grantee.String(),
+		Permission: permission,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+	return nil
+}
+
+// setAdmin sets the admin of the contract, clearing the pending one, and records
+// the change into the admin history.
+func (k Keeper) setAdmin(ctx sdk.Context, contract collection.Contract, admin sdk.AccAddress) {
+	entry := collection.AdminHistoryEntry{
+		PreviousAdmin: contract.Admin,
+		Admin:         admin.String(),
+		Height:        ctx.BlockHeight(),
+		Time:          ctx.BlockTime(),
+	}
+	k.addAdminHistoryEntry(ctx, contract.Id, entry)
+
+	contract.Admin = admin.String()
+	contract.PendingAdmin = ""
+	k.setContract(ctx, contract)
+}
+
+// GetAdminHistory returns the admin history of the contract, in chronological order.
+func (k Keeper) GetAdminHistory(ctx sdk.Context, contractID string) []collection.AdminHistoryEntry {
+	var history []collection.AdminHistoryEntry
+	k.iterateContractAdminHistory(ctx, contractID, func(entry collection.AdminHistoryEntry) (stop bool) {
+		history = append(history, entry)
+		return false
+	})
+
+	return history
+}
+
+func (k Keeper) addAdminHistoryEntry(ctx sdk.Context, contractID string, entry collection.AdminHistoryEntry) {
+	store := ctx.KVStore(k.storeKey)
+
+	sequence := internaladmin.NextHistorySequence(store, adminHistoryKeyPrefixByContractID(contractID))
+	k.setAdminHistoryEntry(ctx, contractID, sequence, entry)
+}
+
+func (k Keeper) setAdminHistoryEntry(ctx sdk.Context, contractID string, sequence uint64, entry collection.AdminHistoryEntry) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz, err := entry.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(adminHistoryKey(contractID, sequence), bz)
+}
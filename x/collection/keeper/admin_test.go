@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/collection"
+)
+
+func (s *KeeperTestSuite) TestProposeAdmin() {
+	testCases := map[string]struct {
+		admin    sdk.AccAddress
+		proposed sdk.AccAddress
+		err      error
+	}{
+		"valid request": {
+			admin:    s.vendor,
+			proposed: s.customer,
+		},
+		"not admin": {
+			admin:    s.operator,
+			proposed: s.customer,
+			err:      collection.ErrTokenNoPermission,
+		},
+		"admin already": {
+			admin:    s.vendor,
+			proposed: s.vendor,
+			err:      sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			err := s.keeper.ProposeAdmin(ctx, s.contractID, tc.admin, tc.proposed)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			contract, err := s.keeper.GetContract(ctx, s.contractID)
+			s.Require().NoError(err)
+			s.Require().Equal(s.vendor.String(), contract.Admin)
+			s.Require().Equal(tc.proposed.String(), contract.PendingAdmin)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestAcceptAdmin() {
+	testCases := map[string]struct {
+		admin sdk.AccAddress
+		err   error
+	}{
+		"valid request": {
+			admin: s.customer,
+		},
+		"not proposed": {
+			admin: s.stranger,
+			err:   collection.ErrTokenNoPermission,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			err := s.keeper.ProposeAdmin(ctx, s.contractID, s.vendor, s.customer)
+			s.Require().NoError(err)
+
+			err = s.keeper.AcceptAdmin(ctx, s.contractID, tc.admin)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			contract, err := s.keeper.GetContract(ctx, s.contractID)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.admin.String(), contract.Admin)
+			s.Require().Empty(contract.PendingAdmin)
+
+			// the grants have been moved
+			for _, permission := range []collection.Permission{
+				collection.PermissionIssue,
+				collection.PermissionModify,
+				collection.PermissionMint,
+				collection.PermissionBurn,
+			} {
+				_, err := s.keeper.GetGrant(ctx, s.contractID, s.vendor, permission)
+				s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+
+				_, err = s.keeper.GetGrant(ctx, s.contractID, tc.admin, permission)
+				s.Require().NoError(err)
+			}
+
+			history := s.keeper.GetAdminHistory(ctx, s.contractID)
+			s.Require().Len(history, 2)
+			s.Require().Equal(s.vendor.String(), history[1].PreviousAdmin)
+			s.Require().Equal(tc.admin.String(), history[1].Admin)
+
+			// the previous admin cannot propose anymore
+			err = s.keeper.ProposeAdmin(ctx, s.contractID, s.vendor, s.stranger)
+			s.Require().ErrorIs(err, collection.ErrTokenNoPermission)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRevokeGrant() {
+	testCases := map[string]struct {
+		admin      sdk.AccAddress
+		permission collection.Permission
+		err        error
+	}{
+		"valid request": {
+			admin:      s.vendor,
+			permission: collection.PermissionMint,
+		},
+		"not admin": {
+			admin:      s.operator,
+			permission: collection.PermissionMint,
+			err:        collection.ErrTokenNoPermission,
+		},
+		"no grant": {
+			admin:      s.vendor,
+			permission: collection.PermissionModify,
+			err:        sdkerrors.ErrNotFound,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			err := s.keeper.RevokeGrant(ctx, s.contractID, tc.admin, s.operator, tc.permission)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			_, err = s.keeper.GetGrant(ctx, s.contractID, s.operator, tc.permission)
+			s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+		})
+	}
+}
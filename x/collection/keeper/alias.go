@@ -36,6 +36,40 @@ func (k Keeper) iterateBalancesImpl(ctx sdk.Context, prefix []byte, fn func(cont
 	}
 }
 
+// iterate through the locks of a contract and perform the provided function
+func (k Keeper) iterateContractLocks(ctx sdk.Context, contractID string, fn func(lock collection.Lock) (stop bool)) {
+	k.iterateLocksImpl(ctx, lockKeyPrefixByContractID(contractID), func(_ string, lock collection.Lock) (stop bool) {
+		return fn(lock)
+	})
+}
+
+func (k Keeper) iterateLocksImpl(ctx sdk.Context, prefix []byte, fn func(contractID string, lock collection.Lock) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		contractID, address, tokenID, schedule := splitLockKey(iterator.Key())
+
+		var amount sdk.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		lock := collection.Lock{
+			Address:  address.String(),
+			TokenId:  tokenID,
+			Amount:   amount,
+			Schedule: schedule,
+		}
+
+		stop := fn(contractID, lock)
+		if stop {
+			break
+		}
+	}
+}
+
 func (k Keeper) iterateContracts(ctx sdk.Context, fn func(contract collection.Contract) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
 
@@ -272,3 +306,53 @@ func (k Keeper) iterateNextTokenIDsImpl(ctx sdk.Context, prefix []byte, fn func(
 		}
 	}
 }
+
+func (k Keeper) iterateNFTHistoryContracts(ctx sdk.Context, fn func(contractID string) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, nftHistoryContractKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		contractID := splitNFTHistoryContractKey(iterator.Key())
+
+		stop := fn(contractID)
+		if stop {
+			break
+		}
+	}
+}
+
+func (k Keeper) iterateContractNFTHistory(ctx sdk.Context, contractID string, fn func(entry collection.NFTHistoryEntry) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, nftHistoryKeyPrefixByContractID(contractID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var entry collection.NFTHistoryEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+
+		stop := fn(entry)
+		if stop {
+			break
+		}
+	}
+}
+
+func (k Keeper) iterateContractAdminHistory(ctx sdk.Context, contractID string, fn func(entry collection.AdminHistoryEntry) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, adminHistoryKeyPrefixByContractID(contractID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var entry collection.AdminHistoryEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+
+		stop := fn(entry)
+		if stop {
+			break
+		}
+	}
+}
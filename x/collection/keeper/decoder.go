@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/Finschia/finschia-sdk/codec"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/kv"
+	"github.com/Finschia/finschia-sdk/x/collection"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding collection type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], balanceKeyPrefix),
+			bytes.Equal(kvA.Key[:1], lockKeyPrefix),
+			bytes.Equal(kvA.Key[:1], supplyKeyPrefix),
+			bytes.Equal(kvA.Key[:1], mintedKeyPrefix),
+			bytes.Equal(kvA.Key[:1], burntKeyPrefix):
+			var amountA, amountB sdk.Int
+			if err := amountA.Unmarshal(kvA.Value); err != nil {
+				panic(err)
+			}
+			if err := amountB.Unmarshal(kvB.Value); err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%v\n%v", amountA, amountB)
+
+		case bytes.Equal(kvA.Key[:1], nextTokenIDKeyPrefix):
+			var idA, idB sdk.Uint
+			if err := idA.Unmarshal(kvA.Value); err != nil {
+				panic(err)
+			}
+			if err := idB.Unmarshal(kvB.Value); err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%v\n%v", idA, idB)
+
+		case bytes.Equal(kvA.Key[:1], paramsKey):
+			var paramsA, paramsB collection.Params
+			cdc.MustUnmarshal(kvA.Value, &paramsA)
+			cdc.MustUnmarshal(kvB.Value, &paramsB)
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+
+		case bytes.Equal(kvA.Key[:1], contractKeyPrefix):
+			var contractA, contractB collection.Contract
+			cdc.MustUnmarshal(kvA.Value, &contractA)
+			cdc.MustUnmarshal(kvB.Value, &contractB)
+			return fmt.Sprintf("%v\n%v", contractA, contractB)
+
+		case bytes.Equal(kvA.Key[:1], classKeyPrefix):
+			var classA, classB collection.TokenClass
+			if err := cdc.UnmarshalInterface(kvA.Value, &classA); err != nil {
+				panic(err)
+			}
+			if err := cdc.UnmarshalInterface(kvB.Value, &classB); err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%v\n%v", classA, classB)
+
+		case bytes.Equal(kvA.Key[:1], nextClassIDKeyPrefix):
+			var idsA, idsB collection.NextClassIDs
+			cdc.MustUnmarshal(kvA.Value, &idsA)
+			cdc.MustUnmarshal(kvB.Value, &idsB)
+			return fmt.Sprintf("%v\n%v", idsA, idsB)
+
+		case bytes.Equal(kvA.Key[:1], ownerKeyPrefix):
+			return fmt.Sprintf("%v\n%v", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], nftKeyPrefix):
+			var nftA, nftB collection.NFT
+			cdc.MustUnmarshal(kvA.Value, &nftA)
+			cdc.MustUnmarshal(kvB.Value, &nftB)
+			return fmt.Sprintf("%v\n%v", nftA, nftB)
+
+		case bytes.Equal(kvA.Key[:1], parentKeyPrefix):
+			var parentA, parentB gogotypes.StringValue
+			cdc.MustUnmarshal(kvA.Value, &parentA)
+			cdc.MustUnmarshal(kvB.Value, &parentB)
+			return fmt.Sprintf("%v\n%v", parentA.Value, parentB.Value)
+
+		case bytes.Equal(kvA.Key[:1], nftHistoryKeyPrefix):
+			var entryA, entryB collection.NFTHistoryEntry
+			cdc.MustUnmarshal(kvA.Value, &entryA)
+			cdc.MustUnmarshal(kvB.Value, &entryB)
+			return fmt.Sprintf("%v\n%v", entryA, entryB)
+
+		case bytes.Equal(kvA.Key[:1], nftHistorySequenceKey):
+			return fmt.Sprintf("%d\n%d", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], nftHistoryQueueKeyPrefix):
+			// the values are the keys of the history entries
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], adminHistoryKeyPrefix):
+			var entryA, entryB collection.AdminHistoryEntry
+			cdc.MustUnmarshal(kvA.Value, &entryA)
+			cdc.MustUnmarshal(kvB.Value, &entryB)
+			return fmt.Sprintf("%v\n%v", entryA, entryB)
+
+		case bytes.Equal(kvA.Key[:1], childKeyPrefix),
+			bytes.Equal(kvA.Key[:1], holderKeyPrefix),
+			bytes.Equal(kvA.Key[:1], ownerNFTKeyPrefix),
+			bytes.Equal(kvA.Key[:1], nftTraitKeyPrefix),
+			bytes.Equal(kvA.Key[:1], authorizationKeyPrefix),
+			bytes.Equal(kvA.Key[:1], grantKeyPrefix),
+			bytes.Equal(kvA.Key[:1], nftHistoryContractKeyPrefix),
+			bytes.Equal(kvA.Key[:1], legacyTokenKeyPrefix),
+			bytes.Equal(kvA.Key[:1], legacyTokenTypeKeyPrefix):
+			// the values are empty, the keys carry the data
+			return fmt.Sprintf("%X\n%X", kvA.Key, kvB.Key)
+
+		default:
+			panic(fmt.Sprintf("invalid collection key %X", kvA.Key))
+		}
+	}
+}
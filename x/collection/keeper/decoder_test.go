@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/Finschia/finschia-sdk/simapp"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/kv"
+	"github.com/Finschia/finschia-sdk/x/collection"
+	"github.com/Finschia/finschia-sdk/x/collection/keeper"
+)
+
+func TestDecodeStore(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	dec := keeper.NewDecodeStore(app.AppCodec())
+
+	owner := sdk.AccAddress("owner")
+	holder := sdk.AccAddress("holder")
+	contractID := app.CollectionKeeper.CreateContract(ctx, owner, collection.Contract{Name: "fox"})
+	app.CollectionKeeper.SetNFTHistoryEnabled(ctx, contractID, true)
+
+	ftClassID, err := app.CollectionKeeper.CreateTokenClass(ctx, contractID, &collection.FTClass{
+		Name:     "tibetian fox",
+		Mintable: true,
+	})
+	require.NoError(t, err)
+	ft := collection.NewFTCoin(*ftClassID, sdk.NewInt(100))
+	require.NoError(t, app.CollectionKeeper.MintFT(ctx, contractID, owner, collection.NewCoins(ft)))
+
+	nftClassID, err := app.CollectionKeeper.CreateTokenClass(ctx, contractID, &collection.NFTClass{
+		Name: "fennec fox",
+	})
+	require.NoError(t, err)
+	nfts, err := app.CollectionKeeper.MintNFT(ctx, contractID, owner, []collection.MintNFTParam{
+		{TokenType: *nftClassID, Name: "arctic fox"},
+		{TokenType: *nftClassID, Name: "red fox"},
+		{TokenType: *nftClassID, Name: "grey fox"},
+	})
+	require.NoError(t, err)
+	require.NoError(t, app.CollectionKeeper.Attach(ctx, contractID, owner, nfts[1].TokenId, nfts[0].TokenId))
+	_, err = app.CollectionKeeper.BurnCoins(ctx, contractID, owner, collection.NewCoins(collection.NewNFTCoin(*nftClassID, 3)))
+	require.NoError(t, err)
+
+	require.NoError(t, app.CollectionKeeper.SendCoins(ctx, contractID, owner, holder, collection.NewCoins(collection.NewFTCoin(*ftClassID, sdk.OneInt()))))
+	require.NoError(t, app.CollectionKeeper.AuthorizeOperator(ctx, contractID, holder, owner))
+	require.NoError(t, app.CollectionKeeper.LockCoins(ctx, contractID, holder, collection.NewCoins(collection.NewFTCoin(*ftClassID, sdk.OneInt())), collection.VestingSchedule{
+		StartTime: ctx.BlockTime(),
+		EndTime:   ctx.BlockTime().Add(time.Hour),
+	}))
+
+	// every entry in the store must be decodable
+	store := ctx.KVStore(app.GetKey(collection.StoreKey))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		pair := kv.Pair{Key: iterator.Key(), Value: iterator.Value()}
+		require.NotPanics(t, func() { dec(pair, pair) }, "%X", pair.Key)
+	}
+
+	invalid := kv.Pair{Key: []byte{0x99}, Value: []byte{0x99}}
+	require.Panics(t, func() { dec(invalid, invalid) })
+}
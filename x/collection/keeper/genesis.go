@@ -180,6 +180,46 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *collection.GenesisState) {
 		reporter.Tick()
 	}
 
+	reporter = newProgressReporter(k.Logger(ctx), "import locks", len(data.Locks))
+	for _, contractLocks := range data.Locks {
+		contractID := contractLocks.ContractId
+
+		for _, lock := range contractLocks.Locks {
+			addr, err := sdk.AccAddressFromBech32(lock.Address)
+			if err != nil {
+				panic(err)
+			}
+			k.setLock(ctx, contractID, addr, lock.TokenId, lock.Schedule, lock.Amount)
+		}
+
+		reporter.Tick()
+	}
+
+	reporter = newProgressReporter(k.Logger(ctx), "import nft history contracts", len(data.NftHistoryContracts))
+	for _, contractID := range data.NftHistoryContracts {
+		k.SetNFTHistoryEnabled(ctx, contractID, true)
+
+		reporter.Tick()
+	}
+
+	reporter = newProgressReporter(k.Logger(ctx), "import nft histories", len(data.NftHistories))
+	for _, contractHistories := range data.NftHistories {
+		for _, entry := range contractHistories.Entries {
+			k.addNFTHistoryEntry(ctx, contractHistories.ContractId, entry)
+		}
+
+		reporter.Tick()
+	}
+
+	reporter = newProgressReporter(k.Logger(ctx), "import admin histories", len(data.AdminHistories))
+	for _, contractHistory := range data.AdminHistories {
+		for i, entry := range contractHistory.Entries {
+			k.setAdminHistoryEntry(ctx, contractHistory.ContractId, uint64(i), entry)
+		}
+
+		reporter.Tick()
+	}
+
 	reporter = newProgressReporter(k.Logger(ctx), "import statistics (burnt)", len(data.Burnts))
 	for _, contractBurnts := range data.Burnts {
 		contractID := contractBurnts.ContractId
@@ -211,6 +251,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *collection.GenesisState {
 	contracts := k.getContracts(ctx)
 
 	return &collection.GenesisState{
+		Params:         k.GetParams(ctx),
 		Contracts:      contracts,
 		NextClassIds:   k.getAllNextClassIDs(ctx),
 		Classes:        k.getClasses(ctx, contracts),
@@ -222,6 +263,12 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *collection.GenesisState {
 		Authorizations: k.getAuthorizations(ctx, contracts),
 		Supplies:       k.getSupplies(ctx, contracts),
 		Burnts:         k.getBurnts(ctx, contracts),
+		Locks:          k.getLocks(ctx, contracts),
+
+		NftHistoryContracts: k.getNFTHistoryContracts(ctx),
+		NftHistories:        k.getNFTHistories(ctx, contracts),
+
+		AdminHistories: k.getAdminHistories(ctx, contracts),
 	}
 }
 
@@ -326,6 +373,76 @@ func (k Keeper) getContractBalances(ctx sdk.Context, contractID string) []collec
 	return balances
 }
 
+func (k Keeper) getLocks(ctx sdk.Context, contracts []collection.Contract) []collection.ContractLocks {
+	var locks []collection.ContractLocks
+	for _, contract := range contracts {
+		contractID := contract.Id
+		contractLocks := collection.ContractLocks{
+			ContractId: contractID,
+		}
+
+		k.iterateContractLocks(ctx, contractID, func(lock collection.Lock) (stop bool) {
+			contractLocks.Locks = append(contractLocks.Locks, lock)
+			return false
+		})
+		if len(contractLocks.Locks) != 0 {
+			locks = append(locks, contractLocks)
+		}
+	}
+
+	return locks
+}
+
+func (k Keeper) getNFTHistoryContracts(ctx sdk.Context) []string {
+	var contractIDs []string
+	k.iterateNFTHistoryContracts(ctx, func(contractID string) (stop bool) {
+		contractIDs = append(contractIDs, contractID)
+		return false
+	})
+
+	return contractIDs
+}
+
+func (k Keeper) getNFTHistories(ctx sdk.Context, contracts []collection.Contract) []collection.ContractNFTHistories {
+	var histories []collection.ContractNFTHistories
+	for _, contract := range contracts {
+		contractID := contract.Id
+		contractHistories := collection.ContractNFTHistories{
+			ContractId: contractID,
+		}
+
+		k.iterateContractNFTHistory(ctx, contractID, func(entry collection.NFTHistoryEntry) (stop bool) {
+			contractHistories.Entries = append(contractHistories.Entries, entry)
+			return false
+		})
+		if len(contractHistories.Entries) != 0 {
+			histories = append(histories, contractHistories)
+		}
+	}
+
+	return histories
+}
+
+func (k Keeper) getAdminHistories(ctx sdk.Context, contracts []collection.Contract) []collection.ContractAdminHistory {
+	var histories []collection.ContractAdminHistory
+	for _, contract := range contracts {
+		contractID := contract.Id
+		contractHistory := collection.ContractAdminHistory{
+			ContractId: contractID,
+		}
+
+		k.iterateContractAdminHistory(ctx, contractID, func(entry collection.AdminHistoryEntry) (stop bool) {
+			contractHistory.Entries = append(contractHistory.Entries, entry)
+			return false
+		})
+		if len(contractHistory.Entries) != 0 {
+			histories = append(histories, contractHistory)
+		}
+	}
+
+	return histories
+}
+
 func (k Keeper) getNFTs(ctx sdk.Context, contracts []collection.Contract) []collection.ContractNFTs {
 	var parents []collection.ContractNFTs
 	for _, contract := range contracts {
@@ -1,12 +1,26 @@
 package keeper_test
 
 import (
+	"time"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/Finschia/finschia-sdk/simapp"
 	"github.com/Finschia/finschia-sdk/x/collection"
 )
 
 func (s *KeeperTestSuite) TestImportExportGenesis() {
+	// lock some tokens
+	schedule := collection.VestingSchedule{
+		StartTime: s.ctx.BlockTime(),
+		EndTime:   s.ctx.BlockTime().Add(time.Hour),
+	}
+	locked := collection.NewCoins(collection.NewFTCoin(s.ftClassID, s.balance))
+	s.Require().NoError(s.keeper.LockCoins(s.ctx, s.contractID, s.operator, locked, schedule))
+
 	// export
 	genesis := s.keeper.ExportGenesis(s.ctx)
+	s.Require().Len(genesis.Locks, 1)
 
 	// forge
 	amount := collection.NewCoins(collection.NewFTCoin(s.ftClassID, s.balance))
@@ -20,6 +34,7 @@ func (s *KeeperTestSuite) TestImportExportGenesis() {
 	s.Require().NoError(err)
 
 	s.keeper.Abandon(s.ctx, s.contractID, s.vendor, collection.PermissionMint)
+	s.Require().NoError(s.keeper.LockCoins(s.ctx, s.contractID, s.operator, locked, schedule))
 
 	// restore
 	s.keeper.InitGenesis(s.ctx, genesis)
@@ -28,3 +43,28 @@ func (s *KeeperTestSuite) TestImportExportGenesis() {
 	newGenesis := s.keeper.ExportGenesis(s.ctx)
 	s.Require().Equal(genesis, newGenesis)
 }
+
+func (s *KeeperTestSuite) TestImportExportNFTHistory() {
+	s.keeper.SetNFTHistoryEnabled(s.ctx, s.contractID, true)
+
+	amount := collection.NewCoins(collection.NewNFTCoin(s.nftClassID, 1))
+	err := s.keeper.SendCoins(s.ctx, s.contractID, s.customer, s.vendor, amount)
+	s.Require().NoError(err)
+	_, err = s.keeper.BurnCoins(s.ctx, s.contractID, s.vendor, amount)
+	s.Require().NoError(err)
+
+	// export
+	genesis := s.keeper.ExportGenesis(s.ctx)
+	s.Require().Equal([]string{s.contractID}, genesis.NftHistoryContracts)
+	s.Require().Len(genesis.NftHistories, 1)
+
+	// restore into a fresh state
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	app.CollectionKeeper.InitGenesis(ctx, genesis)
+
+	// export again and compare
+	newGenesis := app.CollectionKeeper.ExportGenesis(ctx)
+	s.Require().Equal(genesis.NftHistoryContracts, newGenesis.NftHistoryContracts)
+	s.Require().Equal(genesis.NftHistories, newGenesis.NftHistories)
+}
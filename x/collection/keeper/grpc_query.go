@@ -94,6 +94,65 @@ func (s queryServer) Balance(c context.Context, req *collection.QueryBalanceRequ
 	return &collection.QueryBalanceResponse{Balance: coin}, nil
 }
 
+// Spendable queries the number of tokens of a given token id owned by the owner, which are not locked.
+func (s queryServer) Spendable(c context.Context, req *collection.QuerySpendableRequest) (*collection.QuerySpendableResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := collection.ValidateContractID(req.ContractId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	addr, err := s.addressFromBech32GRPC(req.Address, "address")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := collection.ValidateTokenID(req.TokenId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	spendable := s.keeper.GetSpendable(ctx, req.ContractId, addr, req.TokenId)
+	coin := collection.Coin{
+		TokenId: req.TokenId,
+		Amount:  spendable,
+	}
+
+	return &collection.QuerySpendableResponse{Balance: coin}, nil
+}
+
+// Locked queries the number of locked tokens of a given token id owned by the owner.
+func (s queryServer) Locked(c context.Context, req *collection.QueryLockedRequest) (*collection.QueryLockedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := collection.ValidateContractID(req.ContractId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	addr, err := s.addressFromBech32GRPC(req.Address, "address")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := collection.ValidateTokenID(req.TokenId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	locked := s.keeper.GetLocked(ctx, req.ContractId, addr, req.TokenId)
+	coin := collection.Coin{
+		TokenId: req.TokenId,
+		Amount:  locked,
+	}
+	locks := s.keeper.GetLocks(ctx, req.ContractId, addr, req.TokenId)
+
+	return &collection.QueryLockedResponse{Balance: coin, Locks: locks}, nil
+}
+
 // AllBalances queries all tokens owned by owner.
 func (s queryServer) AllBalances(c context.Context, req *collection.QueryAllBalancesRequest) (*collection.QueryAllBalancesResponse, error) {
 	if req == nil {
@@ -300,10 +359,209 @@ func (s queryServer) Contract(c context.Context, req *collection.QueryContractRe
 		return nil, status.Error(codes.NotFound, err.Error())
 	}
 
-	return &collection.QueryContractResponse{Contract: *contract}, nil
+	history := s.keeper.GetAdminHistory(ctx, req.ContractId)
+
+	return &collection.QueryContractResponse{Contract: *contract, AdminHistory: history}, nil
 }
 
 // TokenClassTypeName queries the fully qualified message type name of a token class based on its class id.
+func (s queryServer) Contracts(c context.Context, req *collection.QueryContractsRequest) (*collection.QueryContractsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	contractStore := prefix.NewStore(store, contractKeyPrefix)
+	var contracts []collection.Contract
+	pageRes, err := query.Paginate(contractStore, req.Pagination, func(_ []byte, value []byte) error {
+		var contract collection.Contract
+		s.keeper.cdc.MustUnmarshal(value, &contract)
+
+		contracts = append(contracts, contract)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &collection.QueryContractsResponse{Contracts: contracts, Pagination: pageRes}, nil
+}
+
+func (s queryServer) TokenClasses(c context.Context, req *collection.QueryTokenClassesRequest) (*collection.QueryTokenClassesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := collection.ValidateContractID(req.ContractId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	classStore := prefix.NewStore(store, classKeyPrefixByContractID(req.ContractId))
+	var classes []codectypes.Any
+	pageRes, err := query.Paginate(classStore, req.Pagination, func(_ []byte, value []byte) error {
+		var class collection.TokenClass
+		if err := s.keeper.cdc.UnmarshalInterface(value, &class); err != nil {
+			panic(err)
+		}
+
+		any, err := codectypes.NewAnyWithValue(class)
+		if err != nil {
+			panic(err)
+		}
+
+		classes = append(classes, *any)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &collection.QueryTokenClassesResponse{Classes: classes, Pagination: pageRes}, nil
+}
+
+func (s queryServer) NFTsByType(c context.Context, req *collection.QueryNFTsByTypeRequest) (*collection.QueryNFTsByTypeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := collection.ValidateContractID(req.ContractId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	classID := req.TokenType
+	if err := collection.ValidateClassID(classID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	// the token ids of the nfts start with their class id
+	nftStore := prefix.NewStore(store, nftKey(req.ContractId, classID))
+	var tokens []collection.NFT
+	pageRes, err := query.Paginate(nftStore, req.Pagination, func(_ []byte, value []byte) error {
+		var token collection.NFT
+		s.keeper.cdc.MustUnmarshal(value, &token)
+
+		tokens = append(tokens, token)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &collection.QueryNFTsByTypeResponse{Tokens: tokens, Pagination: pageRes}, nil
+}
+
+func (s queryServer) FTHolders(c context.Context, req *collection.QueryFTHoldersRequest) (*collection.QueryFTHoldersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := collection.ValidateContractID(req.ContractId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := collection.ValidateFTID(req.TokenId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	holderStore := prefix.NewStore(store, holderKeyPrefixByTokenID(req.ContractId, req.TokenId))
+	var holders []collection.Holder
+	pageRes, err := query.Paginate(holderStore, req.Pagination, func(key []byte, _ []byte) error {
+		address := sdk.AccAddress(key)
+		holders = append(holders, collection.Holder{
+			Address: address.String(),
+			Amount:  s.keeper.GetBalance(ctx, req.ContractId, address, req.TokenId),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &collection.QueryFTHoldersResponse{Holders: holders, Pagination: pageRes}, nil
+}
+
+func (s queryServer) OwnerNFTs(c context.Context, req *collection.QueryOwnerNFTsRequest) (*collection.QueryOwnerNFTsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	owner, err := s.addressFromBech32GRPC(req.Owner, "owner")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	ownerNFTStore := prefix.NewStore(store, ownerNFTKeyPrefixByOwner(owner))
+	var nfts []collection.OwnedNFT
+	pageRes, err := query.Paginate(ownerNFTStore, req.Pagination, func(key []byte, _ []byte) error {
+		contractID, tokenID := splitOwnerNFTKeyTail(key)
+		token, err := s.keeper.GetNFT(ctx, contractID, tokenID)
+		if err != nil {
+			panic(err)
+		}
+
+		nfts = append(nfts, collection.OwnedNFT{
+			ContractId: contractID,
+			Nft:        *token,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &collection.QueryOwnerNFTsResponse{Nfts: nfts, Pagination: pageRes}, nil
+}
+
+func (s queryServer) NFTsByTrait(c context.Context, req *collection.QueryNFTsByTraitRequest) (*collection.QueryNFTsByTraitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := collection.ValidateContractID(req.ContractId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	trait := collection.Attribute{
+		Key:   req.Key,
+		Value: req.Value,
+	}
+	if err := collection.ValidateTraitKey(trait.Key); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := collection.ValidateTraitValue(trait.Value); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	traitStore := prefix.NewStore(store, nftTraitKeyPrefixByValue(req.ContractId, trait))
+	var tokens []collection.NFT
+	pageRes, err := query.Paginate(traitStore, req.Pagination, func(key []byte, _ []byte) error {
+		tokenID := string(key)
+		token, err := s.keeper.GetNFT(ctx, req.ContractId, tokenID)
+		if err != nil {
+			panic(err)
+		}
+
+		tokens = append(tokens, *token)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &collection.QueryNFTsByTraitResponse{Tokens: tokens, Pagination: pageRes}, nil
+}
+
 func (s queryServer) TokenClassTypeName(c context.Context, req *collection.QueryTokenClassTypeNameRequest) (*collection.QueryTokenClassTypeNameResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -536,6 +794,70 @@ func (s queryServer) Children(c context.Context, req *collection.QueryChildrenRe
 	return &collection.QueryChildrenResponse{Children: children, Pagination: pageRes}, nil
 }
 
+func (s queryServer) Royalty(c context.Context, req *collection.QueryRoyaltyRequest) (*collection.QueryRoyaltyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := collection.ValidateContractID(req.ContractId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := collection.ValidateNFTID(req.TokenId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := req.SalePrice.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	royalty, err := s.keeper.GetRoyalty(ctx, req.ContractId, req.TokenId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if royalty == nil {
+		return &collection.QueryRoyaltyResponse{Royalty: sdk.NewCoin(req.SalePrice.Denom, sdk.ZeroInt())}, nil
+	}
+
+	return &collection.QueryRoyaltyResponse{
+		Recipient: royalty.Recipient,
+		Royalty:   royalty.Amount(req.SalePrice),
+	}, nil
+}
+
+func (s queryServer) NFTHistory(c context.Context, req *collection.QueryNFTHistoryRequest) (*collection.QueryNFTHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := collection.ValidateContractID(req.ContractId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := collection.ValidateNFTID(req.TokenId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	historyStore := prefix.NewStore(store, nftHistoryKeyPrefixByTokenID(req.ContractId, req.TokenId))
+	var entries []collection.NFTHistoryEntry
+	pageRes, err := query.Paginate(historyStore, req.Pagination, func(_ []byte, value []byte) error {
+		var entry collection.NFTHistoryEntry
+		s.keeper.cdc.MustUnmarshal(value, &entry)
+
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &collection.QueryNFTHistoryResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
 func (s queryServer) GranteeGrants(c context.Context, req *collection.QueryGranteeGrantsRequest) (*collection.QueryGranteeGrantsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
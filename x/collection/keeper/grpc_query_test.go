@@ -572,6 +572,9 @@ func (s *KeeperTestSuite) TestQueryContract() {
 			valid:      true,
 			postTest: func(res *collection.QueryContractResponse) {
 				s.Require().Equal(s.contractID, res.Contract.Id)
+				s.Require().Equal(s.vendor.String(), res.Contract.Admin)
+				s.Require().Len(res.AdminHistory, 1)
+				s.Require().Equal(s.vendor.String(), res.AdminHistory[0].Admin)
 			},
 		},
 		"invalid contract id": {},
@@ -597,6 +600,320 @@ func (s *KeeperTestSuite) TestQueryContract() {
 	}
 }
 
+func (s *KeeperTestSuite) TestQueryContracts() {
+	// empty request
+	_, err := s.queryServer.Contracts(s.goCtx, nil)
+	s.Require().Error(err)
+
+	testCases := map[string]struct {
+		count    uint64
+		postTest func(res *collection.QueryContractsResponse)
+	}{
+		"valid request": {
+			postTest: func(res *collection.QueryContractsResponse) {
+				s.Require().Equal(1, len(res.Contracts))
+				s.Require().Equal(s.contractID, res.Contracts[0].Id)
+			},
+		},
+		"valid request with limit": {
+			count: 1,
+			postTest: func(res *collection.QueryContractsResponse) {
+				s.Require().Equal(1, len(res.Contracts))
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			pageReq := &query.PageRequest{}
+			if tc.count != 0 {
+				pageReq.Limit = tc.count
+			}
+			req := &collection.QueryContractsRequest{
+				Pagination: pageReq,
+			}
+			res, err := s.queryServer.Contracts(s.goCtx, req)
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryTokenClasses() {
+	// empty request
+	_, err := s.queryServer.TokenClasses(s.goCtx, nil)
+	s.Require().Error(err)
+
+	testCases := map[string]struct {
+		contractID string
+		valid      bool
+		count      uint64
+		postTest   func(res *collection.QueryTokenClassesResponse)
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			valid:      true,
+			postTest: func(res *collection.QueryTokenClassesResponse) {
+				s.Require().Equal(2, len(res.Classes))
+				ids := make([]string, len(res.Classes))
+				for i := range res.Classes {
+					ids[i] = collection.TokenClassFromAny(&res.Classes[i]).GetId()
+				}
+				s.Require().ElementsMatch([]string{s.ftClassID, s.nftClassID}, ids)
+			},
+		},
+		"valid request with limit": {
+			contractID: s.contractID,
+			valid:      true,
+			count:      1,
+			postTest: func(res *collection.QueryTokenClassesResponse) {
+				s.Require().Equal(1, len(res.Classes))
+				s.Require().NotNil(res.Pagination.NextKey)
+			},
+		},
+		"contract not found": {
+			contractID: "deadbeef",
+			valid:      true,
+			postTest: func(res *collection.QueryTokenClassesResponse) {
+				s.Require().Equal(0, len(res.Classes))
+			},
+		},
+		"invalid contract id": {},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			pageReq := &query.PageRequest{}
+			if tc.count != 0 {
+				pageReq.Limit = tc.count
+			}
+			req := &collection.QueryTokenClassesRequest{
+				ContractId: tc.contractID,
+				Pagination: pageReq,
+			}
+			res, err := s.queryServer.TokenClasses(s.goCtx, req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryNFTsByType() {
+	// empty request
+	_, err := s.queryServer.NFTsByType(s.goCtx, nil)
+	s.Require().Error(err)
+
+	testCases := map[string]struct {
+		contractID string
+		classID    string
+		valid      bool
+		count      uint64
+		postTest   func(res *collection.QueryNFTsByTypeResponse)
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			classID:    s.nftClassID,
+			valid:      true,
+			postTest: func(res *collection.QueryNFTsByTypeResponse) {
+				s.Require().Equal(s.numNFTs*3, len(res.Tokens))
+				s.Require().Equal(collection.NewNFTID(s.nftClassID, 1), res.Tokens[0].TokenId)
+			},
+		},
+		"valid request with limit": {
+			contractID: s.contractID,
+			classID:    s.nftClassID,
+			valid:      true,
+			count:      1,
+			postTest: func(res *collection.QueryNFTsByTypeResponse) {
+				s.Require().Equal(1, len(res.Tokens))
+				s.Require().NotNil(res.Pagination.NextKey)
+			},
+		},
+		"class of fungible tokens": {
+			contractID: s.contractID,
+			classID:    s.ftClassID,
+			valid:      true,
+			postTest: func(res *collection.QueryNFTsByTypeResponse) {
+				s.Require().Equal(0, len(res.Tokens))
+			},
+		},
+		"invalid contract id": {
+			classID: s.nftClassID,
+		},
+		"invalid token type": {
+			contractID: s.contractID,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			pageReq := &query.PageRequest{}
+			if tc.count != 0 {
+				pageReq.Limit = tc.count
+			}
+			req := &collection.QueryNFTsByTypeRequest{
+				ContractId: tc.contractID,
+				TokenType:  tc.classID,
+				Pagination: pageReq,
+			}
+			res, err := s.queryServer.NFTsByType(s.goCtx, req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryFTHolders() {
+	// empty request
+	_, err := s.queryServer.FTHolders(s.goCtx, nil)
+	s.Require().Error(err)
+
+	tokenID := collection.NewFTID(s.ftClassID)
+	testCases := map[string]struct {
+		contractID string
+		tokenID    string
+		valid      bool
+		count      uint64
+		postTest   func(res *collection.QueryFTHoldersResponse)
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			tokenID:    tokenID,
+			valid:      true,
+			postTest: func(res *collection.QueryFTHoldersResponse) {
+				s.Require().Equal(3, len(res.Holders))
+				addresses := make([]string, len(res.Holders))
+				for i, holder := range res.Holders {
+					addresses[i] = holder.Address
+					s.Require().Equal(s.balance, holder.Amount)
+				}
+				s.Require().ElementsMatch([]string{s.customer.String(), s.operator.String(), s.vendor.String()}, addresses)
+			},
+		},
+		"valid request with limit": {
+			contractID: s.contractID,
+			tokenID:    tokenID,
+			valid:      true,
+			count:      1,
+			postTest: func(res *collection.QueryFTHoldersResponse) {
+				s.Require().Equal(1, len(res.Holders))
+				s.Require().NotNil(res.Pagination.NextKey)
+			},
+		},
+		"token not found": {
+			contractID: s.contractID,
+			tokenID:    collection.NewFTID("deadbeef"),
+			valid:      true,
+			postTest: func(res *collection.QueryFTHoldersResponse) {
+				s.Require().Equal(0, len(res.Holders))
+			},
+		},
+		"invalid contract id": {
+			tokenID: tokenID,
+		},
+		"invalid token id": {
+			contractID: s.contractID,
+			tokenID:    collection.NewNFTID(s.nftClassID, 1),
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			pageReq := &query.PageRequest{}
+			if tc.count != 0 {
+				pageReq.Limit = tc.count
+			}
+			req := &collection.QueryFTHoldersRequest{
+				ContractId: tc.contractID,
+				TokenId:    tc.tokenID,
+				Pagination: pageReq,
+			}
+			res, err := s.queryServer.FTHolders(s.goCtx, req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryOwnerNFTs() {
+	// empty request
+	_, err := s.queryServer.OwnerNFTs(s.goCtx, nil)
+	s.Require().Error(err)
+
+	testCases := map[string]struct {
+		owner    sdk.AccAddress
+		valid    bool
+		count    uint64
+		postTest func(res *collection.QueryOwnerNFTsResponse)
+	}{
+		"valid request": {
+			owner: s.customer,
+			valid: true,
+			postTest: func(res *collection.QueryOwnerNFTsResponse) {
+				s.Require().Equal(s.numRoots, len(res.Nfts))
+				for _, nft := range res.Nfts {
+					s.Require().Equal(s.contractID, nft.ContractId)
+					s.Require().Equal(s.customer, s.keeper.GetRootOwner(s.ctx, s.contractID, nft.Nft.TokenId))
+				}
+			},
+		},
+		"valid request with limit": {
+			owner: s.customer,
+			valid: true,
+			count: 1,
+			postTest: func(res *collection.QueryOwnerNFTsResponse) {
+				s.Require().Equal(1, len(res.Nfts))
+				s.Require().NotNil(res.Pagination.NextKey)
+			},
+		},
+		"owner without nfts": {
+			owner: s.stranger,
+			valid: true,
+			postTest: func(res *collection.QueryOwnerNFTsResponse) {
+				s.Require().Equal(0, len(res.Nfts))
+			},
+		},
+		"invalid owner": {},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			pageReq := &query.PageRequest{}
+			if tc.count != 0 {
+				pageReq.Limit = tc.count
+			}
+			req := &collection.QueryOwnerNFTsRequest{
+				Owner:      tc.owner.String(),
+				Pagination: pageReq,
+			}
+			res, err := s.queryServer.OwnerNFTs(s.goCtx, req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestQueryTokenClassTypeName() {
 	// empty request
 	_, err := s.queryServer.TokenClassTypeName(s.goCtx, nil)
@@ -1049,6 +1366,158 @@ func (s *KeeperTestSuite) TestQueryChildren() {
 	}
 }
 
+func (s *KeeperTestSuite) TestQueryRoyalty() {
+	// empty request
+	_, err := s.queryServer.Royalty(s.goCtx, nil)
+	s.Require().Error(err)
+
+	ctx, _ := s.ctx.CacheContext()
+	changes := []collection.Attribute{
+		{Key: collection.AttributeKeyRoyaltyRecipient.String(), Value: s.vendor.String()},
+		{Key: collection.AttributeKeyRoyaltyBasisPoints.String(), Value: "250"},
+	}
+	err = s.keeper.ModifyTokenClass(ctx, s.contractID, s.nftClassID, s.vendor, changes)
+	s.Require().NoError(err)
+
+	tokenID := collection.NewNFTID(s.nftClassID, 1)
+	salePrice := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+	testCases := map[string]struct {
+		contractID string
+		tokenID    string
+		salePrice  sdk.Coin
+		valid      bool
+		postTest   func(res *collection.QueryRoyaltyResponse)
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			tokenID:    tokenID,
+			salePrice:  salePrice,
+			valid:      true,
+			postTest: func(res *collection.QueryRoyaltyResponse) {
+				s.Require().Equal(s.vendor.String(), res.Recipient)
+				s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 25), res.Royalty)
+			},
+		},
+		"invalid contract id": {
+			tokenID:   tokenID,
+			salePrice: salePrice,
+		},
+		"invalid token id": {
+			contractID: s.contractID,
+			salePrice:  salePrice,
+		},
+		"invalid sale price": {
+			contractID: s.contractID,
+			tokenID:    tokenID,
+		},
+		"token not found": {
+			contractID: s.contractID,
+			tokenID:    collection.NewNFTID("deadbeef", 1),
+			salePrice:  salePrice,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			req := &collection.QueryRoyaltyRequest{
+				ContractId: tc.contractID,
+				TokenId:    tc.tokenID,
+				SalePrice:  tc.salePrice,
+			}
+			res, err := s.queryServer.Royalty(sdk.WrapSDKContext(ctx), req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryNFTHistory() {
+	// empty request
+	_, err := s.queryServer.NFTHistory(s.goCtx, nil)
+	s.Require().Error(err)
+
+	ctx, _ := s.ctx.CacheContext()
+	s.keeper.SetNFTHistoryEnabled(ctx, s.contractID, true)
+
+	tokenID := collection.NewNFTID(s.nftClassID, 5)
+	amount := collection.NewCoins(collection.NewCoin(tokenID, sdk.OneInt()))
+	err = s.keeper.SendCoins(ctx, s.contractID, s.customer, s.vendor, amount)
+	s.Require().NoError(err)
+	err = s.keeper.SendCoins(ctx, s.contractID, s.vendor, s.customer, amount)
+	s.Require().NoError(err)
+
+	testCases := map[string]struct {
+		contractID string
+		tokenID    string
+		valid      bool
+		count      uint64
+		postTest   func(res *collection.QueryNFTHistoryResponse)
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			tokenID:    tokenID,
+			valid:      true,
+			postTest: func(res *collection.QueryNFTHistoryResponse) {
+				s.Require().Equal(2, len(res.Entries))
+				s.Require().Equal(s.customer.String(), res.Entries[0].From)
+				s.Require().Equal(s.vendor.String(), res.Entries[1].From)
+			},
+		},
+		"valid request with limit": {
+			contractID: s.contractID,
+			tokenID:    tokenID,
+			valid:      true,
+			count:      1,
+			postTest: func(res *collection.QueryNFTHistoryResponse) {
+				s.Require().Equal(1, len(res.Entries))
+				s.Require().Equal(s.customer.String(), res.Entries[0].From)
+				s.Require().NotNil(res.Pagination.NextKey)
+			},
+		},
+		"no history": {
+			contractID: s.contractID,
+			tokenID:    collection.NewNFTID(s.nftClassID, 6),
+			valid:      true,
+			postTest: func(res *collection.QueryNFTHistoryResponse) {
+				s.Require().Equal(0, len(res.Entries))
+			},
+		},
+		"invalid contract id": {
+			tokenID: tokenID,
+		},
+		"invalid token id": {
+			contractID: s.contractID,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			pageReq := &query.PageRequest{}
+			if tc.count != 0 {
+				pageReq.Limit = tc.count
+			}
+			req := &collection.QueryNFTHistoryRequest{
+				ContractId: tc.contractID,
+				TokenId:    tc.tokenID,
+				Pagination: pageReq,
+			}
+			res, err := s.queryServer.NFTHistory(sdk.WrapSDKContext(ctx), req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestQueryGranteeGrants() {
 	// empty request
 	_, err := s.queryServer.GranteeGrants(s.goCtx, nil)
@@ -1232,3 +1701,92 @@ func (s *KeeperTestSuite) TestQueryHoldersByOperator() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestQueryNFTsByTrait() {
+	// empty request
+	_, err := s.queryServer.NFTsByTrait(s.goCtx, nil)
+	s.Require().Error(err)
+
+	ctx, _ := s.ctx.CacheContext()
+	goCtx := sdk.WrapSDKContext(ctx)
+	contractID, classID := s.createTraitContract(ctx)
+
+	params := make([]collection.MintNFTParam, 3)
+	for i, color := range []string{"red", "red", "blue"} {
+		params[i] = collection.MintNFTParam{
+			TokenType: classID,
+			Name:      "fox",
+			Traits:    []collection.Attribute{{Key: "color", Value: color}},
+		}
+	}
+	tokens, err := s.keeper.MintNFT(ctx, contractID, s.customer, params)
+	s.Require().NoError(err)
+
+	// the index follows the modification and the burn
+	err = s.keeper.ModifyNFT(ctx, contractID, tokens[2].TokenId, s.vendor, []collection.Attribute{
+		{Key: collection.TraitChangeKeyPrefix + "color", Value: "red"},
+	})
+	s.Require().NoError(err)
+	_, err = s.keeper.BurnCoins(ctx, contractID, s.customer, []collection.Coin{collection.NewCoin(tokens[0].TokenId, sdk.OneInt())})
+	s.Require().NoError(err)
+
+	testCases := map[string]struct {
+		contractID string
+		key        string
+		value      string
+		valid      bool
+		postTest   func(res *collection.QueryNFTsByTraitResponse)
+	}{
+		"valid request": {
+			contractID: contractID,
+			key:        "color",
+			value:      "red",
+			valid:      true,
+			postTest: func(res *collection.QueryNFTsByTraitResponse) {
+				s.Require().Equal(2, len(res.Tokens))
+				s.Require().Equal(tokens[1].TokenId, res.Tokens[0].TokenId)
+				s.Require().Equal(tokens[2].TokenId, res.Tokens[1].TokenId)
+			},
+		},
+		"no such a value": {
+			contractID: contractID,
+			key:        "color",
+			value:      "blue",
+			valid:      true,
+			postTest: func(res *collection.QueryNFTsByTraitResponse) {
+				s.Require().Equal(0, len(res.Tokens))
+			},
+		},
+		"invalid contract id": {
+			key:   "color",
+			value: "red",
+		},
+		"invalid key": {
+			contractID: contractID,
+			key:        "Color",
+			value:      "red",
+		},
+		"empty value": {
+			contractID: contractID,
+			key:        "color",
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			req := &collection.QueryNFTsByTraitRequest{
+				ContractId: tc.contractID,
+				Key:        tc.key,
+				Value:      tc.value,
+			}
+			res, err := s.queryServer.NFTsByTrait(goCtx, req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
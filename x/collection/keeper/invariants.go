@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/collection"
+)
+
+const (
+	supplyInvariant = "supply"
+)
+
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	for name, invariant := range map[string]func(k Keeper) sdk.Invariant{
+		supplyInvariant: SupplyInvariant,
+	} {
+		ir.RegisterRoute(collection.ModuleName, name, invariant(k))
+	}
+}
+
+// SupplyInvariant checks that the supply of each token class equals the
+// tokens in existence, and the difference between the minted and the burnt.
+// The tokens in existence are the sum of the balances for fungible tokens,
+// and the number of the tokens for non-fungible tokens.
+func SupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		// cache, we don't want to write changes
+		ctx, _ = ctx.CacheContext()
+
+		msg := ""
+		broken := false
+
+		k.iterateContracts(ctx, func(contract collection.Contract) (stop bool) {
+			existing := map[string]sdk.Int{}
+			k.iterateContractBalances(ctx, contract.Id, func(_ sdk.AccAddress, balance collection.Coin) (stop bool) {
+				if err := collection.ValidateFTID(balance.TokenId); err != nil {
+					return false
+				}
+
+				classID := collection.SplitTokenID(balance.TokenId)
+				if amount, ok := existing[classID]; ok {
+					existing[classID] = amount.Add(balance.Amount)
+				} else {
+					existing[classID] = balance.Amount
+				}
+				return false
+			})
+			k.iterateContractNFTs(ctx, contract.Id, func(nft collection.NFT) (stop bool) {
+				classID := collection.SplitTokenID(nft.TokenId)
+				if amount, ok := existing[classID]; ok {
+					existing[classID] = amount.Add(sdk.OneInt())
+				} else {
+					existing[classID] = sdk.OneInt()
+				}
+				return false
+			})
+
+			k.iterateContractClasses(ctx, contract.Id, func(class collection.TokenClass) (stop bool) {
+				classID := class.GetId()
+				supply := k.GetSupply(ctx, contract.Id, classID)
+
+				amount, ok := existing[classID]
+				if !ok {
+					amount = sdk.ZeroInt()
+				}
+				if !amount.Equal(supply) {
+					msg += fmt.Sprintf("supply of %s in %s; expected %s, tokens in existence %s\n", classID, contract.Id, supply, amount)
+					broken = true
+				}
+
+				if issued := k.GetMinted(ctx, contract.Id, classID).Sub(k.GetBurnt(ctx, contract.Id, classID)); !issued.Equal(supply) {
+					msg += fmt.Sprintf("supply of %s in %s; expected %s, minted minus burnt %s\n", classID, contract.Id, supply, issued)
+					broken = true
+				}
+
+				return false
+			})
+
+			return false
+		})
+
+		return sdk.FormatInvariant(collection.ModuleName, supplyInvariant, msg), broken
+	}
+}
@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/collection"
+	"github.com/Finschia/finschia-sdk/x/collection/keeper"
+)
+
+func (s *KeeperTestSuite) TestSupplyInvariant() {
+	testCases := map[string]struct {
+		malleate func(ctx sdk.Context)
+		valid    bool
+	}{
+		"invariant not broken": {
+			valid: true,
+		},
+		"tokens moved and burnt": {
+			malleate: func(ctx sdk.Context) {
+				ft := collection.NewFTCoin(s.ftClassID, s.balance)
+				err := s.keeper.SendCoins(ctx, s.contractID, s.customer, s.stranger, collection.NewCoins(ft))
+				s.Require().NoError(err)
+
+				// burn the root of a chain, which burns its descendants also
+				nft := collection.NewNFTCoin(s.nftClassID, 1)
+				_, err = s.keeper.BurnCoins(ctx, s.contractID, s.customer, collection.NewCoins(nft))
+				s.Require().NoError(err)
+			},
+			valid: true,
+		},
+		"balances differ from the supply": {
+			malleate: func(ctx sdk.Context) {
+				s.keeper.InitGenesis(ctx, &collection.GenesisState{
+					Params: s.keeper.GetParams(ctx),
+					Balances: []collection.ContractBalances{{
+						ContractId: s.contractID,
+						Balances: []collection.Balance{{
+							Address: s.stranger.String(),
+							Amount:  collection.NewCoins(collection.NewFTCoin(s.ftClassID, s.balance)),
+						}},
+					}},
+				})
+			},
+		},
+		"nfts differ from the supply": {
+			malleate: func(ctx sdk.Context) {
+				s.keeper.InitGenesis(ctx, &collection.GenesisState{
+					Params: s.keeper.GetParams(ctx),
+					Nfts: []collection.ContractNFTs{{
+						ContractId: s.contractID,
+						Nfts: []collection.NFT{{
+							TokenId: collection.NewNFTID(s.nftClassID, s.numNFTs*3+1),
+							Name:    "arctic fox",
+						}},
+					}},
+				})
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			if tc.malleate != nil {
+				tc.malleate(ctx)
+			}
+
+			invariant := keeper.SupplyInvariant(s.keeper)
+			_, broken := invariant(ctx)
+			s.Require().Equal(!tc.valid, broken)
+		})
+	}
+}
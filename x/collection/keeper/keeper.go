@@ -12,6 +12,7 @@ import (
 // Keeper defines the collection module Keeper
 type Keeper struct {
 	classKeeper collection.ClassKeeper
+	bankKeeper  collection.BankKeeper
 
 	// The (unexposed) keys used to access the stores from the Context.
 	storeKey sdk.StoreKey
@@ -25,9 +26,11 @@ func NewKeeper(
 	cdc codec.Codec,
 	key sdk.StoreKey,
 	ck collection.ClassKeeper,
+	bk collection.BankKeeper,
 ) Keeper {
 	return Keeper{
 		classKeeper: ck,
+		bankKeeper:  bk,
 		storeKey:    key,
 		cdc:         cdc,
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/Finschia/finschia-sdk/crypto/keys/secp256k1"
 	"github.com/Finschia/finschia-sdk/simapp"
 	sdk "github.com/Finschia/finschia-sdk/types"
+	bankkeeper "github.com/Finschia/finschia-sdk/x/bank/keeper"
 	"github.com/Finschia/finschia-sdk/x/collection"
 	"github.com/Finschia/finschia-sdk/x/collection/keeper"
 )
@@ -26,6 +27,8 @@ type KeeperTestSuite struct {
 	queryServer collection.QueryServer
 	msgServer   collection.MsgServer
 
+	bankKeeper bankkeeper.Keeper
+
 	vendor   sdk.AccAddress
 	operator sdk.AccAddress
 	customer sdk.AccAddress
@@ -75,14 +78,16 @@ func (s *KeeperTestSuite) SetupTest() {
 	s.ctx = app.BaseApp.NewContext(checkTx, tmproto.Header{})
 	s.goCtx = sdk.WrapSDKContext(s.ctx)
 	s.keeper = app.CollectionKeeper
+	s.bankKeeper = app.BankKeeper
 
 	s.queryServer = keeper.NewQueryServer(s.keeper)
 	s.msgServer = keeper.NewMsgServer(s.keeper)
 
 	s.depthLimit = 4
 	s.keeper.SetParams(s.ctx, collection.Params{
-		DepthLimit: uint32(s.depthLimit),
-		WidthLimit: 4,
+		DepthLimit:   uint32(s.depthLimit),
+		WidthLimit:   4,
+		MaxBatchSize: 4,
 	})
 
 	addresses := []*sdk.AccAddress{
@@ -124,6 +129,13 @@ func (s *KeeperTestSuite) SetupTest() {
 	s.Require().NoError(err)
 	s.nftClassID = *nftClassID
 
+	// fund the accounts
+	for _, to := range []sdk.AccAddress{s.customer, s.operator, s.vendor, s.stranger} {
+		coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, s.balance))
+		err := simapp.FundAccount(app, s.ctx, to, coins)
+		s.Require().NoError(err)
+	}
+
 	// mint & burn fts
 	for _, to := range []sdk.AccAddress{s.customer, s.operator, s.vendor} {
 		tokenID := collection.NewFTID(s.ftClassID)
@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"encoding/binary"
+	"time"
+
 	sdk "github.com/Finschia/finschia-sdk/types"
 
 	"github.com/Finschia/finschia-sdk/x/collection"
@@ -19,6 +22,14 @@ var (
 	nftKeyPrefix     = []byte{0x22}
 	parentKeyPrefix  = []byte{0x23}
 	childKeyPrefix   = []byte{0x24}
+	lockKeyPrefix    = []byte{0x25}
+
+	// indexes of the balances
+	holderKeyPrefix   = []byte{0x26}
+	ownerNFTKeyPrefix = []byte{0x27}
+
+	// index of the nfts by trait
+	nftTraitKeyPrefix = []byte{0x28}
 
 	authorizationKeyPrefix = []byte{0x30}
 	grantKeyPrefix         = []byte{0x31}
@@ -27,6 +38,13 @@ var (
 	mintedKeyPrefix = []byte{0x41}
 	burntKeyPrefix  = []byte{0x42}
 
+	nftHistoryContractKeyPrefix = []byte{0x50}
+	nftHistoryKeyPrefix         = []byte{0x51}
+	nftHistorySequenceKey       = []byte{0x52}
+	nftHistoryQueueKeyPrefix    = []byte{0x53}
+
+	adminHistoryKeyPrefix = []byte{0x60}
+
 	legacyTokenKeyPrefix     = []byte{0xf0}
 	legacyTokenTypeKeyPrefix = []byte{0xf1}
 )
@@ -87,6 +105,218 @@ func splitBalanceKey(key []byte) (contractID string, address sdk.AccAddress, tok
 	return
 }
 
+// ----------------------------------------------------------------------------
+// lock
+func lockKey(contractID string, address sdk.AccAddress, tokenID string, schedule collection.VestingSchedule) []byte {
+	prefix := lockKeyPrefixByTokenID(contractID, address, tokenID)
+	startTime := sdk.FormatTimeBytes(schedule.StartTime)
+	endTime := sdk.FormatTimeBytes(schedule.EndTime)
+	key := make([]byte, len(prefix)+len(startTime)+len(endTime))
+
+	begin := 0
+	copy(key, prefix)
+
+	begin += len(prefix)
+	copy(key[begin:], startTime)
+
+	begin += len(startTime)
+	copy(key[begin:], endTime)
+
+	return key
+}
+
+func lockKeyPrefixByTokenID(contractID string, address sdk.AccAddress, tokenID string) []byte {
+	prefix := lockKeyPrefixByContractID(contractID)
+	key := make([]byte, len(prefix)+1+len(address)+1+len(tokenID))
+
+	begin := 0
+	copy(key, prefix)
+
+	begin += len(prefix)
+	key[begin] = byte(len(address))
+
+	begin++
+	copy(key[begin:], address)
+
+	begin += len(address)
+	key[begin] = byte(len(tokenID))
+
+	begin++
+	copy(key[begin:], tokenID)
+
+	return key
+}
+
+func lockKeyPrefixByContractID(contractID string) []byte {
+	key := make([]byte, len(lockKeyPrefix)+1+len(contractID))
+
+	begin := 0
+	copy(key, lockKeyPrefix)
+
+	begin += len(lockKeyPrefix)
+	key[begin] = byte(len(contractID))
+
+	begin++
+	copy(key[begin:], contractID)
+
+	return key
+}
+
+func splitLockKey(key []byte) (contractID string, address sdk.AccAddress, tokenID string, schedule collection.VestingSchedule) {
+	begin := len(lockKeyPrefix) + 1
+	end := begin + int(key[begin-1])
+	contractID = string(key[begin:end])
+
+	begin = end + 1
+	end = begin + int(key[begin-1])
+	address = sdk.AccAddress(key[begin:end])
+
+	begin = end + 1
+	end = begin + int(key[begin-1])
+	tokenID = string(key[begin:end])
+
+	// both of the timestamps have the same length
+	begin = end
+	end = begin + (len(key)-begin)/2
+	startTime, err := sdk.ParseTimeBytes(key[begin:end])
+	if err != nil {
+		panic(err)
+	}
+
+	begin = end
+	endTime, err := sdk.ParseTimeBytes(key[begin:])
+	if err != nil {
+		panic(err)
+	}
+
+	schedule = collection.VestingSchedule{
+		StartTime: startTime,
+		EndTime:   endTime,
+	}
+
+	return
+}
+
+// ----------------------------------------------------------------------------
+// holder (index of the fungible token balances by token)
+func holderKey(contractID string, tokenID string, address sdk.AccAddress) []byte {
+	prefix := holderKeyPrefixByTokenID(contractID, tokenID)
+	key := make([]byte, len(prefix)+len(address))
+
+	copy(key, prefix)
+	copy(key[len(prefix):], address)
+
+	return key
+}
+
+func holderKeyPrefixByTokenID(contractID string, tokenID string) []byte {
+	key := make([]byte, len(holderKeyPrefix)+1+len(contractID)+1+len(tokenID))
+
+	begin := 0
+	copy(key, holderKeyPrefix)
+
+	begin += len(holderKeyPrefix)
+	key[begin] = byte(len(contractID))
+
+	begin++
+	copy(key[begin:], contractID)
+
+	begin += len(contractID)
+	key[begin] = byte(len(tokenID))
+
+	begin++
+	copy(key[begin:], tokenID)
+
+	return key
+}
+
+// ----------------------------------------------------------------------------
+// owner nft (index of the non-fungible token balances by owner)
+func ownerNFTKey(owner sdk.AccAddress, contractID string, tokenID string) []byte {
+	prefix := ownerNFTKeyPrefixByOwner(owner)
+	key := make([]byte, len(prefix)+1+len(contractID)+len(tokenID))
+
+	begin := 0
+	copy(key, prefix)
+
+	begin += len(prefix)
+	key[begin] = byte(len(contractID))
+
+	begin++
+	copy(key[begin:], contractID)
+
+	begin += len(contractID)
+	copy(key[begin:], tokenID)
+
+	return key
+}
+
+func ownerNFTKeyPrefixByOwner(owner sdk.AccAddress) []byte {
+	key := make([]byte, len(ownerNFTKeyPrefix)+1+len(owner))
+
+	begin := 0
+	copy(key, ownerNFTKeyPrefix)
+
+	begin += len(ownerNFTKeyPrefix)
+	key[begin] = byte(len(owner))
+
+	begin++
+	copy(key[begin:], owner)
+
+	return key
+}
+
+// splitOwnerNFTKeyTail splits the key in the store prefixed by ownerNFTKeyPrefixByOwner.
+func splitOwnerNFTKeyTail(key []byte) (contractID string, tokenID string) {
+	begin := 1
+	end := begin + int(key[begin-1])
+	contractID = string(key[begin:end])
+
+	begin = end
+	tokenID = string(key[begin:])
+
+	return
+}
+
+// ----------------------------------------------------------------------------
+// nft trait (index of the non-fungible tokens by trait)
+func nftTraitKey(contractID string, trait collection.Attribute, tokenID string) []byte {
+	prefix := nftTraitKeyPrefixByValue(contractID, trait)
+	key := make([]byte, len(prefix)+len(tokenID))
+
+	copy(key, prefix)
+	copy(key[len(prefix):], tokenID)
+
+	return key
+}
+
+func nftTraitKeyPrefixByValue(contractID string, trait collection.Attribute) []byte {
+	key := make([]byte, len(nftTraitKeyPrefix)+1+len(contractID)+1+len(trait.Key)+1+len(trait.Value))
+
+	begin := 0
+	copy(key, nftTraitKeyPrefix)
+
+	begin += len(nftTraitKeyPrefix)
+	key[begin] = byte(len(contractID))
+
+	begin++
+	copy(key[begin:], contractID)
+
+	begin += len(contractID)
+	key[begin] = byte(len(trait.Key))
+
+	begin++
+	copy(key[begin:], trait.Key)
+
+	begin += len(trait.Key)
+	key[begin] = byte(len(trait.Value))
+
+	begin++
+	copy(key[begin:], trait.Value)
+
+	return key
+}
+
 // ----------------------------------------------------------------------------
 // owner
 func ownerKey(contractID string, tokenID string) []byte {
@@ -480,6 +710,111 @@ func splitStatisticKey(keyPrefix, key []byte) (contractID string, classID string
 	return
 }
 
+// ----------------------------------------------------------------------------
+// nft history
+func nftHistoryContractKey(contractID string) []byte {
+	key := make([]byte, len(nftHistoryContractKeyPrefix)+len(contractID))
+
+	copy(key, nftHistoryContractKeyPrefix)
+	copy(key[len(nftHistoryContractKeyPrefix):], contractID)
+
+	return key
+}
+
+func splitNFTHistoryContractKey(key []byte) (contractID string) {
+	return string(key[len(nftHistoryContractKeyPrefix):])
+}
+
+func nftHistoryKey(contractID string, tokenID string, sequence uint64) []byte {
+	prefix := nftHistoryKeyPrefixByTokenID(contractID, tokenID)
+	key := make([]byte, len(prefix)+8)
+
+	copy(key, prefix)
+	binary.BigEndian.PutUint64(key[len(prefix):], sequence)
+
+	return key
+}
+
+func nftHistoryKeyPrefixByTokenID(contractID string, tokenID string) []byte {
+	prefix := nftHistoryKeyPrefixByContractID(contractID)
+	key := make([]byte, len(prefix)+1+len(tokenID))
+
+	begin := 0
+	copy(key, prefix)
+
+	begin += len(prefix)
+	key[begin] = byte(len(tokenID))
+
+	begin++
+	copy(key[begin:], tokenID)
+
+	return key
+}
+
+func nftHistoryKeyPrefixByContractID(contractID string) []byte {
+	key := make([]byte, len(nftHistoryKeyPrefix)+1+len(contractID))
+
+	begin := 0
+	copy(key, nftHistoryKeyPrefix)
+
+	begin += len(nftHistoryKeyPrefix)
+	key[begin] = byte(len(contractID))
+
+	begin++
+	copy(key[begin:], contractID)
+
+	return key
+}
+
+// nftHistoryQueueKey returns the key of the queue ordering the history entries by their time,
+// which is used to prune the entries.
+func nftHistoryQueueKey(recorded time.Time, sequence uint64) []byte {
+	prefix := nftHistoryQueueKeyPrefixByTime(recorded)
+	key := make([]byte, len(prefix)+8)
+
+	copy(key, prefix)
+	binary.BigEndian.PutUint64(key[len(prefix):], sequence)
+
+	return key
+}
+
+func nftHistoryQueueKeyPrefixByTime(recorded time.Time) []byte {
+	timeBytes := sdk.FormatTimeBytes(recorded)
+	key := make([]byte, len(nftHistoryQueueKeyPrefix)+len(timeBytes))
+
+	copy(key, nftHistoryQueueKeyPrefix)
+	copy(key[len(nftHistoryQueueKeyPrefix):], timeBytes)
+
+	return key
+}
+
+// ----------------------------------------------------------------------------
+// admin history
+func adminHistoryKey(contractID string, sequence uint64) []byte {
+	prefix := adminHistoryKeyPrefixByContractID(contractID)
+	key := make([]byte, len(prefix)+8)
+
+	copy(key, prefix)
+	binary.BigEndian.PutUint64(key[len(prefix):], sequence)
+
+	return key
+}
+
+func adminHistoryKeyPrefixByContractID(contractID string) []byte {
+	key := make([]byte, len(adminHistoryKeyPrefix)+1+len(contractID))
+
+	begin := 0
+	copy(key, adminHistoryKeyPrefix)
+
+	begin += len(adminHistoryKeyPrefix)
+	key[begin] = byte(len(contractID))
+
+	begin++
+	copy(key[begin:], contractID)
+
+	return key
+}
+
 // ----------------------------------------------------------------------------
 // legacy keys
 func legacyTokenKey(contractID string, tokenID string) []byte {
@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"github.com/Finschia/finschia-sdk/internal/vesting"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/collection"
+)
+
+// LockCoins locks the tokens of the holder, which would be unlocked on the schedule.
+// It fails if the holder has vesting.MaxLocks locks of other schedules on any of the tokens already.
+func (k Keeper) LockCoins(ctx sdk.Context, contractID string, address sdk.AccAddress, amount []collection.Coin, schedule collection.VestingSchedule) error {
+	locks := make([]collection.Lock, 0, len(amount))
+	for _, coin := range amount {
+		locked := k.getLock(ctx, contractID, address, coin.TokenId, schedule)
+		if locked.IsZero() {
+			k.pruneLocks(ctx, contractID, address, coin.TokenId)
+			if len(k.GetLocks(ctx, contractID, address, coin.TokenId)) >= vesting.MaxLocks {
+				return collection.ErrTooManyLocks.Wrapf("%s has %d locks of %s already", address, vesting.MaxLocks, coin.TokenId)
+			}
+		}
+		k.setLock(ctx, contractID, address, coin.TokenId, schedule, locked.Add(coin.Amount))
+
+		locks = append(locks, collection.Lock{
+			Address:  address.String(),
+			TokenId:  coin.TokenId,
+			Amount:   coin.Amount,
+			Schedule: schedule,
+		})
+	}
+
+	event := collection.EventLocked{
+		ContractId: contractID,
+		Locks:      locks,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+
+	return nil
+}
+
+// GetLocked returns the amount of the locked tokens of the holder at the current block time.
+func (k Keeper) GetLocked(ctx sdk.Context, contractID string, address sdk.AccAddress, tokenID string) sdk.Int {
+	locked := sdk.ZeroInt()
+	k.iterateLocksImpl(ctx, lockKeyPrefixByTokenID(contractID, address, tokenID), func(_ string, lock collection.Lock) (stop bool) {
+		locked = locked.Add(lock.Schedule.LockedAmount(lock.Amount, ctx.BlockTime()))
+		return false
+	})
+
+	return locked
+}
+
+// GetSpendable returns the amount of the tokens of the holder, which are not locked.
+func (k Keeper) GetSpendable(ctx sdk.Context, contractID string, address sdk.AccAddress, tokenID string) sdk.Int {
+	spendable := k.GetBalance(ctx, contractID, address, tokenID).Sub(k.GetLocked(ctx, contractID, address, tokenID))
+	if spendable.IsNegative() {
+		return sdk.ZeroInt()
+	}
+
+	return spendable
+}
+
+// GetLocks returns the locks of the holder.
+func (k Keeper) GetLocks(ctx sdk.Context, contractID string, address sdk.AccAddress, tokenID string) []collection.Lock {
+	var locks []collection.Lock
+	k.iterateLocksImpl(ctx, lockKeyPrefixByTokenID(contractID, address, tokenID), func(_ string, lock collection.Lock) (stop bool) {
+		locks = append(locks, lock)
+		return false
+	})
+
+	return locks
+}
+
+// pruneLocks deletes the locks of the holder, which have been unlocked entirely.
+func (k Keeper) pruneLocks(ctx sdk.Context, contractID string, address sdk.AccAddress, tokenID string) {
+	for _, lock := range k.GetLocks(ctx, contractID, address, tokenID) {
+		if lock.Schedule.LockedAmount(lock.Amount, ctx.BlockTime()).IsZero() {
+			k.setLock(ctx, contractID, address, tokenID, lock.Schedule, sdk.ZeroInt())
+		}
+	}
+}
+
+// validateSpendable checks whether the holder can spend the amount.
+func (k Keeper) validateSpendable(ctx sdk.Context, contractID string, address sdk.AccAddress, amount []collection.Coin) error {
+	for _, coin := range amount {
+		k.pruneLocks(ctx, contractID, address, coin.TokenId)
+		if spendable := k.GetSpendable(ctx, contractID, address, coin.TokenId); spendable.LT(coin.Amount) {
+			return collection.ErrInsufficientToken.Wrapf("spendable balance %s is smaller than %s", spendable, coin.Amount)
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) getLock(ctx sdk.Context, contractID string, address sdk.AccAddress, tokenID string, schedule collection.VestingSchedule) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	key := lockKey(contractID, address, tokenID, schedule)
+	bz := store.Get(key)
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	var amount sdk.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return amount
+}
+
+func (k Keeper) setLock(ctx sdk.Context, contractID string, address sdk.AccAddress, tokenID string, schedule collection.VestingSchedule, amount sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	key := lockKey(contractID, address, tokenID, schedule)
+
+	if amount.IsZero() {
+		store.Delete(key)
+	} else {
+		bz, err := amount.Marshal()
+		if err != nil {
+			panic(err)
+		}
+		store.Set(key, bz)
+	}
+}
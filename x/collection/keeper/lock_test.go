@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/Finschia/finschia-sdk/internal/vesting"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/collection"
+)
+
+func (s *KeeperTestSuite) TestLockCoins() {
+	schedule := collection.VestingSchedule{
+		StartTime: s.ctx.BlockTime().Add(time.Hour),
+		EndTime:   s.ctx.BlockTime().Add(5 * time.Hour),
+	}
+	tokenID := collection.NewFTID(s.ftClassID)
+
+	testCases := map[string]struct {
+		after  time.Duration
+		locked sdk.Int
+	}{
+		"before the start time": {
+			locked: s.balance,
+		},
+		"at the start time": {
+			after:  time.Hour,
+			locked: s.balance,
+		},
+		"in the middle": {
+			after:  2 * time.Hour,
+			locked: s.balance.QuoRaw(4).MulRaw(3),
+		},
+		"at the end time": {
+			after:  5 * time.Hour,
+			locked: sdk.ZeroInt(),
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			amount := collection.NewCoins(collection.NewCoin(tokenID, s.balance))
+			s.Require().NoError(s.keeper.LockCoins(ctx, s.contractID, s.customer, amount, schedule))
+			s.Require().Len(s.keeper.GetLocks(ctx, s.contractID, s.customer, tokenID), 1)
+
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(tc.after))
+			locked := s.keeper.GetLocked(ctx, s.contractID, s.customer, tokenID)
+			s.Require().Equal(tc.locked, locked)
+
+			spendable := s.keeper.GetSpendable(ctx, s.contractID, s.customer, tokenID)
+			s.Require().Equal(s.balance.Sub(tc.locked), spendable)
+
+			// cannot send the locked tokens
+			err := s.keeper.SendCoins(ctx, s.contractID, s.customer, s.stranger, amount)
+			if tc.locked.IsPositive() {
+				s.Require().ErrorIs(err, collection.ErrInsufficientToken)
+				return
+			}
+			s.Require().NoError(err)
+
+			// the lock has been pruned
+			s.Require().Empty(s.keeper.GetLocks(ctx, s.contractID, s.customer, tokenID))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestLockNFT() {
+	ctx, _ := s.ctx.CacheContext()
+
+	endTime := ctx.BlockTime().Add(time.Hour)
+	schedule := collection.VestingSchedule{
+		StartTime: endTime,
+		EndTime:   endTime,
+	}
+	subject := collection.NewNFTID(s.nftClassID, s.depthLimit+1)
+	target := collection.NewNFTID(s.nftClassID, s.depthLimit)
+	amount := collection.NewCoins(collection.NewCoin(subject, sdk.OneInt()))
+	s.Require().NoError(s.keeper.LockCoins(ctx, s.contractID, s.customer, amount, schedule))
+
+	// cannot send the locked token
+	err := s.keeper.SendCoins(ctx, s.contractID, s.customer, s.stranger, amount)
+	s.Require().ErrorIs(err, collection.ErrInsufficientToken)
+
+	// cannot attach the locked token
+	err = s.keeper.Attach(ctx, s.contractID, s.customer, subject, target)
+	s.Require().ErrorIs(err, collection.ErrInsufficientToken)
+
+	// unlocked
+	ctx = ctx.WithBlockTime(endTime)
+	err = s.keeper.Attach(ctx, s.contractID, s.customer, subject, target)
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestMaxLocks() {
+	ctx, _ := s.ctx.CacheContext()
+
+	scheduleAt := func(i int) collection.VestingSchedule {
+		endTime := ctx.BlockTime().Add(time.Duration(i+1) * time.Hour)
+		return collection.VestingSchedule{
+			StartTime: endTime,
+			EndTime:   endTime,
+		}
+	}
+	tokenID := collection.NewFTID(s.ftClassID)
+	amount := collection.NewCoins(collection.NewCoin(tokenID, sdk.OneInt()))
+	for i := 0; i < vesting.MaxLocks; i++ {
+		s.Require().NoError(s.keeper.LockCoins(ctx, s.contractID, s.customer, amount, scheduleAt(i)))
+	}
+
+	// the locks of the existing schedules are merged
+	s.Require().NoError(s.keeper.LockCoins(ctx, s.contractID, s.customer, amount, scheduleAt(0)))
+
+	// a new schedule is rejected
+	err := s.keeper.LockCoins(ctx, s.contractID, s.customer, amount, scheduleAt(vesting.MaxLocks))
+	s.Require().ErrorIs(err, collection.ErrTooManyLocks)
+
+	// the unlocked ones do not count
+	ctx = ctx.WithBlockTime(scheduleAt(0).EndTime)
+	s.Require().NoError(s.keeper.LockCoins(ctx, s.contractID, s.customer, amount, scheduleAt(vesting.MaxLocks)))
+	s.Require().Len(s.keeper.GetLocks(ctx, s.contractID, s.customer, tokenID), vesting.MaxLocks)
+}
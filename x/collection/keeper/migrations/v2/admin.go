@@ -0,0 +1,51 @@
+package v2
+
+import (
+	internaladmin "github.com/Finschia/finschia-sdk/internal/admin"
+	storetypes "github.com/Finschia/finschia-sdk/store/types"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/collection"
+)
+
+// setAdmins makes the sole grantee of the permission to modify the admin of each contract created before the admin was introduced,
+// recording it into the admin history. The contracts with more than one of them are left without admin.
+func setAdmins(ctx sdk.Context, store storetypes.KVStore) error {
+	for _, grantee := range internaladmin.SoleGrantees(store, grantKeyPrefix, permissionModify) {
+		key := contractKey(grantee.ContractID)
+		bz := store.Get(key)
+		if bz == nil {
+			continue
+		}
+
+		var contract collection.Contract
+		if err := contract.Unmarshal(bz); err != nil {
+			return err
+		}
+		if len(contract.Admin) != 0 {
+			continue
+		}
+
+		entry := collection.AdminHistoryEntry{
+			Admin:  grantee.Address.String(),
+			Height: ctx.BlockHeight(),
+			Time:   ctx.BlockTime(),
+		}
+		entryBz, err := entry.Marshal()
+		if err != nil {
+			return err
+		}
+		historyPrefix := adminHistoryKeyPrefixByContractID(contract.Id)
+		sequence := internaladmin.NextHistorySequence(store, historyPrefix)
+		store.Set(adminHistoryKey(historyPrefix, sequence), entryBz)
+
+		contract.Admin = grantee.Address.String()
+		contract.PendingAdmin = ""
+		contractBz, err := contract.Marshal()
+		if err != nil {
+			return err
+		}
+		store.Set(key, contractBz)
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/crypto/keys/secp256k1"
+	"github.com/Finschia/finschia-sdk/testutil"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/collection"
+
+	"github.com/Finschia/finschia-sdk/x/collection/keeper/migrations/v2"
+)
+
+func TestMigrateStoreAdmins(t *testing.T) {
+	collectionKey := sdk.NewKVStoreKey(collection.StoreKey)
+	newKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(collectionKey, newKey)
+
+	addrs := make([]sdk.AccAddress, 3)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	contractKey := func(contractID string) []byte {
+		return append([]byte{0x10}, contractID...)
+	}
+	grantKey := func(contractID string, grantee sdk.AccAddress, permission collection.Permission) []byte {
+		key := []byte{0x31, byte(len(contractID))}
+		key = append(key, contractID...)
+		key = append(key, byte(len(grantee)))
+		key = append(key, grantee...)
+		return append(key, byte(permission))
+	}
+	adminHistoryKey := func(contractID string, sequence byte) []byte {
+		key := []byte{0x60, byte(len(contractID))}
+		key = append(key, contractID...)
+		return append(key, 0, 0, 0, 0, 0, 0, 0, sequence)
+	}
+
+	// the admins of the contracts, after the migration
+	admins := map[string]sdk.AccAddress{
+		"deadbeef": addrs[0], // sole grantee of the permission to modify
+		"fee1dead": nil,      // several grantees of the permission to modify
+		"00bab10c": addrs[2], // admin already
+	}
+
+	store := ctx.KVStore(collectionKey)
+	for contractID := range admins {
+		contract := collection.Contract{
+			Id:   contractID,
+			Name: "test",
+		}
+		if contractID == "00bab10c" {
+			contract.Admin = addrs[2].String()
+		}
+		bz, err := contract.Marshal()
+		require.NoError(t, err)
+		store.Set(contractKey(contractID), bz)
+	}
+	store.Set(grantKey("deadbeef", addrs[0], collection.PermissionModify), []byte{})
+	store.Set(grantKey("deadbeef", addrs[1], collection.PermissionMint), []byte{})
+	store.Set(grantKey("fee1dead", addrs[0], collection.PermissionModify), []byte{})
+	store.Set(grantKey("fee1dead", addrs[1], collection.PermissionModify), []byte{})
+	store.Set(grantKey("00bab10c", addrs[1], collection.PermissionModify), []byte{})
+
+	// migrate
+	err := v2.MigrateStore(ctx, collectionKey)
+	require.NoError(t, err)
+
+	for contractID, admin := range admins {
+		var contract collection.Contract
+		err := contract.Unmarshal(store.Get(contractKey(contractID)))
+		require.NoError(t, err, contractID)
+
+		if admin == nil {
+			require.Empty(t, contract.Admin, contractID)
+			continue
+		}
+		require.Equal(t, admin.String(), contract.Admin, contractID)
+	}
+
+	// the migrated admin has been recorded into the history
+	var entry collection.AdminHistoryEntry
+	err = entry.Unmarshal(store.Get(adminHistoryKey("deadbeef", 0)))
+	require.NoError(t, err)
+	require.Empty(t, entry.PreviousAdmin)
+	require.Equal(t, addrs[0].String(), entry.Admin)
+	require.Equal(t, ctx.BlockHeight(), entry.Height)
+
+	require.False(t, store.Has(adminHistoryKey("fee1dead", 0)))
+	require.False(t, store.Has(adminHistoryKey("00bab10c", 0)))
+}
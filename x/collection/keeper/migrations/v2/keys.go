@@ -0,0 +1,121 @@
+package v2
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+)
+
+// the value of collection.PermissionModify
+const permissionModify = 2
+
+var (
+	paramsKey = []byte{0x00}
+
+	contractKeyPrefix = []byte{0x10}
+
+	balanceKeyPrefix = []byte{0x20}
+
+	holderKeyPrefix   = []byte{0x26}
+	ownerNFTKeyPrefix = []byte{0x27}
+
+	grantKeyPrefix = []byte{0x31}
+
+	adminHistoryKeyPrefix = []byte{0x60}
+)
+
+func splitBalanceKey(key []byte) (contractID string, address sdk.AccAddress, tokenID string) {
+	begin := len(balanceKeyPrefix) + 1
+	end := begin + int(key[begin-1])
+	contractID = string(key[begin:end])
+
+	begin = end + 1
+	end = begin + int(key[begin-1])
+	address = sdk.AccAddress(key[begin:end])
+
+	begin = end
+	tokenID = string(key[begin:])
+
+	return
+}
+
+func contractKey(contractID string) []byte {
+	key := make([]byte, len(contractKeyPrefix)+len(contractID))
+
+	copy(key, contractKeyPrefix)
+	copy(key[len(contractKeyPrefix):], contractID)
+
+	return key
+}
+
+func holderKey(contractID string, tokenID string, address sdk.AccAddress) []byte {
+	key := make([]byte, len(holderKeyPrefix)+1+len(contractID)+1+len(tokenID)+len(address))
+
+	begin := 0
+	copy(key, holderKeyPrefix)
+
+	begin += len(holderKeyPrefix)
+	key[begin] = byte(len(contractID))
+
+	begin++
+	copy(key[begin:], contractID)
+
+	begin += len(contractID)
+	key[begin] = byte(len(tokenID))
+
+	begin++
+	copy(key[begin:], tokenID)
+
+	begin += len(tokenID)
+	copy(key[begin:], address)
+
+	return key
+}
+
+func ownerNFTKey(owner sdk.AccAddress, contractID string, tokenID string) []byte {
+	key := make([]byte, len(ownerNFTKeyPrefix)+1+len(owner)+1+len(contractID)+len(tokenID))
+
+	begin := 0
+	copy(key, ownerNFTKeyPrefix)
+
+	begin += len(ownerNFTKeyPrefix)
+	key[begin] = byte(len(owner))
+
+	begin++
+	copy(key[begin:], owner)
+
+	begin += len(owner)
+	key[begin] = byte(len(contractID))
+
+	begin++
+	copy(key[begin:], contractID)
+
+	begin += len(contractID)
+	copy(key[begin:], tokenID)
+
+	return key
+}
+
+func adminHistoryKey(prefix []byte, sequence uint64) []byte {
+	key := make([]byte, len(prefix)+8)
+
+	copy(key, prefix)
+	binary.BigEndian.PutUint64(key[len(prefix):], sequence)
+
+	return key
+}
+
+func adminHistoryKeyPrefixByContractID(contractID string) []byte {
+	key := make([]byte, len(adminHistoryKeyPrefix)+1+len(contractID))
+
+	begin := 0
+	copy(key, adminHistoryKeyPrefix)
+
+	begin += len(adminHistoryKeyPrefix)
+	key[begin] = byte(len(contractID))
+
+	begin++
+	copy(key[begin:], contractID)
+
+	return key
+}
@@ -0,0 +1,28 @@
+package v2
+
+import (
+	storetypes "github.com/Finschia/finschia-sdk/store/types"
+	"github.com/Finschia/finschia-sdk/x/collection"
+)
+
+// setMaxBatchSize sets the max batch size of the batch transfers in the params, which v1 does not have.
+func setMaxBatchSize(store storetypes.KVStore) error {
+	var params collection.Params
+	if bz := store.Get(paramsKey); bz != nil {
+		if err := params.Unmarshal(bz); err != nil {
+			return err
+		}
+	}
+	if params.MaxBatchSize != 0 {
+		return nil
+	}
+
+	params.MaxBatchSize = collection.DefaultMaxBatchSize
+	bz, err := params.Marshal()
+	if err != nil {
+		return err
+	}
+	store.Set(paramsKey, bz)
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/testutil"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/collection"
+
+	"github.com/Finschia/finschia-sdk/x/collection/keeper/migrations/v2"
+)
+
+func TestMigrateStoreParams(t *testing.T) {
+	collectionKey := sdk.NewKVStoreKey(collection.StoreKey)
+	newKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(collectionKey, newKey)
+
+	// the params of v1 have no max batch size
+	store := ctx.KVStore(collectionKey)
+	paramsKey := []byte{0x00}
+	v1Params := collection.Params{
+		DepthLimit: 2,
+		WidthLimit: 3,
+	}
+	bz, err := v1Params.Marshal()
+	require.NoError(t, err)
+	store.Set(paramsKey, bz)
+
+	// migrate
+	err = v2.MigrateStore(ctx, collectionKey)
+	require.NoError(t, err)
+
+	var params collection.Params
+	require.NoError(t, params.Unmarshal(store.Get(paramsKey)))
+	expected := v1Params
+	expected.MaxBatchSize = collection.DefaultMaxBatchSize
+	require.Equal(t, expected, params)
+}
@@ -0,0 +1,45 @@
+package v2
+
+import (
+	storetypes "github.com/Finschia/finschia-sdk/store/types"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/collection"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2, which covers the state introduced since v1:
+//   - the indexes of the balances, used by the enumeration queries (see buildBalanceIndexes)
+//   - the max batch size of the params (see setMaxBatchSize)
+//   - the admins of the contracts (see setAdmins)
+//
+// Each step is independent of the others, so that it could be run alone.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+
+	buildBalanceIndexes(store)
+
+	if err := setMaxBatchSize(store); err != nil {
+		return err
+	}
+
+	return setAdmins(ctx, store)
+}
+
+func buildBalanceIndexes(store storetypes.KVStore) {
+	iterator := sdk.KVStorePrefixIterator(store, balanceKeyPrefix)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		contractID, address, tokenID := splitBalanceKey(iterator.Key())
+
+		if err := collection.ValidateNFTID(tokenID); err == nil {
+			keys = append(keys, ownerNFTKey(address, contractID, tokenID))
+		} else {
+			keys = append(keys, holderKey(contractID, tokenID, address))
+		}
+	}
+
+	for _, key := range keys {
+		store.Set(key, []byte{})
+	}
+}
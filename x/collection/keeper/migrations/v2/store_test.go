@@ -0,0 +1,76 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/crypto/keys/secp256k1"
+	"github.com/Finschia/finschia-sdk/testutil"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/collection"
+
+	"github.com/Finschia/finschia-sdk/x/collection/keeper/migrations/v2"
+)
+
+func TestMigrateStore(t *testing.T) {
+	collectionKey := sdk.NewKVStoreKey(collection.StoreKey)
+	newKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(collectionKey, newKey)
+
+	addrs := make([]sdk.AccAddress, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	contractID := "deadbeef"
+	ftID := collection.NewFTID("00bab10c")
+	nftID := collection.NewNFTID("fee1dead", 1)
+
+	balanceKey := func(address sdk.AccAddress, tokenID string) []byte {
+		key := []byte{0x20, byte(len(contractID))}
+		key = append(key, contractID...)
+		key = append(key, byte(len(address)))
+		key = append(key, address...)
+		return append(key, tokenID...)
+	}
+
+	// set the balances without their indexes
+	store := ctx.KVStore(collectionKey)
+	bz, err := sdk.OneInt().Marshal()
+	require.NoError(t, err)
+	for _, addr := range addrs {
+		store.Set(balanceKey(addr, ftID), bz)
+	}
+	store.Set(balanceKey(addrs[0], nftID), bz)
+
+	// migrate
+	err = v2.MigrateStore(ctx, collectionKey)
+	require.NoError(t, err)
+
+	// fungible token holders
+	for _, addr := range addrs {
+		key := []byte{0x26, byte(len(contractID))}
+		key = append(key, contractID...)
+		key = append(key, byte(len(ftID)))
+		key = append(key, ftID...)
+		key = append(key, addr...)
+		require.True(t, store.Has(key))
+	}
+
+	// non-fungible token owners
+	key := []byte{0x27, byte(len(addrs[0]))}
+	key = append(key, addrs[0]...)
+	key = append(key, byte(len(contractID)))
+	key = append(key, contractID...)
+	key = append(key, nftID...)
+	require.True(t, store.Has(key))
+
+	iterator := sdk.KVStorePrefixIterator(store, []byte{0x27})
+	defer iterator.Close()
+	owned := 0
+	for ; iterator.Valid(); iterator.Next() {
+		owned++
+	}
+	require.Equal(t, 1, owned)
+}
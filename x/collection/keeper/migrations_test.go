@@ -0,0 +1,49 @@
+package keeper_test
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/module"
+	"github.com/Finschia/finschia-sdk/x/collection"
+	"github.com/Finschia/finschia-sdk/x/collection/keeper"
+)
+
+func (s *KeeperTestSuite) TestMigrateFromV1() {
+	ctx, _ := s.ctx.CacheContext()
+
+	// v1 has no max batch size
+	params := s.keeper.GetParams(ctx)
+	params.MaxBatchSize = 0
+	s.keeper.SetParams(ctx, params)
+
+	// migrate
+	var migrations []module.MigrationHandler
+	err := keeper.NewMigrator(s.keeper).Register(func(moduleName string, fromVersion uint64, handler module.MigrationHandler) error {
+		s.Require().Equal(collection.ModuleName, moduleName)
+		s.Require().Equal(uint64(1), fromVersion)
+		migrations = append(migrations, handler)
+		return nil
+	})
+	s.Require().NoError(err)
+	s.Require().Len(migrations, 1)
+	s.Require().NoError(migrations[0](ctx))
+
+	migrated := s.keeper.GetParams(ctx)
+	s.Require().Equal(uint32(collection.DefaultMaxBatchSize), migrated.MaxBatchSize)
+	params.MaxBatchSize = migrated.MaxBatchSize
+	s.Require().Equal(params, migrated)
+
+	// the batch transfers work
+	req := &collection.MsgMultiSendFT{
+		ContractId: s.contractID,
+		From:       s.vendor.String(),
+		Outputs: []collection.FTOutput{{
+			To:     s.stranger.String(),
+			Amount: collection.NewCoins(collection.NewFTCoin(s.ftClassID, sdk.OneInt())),
+		}},
+	}
+	_, err = s.msgServer.MultiSendFT(sdk.WrapSDKContext(ctx), req)
+	s.Require().NoError(err)
+
+	// and so does the export
+	s.Require().NoError(collection.ValidateGenesis(*s.keeper.ExportGenesis(ctx)))
+}
@@ -181,6 +181,109 @@ func (s msgServer) OperatorSendNFT(c context.Context, req *collection.MsgOperato
 	return &collection.MsgOperatorSendNFTResponse{}, nil
 }
 
+func (s msgServer) MultiSendFT(c context.Context, req *collection.MsgMultiSendFT) (*collection.MsgMultiSendFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	if err := collection.ValidateBatchSize(s.keeper.GetParams(ctx), len(req.Outputs)); err != nil {
+		return nil, err
+	}
+
+	fromAddr := sdk.MustAccAddressFromBech32(req.From)
+
+	for _, output := range req.Outputs {
+		toAddr := sdk.MustAccAddressFromBech32(output.To)
+
+		if err := s.keeper.SendCoins(ctx, req.ContractId, fromAddr, toAddr, output.Amount); err != nil {
+			return nil, err
+		}
+
+		event := collection.EventSent{
+			ContractId: req.ContractId,
+			Operator:   req.From,
+			From:       req.From,
+			To:         output.To,
+			Amount:     output.Amount,
+		}
+		if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+			panic(err)
+		}
+	}
+
+	return &collection.MsgMultiSendFTResponse{}, nil
+}
+
+func (s msgServer) MultiSendNFT(c context.Context, req *collection.MsgMultiSendNFT) (*collection.MsgMultiSendNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	if err := collection.ValidateBatchSize(s.keeper.GetParams(ctx), len(req.Outputs)); err != nil {
+		return nil, err
+	}
+
+	fromAddr := sdk.MustAccAddressFromBech32(req.From)
+
+	for _, output := range req.Outputs {
+		amount := make([]collection.Coin, len(output.TokenIds))
+		for i, id := range output.TokenIds {
+			amount[i] = collection.Coin{TokenId: id, Amount: sdk.OneInt()}
+
+			// legacy
+			if err := s.keeper.hasNFT(ctx, req.ContractId, id); err != nil {
+				return nil, err
+			}
+			if _, err := s.keeper.GetParent(ctx, req.ContractId, id); err == nil {
+				return nil, collection.ErrTokenCannotTransferChildToken.Wrap(id)
+			}
+			if !s.keeper.getOwner(ctx, req.ContractId, id).Equals(fromAddr) {
+				return nil, collection.ErrTokenNotOwnedBy.Wrapf("%s does not have %s", fromAddr, id)
+			}
+		}
+
+		toAddr := sdk.MustAccAddressFromBech32(output.To)
+
+		if err := s.keeper.SendCoins(ctx, req.ContractId, fromAddr, toAddr, amount); err != nil {
+			return nil, err
+		}
+
+		event := collection.EventSent{
+			ContractId: req.ContractId,
+			Operator:   req.From,
+			From:       req.From,
+			To:         output.To,
+			Amount:     amount,
+		}
+		if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+			panic(err)
+		}
+	}
+
+	return &collection.MsgMultiSendNFTResponse{}, nil
+}
+
+func (s msgServer) SellNFT(c context.Context, req *collection.MsgSellNFT) (*collection.MsgSellNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	seller := sdk.MustAccAddressFromBech32(req.Seller)
+	buyer := sdk.MustAccAddressFromBech32(req.Buyer)
+
+	if err := s.keeper.SellNFT(ctx, req.ContractId, seller, buyer, req.TokenId, req.Price); err != nil {
+		return nil, err
+	}
+
+	return &collection.MsgSellNFTResponse{}, nil
+}
+
 func (s msgServer) AuthorizeOperator(c context.Context, req *collection.MsgAuthorizeOperator) (*collection.MsgAuthorizeOperatorResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -237,9 +340,10 @@ func (s msgServer) CreateContract(c context.Context, req *collection.MsgCreateCo
 	ctx := sdk.UnwrapSDKContext(c)
 
 	contract := collection.Contract{
-		Name: req.Name,
-		Uri:  req.Uri,
-		Meta: req.Meta,
+		Name:        req.Name,
+		Uri:         req.Uri,
+		Meta:        req.Meta,
+		TraitSchema: req.TraitSchema,
 	}
 	ownerAddr := sdk.MustAccAddressFromBech32(req.Owner)
 
@@ -320,8 +424,10 @@ func (s msgServer) IssueNFT(c context.Context, req *collection.MsgIssueNFT) (*co
 	}
 
 	class := &collection.NFTClass{
-		Name: req.Name,
-		Meta: req.Meta,
+		Name:        req.Name,
+		Meta:        req.Meta,
+		Royalty:     req.Royalty,
+		TraitSchema: req.TraitSchema,
 	}
 	id, err := s.keeper.CreateTokenClass(ctx, req.ContractId, class)
 	if err != nil {
@@ -329,11 +435,13 @@ func (s msgServer) IssueNFT(c context.Context, req *collection.MsgIssueNFT) (*co
 	}
 
 	event := collection.EventCreatedNFTClass{
-		ContractId: req.ContractId,
-		Operator:   req.Owner,
-		TokenType:  *id,
-		Name:       class.Name,
-		Meta:       class.Meta,
+		ContractId:  req.ContractId,
+		Operator:    req.Owner,
+		TokenType:   *id,
+		Name:        class.Name,
+		Meta:        class.Meta,
+		Royalty:     class.Royalty,
+		TraitSchema: class.TraitSchema,
 	}
 	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
 		panic(err)
@@ -378,6 +486,12 @@ func (s msgServer) MintFT(c context.Context, req *collection.MsgMintFT) (*collec
 		panic(err)
 	}
 
+	if req.Vesting != nil {
+		if err := s.keeper.LockCoins(ctx, req.ContractId, toAddr, req.Amount, *req.Vesting); err != nil {
+			return nil, err
+		}
+	}
+
 	return &collection.MsgMintFTResponse{}, nil
 }
 
@@ -415,6 +529,16 @@ func (s msgServer) MintNFT(c context.Context, req *collection.MsgMintNFT) (*coll
 	for _, token := range tokens {
 		tokenIDs = append(tokenIDs, token.TokenId)
 	}
+
+	if req.Vesting != nil {
+		amount := make([]collection.Coin, 0, len(tokenIDs))
+		for _, tokenID := range tokenIDs {
+			amount = append(amount, collection.NewCoin(tokenID, sdk.OneInt()))
+		}
+		if err := s.keeper.LockCoins(ctx, req.ContractId, toAddr, amount, *req.Vesting); err != nil {
+			return nil, err
+		}
+	}
 	return &collection.MsgMintNFTResponse{TokenIds: tokenIDs}, nil
 }
 
@@ -690,8 +814,10 @@ func (s msgServer) GrantPermission(c context.Context, req *collection.MsgGrantPe
 	grantee := sdk.MustAccAddressFromBech32(req.To)
 	permission := collection.Permission(collection.LegacyPermissionFromString(req.Permission))
 
-	if _, err := s.keeper.GetGrant(ctx, req.ContractId, granter, permission); err != nil {
-		return nil, collection.ErrTokenNoPermission.Wrapf("%s is not authorized for %s", granter, permission)
+	if !s.keeper.IsAdmin(ctx, req.ContractId, granter) {
+		if _, err := s.keeper.GetGrant(ctx, req.ContractId, granter, permission); err != nil {
+			return nil, collection.ErrTokenNoPermission.Wrapf("%s is not authorized for %s", granter, permission)
+		}
 	}
 
 	// it emits typed event inside s.keeper.Grant()
@@ -857,3 +983,82 @@ func (s msgServer) OperatorDetach(c context.Context, req *collection.MsgOperator
 
 	return &collection.MsgOperatorDetachResponse{}, nil
 }
+
+// SetNFTHistoryEnabled enables or disables recording the history of the nfts of the contract.
+func (s msgServer) SetNFTHistoryEnabled(c context.Context, req *collection.MsgSetNFTHistoryEnabled) (*collection.MsgSetNFTHistoryEnabledResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	operator := sdk.MustAccAddressFromBech32(req.Operator)
+
+	if _, err := s.keeper.GetGrant(ctx, req.ContractId, operator, collection.PermissionModify); err != nil {
+		return nil, collection.ErrTokenNoPermission.Wrap(err.Error())
+	}
+
+	s.keeper.SetNFTHistoryEnabled(ctx, req.ContractId, req.Enabled)
+
+	event := collection.EventSetNFTHistoryEnabled{
+		ContractId: req.ContractId,
+		Operator:   req.Operator,
+		Enabled:    req.Enabled,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+
+	return &collection.MsgSetNFTHistoryEnabledResponse{}, nil
+}
+
+func (s msgServer) ProposeAdmin(c context.Context, req *collection.MsgProposeAdmin) (*collection.MsgProposeAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	admin := sdk.MustAccAddressFromBech32(req.From)
+	proposed := sdk.MustAccAddressFromBech32(req.To)
+
+	if err := s.keeper.ProposeAdmin(ctx, req.ContractId, admin, proposed); err != nil {
+		return nil, err
+	}
+
+	return &collection.MsgProposeAdminResponse{}, nil
+}
+
+func (s msgServer) AcceptAdmin(c context.Context, req *collection.MsgAcceptAdmin) (*collection.MsgAcceptAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	admin := sdk.MustAccAddressFromBech32(req.From)
+
+	if err := s.keeper.AcceptAdmin(ctx, req.ContractId, admin); err != nil {
+		return nil, err
+	}
+
+	return &collection.MsgAcceptAdminResponse{}, nil
+}
+
+func (s msgServer) RevokeGrant(c context.Context, req *collection.MsgRevokeGrant) (*collection.MsgRevokeGrantResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	admin := sdk.MustAccAddressFromBech32(req.From)
+	grantee := sdk.MustAccAddressFromBech32(req.Grantee)
+	permission := collection.Permission(collection.LegacyPermissionFromString(req.Permission))
+
+	if err := s.keeper.RevokeGrant(ctx, req.ContractId, admin, grantee, permission); err != nil {
+		return nil, err
+	}
+
+	return &collection.MsgRevokeGrantResponse{}, nil
+}
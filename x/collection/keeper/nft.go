@@ -47,6 +47,8 @@ func (k Keeper) GetNFT(ctx sdk.Context, contractID string, tokenID string) (*col
 }
 
 func (k Keeper) setNFT(ctx sdk.Context, contractID string, token collection.NFT) {
+	k.deleteNFTTraitIndex(ctx, contractID, token.TokenId)
+
 	store := ctx.KVStore(k.storeKey)
 	key := nftKey(contractID, token.TokenId)
 
@@ -55,9 +57,15 @@ func (k Keeper) setNFT(ctx sdk.Context, contractID string, token collection.NFT)
 		panic(err)
 	}
 	store.Set(key, bz)
+
+	for _, trait := range token.Traits {
+		store.Set(nftTraitKey(contractID, trait, token.TokenId), []byte{})
+	}
 }
 
 func (k Keeper) deleteNFT(ctx sdk.Context, contractID string, tokenID string) {
+	k.deleteNFTTraitIndex(ctx, contractID, tokenID)
+
 	store := ctx.KVStore(k.storeKey)
 	key := nftKey(contractID, tokenID)
 	store.Delete(key)
@@ -91,6 +99,11 @@ func (k Keeper) Attach(ctx sdk.Context, contractID string, owner sdk.AccAddress,
 		return collection.ErrTokenNotOwnedBy.Wrapf("%s is not owner of %s", owner, subject)
 	}
 
+	// a locked token cannot be attached, because it would be sent along with its root
+	if err := k.validateSpendable(ctx, contractID, owner, collection.NewCoins(collection.NewCoin(subject, sdk.OneInt()))); err != nil {
+		return err
+	}
+
 	// validate target
 	if err := k.hasNFT(ctx, contractID, target); err != nil {
 		return err
@@ -117,6 +130,12 @@ func (k Keeper) Attach(ctx sdk.Context, contractID string, owner sdk.AccAddress,
 		return err
 	}
 
+	k.recordNFTHistory(ctx, contractID, collection.NFTHistoryEntry{
+		TokenId: subject,
+		Action:  collection.NFTActionAttach,
+		Parent:  target,
+	})
+
 	// legacy
 	k.iterateDescendants(ctx, contractID, subject, func(descendantID string, _ int) (stop bool) {
 		event := collection.EventRootChanged{
@@ -154,6 +173,12 @@ func (k Keeper) Detach(ctx sdk.Context, contractID string, owner sdk.AccAddress,
 	k.deleteParent(ctx, contractID, subject)
 	k.deleteChild(ctx, contractID, *parent, subject)
 
+	k.recordNFTHistory(ctx, contractID, collection.NFTHistoryEntry{
+		TokenId: subject,
+		Action:  collection.NFTActionDetach,
+		Parent:  *parent,
+	})
+
 	// legacy
 	root := k.GetRoot(ctx, contractID, *parent)
 	k.iterateDescendants(ctx, contractID, subject, func(descendantID string, _ int) (stop bool) {
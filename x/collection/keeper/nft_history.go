@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/collection"
+)
+
+// IsNFTHistoryEnabled returns whether the contract records the history of its nfts.
+func (k Keeper) IsNFTHistoryEnabled(ctx sdk.Context, contractID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(nftHistoryContractKey(contractID))
+}
+
+// SetNFTHistoryEnabled enables or disables recording the history of the nfts of the contract.
+// Note: disabling the history does not delete the entries recorded so far.
+func (k Keeper) SetNFTHistoryEnabled(ctx sdk.Context, contractID string, enabled bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := nftHistoryContractKey(contractID)
+
+	if enabled {
+		store.Set(key, []byte{})
+	} else {
+		store.Delete(key)
+	}
+}
+
+// recordNFTHistory appends the entry to the history of the nft, if the contract records the history.
+// The height and the time of the entry are those of the current block.
+func (k Keeper) recordNFTHistory(ctx sdk.Context, contractID string, entry collection.NFTHistoryEntry) {
+	if !k.IsNFTHistoryEnabled(ctx, contractID) {
+		return
+	}
+
+	entry.Height = ctx.BlockHeight()
+	entry.Time = ctx.BlockTime()
+	k.addNFTHistoryEntry(ctx, contractID, entry)
+}
+
+func (k Keeper) recordNFTTransfers(ctx sdk.Context, contractID string, from, to sdk.AccAddress, tokenID string) {
+	record := func(id string) {
+		k.recordNFTHistory(ctx, contractID, collection.NFTHistoryEntry{
+			TokenId: id,
+			Action:  collection.NFTActionTransfer,
+			From:    from.String(),
+			To:      to.String(),
+		})
+	}
+
+	record(tokenID)
+	k.iterateDescendants(ctx, contractID, tokenID, func(descendantID string, _ int) (stop bool) {
+		record(descendantID)
+		return false
+	})
+}
+
+func (k Keeper) addNFTHistoryEntry(ctx sdk.Context, contractID string, entry collection.NFTHistoryEntry) {
+	sequence := k.getNextNFTHistorySequence(ctx)
+	k.setNextNFTHistorySequence(ctx, sequence+1)
+
+	store := ctx.KVStore(k.storeKey)
+	key := nftHistoryKey(contractID, entry.TokenId, sequence)
+
+	bz, err := entry.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(key, bz)
+
+	store.Set(nftHistoryQueueKey(entry.Time, sequence), key)
+}
+
+func (k Keeper) getNextNFTHistorySequence(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(nftHistorySequenceKey)
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setNextNFTHistorySequence(ctx sdk.Context, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, sequence)
+	store.Set(nftHistorySequenceKey, bz)
+}
+
+// PruneNFTHistory deletes at most limit history entries older than the retention of the params,
+// oldest first. It does nothing if the retention is zero.
+// The queue of the entries is ordered by their time, so the entries left behind are the first
+// ones to be deleted in the next call.
+func (k Keeper) PruneNFTHistory(ctx sdk.Context, limit int) {
+	retention := k.GetParams(ctx).NftHistoryRetention
+	if retention == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	end := nftHistoryQueueKeyPrefixByTime(ctx.BlockTime().Add(-retention))
+	iterator := store.Iterator(nftHistoryQueueKeyPrefix, end)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for pruned := 0; iterator.Valid() && pruned < limit; iterator.Next() {
+		keys = append(keys, iterator.Key(), iterator.Value())
+		pruned++
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
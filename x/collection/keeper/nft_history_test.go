@@ -0,0 +1,157 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/collection"
+	"github.com/Finschia/finschia-sdk/x/collection/keeper"
+)
+
+func (s *KeeperTestSuite) getNFTHistory(ctx sdk.Context, tokenID string) []collection.NFTHistoryEntry {
+	req := &collection.QueryNFTHistoryRequest{
+		ContractId: s.contractID,
+		TokenId:    tokenID,
+	}
+	res, err := s.queryServer.NFTHistory(sdk.WrapSDKContext(ctx), req)
+	s.Require().NoError(err)
+	s.Require().NotNil(res)
+
+	return res.Entries
+}
+
+func (s *KeeperTestSuite) TestNFTHistory() {
+	ctx, _ := s.ctx.CacheContext()
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockHeight(42).WithBlockTime(now)
+
+	// not recorded while disabled
+	root := collection.NewNFTID(s.nftClassID, 1)
+	err := s.keeper.SendCoins(ctx, s.contractID, s.customer, s.vendor, collection.NewCoins(collection.NewCoin(root, sdk.OneInt())))
+	s.Require().NoError(err)
+	s.Require().Empty(s.getNFTHistory(ctx, root))
+
+	s.keeper.SetNFTHistoryEnabled(ctx, s.contractID, true)
+	s.Require().True(s.keeper.IsNFTHistoryEnabled(ctx, s.contractID))
+
+	// mint
+	tokens, err := s.keeper.MintNFT(ctx, s.contractID, s.customer, []collection.MintNFTParam{{TokenType: s.nftClassID}})
+	s.Require().NoError(err)
+	tokenID := tokens[0].TokenId
+
+	// attach & detach
+	parent := collection.NewNFTID(s.nftClassID, 5)
+	err = s.keeper.Attach(ctx, s.contractID, s.customer, tokenID, parent)
+	s.Require().NoError(err)
+	err = s.keeper.Detach(ctx, s.contractID, s.customer, tokenID)
+	s.Require().NoError(err)
+
+	// transfer & burn
+	err = s.keeper.SendCoins(ctx, s.contractID, s.customer, s.stranger, collection.NewCoins(collection.NewCoin(tokenID, sdk.OneInt())))
+	s.Require().NoError(err)
+	_, err = s.keeper.BurnCoins(ctx, s.contractID, s.stranger, collection.NewCoins(collection.NewCoin(tokenID, sdk.OneInt())))
+	s.Require().NoError(err)
+
+	expected := []collection.NFTHistoryEntry{
+		{TokenId: tokenID, Action: collection.NFTActionMint, To: s.customer.String()},
+		{TokenId: tokenID, Action: collection.NFTActionAttach, Parent: parent},
+		{TokenId: tokenID, Action: collection.NFTActionDetach, Parent: parent},
+		{TokenId: tokenID, Action: collection.NFTActionTransfer, From: s.customer.String(), To: s.stranger.String()},
+		{TokenId: tokenID, Action: collection.NFTActionBurn, From: s.stranger.String()},
+	}
+	for i := range expected {
+		expected[i].Height = ctx.BlockHeight()
+		expected[i].Time = now
+	}
+	s.Require().Equal(expected, s.getNFTHistory(ctx, tokenID))
+
+	// the descendants are recorded along with their root
+	err = s.keeper.SendCoins(ctx, s.contractID, s.vendor, s.customer, collection.NewCoins(collection.NewCoin(root, sdk.OneInt())))
+	s.Require().NoError(err)
+	_, err = s.keeper.BurnCoins(ctx, s.contractID, s.customer, collection.NewCoins(collection.NewCoin(root, sdk.OneInt())))
+	s.Require().NoError(err)
+
+	for _, id := range []string{root, collection.NewNFTID(s.nftClassID, s.depthLimit)} {
+		entries := s.getNFTHistory(ctx, id)
+		s.Require().Len(entries, 2)
+		s.Require().Equal(collection.NFTActionTransfer, entries[0].Action)
+		s.Require().Equal(s.vendor.String(), entries[0].From)
+		s.Require().Equal(collection.NFTActionBurn, entries[1].Action)
+		s.Require().Equal(s.customer.String(), entries[1].From)
+	}
+
+	// disabling keeps the entries
+	s.keeper.SetNFTHistoryEnabled(ctx, s.contractID, false)
+	s.Require().False(s.keeper.IsNFTHistoryEnabled(ctx, s.contractID))
+	s.Require().Len(s.getNFTHistory(ctx, tokenID), len(expected))
+}
+
+func (s *KeeperTestSuite) TestPruneNFTHistory() {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	retention := time.Hour
+
+	testCases := map[string]struct {
+		retention time.Duration
+		elapsed   time.Duration
+		limit     int
+		remaining int
+	}{
+		"nothing expired": {
+			retention: retention,
+			elapsed:   retention,
+			remaining: 2,
+		},
+		"one expired": {
+			retention: retention,
+			elapsed:   retention + time.Minute,
+			remaining: 1,
+		},
+		"all expired": {
+			retention: retention,
+			elapsed:   retention + time.Hour + time.Minute,
+			remaining: 0,
+		},
+		"all expired but limited": {
+			retention: retention,
+			elapsed:   retention + time.Hour + time.Minute,
+			limit:     1,
+			remaining: 1,
+		},
+		"kept forever": {
+			elapsed:   retention + time.Hour,
+			remaining: 2,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			params := s.keeper.GetParams(ctx)
+			params.NftHistoryRetention = tc.retention
+			s.keeper.SetParams(ctx, params)
+
+			s.keeper.SetNFTHistoryEnabled(ctx, s.contractID, true)
+
+			tokenID := collection.NewNFTID(s.nftClassID, 5)
+			amount := collection.NewCoins(collection.NewCoin(tokenID, sdk.OneInt()))
+			err := s.keeper.SendCoins(ctx.WithBlockTime(now), s.contractID, s.customer, s.vendor, amount)
+			s.Require().NoError(err)
+			err = s.keeper.SendCoins(ctx.WithBlockTime(now.Add(time.Hour)), s.contractID, s.vendor, s.customer, amount)
+			s.Require().NoError(err)
+
+			limit := tc.limit
+			if limit == 0 {
+				limit = keeper.MaxNFTHistoryPrunesPerBlock
+			}
+			s.keeper.PruneNFTHistory(ctx.WithBlockTime(now.Add(tc.elapsed)), limit)
+
+			history := s.getNFTHistory(ctx, tokenID)
+			s.Require().Len(history, tc.remaining)
+			if tc.remaining == 1 {
+				// the older one goes first
+				s.Require().Equal(now.Add(time.Hour), history[0].Time)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/collection"
+)
+
+// GetRoyalty returns the royalty of the class of the nft, or nil if the class has no royalty.
+func (k Keeper) GetRoyalty(ctx sdk.Context, contractID string, tokenID string) (*collection.Royalty, error) {
+	if err := k.hasNFT(ctx, contractID, tokenID); err != nil {
+		return nil, err
+	}
+
+	classID := collection.SplitTokenID(tokenID)
+	class, err := k.GetTokenClass(ctx, contractID, classID)
+	if err != nil {
+		panic(err)
+	}
+
+	nftClass, ok := class.(*collection.NFTClass)
+	if !ok {
+		panic(sdkerrors.ErrInvalidType.Wrapf("not a class of non-fungible token: %s", classID))
+	}
+	return nftClass.Royalty, nil
+}
+
+// SellNFT transfers the nft from the seller to the buyer in exchange for the price.
+// The royalty of the class of the nft, if any, is paid to its recipient out of the price.
+func (k Keeper) SellNFT(ctx sdk.Context, contractID string, seller, buyer sdk.AccAddress, tokenID string, price sdk.Coin) error {
+	royalty, err := k.GetRoyalty(ctx, contractID, tokenID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := k.GetParent(ctx, contractID, tokenID); err == nil {
+		return collection.ErrTokenCannotTransferChildToken.Wrap(tokenID)
+	}
+	if !k.getOwner(ctx, contractID, tokenID).Equals(seller) {
+		return collection.ErrTokenNotOwnedBy.Wrapf("%s does not have %s", seller, tokenID)
+	}
+
+	amount := []collection.Coin{{TokenId: tokenID, Amount: sdk.OneInt()}}
+	if err := k.SendCoins(ctx, contractID, seller, buyer, amount); err != nil {
+		return err
+	}
+
+	sent := collection.EventSent{
+		ContractId: contractID,
+		Operator:   seller.String(),
+		From:       seller.String(),
+		To:         buyer.String(),
+		Amount:     amount,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&sent); err != nil {
+		panic(err)
+	}
+
+	royaltyRecipient := ""
+	royaltyAmount := sdk.NewCoin(price.Denom, sdk.ZeroInt())
+	if royalty != nil {
+		royaltyRecipient = royalty.Recipient
+		royaltyAmount = royalty.Amount(price)
+	}
+
+	if royaltyAmount.IsPositive() {
+		recipient := sdk.MustAccAddressFromBech32(royaltyRecipient)
+		if err := k.bankKeeper.SendCoins(ctx, buyer, recipient, sdk.NewCoins(royaltyAmount)); err != nil {
+			return err
+		}
+	}
+	if proceeds := price.Sub(royaltyAmount); proceeds.IsPositive() {
+		if err := k.bankKeeper.SendCoins(ctx, buyer, seller, sdk.NewCoins(proceeds)); err != nil {
+			return err
+		}
+	}
+
+	event := collection.EventSold{
+		ContractId:       contractID,
+		Seller:           seller.String(),
+		Buyer:            buyer.String(),
+		TokenId:          tokenID,
+		Price:            price,
+		RoyaltyRecipient: royaltyRecipient,
+		Royalty:          royaltyAmount,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/collection"
+)
+
+func (s *KeeperTestSuite) TestSellNFT() {
+	price := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1001)
+
+	testCases := map[string]struct {
+		tokenID     string
+		basisPoints uint32
+		price       sdk.Coin
+		royalty     sdk.Int
+		err         error
+	}{
+		"valid request": {
+			tokenID: collection.NewNFTID(s.nftClassID, s.depthLimit+1),
+			price:   price,
+			royalty: sdk.ZeroInt(),
+		},
+		"valid request with royalty": {
+			tokenID:     collection.NewNFTID(s.nftClassID, s.depthLimit+1),
+			basisPoints: 250,
+			price:       price,
+			royalty:     sdk.NewInt(25),
+		},
+		"valid request with full royalty": {
+			tokenID:     collection.NewNFTID(s.nftClassID, s.depthLimit+1),
+			basisPoints: collection.MaxRoyaltyBasisPoints,
+			price:       price,
+			royalty:     price.Amount,
+		},
+		"not found": {
+			tokenID: collection.NewNFTID(s.nftClassID, s.numNFTs*3+1),
+			price:   price,
+			err:     collection.ErrTokenNotExist,
+		},
+		"child": {
+			tokenID: collection.NewNFTID(s.nftClassID, 2),
+			price:   price,
+			err:     collection.ErrTokenCannotTransferChildToken,
+		},
+		"not owned by": {
+			tokenID: collection.NewNFTID(s.nftClassID, s.numNFTs+1),
+			price:   price,
+			err:     collection.ErrTokenNotOwnedBy,
+		},
+		"insufficient funds": {
+			tokenID: collection.NewNFTID(s.nftClassID, s.depthLimit+1),
+			price:   sdk.NewCoin(sdk.DefaultBondDenom, s.balance.AddRaw(1)),
+			err:     sdkerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			if tc.basisPoints != 0 {
+				changes := []collection.Attribute{
+					{Key: collection.AttributeKeyRoyaltyRecipient.String(), Value: s.vendor.String()},
+					{Key: collection.AttributeKeyRoyaltyBasisPoints.String(), Value: fmt.Sprint(tc.basisPoints)},
+				}
+				err := s.keeper.ModifyTokenClass(ctx, s.contractID, s.nftClassID, s.vendor, changes)
+				s.Require().NoError(err)
+			}
+
+			sellerBefore := s.bankKeeper.GetBalance(ctx, s.customer, tc.price.Denom)
+			buyerBefore := s.bankKeeper.GetBalance(ctx, s.stranger, tc.price.Denom)
+			recipientBefore := s.bankKeeper.GetBalance(ctx, s.vendor, tc.price.Denom)
+
+			err := s.keeper.SellNFT(ctx, s.contractID, s.customer, s.stranger, tc.tokenID, tc.price)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			owner := s.keeper.GetRootOwner(ctx, s.contractID, tc.tokenID)
+			s.Require().Equal(s.stranger, owner)
+
+			buyerAfter := s.bankKeeper.GetBalance(ctx, s.stranger, tc.price.Denom)
+			s.Require().Equal(buyerBefore.Sub(tc.price), buyerAfter)
+
+			sellerAfter := s.bankKeeper.GetBalance(ctx, s.customer, tc.price.Denom)
+			s.Require().Equal(sellerBefore.Amount.Add(tc.price.Amount).Sub(tc.royalty), sellerAfter.Amount)
+
+			recipientAfter := s.bankKeeper.GetBalance(ctx, s.vendor, tc.price.Denom)
+			s.Require().Equal(recipientBefore.Amount.Add(tc.royalty), recipientAfter.Amount)
+
+			var types []string
+			for _, event := range ctx.EventManager().Events() {
+				types = append(types, event.Type)
+			}
+			s.Require().Contains(types, "lbm.collection.v1.EventSent")
+			s.Require().Contains(types, "lbm.collection.v1.EventSold")
+		})
+	}
+}
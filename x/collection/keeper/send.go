@@ -6,11 +6,21 @@ import (
 )
 
 func (k Keeper) SendCoins(ctx sdk.Context, contractID string, from, to sdk.AccAddress, amount []collection.Coin) error {
+	if err := k.validateSpendable(ctx, contractID, from, amount); err != nil {
+		return err
+	}
+
 	if err := k.subtractCoins(ctx, contractID, from, amount); err != nil {
 		return err
 	}
 	k.addCoins(ctx, contractID, to, amount)
 
+	for _, coin := range amount {
+		if err := collection.ValidateNFTID(coin.TokenId); err == nil {
+			k.recordNFTTransfers(ctx, contractID, from, to, coin.TokenId)
+		}
+	}
+
 	// legacy
 	for _, coin := range amount {
 		if err := collection.ValidateNFTID(coin.TokenId); err == nil {
@@ -89,6 +99,26 @@ func (k Keeper) setBalance(ctx sdk.Context, contractID string, address sdk.AccAd
 		}
 		store.Set(key, bz)
 	}
+
+	k.updateBalanceIndex(ctx, contractID, address, tokenID, !balance.IsZero())
+}
+
+// updateBalanceIndex updates the indexes of the balance, which are used by the enumeration queries.
+func (k Keeper) updateBalanceIndex(ctx sdk.Context, contractID string, address sdk.AccAddress, tokenID string, exists bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	var key []byte
+	if err := collection.ValidateNFTID(tokenID); err == nil {
+		key = ownerNFTKey(address, contractID, tokenID)
+	} else {
+		key = holderKey(contractID, tokenID, address)
+	}
+
+	if exists {
+		store.Set(key, []byte{})
+	} else {
+		store.Delete(key)
+	}
 }
 
 func (k Keeper) AuthorizeOperator(ctx sdk.Context, contractID string, holder, operator sdk.AccAddress) error {
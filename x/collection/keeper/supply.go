@@ -9,12 +9,16 @@ import (
 func (k Keeper) CreateContract(ctx sdk.Context, creator sdk.AccAddress, contract collection.Contract) string {
 	contractID := k.createContract(ctx, contract)
 
+	contract.Id = contractID
+	k.setAdmin(ctx, contract, creator)
+
 	event := collection.EventCreatedContract{
-		Creator:    creator.String(),
-		ContractId: contractID,
-		Name:       contract.Name,
-		Meta:       contract.Meta,
-		Uri:        contract.Uri,
+		Creator:     creator.String(),
+		ContractId:  contractID,
+		Name:        contract.Name,
+		Meta:        contract.Meta,
+		Uri:         contract.Uri,
+		TraitSchema: contract.TraitSchema,
 	}
 	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
 		panic(err)
@@ -80,6 +84,11 @@ func (k Keeper) CreateTokenClass(ctx sdk.Context, contractID string, class colle
 	if err := class.ValidateBasic(); err != nil {
 		return nil, err
 	}
+	if nftClass, ok := class.(*collection.NFTClass); ok {
+		if err := k.validateClassTraitSchema(ctx, contractID, nftClass.TraitSchema); err != nil {
+			return nil, err
+		}
+	}
 	k.setTokenClass(ctx, contractID, class)
 
 	if nftClass, ok := class.(*collection.NFTClass); ok {
@@ -207,6 +216,14 @@ func (k Keeper) MintNFT(ctx sdk.Context, contractID string, to sdk.AccAddress, p
 			return nil, collection.ErrTokenTypeNotExist.Wrapf("not a class of non-fungible token: %s", classID)
 		}
 
+		schema, err := k.GetTraitSchema(ctx, contractID, classID)
+		if err != nil {
+			return nil, err
+		}
+		if err := collection.ValidateTraits(schema, param.Traits); err != nil {
+			return nil, err
+		}
+
 		nextTokenID := k.getNextTokenID(ctx, contractID, classID)
 		k.setNextTokenID(ctx, contractID, classID, nextTokenID.Incr())
 		tokenID := collection.NewNFTID(classID, int(nextTokenID.Uint64()))
@@ -220,9 +237,16 @@ func (k Keeper) MintNFT(ctx sdk.Context, contractID string, to sdk.AccAddress, p
 			TokenId: tokenID,
 			Name:    param.Name,
 			Meta:    param.Meta,
+			Traits:  param.Traits,
 		}
 		k.setNFT(ctx, contractID, token)
 
+		k.recordNFTHistory(ctx, contractID, collection.NFTHistoryEntry{
+			TokenId: tokenID,
+			Action:  collection.NFTActionMint,
+			To:      to.String(),
+		})
+
 		// update statistics
 		supply := k.GetSupply(ctx, contractID, classID)
 		k.setSupply(ctx, contractID, classID, supply.Add(amount))
@@ -255,6 +279,14 @@ func (k Keeper) BurnCoins(ctx sdk.Context, contractID string, from sdk.AccAddres
 				burntAmount = append(burntAmount, collection.NewCoin(id, sdk.OneInt()))
 			}
 
+			for _, id := range append([]string{coin.TokenId}, pruned...) {
+				k.recordNFTHistory(ctx, contractID, collection.NFTHistoryEntry{
+					TokenId: id,
+					Action:  collection.NFTActionBurn,
+					From:    from.String(),
+				})
+			}
+
 			// legacy
 			k.deleteLegacyToken(ctx, contractID, coin.TokenId)
 		}
@@ -349,9 +381,42 @@ func (k Keeper) ModifyTokenClass(ctx sdk.Context, contractID string, classID str
 			class.SetMeta(meta)
 		},
 	}
+	nftClass, isNFTClass := class.(*collection.NFTClass)
+	var royalty collection.Royalty
+	if isNFTClass {
+		if nftClass.Royalty != nil {
+			royalty = *nftClass.Royalty
+		}
+
+		modifiers[collection.AttributeKeyRoyaltyRecipient] = func(recipient string) {
+			royalty.Recipient = recipient
+		}
+		modifiers[collection.AttributeKeyRoyaltyBasisPoints] = func(basisPoints string) {
+			parsed, err := collection.ParseRoyaltyBasisPoints(basisPoints)
+			if err != nil {
+				panic(err)
+			}
+			royalty.BasisPoints = parsed
+		}
+	}
 	for _, change := range changes {
 		key := collection.AttributeKeyFromString(change.Key)
-		modifiers[key](change.Value)
+		modifier, ok := modifiers[key]
+		if !ok {
+			return collection.ErrInvalidChangesField.Wrapf("invalid field: %s", change.Key)
+		}
+		modifier(change.Value)
+	}
+
+	if isNFTClass {
+		// zero basis points removes the royalty
+		nftClass.Royalty = nil
+		if royalty.BasisPoints != 0 {
+			if err := royalty.ValidateBasic(); err != nil {
+				return err
+			}
+			nftClass.Royalty = &royalty
+		}
 	}
 
 	k.setTokenClass(ctx, contractID, class)
@@ -373,11 +438,32 @@ func (k Keeper) ModifyNFT(ctx sdk.Context, contractID string, tokenID string, op
 			token.Meta = meta
 		},
 	}
+	var traitChanges []collection.Attribute
 	for _, change := range changes {
+		if traitKey, ok := collection.TraitKeyFromChangeKey(change.Key); ok {
+			traitChanges = append(traitChanges, collection.Attribute{
+				Key:   traitKey,
+				Value: change.Value,
+			})
+			continue
+		}
+
 		key := collection.AttributeKeyFromString(change.Key)
 		modifiers[key](change.Value)
 	}
 
+	if len(traitChanges) != 0 {
+		schema, err := k.GetTraitSchema(ctx, contractID, collection.SplitTokenID(tokenID))
+		if err != nil {
+			panic(err)
+		}
+
+		token.Traits = applyTraitChanges(token.Traits, traitChanges)
+		if err := collection.ValidateTraits(schema, token.Traits); err != nil {
+			return err
+		}
+	}
+
 	k.setNFT(ctx, contractID, *token)
 
 	return nil
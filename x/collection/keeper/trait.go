@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/collection"
+)
+
+// GetTraitSchema returns the trait schema applied to the non-fungible tokens of the class,
+// which consists of the schema of the contract and that of the class.
+func (k Keeper) GetTraitSchema(ctx sdk.Context, contractID, classID string) ([]collection.TraitDefinition, error) {
+	contract, err := k.GetContract(ctx, contractID)
+	if err != nil {
+		return nil, err
+	}
+
+	class, err := k.GetTokenClass(ctx, contractID, classID)
+	if err != nil {
+		return nil, err
+	}
+	nftClass, ok := class.(*collection.NFTClass)
+	if !ok {
+		return nil, collection.ErrTokenTypeNotExist.Wrapf("not a class of non-fungible token: %s", classID)
+	}
+
+	schema := make([]collection.TraitDefinition, 0, len(contract.TraitSchema)+len(nftClass.TraitSchema))
+	schema = append(schema, contract.TraitSchema...)
+	schema = append(schema, nftClass.TraitSchema...)
+
+	return schema, nil
+}
+
+// validateClassTraitSchema checks the schema of a new class does not redefine the traits of the contract.
+func (k Keeper) validateClassTraitSchema(ctx sdk.Context, contractID string, schema []collection.TraitDefinition) error {
+	contract, err := k.GetContract(ctx, contractID)
+	if err != nil {
+		return err
+	}
+
+	contractKeys := map[string]bool{}
+	for _, definition := range contract.TraitSchema {
+		contractKeys[definition.Key] = true
+	}
+	for _, definition := range schema {
+		if contractKeys[definition.Key] {
+			return collection.ErrInvalidTrait.Wrapf("already defined by the contract: %s", definition.Key)
+		}
+	}
+
+	return nil
+}
+
+// applyTraitChanges returns the traits with the changes applied.
+// An empty value removes the trait, and the others update the trait in place or append it.
+func applyTraitChanges(traits []collection.Attribute, changes []collection.Attribute) []collection.Attribute {
+	values := make(map[string]string, len(changes))
+	for _, change := range changes {
+		values[change.Key] = change.Value
+	}
+
+	applied := make([]collection.Attribute, 0, len(traits)+len(changes))
+	seenKeys := map[string]bool{}
+	for _, trait := range traits {
+		seenKeys[trait.Key] = true
+
+		value, ok := values[trait.Key]
+		if !ok {
+			applied = append(applied, trait)
+			continue
+		}
+		if len(value) != 0 {
+			applied = append(applied, collection.Attribute{Key: trait.Key, Value: value})
+		}
+	}
+
+	for _, change := range changes {
+		if !seenKeys[change.Key] && len(change.Value) != 0 {
+			applied = append(applied, change)
+		}
+	}
+
+	return applied
+}
+
+func (k Keeper) deleteNFTTraitIndex(ctx sdk.Context, contractID string, tokenID string) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(nftKey(contractID, tokenID))
+	if bz == nil {
+		return
+	}
+
+	var token collection.NFT
+	k.cdc.MustUnmarshal(bz, &token)
+
+	for _, trait := range token.Traits {
+		store.Delete(nftTraitKey(contractID, trait, tokenID))
+	}
+}
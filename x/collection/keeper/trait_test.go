@@ -0,0 +1,195 @@
+package keeper_test
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/collection"
+)
+
+// createTraitContract creates a contract and a non-fungible token class with their trait schemas.
+func (s *KeeperTestSuite) createTraitContract(ctx sdk.Context) (contractID, classID string) {
+	contractID = s.keeper.CreateContract(ctx, s.vendor, collection.Contract{
+		Name: "traits",
+		TraitSchema: []collection.TraitDefinition{
+			{Key: "color", Type: collection.TraitTypeString, Required: true},
+			{Key: "shiny", Type: collection.TraitTypeBoolean},
+		},
+	})
+
+	id, err := s.keeper.CreateTokenClass(ctx, contractID, &collection.NFTClass{
+		Name: "traits",
+		TraitSchema: []collection.TraitDefinition{
+			{Key: "level", Type: collection.TraitTypeInteger},
+		},
+	})
+	s.Require().NoError(err)
+
+	return contractID, *id
+}
+
+func (s *KeeperTestSuite) TestCreateTokenClassWithTraitSchema() {
+	testCases := map[string]struct {
+		schema []collection.TraitDefinition
+		err    error
+	}{
+		"valid request": {
+			schema: []collection.TraitDefinition{
+				{Key: "speed", Type: collection.TraitTypeInteger},
+			},
+		},
+		"redefinition of the contract schema": {
+			schema: []collection.TraitDefinition{
+				{Key: "color", Type: collection.TraitTypeInteger},
+			},
+			err: collection.ErrInvalidTrait,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			contractID, _ := s.createTraitContract(ctx)
+
+			_, err := s.keeper.CreateTokenClass(ctx, contractID, &collection.NFTClass{
+				TraitSchema: tc.schema,
+			})
+			s.Require().ErrorIs(err, tc.err)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMintNFTWithTraits() {
+	testCases := map[string]struct {
+		traits []collection.Attribute
+		err    error
+	}{
+		"valid request": {
+			traits: []collection.Attribute{
+				{Key: "color", Value: "red"},
+				{Key: "shiny", Value: "true"},
+				{Key: "level", Value: "3"},
+			},
+		},
+		"missing required trait": {
+			traits: []collection.Attribute{
+				{Key: "level", Value: "3"},
+			},
+			err: collection.ErrInvalidTrait,
+		},
+		"not in the schema": {
+			traits: []collection.Attribute{
+				{Key: "color", Value: "red"},
+				{Key: "size", Value: "large"},
+			},
+			err: collection.ErrInvalidTrait,
+		},
+		"invalid integer": {
+			traits: []collection.Attribute{
+				{Key: "color", Value: "red"},
+				{Key: "level", Value: "03"},
+			},
+			err: collection.ErrInvalidTrait,
+		},
+		"invalid boolean": {
+			traits: []collection.Attribute{
+				{Key: "color", Value: "red"},
+				{Key: "shiny", Value: "yes"},
+			},
+			err: collection.ErrInvalidTrait,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			contractID, classID := s.createTraitContract(ctx)
+
+			tokens, err := s.keeper.MintNFT(ctx, contractID, s.customer, []collection.MintNFTParam{{
+				TokenType: classID,
+				Name:      "fox",
+				Traits:    tc.traits,
+			}})
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			token, err := s.keeper.GetNFT(ctx, contractID, tokens[0].TokenId)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.traits, token.Traits)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestModifyNFTTraits() {
+	testCases := map[string]struct {
+		changes []collection.Attribute
+		traits  []collection.Attribute
+		err     error
+	}{
+		"update and add": {
+			changes: []collection.Attribute{
+				{Key: collection.TraitChangeKeyPrefix + "level", Value: "4"},
+				{Key: collection.TraitChangeKeyPrefix + "shiny", Value: "false"},
+			},
+			traits: []collection.Attribute{
+				{Key: "color", Value: "red"},
+				{Key: "level", Value: "4"},
+				{Key: "shiny", Value: "false"},
+			},
+		},
+		"remove optional trait": {
+			changes: []collection.Attribute{
+				{Key: collection.TraitChangeKeyPrefix + "level", Value: ""},
+			},
+			traits: []collection.Attribute{
+				{Key: "color", Value: "red"},
+			},
+		},
+		"remove required trait": {
+			changes: []collection.Attribute{
+				{Key: collection.TraitChangeKeyPrefix + "color", Value: ""},
+			},
+			err: collection.ErrInvalidTrait,
+		},
+		"not in the schema": {
+			changes: []collection.Attribute{
+				{Key: collection.TraitChangeKeyPrefix + "size", Value: "large"},
+			},
+			err: collection.ErrInvalidTrait,
+		},
+		"invalid type": {
+			changes: []collection.Attribute{
+				{Key: collection.TraitChangeKeyPrefix + "level", Value: "high"},
+			},
+			err: collection.ErrInvalidTrait,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			contractID, classID := s.createTraitContract(ctx)
+
+			tokens, err := s.keeper.MintNFT(ctx, contractID, s.customer, []collection.MintNFTParam{{
+				TokenType: classID,
+				Name:      "fox",
+				Traits: []collection.Attribute{
+					{Key: "color", Value: "red"},
+					{Key: "level", Value: "3"},
+				},
+			}})
+			s.Require().NoError(err)
+			tokenID := tokens[0].TokenId
+
+			err = s.keeper.ModifyNFT(ctx, contractID, tokenID, s.vendor, tc.changes)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			token, err := s.keeper.GetNFT(ctx, contractID, tokenID)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.traits, token.Traits)
+		})
+	}
+}
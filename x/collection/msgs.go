@@ -3,6 +3,8 @@ package collection
 import (
 	"fmt"
 	"regexp"
+	"strconv"
+	"strings"
 	"unicode/utf8"
 
 	sdk "github.com/Finschia/finschia-sdk/types"
@@ -214,6 +216,66 @@ func validateTokenClassChange(change Attribute) error {
 	return validateChange(change, validators)
 }
 
+func validateNFTClassChange(change Attribute) error {
+	validators := map[string]func(string) error{
+		AttributeKeyName.String():               validateName,
+		AttributeKeyMeta.String():               validateMeta,
+		AttributeKeyRoyaltyRecipient.String():   validateRoyaltyRecipient,
+		AttributeKeyRoyaltyBasisPoints.String(): validateRoyaltyBasisPoints,
+	}
+
+	return validateChange(change, validators)
+}
+
+func validateNFTChange(change Attribute) error {
+	if traitKey, ok := TraitKeyFromChangeKey(change.Key); ok {
+		if err := ValidateTraitKey(traitKey); err != nil {
+			return err
+		}
+
+		// empty value removes the trait
+		if len(change.Value) == 0 {
+			return nil
+		}
+		return ValidateTraitValue(change.Value)
+	}
+
+	return validateTokenClassChange(change)
+}
+
+// TraitKeyFromChangeKey returns the trait key of the change key, if the change is on a trait.
+func TraitKeyFromChangeKey(key string) (string, bool) {
+	if !strings.HasPrefix(key, TraitChangeKeyPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(key, TraitChangeKeyPrefix), true
+}
+
+func validateRoyaltyRecipient(recipient string) error {
+	if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+		return ErrInvalidRoyalty.Wrapf("invalid recipient address: %s", recipient)
+	}
+	return nil
+}
+
+// validateRoyaltyBasisPoints checks the basis points of the royalty change.
+// Note: zero is allowed, which removes the royalty.
+func validateRoyaltyBasisPoints(basisPoints string) error {
+	if _, err := ParseRoyaltyBasisPoints(basisPoints); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ParseRoyaltyBasisPoints parses the basis points of a royalty.
+func ParseRoyaltyBasisPoints(basisPoints string) (uint32, error) {
+	parsed, err := strconv.ParseUint(basisPoints, 10, 32)
+	if err != nil || parsed > MaxRoyaltyBasisPoints {
+		return 0, ErrInvalidRoyalty.Wrapf("basis points must be in the range of 0 ~ %d: %s", MaxRoyaltyBasisPoints, basisPoints)
+	}
+	return uint32(parsed), nil
+}
+
 func validateChange(change Attribute, validators map[string]func(string) error) error {
 	validator, ok := validators[change.Key]
 	if !ok {
@@ -420,6 +482,174 @@ func (m MsgOperatorSendNFT) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
 }
 
+// ValidateBatchSize checks the number of the outputs of a batch transfer against the params.
+func ValidateBatchSize(params Params, size int) error {
+	if uint64(size) > uint64(params.MaxBatchSize) {
+		return ErrBatchTooLarge.Wrapf("%d > %d", size, params.MaxBatchSize)
+	}
+	return nil
+}
+
+var _ sdk.Msg = (*MsgMultiSendFT)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgMultiSendFT) ValidateBasic() error {
+	if err := ValidateContractID(m.ContractId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.From); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", m.From)
+	}
+
+	if len(m.Outputs) == 0 {
+		return ErrEmptyField.Wrap("outputs cannot be empty")
+	}
+	for _, output := range m.Outputs {
+		if _, err := sdk.AccAddressFromBech32(output.To); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %s", output.To)
+		}
+
+		if err := validateCoins(output.Amount); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgMultiSendFT) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.From)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgMultiSendFT) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgMultiSendFT) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgMultiSendFT) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgMultiSendNFT)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgMultiSendNFT) ValidateBasic() error {
+	if err := ValidateContractID(m.ContractId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.From); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", m.From)
+	}
+
+	if len(m.Outputs) == 0 {
+		return ErrEmptyField.Wrap("outputs cannot be empty")
+	}
+	seenIDs := map[string]bool{}
+	for _, output := range m.Outputs {
+		if _, err := sdk.AccAddressFromBech32(output.To); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %s", output.To)
+		}
+
+		if len(output.TokenIds) == 0 {
+			return ErrEmptyField.Wrap("token ids cannot be empty")
+		}
+		for _, id := range output.TokenIds {
+			if err := ValidateTokenID(id); err != nil {
+				return err
+			}
+
+			if seenIDs[id] {
+				return sdkerrors.ErrInvalidRequest.Wrapf("duplicate token id: %s", id)
+			}
+			seenIDs[id] = true
+		}
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgMultiSendNFT) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.From)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgMultiSendNFT) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgMultiSendNFT) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgMultiSendNFT) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgSellNFT)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgSellNFT) ValidateBasic() error {
+	if err := ValidateContractID(m.ContractId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Seller); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid seller address: %s", m.Seller)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Buyer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid buyer address: %s", m.Buyer)
+	}
+	if m.Seller == m.Buyer {
+		return sdkerrors.ErrInvalidRequest.Wrap("seller and buyer cannot be the same")
+	}
+
+	if err := ValidateNFTID(m.TokenId); err != nil {
+		return err
+	}
+
+	if !m.Price.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrap(m.Price.String())
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgSellNFT) GetSigners() []sdk.AccAddress {
+	seller, _ := sdk.AccAddressFromBech32(m.Seller)
+	buyer, _ := sdk.AccAddressFromBech32(m.Buyer)
+	return []sdk.AccAddress{seller, buyer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgSellNFT) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgSellNFT) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgSellNFT) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
 var _ sdk.Msg = (*MsgAuthorizeOperator)(nil)
 
 // ValidateBasic implements Msg.
@@ -526,6 +756,10 @@ func (m MsgCreateContract) ValidateBasic() error {
 		return err
 	}
 
+	if err := validateTraitSchema(m.TraitSchema); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -630,6 +864,16 @@ func (m MsgIssueNFT) ValidateBasic() error {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", m.Owner)
 	}
 
+	if m.Royalty != nil {
+		if err := m.Royalty.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	if err := validateTraitSchema(m.TraitSchema); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -673,6 +917,12 @@ func (m MsgMintFT) ValidateBasic() error {
 		return err
 	}
 
+	if m.Vesting != nil {
+		if err := m.Vesting.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -731,6 +981,16 @@ func (m MsgMintNFT) ValidateBasic() error {
 		if err := validateMeta(param.Meta); err != nil {
 			return err
 		}
+
+		if err := validateTraits(param.Traits); err != nil {
+			return err
+		}
+	}
+
+	if m.Vesting != nil {
+		if err := m.Vesting.ValidateBasic(); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -965,6 +1225,12 @@ func (m MsgModify) ValidateBasic() error {
 	}
 
 	validator := validateTokenClassChange
+	if len(m.TokenType) != 0 && len(m.TokenIndex) == 0 {
+		validator = validateNFTClassChange
+	}
+	if len(m.TokenIndex) != 0 && ValidateNFTID(m.TokenType+m.TokenIndex) == nil {
+		validator = validateNFTChange
+	}
 	if len(m.TokenType) == 0 {
 		if len(m.TokenIndex) == 0 {
 			validator = validateContractChange
@@ -1291,3 +1557,157 @@ func (m MsgOperatorDetach) Route() string {
 func (m MsgOperatorDetach) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
 }
+
+var _ sdk.Msg = (*MsgSetNFTHistoryEnabled)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgSetNFTHistoryEnabled) ValidateBasic() error {
+	if err := ValidateContractID(m.ContractId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address: %s", m.Operator)
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgSetNFTHistoryEnabled) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Operator)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgSetNFTHistoryEnabled) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgSetNFTHistoryEnabled) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgSetNFTHistoryEnabled) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgProposeAdmin)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgProposeAdmin) ValidateBasic() error {
+	if err := ValidateContractID(m.ContractId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.From); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", m.From)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.To); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid proposed admin address: %s", m.To)
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgProposeAdmin) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.From)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgProposeAdmin) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgProposeAdmin) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgProposeAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgAcceptAdmin)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgAcceptAdmin) ValidateBasic() error {
+	if err := ValidateContractID(m.ContractId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.From); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid proposed admin address: %s", m.From)
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgAcceptAdmin) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.From)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgAcceptAdmin) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgAcceptAdmin) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgAcceptAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgRevokeGrant)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgRevokeGrant) ValidateBasic() error {
+	if err := ValidateContractID(m.ContractId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.From); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", m.From)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Grantee); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", m.Grantee)
+	}
+
+	if err := validateLegacyPermission(m.Permission); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgRevokeGrant) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.From)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgRevokeGrant) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgRevokeGrant) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgRevokeGrant) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -368,6 +369,264 @@ func TestMsgOperatorSendNFT(t *testing.T) {
 	}
 }
 
+func TestMsgMultiSendFT(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 3)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	amount := collection.NewCoins(
+		collection.NewFTCoin("00bab10c", sdk.OneInt()),
+	)
+	outputs := []collection.FTOutput{
+		{To: addrs[1].String(), Amount: amount},
+		{To: addrs[2].String(), Amount: amount},
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		from       sdk.AccAddress
+		outputs    []collection.FTOutput
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			outputs:    outputs,
+		},
+		"invalid contract id": {
+			from:    addrs[0],
+			outputs: outputs,
+			err:     class.ErrInvalidContractID,
+		},
+		"invalid from": {
+			contractID: "deadbeef",
+			outputs:    outputs,
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"empty outputs": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			err:        collection.ErrEmptyField,
+		},
+		"invalid to": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			outputs:    []collection.FTOutput{{Amount: amount}},
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"zero amount": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			outputs: []collection.FTOutput{{
+				To: addrs[1].String(),
+				Amount: []collection.Coin{{
+					TokenId: collection.NewFTID("00bab10c"),
+					Amount:  sdk.ZeroInt(),
+				}},
+			}},
+			err: collection.ErrInvalidAmount,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := collection.MsgMultiSendFT{
+				ContractId: tc.contractID,
+				From:       tc.from.String(),
+				Outputs:    tc.outputs,
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.from}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgMultiSendNFT(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 3)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	tokenIDs := []string{
+		collection.NewNFTID("deadbeef", 1),
+		collection.NewNFTID("deadbeef", 2),
+	}
+	outputs := []collection.NFTOutput{
+		{To: addrs[1].String(), TokenIds: tokenIDs[:1]},
+		{To: addrs[2].String(), TokenIds: tokenIDs[1:]},
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		from       sdk.AccAddress
+		outputs    []collection.NFTOutput
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			outputs:    outputs,
+		},
+		"invalid contract id": {
+			from:    addrs[0],
+			outputs: outputs,
+			err:     class.ErrInvalidContractID,
+		},
+		"invalid from": {
+			contractID: "deadbeef",
+			outputs:    outputs,
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"empty outputs": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			err:        collection.ErrEmptyField,
+		},
+		"invalid to": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			outputs:    []collection.NFTOutput{{TokenIds: tokenIDs}},
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"empty token ids": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			outputs:    []collection.NFTOutput{{To: addrs[1].String()}},
+			err:        collection.ErrEmptyField,
+		},
+		"invalid token id": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			outputs:    []collection.NFTOutput{{To: addrs[1].String(), TokenIds: []string{""}}},
+			err:        collection.ErrInvalidTokenID,
+		},
+		"duplicate token ids": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			outputs: []collection.NFTOutput{
+				{To: addrs[1].String(), TokenIds: tokenIDs[:1]},
+				{To: addrs[2].String(), TokenIds: tokenIDs[:1]},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := collection.MsgMultiSendNFT{
+				ContractId: tc.contractID,
+				From:       tc.from.String(),
+				Outputs:    tc.outputs,
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.from}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgSellNFT(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	tokenID := collection.NewNFTID("deadbeef", 1)
+	price := sdk.NewInt64Coin("stake", 100)
+
+	testCases := map[string]struct {
+		contractID string
+		seller     sdk.AccAddress
+		buyer      sdk.AccAddress
+		tokenID    string
+		price      sdk.Coin
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			seller:     addrs[0],
+			buyer:      addrs[1],
+			tokenID:    tokenID,
+			price:      price,
+		},
+		"invalid contract id": {
+			seller:  addrs[0],
+			buyer:   addrs[1],
+			tokenID: tokenID,
+			price:   price,
+			err:     class.ErrInvalidContractID,
+		},
+		"invalid seller": {
+			contractID: "deadbeef",
+			buyer:      addrs[1],
+			tokenID:    tokenID,
+			price:      price,
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"invalid buyer": {
+			contractID: "deadbeef",
+			seller:     addrs[0],
+			tokenID:    tokenID,
+			price:      price,
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"seller is buyer": {
+			contractID: "deadbeef",
+			seller:     addrs[0],
+			buyer:      addrs[0],
+			tokenID:    tokenID,
+			price:      price,
+			err:        sdkerrors.ErrInvalidRequest,
+		},
+		"invalid token id": {
+			contractID: "deadbeef",
+			seller:     addrs[0],
+			buyer:      addrs[1],
+			price:      price,
+			err:        collection.ErrInvalidTokenID,
+		},
+		"invalid price": {
+			contractID: "deadbeef",
+			seller:     addrs[0],
+			buyer:      addrs[1],
+			tokenID:    tokenID,
+			price:      sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)},
+			err:        sdkerrors.ErrInvalidCoins,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := collection.MsgSellNFT{
+				ContractId: tc.contractID,
+				Seller:     tc.seller.String(),
+				Buyer:      tc.buyer.String(),
+				TokenId:    tc.tokenID,
+				Price:      tc.price,
+			}
+
+			require.ErrorIs(t, msg.ValidateBasic(), tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.seller, tc.buyer}, msg.GetSigners())
+		})
+	}
+}
+
 func TestMsgAuthorizeOperator(t *testing.T) {
 	addrs := make([]sdk.AccAddress, 2)
 	for i := range addrs {
@@ -486,6 +745,7 @@ func TestMsgCreateContract(t *testing.T) {
 		name       string
 		baseImgURI string
 		meta       string
+		schema     []collection.TraitDefinition
 		err        error
 	}{
 		"valid msg": {
@@ -521,15 +781,31 @@ func TestMsgCreateContract(t *testing.T) {
 			meta:       string(make([]rune, 1001)),
 			err:        collection.ErrInvalidMetaLength,
 		},
+		"valid msg with trait schema": {
+			owner:      addrs[0],
+			name:       name,
+			baseImgURI: uri,
+			meta:       meta,
+			schema:     []collection.TraitDefinition{{Key: "color", Type: collection.TraitTypeString}},
+		},
+		"invalid trait key": {
+			owner:      addrs[0],
+			name:       name,
+			baseImgURI: uri,
+			meta:       meta,
+			schema:     []collection.TraitDefinition{{Key: "Color", Type: collection.TraitTypeString}},
+			err:        collection.ErrInvalidTrait,
+		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			msg := collection.MsgCreateContract{
-				Owner: tc.owner.String(),
-				Name:  tc.name,
-				Uri:   tc.baseImgURI,
-				Meta:  tc.meta,
+				Owner:       tc.owner.String(),
+				Name:        tc.name,
+				Uri:         tc.baseImgURI,
+				Meta:        tc.meta,
+				TraitSchema: tc.schema,
 			}
 
 			require.ErrorIs(t, msg.ValidateBasic(), tc.err)
@@ -685,6 +961,8 @@ func TestMsgIssueNFT(t *testing.T) {
 		operator   sdk.AccAddress
 		name       string
 		meta       string
+		royalty    *collection.Royalty
+		schema     []collection.TraitDefinition
 		err        error
 	}{
 		"valid msg": {
@@ -693,6 +971,13 @@ func TestMsgIssueNFT(t *testing.T) {
 			name:       name,
 			meta:       meta,
 		},
+		"valid msg with royalty": {
+			contractID: contractID,
+			operator:   addrs[0],
+			name:       name,
+			meta:       meta,
+			royalty:    &collection.Royalty{Recipient: addrs[0].String(), BasisPoints: 250},
+		},
 		"invalid contract id": {
 			operator: addrs[0],
 			name:     name,
@@ -719,15 +1004,67 @@ func TestMsgIssueNFT(t *testing.T) {
 			meta:       string(make([]rune, 1001)),
 			err:        collection.ErrInvalidMetaLength,
 		},
+		"invalid royalty recipient": {
+			contractID: contractID,
+			operator:   addrs[0],
+			name:       name,
+			meta:       meta,
+			royalty:    &collection.Royalty{BasisPoints: 250},
+			err:        collection.ErrInvalidRoyalty,
+		},
+		"zero royalty basis points": {
+			contractID: contractID,
+			operator:   addrs[0],
+			name:       name,
+			meta:       meta,
+			royalty:    &collection.Royalty{Recipient: addrs[0].String()},
+			err:        collection.ErrInvalidRoyalty,
+		},
+		"too large royalty basis points": {
+			contractID: contractID,
+			operator:   addrs[0],
+			name:       name,
+			meta:       meta,
+			royalty:    &collection.Royalty{Recipient: addrs[0].String(), BasisPoints: collection.MaxRoyaltyBasisPoints + 1},
+			err:        collection.ErrInvalidRoyalty,
+		},
+		"valid msg with trait schema": {
+			contractID: contractID,
+			operator:   addrs[0],
+			name:       name,
+			meta:       meta,
+			schema:     []collection.TraitDefinition{{Key: "level", Type: collection.TraitTypeInteger, Required: true}},
+		},
+		"trait of unspecified type": {
+			contractID: contractID,
+			operator:   addrs[0],
+			name:       name,
+			meta:       meta,
+			schema:     []collection.TraitDefinition{{Key: "level"}},
+			err:        collection.ErrInvalidTrait,
+		},
+		"duplicate traits": {
+			contractID: contractID,
+			operator:   addrs[0],
+			name:       name,
+			meta:       meta,
+			schema: []collection.TraitDefinition{
+				{Key: "level", Type: collection.TraitTypeInteger},
+				{Key: "level", Type: collection.TraitTypeString},
+			},
+			err: collection.ErrInvalidTrait,
+		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			msg := collection.MsgIssueNFT{
-				ContractId: tc.contractID,
-				Owner:      tc.operator.String(),
-				Name:       tc.name,
-				Meta:       tc.meta,
+				ContractId:  tc.contractID,
+				Owner:       tc.operator.String(),
+				Name:        tc.name,
+				Meta:        tc.meta,
+				Royalty:     tc.royalty,
+				TraitSchema: tc.schema,
 			}
 
 			require.ErrorIs(t, msg.ValidateBasic(), tc.err)
@@ -756,6 +1093,7 @@ func TestMsgMintFT(t *testing.T) {
 		operator   sdk.AccAddress
 		to         sdk.AccAddress
 		amount     []collection.Coin
+		vesting    *collection.VestingSchedule
 		err        error
 	}{
 		"valid msg": {
@@ -801,6 +1139,17 @@ func TestMsgMintFT(t *testing.T) {
 			}},
 			err: collection.ErrInvalidTokenID,
 		},
+		"invalid vesting": {
+			contractID: contractID,
+			operator:   addrs[0],
+			to:         addrs[1],
+			amount:     amount,
+			vesting: &collection.VestingSchedule{
+				StartTime: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+				EndTime:   time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC).Add(-time.Hour),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -810,6 +1159,7 @@ func TestMsgMintFT(t *testing.T) {
 				From:       tc.operator.String(),
 				To:         tc.to.String(),
 				Amount:     tc.amount,
+				Vesting:    tc.vesting,
 			}
 
 			require.ErrorIs(t, msg.ValidateBasic(), tc.err)
@@ -838,6 +1188,7 @@ func TestMsgMintNFT(t *testing.T) {
 		operator   sdk.AccAddress
 		to         sdk.AccAddress
 		params     []collection.MintNFTParam
+		vesting    *collection.VestingSchedule
 		err        error
 	}{
 		"valid msg": {
@@ -909,6 +1260,53 @@ func TestMsgMintNFT(t *testing.T) {
 			}},
 			err: collection.ErrInvalidMetaLength,
 		},
+		"param of invalid trait key": {
+			contractID: "deadbeef",
+			operator:   addrs[0],
+			to:         addrs[1],
+			params: []collection.MintNFTParam{{
+				TokenType: "deadbeef",
+				Name:      "tibetian fox",
+				Traits:    []collection.Attribute{{Key: "Color", Value: "red"}},
+			}},
+			err: collection.ErrInvalidTrait,
+		},
+		"param of empty trait value": {
+			contractID: "deadbeef",
+			operator:   addrs[0],
+			to:         addrs[1],
+			params: []collection.MintNFTParam{{
+				TokenType: "deadbeef",
+				Name:      "tibetian fox",
+				Traits:    []collection.Attribute{{Key: "color"}},
+			}},
+			err: collection.ErrInvalidTrait,
+		},
+		"param of duplicate traits": {
+			contractID: "deadbeef",
+			operator:   addrs[0],
+			to:         addrs[1],
+			params: []collection.MintNFTParam{{
+				TokenType: "deadbeef",
+				Name:      "tibetian fox",
+				Traits: []collection.Attribute{
+					{Key: "color", Value: "red"},
+					{Key: "color", Value: "blue"},
+				},
+			}},
+			err: collection.ErrInvalidTrait,
+		},
+		"invalid vesting": {
+			contractID: "deadbeef",
+			operator:   addrs[0],
+			to:         addrs[1],
+			params:     params,
+			vesting: &collection.VestingSchedule{
+				StartTime: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+				EndTime:   time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC).Add(-time.Hour),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -918,6 +1316,7 @@ func TestMsgMintNFT(t *testing.T) {
 				From:       tc.operator.String(),
 				To:         tc.to.String(),
 				Params:     tc.params,
+				Vesting:    tc.vesting,
 			}
 
 			require.ErrorIs(t, msg.ValidateBasic(), tc.err)
@@ -1245,6 +1644,55 @@ func TestMsgModify(t *testing.T) {
 			owner:      addrs[0],
 			changes:    changes,
 		},
+		"valid royalty modification": {
+			contractID: "deadbeef",
+			tokenType:  "deadbeef",
+			owner:      addrs[0],
+			changes: []collection.Attribute{
+				{Key: collection.AttributeKeyRoyaltyRecipient.String(), Value: addrs[0].String()},
+				{Key: collection.AttributeKeyRoyaltyBasisPoints.String(), Value: "250"},
+			},
+		},
+		"royalty modification on nft": {
+			contractID: "deadbeef",
+			tokenType:  "deadbeef",
+			tokenIndex: "deadbeef",
+			owner:      addrs[0],
+			changes:    []collection.Attribute{{Key: collection.AttributeKeyRoyaltyBasisPoints.String(), Value: "250"}},
+			err:        collection.ErrInvalidChangesField,
+		},
+		"invalid royalty basis points": {
+			contractID: "deadbeef",
+			tokenType:  "deadbeef",
+			owner:      addrs[0],
+			changes:    []collection.Attribute{{Key: collection.AttributeKeyRoyaltyBasisPoints.String(), Value: "10001"}},
+			err:        collection.ErrInvalidRoyalty,
+		},
+		"valid trait modification": {
+			contractID: "deadbeef",
+			tokenType:  "deadbeef",
+			tokenIndex: "deadbeef",
+			owner:      addrs[0],
+			changes: []collection.Attribute{
+				{Key: collection.TraitChangeKeyPrefix + "color", Value: "red"},
+				{Key: collection.TraitChangeKeyPrefix + "level", Value: ""},
+			},
+		},
+		"trait modification on token class": {
+			contractID: "deadbeef",
+			tokenType:  "deadbeef",
+			owner:      addrs[0],
+			changes:    []collection.Attribute{{Key: collection.TraitChangeKeyPrefix + "color", Value: "red"}},
+			err:        collection.ErrInvalidChangesField,
+		},
+		"invalid trait key": {
+			contractID: "deadbeef",
+			tokenType:  "deadbeef",
+			tokenIndex: "deadbeef",
+			owner:      addrs[0],
+			changes:    []collection.Attribute{{Key: collection.TraitChangeKeyPrefix + "Color", Value: "red"}},
+			err:        collection.ErrInvalidTrait,
+		},
 		"invalid contract id": {
 			owner:   addrs[0],
 			changes: changes,
@@ -1714,6 +2162,207 @@ func TestMsgOperatorDetach(t *testing.T) {
 	}
 }
 
+func TestMsgSetNFTHistoryEnabled(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 1)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		operator   sdk.AccAddress
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			operator:   addrs[0],
+		},
+		"invalid contract id": {
+			operator: addrs[0],
+			err:      class.ErrInvalidContractID,
+		},
+		"empty operator": {
+			contractID: "deadbeef",
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := collection.MsgSetNFTHistoryEnabled{
+				ContractId: tc.contractID,
+				Operator:   tc.operator.String(),
+				Enabled:    true,
+			}
+
+			require.ErrorIs(t, msg.ValidateBasic(), tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.operator}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgProposeAdmin(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		from       sdk.AccAddress
+		to         sdk.AccAddress
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			to:         addrs[1],
+		},
+		"invalid contract id": {
+			from: addrs[0],
+			to:   addrs[1],
+			err:  class.ErrInvalidContractID,
+		},
+		"invalid from": {
+			contractID: "deadbeef",
+			to:         addrs[1],
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"invalid to": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := collection.MsgProposeAdmin{
+				ContractId: tc.contractID,
+				From:       tc.from.String(),
+				To:         tc.to.String(),
+			}
+
+			require.ErrorIs(t, msg.ValidateBasic(), tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.from}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgAcceptAdmin(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 1)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		from       sdk.AccAddress
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+		},
+		"invalid contract id": {
+			from: addrs[0],
+			err:  class.ErrInvalidContractID,
+		},
+		"invalid from": {
+			contractID: "deadbeef",
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := collection.MsgAcceptAdmin{
+				ContractId: tc.contractID,
+				From:       tc.from.String(),
+			}
+
+			require.ErrorIs(t, msg.ValidateBasic(), tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.from}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgRevokeGrant(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		from       sdk.AccAddress
+		grantee    sdk.AccAddress
+		permission string
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			grantee:    addrs[1],
+			permission: collection.LegacyPermissionMint.String(),
+		},
+		"invalid contract id": {
+			from:       addrs[0],
+			grantee:    addrs[1],
+			permission: collection.LegacyPermissionMint.String(),
+			err:        class.ErrInvalidContractID,
+		},
+		"invalid from": {
+			contractID: "deadbeef",
+			grantee:    addrs[1],
+			permission: collection.LegacyPermissionMint.String(),
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"invalid grantee": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			permission: collection.LegacyPermissionMint.String(),
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"invalid permission": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			grantee:    addrs[1],
+			err:        sdkerrors.ErrInvalidPermission,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := collection.MsgRevokeGrant{
+				ContractId: tc.contractID,
+				From:       tc.from.String(),
+				Grantee:    tc.grantee.String(),
+				Permission: tc.permission,
+			}
+
+			require.ErrorIs(t, msg.ValidateBasic(), tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.from}, msg.GetSigners())
+		})
+	}
+}
+
 func TestAminoJSON(t *testing.T) {
 	tx := legacytx.StdTx{}
 	var contractId = "deadbeef"
@@ -1959,6 +2608,42 @@ func TestAminoJSON(t *testing.T) {
 			"/lbm.collection.v1.MsgOperatorDetach",
 			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgOperatorDetach\",\"value\":{\"contract_id\":\"deadbeef\",\"from\":\"%s\",\"operator\":\"%s\",\"token_id\":\"fee1dead00000001\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[1].String(), addrs[0].String()),
 		},
+		"MsgSetNFTHistoryEnabled": {
+			&collection.MsgSetNFTHistoryEnabled{
+				ContractId: contractId,
+				Operator:   addrs[0].String(),
+				Enabled:    true,
+			},
+			"/lbm.collection.v1.MsgSetNFTHistoryEnabled",
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSetNFTHistoryEnabled\",\"value\":{\"contract_id\":\"deadbeef\",\"enabled\":true,\"operator\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String()),
+		},
+		"MsgProposeAdmin": {
+			&collection.MsgProposeAdmin{
+				ContractId: contractId,
+				From:       addrs[0].String(),
+				To:         addrs[1].String(),
+			},
+			"/lbm.collection.v1.MsgProposeAdmin",
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/collection/MsgProposeAdmin\",\"value\":{\"contract_id\":\"deadbeef\",\"from\":\"%s\",\"to\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), addrs[1].String()),
+		},
+		"MsgAcceptAdmin": {
+			&collection.MsgAcceptAdmin{
+				ContractId: contractId,
+				From:       addrs[0].String(),
+			},
+			"/lbm.collection.v1.MsgAcceptAdmin",
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/collection/MsgAcceptAdmin\",\"value\":{\"contract_id\":\"deadbeef\",\"from\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String()),
+		},
+		"MsgRevokeGrant": {
+			&collection.MsgRevokeGrant{
+				ContractId: contractId,
+				From:       addrs[0].String(),
+				Grantee:    addrs[1].String(),
+				Permission: collection.LegacyPermissionMint.String(),
+			},
+			"/lbm.collection.v1.MsgRevokeGrant",
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/collection/MsgRevokeGrant\",\"value\":{\"contract_id\":\"deadbeef\",\"from\":\"%s\",\"grantee\":\"%s\",\"permission\":\"mint\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), addrs[1].String()),
+		},
 	}
 
 	for name, tc := range testCase {
@@ -8,10 +8,12 @@ import (
 	fmt "fmt"
 	types "github.com/Finschia/finschia-sdk/codec/types"
 	github_com_Finschia_finschia_sdk_types "github.com/Finschia/finschia-sdk/types"
+	types1 "github.com/Finschia/finschia-sdk/types"
 	query "github.com/Finschia/finschia-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/regen-network/cosmos-proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -261,6 +263,235 @@ func (m *QueryAllBalancesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QuerySpendableRequest is the request type for the Query/Spendable RPC method.
+type QuerySpendableRequest struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// address is the address to query the spendable balance for.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// token id associated with the token.
+	TokenId string `protobuf:"bytes,3,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+}
+
+func (m *QuerySpendableRequest) Reset()         { *m = QuerySpendableRequest{} }
+func (m *QuerySpendableRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySpendableRequest) ProtoMessage()    {}
+func (*QuerySpendableRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{4}
+}
+func (m *QuerySpendableRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpendableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpendableRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySpendableRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpendableRequest.Merge(m, src)
+}
+func (m *QuerySpendableRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpendableRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpendableRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySpendableRequest proto.InternalMessageInfo
+
+func (m *QuerySpendableRequest) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *QuerySpendableRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QuerySpendableRequest) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
+	}
+	return ""
+}
+
+// QuerySpendableResponse is the response type for the Query/Spendable RPC method.
+type QuerySpendableResponse struct {
+	// balance is the spendable balance of the token.
+	Balance Coin `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance"`
+}
+
+func (m *QuerySpendableResponse) Reset()         { *m = QuerySpendableResponse{} }
+func (m *QuerySpendableResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySpendableResponse) ProtoMessage()    {}
+func (*QuerySpendableResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{5}
+}
+func (m *QuerySpendableResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpendableResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpendableResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySpendableResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpendableResponse.Merge(m, src)
+}
+func (m *QuerySpendableResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpendableResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpendableResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySpendableResponse proto.InternalMessageInfo
+
+func (m *QuerySpendableResponse) GetBalance() Coin {
+	if m != nil {
+		return m.Balance
+	}
+	return Coin{}
+}
+
+// QueryLockedRequest is the request type for the Query/Locked RPC method.
+type QueryLockedRequest struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// address is the address to query the locked balance for.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// token id associated with the token.
+	TokenId string `protobuf:"bytes,3,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+}
+
+func (m *QueryLockedRequest) Reset()         { *m = QueryLockedRequest{} }
+func (m *QueryLockedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLockedRequest) ProtoMessage()    {}
+func (*QueryLockedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{6}
+}
+func (m *QueryLockedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLockedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLockedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLockedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockedRequest.Merge(m, src)
+}
+func (m *QueryLockedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLockedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLockedRequest proto.InternalMessageInfo
+
+func (m *QueryLockedRequest) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *QueryLockedRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryLockedRequest) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
+	}
+	return ""
+}
+
+// QueryLockedResponse is the response type for the Query/Locked RPC method.
+type QueryLockedResponse struct {
+	// balance is the locked balance of the token.
+	Balance Coin `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance"`
+	// locks of the token.
+	Locks []Lock `protobuf:"bytes,2,rep,name=locks,proto3" json:"locks"`
+}
+
+func (m *QueryLockedResponse) Reset()         { *m = QueryLockedResponse{} }
+func (m *QueryLockedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLockedResponse) ProtoMessage()    {}
+func (*QueryLockedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{7}
+}
+func (m *QueryLockedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLockedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLockedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLockedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockedResponse.Merge(m, src)
+}
+func (m *QueryLockedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLockedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLockedResponse proto.InternalMessageInfo
+
+func (m *QueryLockedResponse) GetBalance() Coin {
+	if m != nil {
+		return m.Balance
+	}
+	return Coin{}
+}
+
+func (m *QueryLockedResponse) GetLocks() []Lock {
+	if m != nil {
+		return m.Locks
+	}
+	return nil
+}
+
 // QueryFTSupplyRequest is the request type for the Query/FTSupply RPC method.
 type QueryFTSupplyRequest struct {
 	// contract id associated with the contract.
@@ -273,7 +504,7 @@ func (m *QueryFTSupplyRequest) Reset()         { *m = QueryFTSupplyRequest{} }
 func (m *QueryFTSupplyRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFTSupplyRequest) ProtoMessage()    {}
 func (*QueryFTSupplyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a09de688aac2ee73, []int{4}
+	return fileDescriptor_a09de688aac2ee73, []int{8}
 }
 func (m *QueryFTSupplyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -326,7 +557,7 @@ func (m *QueryFTSupplyResponse) Reset()         { *m = QueryFTSupplyResponse{} }
 func (m *QueryFTSupplyResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFTSupplyResponse) ProtoMessage()    {}
 func (*QueryFTSupplyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a09de688aac2ee73, []int{5}
+	return fileDescriptor_a09de688aac2ee73, []int{9}
 }
 func (m *QueryFTSupplyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -367,7 +598,7 @@ func (m *QueryFTMintedRequest) Reset()         { *m = QueryFTMintedRequest{} }
 func (m *QueryFTMintedRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFTMintedRequest) ProtoMessage()    {}
 func (*QueryFTMintedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a09de688aac2ee73, []int{6}
+	return fileDescriptor_a09de688aac2ee73, []int{10}
 }
 func (m *QueryFTMintedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -420,7 +651,7 @@ func (m *QueryFTMintedResponse) Reset()         { *m = QueryFTMintedResponse{} }
 func (m *QueryFTMintedResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFTMintedResponse) ProtoMessage()    {}
 func (*QueryFTMintedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a09de688aac2ee73, []int{7}
+	return fileDescriptor_a09de688aac2ee73, []int{11}
 }
 func (m *QueryFTMintedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -461,7 +692,7 @@ func (m *QueryFTBurntRequest) Reset()         { *m = QueryFTBurntRequest{} }
 func (m *QueryFTBurntRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFTBurntRequest) ProtoMessage()    {}
 func (*QueryFTBurntRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a09de688aac2ee73, []int{8}
+	return fileDescriptor_a09de688aac2ee73, []int{12}
 }
 func (m *QueryFTBurntRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -514,7 +745,7 @@ func (m *QueryFTBurntResponse) Reset()         { *m = QueryFTBurntResponse{} }
 func (m *QueryFTBurntResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFTBurntResponse) ProtoMessage()    {}
 func (*QueryFTBurntResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a09de688aac2ee73, []int{9}
+	return fileDescriptor_a09de688aac2ee73, []int{13}
 }
 func (m *QueryFTBurntResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -556,7 +787,7 @@ func (m *QueryNFTSupplyRequest) Reset()         { *m = QueryNFTSupplyRequest{} }
 func (m *QueryNFTSupplyRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryNFTSupplyRequest) ProtoMessage()    {}
 func (*QueryNFTSupplyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a09de688aac2ee73, []int{10}
+	return fileDescriptor_a09de688aac2ee73, []int{14}
 }
 func (m *QueryNFTSupplyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -609,7 +840,7 @@ func (m *QueryNFTSupplyResponse) Reset()         { *m = QueryNFTSupplyResponse{}
 func (m *QueryNFTSupplyResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryNFTSupplyResponse) ProtoMessage()    {}
 func (*QueryNFTSupplyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a09de688aac2ee73, []int{11}
+	return fileDescriptor_a09de688aac2ee73, []int{15}
 }
 func (m *QueryNFTSupplyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -651,7 +882,7 @@ func (m *QueryNFTMintedRequest) Reset()         { *m = QueryNFTMintedRequest{} }
 func (m *QueryNFTMintedRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryNFTMintedRequest) ProtoMessage()    {}
 func (*QueryNFTMintedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a09de688aac2ee73, []int{12}
+	return fileDescriptor_a09de688aac2ee73, []int{16}
 }
 func (m *QueryNFTMintedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -704,7 +935,7 @@ func (m *QueryNFTMintedResponse) Reset()         { *m = QueryNFTMintedResponse{}
 func (m *QueryNFTMintedResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryNFTMintedResponse) ProtoMessage()    {}
 func (*QueryNFTMintedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a09de688aac2ee73, []int{13}
+	return fileDescriptor_a09de688aac2ee73, []int{17}
 }
 func (m *QueryNFTMintedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -746,7 +977,7 @@ func (m *QueryNFTBurntRequest) Reset()         { *m = QueryNFTBurntRequest{} }
 func (m *QueryNFTBurntRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryNFTBurntRequest) ProtoMessage()    {}
 func (*QueryNFTBurntRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a09de688aac2ee73, []int{14}
+	return fileDescriptor_a09de688aac2ee73, []int{18}
 }
 func (m *QueryNFTBurntRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -799,7 +1030,7 @@ func (m *QueryNFTBurntResponse) Reset()         { *m = QueryNFTBurntResponse{} }
 func (m *QueryNFTBurntResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryNFTBurntResponse) ProtoMessage()    {}
 func (*QueryNFTBurntResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a09de688aac2ee73, []int{15}
+	return fileDescriptor_a09de688aac2ee73, []int{19}
 }
 func (m *QueryNFTBurntResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -838,7 +1069,7 @@ func (m *QueryContractRequest) Reset()         { *m = QueryContractRequest{} }
 func (m *QueryContractRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryContractRequest) ProtoMessage()    {}
 func (*QueryContractRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a09de688aac2ee73, []int{16}
+	return fileDescriptor_a09de688aac2ee73, []int{20}
 }
 func (m *QueryContractRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -878,13 +1109,15 @@ func (m *QueryContractRequest) GetContractId() string {
 type QueryContractResponse struct {
 	// contract is the information of the contract.
 	Contract Contract `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract"`
+	// history of the admin of the contract, in chronological order.
+	AdminHistory []AdminHistoryEntry `protobuf:"bytes,2,rep,name=admin_history,json=adminHistory,proto3" json:"admin_history"`
 }
 
 func (m *QueryContractResponse) Reset()         { *m = QueryContractResponse{} }
 func (m *QueryContractResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryContractResponse) ProtoMessage()    {}
 func (*QueryContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a09de688aac2ee73, []int{17}
+	return fileDescriptor_a09de688aac2ee73, []int{21}
 }
 func (m *QueryContractResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -920,28 +1153,31 @@ func (m *QueryContractResponse) GetContract() Contract {
 	return Contract{}
 }
 
-// QueryTokenClassTypeNameRequest is the request type for the Query/TokenClassTypeName RPC method.
-//
-// Since: 0.46.0 (finschia)
-type QueryTokenClassTypeNameRequest struct {
-	// contract id associated with the contract.
-	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	// class id associated with the token class.
-	ClassId string `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+func (m *QueryContractResponse) GetAdminHistory() []AdminHistoryEntry {
+	if m != nil {
+		return m.AdminHistory
+	}
+	return nil
 }
 
-func (m *QueryTokenClassTypeNameRequest) Reset()         { *m = QueryTokenClassTypeNameRequest{} }
-func (m *QueryTokenClassTypeNameRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryTokenClassTypeNameRequest) ProtoMessage()    {}
-func (*QueryTokenClassTypeNameRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a09de688aac2ee73, []int{18}
+// QueryContractsRequest is the request type for the Query/Contracts RPC method.
+type QueryContractsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (m *QueryTokenClassTypeNameRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryContractsRequest) Reset()         { *m = QueryContractsRequest{} }
+func (m *QueryContractsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractsRequest) ProtoMessage()    {}
+func (*QueryContractsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{22}
+}
+func (m *QueryContractsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTokenClassTypeNameRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryContractsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTokenClassTypeNameRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryContractsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -951,52 +1187,45 @@ func (m *QueryTokenClassTypeNameRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryTokenClassTypeNameRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTokenClassTypeNameRequest.Merge(m, src)
+func (m *QueryContractsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractsRequest.Merge(m, src)
 }
-func (m *QueryTokenClassTypeNameRequest) XXX_Size() int {
+func (m *QueryContractsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTokenClassTypeNameRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTokenClassTypeNameRequest.DiscardUnknown(m)
+func (m *QueryContractsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTokenClassTypeNameRequest proto.InternalMessageInfo
-
-func (m *QueryTokenClassTypeNameRequest) GetContractId() string {
-	if m != nil {
-		return m.ContractId
-	}
-	return ""
-}
+var xxx_messageInfo_QueryContractsRequest proto.InternalMessageInfo
 
-func (m *QueryTokenClassTypeNameRequest) GetClassId() string {
+func (m *QueryContractsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.ClassId
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-// QueryTokenClassTypeNameResponse is the response type for the Query/TokenClassTypeName RPC method.
-//
-// Since: 0.46.0 (finschia)
-type QueryTokenClassTypeNameResponse struct {
-	// type name of the token class.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+// QueryContractsResponse is the response type for the Query/Contracts RPC method.
+type QueryContractsResponse struct {
+	// information of the contracts.
+	Contracts []Contract `protobuf:"bytes,1,rep,name=contracts,proto3" json:"contracts"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryTokenClassTypeNameResponse) Reset()         { *m = QueryTokenClassTypeNameResponse{} }
-func (m *QueryTokenClassTypeNameResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryTokenClassTypeNameResponse) ProtoMessage()    {}
-func (*QueryTokenClassTypeNameResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a09de688aac2ee73, []int{19}
+func (m *QueryContractsResponse) Reset()         { *m = QueryContractsResponse{} }
+func (m *QueryContractsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractsResponse) ProtoMessage()    {}
+func (*QueryContractsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{23}
 }
-func (m *QueryTokenClassTypeNameResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryContractsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTokenClassTypeNameResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryContractsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTokenClassTypeNameResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryContractsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1006,46 +1235,52 @@ func (m *QueryTokenClassTypeNameResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryTokenClassTypeNameResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTokenClassTypeNameResponse.Merge(m, src)
+func (m *QueryContractsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractsResponse.Merge(m, src)
 }
-func (m *QueryTokenClassTypeNameResponse) XXX_Size() int {
+func (m *QueryContractsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTokenClassTypeNameResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTokenClassTypeNameResponse.DiscardUnknown(m)
+func (m *QueryContractsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTokenClassTypeNameResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryContractsResponse proto.InternalMessageInfo
 
-func (m *QueryTokenClassTypeNameResponse) GetName() string {
+func (m *QueryContractsResponse) GetContracts() []Contract {
 	if m != nil {
-		return m.Name
+		return m.Contracts
 	}
-	return ""
+	return nil
 }
 
-// QueryTokenTypeRequest is the request type for the Query/TokenType RPC method.
-type QueryTokenTypeRequest struct {
-	// contract id associated with the contract.
-	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	// token type associated with the token type.
-	// refer to TokenType for the definition.
-	TokenType string `protobuf:"bytes,2,opt,name=token_type,json=tokenType,proto3" json:"token_type,omitempty"`
+func (m *QueryContractsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryTokenTypeRequest) Reset()         { *m = QueryTokenTypeRequest{} }
-func (m *QueryTokenTypeRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryTokenTypeRequest) ProtoMessage()    {}
-func (*QueryTokenTypeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a09de688aac2ee73, []int{20}
+// QueryTokenClassesRequest is the request type for the Query/TokenClasses RPC method.
+type QueryTokenClassesRequest struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (m *QueryTokenTypeRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryTokenClassesRequest) Reset()         { *m = QueryTokenClassesRequest{} }
+func (m *QueryTokenClassesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenClassesRequest) ProtoMessage()    {}
+func (*QueryTokenClassesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{24}
+}
+func (m *QueryTokenClassesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTokenTypeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTokenClassesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTokenTypeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTokenClassesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1055,50 +1290,52 @@ func (m *QueryTokenTypeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *QueryTokenTypeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTokenTypeRequest.Merge(m, src)
+func (m *QueryTokenClassesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenClassesRequest.Merge(m, src)
 }
-func (m *QueryTokenTypeRequest) XXX_Size() int {
+func (m *QueryTokenClassesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTokenTypeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTokenTypeRequest.DiscardUnknown(m)
+func (m *QueryTokenClassesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenClassesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTokenTypeRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryTokenClassesRequest proto.InternalMessageInfo
 
-func (m *QueryTokenTypeRequest) GetContractId() string {
+func (m *QueryTokenClassesRequest) GetContractId() string {
 	if m != nil {
 		return m.ContractId
 	}
 	return ""
 }
 
-func (m *QueryTokenTypeRequest) GetTokenType() string {
+func (m *QueryTokenClassesRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.TokenType
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-// QueryTokenTypeResponse is the response type for the Query/TokenType RPC method.
-type QueryTokenTypeResponse struct {
-	// token type is the information of the token type.
-	TokenType TokenType `protobuf:"bytes,1,opt,name=token_type,json=tokenType,proto3" json:"token_type"`
+// QueryTokenClassesResponse is the response type for the Query/TokenClasses RPC method.
+type QueryTokenClassesResponse struct {
+	// information of the token classes.
+	Classes []types.Any `protobuf:"bytes,1,rep,name=classes,proto3" json:"classes"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryTokenTypeResponse) Reset()         { *m = QueryTokenTypeResponse{} }
-func (m *QueryTokenTypeResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryTokenTypeResponse) ProtoMessage()    {}
-func (*QueryTokenTypeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a09de688aac2ee73, []int{21}
+func (m *QueryTokenClassesResponse) Reset()         { *m = QueryTokenClassesResponse{} }
+func (m *QueryTokenClassesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenClassesResponse) ProtoMessage()    {}
+func (*QueryTokenClassesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{25}
 }
-func (m *QueryTokenTypeResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryTokenClassesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTokenTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTokenClassesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTokenTypeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTokenClassesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1108,45 +1345,55 @@ func (m *QueryTokenTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryTokenTypeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTokenTypeResponse.Merge(m, src)
+func (m *QueryTokenClassesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenClassesResponse.Merge(m, src)
 }
-func (m *QueryTokenTypeResponse) XXX_Size() int {
+func (m *QueryTokenClassesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTokenTypeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTokenTypeResponse.DiscardUnknown(m)
+func (m *QueryTokenClassesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenClassesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTokenTypeResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryTokenClassesResponse proto.InternalMessageInfo
 
-func (m *QueryTokenTypeResponse) GetTokenType() TokenType {
+func (m *QueryTokenClassesResponse) GetClasses() []types.Any {
 	if m != nil {
-		return m.TokenType
+		return m.Classes
 	}
-	return TokenType{}
+	return nil
 }
 
-// QueryTokenRequest is the request type for the Query/Token RPC method.
-type QueryTokenRequest struct {
+func (m *QueryTokenClassesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryNFTsByTypeRequest is the request type for the Query/NFTsByType RPC method.
+type QueryNFTsByTypeRequest struct {
 	// contract id associated with the contract.
 	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	// token id associated with the fungible token.
-	TokenId string `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// token type associated with the token type.
+	// refer to TokenType for the definition.
+	TokenType string `protobuf:"bytes,2,opt,name=token_type,json=tokenType,proto3" json:"token_type,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryTokenRequest) Reset()         { *m = QueryTokenRequest{} }
-func (m *QueryTokenRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryTokenRequest) ProtoMessage()    {}
-func (*QueryTokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a09de688aac2ee73, []int{22}
+func (m *QueryNFTsByTypeRequest) Reset()         { *m = QueryNFTsByTypeRequest{} }
+func (m *QueryNFTsByTypeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNFTsByTypeRequest) ProtoMessage()    {}
+func (*QueryNFTsByTypeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{26}
 }
-func (m *QueryTokenRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryNFTsByTypeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryNFTsByTypeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTokenRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryNFTsByTypeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1156,50 +1403,59 @@ func (m *QueryTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *QueryTokenRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTokenRequest.Merge(m, src)
+func (m *QueryNFTsByTypeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNFTsByTypeRequest.Merge(m, src)
 }
-func (m *QueryTokenRequest) XXX_Size() int {
+func (m *QueryNFTsByTypeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTokenRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTokenRequest.DiscardUnknown(m)
+func (m *QueryNFTsByTypeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNFTsByTypeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTokenRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryNFTsByTypeRequest proto.InternalMessageInfo
 
-func (m *QueryTokenRequest) GetContractId() string {
+func (m *QueryNFTsByTypeRequest) GetContractId() string {
 	if m != nil {
 		return m.ContractId
 	}
 	return ""
 }
 
-func (m *QueryTokenRequest) GetTokenId() string {
+func (m *QueryNFTsByTypeRequest) GetTokenType() string {
 	if m != nil {
-		return m.TokenId
+		return m.TokenType
 	}
 	return ""
 }
 
-// QueryTokenResponse is the response type for the Query/Token RPC method.
-type QueryTokenResponse struct {
-	// information of the token.
-	Token types.Any `protobuf:"bytes,1,opt,name=token,proto3" json:"token"`
+func (m *QueryNFTsByTypeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryTokenResponse) Reset()         { *m = QueryTokenResponse{} }
-func (m *QueryTokenResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryTokenResponse) ProtoMessage()    {}
-func (*QueryTokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a09de688aac2ee73, []int{23}
+// QueryNFTsByTypeResponse is the response type for the Query/NFTsByType RPC method.
+type QueryNFTsByTypeResponse struct {
+	// information of the non-fungible tokens.
+	Tokens []NFT `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (m *QueryTokenResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryNFTsByTypeResponse) Reset()         { *m = QueryNFTsByTypeResponse{} }
+func (m *QueryNFTsByTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNFTsByTypeResponse) ProtoMessage()    {}
+func (*QueryNFTsByTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{27}
+}
+func (m *QueryNFTsByTypeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryNFTsByTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTokenResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryNFTsByTypeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1209,45 +1465,54 @@ func (m *QueryTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryTokenResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTokenResponse.Merge(m, src)
+func (m *QueryNFTsByTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNFTsByTypeResponse.Merge(m, src)
 }
-func (m *QueryTokenResponse) XXX_Size() int {
+func (m *QueryNFTsByTypeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTokenResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTokenResponse.DiscardUnknown(m)
+func (m *QueryNFTsByTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNFTsByTypeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTokenResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryNFTsByTypeResponse proto.InternalMessageInfo
 
-func (m *QueryTokenResponse) GetToken() types.Any {
+func (m *QueryNFTsByTypeResponse) GetTokens() []NFT {
 	if m != nil {
-		return m.Token
+		return m.Tokens
 	}
-	return types.Any{}
+	return nil
 }
 
-// QueryRootRequest is the request type for the Query/Root RPC method.
-type QueryRootRequest struct {
+func (m *QueryNFTsByTypeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFTHoldersRequest is the request type for the Query/FTHolders RPC method.
+type QueryFTHoldersRequest struct {
 	// contract id associated with the contract.
 	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	// token id associated with the non-fungible token.
+	// token id associated with the fungible token.
 	TokenId string `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryRootRequest) Reset()         { *m = QueryRootRequest{} }
-func (m *QueryRootRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryRootRequest) ProtoMessage()    {}
-func (*QueryRootRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a09de688aac2ee73, []int{24}
+func (m *QueryFTHoldersRequest) Reset()         { *m = QueryFTHoldersRequest{} }
+func (m *QueryFTHoldersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFTHoldersRequest) ProtoMessage()    {}
+func (*QueryFTHoldersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{28}
 }
-func (m *QueryRootRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryFTHoldersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryRootRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryFTHoldersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryRootRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryFTHoldersRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1257,51 +1522,59 @@ func (m *QueryRootRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *QueryRootRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryRootRequest.Merge(m, src)
+func (m *QueryFTHoldersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFTHoldersRequest.Merge(m, src)
 }
-func (m *QueryRootRequest) XXX_Size() int {
+func (m *QueryFTHoldersRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryRootRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryRootRequest.DiscardUnknown(m)
+func (m *QueryFTHoldersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFTHoldersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryRootRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryFTHoldersRequest proto.InternalMessageInfo
 
-func (m *QueryRootRequest) GetContractId() string {
+func (m *QueryFTHoldersRequest) GetContractId() string {
 	if m != nil {
 		return m.ContractId
 	}
 	return ""
 }
 
-func (m *QueryRootRequest) GetTokenId() string {
+func (m *QueryFTHoldersRequest) GetTokenId() string {
 	if m != nil {
 		return m.TokenId
 	}
 	return ""
 }
 
-// QueryRootResponse is the response type for the Query/Root RPC method.
-type QueryRootResponse struct {
-	// root is the information of the root token.
-	// it would return itself if it's the root token.
-	Root NFT `protobuf:"bytes,1,opt,name=root,proto3" json:"root"`
+func (m *QueryFTHoldersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryRootResponse) Reset()         { *m = QueryRootResponse{} }
-func (m *QueryRootResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryRootResponse) ProtoMessage()    {}
-func (*QueryRootResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a09de688aac2ee73, []int{25}
+// QueryFTHoldersResponse is the response type for the Query/FTHolders RPC method.
+type QueryFTHoldersResponse struct {
+	// holders of the token with their balances.
+	Holders []Holder `protobuf:"bytes,1,rep,name=holders,proto3" json:"holders"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (m *QueryRootResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryFTHoldersResponse) Reset()         { *m = QueryFTHoldersResponse{} }
+func (m *QueryFTHoldersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFTHoldersResponse) ProtoMessage()    {}
+func (*QueryFTHoldersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{29}
+}
+func (m *QueryFTHoldersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryRootResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryFTHoldersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryRootResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryFTHoldersResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1311,45 +1584,52 @@ func (m *QueryRootResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *QueryRootResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryRootResponse.Merge(m, src)
+func (m *QueryFTHoldersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFTHoldersResponse.Merge(m, src)
 }
-func (m *QueryRootResponse) XXX_Size() int {
+func (m *QueryFTHoldersResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryRootResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryRootResponse.DiscardUnknown(m)
+func (m *QueryFTHoldersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFTHoldersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryRootResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryFTHoldersResponse proto.InternalMessageInfo
 
-func (m *QueryRootResponse) GetRoot() NFT {
+func (m *QueryFTHoldersResponse) GetHolders() []Holder {
 	if m != nil {
-		return m.Root
+		return m.Holders
 	}
-	return NFT{}
+	return nil
 }
 
-// QueryHasParentRequest is the request type for the Query/HasParent RPC method.
-type QueryHasParentRequest struct {
-	// contract id associated with the contract.
-	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	// token id associated wit the non-fungible token.
-	TokenId string `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+func (m *QueryFTHoldersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryHasParentRequest) Reset()         { *m = QueryHasParentRequest{} }
-func (m *QueryHasParentRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryHasParentRequest) ProtoMessage()    {}
-func (*QueryHasParentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a09de688aac2ee73, []int{26}
+// QueryOwnerNFTsRequest is the request type for the Query/OwnerNFTs RPC method.
+type QueryOwnerNFTsRequest struct {
+	// address of the owner.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (m *QueryHasParentRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryOwnerNFTsRequest) Reset()         { *m = QueryOwnerNFTsRequest{} }
+func (m *QueryOwnerNFTsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOwnerNFTsRequest) ProtoMessage()    {}
+func (*QueryOwnerNFTsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{30}
+}
+func (m *QueryOwnerNFTsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryHasParentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryOwnerNFTsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryHasParentRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryOwnerNFTsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1359,50 +1639,52 @@ func (m *QueryHasParentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *QueryHasParentRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryHasParentRequest.Merge(m, src)
+func (m *QueryOwnerNFTsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOwnerNFTsRequest.Merge(m, src)
 }
-func (m *QueryHasParentRequest) XXX_Size() int {
+func (m *QueryOwnerNFTsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryHasParentRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryHasParentRequest.DiscardUnknown(m)
+func (m *QueryOwnerNFTsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOwnerNFTsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryHasParentRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryOwnerNFTsRequest proto.InternalMessageInfo
 
-func (m *QueryHasParentRequest) GetContractId() string {
+func (m *QueryOwnerNFTsRequest) GetOwner() string {
 	if m != nil {
-		return m.ContractId
+		return m.Owner
 	}
 	return ""
 }
 
-func (m *QueryHasParentRequest) GetTokenId() string {
+func (m *QueryOwnerNFTsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.TokenId
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-// QueryHasParentResponse is the response type for the Query/HasParent RPC method.
-type QueryHasParentResponse struct {
-	// whether the token has its parent.
-	HasParent bool `protobuf:"varint,1,opt,name=has_parent,json=hasParent,proto3" json:"has_parent,omitempty"`
+// QueryOwnerNFTsResponse is the response type for the Query/OwnerNFTs RPC method.
+type QueryOwnerNFTsResponse struct {
+	// non-fungible tokens held by the owner.
+	Nfts []OwnedNFT `protobuf:"bytes,1,rep,name=nfts,proto3" json:"nfts"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryHasParentResponse) Reset()         { *m = QueryHasParentResponse{} }
-func (m *QueryHasParentResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryHasParentResponse) ProtoMessage()    {}
-func (*QueryHasParentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a09de688aac2ee73, []int{27}
+func (m *QueryOwnerNFTsResponse) Reset()         { *m = QueryOwnerNFTsResponse{} }
+func (m *QueryOwnerNFTsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOwnerNFTsResponse) ProtoMessage()    {}
+func (*QueryOwnerNFTsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{31}
 }
-func (m *QueryHasParentResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryOwnerNFTsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryHasParentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryOwnerNFTsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryHasParentResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryOwnerNFTsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1412,45 +1694,57 @@ func (m *QueryHasParentResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryHasParentResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryHasParentResponse.Merge(m, src)
+func (m *QueryOwnerNFTsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOwnerNFTsResponse.Merge(m, src)
 }
-func (m *QueryHasParentResponse) XXX_Size() int {
+func (m *QueryOwnerNFTsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryHasParentResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryHasParentResponse.DiscardUnknown(m)
+func (m *QueryOwnerNFTsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOwnerNFTsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryHasParentResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryOwnerNFTsResponse proto.InternalMessageInfo
 
-func (m *QueryHasParentResponse) GetHasParent() bool {
+func (m *QueryOwnerNFTsResponse) GetNfts() []OwnedNFT {
 	if m != nil {
-		return m.HasParent
+		return m.Nfts
 	}
-	return false
+	return nil
 }
 
-// QueryParentRequest is the request type for the Query/Parent RPC method.
-type QueryParentRequest struct {
+func (m *QueryOwnerNFTsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryNFTsByTraitRequest is the request type for the Query/NFTsByTrait RPC method.
+type QueryNFTsByTraitRequest struct {
 	// contract id associated with the contract.
 	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	// token id associated wit the non-fungible token.
-	TokenId string `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// key of the trait.
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// value of the trait.
+	// Note: integers and booleans must be in their canonical forms.
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParentRequest) Reset()         { *m = QueryParentRequest{} }
-func (m *QueryParentRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParentRequest) ProtoMessage()    {}
-func (*QueryParentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a09de688aac2ee73, []int{28}
+func (m *QueryNFTsByTraitRequest) Reset()         { *m = QueryNFTsByTraitRequest{} }
+func (m *QueryNFTsByTraitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNFTsByTraitRequest) ProtoMessage()    {}
+func (*QueryNFTsByTraitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{32}
 }
-func (m *QueryParentRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryNFTsByTraitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryNFTsByTraitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParentRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryNFTsByTraitRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1460,50 +1754,66 @@ func (m *QueryParentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParentRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParentRequest.Merge(m, src)
+func (m *QueryNFTsByTraitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNFTsByTraitRequest.Merge(m, src)
 }
-func (m *QueryParentRequest) XXX_Size() int {
+func (m *QueryNFTsByTraitRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParentRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParentRequest.DiscardUnknown(m)
+func (m *QueryNFTsByTraitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNFTsByTraitRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParentRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryNFTsByTraitRequest proto.InternalMessageInfo
 
-func (m *QueryParentRequest) GetContractId() string {
+func (m *QueryNFTsByTraitRequest) GetContractId() string {
 	if m != nil {
 		return m.ContractId
 	}
 	return ""
 }
 
-func (m *QueryParentRequest) GetTokenId() string {
+func (m *QueryNFTsByTraitRequest) GetKey() string {
 	if m != nil {
-		return m.TokenId
+		return m.Key
 	}
 	return ""
 }
 
-// QueryParentResponse is the response type for the Query/Parent RPC method.
-type QueryParentResponse struct {
-	// parent is the information of the parent token.
-	Parent NFT `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent"`
+func (m *QueryNFTsByTraitRequest) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
 }
 
-func (m *QueryParentResponse) Reset()         { *m = QueryParentResponse{} }
-func (m *QueryParentResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParentResponse) ProtoMessage()    {}
-func (*QueryParentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a09de688aac2ee73, []int{29}
+func (m *QueryNFTsByTraitRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (m *QueryParentResponse) XXX_Unmarshal(b []byte) error {
+
+// QueryNFTsByTraitResponse is the response type for the Query/NFTsByTrait RPC method.
+type QueryNFTsByTraitResponse struct {
+	// information of the non-fungible tokens.
+	Tokens []NFT `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryNFTsByTraitResponse) Reset()         { *m = QueryNFTsByTraitResponse{} }
+func (m *QueryNFTsByTraitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNFTsByTraitResponse) ProtoMessage()    {}
+func (*QueryNFTsByTraitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{33}
+}
+func (m *QueryNFTsByTraitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryNFTsByTraitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParentResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryNFTsByTraitResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1513,47 +1823,54 @@ func (m *QueryParentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParentResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParentResponse.Merge(m, src)
+func (m *QueryNFTsByTraitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNFTsByTraitResponse.Merge(m, src)
 }
-func (m *QueryParentResponse) XXX_Size() int {
+func (m *QueryNFTsByTraitResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParentResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParentResponse.DiscardUnknown(m)
+func (m *QueryNFTsByTraitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNFTsByTraitResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParentResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryNFTsByTraitResponse proto.InternalMessageInfo
 
-func (m *QueryParentResponse) GetParent() NFT {
+func (m *QueryNFTsByTraitResponse) GetTokens() []NFT {
 	if m != nil {
-		return m.Parent
+		return m.Tokens
 	}
-	return NFT{}
+	return nil
 }
 
-// QueryChildrenRequest is the request type for the Query/Children RPC method.
-type QueryChildrenRequest struct {
+func (m *QueryNFTsByTraitResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTokenClassTypeNameRequest is the request type for the Query/TokenClassTypeName RPC method.
+//
+// Since: 0.46.0 (finschia)
+type QueryTokenClassTypeNameRequest struct {
 	// contract id associated with the contract.
 	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	// token id associated with the non-fungible token.
-	TokenId string `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
-	// pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// class id associated with the token class.
+	ClassId string `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
 }
 
-func (m *QueryChildrenRequest) Reset()         { *m = QueryChildrenRequest{} }
-func (m *QueryChildrenRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryChildrenRequest) ProtoMessage()    {}
-func (*QueryChildrenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a09de688aac2ee73, []int{30}
+func (m *QueryTokenClassTypeNameRequest) Reset()         { *m = QueryTokenClassTypeNameRequest{} }
+func (m *QueryTokenClassTypeNameRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenClassTypeNameRequest) ProtoMessage()    {}
+func (*QueryTokenClassTypeNameRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{34}
 }
-func (m *QueryChildrenRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryTokenClassTypeNameRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryChildrenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTokenClassTypeNameRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryChildrenRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTokenClassTypeNameRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1563,59 +1880,52 @@ func (m *QueryChildrenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *QueryChildrenRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryChildrenRequest.Merge(m, src)
+func (m *QueryTokenClassTypeNameRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenClassTypeNameRequest.Merge(m, src)
 }
-func (m *QueryChildrenRequest) XXX_Size() int {
+func (m *QueryTokenClassTypeNameRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryChildrenRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryChildrenRequest.DiscardUnknown(m)
+func (m *QueryTokenClassTypeNameRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenClassTypeNameRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryChildrenRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryTokenClassTypeNameRequest proto.InternalMessageInfo
 
-func (m *QueryChildrenRequest) GetContractId() string {
+func (m *QueryTokenClassTypeNameRequest) GetContractId() string {
 	if m != nil {
 		return m.ContractId
 	}
 	return ""
 }
 
-func (m *QueryChildrenRequest) GetTokenId() string {
+func (m *QueryTokenClassTypeNameRequest) GetClassId() string {
 	if m != nil {
-		return m.TokenId
+		return m.ClassId
 	}
 	return ""
 }
 
-func (m *QueryChildrenRequest) GetPagination() *query.PageRequest {
-	if m != nil {
-		return m.Pagination
-	}
-	return nil
-}
-
-// QueryChildrenResponse is the response type for the Query/Children RPC method.
-type QueryChildrenResponse struct {
-	// children is the information of the child tokens.
-	Children []NFT `protobuf:"bytes,1,rep,name=children,proto3" json:"children"`
-	// pagination defines the pagination in the response.
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryTokenClassTypeNameResponse is the response type for the Query/TokenClassTypeName RPC method.
+//
+// Since: 0.46.0 (finschia)
+type QueryTokenClassTypeNameResponse struct {
+	// type name of the token class.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *QueryChildrenResponse) Reset()         { *m = QueryChildrenResponse{} }
-func (m *QueryChildrenResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryChildrenResponse) ProtoMessage()    {}
-func (*QueryChildrenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a09de688aac2ee73, []int{31}
+func (m *QueryTokenClassTypeNameResponse) Reset()         { *m = QueryTokenClassTypeNameResponse{} }
+func (m *QueryTokenClassTypeNameResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenClassTypeNameResponse) ProtoMessage()    {}
+func (*QueryTokenClassTypeNameResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{35}
 }
-func (m *QueryChildrenResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryTokenClassTypeNameResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryChildrenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTokenClassTypeNameResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryChildrenResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTokenClassTypeNameResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1625,54 +1935,46 @@ func (m *QueryChildrenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *QueryChildrenResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryChildrenResponse.Merge(m, src)
+func (m *QueryTokenClassTypeNameResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenClassTypeNameResponse.Merge(m, src)
 }
-func (m *QueryChildrenResponse) XXX_Size() int {
+func (m *QueryTokenClassTypeNameResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryChildrenResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryChildrenResponse.DiscardUnknown(m)
+func (m *QueryTokenClassTypeNameResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenClassTypeNameResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryChildrenResponse proto.InternalMessageInfo
-
-func (m *QueryChildrenResponse) GetChildren() []NFT {
-	if m != nil {
-		return m.Children
-	}
-	return nil
-}
+var xxx_messageInfo_QueryTokenClassTypeNameResponse proto.InternalMessageInfo
 
-func (m *QueryChildrenResponse) GetPagination() *query.PageResponse {
+func (m *QueryTokenClassTypeNameResponse) GetName() string {
 	if m != nil {
-		return m.Pagination
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-// QueryGranteeGrantsRequest is the request type for the Query/GranteeGrants RPC method.
-type QueryGranteeGrantsRequest struct {
+// QueryTokenTypeRequest is the request type for the Query/TokenType RPC method.
+type QueryTokenTypeRequest struct {
 	// contract id associated with the contract.
 	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	// the address of the grantee.
-	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
-	// pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// token type associated with the token type.
+	// refer to TokenType for the definition.
+	TokenType string `protobuf:"bytes,2,opt,name=token_type,json=tokenType,proto3" json:"token_type,omitempty"`
 }
 
-func (m *QueryGranteeGrantsRequest) Reset()         { *m = QueryGranteeGrantsRequest{} }
-func (m *QueryGranteeGrantsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGranteeGrantsRequest) ProtoMessage()    {}
-func (*QueryGranteeGrantsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a09de688aac2ee73, []int{32}
+func (m *QueryTokenTypeRequest) Reset()         { *m = QueryTokenTypeRequest{} }
+func (m *QueryTokenTypeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenTypeRequest) ProtoMessage()    {}
+func (*QueryTokenTypeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{36}
 }
-func (m *QueryGranteeGrantsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryTokenTypeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGranteeGrantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTokenTypeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGranteeGrantsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTokenTypeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1682,58 +1984,50 @@ func (m *QueryGranteeGrantsRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryGranteeGrantsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGranteeGrantsRequest.Merge(m, src)
+func (m *QueryTokenTypeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenTypeRequest.Merge(m, src)
 }
-func (m *QueryGranteeGrantsRequest) XXX_Size() int {
+func (m *QueryTokenTypeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGranteeGrantsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGranteeGrantsRequest.DiscardUnknown(m)
+func (m *QueryTokenTypeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenTypeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGranteeGrantsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryTokenTypeRequest proto.InternalMessageInfo
 
-func (m *QueryGranteeGrantsRequest) GetContractId() string {
+func (m *QueryTokenTypeRequest) GetContractId() string {
 	if m != nil {
 		return m.ContractId
 	}
 	return ""
 }
 
-func (m *QueryGranteeGrantsRequest) GetGrantee() string {
+func (m *QueryTokenTypeRequest) GetTokenType() string {
 	if m != nil {
-		return m.Grantee
+		return m.TokenType
 	}
 	return ""
 }
 
-func (m *QueryGranteeGrantsRequest) GetPagination() *query.PageRequest {
-	if m != nil {
-		return m.Pagination
-	}
-	return nil
-}
-
-// QueryGranteeGrantsResponse is the response type for the Query/GranteeGrants RPC method.
-type QueryGranteeGrantsResponse struct {
-	Grants []Grant `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants"`
-	// pagination defines the pagination in the response.
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryTokenTypeResponse is the response type for the Query/TokenType RPC method.
+type QueryTokenTypeResponse struct {
+	// token type is the information of the token type.
+	TokenType TokenType `protobuf:"bytes,1,opt,name=token_type,json=tokenType,proto3" json:"token_type"`
 }
 
-func (m *QueryGranteeGrantsResponse) Reset()         { *m = QueryGranteeGrantsResponse{} }
-func (m *QueryGranteeGrantsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGranteeGrantsResponse) ProtoMessage()    {}
-func (*QueryGranteeGrantsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a09de688aac2ee73, []int{33}
+func (m *QueryTokenTypeResponse) Reset()         { *m = QueryTokenTypeResponse{} }
+func (m *QueryTokenTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenTypeResponse) ProtoMessage()    {}
+func (*QueryTokenTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{37}
 }
-func (m *QueryGranteeGrantsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryTokenTypeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGranteeGrantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTokenTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGranteeGrantsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTokenTypeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1743,54 +2037,45 @@ func (m *QueryGranteeGrantsResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryGranteeGrantsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGranteeGrantsResponse.Merge(m, src)
+func (m *QueryTokenTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenTypeResponse.Merge(m, src)
 }
-func (m *QueryGranteeGrantsResponse) XXX_Size() int {
+func (m *QueryTokenTypeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGranteeGrantsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGranteeGrantsResponse.DiscardUnknown(m)
+func (m *QueryTokenTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenTypeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGranteeGrantsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryTokenTypeResponse proto.InternalMessageInfo
 
-func (m *QueryGranteeGrantsResponse) GetGrants() []Grant {
+func (m *QueryTokenTypeResponse) GetTokenType() TokenType {
 	if m != nil {
-		return m.Grants
+		return m.TokenType
 	}
-	return nil
-}
-
-func (m *QueryGranteeGrantsResponse) GetPagination() *query.PageResponse {
-	if m != nil {
-		return m.Pagination
-	}
-	return nil
+	return TokenType{}
 }
 
-// QueryIsOperatorForRequest is the request type for the Query/IsOperatorFor RPC method.
-type QueryIsOperatorForRequest struct {
+// QueryTokenRequest is the request type for the Query/Token RPC method.
+type QueryTokenRequest struct {
 	// contract id associated with the contract.
 	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	// the address of the operator.
-	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
-	// the address of the token holder.
-	Holder string `protobuf:"bytes,3,opt,name=holder,proto3" json:"holder,omitempty"`
+	// token id associated with the fungible token.
+	TokenId string `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
 }
 
-func (m *QueryIsOperatorForRequest) Reset()         { *m = QueryIsOperatorForRequest{} }
-func (m *QueryIsOperatorForRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryIsOperatorForRequest) ProtoMessage()    {}
-func (*QueryIsOperatorForRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a09de688aac2ee73, []int{34}
+func (m *QueryTokenRequest) Reset()         { *m = QueryTokenRequest{} }
+func (m *QueryTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenRequest) ProtoMessage()    {}
+func (*QueryTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{38}
 }
-func (m *QueryIsOperatorForRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryTokenRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryIsOperatorForRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryIsOperatorForRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTokenRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1800,56 +2085,50 @@ func (m *QueryIsOperatorForRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryIsOperatorForRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryIsOperatorForRequest.Merge(m, src)
+func (m *QueryTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenRequest.Merge(m, src)
 }
-func (m *QueryIsOperatorForRequest) XXX_Size() int {
+func (m *QueryTokenRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryIsOperatorForRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryIsOperatorForRequest.DiscardUnknown(m)
+func (m *QueryTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryIsOperatorForRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryTokenRequest proto.InternalMessageInfo
 
-func (m *QueryIsOperatorForRequest) GetContractId() string {
+func (m *QueryTokenRequest) GetContractId() string {
 	if m != nil {
 		return m.ContractId
 	}
 	return ""
 }
 
-func (m *QueryIsOperatorForRequest) GetOperator() string {
-	if m != nil {
-		return m.Operator
-	}
-	return ""
-}
-
-func (m *QueryIsOperatorForRequest) GetHolder() string {
+func (m *QueryTokenRequest) GetTokenId() string {
 	if m != nil {
-		return m.Holder
+		return m.TokenId
 	}
 	return ""
 }
 
-// QueryIsOperatorForResponse is the response type for the Query/IsOperatorFor RPC method.
-type QueryIsOperatorForResponse struct {
-	Authorized bool `protobuf:"varint,1,opt,name=authorized,proto3" json:"authorized,omitempty"`
+// QueryTokenResponse is the response type for the Query/Token RPC method.
+type QueryTokenResponse struct {
+	// information of the token.
+	Token types.Any `protobuf:"bytes,1,opt,name=token,proto3" json:"token"`
 }
 
-func (m *QueryIsOperatorForResponse) Reset()         { *m = QueryIsOperatorForResponse{} }
-func (m *QueryIsOperatorForResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryIsOperatorForResponse) ProtoMessage()    {}
-func (*QueryIsOperatorForResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a09de688aac2ee73, []int{35}
+func (m *QueryTokenResponse) Reset()         { *m = QueryTokenResponse{} }
+func (m *QueryTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenResponse) ProtoMessage()    {}
+func (*QueryTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{39}
 }
-func (m *QueryIsOperatorForResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryTokenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryIsOperatorForResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryIsOperatorForResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTokenResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1859,47 +2138,45 @@ func (m *QueryIsOperatorForResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryIsOperatorForResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryIsOperatorForResponse.Merge(m, src)
+func (m *QueryTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenResponse.Merge(m, src)
 }
-func (m *QueryIsOperatorForResponse) XXX_Size() int {
+func (m *QueryTokenResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryIsOperatorForResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryIsOperatorForResponse.DiscardUnknown(m)
+func (m *QueryTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryIsOperatorForResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryTokenResponse proto.InternalMessageInfo
 
-func (m *QueryIsOperatorForResponse) GetAuthorized() bool {
+func (m *QueryTokenResponse) GetToken() types.Any {
 	if m != nil {
-		return m.Authorized
+		return m.Token
 	}
-	return false
+	return types.Any{}
 }
 
-// QueryHoldersByOperatorRequest is the request type for the Query/HoldersByOperator RPC method.
-type QueryHoldersByOperatorRequest struct {
+// QueryRootRequest is the request type for the Query/Root RPC method.
+type QueryRootRequest struct {
 	// contract id associated with the contract.
 	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	// address of the operator.
-	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
-	// pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// token id associated with the non-fungible token.
+	TokenId string `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
 }
 
-func (m *QueryHoldersByOperatorRequest) Reset()         { *m = QueryHoldersByOperatorRequest{} }
-func (m *QueryHoldersByOperatorRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryHoldersByOperatorRequest) ProtoMessage()    {}
-func (*QueryHoldersByOperatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a09de688aac2ee73, []int{36}
+func (m *QueryRootRequest) Reset()         { *m = QueryRootRequest{} }
+func (m *QueryRootRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRootRequest) ProtoMessage()    {}
+func (*QueryRootRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{40}
 }
-func (m *QueryHoldersByOperatorRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryRootRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryHoldersByOperatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRootRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryHoldersByOperatorRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRootRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1909,58 +2186,99 @@ func (m *QueryHoldersByOperatorRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryHoldersByOperatorRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryHoldersByOperatorRequest.Merge(m, src)
+func (m *QueryRootRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRootRequest.Merge(m, src)
 }
-func (m *QueryHoldersByOperatorRequest) XXX_Size() int {
+func (m *QueryRootRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryHoldersByOperatorRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryHoldersByOperatorRequest.DiscardUnknown(m)
+func (m *QueryRootRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRootRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryHoldersByOperatorRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryRootRequest proto.InternalMessageInfo
 
-func (m *QueryHoldersByOperatorRequest) GetContractId() string {
+func (m *QueryRootRequest) GetContractId() string {
 	if m != nil {
 		return m.ContractId
 	}
 	return ""
 }
 
-func (m *QueryHoldersByOperatorRequest) GetOperator() string {
+func (m *QueryRootRequest) GetTokenId() string {
 	if m != nil {
-		return m.Operator
+		return m.TokenId
 	}
 	return ""
 }
 
-func (m *QueryHoldersByOperatorRequest) GetPagination() *query.PageRequest {
+// QueryRootResponse is the response type for the Query/Root RPC method.
+type QueryRootResponse struct {
+	// root is the information of the root token.
+	// it would return itself if it's the root token.
+	Root NFT `protobuf:"bytes,1,opt,name=root,proto3" json:"root"`
+}
+
+func (m *QueryRootResponse) Reset()         { *m = QueryRootResponse{} }
+func (m *QueryRootResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRootResponse) ProtoMessage()    {}
+func (*QueryRootResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{41}
+}
+func (m *QueryRootResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRootResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRootResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRootResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRootResponse.Merge(m, src)
+}
+func (m *QueryRootResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRootResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRootResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRootResponse proto.InternalMessageInfo
+
+func (m *QueryRootResponse) GetRoot() NFT {
 	if m != nil {
-		return m.Pagination
+		return m.Root
 	}
-	return nil
+	return NFT{}
 }
 
-// QueryHoldersByOperatorResponse is the response type for the Query/HoldersByOperator RPC method.
-type QueryHoldersByOperatorResponse struct {
-	Holders []string `protobuf:"bytes,1,rep,name=holders,proto3" json:"holders,omitempty"`
-	// pagination defines the pagination in the response.
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryHasParentRequest is the request type for the Query/HasParent RPC method.
+type QueryHasParentRequest struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// token id associated wit the non-fungible token.
+	TokenId string `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
 }
 
-func (m *QueryHoldersByOperatorResponse) Reset()         { *m = QueryHoldersByOperatorResponse{} }
-func (m *QueryHoldersByOperatorResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryHoldersByOperatorResponse) ProtoMessage()    {}
-func (*QueryHoldersByOperatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a09de688aac2ee73, []int{37}
+func (m *QueryHasParentRequest) Reset()         { *m = QueryHasParentRequest{} }
+func (m *QueryHasParentRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHasParentRequest) ProtoMessage()    {}
+func (*QueryHasParentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{42}
 }
-func (m *QueryHoldersByOperatorResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryHasParentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryHoldersByOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryHasParentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryHoldersByOperatorResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryHasParentRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1970,2121 +2288,2373 @@ func (m *QueryHoldersByOperatorResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryHoldersByOperatorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryHoldersByOperatorResponse.Merge(m, src)
+func (m *QueryHasParentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHasParentRequest.Merge(m, src)
 }
-func (m *QueryHoldersByOperatorResponse) XXX_Size() int {
+func (m *QueryHasParentRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryHoldersByOperatorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryHoldersByOperatorResponse.DiscardUnknown(m)
+func (m *QueryHasParentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHasParentRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryHoldersByOperatorResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryHasParentRequest proto.InternalMessageInfo
 
-func (m *QueryHoldersByOperatorResponse) GetHolders() []string {
+func (m *QueryHasParentRequest) GetContractId() string {
 	if m != nil {
-		return m.Holders
+		return m.ContractId
 	}
-	return nil
+	return ""
 }
 
-func (m *QueryHoldersByOperatorResponse) GetPagination() *query.PageResponse {
+func (m *QueryHasParentRequest) GetTokenId() string {
 	if m != nil {
-		return m.Pagination
+		return m.TokenId
 	}
-	return nil
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryBalanceRequest)(nil), "lbm.collection.v1.QueryBalanceRequest")
-	proto.RegisterType((*QueryBalanceResponse)(nil), "lbm.collection.v1.QueryBalanceResponse")
-	proto.RegisterType((*QueryAllBalancesRequest)(nil), "lbm.collection.v1.QueryAllBalancesRequest")
-	proto.RegisterType((*QueryAllBalancesResponse)(nil), "lbm.collection.v1.QueryAllBalancesResponse")
-	proto.RegisterType((*QueryFTSupplyRequest)(nil), "lbm.collection.v1.QueryFTSupplyRequest")
-	proto.RegisterType((*QueryFTSupplyResponse)(nil), "lbm.collection.v1.QueryFTSupplyResponse")
-	proto.RegisterType((*QueryFTMintedRequest)(nil), "lbm.collection.v1.QueryFTMintedRequest")
-	proto.RegisterType((*QueryFTMintedResponse)(nil), "lbm.collection.v1.QueryFTMintedResponse")
-	proto.RegisterType((*QueryFTBurntRequest)(nil), "lbm.collection.v1.QueryFTBurntRequest")
-	proto.RegisterType((*QueryFTBurntResponse)(nil), "lbm.collection.v1.QueryFTBurntResponse")
-	proto.RegisterType((*QueryNFTSupplyRequest)(nil), "lbm.collection.v1.QueryNFTSupplyRequest")
-	proto.RegisterType((*QueryNFTSupplyResponse)(nil), "lbm.collection.v1.QueryNFTSupplyResponse")
-	proto.RegisterType((*QueryNFTMintedRequest)(nil), "lbm.collection.v1.QueryNFTMintedRequest")
-	proto.RegisterType((*QueryNFTMintedResponse)(nil), "lbm.collection.v1.QueryNFTMintedResponse")
-	proto.RegisterType((*QueryNFTBurntRequest)(nil), "lbm.collection.v1.QueryNFTBurntRequest")
-	proto.RegisterType((*QueryNFTBurntResponse)(nil), "lbm.collection.v1.QueryNFTBurntResponse")
-	proto.RegisterType((*QueryContractRequest)(nil), "lbm.collection.v1.QueryContractRequest")
-	proto.RegisterType((*QueryContractResponse)(nil), "lbm.collection.v1.QueryContractResponse")
-	proto.RegisterType((*QueryTokenClassTypeNameRequest)(nil), "lbm.collection.v1.QueryTokenClassTypeNameRequest")
-	proto.RegisterType((*QueryTokenClassTypeNameResponse)(nil), "lbm.collection.v1.QueryTokenClassTypeNameResponse")
-	proto.RegisterType((*QueryTokenTypeRequest)(nil), "lbm.collection.v1.QueryTokenTypeRequest")
-	proto.RegisterType((*QueryTokenTypeResponse)(nil), "lbm.collection.v1.QueryTokenTypeResponse")
-	proto.RegisterType((*QueryTokenRequest)(nil), "lbm.collection.v1.QueryTokenRequest")
-	proto.RegisterType((*QueryTokenResponse)(nil), "lbm.collection.v1.QueryTokenResponse")
-	proto.RegisterType((*QueryRootRequest)(nil), "lbm.collection.v1.QueryRootRequest")
-	proto.RegisterType((*QueryRootResponse)(nil), "lbm.collection.v1.QueryRootResponse")
-	proto.RegisterType((*QueryHasParentRequest)(nil), "lbm.collection.v1.QueryHasParentRequest")
-	proto.RegisterType((*QueryHasParentResponse)(nil), "lbm.collection.v1.QueryHasParentResponse")
-	proto.RegisterType((*QueryParentRequest)(nil), "lbm.collection.v1.QueryParentRequest")
-	proto.RegisterType((*QueryParentResponse)(nil), "lbm.collection.v1.QueryParentResponse")
-	proto.RegisterType((*QueryChildrenRequest)(nil), "lbm.collection.v1.QueryChildrenRequest")
-	proto.RegisterType((*QueryChildrenResponse)(nil), "lbm.collection.v1.QueryChildrenResponse")
-	proto.RegisterType((*QueryGranteeGrantsRequest)(nil), "lbm.collection.v1.QueryGranteeGrantsRequest")
-	proto.RegisterType((*QueryGranteeGrantsResponse)(nil), "lbm.collection.v1.QueryGranteeGrantsResponse")
-	proto.RegisterType((*QueryIsOperatorForRequest)(nil), "lbm.collection.v1.QueryIsOperatorForRequest")
-	proto.RegisterType((*QueryIsOperatorForResponse)(nil), "lbm.collection.v1.QueryIsOperatorForResponse")
-	proto.RegisterType((*QueryHoldersByOperatorRequest)(nil), "lbm.collection.v1.QueryHoldersByOperatorRequest")
-	proto.RegisterType((*QueryHoldersByOperatorResponse)(nil), "lbm.collection.v1.QueryHoldersByOperatorResponse")
+// QueryHasParentResponse is the response type for the Query/HasParent RPC method.
+type QueryHasParentResponse struct {
+	// whether the token has its parent.
+	HasParent bool `protobuf:"varint,1,opt,name=has_parent,json=hasParent,proto3" json:"has_parent,omitempty"`
 }
 
-func init() { proto.RegisterFile("lbm/collection/v1/query.proto", fileDescriptor_a09de688aac2ee73) }
-
-var fileDescriptor_a09de688aac2ee73 = []byte{
-	// 1561 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x98, 0xdb, 0x6f, 0x1b, 0xc5,
-	0x17, 0xc7, 0x33, 0x69, 0x2e, 0xf6, 0x89, 0x2a, 0xfd, 0x3a, 0xbf, 0xd2, 0xba, 0x4b, 0xe3, 0x54,
-	0x4b, 0xe9, 0x25, 0xb4, 0xbb, 0x4d, 0xb8, 0x14, 0x44, 0x4b, 0x89, 0x43, 0x2e, 0x6e, 0x69, 0x92,
-	0xba, 0xa1, 0x48, 0x80, 0x54, 0xd6, 0xf6, 0xd6, 0xb6, 0x6a, 0xef, 0xb8, 0xbb, 0xeb, 0x8a, 0x34,
-	0xca, 0x0b, 0xfc, 0x03, 0x20, 0xde, 0x2a, 0xe8, 0x03, 0x20, 0x21, 0x21, 0x10, 0x20, 0xf1, 0x47,
-	0xf4, 0xb1, 0x82, 0x17, 0xc4, 0x43, 0x85, 0x5a, 0xfe, 0x08, 0x1e, 0xd1, 0xce, 0x9c, 0x59, 0xef,
-	0xae, 0xbd, 0xbe, 0x34, 0x9b, 0xa7, 0x78, 0x66, 0xcf, 0x9c, 0xf9, 0xcc, 0x39, 0x67, 0xcf, 0xce,
-	0x37, 0x30, 0x5d, 0x2f, 0x36, 0xf4, 0x12, 0xab, 0xd7, 0xcd, 0x92, 0x5b, 0x63, 0x96, 0x7e, 0x77,
-	0x4e, 0xbf, 0xd3, 0x32, 0xed, 0x2d, 0xad, 0x69, 0x33, 0x97, 0xd1, 0x03, 0xf5, 0x62, 0x43, 0x6b,
-	0x3f, 0xd6, 0xee, 0xce, 0x29, 0xb3, 0x25, 0xe6, 0x34, 0x98, 0xa3, 0x17, 0x0d, 0xc7, 0x14, 0xb6,
-	0xfa, 0xdd, 0xb9, 0xa2, 0xe9, 0x1a, 0x73, 0x7a, 0xd3, 0xa8, 0xd4, 0x2c, 0x83, 0x1b, 0xf2, 0xe5,
-	0xca, 0xd1, 0x0a, 0x63, 0x95, 0xba, 0xa9, 0x1b, 0xcd, 0x9a, 0x6e, 0x58, 0x16, 0x73, 0xf9, 0x43,
-	0x07, 0x9f, 0xaa, 0x9d, 0x7b, 0x07, 0xb6, 0x12, 0x36, 0x47, 0xd0, 0x03, 0x1f, 0x15, 0x5b, 0xb7,
-	0x74, 0xc3, 0x42, 0x36, 0xe5, 0x60, 0x85, 0x55, 0x18, 0xff, 0xa9, 0x7b, 0xbf, 0xc4, 0xac, 0x7a,
-	0x1b, 0xfe, 0x7f, 0xcd, 0x83, 0xca, 0x19, 0x75, 0xc3, 0x2a, 0x99, 0x05, 0xf3, 0x4e, 0xcb, 0x74,
-	0x5c, 0x3a, 0x03, 0x53, 0x25, 0x66, 0xb9, 0xb6, 0x51, 0x72, 0x6f, 0xd6, 0xca, 0x19, 0x72, 0x8c,
-	0x9c, 0x4a, 0x17, 0x40, 0x4e, 0xe5, 0xcb, 0x34, 0x03, 0x93, 0x46, 0xb9, 0x6c, 0x9b, 0x8e, 0x93,
-	0x19, 0xe5, 0x0f, 0xe5, 0x90, 0x1e, 0x81, 0x94, 0xcb, 0x6e, 0x9b, 0x96, 0xb7, 0x6e, 0x9f, 0x78,
-	0xc4, 0xc7, 0xf9, 0xb2, 0xba, 0x0e, 0x07, 0xc3, 0x9b, 0x39, 0x4d, 0x66, 0x39, 0x26, 0x3d, 0x0f,
-	0x93, 0x45, 0x31, 0xc5, 0x77, 0x9a, 0x9a, 0x3f, 0xac, 0x75, 0x04, 0x52, 0x5b, 0x64, 0x35, 0x2b,
-	0x37, 0xf6, 0xf0, 0xf1, 0xcc, 0x48, 0x41, 0x5a, 0xab, 0x5f, 0x11, 0x38, 0xcc, 0x3d, 0x2e, 0xd4,
-	0xeb, 0xe8, 0xd4, 0x49, 0xe0, 0x08, 0xcb, 0x00, 0xed, 0xdc, 0xf0, 0x43, 0x4c, 0xcd, 0x9f, 0xd0,
-	0x44, 0x22, 0x35, 0x2f, 0x91, 0x9a, 0x48, 0x3a, 0x26, 0x52, 0xdb, 0x30, 0x2a, 0x32, 0x72, 0x85,
-	0xc0, 0x4a, 0xf5, 0x01, 0x81, 0x4c, 0x27, 0x1e, 0x1e, 0xfa, 0x0d, 0x48, 0xe1, 0x31, 0x9c, 0x0c,
-	0x39, 0xb6, 0xaf, 0xff, 0xa9, 0x7d, 0x73, 0xba, 0x12, 0xe2, 0x1b, 0xe5, 0x7c, 0x27, 0xfb, 0xf2,
-	0x89, 0x7d, 0x43, 0x80, 0x05, 0x4c, 0xc8, 0xf2, 0xe6, 0xf5, 0x56, 0xb3, 0x59, 0xdf, 0x1a, 0x38,
-	0x76, 0xc1, 0x24, 0x8f, 0x86, 0x93, 0x5c, 0x82, 0xe7, 0x22, 0x3e, 0xf1, 0xc0, 0x97, 0x61, 0xc2,
-	0xe1, 0x33, 0xc2, 0x5f, 0x6e, 0xde, 0x3b, 0xd5, 0x5f, 0x8f, 0x67, 0x66, 0x2b, 0x35, 0xb7, 0xda,
-	0x2a, 0x6a, 0x25, 0xd6, 0xd0, 0x97, 0x6b, 0x96, 0x53, 0xaa, 0xd6, 0x0c, 0xfd, 0x16, 0xfe, 0x38,
-	0xeb, 0x94, 0x6f, 0xeb, 0xee, 0x56, 0xd3, 0x74, 0xb4, 0xbc, 0xe5, 0x16, 0xd0, 0x43, 0x00, 0xfc,
-	0x6a, 0xcd, 0x72, 0xcd, 0x72, 0xb2, 0xe0, 0xd2, 0x67, 0x1b, 0xbc, 0xc1, 0x67, 0x76, 0x03, 0x2e,
-	0x3c, 0xa8, 0xd7, 0xf0, 0x7d, 0x5b, 0xde, 0xcc, 0xb5, 0x6c, 0xcb, 0x4d, 0x82, 0xfb, 0x63, 0x3f,
-	0x16, 0xe8, 0x12, 0xb1, 0x57, 0x61, 0xbc, 0xe8, 0x4d, 0xec, 0x82, 0x5a, 0x38, 0x50, 0xdf, 0xc7,
-	0xc8, 0xac, 0x0d, 0x5d, 0x27, 0xd3, 0x00, 0x02, 0xdb, 0xf3, 0x89, 0xe0, 0x69, 0x3e, 0xb3, 0xb9,
-	0xd5, 0x34, 0xd5, 0x32, 0x1c, 0x8a, 0x3a, 0xde, 0x83, 0x62, 0x09, 0xe0, 0x0f, 0x59, 0x2d, 0x83,
-	0xe3, 0xef, 0x61, 0xc9, 0xdc, 0xc0, 0xfc, 0xae, 0x0d, 0x5b, 0x33, 0x7d, 0xe8, 0x8d, 0x76, 0x58,
-	0xf6, 0xaa, 0x70, 0xce, 0x23, 0xfa, 0x22, 0x42, 0x0d, 0x8a, 0xae, 0xde, 0x40, 0xb6, 0xf6, 0x42,
-	0x64, 0xbb, 0x08, 0x29, 0x69, 0x86, 0xdf, 0x8a, 0xe7, 0xbb, 0x76, 0x4d, 0x61, 0x22, 0x3b, 0xa7,
-	0x5c, 0xa2, 0x7e, 0x04, 0x59, 0xee, 0x77, 0xd3, 0x8b, 0xc2, 0x62, 0xdd, 0x70, 0x1c, 0x2f, 0x14,
-	0x6b, 0x46, 0xc3, 0x1c, 0xe6, 0x4d, 0x2c, 0x79, 0x0b, 0x03, 0x6f, 0x22, 0x1f, 0xe7, 0xcb, 0xea,
-	0xab, 0x30, 0x13, 0xeb, 0x1d, 0xf9, 0x29, 0x8c, 0x59, 0x46, 0xc3, 0x44, 0xbf, 0xfc, 0xb7, 0x5f,
-	0x9f, 0x9b, 0x32, 0x35, 0x49, 0x65, 0xf8, 0x43, 0xac, 0xcf, 0x80, 0x63, 0xc4, 0x58, 0x08, 0x2d,
-	0x14, 0x81, 0x3c, 0xda, 0x25, 0x90, 0xfe, 0x4a, 0x8c, 0x64, 0xc0, 0xf9, 0x3a, 0x1c, 0x68, 0x3b,
-	0x4f, 0xa2, 0x8f, 0x2d, 0x03, 0x0d, 0x3a, 0x44, 0xd2, 0x73, 0x30, 0xce, 0x0d, 0x10, 0xf2, 0xa0,
-	0x26, 0x6e, 0x38, 0x9a, 0xbc, 0xe1, 0x68, 0x0b, 0xd6, 0x16, 0xc2, 0x09, 0x43, 0x75, 0x0d, 0xfe,
-	0xc7, 0xfd, 0x14, 0x18, 0x4b, 0xa4, 0xbf, 0x2e, 0xe1, 0x41, 0x85, 0x3f, 0x1f, 0x6b, 0xcc, 0x66,
-	0x4c, 0xd6, 0xe0, 0xa1, 0x2e, 0xa1, 0xf3, 0x5e, 0x2b, 0xc1, 0xc5, 0x2d, 0xd5, 0xeb, 0x98, 0xe5,
-	0x55, 0xc3, 0xd9, 0x30, 0x6c, 0x33, 0x99, 0xde, 0x7f, 0x1e, 0x33, 0x1c, 0x70, 0x8a, 0x80, 0xd3,
-	0x00, 0x55, 0xc3, 0xb9, 0xd9, 0xe4, 0xb3, 0xdc, 0x69, 0xaa, 0x90, 0xae, 0x4a, 0x33, 0x75, 0x03,
-	0x83, 0x9d, 0x1c, 0xca, 0x15, 0xfc, 0xb2, 0x45, 0x38, 0x5e, 0x81, 0x89, 0x00, 0x43, 0xbf, 0x50,
-	0xa1, 0xad, 0x7a, 0x9f, 0xc8, 0xce, 0x51, 0xad, 0xd5, 0xcb, 0x76, 0x22, 0x05, 0x96, 0xd8, 0xb5,
-	0xee, 0x3e, 0x91, 0xdd, 0xc9, 0x87, 0xc3, 0xc3, 0xbe, 0x0e, 0xa9, 0x12, 0xce, 0xe1, 0x9d, 0xae,
-	0xf7, 0x71, 0x7d, 0xeb, 0xe4, 0xae, 0x74, 0x0f, 0x08, 0x1c, 0xe1, 0x70, 0x2b, 0xb6, 0x61, 0xb9,
-	0xa6, 0xc9, 0xff, 0x0c, 0x75, 0x29, 0xae, 0x88, 0x85, 0x32, 0x7a, 0x38, 0x4c, 0x2c, 0x7a, 0x5f,
-	0x13, 0x50, 0xba, 0x01, 0x62, 0x08, 0x5f, 0x83, 0x09, 0xbe, 0xa3, 0xbc, 0x14, 0x67, 0xba, 0x04,
-	0x90, 0x2f, 0x91, 0x15, 0x23, 0xac, 0x93, 0x0b, 0x60, 0x13, 0xe3, 0x97, 0x77, 0xd6, 0x9b, 0xa6,
-	0x6d, 0xb8, 0xcc, 0x5e, 0x66, 0xf6, 0xc0, 0xf1, 0x53, 0x20, 0xc5, 0x70, 0x19, 0x06, 0xd0, 0x1f,
-	0xd3, 0x43, 0x30, 0x51, 0x65, 0xf5, 0xb2, 0x69, 0xa3, 0x2e, 0xc2, 0x91, 0x7a, 0x01, 0x03, 0x12,
-	0xd9, 0x11, 0x03, 0x92, 0x05, 0x30, 0x5a, 0x6e, 0x95, 0xd9, 0xb5, 0x7b, 0x78, 0x9d, 0x48, 0x15,
-	0x02, 0x33, 0xea, 0x77, 0x04, 0xa6, 0x45, 0x0f, 0xe0, 0xde, 0x9c, 0xdc, 0x96, 0xf4, 0x92, 0x08,
-	0x74, 0x52, 0x69, 0xff, 0x8c, 0xe0, 0xa7, 0xb7, 0x0b, 0x26, 0x9e, 0x34, 0x03, 0x93, 0x22, 0x22,
-	0x22, 0xf7, 0xe9, 0x82, 0x1c, 0x26, 0x96, 0xdc, 0xf9, 0x7f, 0x33, 0x30, 0xce, 0x29, 0xe8, 0x8f,
-	0x04, 0x26, 0x51, 0x93, 0xd1, 0x13, 0x5d, 0x6a, 0xac, 0x8b, 0x2a, 0x56, 0x4e, 0xf6, 0xb5, 0x13,
-	0x5b, 0xaa, 0x1b, 0x9f, 0xfe, 0xf1, 0xcf, 0x97, 0xa3, 0x97, 0xe9, 0xaa, 0xde, 0x4d, 0xb3, 0x8b,
-	0xb8, 0x3b, 0xfa, 0x76, 0x20, 0x2b, 0x3b, 0xba, 0x54, 0x77, 0xfa, 0x36, 0xca, 0xd0, 0x1d, 0x7d,
-	0x5b, 0x76, 0xb2, 0x1d, 0xfa, 0x13, 0x81, 0xa9, 0x80, 0x8a, 0xa4, 0xb3, 0x71, 0x28, 0x9d, 0x4a,
-	0x58, 0x79, 0x69, 0x20, 0x5b, 0x44, 0x5f, 0xe2, 0xe8, 0x97, 0xe8, 0xc5, 0x5d, 0xa1, 0xd3, 0xef,
-	0x09, 0xa4, 0xe4, 0xa5, 0x9e, 0xc6, 0xc6, 0x2d, 0xa2, 0x27, 0x94, 0x53, 0xfd, 0x0d, 0x11, 0x73,
-	0x95, 0x63, 0xe6, 0xe8, 0xdb, 0x43, 0x60, 0xde, 0xf2, 0x66, 0xfc, 0x90, 0xea, 0x42, 0x1d, 0x20,
-	0xa9, 0xb8, 0xbf, 0xf7, 0x22, 0x0d, 0x49, 0x87, 0x5e, 0xa4, 0x61, 0x29, 0x90, 0x04, 0xa9, 0x10,
-	0x02, 0xf4, 0x5b, 0x02, 0x93, 0x78, 0x57, 0x8f, 0x2f, 0xd9, 0xb0, 0x48, 0x50, 0x4e, 0xf6, 0xb5,
-	0x43, 0xcc, 0x15, 0x8e, 0xb9, 0x40, 0x2f, 0x3d, 0x3b, 0x26, 0xbf, 0xf3, 0xd3, 0xdf, 0x08, 0xa4,
-	0x7d, 0x3d, 0x47, 0x63, 0xe3, 0x14, 0xd5, 0x92, 0xca, 0xe9, 0x01, 0x2c, 0x91, 0xb5, 0xc0, 0x59,
-	0xdf, 0xa5, 0x97, 0x87, 0x60, 0x6d, 0x5f, 0x77, 0x7d, 0x66, 0x6f, 0xe0, 0x97, 0x01, 0x62, 0x63,
-	0x1d, 0xf4, 0xc2, 0x0e, 0x17, 0xc2, 0xe9, 0x01, 0x2c, 0xf7, 0x02, 0x1b, 0x6b, 0xe2, 0x17, 0x02,
-	0x29, 0x29, 0xe0, 0xe2, 0xab, 0x37, 0x22, 0x1d, 0x95, 0x53, 0xfd, 0x0d, 0x91, 0xf9, 0x1a, 0x67,
-	0xbe, 0x42, 0xf3, 0x49, 0x30, 0x8b, 0x02, 0xf9, 0x82, 0x40, 0x4a, 0x0a, 0xb4, 0x78, 0xe4, 0x88,
-	0x64, 0x8c, 0x47, 0x8e, 0x4a, 0x44, 0x75, 0x9e, 0x23, 0x9f, 0xa1, 0xb3, 0x83, 0x23, 0xd3, 0xdf,
-	0x09, 0xd0, 0x4e, 0xd5, 0x46, 0xe7, 0xe2, 0x36, 0x8d, 0xd5, 0x8f, 0xca, 0xfc, 0x30, 0x4b, 0x90,
-	0xf8, 0x3d, 0x4e, 0xbc, 0x4e, 0xaf, 0x0e, 0x1d, 0x64, 0xae, 0x3c, 0xbd, 0x30, 0x4b, 0x49, 0xba,
-	0xc3, 0x45, 0xf8, 0x4d, 0x4f, 0x57, 0x7a, 0xdf, 0x8c, 0xb4, 0x2f, 0xe0, 0xe2, 0x4b, 0x3a, 0x2a,
-	0x3b, 0xe3, 0x4b, 0xba, 0x43, 0x47, 0xaa, 0x57, 0x38, 0xf9, 0x12, 0x5d, 0x4c, 0xa0, 0x3c, 0xe8,
-	0x7d, 0x02, 0xe3, 0x7c, 0x0b, 0x7a, 0xbc, 0x27, 0x81, 0xe4, 0x7c, 0xb1, 0x8f, 0x15, 0x32, 0xbe,
-	0xc3, 0x19, 0xdf, 0xa2, 0x17, 0x86, 0x65, 0x0c, 0x36, 0x37, 0x0f, 0x6e, 0xcc, 0x53, 0x80, 0xf4,
-	0x85, 0xb8, 0x5d, 0x03, 0x7a, 0x53, 0x39, 0xde, 0xdb, 0x68, 0x17, 0x3d, 0xd7, 0x8a, 0x34, 0x5d,
-	0x4f, 0x5b, 0xd2, 0x9f, 0x09, 0xa4, 0x7d, 0x09, 0x18, 0x9f, 0xe9, 0xa8, 0xf4, 0x8c, 0xcf, 0x74,
-	0x87, 0x9e, 0x54, 0xaf, 0x72, 0xd6, 0x15, 0xba, 0xb4, 0x0b, 0xd6, 0xb6, 0x20, 0xa5, 0xdf, 0x10,
-	0x98, 0x40, 0xdc, 0xd8, 0x34, 0x86, 0x59, 0x4f, 0xf4, 0x33, 0x43, 0xd0, 0x3c, 0x07, 0x5d, 0xa4,
-	0x0b, 0xbb, 0x00, 0x45, 0xc8, 0x1f, 0xbc, 0x4e, 0x25, 0x15, 0x5a, 0x7c, 0xa7, 0x0a, 0x4b, 0xd4,
-	0x1e, 0x9d, 0x2a, 0x22, 0x17, 0x9f, 0xe9, 0xed, 0x89, 0xa2, 0xfa, 0x0a, 0xf2, 0x57, 0x02, 0xfb,
-	0x43, 0x92, 0x8a, 0x9e, 0x89, 0x03, 0xe9, 0x26, 0x0d, 0x95, 0xb3, 0x03, 0x5a, 0x23, 0xfb, 0x22,
-	0x67, 0xbf, 0x48, 0xdf, 0x1c, 0x82, 0x5d, 0x48, 0x35, 0x7d, 0x1b, 0x25, 0xe5, 0x0e, 0xb5, 0x60,
-	0x7f, 0x48, 0xf4, 0xc4, 0x23, 0x77, 0x53, 0x63, 0xf1, 0xc8, 0x5d, 0x95, 0x94, 0x3a, 0x42, 0xef,
-	0xc1, 0x81, 0x0e, 0xf9, 0x41, 0xcf, 0xc5, 0xbe, 0x04, 0x31, 0x82, 0x4a, 0x99, 0x1b, 0x62, 0x85,
-	0xdc, 0x3b, 0xb7, 0xf2, 0xf0, 0x49, 0x96, 0x3c, 0x7a, 0x92, 0x25, 0x7f, 0x3f, 0xc9, 0x92, 0xcf,
-	0x9f, 0x66, 0x47, 0x1e, 0x3d, 0xcd, 0x8e, 0xfc, 0xf9, 0x34, 0x3b, 0xf2, 0xc1, 0xd9, 0xbe, 0xff,
-	0x58, 0xfd, 0x24, 0x10, 0xe0, 0xe2, 0x04, 0xff, 0xd7, 0xd7, 0xcb, 0xff, 0x05, 0x00, 0x00, 0xff,
-	0xff, 0x47, 0x9c, 0x47, 0x89, 0x8c, 0x1c, 0x00, 0x00,
+func (m *QueryHasParentResponse) Reset()         { *m = QueryHasParentResponse{} }
+func (m *QueryHasParentResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHasParentResponse) ProtoMessage()    {}
+func (*QueryHasParentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{43}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Balance queries the balance of a single token class for a single account.
-	Balance(ctx context.Context, in *QueryBalanceRequest, opts ...grpc.CallOption) (*QueryBalanceResponse, error)
-	// AllBalances queries the balance of all token classes for a single account.
-	AllBalances(ctx context.Context, in *QueryAllBalancesRequest, opts ...grpc.CallOption) (*QueryAllBalancesResponse, error)
-	// FTSupply queries the number of tokens from a given contract id and token id.
-	FTSupply(ctx context.Context, in *QueryFTSupplyRequest, opts ...grpc.CallOption) (*QueryFTSupplyResponse, error)
-	// FTMinted queries the number of minted tokens from a given contract id and token id.
-	FTMinted(ctx context.Context, in *QueryFTMintedRequest, opts ...grpc.CallOption) (*QueryFTMintedResponse, error)
-	// FTBurnt queries the number of burnt tokens from a given contract id and token id.
-	FTBurnt(ctx context.Context, in *QueryFTBurntRequest, opts ...grpc.CallOption) (*QueryFTBurntResponse, error)
-	// NFTSupply queries the number of tokens from a given contract id and token type.
-	NFTSupply(ctx context.Context, in *QueryNFTSupplyRequest, opts ...grpc.CallOption) (*QueryNFTSupplyResponse, error)
-	// NFTMinted queries the number of minted tokens from a given contract id and token type.
-	NFTMinted(ctx context.Context, in *QueryNFTMintedRequest, opts ...grpc.CallOption) (*QueryNFTMintedResponse, error)
-	// NFTBurnt queries the number of burnt tokens from a given contract id and token type.
-	NFTBurnt(ctx context.Context, in *QueryNFTBurntRequest, opts ...grpc.CallOption) (*QueryNFTBurntResponse, error)
-	// Contract queries a contract metadata based on its contract id.
-	Contract(ctx context.Context, in *QueryContractRequest, opts ...grpc.CallOption) (*QueryContractResponse, error)
-	// TokenClassTypeName queries the fully qualified message type name of a token class from its class id.
-	//
-	// Since: 0.46.0 (finschia)
-	TokenClassTypeName(ctx context.Context, in *QueryTokenClassTypeNameRequest, opts ...grpc.CallOption) (*QueryTokenClassTypeNameResponse, error)
-	// TokenType queries metadata of a token type.
-	TokenType(ctx context.Context, in *QueryTokenTypeRequest, opts ...grpc.CallOption) (*QueryTokenTypeResponse, error)
-	// Token queries a metadata of a token from its token id.
-	Token(ctx context.Context, in *QueryTokenRequest, opts ...grpc.CallOption) (*QueryTokenResponse, error)
-	// Root queries the root of a given nft.
-	Root(ctx context.Context, in *QueryRootRequest, opts ...grpc.CallOption) (*QueryRootResponse, error)
-	// HasParent queries whether a given nft has its parent.
-	HasParent(ctx context.Context, in *QueryHasParentRequest, opts ...grpc.CallOption) (*QueryHasParentResponse, error)
-	// Parent queries the parent of a given nft.
-	Parent(ctx context.Context, in *QueryParentRequest, opts ...grpc.CallOption) (*QueryParentResponse, error)
-	// Children queries the children of a given nft.
-	Children(ctx context.Context, in *QueryChildrenRequest, opts ...grpc.CallOption) (*QueryChildrenResponse, error)
-	// GranteeGrants queries all permissions on a given grantee.
-	GranteeGrants(ctx context.Context, in *QueryGranteeGrantsRequest, opts ...grpc.CallOption) (*QueryGranteeGrantsResponse, error)
-	// IsOperatorFor queries whether the operator is authorized by the holder.
-	IsOperatorFor(ctx context.Context, in *QueryIsOperatorForRequest, opts ...grpc.CallOption) (*QueryIsOperatorForResponse, error)
-	// HoldersByOperator queries holders of a given operator.
-	HoldersByOperator(ctx context.Context, in *QueryHoldersByOperatorRequest, opts ...grpc.CallOption) (*QueryHoldersByOperatorResponse, error)
+func (m *QueryHasParentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryHasParentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHasParentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryHasParentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHasParentResponse.Merge(m, src)
 }
-
-func (c *queryClient) Balance(ctx context.Context, in *QueryBalanceRequest, opts ...grpc.CallOption) (*QueryBalanceResponse, error) {
-	out := new(QueryBalanceResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/Balance", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryHasParentResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) AllBalances(ctx context.Context, in *QueryAllBalancesRequest, opts ...grpc.CallOption) (*QueryAllBalancesResponse, error) {
-	out := new(QueryAllBalancesResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/AllBalances", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryHasParentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHasParentResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) FTSupply(ctx context.Context, in *QueryFTSupplyRequest, opts ...grpc.CallOption) (*QueryFTSupplyResponse, error) {
-	out := new(QueryFTSupplyResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/FTSupply", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QueryHasParentResponse proto.InternalMessageInfo
 
-func (c *queryClient) FTMinted(ctx context.Context, in *QueryFTMintedRequest, opts ...grpc.CallOption) (*QueryFTMintedResponse, error) {
-	out := new(QueryFTMintedResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/FTMinted", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryHasParentResponse) GetHasParent() bool {
+	if m != nil {
+		return m.HasParent
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) FTBurnt(ctx context.Context, in *QueryFTBurntRequest, opts ...grpc.CallOption) (*QueryFTBurntResponse, error) {
-	out := new(QueryFTBurntResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/FTBurnt", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryParentRequest is the request type for the Query/Parent RPC method.
+type QueryParentRequest struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// token id associated wit the non-fungible token.
+	TokenId string `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
 }
 
-func (c *queryClient) NFTSupply(ctx context.Context, in *QueryNFTSupplyRequest, opts ...grpc.CallOption) (*QueryNFTSupplyResponse, error) {
-	out := new(QueryNFTSupplyResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/NFTSupply", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryParentRequest) Reset()         { *m = QueryParentRequest{} }
+func (m *QueryParentRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParentRequest) ProtoMessage()    {}
+func (*QueryParentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{44}
 }
-
-func (c *queryClient) NFTMinted(ctx context.Context, in *QueryNFTMintedRequest, opts ...grpc.CallOption) (*QueryNFTMintedResponse, error) {
-	out := new(QueryNFTMintedResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/NFTMinted", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryParentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) NFTBurnt(ctx context.Context, in *QueryNFTBurntRequest, opts ...grpc.CallOption) (*QueryNFTBurntResponse, error) {
-	out := new(QueryNFTBurntResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/NFTBurnt", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryParentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) Contract(ctx context.Context, in *QueryContractRequest, opts ...grpc.CallOption) (*QueryContractResponse, error) {
-	out := new(QueryContractResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/Contract", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryParentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParentRequest.Merge(m, src)
 }
-
-func (c *queryClient) TokenClassTypeName(ctx context.Context, in *QueryTokenClassTypeNameRequest, opts ...grpc.CallOption) (*QueryTokenClassTypeNameResponse, error) {
-	out := new(QueryTokenClassTypeNameResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/TokenClassTypeName", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryParentRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) TokenType(ctx context.Context, in *QueryTokenTypeRequest, opts ...grpc.CallOption) (*QueryTokenTypeResponse, error) {
-	out := new(QueryTokenTypeResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/TokenType", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryParentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParentRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Token(ctx context.Context, in *QueryTokenRequest, opts ...grpc.CallOption) (*QueryTokenResponse, error) {
-	out := new(QueryTokenResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/Token", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QueryParentRequest proto.InternalMessageInfo
 
-func (c *queryClient) Root(ctx context.Context, in *QueryRootRequest, opts ...grpc.CallOption) (*QueryRootResponse, error) {
-	out := new(QueryRootResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/Root", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryParentRequest) GetContractId() string {
+	if m != nil {
+		return m.ContractId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) HasParent(ctx context.Context, in *QueryHasParentRequest, opts ...grpc.CallOption) (*QueryHasParentResponse, error) {
-	out := new(QueryHasParentResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/HasParent", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryParentRequest) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Parent(ctx context.Context, in *QueryParentRequest, opts ...grpc.CallOption) (*QueryParentResponse, error) {
-	out := new(QueryParentResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/Parent", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryParentResponse is the response type for the Query/Parent RPC method.
+type QueryParentResponse struct {
+	// parent is the information of the parent token.
+	Parent NFT `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent"`
 }
 
-func (c *queryClient) Children(ctx context.Context, in *QueryChildrenRequest, opts ...grpc.CallOption) (*QueryChildrenResponse, error) {
-	out := new(QueryChildrenResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/Children", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryParentResponse) Reset()         { *m = QueryParentResponse{} }
+func (m *QueryParentResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParentResponse) ProtoMessage()    {}
+func (*QueryParentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{45}
+}
+func (m *QueryParentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryParentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParentResponse.Merge(m, src)
+}
+func (m *QueryParentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParentResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GranteeGrants(ctx context.Context, in *QueryGranteeGrantsRequest, opts ...grpc.CallOption) (*QueryGranteeGrantsResponse, error) {
-	out := new(QueryGranteeGrantsResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/GranteeGrants", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryParentResponse proto.InternalMessageInfo
+
+func (m *QueryParentResponse) GetParent() NFT {
+	if m != nil {
+		return m.Parent
 	}
-	return out, nil
+	return NFT{}
 }
 
-func (c *queryClient) IsOperatorFor(ctx context.Context, in *QueryIsOperatorForRequest, opts ...grpc.CallOption) (*QueryIsOperatorForResponse, error) {
-	out := new(QueryIsOperatorForResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/IsOperatorFor", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryChildrenRequest is the request type for the Query/Children RPC method.
+type QueryChildrenRequest struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// token id associated with the non-fungible token.
+	TokenId string `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryChildrenRequest) Reset()         { *m = QueryChildrenRequest{} }
+func (m *QueryChildrenRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChildrenRequest) ProtoMessage()    {}
+func (*QueryChildrenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{46}
+}
+func (m *QueryChildrenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChildrenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChildrenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryChildrenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChildrenRequest.Merge(m, src)
+}
+func (m *QueryChildrenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChildrenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChildrenRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) HoldersByOperator(ctx context.Context, in *QueryHoldersByOperatorRequest, opts ...grpc.CallOption) (*QueryHoldersByOperatorResponse, error) {
-	out := new(QueryHoldersByOperatorResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/HoldersByOperator", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryChildrenRequest proto.InternalMessageInfo
+
+func (m *QueryChildrenRequest) GetContractId() string {
+	if m != nil {
+		return m.ContractId
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Balance queries the balance of a single token class for a single account.
-	Balance(context.Context, *QueryBalanceRequest) (*QueryBalanceResponse, error)
-	// AllBalances queries the balance of all token classes for a single account.
-	AllBalances(context.Context, *QueryAllBalancesRequest) (*QueryAllBalancesResponse, error)
-	// FTSupply queries the number of tokens from a given contract id and token id.
-	FTSupply(context.Context, *QueryFTSupplyRequest) (*QueryFTSupplyResponse, error)
-	// FTMinted queries the number of minted tokens from a given contract id and token id.
-	FTMinted(context.Context, *QueryFTMintedRequest) (*QueryFTMintedResponse, error)
-	// FTBurnt queries the number of burnt tokens from a given contract id and token id.
-	FTBurnt(context.Context, *QueryFTBurntRequest) (*QueryFTBurntResponse, error)
-	// NFTSupply queries the number of tokens from a given contract id and token type.
-	NFTSupply(context.Context, *QueryNFTSupplyRequest) (*QueryNFTSupplyResponse, error)
-	// NFTMinted queries the number of minted tokens from a given contract id and token type.
-	NFTMinted(context.Context, *QueryNFTMintedRequest) (*QueryNFTMintedResponse, error)
-	// NFTBurnt queries the number of burnt tokens from a given contract id and token type.
-	NFTBurnt(context.Context, *QueryNFTBurntRequest) (*QueryNFTBurntResponse, error)
-	// Contract queries a contract metadata based on its contract id.
-	Contract(context.Context, *QueryContractRequest) (*QueryContractResponse, error)
-	// TokenClassTypeName queries the fully qualified message type name of a token class from its class id.
-	//
-	// Since: 0.46.0 (finschia)
-	TokenClassTypeName(context.Context, *QueryTokenClassTypeNameRequest) (*QueryTokenClassTypeNameResponse, error)
-	// TokenType queries metadata of a token type.
-	TokenType(context.Context, *QueryTokenTypeRequest) (*QueryTokenTypeResponse, error)
-	// Token queries a metadata of a token from its token id.
-	Token(context.Context, *QueryTokenRequest) (*QueryTokenResponse, error)
-	// Root queries the root of a given nft.
-	Root(context.Context, *QueryRootRequest) (*QueryRootResponse, error)
-	// HasParent queries whether a given nft has its parent.
-	HasParent(context.Context, *QueryHasParentRequest) (*QueryHasParentResponse, error)
-	// Parent queries the parent of a given nft.
-	Parent(context.Context, *QueryParentRequest) (*QueryParentResponse, error)
-	// Children queries the children of a given nft.
-	Children(context.Context, *QueryChildrenRequest) (*QueryChildrenResponse, error)
-	// GranteeGrants queries all permissions on a given grantee.
-	GranteeGrants(context.Context, *QueryGranteeGrantsRequest) (*QueryGranteeGrantsResponse, error)
-	// IsOperatorFor queries whether the operator is authorized by the holder.
-	IsOperatorFor(context.Context, *QueryIsOperatorForRequest) (*QueryIsOperatorForResponse, error)
-	// HoldersByOperator queries holders of a given operator.
-	HoldersByOperator(context.Context, *QueryHoldersByOperatorRequest) (*QueryHoldersByOperatorResponse, error)
+func (m *QueryChildrenRequest) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
+	}
+	return ""
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryChildrenRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (*UnimplementedQueryServer) Balance(ctx context.Context, req *QueryBalanceRequest) (*QueryBalanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Balance not implemented")
+// QueryChildrenResponse is the response type for the Query/Children RPC method.
+type QueryChildrenResponse struct {
+	// children is the information of the child tokens.
+	Children []NFT `protobuf:"bytes,1,rep,name=children,proto3" json:"children"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*UnimplementedQueryServer) AllBalances(ctx context.Context, req *QueryAllBalancesRequest) (*QueryAllBalancesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllBalances not implemented")
+
+func (m *QueryChildrenResponse) Reset()         { *m = QueryChildrenResponse{} }
+func (m *QueryChildrenResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChildrenResponse) ProtoMessage()    {}
+func (*QueryChildrenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{47}
 }
-func (*UnimplementedQueryServer) FTSupply(ctx context.Context, req *QueryFTSupplyRequest) (*QueryFTSupplyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FTSupply not implemented")
+func (m *QueryChildrenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) FTMinted(ctx context.Context, req *QueryFTMintedRequest) (*QueryFTMintedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FTMinted not implemented")
+func (m *QueryChildrenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChildrenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) FTBurnt(ctx context.Context, req *QueryFTBurntRequest) (*QueryFTBurntResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FTBurnt not implemented")
+func (m *QueryChildrenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChildrenResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) NFTSupply(ctx context.Context, req *QueryNFTSupplyRequest) (*QueryNFTSupplyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NFTSupply not implemented")
+func (m *QueryChildrenResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) NFTMinted(ctx context.Context, req *QueryNFTMintedRequest) (*QueryNFTMintedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NFTMinted not implemented")
+func (m *QueryChildrenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChildrenResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) NFTBurnt(ctx context.Context, req *QueryNFTBurntRequest) (*QueryNFTBurntResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NFTBurnt not implemented")
+
+var xxx_messageInfo_QueryChildrenResponse proto.InternalMessageInfo
+
+func (m *QueryChildrenResponse) GetChildren() []NFT {
+	if m != nil {
+		return m.Children
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) Contract(ctx context.Context, req *QueryContractRequest) (*QueryContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Contract not implemented")
+
+func (m *QueryChildrenResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) TokenClassTypeName(ctx context.Context, req *QueryTokenClassTypeNameRequest) (*QueryTokenClassTypeNameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TokenClassTypeName not implemented")
+
+// QueryRoyaltyRequest is the request type for the Query/Royalty RPC method.
+type QueryRoyaltyRequest struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// token id associated with the non-fungible token.
+	TokenId string `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// sale price of the token.
+	SalePrice types1.Coin `protobuf:"bytes,3,opt,name=sale_price,json=salePrice,proto3" json:"sale_price"`
 }
-func (*UnimplementedQueryServer) TokenType(ctx context.Context, req *QueryTokenTypeRequest) (*QueryTokenTypeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TokenType not implemented")
+
+func (m *QueryRoyaltyRequest) Reset()         { *m = QueryRoyaltyRequest{} }
+func (m *QueryRoyaltyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRoyaltyRequest) ProtoMessage()    {}
+func (*QueryRoyaltyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{48}
 }
-func (*UnimplementedQueryServer) Token(ctx context.Context, req *QueryTokenRequest) (*QueryTokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Token not implemented")
+func (m *QueryRoyaltyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Root(ctx context.Context, req *QueryRootRequest) (*QueryRootResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Root not implemented")
+func (m *QueryRoyaltyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoyaltyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) HasParent(ctx context.Context, req *QueryHasParentRequest) (*QueryHasParentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HasParent not implemented")
+func (m *QueryRoyaltyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoyaltyRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Parent(ctx context.Context, req *QueryParentRequest) (*QueryParentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Parent not implemented")
+func (m *QueryRoyaltyRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Children(ctx context.Context, req *QueryChildrenRequest) (*QueryChildrenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Children not implemented")
-}
-func (*UnimplementedQueryServer) GranteeGrants(ctx context.Context, req *QueryGranteeGrantsRequest) (*QueryGranteeGrantsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GranteeGrants not implemented")
-}
-func (*UnimplementedQueryServer) IsOperatorFor(ctx context.Context, req *QueryIsOperatorForRequest) (*QueryIsOperatorForResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsOperatorFor not implemented")
-}
-func (*UnimplementedQueryServer) HoldersByOperator(ctx context.Context, req *QueryHoldersByOperatorRequest) (*QueryHoldersByOperatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HoldersByOperator not implemented")
+func (m *QueryRoyaltyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoyaltyRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryRoyaltyRequest proto.InternalMessageInfo
 
-func _Query_Balance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBalanceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Balance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Query/Balance",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Balance(ctx, req.(*QueryBalanceRequest))
+func (m *QueryRoyaltyRequest) GetContractId() string {
+	if m != nil {
+		return m.ContractId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_AllBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllBalancesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllBalances(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Query/AllBalances",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllBalances(ctx, req.(*QueryAllBalancesRequest))
+func (m *QueryRoyaltyRequest) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_FTSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFTSupplyRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FTSupply(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Query/FTSupply",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FTSupply(ctx, req.(*QueryFTSupplyRequest))
+func (m *QueryRoyaltyRequest) GetSalePrice() types1.Coin {
+	if m != nil {
+		return m.SalePrice
 	}
-	return interceptor(ctx, in, info, handler)
+	return types1.Coin{}
 }
 
-func _Query_FTMinted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFTMintedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FTMinted(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Query/FTMinted",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FTMinted(ctx, req.(*QueryFTMintedRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryRoyaltyResponse is the response type for the Query/Royalty RPC method.
+type QueryRoyaltyResponse struct {
+	// address which receives the royalty.
+	// Note: it would be empty if the token class has no royalty.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// royalty paid out of the sale price.
+	Royalty types1.Coin `protobuf:"bytes,2,opt,name=royalty,proto3" json:"royalty"`
 }
 
-func _Query_FTBurnt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFTBurntRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FTBurnt(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Query/FTBurnt",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FTBurnt(ctx, req.(*QueryFTBurntRequest))
+func (m *QueryRoyaltyResponse) Reset()         { *m = QueryRoyaltyResponse{} }
+func (m *QueryRoyaltyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRoyaltyResponse) ProtoMessage()    {}
+func (*QueryRoyaltyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{49}
+}
+func (m *QueryRoyaltyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoyaltyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoyaltyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryRoyaltyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoyaltyResponse.Merge(m, src)
+}
+func (m *QueryRoyaltyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoyaltyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoyaltyResponse.DiscardUnknown(m)
 }
 
-func _Query_NFTSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNFTSupplyRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).NFTSupply(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Query/NFTSupply",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).NFTSupply(ctx, req.(*QueryNFTSupplyRequest))
+var xxx_messageInfo_QueryRoyaltyResponse proto.InternalMessageInfo
+
+func (m *QueryRoyaltyResponse) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_NFTMinted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNFTMintedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).NFTMinted(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Query/NFTMinted",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).NFTMinted(ctx, req.(*QueryNFTMintedRequest))
+func (m *QueryRoyaltyResponse) GetRoyalty() types1.Coin {
+	if m != nil {
+		return m.Royalty
 	}
-	return interceptor(ctx, in, info, handler)
+	return types1.Coin{}
 }
 
-func _Query_NFTBurnt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNFTBurntRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).NFTBurnt(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Query/NFTBurnt",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).NFTBurnt(ctx, req.(*QueryNFTBurntRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryNFTHistoryRequest is the request type for the Query/NFTHistory RPC method.
+type QueryNFTHistoryRequest struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// token id associated with the non-fungible token.
+	TokenId string `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_Contract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryContractRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Contract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Query/Contract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Contract(ctx, req.(*QueryContractRequest))
+func (m *QueryNFTHistoryRequest) Reset()         { *m = QueryNFTHistoryRequest{} }
+func (m *QueryNFTHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNFTHistoryRequest) ProtoMessage()    {}
+func (*QueryNFTHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{50}
+}
+func (m *QueryNFTHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNFTHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNFTHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryNFTHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNFTHistoryRequest.Merge(m, src)
+}
+func (m *QueryNFTHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNFTHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNFTHistoryRequest.DiscardUnknown(m)
 }
 
-func _Query_TokenClassTypeName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTokenClassTypeNameRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TokenClassTypeName(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Query/TokenClassTypeName",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TokenClassTypeName(ctx, req.(*QueryTokenClassTypeNameRequest))
+var xxx_messageInfo_QueryNFTHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryNFTHistoryRequest) GetContractId() string {
+	if m != nil {
+		return m.ContractId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_TokenType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTokenTypeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TokenType(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Query/TokenType",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TokenType(ctx, req.(*QueryTokenTypeRequest))
+func (m *QueryNFTHistoryRequest) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Token_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTokenRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Token(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Query/Token",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Token(ctx, req.(*QueryTokenRequest))
+func (m *QueryNFTHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Root_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRootRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Root(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Query/Root",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Root(ctx, req.(*QueryRootRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryNFTHistoryResponse is the response type for the Query/NFTHistory RPC method.
+type QueryNFTHistoryResponse struct {
+	// entries of the history of the token.
+	Entries []NFTHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_HasParent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHasParentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HasParent(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Query/HasParent",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HasParent(ctx, req.(*QueryHasParentRequest))
+func (m *QueryNFTHistoryResponse) Reset()         { *m = QueryNFTHistoryResponse{} }
+func (m *QueryNFTHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNFTHistoryResponse) ProtoMessage()    {}
+func (*QueryNFTHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{51}
+}
+func (m *QueryNFTHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNFTHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNFTHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryNFTHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNFTHistoryResponse.Merge(m, src)
+}
+func (m *QueryNFTHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNFTHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNFTHistoryResponse.DiscardUnknown(m)
 }
 
-func _Query_Parent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Parent(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Query/Parent",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Parent(ctx, req.(*QueryParentRequest))
+var xxx_messageInfo_QueryNFTHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryNFTHistoryResponse) GetEntries() []NFTHistoryEntry {
+	if m != nil {
+		return m.Entries
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Children_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryChildrenRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Children(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Query/Children",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Children(ctx, req.(*QueryChildrenRequest))
+func (m *QueryNFTHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GranteeGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGranteeGrantsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GranteeGrants(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Query/GranteeGrants",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GranteeGrants(ctx, req.(*QueryGranteeGrantsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryGranteeGrantsRequest is the request type for the Query/GranteeGrants RPC method.
+type QueryGranteeGrantsRequest struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// the address of the grantee.
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_IsOperatorFor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIsOperatorForRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).IsOperatorFor(ctx, in)
+func (m *QueryGranteeGrantsRequest) Reset()         { *m = QueryGranteeGrantsRequest{} }
+func (m *QueryGranteeGrantsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGranteeGrantsRequest) ProtoMessage()    {}
+func (*QueryGranteeGrantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{52}
+}
+func (m *QueryGranteeGrantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGranteeGrantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGranteeGrantsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Query/IsOperatorFor",
+}
+func (m *QueryGranteeGrantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGranteeGrantsRequest.Merge(m, src)
+}
+func (m *QueryGranteeGrantsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGranteeGrantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGranteeGrantsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGranteeGrantsRequest proto.InternalMessageInfo
+
+func (m *QueryGranteeGrantsRequest) GetContractId() string {
+	if m != nil {
+		return m.ContractId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IsOperatorFor(ctx, req.(*QueryIsOperatorForRequest))
+	return ""
+}
+
+func (m *QueryGranteeGrantsRequest) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_HoldersByOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHoldersByOperatorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryGranteeGrantsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).HoldersByOperator(ctx, in)
+	return nil
+}
+
+// QueryGranteeGrantsResponse is the response type for the Query/GranteeGrants RPC method.
+type QueryGranteeGrantsResponse struct {
+	Grants []Grant `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGranteeGrantsResponse) Reset()         { *m = QueryGranteeGrantsResponse{} }
+func (m *QueryGranteeGrantsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGranteeGrantsResponse) ProtoMessage()    {}
+func (*QueryGranteeGrantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{53}
+}
+func (m *QueryGranteeGrantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGranteeGrantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGranteeGrantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Query/HoldersByOperator",
+}
+func (m *QueryGranteeGrantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGranteeGrantsResponse.Merge(m, src)
+}
+func (m *QueryGranteeGrantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGranteeGrantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGranteeGrantsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGranteeGrantsResponse proto.InternalMessageInfo
+
+func (m *QueryGranteeGrantsResponse) GetGrants() []Grant {
+	if m != nil {
+		return m.Grants
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HoldersByOperator(ctx, req.(*QueryHoldersByOperatorRequest))
+	return nil
+}
+
+func (m *QueryGranteeGrantsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "lbm.collection.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Balance",
-			Handler:    _Query_Balance_Handler,
-		},
-		{
-			MethodName: "AllBalances",
-			Handler:    _Query_AllBalances_Handler,
-		},
-		{
-			MethodName: "FTSupply",
-			Handler:    _Query_FTSupply_Handler,
-		},
-		{
-			MethodName: "FTMinted",
-			Handler:    _Query_FTMinted_Handler,
-		},
-		{
-			MethodName: "FTBurnt",
-			Handler:    _Query_FTBurnt_Handler,
-		},
-		{
-			MethodName: "NFTSupply",
-			Handler:    _Query_NFTSupply_Handler,
-		},
-		{
-			MethodName: "NFTMinted",
-			Handler:    _Query_NFTMinted_Handler,
-		},
-		{
-			MethodName: "NFTBurnt",
-			Handler:    _Query_NFTBurnt_Handler,
-		},
-		{
-			MethodName: "Contract",
-			Handler:    _Query_Contract_Handler,
-		},
-		{
-			MethodName: "TokenClassTypeName",
-			Handler:    _Query_TokenClassTypeName_Handler,
-		},
-		{
-			MethodName: "TokenType",
-			Handler:    _Query_TokenType_Handler,
-		},
-		{
-			MethodName: "Token",
-			Handler:    _Query_Token_Handler,
-		},
-		{
-			MethodName: "Root",
-			Handler:    _Query_Root_Handler,
-		},
-		{
-			MethodName: "HasParent",
-			Handler:    _Query_HasParent_Handler,
-		},
-		{
-			MethodName: "Parent",
-			Handler:    _Query_Parent_Handler,
-		},
-		{
-			MethodName: "Children",
-			Handler:    _Query_Children_Handler,
-		},
-		{
-			MethodName: "GranteeGrants",
-			Handler:    _Query_GranteeGrants_Handler,
-		},
-		{
-			MethodName: "IsOperatorFor",
-			Handler:    _Query_IsOperatorFor_Handler,
-		},
-		{
-			MethodName: "HoldersByOperator",
-			Handler:    _Query_HoldersByOperator_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "lbm/collection/v1/query.proto",
+// QueryIsOperatorForRequest is the request type for the Query/IsOperatorFor RPC method.
+type QueryIsOperatorForRequest struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// the address of the operator.
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// the address of the token holder.
+	Holder string `protobuf:"bytes,3,opt,name=holder,proto3" json:"holder,omitempty"`
 }
 
-func (m *QueryBalanceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryIsOperatorForRequest) Reset()         { *m = QueryIsOperatorForRequest{} }
+func (m *QueryIsOperatorForRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIsOperatorForRequest) ProtoMessage()    {}
+func (*QueryIsOperatorForRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{54}
+}
+func (m *QueryIsOperatorForRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIsOperatorForRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIsOperatorForRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryIsOperatorForRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIsOperatorForRequest.Merge(m, src)
+}
+func (m *QueryIsOperatorForRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIsOperatorForRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIsOperatorForRequest.DiscardUnknown(m)
 }
 
-func (m *QueryBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenId) > 0 {
-		i -= len(m.TokenId)
-		copy(dAtA[i:], m.TokenId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_QueryIsOperatorForRequest proto.InternalMessageInfo
+
+func (m *QueryIsOperatorForRequest) GetContractId() string {
+	if m != nil {
+		return m.ContractId
 	}
-	if len(m.ContractId) > 0 {
-		i -= len(m.ContractId)
-		copy(dAtA[i:], m.ContractId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *QueryIsOperatorForRequest) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryBalanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryIsOperatorForRequest) GetHolder() string {
+	if m != nil {
+		return m.Holder
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryIsOperatorForResponse is the response type for the Query/IsOperatorFor RPC method.
+type QueryIsOperatorForResponse struct {
+	Authorized bool `protobuf:"varint,1,opt,name=authorized,proto3" json:"authorized,omitempty"`
 }
 
-func (m *QueryBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryIsOperatorForResponse) Reset()         { *m = QueryIsOperatorForResponse{} }
+func (m *QueryIsOperatorForResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIsOperatorForResponse) ProtoMessage()    {}
+func (*QueryIsOperatorForResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{55}
+}
+func (m *QueryIsOperatorForResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIsOperatorForResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIsOperatorForResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllBalancesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryIsOperatorForResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIsOperatorForResponse.Merge(m, src)
 }
-
-func (m *QueryAllBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryIsOperatorForResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryAllBalancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ContractId) > 0 {
-		i -= len(m.ContractId)
-		copy(dAtA[i:], m.ContractId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryIsOperatorForResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIsOperatorForResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllBalancesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryIsOperatorForResponse proto.InternalMessageInfo
+
+func (m *QueryIsOperatorForResponse) GetAuthorized() bool {
+	if m != nil {
+		return m.Authorized
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryAllBalancesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryHoldersByOperatorRequest is the request type for the Query/HoldersByOperator RPC method.
+type QueryHoldersByOperatorRequest struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// address of the operator.
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Balances) > 0 {
-		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func (m *QueryHoldersByOperatorRequest) Reset()         { *m = QueryHoldersByOperatorRequest{} }
+func (m *QueryHoldersByOperatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHoldersByOperatorRequest) ProtoMessage()    {}
+func (*QueryHoldersByOperatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{56}
 }
-
-func (m *QueryFTSupplyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryHoldersByOperatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHoldersByOperatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHoldersByOperatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryFTSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryHoldersByOperatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHoldersByOperatorRequest.Merge(m, src)
 }
-
-func (m *QueryFTSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenId) > 0 {
-		i -= len(m.TokenId)
-		copy(dAtA[i:], m.TokenId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ContractId) > 0 {
-		i -= len(m.ContractId)
-		copy(dAtA[i:], m.ContractId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryHoldersByOperatorRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryFTSupplyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryHoldersByOperatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHoldersByOperatorRequest.DiscardUnknown(m)
 }
 
-func (m *QueryFTSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_QueryHoldersByOperatorRequest proto.InternalMessageInfo
 
-func (m *QueryFTSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Supply.Size()
-		i -= size
-		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryHoldersByOperatorRequest) GetContractId() string {
+	if m != nil {
+		return m.ContractId
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryFTMintedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryHoldersByOperatorRequest) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryFTMintedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return ""
 }
 
-func (m *QueryFTMintedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenId) > 0 {
-		i -= len(m.TokenId)
-		copy(dAtA[i:], m.TokenId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ContractId) > 0 {
-		i -= len(m.ContractId)
-		copy(dAtA[i:], m.ContractId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryHoldersByOperatorRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryFTMintedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// QueryHoldersByOperatorResponse is the response type for the Query/HoldersByOperator RPC method.
+type QueryHoldersByOperatorResponse struct {
+	Holders []string `protobuf:"bytes,1,rep,name=holders,proto3" json:"holders,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryFTMintedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryHoldersByOperatorResponse) Reset()         { *m = QueryHoldersByOperatorResponse{} }
+func (m *QueryHoldersByOperatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHoldersByOperatorResponse) ProtoMessage()    {}
+func (*QueryHoldersByOperatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{57}
 }
-
-func (m *QueryFTMintedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Minted.Size()
-		i -= size
-		if _, err := m.Minted.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryHoldersByOperatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHoldersByOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHoldersByOperatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryFTBurntRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryHoldersByOperatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHoldersByOperatorResponse.Merge(m, src)
 }
-
-func (m *QueryFTBurntRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryHoldersByOperatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHoldersByOperatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHoldersByOperatorResponse.DiscardUnknown(m)
 }
 
-func (m *QueryFTBurntRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenId) > 0 {
-		i -= len(m.TokenId)
-		copy(dAtA[i:], m.TokenId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenId)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_QueryHoldersByOperatorResponse proto.InternalMessageInfo
+
+func (m *QueryHoldersByOperatorResponse) GetHolders() []string {
+	if m != nil {
+		return m.Holders
 	}
-	if len(m.ContractId) > 0 {
-		i -= len(m.ContractId)
-		copy(dAtA[i:], m.ContractId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *QueryHoldersByOperatorResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryFTBurntResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterType((*QueryBalanceRequest)(nil), "lbm.collection.v1.QueryBalanceRequest")
+	proto.RegisterType((*QueryBalanceResponse)(nil), "lbm.collection.v1.QueryBalanceResponse")
+	proto.RegisterType((*QueryAllBalancesRequest)(nil), "lbm.collection.v1.QueryAllBalancesRequest")
+	proto.RegisterType((*QueryAllBalancesResponse)(nil), "lbm.collection.v1.QueryAllBalancesResponse")
+	proto.RegisterType((*QuerySpendableRequest)(nil), "lbm.collection.v1.QuerySpendableRequest")
+	proto.RegisterType((*QuerySpendableResponse)(nil), "lbm.collection.v1.QuerySpendableResponse")
+	proto.RegisterType((*QueryLockedRequest)(nil), "lbm.collection.v1.QueryLockedRequest")
+	proto.RegisterType((*QueryLockedResponse)(nil), "lbm.collection.v1.QueryLockedResponse")
+	proto.RegisterType((*QueryFTSupplyRequest)(nil), "lbm.collection.v1.QueryFTSupplyRequest")
+	proto.RegisterType((*QueryFTSupplyResponse)(nil), "lbm.collection.v1.QueryFTSupplyResponse")
+	proto.RegisterType((*QueryFTMintedRequest)(nil), "lbm.collection.v1.QueryFTMintedRequest")
+	proto.RegisterType((*QueryFTMintedResponse)(nil), "lbm.collection.v1.QueryFTMintedResponse")
+	proto.RegisterType((*QueryFTBurntRequest)(nil), "lbm.collection.v1.QueryFTBurntRequest")
+	proto.RegisterType((*QueryFTBurntResponse)(nil), "lbm.collection.v1.QueryFTBurntResponse")
+	proto.RegisterType((*QueryNFTSupplyRequest)(nil), "lbm.collection.v1.QueryNFTSupplyRequest")
+	proto.RegisterType((*QueryNFTSupplyResponse)(nil), "lbm.collection.v1.QueryNFTSupplyResponse")
+	proto.RegisterType((*QueryNFTMintedRequest)(nil), "lbm.collection.v1.QueryNFTMintedRequest")
+	proto.RegisterType((*QueryNFTMintedResponse)(nil), "lbm.collection.v1.QueryNFTMintedResponse")
+	proto.RegisterType((*QueryNFTBurntRequest)(nil), "lbm.collection.v1.QueryNFTBurntRequest")
+	proto.RegisterType((*QueryNFTBurntResponse)(nil), "lbm.collection.v1.QueryNFTBurntResponse")
+	proto.RegisterType((*QueryContractRequest)(nil), "lbm.collection.v1.QueryContractRequest")
+	proto.RegisterType((*QueryContractResponse)(nil), "lbm.collection.v1.QueryContractResponse")
+	proto.RegisterType((*QueryContractsRequest)(nil), "lbm.collection.v1.QueryContractsRequest")
+	proto.RegisterType((*QueryContractsResponse)(nil), "lbm.collection.v1.QueryContractsResponse")
+	proto.RegisterType((*QueryTokenClassesRequest)(nil), "lbm.collection.v1.QueryTokenClassesRequest")
+	proto.RegisterType((*QueryTokenClassesResponse)(nil), "lbm.collection.v1.QueryTokenClassesResponse")
+	proto.RegisterType((*QueryNFTsByTypeRequest)(nil), "lbm.collection.v1.QueryNFTsByTypeRequest")
+	proto.RegisterType((*QueryNFTsByTypeResponse)(nil), "lbm.collection.v1.QueryNFTsByTypeResponse")
+	proto.RegisterType((*QueryFTHoldersRequest)(nil), "lbm.collection.v1.QueryFTHoldersRequest")
+	proto.RegisterType((*QueryFTHoldersResponse)(nil), "lbm.collection.v1.QueryFTHoldersResponse")
+	proto.RegisterType((*QueryOwnerNFTsRequest)(nil), "lbm.collection.v1.QueryOwnerNFTsRequest")
+	proto.RegisterType((*QueryOwnerNFTsResponse)(nil), "lbm.collection.v1.QueryOwnerNFTsResponse")
+	proto.RegisterType((*QueryNFTsByTraitRequest)(nil), "lbm.collection.v1.QueryNFTsByTraitRequest")
+	proto.RegisterType((*QueryNFTsByTraitResponse)(nil), "lbm.collection.v1.QueryNFTsByTraitResponse")
+	proto.RegisterType((*QueryTokenClassTypeNameRequest)(nil), "lbm.collection.v1.QueryTokenClassTypeNameRequest")
+	proto.RegisterType((*QueryTokenClassTypeNameResponse)(nil), "lbm.collection.v1.QueryTokenClassTypeNameResponse")
+	proto.RegisterType((*QueryTokenTypeRequest)(nil), "lbm.collection.v1.QueryTokenTypeRequest")
+	proto.RegisterType((*QueryTokenTypeResponse)(nil), "lbm.collection.v1.QueryTokenTypeResponse")
+	proto.RegisterType((*QueryTokenRequest)(nil), "lbm.collection.v1.QueryTokenRequest")
+	proto.RegisterType((*QueryTokenResponse)(nil), "lbm.collection.v1.QueryTokenResponse")
+	proto.RegisterType((*QueryRootRequest)(nil), "lbm.collection.v1.QueryRootRequest")
+	proto.RegisterType((*QueryRootResponse)(nil), "lbm.collection.v1.QueryRootResponse")
+	proto.RegisterType((*QueryHasParentRequest)(nil), "lbm.collection.v1.QueryHasParentRequest")
+	proto.RegisterType((*QueryHasParentResponse)(nil), "lbm.collection.v1.QueryHasParentResponse")
+	proto.RegisterType((*QueryParentRequest)(nil), "lbm.collection.v1.QueryParentRequest")
+	proto.RegisterType((*QueryParentResponse)(nil), "lbm.collection.v1.QueryParentResponse")
+	proto.RegisterType((*QueryChildrenRequest)(nil), "lbm.collection.v1.QueryChildrenRequest")
+	proto.RegisterType((*QueryChildrenResponse)(nil), "lbm.collection.v1.QueryChildrenResponse")
+	proto.RegisterType((*QueryRoyaltyRequest)(nil), "lbm.collection.v1.QueryRoyaltyRequest")
+	proto.RegisterType((*QueryRoyaltyResponse)(nil), "lbm.collection.v1.QueryRoyaltyResponse")
+	proto.RegisterType((*QueryNFTHistoryRequest)(nil), "lbm.collection.v1.QueryNFTHistoryRequest")
+	proto.RegisterType((*QueryNFTHistoryResponse)(nil), "lbm.collection.v1.QueryNFTHistoryResponse")
+	proto.RegisterType((*QueryGranteeGrantsRequest)(nil), "lbm.collection.v1.QueryGranteeGrantsRequest")
+	proto.RegisterType((*QueryGranteeGrantsResponse)(nil), "lbm.collection.v1.QueryGranteeGrantsResponse")
+	proto.RegisterType((*QueryIsOperatorForRequest)(nil), "lbm.collection.v1.QueryIsOperatorForRequest")
+	proto.RegisterType((*QueryIsOperatorForResponse)(nil), "lbm.collection.v1.QueryIsOperatorForResponse")
+	proto.RegisterType((*QueryHoldersByOperatorRequest)(nil), "lbm.collection.v1.QueryHoldersByOperatorRequest")
+	proto.RegisterType((*QueryHoldersByOperatorResponse)(nil), "lbm.collection.v1.QueryHoldersByOperatorResponse")
 }
 
-func (m *QueryFTBurntResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("lbm/collection/v1/query.proto", fileDescriptor_a09de688aac2ee73) }
+
+var fileDescriptor_a09de688aac2ee73 = []byte{
+	// 2304 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x9a, 0xcd, 0x6f, 0xdc, 0xc6,
+	0x15, 0xc0, 0x35, 0xb2, 0x3e, 0x76, 0x9f, 0x62, 0x20, 0x1e, 0xcb, 0x8a, 0xc4, 0xda, 0xeb, 0x80,
+	0x75, 0x64, 0x49, 0x89, 0x96, 0x91, 0xd2, 0xd4, 0x69, 0x1b, 0xc7, 0xd1, 0x2a, 0xfa, 0xb2, 0x63,
+	0x7d, 0xac, 0xd5, 0x06, 0x48, 0x0b, 0xa8, 0xdc, 0x5d, 0x5a, 0x62, 0xb5, 0x22, 0x37, 0x24, 0xd7,
+	0xed, 0x46, 0xd0, 0xa5, 0x06, 0x7a, 0x4e, 0xd0, 0x4b, 0xea, 0xa6, 0x41, 0x91, 0x06, 0x49, 0x91,
+	0xa4, 0x48, 0x0a, 0xa4, 0xb7, 0xfe, 0x01, 0x41, 0x4f, 0x41, 0x7b, 0x29, 0x7a, 0x08, 0x0a, 0xbb,
+	0x7f, 0x48, 0xc1, 0x99, 0x37, 0x5c, 0x92, 0x22, 0x97, 0x5c, 0x2d, 0x05, 0xf8, 0xa4, 0x9d, 0xe1,
+	0x9b, 0x37, 0xbf, 0x79, 0xf3, 0x66, 0xe6, 0xcd, 0x1b, 0xc1, 0xa5, 0x7a, 0xe5, 0x40, 0xa9, 0x9a,
+	0xf5, 0xba, 0x56, 0x75, 0x74, 0xd3, 0x50, 0xee, 0xcd, 0x29, 0x6f, 0x35, 0x35, 0xab, 0x55, 0x6c,
+	0x58, 0xa6, 0x63, 0xd2, 0x73, 0xf5, 0xca, 0x41, 0xb1, 0xfd, 0xb9, 0x78, 0x6f, 0x4e, 0x9a, 0xa9,
+	0x9a, 0xf6, 0x81, 0x69, 0x2b, 0x15, 0xd5, 0xd6, 0xb8, 0xac, 0x72, 0x6f, 0xae, 0xa2, 0x39, 0xea,
+	0x9c, 0xd2, 0x50, 0x77, 0x75, 0x43, 0x65, 0x82, 0xac, 0xb9, 0x54, 0xf0, 0xcb, 0x0a, 0xa9, 0xaa,
+	0xa9, 0x8b, 0xef, 0x17, 0x77, 0x4d, 0x73, 0xb7, 0xae, 0x29, 0x6a, 0x43, 0x57, 0x54, 0xc3, 0x30,
+	0x1d, 0xd6, 0xd8, 0xc6, 0xaf, 0xf2, 0x71, 0xb6, 0x76, 0x09, 0x65, 0x26, 0x50, 0x03, 0x2b, 0x55,
+	0x9a, 0x77, 0x15, 0xd5, 0x40, 0x76, 0x69, 0x74, 0xd7, 0xdc, 0x35, 0xd9, 0x4f, 0xc5, 0xfd, 0x25,
+	0x1a, 0x70, 0xa4, 0x1d, 0xfe, 0x81, 0x17, 0xf8, 0x27, 0x79, 0x1f, 0xce, 0x6f, 0xb9, 0xe3, 0x29,
+	0xa9, 0x75, 0xd5, 0xa8, 0x6a, 0x65, 0xed, 0xad, 0xa6, 0x66, 0x3b, 0xf4, 0x32, 0x8c, 0x54, 0x4d,
+	0xc3, 0xb1, 0xd4, 0xaa, 0xb3, 0xa3, 0xd7, 0xc6, 0xc9, 0xd3, 0x64, 0x2a, 0x5f, 0x06, 0x51, 0xb5,
+	0x56, 0xa3, 0xe3, 0x30, 0xac, 0xd6, 0x6a, 0x96, 0x66, 0xdb, 0xe3, 0xfd, 0xec, 0xa3, 0x28, 0xd2,
+	0x09, 0xc8, 0x39, 0xe6, 0xbe, 0x66, 0xb8, 0xed, 0xce, 0xf0, 0x4f, 0xac, 0xbc, 0x56, 0x93, 0x37,
+	0x60, 0x34, 0xd8, 0x99, 0xdd, 0x30, 0x0d, 0x5b, 0xa3, 0xd7, 0x60, 0xb8, 0xc2, 0xab, 0x58, 0x4f,
+	0x23, 0xf3, 0x4f, 0x15, 0x8f, 0xcd, 0x41, 0x71, 0xd1, 0xd4, 0x8d, 0xd2, 0xc0, 0xd7, 0xdf, 0x5e,
+	0xee, 0x2b, 0x0b, 0x69, 0xf9, 0x7d, 0x02, 0x4f, 0x31, 0x8d, 0x0b, 0xf5, 0x3a, 0x2a, 0xb5, 0x33,
+	0x18, 0xc2, 0x32, 0x40, 0x7b, 0x5a, 0xd9, 0x20, 0x46, 0xe6, 0x27, 0x8b, 0x68, 0x37, 0x77, 0x5e,
+	0x8b, 0xdc, 0x5f, 0x70, 0x76, 0x8b, 0x9b, 0xea, 0xae, 0xb0, 0x5c, 0xd9, 0xd7, 0x52, 0xfe, 0x80,
+	0xc0, 0xf8, 0x71, 0x3c, 0x1c, 0xf4, 0x0f, 0x20, 0x87, 0xc3, 0xb0, 0xc7, 0xc9, 0xd3, 0x67, 0x92,
+	0x47, 0xed, 0x89, 0xd3, 0x95, 0x00, 0x5f, 0x3f, 0xe3, 0xbb, 0x9a, 0xc8, 0xc7, 0xfb, 0x0d, 0x00,
+	0x1e, 0xc0, 0x05, 0xc6, 0x77, 0xa7, 0xa1, 0x19, 0x35, 0xb5, 0x52, 0x3f, 0xe5, 0xf9, 0xdf, 0x82,
+	0xb1, 0x70, 0x77, 0xbd, 0x7a, 0xc0, 0x2f, 0x80, 0x32, 0x95, 0xaf, 0x9b, 0xd5, 0x7d, 0xad, 0x76,
+	0xba, 0xf8, 0xf7, 0x09, 0x9c, 0x0f, 0x74, 0xd6, 0x23, 0x3c, 0x7d, 0x01, 0x06, 0xeb, 0x66, 0x75,
+	0xdf, 0x65, 0x88, 0x9b, 0x7f, 0xb7, 0x2b, 0x6c, 0xc6, 0x65, 0xe5, 0x32, 0x2e, 0xa2, 0xe5, 0xed,
+	0x3b, 0xcd, 0x46, 0xa3, 0xde, 0x4a, 0x3d, 0x66, 0xff, 0xc8, 0xfa, 0x83, 0x23, 0xab, 0xc2, 0x85,
+	0x90, 0x4e, 0x1c, 0xda, 0x4d, 0x18, 0xb2, 0x59, 0x0d, 0xd7, 0x57, 0x9a, 0x77, 0x49, 0xfe, 0xf3,
+	0xed, 0xe5, 0x99, 0x5d, 0xdd, 0xd9, 0x6b, 0x56, 0x8a, 0x55, 0xf3, 0x40, 0x59, 0xd6, 0x0d, 0xbb,
+	0xba, 0xa7, 0xab, 0xca, 0x5d, 0xfc, 0x31, 0x6b, 0xd7, 0xf6, 0x15, 0xa7, 0xd5, 0xd0, 0xec, 0xe2,
+	0x9a, 0xe1, 0x94, 0x51, 0x83, 0x0f, 0xfc, 0xb6, 0x6e, 0x38, 0x5a, 0x2d, 0x5b, 0x70, 0xa1, 0xb3,
+	0x0d, 0x7e, 0xc0, 0x6a, 0x7a, 0x01, 0xe7, 0x1a, 0xe4, 0x2d, 0x9c, 0xf6, 0xe5, 0xed, 0x52, 0xd3,
+	0x32, 0x9c, 0x2c, 0xb8, 0x7f, 0x0e, 0xa3, 0x41, 0x95, 0x88, 0xbd, 0x0a, 0x83, 0x15, 0xb7, 0xa2,
+	0x07, 0x6a, 0xae, 0x40, 0x7e, 0x03, 0x2d, 0xb3, 0xde, 0xb5, 0x9f, 0x5c, 0x02, 0xe0, 0xd8, 0xae,
+	0x4e, 0x04, 0xcf, 0xb3, 0x9a, 0xed, 0x56, 0x43, 0x93, 0x6b, 0x30, 0x16, 0x56, 0x7c, 0x0a, 0xce,
+	0xe2, 0xc3, 0xef, 0xd2, 0x5b, 0xd2, 0xe3, 0x9f, 0xa2, 0xcb, 0xfc, 0x04, 0xe7, 0x77, 0xbd, 0x5b,
+	0x9f, 0x49, 0xa0, 0x57, 0xe1, 0x42, 0x48, 0x6f, 0xe6, 0x8e, 0x73, 0x0d, 0xd1, 0x17, 0x11, 0x2a,
+	0x2d, 0xba, 0xfc, 0x09, 0x81, 0x0b, 0xa1, 0x96, 0x08, 0x77, 0x1d, 0x72, 0x42, 0x0e, 0x77, 0xc8,
+	0xef, 0x44, 0xee, 0x90, 0x5c, 0x44, 0x1c, 0x77, 0xa2, 0x09, 0xdd, 0x80, 0xb3, 0x6a, 0xed, 0x40,
+	0x37, 0x76, 0xf6, 0x74, 0xdb, 0x31, 0xad, 0x16, 0x6e, 0x97, 0x57, 0x22, 0x74, 0x2c, 0xb8, 0x72,
+	0xab, 0x5c, 0x6c, 0xc9, 0x70, 0xac, 0x16, 0x2a, 0x7b, 0x42, 0xf5, 0x7d, 0x90, 0x77, 0x42, 0xa0,
+	0x5e, 0xcc, 0x10, 0x3c, 0xf8, 0xc9, 0x89, 0x0f, 0xfe, 0x0f, 0x09, 0x8c, 0x85, 0x7b, 0x40, 0x5b,
+	0xdc, 0x80, 0xbc, 0x18, 0x98, 0x38, 0xf7, 0x53, 0x18, 0xa3, 0xdd, 0x26, 0xbb, 0xc3, 0xff, 0xbe,
+	0x88, 0x4e, 0xb6, 0x5d, 0xf7, 0x5a, 0xac, 0xab, 0xb6, 0xdd, 0x45, 0xf4, 0xb4, 0x1c, 0x81, 0x71,
+	0x12, 0x53, 0xfd, 0x99, 0xc0, 0x44, 0x04, 0x05, 0x5a, 0xab, 0x04, 0xc3, 0x55, 0x5e, 0x85, 0xb6,
+	0x1a, 0x2d, 0xf2, 0xe0, 0xb7, 0x28, 0x82, 0xdf, 0xe2, 0x82, 0xd1, 0x2a, 0x51, 0xd7, 0x48, 0xff,
+	0xf8, 0x6a, 0x16, 0xda, 0x4a, 0xca, 0xa2, 0x61, 0x76, 0x06, 0xfb, 0x23, 0x69, 0xef, 0x1d, 0x76,
+	0xa9, 0xe5, 0x2e, 0xc8, 0x8c, 0xd6, 0x75, 0x66, 0x11, 0xe7, 0x7b, 0x22, 0x20, 0xf6, 0x23, 0xa2,
+	0x2d, 0xbf, 0x07, 0x43, 0xac, 0x43, 0x61, 0xca, 0xb1, 0x08, 0xb7, 0x73, 0xf7, 0x15, 0xee, 0x71,
+	0x28, 0x9b, 0x9d, 0xf5, 0x7e, 0x4f, 0xbc, 0xb3, 0x7a, 0xd5, 0xac, 0xd7, 0x34, 0xcb, 0xce, 0xe0,
+	0x20, 0xcd, 0xcc, 0x70, 0xef, 0x8b, 0xb9, 0xf5, 0xd1, 0x79, 0x81, 0xfa, 0xf0, 0x1e, 0xaf, 0x42,
+	0xc3, 0x4d, 0x44, 0x18, 0x8e, 0x37, 0x12, 0x01, 0x1e, 0xca, 0x67, 0x67, 0xbc, 0x26, 0xda, 0x6e,
+	0xe3, 0x97, 0x86, 0x66, 0xb9, 0x73, 0x2b, 0x6c, 0x37, 0x0a, 0x83, 0xa6, 0x5b, 0x87, 0x56, 0xe3,
+	0x85, 0xcc, 0x16, 0xe7, 0x7b, 0xc2, 0x2a, 0xbe, 0x7e, 0xd1, 0x2a, 0x2f, 0xc2, 0x80, 0x71, 0xb7,
+	0xe3, 0x16, 0xe6, 0xb6, 0xa9, 0xb5, 0x1d, 0x8a, 0x89, 0x67, 0x67, 0x91, 0x4f, 0x43, 0x9e, 0x6e,
+	0xa9, 0x7a, 0xfa, 0x53, 0xf6, 0x49, 0x38, 0xb3, 0xaf, 0xb5, 0xd0, 0x97, 0xdc, 0x9f, 0xae, 0x1d,
+	0xef, 0xa9, 0xf5, 0xa6, 0x86, 0x31, 0x3f, 0x2f, 0x84, 0xec, 0x38, 0x70, 0x62, 0x3b, 0xfe, 0x4e,
+	0x6c, 0xb5, 0x01, 0xd8, 0xc7, 0x63, 0x5d, 0xfe, 0x0c, 0x0a, 0xa1, 0xfd, 0xd7, 0xdd, 0x35, 0xd6,
+	0xd5, 0x03, 0xad, 0x9b, 0xf5, 0xc9, 0x36, 0x5b, 0xdf, 0xfa, 0x64, 0xe5, 0xb5, 0x9a, 0xfc, 0x22,
+	0x5c, 0x8e, 0xd5, 0x8e, 0xe3, 0xa7, 0x30, 0x60, 0xa8, 0x07, 0x1a, 0xea, 0x65, 0xbf, 0xbd, 0xf0,
+	0x6f, 0x5b, 0xec, 0x90, 0x59, 0x05, 0x50, 0x3f, 0x85, 0xb1, 0xb0, 0x62, 0xc4, 0x58, 0x08, 0x34,
+	0xe4, 0x67, 0xff, 0xc5, 0x88, 0xa9, 0xf0, 0x5a, 0x8a, 0xa3, 0xb9, 0xad, 0x7c, 0x03, 0xce, 0xb5,
+	0x95, 0x67, 0x71, 0x4d, 0x58, 0x06, 0xea, 0x57, 0x88, 0xa4, 0xcf, 0xc3, 0x20, 0x13, 0x40, 0xc8,
+	0xe8, 0x23, 0x11, 0xef, 0x8c, 0x4c, 0x50, 0x5e, 0x87, 0x27, 0x99, 0x9e, 0xb2, 0x69, 0x66, 0x72,
+	0x7d, 0x59, 0x82, 0x73, 0x3e, 0x7d, 0x1e, 0xd6, 0x80, 0x65, 0x9a, 0x22, 0xc2, 0xeb, 0xec, 0xc5,
+	0x4c, 0x52, 0xbe, 0x83, 0xb3, 0xbc, 0xaa, 0xda, 0x9b, 0xaa, 0xa5, 0x65, 0x73, 0xb5, 0xba, 0x06,
+	0x63, 0x61, 0xa5, 0x08, 0x78, 0x09, 0x60, 0x4f, 0xb5, 0x77, 0x1a, 0xac, 0x96, 0x29, 0xcd, 0x95,
+	0xf3, 0x7b, 0x42, 0x4c, 0xde, 0x44, 0x63, 0x67, 0x87, 0x72, 0x0b, 0xce, 0x07, 0x34, 0xb6, 0x17,
+	0xbc, 0x8f, 0x21, 0x71, 0xc1, 0x73, 0x59, 0xf9, 0x01, 0x11, 0x81, 0xf9, 0x9e, 0x5e, 0xaf, 0x59,
+	0x99, 0x38, 0x58, 0x66, 0xc7, 0xe7, 0x03, 0x2f, 0xf6, 0xf7, 0xe0, 0x70, 0xb0, 0x2f, 0x41, 0xae,
+	0x8a, 0x75, 0xa9, 0xf6, 0x37, 0x4f, 0x3a, 0xbb, 0x1d, 0xee, 0x5d, 0x91, 0xb7, 0x29, 0x9b, 0x2d,
+	0xb5, 0xee, 0x64, 0x91, 0x31, 0xa1, 0xaf, 0x00, 0xd8, 0x6a, 0x5d, 0xdb, 0x69, 0x58, 0x7a, 0x55,
+	0x43, 0xc3, 0x4d, 0x04, 0xe0, 0x04, 0x96, 0x2f, 0xf1, 0x93, 0x77, 0x9b, 0x6c, 0xba, 0x2d, 0x64,
+	0x13, 0x46, 0x83, 0x48, 0x68, 0xae, 0x8b, 0x90, 0xb7, 0xb4, 0xaa, 0xde, 0xd0, 0x85, 0x7b, 0xe4,
+	0xcb, 0xed, 0x0a, 0x37, 0x14, 0xb1, 0x78, 0x83, 0xf1, 0xfe, 0x74, 0x5d, 0x0a, 0xf9, 0x76, 0x80,
+	0xb3, 0xbe, 0xbc, 0x8d, 0xf7, 0xa0, 0xc7, 0xc9, 0x81, 0x3e, 0xf6, 0x1d, 0xe7, 0x1e, 0x5e, 0xfb,
+	0x12, 0xa0, 0x19, 0x8e, 0xa5, 0x7b, 0x97, 0x00, 0x39, 0xda, 0x83, 0x22, 0xee, 0x7d, 0xa2, 0x61,
+	0x76, 0xce, 0xf4, 0x81, 0xb8, 0xaf, 0xac, 0x58, 0xaa, 0xe1, 0x68, 0x1a, 0xfb, 0xd3, 0x55, 0xd2,
+	0x79, 0x97, 0x37, 0x14, 0x96, 0xc4, 0x62, 0x66, 0x96, 0xfc, 0x03, 0x01, 0x29, 0x0a, 0x10, 0x8d,
+	0xf9, 0x7d, 0x18, 0x62, 0x3d, 0x0a, 0x5b, 0x8e, 0x47, 0xd8, 0x92, 0x35, 0x11, 0xdb, 0x0f, 0x97,
+	0xce, 0xce, 0x80, 0x0d, 0xb4, 0xdf, 0x9a, 0xbd, 0xd1, 0xd0, 0x2c, 0xd5, 0x31, 0xad, 0x65, 0xd3,
+	0x4a, 0x6d, 0x3f, 0x09, 0x72, 0x26, 0x36, 0x43, 0x03, 0x7a, 0x65, 0x3a, 0x06, 0x43, 0x3c, 0xf0,
+	0xc6, 0x20, 0x0e, 0x4b, 0xf2, 0xcb, 0x20, 0x45, 0xf5, 0x88, 0x06, 0x29, 0x00, 0xa8, 0x4d, 0x67,
+	0xcf, 0xb4, 0xf4, 0xb7, 0x31, 0xf5, 0x93, 0x2b, 0xfb, 0x6a, 0xe4, 0x8f, 0x08, 0x5c, 0xe2, 0x07,
+	0x0a, 0xd3, 0x66, 0x97, 0x5a, 0x42, 0x4b, 0x26, 0xd0, 0x59, 0x4d, 0xfb, 0x7d, 0x02, 0x85, 0x38,
+	0x4c, 0x1c, 0xe9, 0x78, 0xf0, 0x22, 0x93, 0xcf, 0xfe, 0x9e, 0x32, 0xff, 0xd1, 0x24, 0x0c, 0x32,
+	0x0a, 0xfa, 0x39, 0x81, 0x61, 0x7c, 0xf3, 0xa0, 0x93, 0x11, 0x3e, 0x16, 0xf1, 0xea, 0x24, 0x5d,
+	0x4d, 0x94, 0xe3, 0x5d, 0xca, 0x9b, 0xbf, 0xfe, 0xd7, 0xff, 0x7e, 0xdb, 0x7f, 0x93, 0xae, 0x2a,
+	0x51, 0xcf, 0x65, 0xdc, 0xee, 0xb6, 0x72, 0xe8, 0x9b, 0x95, 0x23, 0x45, 0xbc, 0x9e, 0x28, 0x87,
+	0x98, 0xea, 0x3f, 0x52, 0x0e, 0xc5, 0xae, 0x76, 0x44, 0xff, 0x42, 0x60, 0xc4, 0xf7, 0x4a, 0x43,
+	0x67, 0xe2, 0x50, 0x8e, 0xbf, 0x34, 0x49, 0xcf, 0xa6, 0x92, 0x45, 0xf4, 0x25, 0x86, 0x7e, 0x83,
+	0x5e, 0xef, 0x09, 0x9d, 0xfe, 0x9d, 0x40, 0xde, 0x7b, 0x46, 0xa1, 0x53, 0x71, 0x04, 0xe1, 0x87,
+	0x1d, 0x69, 0x3a, 0x85, 0x24, 0x92, 0xbe, 0xc9, 0x48, 0xb7, 0x69, 0xb9, 0x0b, 0x52, 0x5b, 0x68,
+	0xd9, 0xe9, 0x6c, 0xee, 0x2f, 0x09, 0x0c, 0xf1, 0x57, 0x14, 0xfa, 0x4c, 0x1c, 0x51, 0xe0, 0x49,
+	0x47, 0x9a, 0x4c, 0x12, 0x43, 0xea, 0x37, 0x18, 0xf5, 0x16, 0xdd, 0xe8, 0x82, 0xba, 0xce, 0x54,
+	0x24, 0x20, 0x7f, 0x42, 0x20, 0x27, 0x52, 0xde, 0x34, 0xd6, 0x53, 0x43, 0xd9, 0x76, 0x69, 0x2a,
+	0x59, 0x10, 0xc1, 0x57, 0x19, 0x78, 0x89, 0xbe, 0xda, 0x05, 0xf8, 0x5d, 0xc7, 0xf6, 0x21, 0x2a,
+	0x3c, 0x77, 0x8e, 0xa4, 0x3c, 0xbb, 0xdd, 0x89, 0x34, 0x90, 0x58, 0x97, 0xa6, 0x92, 0x05, 0xb3,
+	0x23, 0xe5, 0x69, 0x72, 0xfa, 0x27, 0x02, 0xc3, 0x98, 0xc9, 0x8e, 0xdf, 0x24, 0x82, 0x29, 0x74,
+	0xe9, 0x6a, 0xa2, 0x1c, 0x62, 0xae, 0x30, 0xcc, 0x05, 0x7a, 0xe3, 0xe4, 0x98, 0x2c, 0x23, 0x4e,
+	0xbf, 0x22, 0x90, 0xf7, 0x5e, 0x3b, 0xe2, 0xd7, 0x5a, 0xf8, 0xa5, 0x45, 0x9a, 0x4e, 0x21, 0x89,
+	0xac, 0x65, 0xc6, 0xfa, 0x3a, 0xbd, 0xd9, 0x05, 0x6b, 0xfb, 0xb6, 0xea, 0x31, 0xbb, 0x05, 0xcf,
+	0x0d, 0x10, 0x1b, 0xfd, 0xa0, 0x13, 0x76, 0xd0, 0x11, 0xa6, 0x53, 0x48, 0x9e, 0x06, 0x36, 0xfa,
+	0xc4, 0x97, 0x04, 0x72, 0xe2, 0x79, 0x23, 0xde, 0x7b, 0x43, 0x0f, 0x2b, 0xd2, 0x54, 0xb2, 0x20,
+	0x32, 0x6f, 0x31, 0xe6, 0x5b, 0x74, 0x2d, 0x0b, 0x66, 0xee, 0x20, 0xef, 0x12, 0xc8, 0x89, 0x84,
+	0x7d, 0x3c, 0x72, 0xe8, 0x41, 0x45, 0x9a, 0x4a, 0x16, 0x44, 0xe4, 0x79, 0x86, 0xfc, 0x1c, 0x9d,
+	0x49, 0x8f, 0x4c, 0x7f, 0x43, 0x20, 0x2f, 0x14, 0xd9, 0x34, 0xb1, 0x2f, 0x3b, 0x71, 0xf6, 0x8f,
+	0x3d, 0x65, 0xc8, 0x57, 0x18, 0x56, 0x81, 0x5e, 0xec, 0x84, 0x45, 0x3f, 0x23, 0xf0, 0x84, 0x3f,
+	0xb7, 0x4f, 0x63, 0x8f, 0xcb, 0x88, 0x77, 0x08, 0xe9, 0xb9, 0x74, 0xc2, 0x48, 0xf4, 0x2a, 0x23,
+	0xfa, 0x21, 0x7d, 0xa9, 0xeb, 0xb9, 0x15, 0x8f, 0x05, 0x7f, 0x23, 0x00, 0xed, 0xdc, 0x39, 0xed,
+	0xb4, 0x16, 0x82, 0x4f, 0x00, 0xd2, 0x4c, 0x1a, 0xd1, 0x1e, 0xe2, 0x97, 0x58, 0x1f, 0x64, 0x79,
+	0xd5, 0xcf, 0x08, 0xe4, 0xbd, 0xd4, 0x35, 0xed, 0xb0, 0x97, 0x07, 0x73, 0xef, 0xd2, 0x74, 0x0a,
+	0x49, 0x84, 0x5e, 0x63, 0xd0, 0x8b, 0x74, 0xe1, 0xe4, 0xfb, 0xa9, 0x88, 0x37, 0xdf, 0x21, 0x90,
+	0xf7, 0x52, 0xca, 0xf1, 0xb4, 0xe1, 0x6c, 0xb7, 0x34, 0x9d, 0x42, 0x12, 0x69, 0x8b, 0x8c, 0x76,
+	0x8a, 0x4e, 0x46, 0xd0, 0xb2, 0x24, 0xb9, 0xad, 0x1c, 0xb2, 0xbf, 0x68, 0xc0, 0xcf, 0x09, 0x8c,
+	0xf8, 0xb2, 0xb3, 0x34, 0x69, 0x3a, 0x7d, 0xf9, 0x66, 0xe9, 0xd9, 0x54, 0xb2, 0x08, 0xf6, 0x1a,
+	0x03, 0x7b, 0x85, 0xbe, 0xdc, 0xcd, 0xdc, 0xbb, 0x1a, 0x6c, 0xe5, 0x70, 0x5f, 0x6b, 0x21, 0xee,
+	0x3f, 0x09, 0xd0, 0xe3, 0x39, 0x55, 0x3a, 0x97, 0xbc, 0x5c, 0x42, 0xd9, 0x5d, 0x69, 0xbe, 0x9b,
+	0x26, 0x38, 0x86, 0x1f, 0xb3, 0x31, 0x6c, 0xd0, 0xdb, 0x27, 0x5d, 0x67, 0xca, 0xa1, 0x48, 0x18,
+	0x1f, 0xb1, 0x17, 0xe8, 0x1d, 0x37, 0xeb, 0xeb, 0x06, 0xe1, 0x79, 0x2f, 0xbd, 0x1a, 0xef, 0x16,
+	0xe1, 0xa4, 0xb0, 0x34, 0x9d, 0x42, 0x12, 0xc9, 0x6f, 0x31, 0xf2, 0x25, 0xba, 0x98, 0xc1, 0xca,
+	0xa3, 0x0f, 0x08, 0x0c, 0xb2, 0x2e, 0xe8, 0x95, 0x8e, 0x04, 0x82, 0xf3, 0x99, 0x04, 0xa9, 0x5e,
+	0x3c, 0xc4, 0xd5, 0xe0, 0x5f, 0x6b, 0x2e, 0xdc, 0x40, 0xd9, 0x34, 0x1d, 0xfa, 0xdd, 0xb8, 0x5e,
+	0x7d, 0xd9, 0x60, 0xe9, 0x4a, 0x67, 0xa1, 0x1e, 0x42, 0x2a, 0x23, 0xb4, 0x07, 0x58, 0x2e, 0xd3,
+	0x17, 0x04, 0xf2, 0x5e, 0x82, 0x36, 0x7e, 0xa6, 0xc3, 0x89, 0x61, 0x69, 0x3a, 0x85, 0x24, 0xb2,
+	0xde, 0x66, 0xac, 0x2b, 0x74, 0xa9, 0x07, 0xd6, 0x76, 0xba, 0x98, 0x7e, 0x48, 0x60, 0x08, 0x71,
+	0x63, 0xa7, 0x31, 0xc8, 0x3a, 0x99, 0x24, 0xd6, 0xc3, 0xbe, 0x1a, 0x06, 0x45, 0xc8, 0x4f, 0xdd,
+	0x40, 0x44, 0xe4, 0x4f, 0xe3, 0x03, 0x91, 0x60, 0x02, 0x59, 0x9a, 0x4a, 0x16, 0xec, 0x61, 0xf5,
+	0x84, 0x51, 0xbd, 0xfc, 0xee, 0xc7, 0x04, 0x86, 0x31, 0xfd, 0x19, 0x1f, 0xfc, 0x07, 0x53, 0xb6,
+	0xd2, 0xd5, 0x44, 0x39, 0x24, 0xbd, 0xc9, 0x48, 0x5f, 0xa3, 0xa5, 0x9e, 0x3c, 0x95, 0xc3, 0x7d,
+	0xc1, 0x63, 0x02, 0x4c, 0x2f, 0x76, 0x8c, 0x09, 0x82, 0x99, 0x55, 0x69, 0x26, 0x8d, 0x68, 0x86,
+	0xc4, 0xf8, 0x0f, 0x32, 0xf4, 0xaf, 0x04, 0xce, 0x06, 0xd2, 0x7f, 0x34, 0x36, 0x8e, 0x8a, 0x4a,
+	0x63, 0x4a, 0xb3, 0x29, 0xa5, 0x11, 0x7d, 0x91, 0xa1, 0x5f, 0xa7, 0x3f, 0xea, 0x02, 0x9d, 0xa7,
+	0x15, 0x95, 0xc3, 0x5d, 0xae, 0xf1, 0x88, 0x1a, 0x70, 0x36, 0x90, 0xa0, 0x8b, 0x47, 0x8e, 0xca,
+	0x1c, 0x4a, 0xb3, 0x29, 0xa5, 0x11, 0xb9, 0x8f, 0xbe, 0x0d, 0xe7, 0x8e, 0xa5, 0xca, 0xe8, 0xf3,
+	0xb1, 0xfb, 0x4b, 0x4c, 0xf2, 0x4f, 0x9a, 0xeb, 0xa2, 0x85, 0xe8, 0xbb, 0xb4, 0xf2, 0xf5, 0xc3,
+	0x02, 0xf9, 0xe6, 0x61, 0x81, 0xfc, 0xf7, 0x61, 0x81, 0xbc, 0xf3, 0xa8, 0xd0, 0xf7, 0xcd, 0xa3,
+	0x42, 0xdf, 0xbf, 0x1f, 0x15, 0xfa, 0xde, 0x9c, 0x4d, 0xfc, 0x87, 0xad, 0x5f, 0xf9, 0x0c, 0x5c,
+	0x19, 0x62, 0x6f, 0x7e, 0x2f, 0xfc, 0x7f, 0x00, 0x6d, 0xc0, 0xd4, 0xb4, 0xd3, 0x2e, 0x00, 0x00,
 }
 
-func (m *QueryFTBurntResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Burnt.Size()
-		i -= size
-		if _, err := m.Burnt.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Balance queries the balance of a single token class for a single account.
+	Balance(ctx context.Context, in *QueryBalanceRequest, opts ...grpc.CallOption) (*QueryBalanceResponse, error)
+	// AllBalances queries the balance of all token classes for a single account.
+	AllBalances(ctx context.Context, in *QueryAllBalancesRequest, opts ...grpc.CallOption) (*QueryAllBalancesResponse, error)
+	// Spendable queries the spendable balance of a single token class for a single account.
+	Spendable(ctx context.Context, in *QuerySpendableRequest, opts ...grpc.CallOption) (*QuerySpendableResponse, error)
+	// Locked queries the locked balance of a single token class for a single account.
+	Locked(ctx context.Context, in *QueryLockedRequest, opts ...grpc.CallOption) (*QueryLockedResponse, error)
+	// FTSupply queries the number of tokens from a given contract id and token id.
+	FTSupply(ctx context.Context, in *QueryFTSupplyRequest, opts ...grpc.CallOption) (*QueryFTSupplyResponse, error)
+	// FTMinted queries the number of minted tokens from a given contract id and token id.
+	FTMinted(ctx context.Context, in *QueryFTMintedRequest, opts ...grpc.CallOption) (*QueryFTMintedResponse, error)
+	// FTBurnt queries the number of burnt tokens from a given contract id and token id.
+	FTBurnt(ctx context.Context, in *QueryFTBurntRequest, opts ...grpc.CallOption) (*QueryFTBurntResponse, error)
+	// NFTSupply queries the number of tokens from a given contract id and token type.
+	NFTSupply(ctx context.Context, in *QueryNFTSupplyRequest, opts ...grpc.CallOption) (*QueryNFTSupplyResponse, error)
+	// NFTMinted queries the number of minted tokens from a given contract id and token type.
+	NFTMinted(ctx context.Context, in *QueryNFTMintedRequest, opts ...grpc.CallOption) (*QueryNFTMintedResponse, error)
+	// NFTBurnt queries the number of burnt tokens from a given contract id and token type.
+	NFTBurnt(ctx context.Context, in *QueryNFTBurntRequest, opts ...grpc.CallOption) (*QueryNFTBurntResponse, error)
+	// Contract queries a contract metadata based on its contract id.
+	Contract(ctx context.Context, in *QueryContractRequest, opts ...grpc.CallOption) (*QueryContractResponse, error)
+	// Contracts queries all the contracts.
+	Contracts(ctx context.Context, in *QueryContractsRequest, opts ...grpc.CallOption) (*QueryContractsResponse, error)
+	// TokenClasses queries all the token classes of a contract.
+	// Throws:
+	// - ErrInvalidRequest
+	//   - `contract_id` is of invalid format.
+	TokenClasses(ctx context.Context, in *QueryTokenClassesRequest, opts ...grpc.CallOption) (*QueryTokenClassesResponse, error)
+	// NFTsByType queries all the non-fungible tokens of a token type.
+	// Throws:
+	// - ErrInvalidRequest
+	//   - `contract_id` is of invalid format.
+	//   - `token_type` is of invalid format.
+	NFTsByType(ctx context.Context, in *QueryNFTsByTypeRequest, opts ...grpc.CallOption) (*QueryNFTsByTypeResponse, error)
+	// FTHolders queries all the holders of a fungible token with their balances.
+	// Throws:
+	// - ErrInvalidRequest
+	//   - `contract_id` is of invalid format.
+	//   - `token_id` is of invalid format.
+	FTHolders(ctx context.Context, in *QueryFTHoldersRequest, opts ...grpc.CallOption) (*QueryFTHoldersResponse, error)
+	// OwnerNFTs queries all the non-fungible tokens held by an address, across the contracts.
+	// Note: the tokens attached to other tokens are not included, because their owners are those of their roots.
+	// Throws:
+	// - ErrInvalidAddress
+	//   - `owner` is of invalid format.
+	OwnerNFTs(ctx context.Context, in *QueryOwnerNFTsRequest, opts ...grpc.CallOption) (*QueryOwnerNFTsResponse, error)
+	// NFTsByTrait queries all the non-fungible tokens of a contract which have a given trait value.
+	// Throws:
+	// - ErrInvalidRequest
+	//   - `contract_id` is of invalid format.
+	//   - `key` is of invalid format.
+	//   - `value` is empty.
+	NFTsByTrait(ctx context.Context, in *QueryNFTsByTraitRequest, opts ...grpc.CallOption) (*QueryNFTsByTraitResponse, error)
+	// TokenClassTypeName queries the fully qualified message type name of a token class from its class id.
+	//
+	// Since: 0.46.0 (finschia)
+	TokenClassTypeName(ctx context.Context, in *QueryTokenClassTypeNameRequest, opts ...grpc.CallOption) (*QueryTokenClassTypeNameResponse, error)
+	// TokenType queries metadata of a token type.
+	TokenType(ctx context.Context, in *QueryTokenTypeRequest, opts ...grpc.CallOption) (*QueryTokenTypeResponse, error)
+	// Token queries a metadata of a token from its token id.
+	Token(ctx context.Context, in *QueryTokenRequest, opts ...grpc.CallOption) (*QueryTokenResponse, error)
+	// Root queries the root of a given nft.
+	Root(ctx context.Context, in *QueryRootRequest, opts ...grpc.CallOption) (*QueryRootResponse, error)
+	// HasParent queries whether a given nft has its parent.
+	HasParent(ctx context.Context, in *QueryHasParentRequest, opts ...grpc.CallOption) (*QueryHasParentResponse, error)
+	// Parent queries the parent of a given nft.
+	Parent(ctx context.Context, in *QueryParentRequest, opts ...grpc.CallOption) (*QueryParentResponse, error)
+	// Children queries the children of a given nft.
+	Children(ctx context.Context, in *QueryChildrenRequest, opts ...grpc.CallOption) (*QueryChildrenResponse, error)
+	// Royalty queries the royalty paid on a sale of a given nft.
+	Royalty(ctx context.Context, in *QueryRoyaltyRequest, opts ...grpc.CallOption) (*QueryRoyaltyResponse, error)
+	// NFTHistory queries the history of a given nft, in chronological order.
+	// Note: the history is recorded only while it is enabled on the contract, and
+	// the entries older than `nft_history_retention` of the params are pruned.
+	// Throws:
+	// - ErrInvalidRequest
+	//   - `contract_id` is of invalid format.
+	//   - `token_id` is of invalid format.
+	NFTHistory(ctx context.Context, in *QueryNFTHistoryRequest, opts ...grpc.CallOption) (*QueryNFTHistoryResponse, error)
+	// GranteeGrants queries all permissions on a given grantee.
+	GranteeGrants(ctx context.Context, in *QueryGranteeGrantsRequest, opts ...grpc.CallOption) (*QueryGranteeGrantsResponse, error)
+	// IsOperatorFor queries whether the operator is authorized by the holder.
+	IsOperatorFor(ctx context.Context, in *QueryIsOperatorForRequest, opts ...grpc.CallOption) (*QueryIsOperatorForResponse, error)
+	// HoldersByOperator queries holders of a given operator.
+	HoldersByOperator(ctx context.Context, in *QueryHoldersByOperatorRequest, opts ...grpc.CallOption) (*QueryHoldersByOperatorResponse, error)
 }
 
-func (m *QueryNFTSupplyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryNFTSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *QueryNFTSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenType) > 0 {
-		i -= len(m.TokenType)
-		copy(dAtA[i:], m.TokenType)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenType)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ContractId) > 0 {
-		i -= len(m.ContractId)
-		copy(dAtA[i:], m.ContractId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) Balance(ctx context.Context, in *QueryBalanceRequest, opts ...grpc.CallOption) (*QueryBalanceResponse, error) {
+	out := new(QueryBalanceResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/Balance", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryNFTSupplyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AllBalances(ctx context.Context, in *QueryAllBalancesRequest, opts ...grpc.CallOption) (*QueryAllBalancesResponse, error) {
+	out := new(QueryAllBalancesResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/AllBalances", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryNFTSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Spendable(ctx context.Context, in *QuerySpendableRequest, opts ...grpc.CallOption) (*QuerySpendableResponse, error) {
+	out := new(QuerySpendableResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/Spendable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryNFTSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Supply.Size()
-		i -= size
-		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) Locked(ctx context.Context, in *QueryLockedRequest, opts ...grpc.CallOption) (*QueryLockedResponse, error) {
+	out := new(QueryLockedResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/Locked", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryNFTMintedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) FTSupply(ctx context.Context, in *QueryFTSupplyRequest, opts ...grpc.CallOption) (*QueryFTSupplyResponse, error) {
+	out := new(QueryFTSupplyResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/FTSupply", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryNFTMintedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) FTMinted(ctx context.Context, in *QueryFTMintedRequest, opts ...grpc.CallOption) (*QueryFTMintedResponse, error) {
+	out := new(QueryFTMintedResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/FTMinted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryNFTMintedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenType) > 0 {
-		i -= len(m.TokenType)
-		copy(dAtA[i:], m.TokenType)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenType)))
-		i--
-		dAtA[i] = 0x12
+func (c *queryClient) FTBurnt(ctx context.Context, in *QueryFTBurntRequest, opts ...grpc.CallOption) (*QueryFTBurntResponse, error) {
+	out := new(QueryFTBurntResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/FTBurnt", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ContractId) > 0 {
-		i -= len(m.ContractId)
-		copy(dAtA[i:], m.ContractId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *queryClient) NFTSupply(ctx context.Context, in *QueryNFTSupplyRequest, opts ...grpc.CallOption) (*QueryNFTSupplyResponse, error) {
+	out := new(QueryNFTSupplyResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/NFTSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryNFTMintedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) NFTMinted(ctx context.Context, in *QueryNFTMintedRequest, opts ...grpc.CallOption) (*QueryNFTMintedResponse, error) {
+	out := new(QueryNFTMintedResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/NFTMinted", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryNFTMintedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryNFTMintedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Minted.Size()
-		i -= size
-		if _, err := m.Minted.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) NFTBurnt(ctx context.Context, in *QueryNFTBurntRequest, opts ...grpc.CallOption) (*QueryNFTBurntResponse, error) {
+	out := new(QueryNFTBurntResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/NFTBurnt", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryNFTBurntRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Contract(ctx context.Context, in *QueryContractRequest, opts ...grpc.CallOption) (*QueryContractResponse, error) {
+	out := new(QueryContractResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/Contract", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryNFTBurntRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryNFTBurntRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenType) > 0 {
-		i -= len(m.TokenType)
-		copy(dAtA[i:], m.TokenType)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenType)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ContractId) > 0 {
-		i -= len(m.ContractId)
-		copy(dAtA[i:], m.ContractId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) Contracts(ctx context.Context, in *QueryContractsRequest, opts ...grpc.CallOption) (*QueryContractsResponse, error) {
+	out := new(QueryContractsResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/Contracts", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryNFTBurntResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TokenClasses(ctx context.Context, in *QueryTokenClassesRequest, opts ...grpc.CallOption) (*QueryTokenClassesResponse, error) {
+	out := new(QueryTokenClassesResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/TokenClasses", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryNFTBurntResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) NFTsByType(ctx context.Context, in *QueryNFTsByTypeRequest, opts ...grpc.CallOption) (*QueryNFTsByTypeResponse, error) {
+	out := new(QueryNFTsByTypeResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/NFTsByType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryNFTBurntResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Burnt.Size()
-		i -= size
-		if _, err := m.Burnt.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) FTHolders(ctx context.Context, in *QueryFTHoldersRequest, opts ...grpc.CallOption) (*QueryFTHoldersResponse, error) {
+	out := new(QueryFTHoldersResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/FTHolders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryContractRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OwnerNFTs(ctx context.Context, in *QueryOwnerNFTsRequest, opts ...grpc.CallOption) (*QueryOwnerNFTsResponse, error) {
+	out := new(QueryOwnerNFTsResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/OwnerNFTs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryContractRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) NFTsByTrait(ctx context.Context, in *QueryNFTsByTraitRequest, opts ...grpc.CallOption) (*QueryNFTsByTraitResponse, error) {
+	out := new(QueryNFTsByTraitResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/NFTsByTrait", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractId) > 0 {
-		i -= len(m.ContractId)
-		copy(dAtA[i:], m.ContractId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) TokenClassTypeName(ctx context.Context, in *QueryTokenClassTypeNameRequest, opts ...grpc.CallOption) (*QueryTokenClassTypeNameResponse, error) {
+	out := new(QueryTokenClassTypeNameResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/TokenClassTypeName", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TokenType(ctx context.Context, in *QueryTokenTypeRequest, opts ...grpc.CallOption) (*QueryTokenTypeResponse, error) {
+	out := new(QueryTokenTypeResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/TokenType", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Token(ctx context.Context, in *QueryTokenRequest, opts ...grpc.CallOption) (*QueryTokenResponse, error) {
+	out := new(QueryTokenResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/Token", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) Root(ctx context.Context, in *QueryRootRequest, opts ...grpc.CallOption) (*QueryRootResponse, error) {
+	out := new(QueryRootResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/Root", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryTokenClassTypeNameRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) HasParent(ctx context.Context, in *QueryHasParentRequest, opts ...grpc.CallOption) (*QueryHasParentResponse, error) {
+	out := new(QueryHasParentResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/HasParent", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryTokenClassTypeNameRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Parent(ctx context.Context, in *QueryParentRequest, opts ...grpc.CallOption) (*QueryParentResponse, error) {
+	out := new(QueryParentResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/Parent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryTokenClassTypeNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClassId) > 0 {
-		i -= len(m.ClassId)
-		copy(dAtA[i:], m.ClassId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ContractId) > 0 {
-		i -= len(m.ContractId)
-		copy(dAtA[i:], m.ContractId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) Children(ctx context.Context, in *QueryChildrenRequest, opts ...grpc.CallOption) (*QueryChildrenResponse, error) {
+	out := new(QueryChildrenResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/Children", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryTokenClassTypeNameResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Royalty(ctx context.Context, in *QueryRoyaltyRequest, opts ...grpc.CallOption) (*QueryRoyaltyResponse, error) {
+	out := new(QueryRoyaltyResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/Royalty", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryTokenClassTypeNameResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) NFTHistory(ctx context.Context, in *QueryNFTHistoryRequest, opts ...grpc.CallOption) (*QueryNFTHistoryResponse, error) {
+	out := new(QueryNFTHistoryResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/NFTHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryTokenClassTypeNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GranteeGrants(ctx context.Context, in *QueryGranteeGrantsRequest, opts ...grpc.CallOption) (*QueryGranteeGrantsResponse, error) {
+	out := new(QueryGranteeGrantsResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/GranteeGrants", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryTokenTypeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) IsOperatorFor(ctx context.Context, in *QueryIsOperatorForRequest, opts ...grpc.CallOption) (*QueryIsOperatorForResponse, error) {
+	out := new(QueryIsOperatorForResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/IsOperatorFor", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryTokenTypeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) HoldersByOperator(ctx context.Context, in *QueryHoldersByOperatorRequest, opts ...grpc.CallOption) (*QueryHoldersByOperatorResponse, error) {
+	out := new(QueryHoldersByOperatorResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/HoldersByOperator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryTokenTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenType) > 0 {
-		i -= len(m.TokenType)
-		copy(dAtA[i:], m.TokenType)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenType)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ContractId) > 0 {
-		i -= len(m.ContractId)
-		copy(dAtA[i:], m.ContractId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Balance queries the balance of a single token class for a single account.
+	Balance(context.Context, *QueryBalanceRequest) (*QueryBalanceResponse, error)
+	// AllBalances queries the balance of all token classes for a single account.
+	AllBalances(context.Context, *QueryAllBalancesRequest) (*QueryAllBalancesResponse, error)
+	// Spendable queries the spendable balance of a single token class for a single account.
+	Spendable(context.Context, *QuerySpendableRequest) (*QuerySpendableResponse, error)
+	// Locked queries the locked balance of a single token class for a single account.
+	Locked(context.Context, *QueryLockedRequest) (*QueryLockedResponse, error)
+	// FTSupply queries the number of tokens from a given contract id and token id.
+	FTSupply(context.Context, *QueryFTSupplyRequest) (*QueryFTSupplyResponse, error)
+	// FTMinted queries the number of minted tokens from a given contract id and token id.
+	FTMinted(context.Context, *QueryFTMintedRequest) (*QueryFTMintedResponse, error)
+	// FTBurnt queries the number of burnt tokens from a given contract id and token id.
+	FTBurnt(context.Context, *QueryFTBurntRequest) (*QueryFTBurntResponse, error)
+	// NFTSupply queries the number of tokens from a given contract id and token type.
+	NFTSupply(context.Context, *QueryNFTSupplyRequest) (*QueryNFTSupplyResponse, error)
+	// NFTMinted queries the number of minted tokens from a given contract id and token type.
+	NFTMinted(context.Context, *QueryNFTMintedRequest) (*QueryNFTMintedResponse, error)
+	// NFTBurnt queries the number of burnt tokens from a given contract id and token type.
+	NFTBurnt(context.Context, *QueryNFTBurntRequest) (*QueryNFTBurntResponse, error)
+	// Contract queries a contract metadata based on its contract id.
+	Contract(context.Context, *QueryContractRequest) (*QueryContractResponse, error)
+	// Contracts queries all the contracts.
+	Contracts(context.Context, *QueryContractsRequest) (*QueryContractsResponse, error)
+	// TokenClasses queries all the token classes of a contract.
+	// Throws:
+	// - ErrInvalidRequest
+	//   - `contract_id` is of invalid format.
+	TokenClasses(context.Context, *QueryTokenClassesRequest) (*QueryTokenClassesResponse, error)
+	// NFTsByType queries all the non-fungible tokens of a token type.
+	// Throws:
+	// - ErrInvalidRequest
+	//   - `contract_id` is of invalid format.
+	//   - `token_type` is of invalid format.
+	NFTsByType(context.Context, *QueryNFTsByTypeRequest) (*QueryNFTsByTypeResponse, error)
+	// FTHolders queries all the holders of a fungible token with their balances.
+	// Throws:
+	// - ErrInvalidRequest
+	//   - `contract_id` is of invalid format.
+	//   - `token_id` is of invalid format.
+	FTHolders(context.Context, *QueryFTHoldersRequest) (*QueryFTHoldersResponse, error)
+	// OwnerNFTs queries all the non-fungible tokens held by an address, across the contracts.
+	// Note: the tokens attached to other tokens are not included, because their owners are those of their roots.
+	// Throws:
+	// - ErrInvalidAddress
+	//   - `owner` is of invalid format.
+	OwnerNFTs(context.Context, *QueryOwnerNFTsRequest) (*QueryOwnerNFTsResponse, error)
+	// NFTsByTrait queries all the non-fungible tokens of a contract which have a given trait value.
+	// Throws:
+	// - ErrInvalidRequest
+	//   - `contract_id` is of invalid format.
+	//   - `key` is of invalid format.
+	//   - `value` is empty.
+	NFTsByTrait(context.Context, *QueryNFTsByTraitRequest) (*QueryNFTsByTraitResponse, error)
+	// TokenClassTypeName queries the fully qualified message type name of a token class from its class id.
+	//
+	// Since: 0.46.0 (finschia)
+	TokenClassTypeName(context.Context, *QueryTokenClassTypeNameRequest) (*QueryTokenClassTypeNameResponse, error)
+	// TokenType queries metadata of a token type.
+	TokenType(context.Context, *QueryTokenTypeRequest) (*QueryTokenTypeResponse, error)
+	// Token queries a metadata of a token from its token id.
+	Token(context.Context, *QueryTokenRequest) (*QueryTokenResponse, error)
+	// Root queries the root of a given nft.
+	Root(context.Context, *QueryRootRequest) (*QueryRootResponse, error)
+	// HasParent queries whether a given nft has its parent.
+	HasParent(context.Context, *QueryHasParentRequest) (*QueryHasParentResponse, error)
+	// Parent queries the parent of a given nft.
+	Parent(context.Context, *QueryParentRequest) (*QueryParentResponse, error)
+	// Children queries the children of a given nft.
+	Children(context.Context, *QueryChildrenRequest) (*QueryChildrenResponse, error)
+	// Royalty queries the royalty paid on a sale of a given nft.
+	Royalty(context.Context, *QueryRoyaltyRequest) (*QueryRoyaltyResponse, error)
+	// NFTHistory queries the history of a given nft, in chronological order.
+	// Note: the history is recorded only while it is enabled on the contract, and
+	// the entries older than `nft_history_retention` of the params are pruned.
+	// Throws:
+	// - ErrInvalidRequest
+	//   - `contract_id` is of invalid format.
+	//   - `token_id` is of invalid format.
+	NFTHistory(context.Context, *QueryNFTHistoryRequest) (*QueryNFTHistoryResponse, error)
+	// GranteeGrants queries all permissions on a given grantee.
+	GranteeGrants(context.Context, *QueryGranteeGrantsRequest) (*QueryGranteeGrantsResponse, error)
+	// IsOperatorFor queries whether the operator is authorized by the holder.
+	IsOperatorFor(context.Context, *QueryIsOperatorForRequest) (*QueryIsOperatorForResponse, error)
+	// HoldersByOperator queries holders of a given operator.
+	HoldersByOperator(context.Context, *QueryHoldersByOperatorRequest) (*QueryHoldersByOperatorResponse, error)
 }
 
-func (m *QueryTokenTypeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryTokenTypeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Balance(ctx context.Context, req *QueryBalanceRequest) (*QueryBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Balance not implemented")
+}
+func (*UnimplementedQueryServer) AllBalances(ctx context.Context, req *QueryAllBalancesRequest) (*QueryAllBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllBalances not implemented")
+}
+func (*UnimplementedQueryServer) Spendable(ctx context.Context, req *QuerySpendableRequest) (*QuerySpendableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Spendable not implemented")
+}
+func (*UnimplementedQueryServer) Locked(ctx context.Context, req *QueryLockedRequest) (*QueryLockedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Locked not implemented")
+}
+func (*UnimplementedQueryServer) FTSupply(ctx context.Context, req *QueryFTSupplyRequest) (*QueryFTSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FTSupply not implemented")
+}
+func (*UnimplementedQueryServer) FTMinted(ctx context.Context, req *QueryFTMintedRequest) (*QueryFTMintedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FTMinted not implemented")
+}
+func (*UnimplementedQueryServer) FTBurnt(ctx context.Context, req *QueryFTBurntRequest) (*QueryFTBurntResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FTBurnt not implemented")
+}
+func (*UnimplementedQueryServer) NFTSupply(ctx context.Context, req *QueryNFTSupplyRequest) (*QueryNFTSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NFTSupply not implemented")
+}
+func (*UnimplementedQueryServer) NFTMinted(ctx context.Context, req *QueryNFTMintedRequest) (*QueryNFTMintedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NFTMinted not implemented")
+}
+func (*UnimplementedQueryServer) NFTBurnt(ctx context.Context, req *QueryNFTBurntRequest) (*QueryNFTBurntResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NFTBurnt not implemented")
+}
+func (*UnimplementedQueryServer) Contract(ctx context.Context, req *QueryContractRequest) (*QueryContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Contract not implemented")
+}
+func (*UnimplementedQueryServer) Contracts(ctx context.Context, req *QueryContractsRequest) (*QueryContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Contracts not implemented")
+}
+func (*UnimplementedQueryServer) TokenClasses(ctx context.Context, req *QueryTokenClassesRequest) (*QueryTokenClassesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenClasses not implemented")
+}
+func (*UnimplementedQueryServer) NFTsByType(ctx context.Context, req *QueryNFTsByTypeRequest) (*QueryNFTsByTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NFTsByType not implemented")
+}
+func (*UnimplementedQueryServer) FTHolders(ctx context.Context, req *QueryFTHoldersRequest) (*QueryFTHoldersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FTHolders not implemented")
+}
+func (*UnimplementedQueryServer) OwnerNFTs(ctx context.Context, req *QueryOwnerNFTsRequest) (*QueryOwnerNFTsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OwnerNFTs not implemented")
+}
+func (*UnimplementedQueryServer) NFTsByTrait(ctx context.Context, req *QueryNFTsByTraitRequest) (*QueryNFTsByTraitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NFTsByTrait not implemented")
+}
+func (*UnimplementedQueryServer) TokenClassTypeName(ctx context.Context, req *QueryTokenClassTypeNameRequest) (*QueryTokenClassTypeNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenClassTypeName not implemented")
+}
+func (*UnimplementedQueryServer) TokenType(ctx context.Context, req *QueryTokenTypeRequest) (*QueryTokenTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenType not implemented")
+}
+func (*UnimplementedQueryServer) Token(ctx context.Context, req *QueryTokenRequest) (*QueryTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Token not implemented")
+}
+func (*UnimplementedQueryServer) Root(ctx context.Context, req *QueryRootRequest) (*QueryRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Root not implemented")
+}
+func (*UnimplementedQueryServer) HasParent(ctx context.Context, req *QueryHasParentRequest) (*QueryHasParentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HasParent not implemented")
+}
+func (*UnimplementedQueryServer) Parent(ctx context.Context, req *QueryParentRequest) (*QueryParentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Parent not implemented")
+}
+func (*UnimplementedQueryServer) Children(ctx context.Context, req *QueryChildrenRequest) (*QueryChildrenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Children not implemented")
+}
+func (*UnimplementedQueryServer) Royalty(ctx context.Context, req *QueryRoyaltyRequest) (*QueryRoyaltyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Royalty not implemented")
+}
+func (*UnimplementedQueryServer) NFTHistory(ctx context.Context, req *QueryNFTHistoryRequest) (*QueryNFTHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NFTHistory not implemented")
+}
+func (*UnimplementedQueryServer) GranteeGrants(ctx context.Context, req *QueryGranteeGrantsRequest) (*QueryGranteeGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GranteeGrants not implemented")
+}
+func (*UnimplementedQueryServer) IsOperatorFor(ctx context.Context, req *QueryIsOperatorForRequest) (*QueryIsOperatorForResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsOperatorFor not implemented")
+}
+func (*UnimplementedQueryServer) HoldersByOperator(ctx context.Context, req *QueryHoldersByOperatorRequest) (*QueryHoldersByOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HoldersByOperator not implemented")
 }
 
-func (m *QueryTokenTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.TokenType.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Balance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).Balance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Query/Balance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Balance(ctx, req.(*QueryBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTokenRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_AllBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllBalancesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).AllBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Query/AllBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllBalances(ctx, req.(*QueryAllBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTokenRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Spendable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpendableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Spendable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Query/Spendable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Spendable(ctx, req.(*QuerySpendableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenId) > 0 {
-		i -= len(m.TokenId)
-		copy(dAtA[i:], m.TokenId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenId)))
-		i--
-		dAtA[i] = 0x12
+func _Query_Locked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLockedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ContractId) > 0 {
-		i -= len(m.ContractId)
-		copy(dAtA[i:], m.ContractId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).Locked(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Query/Locked",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Locked(ctx, req.(*QueryLockedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTokenResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_FTSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFTSupplyRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).FTSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Query/FTSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FTSupply(ctx, req.(*QueryFTSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTokenResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_FTMinted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFTMintedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).FTMinted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Query/FTMinted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FTMinted(ctx, req.(*QueryFTMintedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryRootRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_FTBurnt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFTBurntRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).FTBurnt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Query/FTBurnt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FTBurnt(ctx, req.(*QueryFTBurntRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryRootRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_NFTSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNFTSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NFTSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Query/NFTSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NFTSupply(ctx, req.(*QueryNFTSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryRootRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenId) > 0 {
-		i -= len(m.TokenId)
-		copy(dAtA[i:], m.TokenId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenId)))
-		i--
-		dAtA[i] = 0x12
+func _Query_NFTMinted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNFTMintedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ContractId) > 0 {
-		i -= len(m.ContractId)
-		copy(dAtA[i:], m.ContractId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).NFTMinted(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Query/NFTMinted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NFTMinted(ctx, req.(*QueryNFTMintedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryRootResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_NFTBurnt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNFTBurntRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).NFTBurnt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Query/NFTBurnt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NFTBurnt(ctx, req.(*QueryNFTBurntRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryRootResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Contract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Contract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Query/Contract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Contract(ctx, req.(*QueryContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryRootResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Root.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_Contracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).Contracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Query/Contracts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Contracts(ctx, req.(*QueryContractsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHasParentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_TokenClasses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenClassesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).TokenClasses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Query/TokenClasses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenClasses(ctx, req.(*QueryTokenClassesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHasParentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_NFTsByType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNFTsByTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NFTsByType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Query/NFTsByType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NFTsByType(ctx, req.(*QueryNFTsByTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHasParentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenId) > 0 {
-		i -= len(m.TokenId)
-		copy(dAtA[i:], m.TokenId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenId)))
-		i--
-		dAtA[i] = 0x12
+func _Query_FTHolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFTHoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ContractId) > 0 {
-		i -= len(m.ContractId)
-		copy(dAtA[i:], m.ContractId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).FTHolders(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Query/FTHolders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FTHolders(ctx, req.(*QueryFTHoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHasParentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_OwnerNFTs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOwnerNFTsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).OwnerNFTs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Query/OwnerNFTs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OwnerNFTs(ctx, req.(*QueryOwnerNFTsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHasParentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_NFTsByTrait_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNFTsByTraitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NFTsByTrait(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Query/NFTsByTrait",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NFTsByTrait(ctx, req.(*QueryNFTsByTraitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHasParentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.HasParent {
-		i--
-		if m.HasParent {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func _Query_TokenClassTypeName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenClassTypeNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).TokenClassTypeName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Query/TokenClassTypeName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenClassTypeName(ctx, req.(*QueryTokenClassTypeNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_TokenType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenTypeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryParentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenId) > 0 {
-		i -= len(m.TokenId)
-		copy(dAtA[i:], m.TokenId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenId)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).TokenType(ctx, in)
 	}
-	if len(m.ContractId) > 0 {
-		i -= len(m.ContractId)
-		copy(dAtA[i:], m.ContractId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Query/TokenType",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenType(ctx, req.(*QueryTokenTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Token_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Token(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Query/Token",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Token(ctx, req.(*QueryTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Root_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRootRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Root(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Query/Root",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Root(ctx, req.(*QueryRootRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Parent.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_HasParent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHasParentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).HasParent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Query/HasParent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HasParent(ctx, req.(*QueryHasParentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryChildrenRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Parent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParentRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Parent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Query/Parent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Parent(ctx, req.(*QueryParentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryChildrenRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Children_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChildrenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Children(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Query/Children",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Children(ctx, req.(*QueryChildrenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryChildrenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+func _Query_Royalty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoyaltyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.TokenId) > 0 {
-		i -= len(m.TokenId)
-		copy(dAtA[i:], m.TokenId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenId)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).Royalty(ctx, in)
 	}
-	if len(m.ContractId) > 0 {
-		i -= len(m.ContractId)
-		copy(dAtA[i:], m.ContractId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Query/Royalty",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Royalty(ctx, req.(*QueryRoyaltyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryChildrenResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_NFTHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNFTHistoryRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).NFTHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Query/NFTHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NFTHistory(ctx, req.(*QueryNFTHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryChildrenResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GranteeGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGranteeGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GranteeGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Query/GranteeGrants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GranteeGrants(ctx, req.(*QueryGranteeGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryChildrenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
+func _Query_IsOperatorFor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIsOperatorForRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IsOperatorFor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Query/IsOperatorFor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IsOperatorFor(ctx, req.(*QueryIsOperatorForRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HoldersByOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHoldersByOperatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HoldersByOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Query/HoldersByOperator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HoldersByOperator(ctx, req.(*QueryHoldersByOperatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lbm.collection.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
 		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+			MethodName: "Balance",
+			Handler:    _Query_Balance_Handler,
+		},
+		{
+			MethodName: "AllBalances",
+			Handler:    _Query_AllBalances_Handler,
+		},
+		{
+			MethodName: "Spendable",
+			Handler:    _Query_Spendable_Handler,
+		},
+		{
+			MethodName: "Locked",
+			Handler:    _Query_Locked_Handler,
+		},
+		{
+			MethodName: "FTSupply",
+			Handler:    _Query_FTSupply_Handler,
+		},
+		{
+			MethodName: "FTMinted",
+			Handler:    _Query_FTMinted_Handler,
+		},
+		{
+			MethodName: "FTBurnt",
+			Handler:    _Query_FTBurnt_Handler,
+		},
+		{
+			MethodName: "NFTSupply",
+			Handler:    _Query_NFTSupply_Handler,
+		},
+		{
+			MethodName: "NFTMinted",
+			Handler:    _Query_NFTMinted_Handler,
+		},
+		{
+			MethodName: "NFTBurnt",
+			Handler:    _Query_NFTBurnt_Handler,
+		},
+		{
+			MethodName: "Contract",
+			Handler:    _Query_Contract_Handler,
+		},
+		{
+			MethodName: "Contracts",
+			Handler:    _Query_Contracts_Handler,
+		},
+		{
+			MethodName: "TokenClasses",
+			Handler:    _Query_TokenClasses_Handler,
+		},
+		{
+			MethodName: "NFTsByType",
+			Handler:    _Query_NFTsByType_Handler,
+		},
+		{
+			MethodName: "FTHolders",
+			Handler:    _Query_FTHolders_Handler,
+		},
+		{
+			MethodName: "OwnerNFTs",
+			Handler:    _Query_OwnerNFTs_Handler,
+		},
+		{
+			MethodName: "NFTsByTrait",
+			Handler:    _Query_NFTsByTrait_Handler,
+		},
+		{
+			MethodName: "TokenClassTypeName",
+			Handler:    _Query_TokenClassTypeName_Handler,
+		},
+		{
+			MethodName: "TokenType",
+			Handler:    _Query_TokenType_Handler,
+		},
+		{
+			MethodName: "Token",
+			Handler:    _Query_Token_Handler,
+		},
+		{
+			MethodName: "Root",
+			Handler:    _Query_Root_Handler,
+		},
+		{
+			MethodName: "HasParent",
+			Handler:    _Query_HasParent_Handler,
+		},
+		{
+			MethodName: "Parent",
+			Handler:    _Query_Parent_Handler,
+		},
+		{
+			MethodName: "Children",
+			Handler:    _Query_Children_Handler,
+		},
+		{
+			MethodName: "Royalty",
+			Handler:    _Query_Royalty_Handler,
+		},
+		{
+			MethodName: "NFTHistory",
+			Handler:    _Query_NFTHistory_Handler,
+		},
+		{
+			MethodName: "GranteeGrants",
+			Handler:    _Query_GranteeGrants_Handler,
+		},
+		{
+			MethodName: "IsOperatorFor",
+			Handler:    _Query_IsOperatorFor_Handler,
+		},
+		{
+			MethodName: "HoldersByOperator",
+			Handler:    _Query_HoldersByOperator_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lbm/collection/v1/query.proto",
+}
+
+func (m *QueryBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Children) > 0 {
-		for iNdEx := len(m.Children) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Children[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGranteeGrantsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllBalancesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4094,12 +4664,12 @@ func (m *QueryGranteeGrantsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGranteeGrantsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGranteeGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllBalancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4116,10 +4686,10 @@ func (m *QueryGranteeGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Grantee) > 0 {
-		i -= len(m.Grantee)
-		copy(dAtA[i:], m.Grantee)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -4133,7 +4703,7 @@ func (m *QueryGranteeGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGranteeGrantsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllBalancesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4143,12 +4713,12 @@ func (m *QueryGranteeGrantsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGranteeGrantsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllBalancesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGranteeGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4165,10 +4735,10 @@ func (m *QueryGranteeGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Grants) > 0 {
-		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4182,7 +4752,7 @@ func (m *QueryGranteeGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryIsOperatorForRequest) Marshal() (dAtA []byte, err error) {
+func (m *QuerySpendableRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4192,27 +4762,27 @@ func (m *QueryIsOperatorForRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryIsOperatorForRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySpendableRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryIsOperatorForRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySpendableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Holder) > 0 {
-		i -= len(m.Holder)
-		copy(dAtA[i:], m.Holder)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Holder)))
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenId)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Operator)))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -4226,7 +4796,7 @@ func (m *QueryIsOperatorForRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryIsOperatorForResponse) Marshal() (dAtA []byte, err error) {
+func (m *QuerySpendableResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4236,30 +4806,30 @@ func (m *QueryIsOperatorForResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryIsOperatorForResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySpendableResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryIsOperatorForResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySpendableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Authorized {
-		i--
-		if m.Authorized {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x8
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryHoldersByOperatorRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryLockedRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4269,32 +4839,27 @@ func (m *QueryHoldersByOperatorRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryHoldersByOperatorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryLockedRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryHoldersByOperatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryLockedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenId)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Operator)))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -4308,7 +4873,7 @@ func (m *QueryHoldersByOperatorRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryHoldersByOperatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryLockedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4318,646 +4883,5238 @@ func (m *QueryHoldersByOperatorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryHoldersByOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryLockedResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryHoldersByOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryLockedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Locks) > 0 {
+		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if len(m.Holders) > 0 {
-		for iNdEx := len(m.Holders) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Holders[iNdEx])
-			copy(dAtA[i:], m.Holders[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Holders[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryFTSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryFTSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFTSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.TokenId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFTSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Balance.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllBalancesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryFTSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllBalancesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFTSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Balances) > 0 {
-		for _, e := range m.Balances {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFTSupplyRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.TokenId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryFTMintedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryFTSupplyResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Supply.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryFTMintedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryFTMintedRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFTMintedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.TokenId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFTMintedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFTMintedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Minted.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryFTBurntRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFTMintedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFTMintedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.TokenId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.Minted.Size()
+		i -= size
+		if _, err := m.Minted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFTBurntResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFTBurntRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Burnt.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryNFTSupplyRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFTBurntRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFTBurntRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.TokenType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryNFTSupplyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFTBurntResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Supply.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryNFTMintedRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFTBurntResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFTBurntResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.TokenType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.Burnt.Size()
+		i -= size
+		if _, err := m.Burnt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryNFTMintedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryNFTSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Minted.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryNFTBurntRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryNFTSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNFTSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.TokenType) > 0 {
+		i -= len(m.TokenType)
+		copy(dAtA[i:], m.TokenType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenType)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.TokenType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryNFTBurntResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryNFTSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Burnt.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryContractRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryNFTSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNFTSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryNFTMintedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Contract.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTokenClassTypeNameRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryNFTMintedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNFTMintedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.TokenType) > 0 {
+		i -= len(m.TokenType)
+		copy(dAtA[i:], m.TokenType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenType)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ClassId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTokenClassTypeNameResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryNFTMintedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTokenTypeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryNFTMintedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNFTMintedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.TokenType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.Minted.Size()
+		i -= size
+		if _, err := m.Minted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTokenTypeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryNFTBurntRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.TokenType.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTokenRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryNFTBurntRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNFTBurntRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.TokenType) > 0 {
+		i -= len(m.TokenType)
+		copy(dAtA[i:], m.TokenType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenType)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.TokenId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTokenResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryNFTBurntResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Token.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryRootRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryNFTBurntResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNFTBurntResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.TokenId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.Burnt.Size()
+		i -= size
+		if _, err := m.Burnt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRootResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Root.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryHasParentRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.TokenId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryHasParentResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.HasParent {
-		n += 2
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParentRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.TokenId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParentResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Parent.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryChildrenRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.TokenId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.AdminHistory) > 0 {
+		for iNdEx := len(m.AdminHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdminHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryChildrenResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Children) > 0 {
-		for _, e := range m.Children {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryContractsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGranteeGrantsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryContractsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGranteeGrantsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryContractsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Grants) > 0 {
-		for _, e := range m.Grants {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Contracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryIsOperatorForRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTokenClassesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenClassesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenClassesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Holder)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryIsOperatorForResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Authorized {
-		n += 2
+func (m *QueryTokenClassesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryHoldersByOperatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryTokenClassesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenClassesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Classes) > 0 {
+		for iNdEx := len(m.Classes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Classes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryHoldersByOperatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryNFTsByTypeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNFTsByTypeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNFTsByTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenType) > 0 {
+		i -= len(m.TokenType)
+		copy(dAtA[i:], m.TokenType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNFTsByTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNFTsByTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNFTsByTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFTHoldersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFTHoldersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFTHoldersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFTHoldersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFTHoldersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFTHoldersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Holders) > 0 {
-		for _, s := range m.Holders {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Holders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Holders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOwnerNFTsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOwnerNFTsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOwnerNFTsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOwnerNFTsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOwnerNFTsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOwnerNFTsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Nfts) > 0 {
+		for iNdEx := len(m.Nfts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nfts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNFTsByTraitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNFTsByTraitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNFTsByTraitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNFTsByTraitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNFTsByTraitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNFTsByTraitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenClassTypeNameRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenClassTypeNameRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenClassTypeNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenClassTypeNameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenClassTypeNameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenClassTypeNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenTypeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenTypeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenType) > 0 {
+		i -= len(m.TokenType)
+		copy(dAtA[i:], m.TokenType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TokenType.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRootRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRootRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRootRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRootResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRootResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRootResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Root.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHasParentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHasParentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHasParentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHasParentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHasParentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHasParentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HasParent {
+		i--
+		if m.HasParent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Parent.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChildrenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChildrenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChildrenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChildrenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChildrenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChildrenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Children) > 0 {
+		for iNdEx := len(m.Children) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Children[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRoyaltyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoyaltyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoyaltyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SalePrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRoyaltyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoyaltyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoyaltyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNFTHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNFTHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNFTHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNFTHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNFTHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNFTHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGranteeGrantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGranteeGrantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGranteeGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGranteeGrantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGranteeGrantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGranteeGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsOperatorForRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsOperatorForRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsOperatorForRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsOperatorForResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsOperatorForResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsOperatorForResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Authorized {
+		i--
+		if m.Authorized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHoldersByOperatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHoldersByOperatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHoldersByOperatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHoldersByOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHoldersByOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHoldersByOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Holders) > 0 {
+		for iNdEx := len(m.Holders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Holders[iNdEx])
+			copy(dAtA[i:], m.Holders[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Holders[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllBalancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllBalancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpendableRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpendableResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLockedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLockedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFTSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFTSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFTMintedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFTMintedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Minted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFTBurntRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFTBurntResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Burnt.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryNFTSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNFTSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryNFTMintedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNFTMintedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Minted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryNFTBurntRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNFTBurntResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Burnt.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Contract.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.AdminHistory) > 0 {
+		for _, e := range m.AdminHistory {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryContractsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Contracts) > 0 {
+		for _, e := range m.Contracts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenClassesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenClassesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Classes) > 0 {
+		for _, e := range m.Classes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNFTsByTypeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNFTsByTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFTHoldersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFTHoldersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Holders) > 0 {
+		for _, e := range m.Holders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOwnerNFTsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOwnerNFTsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nfts) > 0 {
+		for _, e := range m.Nfts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNFTsByTraitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNFTsByTraitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenClassTypeNameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenClassTypeNameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenTypeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenType.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Token.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRootRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRootResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Root.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHasParentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHasParentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasParent {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryParentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Parent.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryChildrenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChildrenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Children) > 0 {
+		for _, e := range m.Children {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRoyaltyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.SalePrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRoyaltyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Royalty.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryNFTHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNFTHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGranteeGrantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGranteeGrantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsOperatorForRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsOperatorForResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Authorized {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryHoldersByOperatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHoldersByOperatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Holders) > 0 {
+		for _, s := range m.Holders {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllBalancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllBalancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllBalancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllBalancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpendableRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpendableRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpendableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpendableResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpendableResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpendableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLockedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLockedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locks = append(m.Locks, Lock{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFTSupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFTSupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFTSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFTSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFTSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFTSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFTMintedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFTMintedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFTMintedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFTMintedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFTMintedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFTMintedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFTBurntRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFTBurntRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFTBurntRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFTBurntResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFTBurntResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFTBurntResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burnt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burnt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNFTSupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNFTSupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNFTSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNFTSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNFTSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNFTSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNFTMintedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNFTMintedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNFTMintedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNFTMintedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNFTMintedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNFTMintedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNFTBurntRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNFTBurntRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNFTBurntRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNFTBurntResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNFTBurntResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNFTBurntResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burnt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burnt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryContractRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4980,10 +10137,10 @@ func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5018,11 +10175,61 @@ func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5032,29 +10239,30 @@ func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.Contract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminHistory", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5064,23 +10272,25 @@ func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenId = string(dAtA[iNdEx:postIndex])
+			m.AdminHistory = append(m.AdminHistory, AdminHistoryEntry{})
+			if err := m.AdminHistory[len(m.AdminHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5103,7 +10313,7 @@ func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryContractsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5126,15 +10336,15 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5161,7 +10371,10 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5186,7 +10399,7 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllBalancesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryContractsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5209,17 +10422,17 @@ func (m *QueryAllBalancesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBalancesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5229,27 +10442,115 @@ func (m *QueryAllBalancesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractId = string(dAtA[iNdEx:postIndex])
+			m.Contracts = append(m.Contracts, Contract{})
+			if err := m.Contracts[len(m.Contracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenClassesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenClassesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenClassesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5277,9 +10578,9 @@ func (m *QueryAllBalancesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -5336,7 +10637,7 @@ func (m *QueryAllBalancesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllBalancesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTokenClassesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5359,15 +10660,15 @@ func (m *QueryAllBalancesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBalancesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTokenClassesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTokenClassesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Classes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5394,8 +10695,8 @@ func (m *QueryAllBalancesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balances = append(m.Balances, Coin{})
-			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Classes = append(m.Classes, types.Any{})
+			if err := m.Classes[len(m.Classes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5456,7 +10757,7 @@ func (m *QueryAllBalancesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFTSupplyRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNFTsByTypeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5479,15 +10780,47 @@ func (m *QueryFTSupplyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFTSupplyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNFTsByTypeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFTSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNFTsByTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5515,13 +10848,13 @@ func (m *QueryFTSupplyRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractId = string(dAtA[iNdEx:postIndex])
+			m.TokenType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5531,23 +10864,27 @@ func (m *QueryFTSupplyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenId = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5570,7 +10907,7 @@ func (m *QueryFTSupplyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFTSupplyResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNFTsByTypeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5593,17 +10930,17 @@ func (m *QueryFTSupplyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFTSupplyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNFTsByTypeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFTSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNFTsByTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5613,23 +10950,59 @@ func (m *QueryFTSupplyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tokens = append(m.Tokens, NFT{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5654,7 +11027,7 @@ func (m *QueryFTSupplyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFTMintedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFTHoldersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5677,10 +11050,10 @@ func (m *QueryFTMintedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFTMintedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFTHoldersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFTMintedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFTHoldersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5747,61 +11120,11 @@ func (m *QueryFTMintedRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.TokenId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryFTMintedResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFTMintedResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFTMintedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5811,23 +11134,25 @@ func (m *QueryFTMintedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5852,7 +11177,7 @@ func (m *QueryFTMintedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFTBurntRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFTHoldersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5875,17 +11200,17 @@ func (m *QueryFTBurntRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFTBurntRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFTHoldersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFTBurntRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFTHoldersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5895,29 +11220,31 @@ func (m *QueryFTBurntRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractId = string(dAtA[iNdEx:postIndex])
+			m.Holders = append(m.Holders, Holder{})
+			if err := m.Holders[len(m.Holders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5927,23 +11254,27 @@ func (m *QueryFTBurntRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenId = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5966,7 +11297,7 @@ func (m *QueryFTBurntRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFTBurntResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOwnerNFTsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5989,15 +11320,15 @@ func (m *QueryFTBurntResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFTBurntResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOwnerNFTsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFTBurntResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOwnerNFTsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Burnt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6025,7 +11356,41 @@ func (m *QueryFTBurntResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Burnt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6050,7 +11415,7 @@ func (m *QueryFTBurntResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNFTSupplyRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOwnerNFTsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6073,17 +11438,17 @@ func (m *QueryNFTSupplyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNFTSupplyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOwnerNFTsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNFTSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOwnerNFTsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nfts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6093,29 +11458,31 @@ func (m *QueryNFTSupplyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractId = string(dAtA[iNdEx:postIndex])
+			m.Nfts = append(m.Nfts, OwnedNFT{})
+			if err := m.Nfts[len(m.Nfts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6125,23 +11492,27 @@ func (m *QueryNFTSupplyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenType = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6164,7 +11535,7 @@ func (m *QueryNFTSupplyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNFTSupplyResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNFTsByTraitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6187,15 +11558,15 @@ func (m *QueryNFTSupplyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNFTSupplyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNFTsByTraitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNFTSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNFTsByTraitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6223,63 +11594,43 @@ func (m *QueryNFTSupplyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryNFTMintedRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNFTMintedRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNFTMintedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6307,13 +11658,13 @@ func (m *QueryNFTMintedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractId = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6323,23 +11674,27 @@ func (m *QueryNFTMintedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenType = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6362,7 +11717,7 @@ func (m *QueryNFTMintedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNFTMintedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNFTsByTraitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6385,17 +11740,17 @@ func (m *QueryNFTMintedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNFTMintedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNFTsByTraitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNFTMintedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNFTsByTraitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6405,23 +11760,59 @@ func (m *QueryNFTMintedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tokens = append(m.Tokens, NFT{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6446,7 +11837,7 @@ func (m *QueryNFTMintedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNFTBurntRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTokenClassTypeNameRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6469,10 +11860,10 @@ func (m *QueryNFTBurntRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNFTBurntRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTokenClassTypeNameRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNFTBurntRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTokenClassTypeNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6509,7 +11900,7 @@ func (m *QueryNFTBurntRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6537,7 +11928,7 @@ func (m *QueryNFTBurntRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenType = string(dAtA[iNdEx:postIndex])
+			m.ClassId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6560,7 +11951,7 @@ func (m *QueryNFTBurntRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNFTBurntResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTokenClassTypeNameResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6583,15 +11974,15 @@ func (m *QueryNFTBurntResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNFTBurntResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTokenClassTypeNameResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNFTBurntResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTokenClassTypeNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Burnt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6619,9 +12010,7 @@ func (m *QueryNFTBurntResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Burnt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6644,7 +12033,7 @@ func (m *QueryNFTBurntResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryContractRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTokenTypeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6667,10 +12056,10 @@ func (m *QueryContractRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContractRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTokenTypeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTokenTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6705,6 +12094,38 @@ func (m *QueryContractRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6726,7 +12147,7 @@ func (m *QueryContractRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryContractResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTokenTypeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6749,15 +12170,15 @@ func (m *QueryContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTokenTypeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTokenTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6784,7 +12205,7 @@ func (m *QueryContractResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Contract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6809,7 +12230,7 @@ func (m *QueryContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenClassTypeNameRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTokenRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6832,10 +12253,10 @@ func (m *QueryTokenClassTypeNameRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenClassTypeNameRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTokenRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenClassTypeNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6872,7 +12293,7 @@ func (m *QueryTokenClassTypeNameRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6900,7 +12321,7 @@ func (m *QueryTokenClassTypeNameRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClassId = string(dAtA[iNdEx:postIndex])
+			m.TokenId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6923,7 +12344,7 @@ func (m *QueryTokenClassTypeNameRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenClassTypeNameResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTokenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6946,17 +12367,17 @@ func (m *QueryTokenClassTypeNameResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenClassTypeNameResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTokenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenClassTypeNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6966,23 +12387,24 @@ func (m *QueryTokenClassTypeNameResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7005,7 +12427,7 @@ func (m *QueryTokenClassTypeNameResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenTypeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRootRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7028,10 +12450,10 @@ func (m *QueryTokenTypeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenTypeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRootRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRootRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7068,7 +12490,7 @@ func (m *QueryTokenTypeRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7096,7 +12518,7 @@ func (m *QueryTokenTypeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenType = string(dAtA[iNdEx:postIndex])
+			m.TokenId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7119,7 +12541,7 @@ func (m *QueryTokenTypeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenTypeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRootResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7142,15 +12564,15 @@ func (m *QueryTokenTypeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenTypeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRootResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7177,7 +12599,7 @@ func (m *QueryTokenTypeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Root.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7202,7 +12624,7 @@ func (m *QueryTokenTypeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHasParentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7225,10 +12647,10 @@ func (m *QueryTokenRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHasParentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHasParentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7316,7 +12738,7 @@ func (m *QueryTokenRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHasParentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7339,17 +12761,17 @@ func (m *QueryTokenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHasParentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHasParentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasParent", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7359,25 +12781,12 @@ func (m *QueryTokenResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.HasParent = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7399,7 +12808,7 @@ func (m *QueryTokenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRootRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7422,10 +12831,10 @@ func (m *QueryRootRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRootRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRootRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7513,7 +12922,7 @@ func (m *QueryRootRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRootResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7536,15 +12945,15 @@ func (m *QueryRootResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRootResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7571,7 +12980,7 @@ func (m *QueryRootResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Root.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7596,7 +13005,7 @@ func (m *QueryRootResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHasParentRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryChildrenRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7619,10 +13028,10 @@ func (m *QueryHasParentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHasParentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChildrenRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHasParentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChildrenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7689,6 +13098,42 @@ func (m *QueryHasParentRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.TokenId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7710,7 +13155,7 @@ func (m *QueryHasParentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHasParentResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryChildrenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7733,17 +13178,17 @@ func (m *QueryHasParentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHasParentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChildrenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHasParentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChildrenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasParent", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7753,12 +13198,62 @@ func (m *QueryHasParentResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HasParent = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Children = append(m.Children, NFT{})
+			if err := m.Children[len(m.Children)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7780,7 +13275,7 @@ func (m *QueryHasParentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParentRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRoyaltyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7803,10 +13298,10 @@ func (m *QueryParentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRoyaltyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRoyaltyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7873,6 +13368,39 @@ func (m *QueryParentRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.TokenId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SalePrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SalePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7894,7 +13422,7 @@ func (m *QueryParentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParentResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRoyaltyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7917,15 +13445,47 @@ func (m *QueryParentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRoyaltyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRoyaltyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7952,7 +13512,7 @@ func (m *QueryParentResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7977,7 +13537,7 @@ func (m *QueryParentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryChildrenRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNFTHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8000,10 +13560,10 @@ func (m *QueryChildrenRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryChildrenRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNFTHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryChildrenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNFTHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8127,7 +13687,7 @@ func (m *QueryChildrenRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryChildrenResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNFTHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8150,15 +13710,15 @@ func (m *QueryChildrenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryChildrenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNFTHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryChildrenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNFTHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8185,8 +13745,8 @@ func (m *QueryChildrenResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Children = append(m.Children, NFT{})
-			if err := m.Children[len(m.Children)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, NFTHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,58 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/Finschia/finschia-sdk/types/module"
+	"github.com/Finschia/finschia-sdk/x/collection"
+)
+
+// Simulation parameter constants
+const (
+	DepthLimit   = "depth_limit"
+	WidthLimit   = "width_limit"
+	MaxBatchSize = "max_batch_size"
+)
+
+// GenDepthLimit returns a randomized limit on the depth of the nft compositions.
+func GenDepthLimit(r *rand.Rand) uint32 {
+	return uint32(r.Intn(4) + 1)
+}
+
+// GenWidthLimit returns a randomized limit on the width of the nft compositions.
+func GenWidthLimit(r *rand.Rand) uint32 {
+	return uint32(r.Intn(2*collection.DefaultWidthLimit) + 1)
+}
+
+// GenMaxBatchSize returns a randomized max number of the outputs in a batch transfer.
+func GenMaxBatchSize(r *rand.Rand) uint32 {
+	return uint32(r.Intn(2*collection.DefaultMaxBatchSize) + 1)
+}
+
+// RandomizedGenState generates a random GenesisState for collection.
+func RandomizedGenState(simState *module.SimulationState) {
+	var depthLimit uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepthLimit, &depthLimit, simState.Rand,
+		func(r *rand.Rand) { depthLimit = GenDepthLimit(r) },
+	)
+
+	var widthLimit uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, WidthLimit, &widthLimit, simState.Rand,
+		func(r *rand.Rand) { widthLimit = GenWidthLimit(r) },
+	)
+
+	var maxBatchSize uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxBatchSize, &maxBatchSize, simState.Rand,
+		func(r *rand.Rand) { maxBatchSize = GenMaxBatchSize(r) },
+	)
+
+	genesis := collection.DefaultGenesisState()
+	genesis.Params.DepthLimit = depthLimit
+	genesis.Params.WidthLimit = widthLimit
+	genesis.Params.MaxBatchSize = maxBatchSize
+
+	simState.GenState[collection.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
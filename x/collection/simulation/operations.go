@@ -0,0 +1,920 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/Finschia/finschia-sdk/baseapp"
+	"github.com/Finschia/finschia-sdk/codec"
+	simappparams "github.com/Finschia/finschia-sdk/simapp/params"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	simtypes "github.com/Finschia/finschia-sdk/types/simulation"
+	"github.com/Finschia/finschia-sdk/x/collection"
+	"github.com/Finschia/finschia-sdk/x/collection/keeper"
+	"github.com/Finschia/finschia-sdk/x/simulation"
+)
+
+// collection message types
+var (
+	TypeMsgCreateContract    = sdk.MsgTypeURL(&collection.MsgCreateContract{})
+	TypeMsgIssueFT           = sdk.MsgTypeURL(&collection.MsgIssueFT{})
+	TypeMsgIssueNFT          = sdk.MsgTypeURL(&collection.MsgIssueNFT{})
+	TypeMsgMintFT            = sdk.MsgTypeURL(&collection.MsgMintFT{})
+	TypeMsgMintNFT           = sdk.MsgTypeURL(&collection.MsgMintNFT{})
+	TypeMsgBurnFT            = sdk.MsgTypeURL(&collection.MsgBurnFT{})
+	TypeMsgBurnNFT           = sdk.MsgTypeURL(&collection.MsgBurnNFT{})
+	TypeMsgSendFT            = sdk.MsgTypeURL(&collection.MsgSendFT{})
+	TypeMsgOperatorSendFT    = sdk.MsgTypeURL(&collection.MsgOperatorSendFT{})
+	TypeMsgSendNFT           = sdk.MsgTypeURL(&collection.MsgSendNFT{})
+	TypeMsgAuthorizeOperator = sdk.MsgTypeURL(&collection.MsgAuthorizeOperator{})
+	TypeMsgRevokeOperator    = sdk.MsgTypeURL(&collection.MsgRevokeOperator{})
+	TypeMsgAttach            = sdk.MsgTypeURL(&collection.MsgAttach{})
+	TypeMsgDetach            = sdk.MsgTypeURL(&collection.MsgDetach{})
+)
+
+// Simulation operation weights constants
+//
+//nolint:gosec
+const (
+	OpWeightMsgCreateContract    = "op_weight_msg_collection_create_contract"
+	OpWeightMsgIssueFT           = "op_weight_msg_collection_issue_ft"
+	OpWeightMsgIssueNFT          = "op_weight_msg_collection_issue_nft"
+	OpWeightMsgMintFT            = "op_weight_msg_collection_mint_ft"
+	OpWeightMsgMintNFT           = "op_weight_msg_collection_mint_nft"
+	OpWeightMsgBurnFT            = "op_weight_msg_collection_burn_ft"
+	OpWeightMsgBurnNFT           = "op_weight_msg_collection_burn_nft"
+	OpWeightMsgSendFT            = "op_weight_msg_collection_send_ft"
+	OpWeightMsgOperatorSendFT    = "op_weight_msg_collection_operator_send_ft"
+	OpWeightMsgSendNFT           = "op_weight_msg_collection_send_nft"
+	OpWeightMsgAuthorizeOperator = "op_weight_msg_collection_authorize_operator"
+	OpWeightMsgRevokeOperator    = "op_weight_msg_collection_revoke_operator"
+	OpWeightMsgAttach            = "op_weight_msg_collection_attach"
+	OpWeightMsgDetach            = "op_weight_msg_collection_detach"
+)
+
+// collection operations weights
+const (
+	WeightCreateContract    = 10
+	WeightIssueFT           = 20
+	WeightIssueNFT          = 20
+	WeightMintFT            = 50
+	WeightMintNFT           = 50
+	WeightBurnFT            = 20
+	WeightBurnNFT           = 20
+	WeightSendFT            = 100
+	WeightOperatorSendFT    = 50
+	WeightSendNFT           = 50
+	WeightAuthorizeOperator = 50
+	WeightRevokeOperator    = 20
+	WeightAttach            = 50
+	WeightDetach            = 20
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgCreateContract    int
+		weightMsgIssueFT           int
+		weightMsgIssueNFT          int
+		weightMsgMintFT            int
+		weightMsgMintNFT           int
+		weightMsgBurnFT            int
+		weightMsgBurnNFT           int
+		weightMsgSendFT            int
+		weightMsgOperatorSendFT    int
+		weightMsgSendNFT           int
+		weightMsgAuthorizeOperator int
+		weightMsgRevokeOperator    int
+		weightMsgAttach            int
+		weightMsgDetach            int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateContract, &weightMsgCreateContract, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateContract = WeightCreateContract
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgIssueFT, &weightMsgIssueFT, nil,
+		func(_ *rand.Rand) {
+			weightMsgIssueFT = WeightIssueFT
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgIssueNFT, &weightMsgIssueNFT, nil,
+		func(_ *rand.Rand) {
+			weightMsgIssueNFT = WeightIssueNFT
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgMintFT, &weightMsgMintFT, nil,
+		func(_ *rand.Rand) {
+			weightMsgMintFT = WeightMintFT
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgMintNFT, &weightMsgMintNFT, nil,
+		func(_ *rand.Rand) {
+			weightMsgMintNFT = WeightMintNFT
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgBurnFT, &weightMsgBurnFT, nil,
+		func(_ *rand.Rand) {
+			weightMsgBurnFT = WeightBurnFT
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgBurnNFT, &weightMsgBurnNFT, nil,
+		func(_ *rand.Rand) {
+			weightMsgBurnNFT = WeightBurnNFT
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgSendFT, &weightMsgSendFT, nil,
+		func(_ *rand.Rand) {
+			weightMsgSendFT = WeightSendFT
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgOperatorSendFT, &weightMsgOperatorSendFT, nil,
+		func(_ *rand.Rand) {
+			weightMsgOperatorSendFT = WeightOperatorSendFT
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgSendNFT, &weightMsgSendNFT, nil,
+		func(_ *rand.Rand) {
+			weightMsgSendNFT = WeightSendNFT
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgAuthorizeOperator, &weightMsgAuthorizeOperator, nil,
+		func(_ *rand.Rand) {
+			weightMsgAuthorizeOperator = WeightAuthorizeOperator
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRevokeOperator, &weightMsgRevokeOperator, nil,
+		func(_ *rand.Rand) {
+			weightMsgRevokeOperator = WeightRevokeOperator
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgAttach, &weightMsgAttach, nil,
+		func(_ *rand.Rand) {
+			weightMsgAttach = WeightAttach
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgDetach, &weightMsgDetach, nil,
+		func(_ *rand.Rand) {
+			weightMsgDetach = WeightDetach
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgCreateContract,
+			SimulateMsgCreateContract(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgIssueFT,
+			SimulateMsgIssueFT(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgIssueNFT,
+			SimulateMsgIssueNFT(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgMintFT,
+			SimulateMsgMintFT(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgMintNFT,
+			SimulateMsgMintNFT(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgBurnFT,
+			SimulateMsgBurnFT(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgBurnNFT,
+			SimulateMsgBurnNFT(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSendFT,
+			SimulateMsgSendFT(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgOperatorSendFT,
+			SimulateMsgOperatorSendFT(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSendNFT,
+			SimulateMsgSendNFT(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgAuthorizeOperator,
+			SimulateMsgAuthorizeOperator(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRevokeOperator,
+			SimulateMsgRevokeOperator(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgAttach,
+			SimulateMsgAttach(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgDetach,
+			SimulateMsgDetach(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgCreateContract generates a MsgCreateContract with random values.
+func SimulateMsgCreateContract(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		owner, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &collection.MsgCreateContract{
+			Owner: owner.Address.String(),
+			Name:  simtypes.RandStringOfLength(r, r.Intn(21)),
+			Uri:   simtypes.RandStringOfLength(r, r.Intn(100)),
+			Meta:  simtypes.RandStringOfLength(r, r.Intn(100)),
+		}
+
+		return deliver(r, app, ctx, ak, bk, msg, TypeMsgCreateContract, owner)
+	}
+}
+
+// SimulateMsgIssueFT generates a MsgIssueFT with random values.
+func SimulateMsgIssueFT(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contractID, ok := randContractID(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgIssueFT, "no contracts"), nil, nil
+		}
+
+		owner, ok := findGrantee(r, ctx, k, accs, contractID, collection.PermissionIssue)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgIssueFT, "no grantee of issue"), nil, nil
+		}
+		to := owner
+		if r.Intn(2) == 0 {
+			to, _ = simtypes.RandomAcc(r, accs)
+		}
+
+		msg := &collection.MsgIssueFT{
+			ContractId: contractID,
+			Name:       simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 1, 21)),
+			Meta:       simtypes.RandStringOfLength(r, r.Intn(100)),
+			Decimals:   int32(r.Intn(19)),
+			Mintable:   r.Intn(4) != 0,
+			Owner:      owner.Address.String(),
+			To:         to.Address.String(),
+			Amount:     randPositiveAmount(r, sdk.NewInt(1_000_000_000)),
+		}
+		// daphne compat, which is rejected by the message
+		if msg.Amount.Equal(sdk.OneInt()) && msg.Decimals == 0 {
+			msg.Mintable = true
+		}
+
+		return deliver(r, app, ctx, ak, bk, msg, TypeMsgIssueFT, owner)
+	}
+}
+
+// SimulateMsgIssueNFT generates a MsgIssueNFT with random values.
+func SimulateMsgIssueNFT(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contractID, ok := randContractID(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgIssueNFT, "no contracts"), nil, nil
+		}
+
+		owner, ok := findGrantee(r, ctx, k, accs, contractID, collection.PermissionIssue)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgIssueNFT, "no grantee of issue"), nil, nil
+		}
+
+		msg := &collection.MsgIssueNFT{
+			ContractId: contractID,
+			Name:       simtypes.RandStringOfLength(r, r.Intn(21)),
+			Meta:       simtypes.RandStringOfLength(r, r.Intn(100)),
+			Owner:      owner.Address.String(),
+		}
+
+		return deliver(r, app, ctx, ak, bk, msg, TypeMsgIssueNFT, owner)
+	}
+}
+
+// SimulateMsgMintFT generates a MsgMintFT with random values.
+func SimulateMsgMintFT(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contractID, ok := randContractID(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgMintFT, "no contracts"), nil, nil
+		}
+
+		class, ok := randTokenClass(r, ctx, k, contractID, func(class collection.TokenClass) bool {
+			ftClass, ok := class.(*collection.FTClass)
+			return ok && ftClass.Mintable
+		})
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgMintFT, "no mintable ft classes"), nil, nil
+		}
+
+		grantee, ok := findGrantee(r, ctx, k, accs, contractID, collection.PermissionMint)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgMintFT, "no grantee of mint"), nil, nil
+		}
+		to, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &collection.MsgMintFT{
+			ContractId: contractID,
+			From:       grantee.Address.String(),
+			To:         to.Address.String(),
+			Amount:     collection.NewCoins(collection.NewFTCoin(class.GetId(), randPositiveAmount(r, sdk.NewInt(1_000_000_000)))),
+		}
+
+		return deliver(r, app, ctx, ak, bk, msg, TypeMsgMintFT, grantee)
+	}
+}
+
+// SimulateMsgMintNFT generates a MsgMintNFT with random values.
+func SimulateMsgMintNFT(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contractID, ok := randContractID(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgMintNFT, "no contracts"), nil, nil
+		}
+
+		class, ok := randTokenClass(r, ctx, k, contractID, func(class collection.TokenClass) bool {
+			_, ok := class.(*collection.NFTClass)
+			return ok
+		})
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgMintNFT, "no nft classes"), nil, nil
+		}
+
+		grantee, ok := findGrantee(r, ctx, k, accs, contractID, collection.PermissionMint)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgMintNFT, "no grantee of mint"), nil, nil
+		}
+		to := grantee
+		if r.Intn(2) == 0 {
+			to, _ = simtypes.RandomAcc(r, accs)
+		}
+
+		params := make([]collection.MintNFTParam, simtypes.RandIntBetween(r, 1, 4))
+		for i := range params {
+			params[i] = collection.MintNFTParam{
+				TokenType: class.GetId(),
+				Name:      simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 1, 21)),
+				Meta:      simtypes.RandStringOfLength(r, r.Intn(100)),
+			}
+		}
+
+		msg := &collection.MsgMintNFT{
+			ContractId: contractID,
+			From:       grantee.Address.String(),
+			To:         to.Address.String(),
+			Params:     params,
+		}
+
+		return deliver(r, app, ctx, ak, bk, msg, TypeMsgMintNFT, grantee)
+	}
+}
+
+// SimulateMsgBurnFT generates a MsgBurnFT with random values.
+func SimulateMsgBurnFT(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contractID, ok := randContractID(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgBurnFT, "no contracts"), nil, nil
+		}
+
+		class, ok := randTokenClass(r, ctx, k, contractID, func(class collection.TokenClass) bool {
+			_, ok := class.(*collection.FTClass)
+			return ok
+		})
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgBurnFT, "no ft classes"), nil, nil
+		}
+
+		grantee, ok := findGrantee(r, ctx, k, accs, contractID, collection.PermissionBurn)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgBurnFT, "no grantee of burn"), nil, nil
+		}
+
+		tokenID := collection.NewFTID(class.GetId())
+		spendable := k.GetSpendable(ctx, contractID, grantee.Address, tokenID)
+		if !spendable.IsPositive() {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgBurnFT, "no spendable tokens"), nil, nil
+		}
+
+		msg := &collection.MsgBurnFT{
+			ContractId: contractID,
+			From:       grantee.Address.String(),
+			Amount:     collection.NewCoins(collection.NewCoin(tokenID, randPositiveAmount(r, spendable))),
+		}
+
+		return deliver(r, app, ctx, ak, bk, msg, TypeMsgBurnFT, grantee)
+	}
+}
+
+// SimulateMsgBurnNFT generates a MsgBurnNFT with random values.
+func SimulateMsgBurnNFT(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contractID, nfts, ok := randNFTs(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgBurnNFT, "no nfts"), nil, nil
+		}
+
+		// the owner of a root nft which has the permission
+		var owner simtypes.Account
+		tokenID, ok := findNFT(r, nfts, func(tokenID string) bool {
+			if _, err := k.GetParent(ctx, contractID, tokenID); err == nil {
+				return false
+			}
+
+			acc, ok := simtypes.FindAccount(accs, k.GetRootOwner(ctx, contractID, tokenID))
+			if !ok {
+				return false
+			}
+			if _, err := k.GetGrant(ctx, contractID, acc.Address, collection.PermissionBurn); err != nil {
+				return false
+			}
+
+			owner = acc
+			return true
+		})
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgBurnNFT, "no nfts held by grantees of burn"), nil, nil
+		}
+
+		msg := &collection.MsgBurnNFT{
+			ContractId: contractID,
+			From:       owner.Address.String(),
+			TokenIds:   []string{tokenID},
+		}
+
+		return deliver(r, app, ctx, ak, bk, msg, TypeMsgBurnNFT, owner)
+	}
+}
+
+// SimulateMsgSendFT generates a MsgSendFT with random values.
+func SimulateMsgSendFT(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		from, contractID, tokenID, ok := randFTHolding(r, ctx, k, accs)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgSendFT, "no holdings"), nil, nil
+		}
+
+		spendable := k.GetSpendable(ctx, contractID, from.Address, tokenID)
+		if !spendable.IsPositive() {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgSendFT, "no spendable tokens"), nil, nil
+		}
+		to, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &collection.MsgSendFT{
+			ContractId: contractID,
+			From:       from.Address.String(),
+			To:         to.Address.String(),
+			Amount:     collection.NewCoins(collection.NewCoin(tokenID, randPositiveAmount(r, spendable))),
+		}
+
+		return deliver(r, app, ctx, ak, bk, msg, TypeMsgSendFT, from)
+	}
+}
+
+// SimulateMsgOperatorSendFT generates a MsgOperatorSendFT with random values.
+func SimulateMsgOperatorSendFT(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		from, contractID, tokenID, ok := randFTHolding(r, ctx, k, accs)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgOperatorSendFT, "no holdings"), nil, nil
+		}
+
+		operator, ok := findOperator(r, ctx, k, accs, contractID, from.Address)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgOperatorSendFT, "no operators"), nil, nil
+		}
+
+		spendable := k.GetSpendable(ctx, contractID, from.Address, tokenID)
+		if !spendable.IsPositive() {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgOperatorSendFT, "no spendable tokens"), nil, nil
+		}
+		to, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &collection.MsgOperatorSendFT{
+			ContractId: contractID,
+			Operator:   operator.Address.String(),
+			From:       from.Address.String(),
+			To:         to.Address.String(),
+			Amount:     collection.NewCoins(collection.NewCoin(tokenID, randPositiveAmount(r, spendable))),
+		}
+
+		return deliver(r, app, ctx, ak, bk, msg, TypeMsgOperatorSendFT, operator)
+	}
+}
+
+// SimulateMsgSendNFT generates a MsgSendNFT with random values.
+func SimulateMsgSendNFT(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contractID, nfts, ok := randNFTs(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgSendNFT, "no nfts"), nil, nil
+		}
+
+		// a root nft which is not locked
+		var from simtypes.Account
+		tokenID, ok := findNFT(r, nfts, func(tokenID string) bool {
+			if _, err := k.GetParent(ctx, contractID, tokenID); err == nil {
+				return false
+			}
+
+			acc, ok := simtypes.FindAccount(accs, k.GetRootOwner(ctx, contractID, tokenID))
+			if !ok || !k.GetSpendable(ctx, contractID, acc.Address, tokenID).IsPositive() {
+				return false
+			}
+
+			from = acc
+			return true
+		})
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgSendNFT, "no transferable nfts"), nil, nil
+		}
+		to, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &collection.MsgSendNFT{
+			ContractId: contractID,
+			From:       from.Address.String(),
+			To:         to.Address.String(),
+			TokenIds:   []string{tokenID},
+		}
+
+		return deliver(r, app, ctx, ak, bk, msg, TypeMsgSendNFT, from)
+	}
+}
+
+// SimulateMsgAuthorizeOperator generates a MsgAuthorizeOperator with random values.
+func SimulateMsgAuthorizeOperator(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		holder, contractID, _, ok := randFTHolding(r, ctx, k, accs)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgAuthorizeOperator, "no holdings"), nil, nil
+		}
+
+		operator, _ := simtypes.RandomAcc(r, accs)
+		if operator.Address.Equals(holder.Address) {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgAuthorizeOperator, "holder and operator cannot be same"), nil, nil
+		}
+
+		if _, err := k.GetAuthorization(ctx, contractID, holder.Address, operator.Address); err == nil {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgAuthorizeOperator, "authorization exists"), nil, nil
+		}
+
+		msg := &collection.MsgAuthorizeOperator{
+			ContractId: contractID,
+			Holder:     holder.Address.String(),
+			Operator:   operator.Address.String(),
+		}
+
+		return deliver(r, app, ctx, ak, bk, msg, TypeMsgAuthorizeOperator, holder)
+	}
+}
+
+// SimulateMsgRevokeOperator generates a MsgRevokeOperator with random values.
+func SimulateMsgRevokeOperator(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		holder, contractID, _, ok := randFTHolding(r, ctx, k, accs)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgRevokeOperator, "no holdings"), nil, nil
+		}
+
+		operator, ok := findOperator(r, ctx, k, accs, contractID, holder.Address)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgRevokeOperator, "no operators"), nil, nil
+		}
+
+		msg := &collection.MsgRevokeOperator{
+			ContractId: contractID,
+			Holder:     holder.Address.String(),
+			Operator:   operator.Address.String(),
+		}
+
+		return deliver(r, app, ctx, ak, bk, msg, TypeMsgRevokeOperator, holder)
+	}
+}
+
+// SimulateMsgAttach generates a MsgAttach with random values.
+func SimulateMsgAttach(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contractID, nfts, ok := randNFTs(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgAttach, "no nfts"), nil, nil
+		}
+
+		// a root nft which is not locked
+		var owner simtypes.Account
+		subject, ok := findNFT(r, nfts, func(tokenID string) bool {
+			if _, err := k.GetParent(ctx, contractID, tokenID); err == nil {
+				return false
+			}
+
+			acc, ok := simtypes.FindAccount(accs, k.GetRootOwner(ctx, contractID, tokenID))
+			if !ok || !k.GetSpendable(ctx, contractID, acc.Address, tokenID).IsPositive() {
+				return false
+			}
+
+			owner = acc
+			return true
+		})
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgAttach, "no subjects"), nil, nil
+		}
+
+		// an nft of the same owner, not in the composition of the subject
+		target, ok := findNFT(r, nfts, func(tokenID string) bool {
+			if !owner.Address.Equals(k.GetRootOwner(ctx, contractID, tokenID)) {
+				return false
+			}
+			if k.GetRoot(ctx, contractID, tokenID) == subject {
+				return false
+			}
+
+			return len(checkComposition(ctx, k, contractID, subject, tokenID)) == 0
+		})
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgAttach, "no targets"), nil, nil
+		}
+
+		msg := &collection.MsgAttach{
+			ContractId: contractID,
+			From:       owner.Address.String(),
+			TokenId:    subject,
+			ToTokenId:  target,
+		}
+
+		return deliver(r, app, ctx, ak, bk, msg, TypeMsgAttach, owner)
+	}
+}
+
+// SimulateMsgDetach generates a MsgDetach with random values.
+func SimulateMsgDetach(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contractID, nfts, ok := randNFTs(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgDetach, "no nfts"), nil, nil
+		}
+
+		// a child nft
+		var owner simtypes.Account
+		subject, ok := findNFT(r, nfts, func(tokenID string) bool {
+			if _, err := k.GetParent(ctx, contractID, tokenID); err != nil {
+				return false
+			}
+
+			acc, ok := simtypes.FindAccount(accs, k.GetRootOwner(ctx, contractID, tokenID))
+			if !ok {
+				return false
+			}
+
+			owner = acc
+			return true
+		})
+		if !ok {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgDetach, "no children"), nil, nil
+		}
+
+		msg := &collection.MsgDetach{
+			ContractId: contractID,
+			From:       owner.Address.String(),
+			TokenId:    subject,
+		}
+
+		return deliver(r, app, ctx, ak, bk, msg, TypeMsgDetach, owner)
+	}
+}
+
+func deliver(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak collection.AccountKeeper, bk collection.BankKeeper, msg sdk.Msg, msgType string, signer simtypes.Account) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:             r,
+		App:           app,
+		TxGen:         simappparams.MakeTestEncodingConfig().TxConfig,
+		Cdc:           nil,
+		Msg:           msg,
+		MsgType:       msgType,
+		Context:       ctx,
+		SimAccount:    signer,
+		AccountKeeper: ak,
+		Bankkeeper:    bk,
+		ModuleName:    collection.ModuleName,
+	}
+
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
+
+// randPositiveAmount returns a random amount in [1, max].
+func randPositiveAmount(r *rand.Rand, max sdk.Int) sdk.Int {
+	if !max.IsPositive() {
+		return sdk.OneInt()
+	}
+
+	return sdk.OneInt().Add(simtypes.RandomAmount(r, max.Sub(sdk.OneInt())))
+}
+
+// randContractID returns the id of a random contract.
+func randContractID(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) (string, bool) {
+	res, err := keeper.NewQueryServer(k).Contracts(sdk.WrapSDKContext(ctx), &collection.QueryContractsRequest{})
+	if err != nil || len(res.Contracts) == 0 {
+		return "", false
+	}
+
+	return res.Contracts[r.Intn(len(res.Contracts))].Id, true
+}
+
+// randTokenClass returns a random token class of the contract, which satisfies the filter.
+func randTokenClass(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, contractID string, filter func(class collection.TokenClass) bool) (collection.TokenClass, bool) {
+	res, err := keeper.NewQueryServer(k).TokenClasses(sdk.WrapSDKContext(ctx), &collection.QueryTokenClassesRequest{
+		ContractId: contractID,
+	})
+	if err != nil {
+		return nil, false
+	}
+
+	var classes []collection.TokenClass
+	for i := range res.Classes {
+		if class := collection.TokenClassFromAny(&res.Classes[i]); filter(class) {
+			classes = append(classes, class)
+		}
+	}
+	if len(classes) == 0 {
+		return nil, false
+	}
+
+	return classes[r.Intn(len(classes))], true
+}
+
+// randFTHolding returns a random fungible token and an account which holds it.
+func randFTHolding(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) (simtypes.Account, string, string, bool) {
+	contractID, ok := randContractID(r, ctx, k)
+	if !ok {
+		return simtypes.Account{}, "", "", false
+	}
+
+	class, ok := randTokenClass(r, ctx, k, contractID, func(class collection.TokenClass) bool {
+		_, ok := class.(*collection.FTClass)
+		return ok
+	})
+	if !ok {
+		return simtypes.Account{}, "", "", false
+	}
+
+	tokenID := collection.NewFTID(class.GetId())
+	res, err := keeper.NewQueryServer(k).FTHolders(sdk.WrapSDKContext(ctx), &collection.QueryFTHoldersRequest{
+		ContractId: contractID,
+		TokenId:    tokenID,
+	})
+	if err != nil || len(res.Holders) == 0 {
+		return simtypes.Account{}, "", "", false
+	}
+
+	holder := sdk.MustAccAddressFromBech32(res.Holders[r.Intn(len(res.Holders))].Address)
+	acc, ok := simtypes.FindAccount(accs, holder)
+	return acc, contractID, tokenID, ok
+}
+
+// randNFTs returns a random contract and the non-fungible tokens of a random class in it.
+func randNFTs(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) (string, []collection.NFT, bool) {
+	contractID, ok := randContractID(r, ctx, k)
+	if !ok {
+		return "", nil, false
+	}
+
+	class, ok := randTokenClass(r, ctx, k, contractID, func(class collection.TokenClass) bool {
+		_, ok := class.(*collection.NFTClass)
+		return ok
+	})
+	if !ok {
+		return "", nil, false
+	}
+
+	res, err := keeper.NewQueryServer(k).NFTsByType(sdk.WrapSDKContext(ctx), &collection.QueryNFTsByTypeRequest{
+		ContractId: contractID,
+		TokenType:  class.GetId(),
+	})
+	if err != nil || len(res.Tokens) == 0 {
+		return "", nil, false
+	}
+
+	return contractID, res.Tokens, true
+}
+
+// findNFT returns the id of an nft which satisfies the filter, starting from a random one.
+func findNFT(r *rand.Rand, nfts []collection.NFT, filter func(tokenID string) bool) (string, bool) {
+	offset := r.Intn(len(nfts))
+	for i := range nfts {
+		tokenID := nfts[(offset+i)%len(nfts)].TokenId
+		if filter(tokenID) {
+			return tokenID, true
+		}
+	}
+
+	return "", false
+}
+
+// findGrantee returns an account which has the permission on the contract.
+func findGrantee(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account, contractID string, permission collection.Permission) (simtypes.Account, bool) {
+	offset := r.Intn(len(accs))
+	for i := range accs {
+		acc := accs[(offset+i)%len(accs)]
+		if _, err := k.GetGrant(ctx, contractID, acc.Address, permission); err == nil {
+			return acc, true
+		}
+	}
+
+	return simtypes.Account{}, false
+}
+
+// findOperator returns an account which has been authorized by the holder.
+func findOperator(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account, contractID string, holder sdk.AccAddress) (simtypes.Account, bool) {
+	offset := r.Intn(len(accs))
+	for i := range accs {
+		acc := accs[(offset+i)%len(accs)]
+		if _, err := k.GetAuthorization(ctx, contractID, holder, acc.Address); err == nil {
+			return acc, true
+		}
+	}
+
+	return simtypes.Account{}, false
+}
+
+// checkComposition returns the reason why the subject cannot be attached to the target, if any.
+// It mirrors the limits on the depth and the width, which the keeper checks after the attachment.
+func checkComposition(ctx sdk.Context, k keeper.Keeper, contractID string, subject, target string) string {
+	root := k.GetRoot(ctx, contractID, target)
+
+	widths := map[int]int{}
+	countWidths(ctx, k, contractID, root, 0, widths)
+
+	depth := 0
+	for id := target; id != root; depth++ {
+		parent, err := k.GetParent(ctx, contractID, id)
+		if err != nil {
+			panic(err)
+		}
+		id = *parent
+	}
+	countWidths(ctx, k, contractID, subject, depth+1, widths)
+
+	params := k.GetParams(ctx)
+	if legacyDepth := len(widths) - 1; legacyDepth > int(params.DepthLimit) {
+		return "composition too deep"
+	}
+	for _, width := range widths {
+		if width > int(params.WidthLimit) {
+			return "composition too wide"
+		}
+	}
+
+	return ""
+}
+
+func countWidths(ctx sdk.Context, k keeper.Keeper, contractID string, tokenID string, depth int, widths map[int]int) {
+	widths[depth]++
+	for _, childID := range k.GetChildren(ctx, contractID, tokenID) {
+		countWidths(ctx, k, contractID, childID, depth+1, widths)
+	}
+}
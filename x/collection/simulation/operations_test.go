@@ -0,0 +1,166 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	ocabci "github.com/Finschia/ostracon/abci/types"
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/Finschia/finschia-sdk/simapp"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	simtypes "github.com/Finschia/finschia-sdk/types/simulation"
+	"github.com/Finschia/finschia-sdk/x/collection"
+	"github.com/Finschia/finschia-sdk/x/collection/simulation"
+)
+
+type SimTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *simapp.SimApp
+}
+
+func (s *SimTestSuite) SetupTest() {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+	s.app = app
+	s.ctx = app.BaseApp.NewContext(checkTx, tmproto.Header{
+		Time: time.Now(),
+	})
+}
+
+func (s *SimTestSuite) getTestingAccounts(r *rand.Rand, n int) []simtypes.Account {
+	accounts := simtypes.RandomAccounts(r, n)
+
+	initAmt := sdk.TokensFromConsensusPower(200, sdk.DefaultPowerReduction)
+	initCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initAmt))
+
+	// add coins to the accounts
+	for _, account := range accounts {
+		err := simapp.FundAccount(s.app, s.ctx, account.Address, initCoins)
+		s.Require().NoError(err)
+	}
+
+	return accounts
+}
+
+func (s *SimTestSuite) TestWeightedOperations() {
+	app, ctx := s.app, s.ctx
+
+	cdc := app.AppCodec()
+	appParams := make(simtypes.AppParams)
+
+	weightedOps := simulation.WeightedOperations(appParams, cdc, app.AccountKeeper, app.BankKeeper, app.CollectionKeeper)
+
+	r := rand.New(rand.NewSource(1))
+	accs := s.getTestingAccounts(r, 3)
+
+	expected := []struct {
+		weight    int
+		opMsgName string
+	}{
+		{simulation.WeightCreateContract, simulation.TypeMsgCreateContract},
+		{simulation.WeightIssueFT, simulation.TypeMsgIssueFT},
+		{simulation.WeightIssueNFT, simulation.TypeMsgIssueNFT},
+		{simulation.WeightMintFT, simulation.TypeMsgMintFT},
+		{simulation.WeightMintNFT, simulation.TypeMsgMintNFT},
+		{simulation.WeightBurnFT, simulation.TypeMsgBurnFT},
+		{simulation.WeightBurnNFT, simulation.TypeMsgBurnNFT},
+		{simulation.WeightSendFT, simulation.TypeMsgSendFT},
+		{simulation.WeightOperatorSendFT, simulation.TypeMsgOperatorSendFT},
+		{simulation.WeightSendNFT, simulation.TypeMsgSendNFT},
+		{simulation.WeightAuthorizeOperator, simulation.TypeMsgAuthorizeOperator},
+		{simulation.WeightRevokeOperator, simulation.TypeMsgRevokeOperator},
+		{simulation.WeightAttach, simulation.TypeMsgAttach},
+		{simulation.WeightDetach, simulation.TypeMsgDetach},
+	}
+	s.Require().Len(weightedOps, len(expected))
+
+	// begin a new block
+	app.BeginBlock(ocabci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash}})
+
+	for i, w := range weightedOps {
+		operationMsg, _, err := w.Op()(r, app.BaseApp, ctx, accs, ctx.ChainID())
+		s.Require().NoError(err)
+
+		// the following checks are very much dependent from the ordering of the output given
+		// by WeightedOperations. if the ordering in WeightedOperations changes some tests
+		// will fail
+		s.Require().Equal(expected[i].weight, w.Weight(), "weight should be the same")
+		s.Require().Equal(collection.ModuleName, operationMsg.Route, "route should be the same")
+		s.Require().Equal(expected[i].opMsgName, operationMsg.Name, "operation Msg name should be the same")
+	}
+}
+
+func (s *SimTestSuite) TestSimulateMsgCreateContract() {
+	app, ctx := s.app, s.ctx
+
+	r := rand.New(rand.NewSource(1))
+	accounts := s.getTestingAccounts(r, 3)
+
+	// begin a new block
+	app.BeginBlock(ocabci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash}})
+
+	// execute operation
+	op := simulation.SimulateMsgCreateContract(app.AccountKeeper, app.BankKeeper, app.CollectionKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	s.Require().NoError(err)
+
+	var msg collection.MsgCreateContract
+	err = collection.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+	s.Require().NoError(err)
+
+	s.Require().True(operationMsg.OK)
+	s.Require().Equal(simulation.TypeMsgCreateContract, operationMsg.Name)
+	s.Require().NoError(msg.ValidateBasic())
+	s.Require().Len(futureOperations, 0)
+}
+
+func (s *SimTestSuite) TestSimulateMsgAttach() {
+	app, ctx := s.app, s.ctx
+
+	r := rand.New(rand.NewSource(1))
+	accounts := s.getTestingAccounts(r, 3)
+
+	// no contracts yet
+	op := simulation.SimulateMsgAttach(app.AccountKeeper, app.BankKeeper, app.CollectionKeeper)
+	operationMsg, _, err := op(r, app.BaseApp, ctx, accounts, "")
+	s.Require().NoError(err)
+	s.Require().False(operationMsg.OK)
+
+	owner := accounts[0].Address
+	contractID := app.CollectionKeeper.CreateContract(ctx, owner, collection.Contract{Name: "fox"})
+	classID, err := app.CollectionKeeper.CreateTokenClass(ctx, contractID, &collection.NFTClass{Name: "fennec fox"})
+	s.Require().NoError(err)
+	_, err = app.CollectionKeeper.MintNFT(ctx, contractID, owner, []collection.MintNFTParam{
+		{TokenType: *classID, Name: "arctic fox"},
+		{TokenType: *classID, Name: "red fox"},
+	})
+	s.Require().NoError(err)
+
+	// begin a new block
+	app.BeginBlock(ocabci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash}})
+
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	s.Require().NoError(err)
+
+	var msg collection.MsgAttach
+	err = collection.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+	s.Require().NoError(err)
+
+	s.Require().True(operationMsg.OK)
+	s.Require().Equal(contractID, msg.ContractId)
+	s.Require().Equal(owner.String(), msg.From)
+	s.Require().Len(futureOperations, 0)
+
+	parent, err := app.CollectionKeeper.GetParent(ctx, contractID, msg.TokenId)
+	s.Require().NoError(err)
+	s.Require().Equal(msg.ToTokenId, *parent)
+}
+
+func TestSimTestSuite(t *testing.T) {
+	suite.Run(t, new(SimTestSuite))
+}
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	github_com_Finschia_finschia_sdk_types "github.com/Finschia/finschia-sdk/types"
+	types "github.com/Finschia/finschia-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -489,28 +490,28 @@ func (m *MsgOperatorSendNFTResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgOperatorSendNFTResponse proto.InternalMessageInfo
 
-// MsgAuthorizeOperator is the Msg/AuthorizeOperator request type.
-type MsgAuthorizeOperator struct {
+// MsgMultiSendFT is the Msg/MultiSendFT request type.
+type MsgMultiSendFT struct {
 	// contract id associated with the contract.
 	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	// address of the holder who allows the manipulation of its token.
-	Holder string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
-	// address which the manipulation is allowed to.
-	Operator string `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+	// the address which the transfer is from.
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// the outputs of the transfer.
+	Outputs []FTOutput `protobuf:"bytes,3,rep,name=outputs,proto3" json:"outputs"`
 }
 
-func (m *MsgAuthorizeOperator) Reset()         { *m = MsgAuthorizeOperator{} }
-func (m *MsgAuthorizeOperator) String() string { return proto.CompactTextString(m) }
-func (*MsgAuthorizeOperator) ProtoMessage()    {}
-func (*MsgAuthorizeOperator) Descriptor() ([]byte, []int) {
+func (m *MsgMultiSendFT) Reset()         { *m = MsgMultiSendFT{} }
+func (m *MsgMultiSendFT) String() string { return proto.CompactTextString(m) }
+func (*MsgMultiSendFT) ProtoMessage()    {}
+func (*MsgMultiSendFT) Descriptor() ([]byte, []int) {
 	return fileDescriptor_eaee77977a3cfe12, []int{8}
 }
-func (m *MsgAuthorizeOperator) XXX_Unmarshal(b []byte) error {
+func (m *MsgMultiSendFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAuthorizeOperator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMultiSendFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAuthorizeOperator.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMultiSendFT.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -520,55 +521,59 @@ func (m *MsgAuthorizeOperator) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *MsgAuthorizeOperator) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAuthorizeOperator.Merge(m, src)
+func (m *MsgMultiSendFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMultiSendFT.Merge(m, src)
 }
-func (m *MsgAuthorizeOperator) XXX_Size() int {
+func (m *MsgMultiSendFT) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAuthorizeOperator) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAuthorizeOperator.DiscardUnknown(m)
+func (m *MsgMultiSendFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMultiSendFT.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAuthorizeOperator proto.InternalMessageInfo
+var xxx_messageInfo_MsgMultiSendFT proto.InternalMessageInfo
 
-func (m *MsgAuthorizeOperator) GetContractId() string {
+func (m *MsgMultiSendFT) GetContractId() string {
 	if m != nil {
 		return m.ContractId
 	}
 	return ""
 }
 
-func (m *MsgAuthorizeOperator) GetHolder() string {
+func (m *MsgMultiSendFT) GetFrom() string {
 	if m != nil {
-		return m.Holder
+		return m.From
 	}
 	return ""
 }
 
-func (m *MsgAuthorizeOperator) GetOperator() string {
+func (m *MsgMultiSendFT) GetOutputs() []FTOutput {
 	if m != nil {
-		return m.Operator
+		return m.Outputs
 	}
-	return ""
+	return nil
 }
 
-// MsgAuthorizeOperatorResponse is the Msg/AuthorizeOperator response type.
-type MsgAuthorizeOperatorResponse struct {
+// FTOutput defines an output of the fungible token batch transfer.
+type FTOutput struct {
+	// the address which the transfer is to.
+	To string `protobuf:"bytes,1,opt,name=to,proto3" json:"to,omitempty"`
+	// the amount of the transfer.
+	Amount []Coin `protobuf:"bytes,2,rep,name=amount,proto3" json:"amount"`
 }
 
-func (m *MsgAuthorizeOperatorResponse) Reset()         { *m = MsgAuthorizeOperatorResponse{} }
-func (m *MsgAuthorizeOperatorResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgAuthorizeOperatorResponse) ProtoMessage()    {}
-func (*MsgAuthorizeOperatorResponse) Descriptor() ([]byte, []int) {
+func (m *FTOutput) Reset()         { *m = FTOutput{} }
+func (m *FTOutput) String() string { return proto.CompactTextString(m) }
+func (*FTOutput) ProtoMessage()    {}
+func (*FTOutput) Descriptor() ([]byte, []int) {
 	return fileDescriptor_eaee77977a3cfe12, []int{9}
 }
-func (m *MsgAuthorizeOperatorResponse) XXX_Unmarshal(b []byte) error {
+func (m *FTOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAuthorizeOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FTOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAuthorizeOperatorResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FTOutput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -578,40 +583,48 @@ func (m *MsgAuthorizeOperatorResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgAuthorizeOperatorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAuthorizeOperatorResponse.Merge(m, src)
+func (m *FTOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FTOutput.Merge(m, src)
 }
-func (m *MsgAuthorizeOperatorResponse) XXX_Size() int {
+func (m *FTOutput) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAuthorizeOperatorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAuthorizeOperatorResponse.DiscardUnknown(m)
+func (m *FTOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_FTOutput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAuthorizeOperatorResponse proto.InternalMessageInfo
+var xxx_messageInfo_FTOutput proto.InternalMessageInfo
 
-// MsgRevokeOperator is the Msg/RevokeOperator request type.
-type MsgRevokeOperator struct {
-	// contract id associated with the contract.
-	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	// address of the holder who allows the manipulation of its token.
-	Holder string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
-	// address which the manipulation is allowed to.
-	Operator string `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+func (m *FTOutput) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
 }
 
-func (m *MsgRevokeOperator) Reset()         { *m = MsgRevokeOperator{} }
-func (m *MsgRevokeOperator) String() string { return proto.CompactTextString(m) }
-func (*MsgRevokeOperator) ProtoMessage()    {}
-func (*MsgRevokeOperator) Descriptor() ([]byte, []int) {
+func (m *FTOutput) GetAmount() []Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgMultiSendFTResponse is the Msg/MultiSendFT response type.
+type MsgMultiSendFTResponse struct {
+}
+
+func (m *MsgMultiSendFTResponse) Reset()         { *m = MsgMultiSendFTResponse{} }
+func (m *MsgMultiSendFTResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMultiSendFTResponse) ProtoMessage()    {}
+func (*MsgMultiSendFTResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_eaee77977a3cfe12, []int{10}
 }
-func (m *MsgRevokeOperator) XXX_Unmarshal(b []byte) error {
+func (m *MsgMultiSendFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRevokeOperator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMultiSendFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRevokeOperator.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMultiSendFTResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -621,55 +634,40 @@ func (m *MsgRevokeOperator) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgRevokeOperator) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRevokeOperator.Merge(m, src)
+func (m *MsgMultiSendFTResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMultiSendFTResponse.Merge(m, src)
 }
-func (m *MsgRevokeOperator) XXX_Size() int {
+func (m *MsgMultiSendFTResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRevokeOperator) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRevokeOperator.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_MsgRevokeOperator proto.InternalMessageInfo
-
-func (m *MsgRevokeOperator) GetContractId() string {
-	if m != nil {
-		return m.ContractId
-	}
-	return ""
-}
-
-func (m *MsgRevokeOperator) GetHolder() string {
-	if m != nil {
-		return m.Holder
-	}
-	return ""
+func (m *MsgMultiSendFTResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMultiSendFTResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRevokeOperator) GetOperator() string {
-	if m != nil {
-		return m.Operator
-	}
-	return ""
-}
+var xxx_messageInfo_MsgMultiSendFTResponse proto.InternalMessageInfo
 
-// MsgRevokeOperatorResponse is the Msg/RevokeOperator response type.
-type MsgRevokeOperatorResponse struct {
+// MsgMultiSendNFT is the Msg/MultiSendNFT request type.
+type MsgMultiSendNFT struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// the address which the transfer is from.
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// the outputs of the transfer.
+	Outputs []NFTOutput `protobuf:"bytes,3,rep,name=outputs,proto3" json:"outputs"`
 }
 
-func (m *MsgRevokeOperatorResponse) Reset()         { *m = MsgRevokeOperatorResponse{} }
-func (m *MsgRevokeOperatorResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRevokeOperatorResponse) ProtoMessage()    {}
-func (*MsgRevokeOperatorResponse) Descriptor() ([]byte, []int) {
+func (m *MsgMultiSendNFT) Reset()         { *m = MsgMultiSendNFT{} }
+func (m *MsgMultiSendNFT) String() string { return proto.CompactTextString(m) }
+func (*MsgMultiSendNFT) ProtoMessage()    {}
+func (*MsgMultiSendNFT) Descriptor() ([]byte, []int) {
 	return fileDescriptor_eaee77977a3cfe12, []int{11}
 }
-func (m *MsgRevokeOperatorResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgMultiSendNFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRevokeOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMultiSendNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRevokeOperatorResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMultiSendNFT.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -679,44 +677,59 @@ func (m *MsgRevokeOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgRevokeOperatorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRevokeOperatorResponse.Merge(m, src)
+func (m *MsgMultiSendNFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMultiSendNFT.Merge(m, src)
 }
-func (m *MsgRevokeOperatorResponse) XXX_Size() int {
+func (m *MsgMultiSendNFT) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRevokeOperatorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRevokeOperatorResponse.DiscardUnknown(m)
+func (m *MsgMultiSendNFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMultiSendNFT.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRevokeOperatorResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgMultiSendNFT proto.InternalMessageInfo
 
-// MsgCreateContract is the Msg/CreateContract request type.
-//
-// Signer: `owner`
-type MsgCreateContract struct {
-	// address which all the permissions on the contract will be granted to (not a permanent property).
-	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	// name defines the human-readable name of the contract.
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// uri for the contract image stored off chain.
-	Uri string `protobuf:"bytes,3,opt,name=uri,proto3" json:"uri,omitempty"`
-	// meta is a brief description of the contract.
-	Meta string `protobuf:"bytes,4,opt,name=meta,proto3" json:"meta,omitempty"`
+func (m *MsgMultiSendNFT) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
 }
 
-func (m *MsgCreateContract) Reset()         { *m = MsgCreateContract{} }
-func (m *MsgCreateContract) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateContract) ProtoMessage()    {}
-func (*MsgCreateContract) Descriptor() ([]byte, []int) {
+func (m *MsgMultiSendNFT) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *MsgMultiSendNFT) GetOutputs() []NFTOutput {
+	if m != nil {
+		return m.Outputs
+	}
+	return nil
+}
+
+// NFTOutput defines an output of the non-fungible token batch transfer.
+type NFTOutput struct {
+	// the address which the transfer is to.
+	To string `protobuf:"bytes,1,opt,name=to,proto3" json:"to,omitempty"`
+	// the token ids to transfer.
+	TokenIds []string `protobuf:"bytes,2,rep,name=token_ids,json=tokenIds,proto3" json:"token_ids,omitempty"`
+}
+
+func (m *NFTOutput) Reset()         { *m = NFTOutput{} }
+func (m *NFTOutput) String() string { return proto.CompactTextString(m) }
+func (*NFTOutput) ProtoMessage()    {}
+func (*NFTOutput) Descriptor() ([]byte, []int) {
 	return fileDescriptor_eaee77977a3cfe12, []int{12}
 }
-func (m *MsgCreateContract) XXX_Unmarshal(b []byte) error {
+func (m *NFTOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *NFTOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateContract.Marshal(b, m, deterministic)
+		return xxx_messageInfo_NFTOutput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -726,64 +739,48 @@ func (m *MsgCreateContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateContract) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateContract.Merge(m, src)
+func (m *NFTOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NFTOutput.Merge(m, src)
 }
-func (m *MsgCreateContract) XXX_Size() int {
+func (m *NFTOutput) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateContract) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateContract.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_MsgCreateContract proto.InternalMessageInfo
-
-func (m *MsgCreateContract) GetOwner() string {
-	if m != nil {
-		return m.Owner
-	}
-	return ""
+func (m *NFTOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_NFTOutput.DiscardUnknown(m)
 }
 
-func (m *MsgCreateContract) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
-}
+var xxx_messageInfo_NFTOutput proto.InternalMessageInfo
 
-func (m *MsgCreateContract) GetUri() string {
+func (m *NFTOutput) GetTo() string {
 	if m != nil {
-		return m.Uri
+		return m.To
 	}
 	return ""
 }
 
-func (m *MsgCreateContract) GetMeta() string {
+func (m *NFTOutput) GetTokenIds() []string {
 	if m != nil {
-		return m.Meta
+		return m.TokenIds
 	}
-	return ""
+	return nil
 }
 
-// MsgCreateContractResponse is the Msg/CreateContract response type.
-type MsgCreateContractResponse struct {
-	// id of the new contract.
-	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+// MsgMultiSendNFTResponse is the Msg/MultiSendNFT response type.
+type MsgMultiSendNFTResponse struct {
 }
 
-func (m *MsgCreateContractResponse) Reset()         { *m = MsgCreateContractResponse{} }
-func (m *MsgCreateContractResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateContractResponse) ProtoMessage()    {}
-func (*MsgCreateContractResponse) Descriptor() ([]byte, []int) {
+func (m *MsgMultiSendNFTResponse) Reset()         { *m = MsgMultiSendNFTResponse{} }
+func (m *MsgMultiSendNFTResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMultiSendNFTResponse) ProtoMessage()    {}
+func (*MsgMultiSendNFTResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_eaee77977a3cfe12, []int{13}
 }
-func (m *MsgCreateContractResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgMultiSendNFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMultiSendNFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateContractResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMultiSendNFTResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -793,61 +790,46 @@ func (m *MsgCreateContractResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateContractResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateContractResponse.Merge(m, src)
+func (m *MsgMultiSendNFTResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMultiSendNFTResponse.Merge(m, src)
 }
-func (m *MsgCreateContractResponse) XXX_Size() int {
+func (m *MsgMultiSendNFTResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateContractResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateContractResponse.DiscardUnknown(m)
+func (m *MsgMultiSendNFTResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMultiSendNFTResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateContractResponse proto.InternalMessageInfo
-
-func (m *MsgCreateContractResponse) GetContractId() string {
-	if m != nil {
-		return m.ContractId
-	}
-	return ""
-}
+var xxx_messageInfo_MsgMultiSendNFTResponse proto.InternalMessageInfo
 
-// MsgIssueFT is the Msg/IssueFT request type.
+// MsgSellNFT is the Msg/SellNFT request type.
 //
-// Signer: `owner`
-type MsgIssueFT struct {
+// Signer: `seller`, `buyer`
+type MsgSellNFT struct {
 	// contract id associated with the contract.
 	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	// name defines the human-readable name of the token type.
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// meta is a brief description of the token type.
-	Meta string `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
-	// decimals is the number of decimals which one must divide the amount by to get its user representation.
-	Decimals int32 `protobuf:"varint,4,opt,name=decimals,proto3" json:"decimals,omitempty"`
-	// mintable represents whether the token is allowed to be minted or burnt.
-	Mintable bool `protobuf:"varint,5,opt,name=mintable,proto3" json:"mintable,omitempty"`
-	// the address of the grantee which must have the permission to issue a token.
-	Owner string `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty"`
-	// the address to send the minted tokens to. mandatory.
-	To string `protobuf:"bytes,7,opt,name=to,proto3" json:"to,omitempty"`
-	// the amount of tokens to mint on the issuance.
-	// Note: if you provide negative amount, a panic may result.
-	// Note: amount may be zero.
-	Amount github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,8,opt,name=amount,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"amount"`
+	// address which sells the token.
+	Seller string `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	// address which buys the token.
+	Buyer string `protobuf:"bytes,3,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// token id of the token to sell.
+	TokenId string `protobuf:"bytes,4,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// sale price paid by the buyer.
+	Price types.Coin `protobuf:"bytes,5,opt,name=price,proto3" json:"price"`
 }
 
-func (m *MsgIssueFT) Reset()         { *m = MsgIssueFT{} }
-func (m *MsgIssueFT) String() string { return proto.CompactTextString(m) }
-func (*MsgIssueFT) ProtoMessage()    {}
-func (*MsgIssueFT) Descriptor() ([]byte, []int) {
+func (m *MsgSellNFT) Reset()         { *m = MsgSellNFT{} }
+func (m *MsgSellNFT) String() string { return proto.CompactTextString(m) }
+func (*MsgSellNFT) ProtoMessage()    {}
+func (*MsgSellNFT) Descriptor() ([]byte, []int) {
 	return fileDescriptor_eaee77977a3cfe12, []int{14}
 }
-func (m *MsgIssueFT) XXX_Unmarshal(b []byte) error {
+func (m *MsgSellNFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgIssueFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSellNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgIssueFT.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSellNFT.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -857,85 +839,69 @@ func (m *MsgIssueFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *MsgIssueFT) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgIssueFT.Merge(m, src)
+func (m *MsgSellNFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSellNFT.Merge(m, src)
 }
-func (m *MsgIssueFT) XXX_Size() int {
+func (m *MsgSellNFT) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgIssueFT) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgIssueFT.DiscardUnknown(m)
+func (m *MsgSellNFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSellNFT.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgIssueFT proto.InternalMessageInfo
+var xxx_messageInfo_MsgSellNFT proto.InternalMessageInfo
 
-func (m *MsgIssueFT) GetContractId() string {
+func (m *MsgSellNFT) GetContractId() string {
 	if m != nil {
 		return m.ContractId
 	}
 	return ""
 }
 
-func (m *MsgIssueFT) GetName() string {
+func (m *MsgSellNFT) GetSeller() string {
 	if m != nil {
-		return m.Name
+		return m.Seller
 	}
 	return ""
 }
 
-func (m *MsgIssueFT) GetMeta() string {
+func (m *MsgSellNFT) GetBuyer() string {
 	if m != nil {
-		return m.Meta
+		return m.Buyer
 	}
 	return ""
 }
 
-func (m *MsgIssueFT) GetDecimals() int32 {
+func (m *MsgSellNFT) GetTokenId() string {
 	if m != nil {
-		return m.Decimals
+		return m.TokenId
 	}
-	return 0
+	return ""
 }
 
-func (m *MsgIssueFT) GetMintable() bool {
+func (m *MsgSellNFT) GetPrice() types.Coin {
 	if m != nil {
-		return m.Mintable
+		return m.Price
 	}
-	return false
+	return types.Coin{}
 }
 
-func (m *MsgIssueFT) GetOwner() string {
-	if m != nil {
-		return m.Owner
-	}
-	return ""
-}
-
-func (m *MsgIssueFT) GetTo() string {
-	if m != nil {
-		return m.To
-	}
-	return ""
-}
-
-// MsgIssueFTResponse is the Msg/IssueFT response type.
-type MsgIssueFTResponse struct {
-	// id of the token.
-	TokenId string `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+// MsgSellNFTResponse is the Msg/SellNFT response type.
+type MsgSellNFTResponse struct {
 }
 
-func (m *MsgIssueFTResponse) Reset()         { *m = MsgIssueFTResponse{} }
-func (m *MsgIssueFTResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgIssueFTResponse) ProtoMessage()    {}
-func (*MsgIssueFTResponse) Descriptor() ([]byte, []int) {
+func (m *MsgSellNFTResponse) Reset()         { *m = MsgSellNFTResponse{} }
+func (m *MsgSellNFTResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSellNFTResponse) ProtoMessage()    {}
+func (*MsgSellNFTResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_eaee77977a3cfe12, []int{15}
 }
-func (m *MsgIssueFTResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSellNFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgIssueFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSellNFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgIssueFTResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSellNFTResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -945,51 +911,40 @@ func (m *MsgIssueFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgIssueFTResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgIssueFTResponse.Merge(m, src)
+func (m *MsgSellNFTResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSellNFTResponse.Merge(m, src)
 }
-func (m *MsgIssueFTResponse) XXX_Size() int {
+func (m *MsgSellNFTResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgIssueFTResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgIssueFTResponse.DiscardUnknown(m)
+func (m *MsgSellNFTResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSellNFTResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgIssueFTResponse proto.InternalMessageInfo
-
-func (m *MsgIssueFTResponse) GetTokenId() string {
-	if m != nil {
-		return m.TokenId
-	}
-	return ""
-}
+var xxx_messageInfo_MsgSellNFTResponse proto.InternalMessageInfo
 
-// MsgIssueNFT is the Msg/IssueNFT request type.
-//
-// Signer: `owner`
-type MsgIssueNFT struct {
+// MsgAuthorizeOperator is the Msg/AuthorizeOperator request type.
+type MsgAuthorizeOperator struct {
 	// contract id associated with the contract.
 	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	// name defines the human-readable name of the token type.
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// meta is a brief description of the token type.
-	Meta string `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
-	// the address of the grantee which must have the permission to issue a token.
-	Owner string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	// address of the holder who allows the manipulation of its token.
+	Holder string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	// address which the manipulation is allowed to.
+	Operator string `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
 }
 
-func (m *MsgIssueNFT) Reset()         { *m = MsgIssueNFT{} }
-func (m *MsgIssueNFT) String() string { return proto.CompactTextString(m) }
-func (*MsgIssueNFT) ProtoMessage()    {}
-func (*MsgIssueNFT) Descriptor() ([]byte, []int) {
+func (m *MsgAuthorizeOperator) Reset()         { *m = MsgAuthorizeOperator{} }
+func (m *MsgAuthorizeOperator) String() string { return proto.CompactTextString(m) }
+func (*MsgAuthorizeOperator) ProtoMessage()    {}
+func (*MsgAuthorizeOperator) Descriptor() ([]byte, []int) {
 	return fileDescriptor_eaee77977a3cfe12, []int{16}
 }
-func (m *MsgIssueNFT) XXX_Unmarshal(b []byte) error {
+func (m *MsgAuthorizeOperator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgIssueNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAuthorizeOperator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgIssueNFT.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAuthorizeOperator.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -999,65 +954,55 @@ func (m *MsgIssueNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *MsgIssueNFT) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgIssueNFT.Merge(m, src)
+func (m *MsgAuthorizeOperator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAuthorizeOperator.Merge(m, src)
 }
-func (m *MsgIssueNFT) XXX_Size() int {
+func (m *MsgAuthorizeOperator) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgIssueNFT) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgIssueNFT.DiscardUnknown(m)
+func (m *MsgAuthorizeOperator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAuthorizeOperator.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgIssueNFT proto.InternalMessageInfo
+var xxx_messageInfo_MsgAuthorizeOperator proto.InternalMessageInfo
 
-func (m *MsgIssueNFT) GetContractId() string {
+func (m *MsgAuthorizeOperator) GetContractId() string {
 	if m != nil {
 		return m.ContractId
 	}
 	return ""
 }
 
-func (m *MsgIssueNFT) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
-}
-
-func (m *MsgIssueNFT) GetMeta() string {
+func (m *MsgAuthorizeOperator) GetHolder() string {
 	if m != nil {
-		return m.Meta
+		return m.Holder
 	}
 	return ""
 }
 
-func (m *MsgIssueNFT) GetOwner() string {
+func (m *MsgAuthorizeOperator) GetOperator() string {
 	if m != nil {
-		return m.Owner
+		return m.Operator
 	}
 	return ""
 }
 
-// MsgIssueNFTResponse is the Msg/IssueNFT response type.
-type MsgIssueNFTResponse struct {
-	// id of the new token type.
-	// refer to TokenType for the definition.
-	TokenType string `protobuf:"bytes,1,opt,name=token_type,json=tokenType,proto3" json:"token_type,omitempty"`
+// MsgAuthorizeOperatorResponse is the Msg/AuthorizeOperator response type.
+type MsgAuthorizeOperatorResponse struct {
 }
 
-func (m *MsgIssueNFTResponse) Reset()         { *m = MsgIssueNFTResponse{} }
-func (m *MsgIssueNFTResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgIssueNFTResponse) ProtoMessage()    {}
-func (*MsgIssueNFTResponse) Descriptor() ([]byte, []int) {
+func (m *MsgAuthorizeOperatorResponse) Reset()         { *m = MsgAuthorizeOperatorResponse{} }
+func (m *MsgAuthorizeOperatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAuthorizeOperatorResponse) ProtoMessage()    {}
+func (*MsgAuthorizeOperatorResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_eaee77977a3cfe12, []int{17}
 }
-func (m *MsgIssueNFTResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgAuthorizeOperatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgIssueNFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAuthorizeOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgIssueNFTResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAuthorizeOperatorResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1067,52 +1012,40 @@ func (m *MsgIssueNFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgIssueNFTResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgIssueNFTResponse.Merge(m, src)
+func (m *MsgAuthorizeOperatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAuthorizeOperatorResponse.Merge(m, src)
 }
-func (m *MsgIssueNFTResponse) XXX_Size() int {
+func (m *MsgAuthorizeOperatorResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgIssueNFTResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgIssueNFTResponse.DiscardUnknown(m)
+func (m *MsgAuthorizeOperatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAuthorizeOperatorResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgIssueNFTResponse proto.InternalMessageInfo
-
-func (m *MsgIssueNFTResponse) GetTokenType() string {
-	if m != nil {
-		return m.TokenType
-	}
-	return ""
-}
+var xxx_messageInfo_MsgAuthorizeOperatorResponse proto.InternalMessageInfo
 
-// MsgMintFT is the Msg/MintFT request type.
-//
-// Signer: `from`
-type MsgMintFT struct {
+// MsgRevokeOperator is the Msg/RevokeOperator request type.
+type MsgRevokeOperator struct {
 	// contract id associated with the contract.
 	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	// address of the grantee which has the permission for the mint.
-	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
-	// address which the minted tokens will be sent to.
-	To string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
-	// the amount of the mint.
-	// Note: amount may be empty.
-	Amount Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=Coins" json:"amount"`
+	// address of the holder who allows the manipulation of its token.
+	Holder string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	// address which the manipulation is allowed to.
+	Operator string `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
 }
 
-func (m *MsgMintFT) Reset()         { *m = MsgMintFT{} }
-func (m *MsgMintFT) String() string { return proto.CompactTextString(m) }
-func (*MsgMintFT) ProtoMessage()    {}
-func (*MsgMintFT) Descriptor() ([]byte, []int) {
+func (m *MsgRevokeOperator) Reset()         { *m = MsgRevokeOperator{} }
+func (m *MsgRevokeOperator) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeOperator) ProtoMessage()    {}
+func (*MsgRevokeOperator) Descriptor() ([]byte, []int) {
 	return fileDescriptor_eaee77977a3cfe12, []int{18}
 }
-func (m *MsgMintFT) XXX_Unmarshal(b []byte) error {
+func (m *MsgRevokeOperator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMintFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRevokeOperator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMintFT.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRevokeOperator.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1122,62 +1055,55 @@ func (m *MsgMintFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *MsgMintFT) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMintFT.Merge(m, src)
+func (m *MsgRevokeOperator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeOperator.Merge(m, src)
 }
-func (m *MsgMintFT) XXX_Size() int {
+func (m *MsgRevokeOperator) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMintFT) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMintFT.DiscardUnknown(m)
+func (m *MsgRevokeOperator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeOperator.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMintFT proto.InternalMessageInfo
+var xxx_messageInfo_MsgRevokeOperator proto.InternalMessageInfo
 
-func (m *MsgMintFT) GetContractId() string {
+func (m *MsgRevokeOperator) GetContractId() string {
 	if m != nil {
 		return m.ContractId
 	}
 	return ""
 }
 
-func (m *MsgMintFT) GetFrom() string {
+func (m *MsgRevokeOperator) GetHolder() string {
 	if m != nil {
-		return m.From
+		return m.Holder
 	}
 	return ""
 }
 
-func (m *MsgMintFT) GetTo() string {
+func (m *MsgRevokeOperator) GetOperator() string {
 	if m != nil {
-		return m.To
+		return m.Operator
 	}
 	return ""
 }
 
-func (m *MsgMintFT) GetAmount() Coins {
-	if m != nil {
-		return m.Amount
-	}
-	return nil
-}
-
-// MsgMintFTResponse is the Msg/MintFT response type.
-type MsgMintFTResponse struct {
+// MsgRevokeOperatorResponse is the Msg/RevokeOperator response type.
+type MsgRevokeOperatorResponse struct {
 }
 
-func (m *MsgMintFTResponse) Reset()         { *m = MsgMintFTResponse{} }
-func (m *MsgMintFTResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgMintFTResponse) ProtoMessage()    {}
-func (*MsgMintFTResponse) Descriptor() ([]byte, []int) {
+func (m *MsgRevokeOperatorResponse) Reset()         { *m = MsgRevokeOperatorResponse{} }
+func (m *MsgRevokeOperatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeOperatorResponse) ProtoMessage()    {}
+func (*MsgRevokeOperatorResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_eaee77977a3cfe12, []int{19}
 }
-func (m *MsgMintFTResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgRevokeOperatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMintFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRevokeOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMintFTResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRevokeOperatorResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1187,44 +1113,46 @@ func (m *MsgMintFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgMintFTResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMintFTResponse.Merge(m, src)
+func (m *MsgRevokeOperatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeOperatorResponse.Merge(m, src)
 }
-func (m *MsgMintFTResponse) XXX_Size() int {
+func (m *MsgRevokeOperatorResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMintFTResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMintFTResponse.DiscardUnknown(m)
+func (m *MsgRevokeOperatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeOperatorResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMintFTResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRevokeOperatorResponse proto.InternalMessageInfo
 
-// MsgMintNFT is the Msg/MintNFT request type.
+// MsgCreateContract is the Msg/CreateContract request type.
 //
-// Signer: `from`
-type MsgMintNFT struct {
-	// contract id associated with the contract.
-	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	// address of the grantee which has the permission for the mint.
-	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
-	// address which the minted token will be sent to.
-	To string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
-	// parameters for the minted tokens.
-	Params []MintNFTParam `protobuf:"bytes,4,rep,name=params,proto3" json:"params"`
+// Signer: `owner`
+type MsgCreateContract struct {
+	// address which all the permissions on the contract will be granted to (not a permanent property).
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// name defines the human-readable name of the contract.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// uri for the contract image stored off chain.
+	Uri string `protobuf:"bytes,3,opt,name=uri,proto3" json:"uri,omitempty"`
+	// meta is a brief description of the contract.
+	Meta string `protobuf:"bytes,4,opt,name=meta,proto3" json:"meta,omitempty"`
+	// schema of the traits, which applies to all the non-fungible tokens of the contract (optional).
+	TraitSchema []TraitDefinition `protobuf:"bytes,5,rep,name=trait_schema,json=traitSchema,proto3" json:"trait_schema,omitempty"`
 }
 
-func (m *MsgMintNFT) Reset()         { *m = MsgMintNFT{} }
-func (m *MsgMintNFT) String() string { return proto.CompactTextString(m) }
-func (*MsgMintNFT) ProtoMessage()    {}
-func (*MsgMintNFT) Descriptor() ([]byte, []int) {
+func (m *MsgCreateContract) Reset()         { *m = MsgCreateContract{} }
+func (m *MsgCreateContract) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateContract) ProtoMessage()    {}
+func (*MsgCreateContract) Descriptor() ([]byte, []int) {
 	return fileDescriptor_eaee77977a3cfe12, []int{20}
 }
-func (m *MsgMintNFT) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateContract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMintNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMintNFT.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateContract.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1234,64 +1162,71 @@ func (m *MsgMintNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *MsgMintNFT) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMintNFT.Merge(m, src)
+func (m *MsgCreateContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateContract.Merge(m, src)
 }
-func (m *MsgMintNFT) XXX_Size() int {
+func (m *MsgCreateContract) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMintNFT) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMintNFT.DiscardUnknown(m)
+func (m *MsgCreateContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateContract.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMintNFT proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateContract proto.InternalMessageInfo
 
-func (m *MsgMintNFT) GetContractId() string {
+func (m *MsgCreateContract) GetOwner() string {
 	if m != nil {
-		return m.ContractId
+		return m.Owner
 	}
 	return ""
 }
 
-func (m *MsgMintNFT) GetFrom() string {
+func (m *MsgCreateContract) GetName() string {
 	if m != nil {
-		return m.From
+		return m.Name
 	}
 	return ""
 }
 
-func (m *MsgMintNFT) GetTo() string {
+func (m *MsgCreateContract) GetUri() string {
 	if m != nil {
-		return m.To
+		return m.Uri
 	}
 	return ""
 }
 
-func (m *MsgMintNFT) GetParams() []MintNFTParam {
+func (m *MsgCreateContract) GetMeta() string {
 	if m != nil {
-		return m.Params
+		return m.Meta
+	}
+	return ""
+}
+
+func (m *MsgCreateContract) GetTraitSchema() []TraitDefinition {
+	if m != nil {
+		return m.TraitSchema
 	}
 	return nil
 }
 
-// MsgMintNFTResponse is the Msg/MintNFT response type.
-type MsgMintNFTResponse struct {
-	// ids of the new non-fungible tokens.
-	TokenIds []string `protobuf:"bytes,1,rep,name=token_ids,json=tokenIds,proto3" json:"token_ids,omitempty"`
+// MsgCreateContractResponse is the Msg/CreateContract response type.
+type MsgCreateContractResponse struct {
+	// id of the new contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
 }
 
-func (m *MsgMintNFTResponse) Reset()         { *m = MsgMintNFTResponse{} }
-func (m *MsgMintNFTResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgMintNFTResponse) ProtoMessage()    {}
-func (*MsgMintNFTResponse) Descriptor() ([]byte, []int) {
+func (m *MsgCreateContractResponse) Reset()         { *m = MsgCreateContractResponse{} }
+func (m *MsgCreateContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateContractResponse) ProtoMessage()    {}
+func (*MsgCreateContractResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_eaee77977a3cfe12, []int{21}
 }
-func (m *MsgMintNFTResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateContractResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMintNFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMintNFTResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateContractResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1301,51 +1236,61 @@ func (m *MsgMintNFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgMintNFTResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMintNFTResponse.Merge(m, src)
+func (m *MsgCreateContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateContractResponse.Merge(m, src)
 }
-func (m *MsgMintNFTResponse) XXX_Size() int {
+func (m *MsgCreateContractResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMintNFTResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMintNFTResponse.DiscardUnknown(m)
+func (m *MsgCreateContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateContractResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMintNFTResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateContractResponse proto.InternalMessageInfo
 
-func (m *MsgMintNFTResponse) GetTokenIds() []string {
+func (m *MsgCreateContractResponse) GetContractId() string {
 	if m != nil {
-		return m.TokenIds
+		return m.ContractId
 	}
-	return nil
+	return ""
 }
 
-// MintNFTParam defines a parameter for minting nft.
-type MintNFTParam struct {
-	// token type or class id of the nft.
-	// Note: it cannot start with zero.
-	// refer to TokenType for the definition.
-	TokenType string `protobuf:"bytes,1,opt,name=token_type,json=tokenType,proto3" json:"token_type,omitempty"`
-	// name defines the human-readable name of the nft (mandatory).
-	// Note: it has an app-specific limit in length.
+// MsgIssueFT is the Msg/IssueFT request type.
+//
+// Signer: `owner`
+type MsgIssueFT struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// name defines the human-readable name of the token type.
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// meta is a brief description of the nft.
-	// Note: it has an app-specific limit in length.
+	// meta is a brief description of the token type.
 	Meta string `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
+	// decimals is the number of decimals which one must divide the amount by to get its user representation.
+	Decimals int32 `protobuf:"varint,4,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// mintable represents whether the token is allowed to be minted or burnt.
+	Mintable bool `protobuf:"varint,5,opt,name=mintable,proto3" json:"mintable,omitempty"`
+	// the address of the grantee which must have the permission to issue a token.
+	Owner string `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty"`
+	// the address to send the minted tokens to. mandatory.
+	To string `protobuf:"bytes,7,opt,name=to,proto3" json:"to,omitempty"`
+	// the amount of tokens to mint on the issuance.
+	// Note: if you provide negative amount, a panic may result.
+	// Note: amount may be zero.
+	Amount github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,8,opt,name=amount,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"amount"`
 }
 
-func (m *MintNFTParam) Reset()         { *m = MintNFTParam{} }
-func (m *MintNFTParam) String() string { return proto.CompactTextString(m) }
-func (*MintNFTParam) ProtoMessage()    {}
-func (*MintNFTParam) Descriptor() ([]byte, []int) {
+func (m *MsgIssueFT) Reset()         { *m = MsgIssueFT{} }
+func (m *MsgIssueFT) String() string { return proto.CompactTextString(m) }
+func (*MsgIssueFT) ProtoMessage()    {}
+func (*MsgIssueFT) Descriptor() ([]byte, []int) {
 	return fileDescriptor_eaee77977a3cfe12, []int{22}
 }
-func (m *MintNFTParam) XXX_Unmarshal(b []byte) error {
+func (m *MsgIssueFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MintNFTParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgIssueFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MintNFTParam.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgIssueFT.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1355,121 +1300,85 @@ func (m *MintNFTParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *MintNFTParam) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MintNFTParam.Merge(m, src)
+func (m *MsgIssueFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIssueFT.Merge(m, src)
 }
-func (m *MintNFTParam) XXX_Size() int {
+func (m *MsgIssueFT) XXX_Size() int {
 	return m.Size()
 }
-func (m *MintNFTParam) XXX_DiscardUnknown() {
-	xxx_messageInfo_MintNFTParam.DiscardUnknown(m)
+func (m *MsgIssueFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIssueFT.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MintNFTParam proto.InternalMessageInfo
+var xxx_messageInfo_MsgIssueFT proto.InternalMessageInfo
 
-func (m *MintNFTParam) GetTokenType() string {
+func (m *MsgIssueFT) GetContractId() string {
 	if m != nil {
-		return m.TokenType
+		return m.ContractId
 	}
 	return ""
 }
 
-func (m *MintNFTParam) GetName() string {
+func (m *MsgIssueFT) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-func (m *MintNFTParam) GetMeta() string {
+func (m *MsgIssueFT) GetMeta() string {
 	if m != nil {
 		return m.Meta
 	}
 	return ""
 }
 
-// MsgBurnFT is the Msg/BurnFT request type.
-type MsgBurnFT struct {
-	// contract id associated with the contract.
-	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	// address which the tokens will be burnt from.
-	// Note: it must have the permission for the burn.
-	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
-	// the amount of the burn.
-	// Note: amount may be empty.
-	Amount []Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount"`
-}
-
-func (m *MsgBurnFT) Reset()         { *m = MsgBurnFT{} }
-func (m *MsgBurnFT) String() string { return proto.CompactTextString(m) }
-func (*MsgBurnFT) ProtoMessage()    {}
-func (*MsgBurnFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{23}
-}
-func (m *MsgBurnFT) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *MsgBurnFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_MsgBurnFT.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *MsgIssueFT) GetDecimals() int32 {
+	if m != nil {
+		return m.Decimals
 	}
+	return 0
 }
-func (m *MsgBurnFT) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgBurnFT.Merge(m, src)
-}
-func (m *MsgBurnFT) XXX_Size() int {
-	return m.Size()
-}
-func (m *MsgBurnFT) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgBurnFT.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_MsgBurnFT proto.InternalMessageInfo
 
-func (m *MsgBurnFT) GetContractId() string {
+func (m *MsgIssueFT) GetMintable() bool {
 	if m != nil {
-		return m.ContractId
+		return m.Mintable
 	}
-	return ""
+	return false
 }
 
-func (m *MsgBurnFT) GetFrom() string {
+func (m *MsgIssueFT) GetOwner() string {
 	if m != nil {
-		return m.From
+		return m.Owner
 	}
 	return ""
 }
 
-func (m *MsgBurnFT) GetAmount() []Coin {
+func (m *MsgIssueFT) GetTo() string {
 	if m != nil {
-		return m.Amount
+		return m.To
 	}
-	return nil
+	return ""
 }
 
-// MsgBurnFTResponse is the Msg/BurnFT response type.
-type MsgBurnFTResponse struct {
+// MsgIssueFTResponse is the Msg/IssueFT response type.
+type MsgIssueFTResponse struct {
+	// id of the token.
+	TokenId string `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
 }
 
-func (m *MsgBurnFTResponse) Reset()         { *m = MsgBurnFTResponse{} }
-func (m *MsgBurnFTResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgBurnFTResponse) ProtoMessage()    {}
-func (*MsgBurnFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{24}
+func (m *MsgIssueFTResponse) Reset()         { *m = MsgIssueFTResponse{} }
+func (m *MsgIssueFTResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgIssueFTResponse) ProtoMessage()    {}
+func (*MsgIssueFTResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{23}
 }
-func (m *MsgBurnFTResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgIssueFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgBurnFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgIssueFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgBurnFTResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgIssueFTResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1479,45 +1388,56 @@ func (m *MsgBurnFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgBurnFTResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgBurnFTResponse.Merge(m, src)
+func (m *MsgIssueFTResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIssueFTResponse.Merge(m, src)
 }
-func (m *MsgBurnFTResponse) XXX_Size() int {
+func (m *MsgIssueFTResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgBurnFTResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgBurnFTResponse.DiscardUnknown(m)
+func (m *MsgIssueFTResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIssueFTResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgBurnFTResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgIssueFTResponse proto.InternalMessageInfo
 
-// MsgOperatorBurnFT is the Msg/OperatorBurnFT request type.
-type MsgOperatorBurnFT struct {
+func (m *MsgIssueFTResponse) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
+	}
+	return ""
+}
+
+// MsgIssueNFT is the Msg/IssueNFT request type.
+//
+// Signer: `owner`
+type MsgIssueNFT struct {
 	// contract id associated with the contract.
 	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	// address which triggers the burn.
-	// Note: it must have the permission for the burn.
-	// Note: it must have been authorized by from.
-	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
-	// address which the tokens will be burnt from.
-	From string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
-	// the amount of the burn.
-	// Note: amount may be empty.
-	Amount []Coin `protobuf:"bytes,4,rep,name=amount,proto3" json:"amount"`
+	// name defines the human-readable name of the token type.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// meta is a brief description of the token type.
+	Meta string `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
+	// the address of the grantee which must have the permission to issue a token.
+	Owner string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	// royalty paid on the sales of the tokens of the type (optional).
+	Royalty *Royalty `protobuf:"bytes,5,opt,name=royalty,proto3" json:"royalty,omitempty"`
+	// schema of the traits, which applies to the tokens of the type in addition to the schema of the contract (optional).
+	// Note: it cannot redefine the traits of the contract schema.
+	TraitSchema []TraitDefinition `protobuf:"bytes,6,rep,name=trait_schema,json=traitSchema,proto3" json:"trait_schema,omitempty"`
 }
 
-func (m *MsgOperatorBurnFT) Reset()         { *m = MsgOperatorBurnFT{} }
-func (m *MsgOperatorBurnFT) String() string { return proto.CompactTextString(m) }
-func (*MsgOperatorBurnFT) ProtoMessage()    {}
-func (*MsgOperatorBurnFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{25}
+func (m *MsgIssueNFT) Reset()         { *m = MsgIssueNFT{} }
+func (m *MsgIssueNFT) String() string { return proto.CompactTextString(m) }
+func (*MsgIssueNFT) ProtoMessage()    {}
+func (*MsgIssueNFT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{24}
 }
-func (m *MsgOperatorBurnFT) XXX_Unmarshal(b []byte) error {
+func (m *MsgIssueNFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgOperatorBurnFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgIssueNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgOperatorBurnFT.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgIssueNFT.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1527,62 +1447,79 @@ func (m *MsgOperatorBurnFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgOperatorBurnFT) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgOperatorBurnFT.Merge(m, src)
+func (m *MsgIssueNFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIssueNFT.Merge(m, src)
 }
-func (m *MsgOperatorBurnFT) XXX_Size() int {
+func (m *MsgIssueNFT) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgOperatorBurnFT) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgOperatorBurnFT.DiscardUnknown(m)
+func (m *MsgIssueNFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIssueNFT.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgOperatorBurnFT proto.InternalMessageInfo
+var xxx_messageInfo_MsgIssueNFT proto.InternalMessageInfo
 
-func (m *MsgOperatorBurnFT) GetContractId() string {
+func (m *MsgIssueNFT) GetContractId() string {
 	if m != nil {
 		return m.ContractId
 	}
 	return ""
 }
 
-func (m *MsgOperatorBurnFT) GetOperator() string {
+func (m *MsgIssueNFT) GetName() string {
 	if m != nil {
-		return m.Operator
+		return m.Name
 	}
 	return ""
 }
 
-func (m *MsgOperatorBurnFT) GetFrom() string {
+func (m *MsgIssueNFT) GetMeta() string {
 	if m != nil {
-		return m.From
+		return m.Meta
 	}
 	return ""
 }
 
-func (m *MsgOperatorBurnFT) GetAmount() []Coin {
+func (m *MsgIssueNFT) GetOwner() string {
 	if m != nil {
-		return m.Amount
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgIssueNFT) GetRoyalty() *Royalty {
+	if m != nil {
+		return m.Royalty
 	}
 	return nil
 }
 
-// MsgOperatorBurnFTResponse is the Msg/OperatorBurnFT response type.
-type MsgOperatorBurnFTResponse struct {
+func (m *MsgIssueNFT) GetTraitSchema() []TraitDefinition {
+	if m != nil {
+		return m.TraitSchema
+	}
+	return nil
 }
 
-func (m *MsgOperatorBurnFTResponse) Reset()         { *m = MsgOperatorBurnFTResponse{} }
-func (m *MsgOperatorBurnFTResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgOperatorBurnFTResponse) ProtoMessage()    {}
-func (*MsgOperatorBurnFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{26}
+// MsgIssueNFTResponse is the Msg/IssueNFT response type.
+type MsgIssueNFTResponse struct {
+	// id of the new token type.
+	// refer to TokenType for the definition.
+	TokenType string `protobuf:"bytes,1,opt,name=token_type,json=tokenType,proto3" json:"token_type,omitempty"`
 }
-func (m *MsgOperatorBurnFTResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgIssueNFTResponse) Reset()         { *m = MsgIssueNFTResponse{} }
+func (m *MsgIssueNFTResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgIssueNFTResponse) ProtoMessage()    {}
+func (*MsgIssueNFTResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{25}
+}
+func (m *MsgIssueNFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgOperatorBurnFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgIssueNFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgOperatorBurnFTResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgIssueNFTResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1592,42 +1529,55 @@ func (m *MsgOperatorBurnFTResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgOperatorBurnFTResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgOperatorBurnFTResponse.Merge(m, src)
+func (m *MsgIssueNFTResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIssueNFTResponse.Merge(m, src)
 }
-func (m *MsgOperatorBurnFTResponse) XXX_Size() int {
+func (m *MsgIssueNFTResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgOperatorBurnFTResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgOperatorBurnFTResponse.DiscardUnknown(m)
+func (m *MsgIssueNFTResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIssueNFTResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgOperatorBurnFTResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgIssueNFTResponse proto.InternalMessageInfo
 
-// MsgBurnNFT is the Msg/BurnNFT request type.
-type MsgBurnNFT struct {
+func (m *MsgIssueNFTResponse) GetTokenType() string {
+	if m != nil {
+		return m.TokenType
+	}
+	return ""
+}
+
+// MsgMintFT is the Msg/MintFT request type.
+//
+// Signer: `from`
+type MsgMintFT struct {
 	// contract id associated with the contract.
 	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	// address which the tokens will be burnt from.
-	// Note: it must have the permission for the burn.
+	// address of the grantee which has the permission for the mint.
 	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
-	// the token ids to burn.
-	// Note: id cannot start with zero.
-	TokenIds []string `protobuf:"bytes,3,rep,name=token_ids,json=tokenIds,proto3" json:"token_ids,omitempty"`
+	// address which the minted tokens will be sent to.
+	To string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// the amount of the mint.
+	// Note: amount may be empty.
+	Amount Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=Coins" json:"amount"`
+	// vesting schedule of the minted tokens (optional).
+	// if provided, the minted tokens are locked until the schedule unlocks them.
+	Vesting *VestingSchedule `protobuf:"bytes,5,opt,name=vesting,proto3" json:"vesting,omitempty"`
 }
 
-func (m *MsgBurnNFT) Reset()         { *m = MsgBurnNFT{} }
-func (m *MsgBurnNFT) String() string { return proto.CompactTextString(m) }
-func (*MsgBurnNFT) ProtoMessage()    {}
-func (*MsgBurnNFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{27}
+func (m *MsgMintFT) Reset()         { *m = MsgMintFT{} }
+func (m *MsgMintFT) String() string { return proto.CompactTextString(m) }
+func (*MsgMintFT) ProtoMessage()    {}
+func (*MsgMintFT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{26}
 }
-func (m *MsgBurnNFT) XXX_Unmarshal(b []byte) error {
+func (m *MsgMintFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgBurnNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMintFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgBurnNFT.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMintFT.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1637,55 +1587,69 @@ func (m *MsgBurnNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *MsgBurnNFT) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgBurnNFT.Merge(m, src)
+func (m *MsgMintFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMintFT.Merge(m, src)
 }
-func (m *MsgBurnNFT) XXX_Size() int {
+func (m *MsgMintFT) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgBurnNFT) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgBurnNFT.DiscardUnknown(m)
+func (m *MsgMintFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMintFT.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgBurnNFT proto.InternalMessageInfo
+var xxx_messageInfo_MsgMintFT proto.InternalMessageInfo
 
-func (m *MsgBurnNFT) GetContractId() string {
+func (m *MsgMintFT) GetContractId() string {
 	if m != nil {
 		return m.ContractId
 	}
 	return ""
 }
 
-func (m *MsgBurnNFT) GetFrom() string {
+func (m *MsgMintFT) GetFrom() string {
 	if m != nil {
 		return m.From
 	}
 	return ""
 }
 
-func (m *MsgBurnNFT) GetTokenIds() []string {
+func (m *MsgMintFT) GetTo() string {
 	if m != nil {
-		return m.TokenIds
+		return m.To
+	}
+	return ""
+}
+
+func (m *MsgMintFT) GetAmount() Coins {
+	if m != nil {
+		return m.Amount
 	}
 	return nil
 }
 
-// MsgBurnNFTResponse is the Msg/BurnNFT response type.
-type MsgBurnNFTResponse struct {
+func (m *MsgMintFT) GetVesting() *VestingSchedule {
+	if m != nil {
+		return m.Vesting
+	}
+	return nil
 }
 
-func (m *MsgBurnNFTResponse) Reset()         { *m = MsgBurnNFTResponse{} }
-func (m *MsgBurnNFTResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgBurnNFTResponse) ProtoMessage()    {}
-func (*MsgBurnNFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{28}
+// MsgMintFTResponse is the Msg/MintFT response type.
+type MsgMintFTResponse struct {
 }
-func (m *MsgBurnNFTResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgMintFTResponse) Reset()         { *m = MsgMintFTResponse{} }
+func (m *MsgMintFTResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMintFTResponse) ProtoMessage()    {}
+func (*MsgMintFTResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{27}
+}
+func (m *MsgMintFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgBurnNFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMintFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgBurnNFTResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMintFTResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1695,45 +1659,47 @@ func (m *MsgBurnNFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgBurnNFTResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgBurnNFTResponse.Merge(m, src)
+func (m *MsgMintFTResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMintFTResponse.Merge(m, src)
 }
-func (m *MsgBurnNFTResponse) XXX_Size() int {
+func (m *MsgMintFTResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgBurnNFTResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgBurnNFTResponse.DiscardUnknown(m)
+func (m *MsgMintFTResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMintFTResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgBurnNFTResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgMintFTResponse proto.InternalMessageInfo
 
-// MsgOperatorBurnNFT is the Msg/OperatorBurnNFT request type.
-type MsgOperatorBurnNFT struct {
+// MsgMintNFT is the Msg/MintNFT request type.
+//
+// Signer: `from`
+type MsgMintNFT struct {
 	// contract id associated with the contract.
 	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	// address which triggers the burn.
-	// Note: it must have the permission for the burn.
-	// Note: it must have been authorized by from.
-	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
-	// address which the tokens will be burnt from.
-	From string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
-	// the token ids to burn.
-	// Note: id cannot start with zero.
-	TokenIds []string `protobuf:"bytes,4,rep,name=token_ids,json=tokenIds,proto3" json:"token_ids,omitempty"`
+	// address of the grantee which has the permission for the mint.
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// address which the minted token will be sent to.
+	To string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// parameters for the minted tokens.
+	Params []MintNFTParam `protobuf:"bytes,4,rep,name=params,proto3" json:"params"`
+	// vesting schedule of the minted tokens (optional).
+	// if provided, the minted tokens are locked until end_time of the schedule.
+	Vesting *VestingSchedule `protobuf:"bytes,5,opt,name=vesting,proto3" json:"vesting,omitempty"`
 }
 
-func (m *MsgOperatorBurnNFT) Reset()         { *m = MsgOperatorBurnNFT{} }
-func (m *MsgOperatorBurnNFT) String() string { return proto.CompactTextString(m) }
-func (*MsgOperatorBurnNFT) ProtoMessage()    {}
-func (*MsgOperatorBurnNFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{29}
+func (m *MsgMintNFT) Reset()         { *m = MsgMintNFT{} }
+func (m *MsgMintNFT) String() string { return proto.CompactTextString(m) }
+func (*MsgMintNFT) ProtoMessage()    {}
+func (*MsgMintNFT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{28}
 }
-func (m *MsgOperatorBurnNFT) XXX_Unmarshal(b []byte) error {
+func (m *MsgMintNFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgOperatorBurnNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMintNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgOperatorBurnNFT.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMintNFT.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1743,62 +1709,71 @@ func (m *MsgOperatorBurnNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgOperatorBurnNFT) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgOperatorBurnNFT.Merge(m, src)
+func (m *MsgMintNFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMintNFT.Merge(m, src)
 }
-func (m *MsgOperatorBurnNFT) XXX_Size() int {
+func (m *MsgMintNFT) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgOperatorBurnNFT) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgOperatorBurnNFT.DiscardUnknown(m)
+func (m *MsgMintNFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMintNFT.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgOperatorBurnNFT proto.InternalMessageInfo
+var xxx_messageInfo_MsgMintNFT proto.InternalMessageInfo
 
-func (m *MsgOperatorBurnNFT) GetContractId() string {
+func (m *MsgMintNFT) GetContractId() string {
 	if m != nil {
 		return m.ContractId
 	}
 	return ""
 }
 
-func (m *MsgOperatorBurnNFT) GetOperator() string {
+func (m *MsgMintNFT) GetFrom() string {
 	if m != nil {
-		return m.Operator
+		return m.From
 	}
 	return ""
 }
 
-func (m *MsgOperatorBurnNFT) GetFrom() string {
+func (m *MsgMintNFT) GetTo() string {
 	if m != nil {
-		return m.From
+		return m.To
 	}
 	return ""
 }
 
-func (m *MsgOperatorBurnNFT) GetTokenIds() []string {
+func (m *MsgMintNFT) GetParams() []MintNFTParam {
 	if m != nil {
-		return m.TokenIds
+		return m.Params
 	}
 	return nil
 }
 
-// MsgOperatorBurnNFTResponse is the Msg/OperatorBurnNFT response type.
-type MsgOperatorBurnNFTResponse struct {
+func (m *MsgMintNFT) GetVesting() *VestingSchedule {
+	if m != nil {
+		return m.Vesting
+	}
+	return nil
 }
 
-func (m *MsgOperatorBurnNFTResponse) Reset()         { *m = MsgOperatorBurnNFTResponse{} }
-func (m *MsgOperatorBurnNFTResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgOperatorBurnNFTResponse) ProtoMessage()    {}
-func (*MsgOperatorBurnNFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{30}
+// MsgMintNFTResponse is the Msg/MintNFT response type.
+type MsgMintNFTResponse struct {
+	// ids of the new non-fungible tokens.
+	TokenIds []string `protobuf:"bytes,1,rep,name=token_ids,json=tokenIds,proto3" json:"token_ids,omitempty"`
 }
-func (m *MsgOperatorBurnNFTResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgMintNFTResponse) Reset()         { *m = MsgMintNFTResponse{} }
+func (m *MsgMintNFTResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMintNFTResponse) ProtoMessage()    {}
+func (*MsgMintNFTResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{29}
+}
+func (m *MsgMintNFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgOperatorBurnNFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMintNFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgOperatorBurnNFTResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMintNFTResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1808,50 +1783,54 @@ func (m *MsgOperatorBurnNFTResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgOperatorBurnNFTResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgOperatorBurnNFTResponse.Merge(m, src)
+func (m *MsgMintNFTResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMintNFTResponse.Merge(m, src)
 }
-func (m *MsgOperatorBurnNFTResponse) XXX_Size() int {
+func (m *MsgMintNFTResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgOperatorBurnNFTResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgOperatorBurnNFTResponse.DiscardUnknown(m)
+func (m *MsgMintNFTResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMintNFTResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgOperatorBurnNFTResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgMintNFTResponse proto.InternalMessageInfo
 
-// MsgModify is the Msg/Modify request type.
-type MsgModify struct {
-	// contract id associated with the contract.
-	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	// the address of the grantee which must have modify permission.
-	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
-	// token type of the token.
+func (m *MsgMintNFTResponse) GetTokenIds() []string {
+	if m != nil {
+		return m.TokenIds
+	}
+	return nil
+}
+
+// MintNFTParam defines a parameter for minting nft.
+type MintNFTParam struct {
+	// token type or class id of the nft.
+	// Note: it cannot start with zero.
 	// refer to TokenType for the definition.
-	TokenType string `protobuf:"bytes,3,opt,name=token_type,json=tokenType,proto3" json:"token_type,omitempty"`
-	// token index of the token.
-	// if index is empty, it would modify the corresponding token type.
-	// if index is not empty, it would modify the corresponding nft.
-	// Note: if token type is of FTs, the index cannot be empty.
-	TokenIndex string `protobuf:"bytes,4,opt,name=token_index,json=tokenIndex,proto3" json:"token_index,omitempty"`
-	// changes to apply.
-	// possible attribute keys on modifying collection: name, uri, base_img_uri (deprecated), meta.
-	// possible attribute keys on modifying token type and token: name, meta.
-	Changes []Attribute `protobuf:"bytes,5,rep,name=changes,proto3" json:"changes"`
+	TokenType string `protobuf:"bytes,1,opt,name=token_type,json=tokenType,proto3" json:"token_type,omitempty"`
+	// name defines the human-readable name of the nft (mandatory).
+	// Note: it has an app-specific limit in length.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// meta is a brief description of the nft.
+	// Note: it has an app-specific limit in length.
+	Meta string `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
+	// traits of the nft.
+	// Note: they must conform to the trait schema of the contract and the token type.
+	Traits []Attribute `protobuf:"bytes,4,rep,name=traits,proto3" json:"traits,omitempty"`
 }
 
-func (m *MsgModify) Reset()         { *m = MsgModify{} }
-func (m *MsgModify) String() string { return proto.CompactTextString(m) }
-func (*MsgModify) ProtoMessage()    {}
-func (*MsgModify) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{31}
+func (m *MintNFTParam) Reset()         { *m = MintNFTParam{} }
+func (m *MintNFTParam) String() string { return proto.CompactTextString(m) }
+func (*MintNFTParam) ProtoMessage()    {}
+func (*MintNFTParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{30}
 }
-func (m *MsgModify) XXX_Unmarshal(b []byte) error {
+func (m *MintNFTParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgModify) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MintNFTParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgModify.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MintNFTParam.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1861,114 +1840,70 @@ func (m *MsgModify) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *MsgModify) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgModify.Merge(m, src)
+func (m *MintNFTParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintNFTParam.Merge(m, src)
 }
-func (m *MsgModify) XXX_Size() int {
+func (m *MintNFTParam) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgModify) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgModify.DiscardUnknown(m)
+func (m *MintNFTParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintNFTParam.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgModify proto.InternalMessageInfo
-
-func (m *MsgModify) GetContractId() string {
-	if m != nil {
-		return m.ContractId
-	}
-	return ""
-}
+var xxx_messageInfo_MintNFTParam proto.InternalMessageInfo
 
-func (m *MsgModify) GetOwner() string {
+func (m *MintNFTParam) GetTokenType() string {
 	if m != nil {
-		return m.Owner
+		return m.TokenType
 	}
 	return ""
 }
 
-func (m *MsgModify) GetTokenType() string {
+func (m *MintNFTParam) GetName() string {
 	if m != nil {
-		return m.TokenType
+		return m.Name
 	}
 	return ""
 }
 
-func (m *MsgModify) GetTokenIndex() string {
+func (m *MintNFTParam) GetMeta() string {
 	if m != nil {
-		return m.TokenIndex
+		return m.Meta
 	}
 	return ""
 }
 
-func (m *MsgModify) GetChanges() []Attribute {
+func (m *MintNFTParam) GetTraits() []Attribute {
 	if m != nil {
-		return m.Changes
+		return m.Traits
 	}
 	return nil
 }
 
-// MsgModifyResponse is the Msg/Modify response type.
-type MsgModifyResponse struct {
-}
-
-func (m *MsgModifyResponse) Reset()         { *m = MsgModifyResponse{} }
-func (m *MsgModifyResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgModifyResponse) ProtoMessage()    {}
-func (*MsgModifyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{32}
-}
-func (m *MsgModifyResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *MsgModifyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_MsgModifyResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *MsgModifyResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgModifyResponse.Merge(m, src)
-}
-func (m *MsgModifyResponse) XXX_Size() int {
-	return m.Size()
-}
-func (m *MsgModifyResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgModifyResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_MsgModifyResponse proto.InternalMessageInfo
-
-// MsgGrantPermission is the Msg/GrantPermission request type.
-type MsgGrantPermission struct {
+// MsgBurnFT is the Msg/BurnFT request type.
+type MsgBurnFT struct {
 	// contract id associated with the contract.
 	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	// address of the granter which must have the permission to give.
+	// address which the tokens will be burnt from.
+	// Note: it must have the permission for the burn.
 	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
-	// address of the grantee.
-	To string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
-	// permission on the contract.
-	Permission string `protobuf:"bytes,4,opt,name=permission,proto3" json:"permission,omitempty"`
+	// the amount of the burn.
+	// Note: amount may be empty.
+	Amount []Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount"`
 }
 
-func (m *MsgGrantPermission) Reset()         { *m = MsgGrantPermission{} }
-func (m *MsgGrantPermission) String() string { return proto.CompactTextString(m) }
-func (*MsgGrantPermission) ProtoMessage()    {}
-func (*MsgGrantPermission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{33}
+func (m *MsgBurnFT) Reset()         { *m = MsgBurnFT{} }
+func (m *MsgBurnFT) String() string { return proto.CompactTextString(m) }
+func (*MsgBurnFT) ProtoMessage()    {}
+func (*MsgBurnFT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{31}
 }
-func (m *MsgGrantPermission) XXX_Unmarshal(b []byte) error {
+func (m *MsgBurnFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgGrantPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBurnFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgGrantPermission.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBurnFT.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1978,62 +1913,55 @@ func (m *MsgGrantPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgGrantPermission) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgGrantPermission.Merge(m, src)
+func (m *MsgBurnFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBurnFT.Merge(m, src)
 }
-func (m *MsgGrantPermission) XXX_Size() int {
+func (m *MsgBurnFT) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgGrantPermission) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgGrantPermission.DiscardUnknown(m)
+func (m *MsgBurnFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBurnFT.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgGrantPermission proto.InternalMessageInfo
+var xxx_messageInfo_MsgBurnFT proto.InternalMessageInfo
 
-func (m *MsgGrantPermission) GetContractId() string {
+func (m *MsgBurnFT) GetContractId() string {
 	if m != nil {
 		return m.ContractId
 	}
 	return ""
 }
 
-func (m *MsgGrantPermission) GetFrom() string {
+func (m *MsgBurnFT) GetFrom() string {
 	if m != nil {
 		return m.From
 	}
 	return ""
 }
 
-func (m *MsgGrantPermission) GetTo() string {
-	if m != nil {
-		return m.To
-	}
-	return ""
-}
-
-func (m *MsgGrantPermission) GetPermission() string {
+func (m *MsgBurnFT) GetAmount() []Coin {
 	if m != nil {
-		return m.Permission
+		return m.Amount
 	}
-	return ""
+	return nil
 }
 
-// MsgGrantPermissionResponse is the Msg/GrantPermission response type.
-type MsgGrantPermissionResponse struct {
+// MsgBurnFTResponse is the Msg/BurnFT response type.
+type MsgBurnFTResponse struct {
 }
 
-func (m *MsgGrantPermissionResponse) Reset()         { *m = MsgGrantPermissionResponse{} }
-func (m *MsgGrantPermissionResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgGrantPermissionResponse) ProtoMessage()    {}
-func (*MsgGrantPermissionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{34}
+func (m *MsgBurnFTResponse) Reset()         { *m = MsgBurnFTResponse{} }
+func (m *MsgBurnFTResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBurnFTResponse) ProtoMessage()    {}
+func (*MsgBurnFTResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{32}
 }
-func (m *MsgGrantPermissionResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgBurnFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgGrantPermissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBurnFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgGrantPermissionResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBurnFTResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2043,40 +1971,45 @@ func (m *MsgGrantPermissionResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgGrantPermissionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgGrantPermissionResponse.Merge(m, src)
+func (m *MsgBurnFTResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBurnFTResponse.Merge(m, src)
 }
-func (m *MsgGrantPermissionResponse) XXX_Size() int {
+func (m *MsgBurnFTResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgGrantPermissionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgGrantPermissionResponse.DiscardUnknown(m)
-}
+func (m *MsgBurnFTResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBurnFTResponse.DiscardUnknown(m)
+}
 
-var xxx_messageInfo_MsgGrantPermissionResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgBurnFTResponse proto.InternalMessageInfo
 
-// MsgRevokePermission is the Msg/RevokePermission request type.
-type MsgRevokePermission struct {
+// MsgOperatorBurnFT is the Msg/OperatorBurnFT request type.
+type MsgOperatorBurnFT struct {
 	// contract id associated with the contract.
 	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	// address of the grantee which abandons the permission.
-	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
-	// permission on the contract.
-	Permission string `protobuf:"bytes,3,opt,name=permission,proto3" json:"permission,omitempty"`
+	// address which triggers the burn.
+	// Note: it must have the permission for the burn.
+	// Note: it must have been authorized by from.
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// address which the tokens will be burnt from.
+	From string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// the amount of the burn.
+	// Note: amount may be empty.
+	Amount []Coin `protobuf:"bytes,4,rep,name=amount,proto3" json:"amount"`
 }
 
-func (m *MsgRevokePermission) Reset()         { *m = MsgRevokePermission{} }
-func (m *MsgRevokePermission) String() string { return proto.CompactTextString(m) }
-func (*MsgRevokePermission) ProtoMessage()    {}
-func (*MsgRevokePermission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{35}
+func (m *MsgOperatorBurnFT) Reset()         { *m = MsgOperatorBurnFT{} }
+func (m *MsgOperatorBurnFT) String() string { return proto.CompactTextString(m) }
+func (*MsgOperatorBurnFT) ProtoMessage()    {}
+func (*MsgOperatorBurnFT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{33}
 }
-func (m *MsgRevokePermission) XXX_Unmarshal(b []byte) error {
+func (m *MsgOperatorBurnFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRevokePermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgOperatorBurnFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRevokePermission.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgOperatorBurnFT.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2086,55 +2019,62 @@ func (m *MsgRevokePermission) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgRevokePermission) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRevokePermission.Merge(m, src)
+func (m *MsgOperatorBurnFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOperatorBurnFT.Merge(m, src)
 }
-func (m *MsgRevokePermission) XXX_Size() int {
+func (m *MsgOperatorBurnFT) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRevokePermission) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRevokePermission.DiscardUnknown(m)
+func (m *MsgOperatorBurnFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOperatorBurnFT.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRevokePermission proto.InternalMessageInfo
+var xxx_messageInfo_MsgOperatorBurnFT proto.InternalMessageInfo
 
-func (m *MsgRevokePermission) GetContractId() string {
+func (m *MsgOperatorBurnFT) GetContractId() string {
 	if m != nil {
 		return m.ContractId
 	}
 	return ""
 }
 
-func (m *MsgRevokePermission) GetFrom() string {
+func (m *MsgOperatorBurnFT) GetOperator() string {
 	if m != nil {
-		return m.From
+		return m.Operator
 	}
 	return ""
 }
 
-func (m *MsgRevokePermission) GetPermission() string {
+func (m *MsgOperatorBurnFT) GetFrom() string {
 	if m != nil {
-		return m.Permission
+		return m.From
 	}
 	return ""
 }
 
-// MsgRevokePermissionResponse is the Msg/RevokePermission response type.
-type MsgRevokePermissionResponse struct {
+func (m *MsgOperatorBurnFT) GetAmount() []Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
 }
 
-func (m *MsgRevokePermissionResponse) Reset()         { *m = MsgRevokePermissionResponse{} }
-func (m *MsgRevokePermissionResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRevokePermissionResponse) ProtoMessage()    {}
-func (*MsgRevokePermissionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{36}
+// MsgOperatorBurnFTResponse is the Msg/OperatorBurnFT response type.
+type MsgOperatorBurnFTResponse struct {
 }
-func (m *MsgRevokePermissionResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgOperatorBurnFTResponse) Reset()         { *m = MsgOperatorBurnFTResponse{} }
+func (m *MsgOperatorBurnFTResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgOperatorBurnFTResponse) ProtoMessage()    {}
+func (*MsgOperatorBurnFTResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{34}
+}
+func (m *MsgOperatorBurnFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRevokePermissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgOperatorBurnFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRevokePermissionResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgOperatorBurnFTResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2144,44 +2084,42 @@ func (m *MsgRevokePermissionResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgRevokePermissionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRevokePermissionResponse.Merge(m, src)
+func (m *MsgOperatorBurnFTResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOperatorBurnFTResponse.Merge(m, src)
 }
-func (m *MsgRevokePermissionResponse) XXX_Size() int {
+func (m *MsgOperatorBurnFTResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRevokePermissionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRevokePermissionResponse.DiscardUnknown(m)
+func (m *MsgOperatorBurnFTResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOperatorBurnFTResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRevokePermissionResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgOperatorBurnFTResponse proto.InternalMessageInfo
 
-// MsgAttach is the Msg/Attach request type.
-//
-// Signer: `from`
-type MsgAttach struct {
+// MsgBurnNFT is the Msg/BurnNFT request type.
+type MsgBurnNFT struct {
 	// contract id associated with the contract.
 	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	// address of the owner of the token.
+	// address which the tokens will be burnt from.
+	// Note: it must have the permission for the burn.
 	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
-	// token id of the token to attach.
-	TokenId string `protobuf:"bytes,3,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
-	// to token id which one attachs the token to.
-	ToTokenId string `protobuf:"bytes,4,opt,name=to_token_id,json=toTokenId,proto3" json:"to_token_id,omitempty"`
+	// the token ids to burn.
+	// Note: id cannot start with zero.
+	TokenIds []string `protobuf:"bytes,3,rep,name=token_ids,json=tokenIds,proto3" json:"token_ids,omitempty"`
 }
 
-func (m *MsgAttach) Reset()         { *m = MsgAttach{} }
-func (m *MsgAttach) String() string { return proto.CompactTextString(m) }
-func (*MsgAttach) ProtoMessage()    {}
-func (*MsgAttach) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{37}
+func (m *MsgBurnNFT) Reset()         { *m = MsgBurnNFT{} }
+func (m *MsgBurnNFT) String() string { return proto.CompactTextString(m) }
+func (*MsgBurnNFT) ProtoMessage()    {}
+func (*MsgBurnNFT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{35}
 }
-func (m *MsgAttach) XXX_Unmarshal(b []byte) error {
+func (m *MsgBurnNFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAttach) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBurnNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAttach.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBurnNFT.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2191,62 +2129,55 @@ func (m *MsgAttach) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *MsgAttach) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAttach.Merge(m, src)
+func (m *MsgBurnNFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBurnNFT.Merge(m, src)
 }
-func (m *MsgAttach) XXX_Size() int {
+func (m *MsgBurnNFT) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAttach) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAttach.DiscardUnknown(m)
+func (m *MsgBurnNFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBurnNFT.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAttach proto.InternalMessageInfo
+var xxx_messageInfo_MsgBurnNFT proto.InternalMessageInfo
 
-func (m *MsgAttach) GetContractId() string {
+func (m *MsgBurnNFT) GetContractId() string {
 	if m != nil {
 		return m.ContractId
 	}
 	return ""
 }
 
-func (m *MsgAttach) GetFrom() string {
+func (m *MsgBurnNFT) GetFrom() string {
 	if m != nil {
 		return m.From
 	}
 	return ""
 }
 
-func (m *MsgAttach) GetTokenId() string {
-	if m != nil {
-		return m.TokenId
-	}
-	return ""
-}
-
-func (m *MsgAttach) GetToTokenId() string {
+func (m *MsgBurnNFT) GetTokenIds() []string {
 	if m != nil {
-		return m.ToTokenId
+		return m.TokenIds
 	}
-	return ""
+	return nil
 }
 
-// MsgAttachResponse is the Msg/Attach response type.
-type MsgAttachResponse struct {
+// MsgBurnNFTResponse is the Msg/BurnNFT response type.
+type MsgBurnNFTResponse struct {
 }
 
-func (m *MsgAttachResponse) Reset()         { *m = MsgAttachResponse{} }
-func (m *MsgAttachResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgAttachResponse) ProtoMessage()    {}
-func (*MsgAttachResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{38}
+func (m *MsgBurnNFTResponse) Reset()         { *m = MsgBurnNFTResponse{} }
+func (m *MsgBurnNFTResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBurnNFTResponse) ProtoMessage()    {}
+func (*MsgBurnNFTResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{36}
 }
-func (m *MsgAttachResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgBurnNFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAttachResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBurnNFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAttachResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBurnNFTResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2256,42 +2187,45 @@ func (m *MsgAttachResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgAttachResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAttachResponse.Merge(m, src)
+func (m *MsgBurnNFTResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBurnNFTResponse.Merge(m, src)
 }
-func (m *MsgAttachResponse) XXX_Size() int {
+func (m *MsgBurnNFTResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAttachResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAttachResponse.DiscardUnknown(m)
+func (m *MsgBurnNFTResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBurnNFTResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAttachResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgBurnNFTResponse proto.InternalMessageInfo
 
-// MsgDetach is the Msg/Detach request type.
-//
-// Signer: `from`
-type MsgDetach struct {
+// MsgOperatorBurnNFT is the Msg/OperatorBurnNFT request type.
+type MsgOperatorBurnNFT struct {
 	// contract id associated with the contract.
 	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	// address of the owner of the token.
-	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
-	// token id of the token to detach.
-	TokenId string `protobuf:"bytes,3,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// address which triggers the burn.
+	// Note: it must have the permission for the burn.
+	// Note: it must have been authorized by from.
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// address which the tokens will be burnt from.
+	From string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// the token ids to burn.
+	// Note: id cannot start with zero.
+	TokenIds []string `protobuf:"bytes,4,rep,name=token_ids,json=tokenIds,proto3" json:"token_ids,omitempty"`
 }
 
-func (m *MsgDetach) Reset()         { *m = MsgDetach{} }
-func (m *MsgDetach) String() string { return proto.CompactTextString(m) }
-func (*MsgDetach) ProtoMessage()    {}
-func (*MsgDetach) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{39}
+func (m *MsgOperatorBurnNFT) Reset()         { *m = MsgOperatorBurnNFT{} }
+func (m *MsgOperatorBurnNFT) String() string { return proto.CompactTextString(m) }
+func (*MsgOperatorBurnNFT) ProtoMessage()    {}
+func (*MsgOperatorBurnNFT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{37}
 }
-func (m *MsgDetach) XXX_Unmarshal(b []byte) error {
+func (m *MsgOperatorBurnNFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDetach) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgOperatorBurnNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDetach.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgOperatorBurnNFT.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2301,55 +2235,62 @@ func (m *MsgDetach) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *MsgDetach) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDetach.Merge(m, src)
+func (m *MsgOperatorBurnNFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOperatorBurnNFT.Merge(m, src)
 }
-func (m *MsgDetach) XXX_Size() int {
+func (m *MsgOperatorBurnNFT) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDetach) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDetach.DiscardUnknown(m)
+func (m *MsgOperatorBurnNFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOperatorBurnNFT.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDetach proto.InternalMessageInfo
+var xxx_messageInfo_MsgOperatorBurnNFT proto.InternalMessageInfo
 
-func (m *MsgDetach) GetContractId() string {
+func (m *MsgOperatorBurnNFT) GetContractId() string {
 	if m != nil {
 		return m.ContractId
 	}
 	return ""
 }
 
-func (m *MsgDetach) GetFrom() string {
+func (m *MsgOperatorBurnNFT) GetOperator() string {
 	if m != nil {
-		return m.From
+		return m.Operator
 	}
 	return ""
 }
 
-func (m *MsgDetach) GetTokenId() string {
+func (m *MsgOperatorBurnNFT) GetFrom() string {
 	if m != nil {
-		return m.TokenId
+		return m.From
 	}
 	return ""
 }
 
-// MsgDetachResponse is the Msg/Detach response type.
-type MsgDetachResponse struct {
+func (m *MsgOperatorBurnNFT) GetTokenIds() []string {
+	if m != nil {
+		return m.TokenIds
+	}
+	return nil
 }
 
-func (m *MsgDetachResponse) Reset()         { *m = MsgDetachResponse{} }
-func (m *MsgDetachResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgDetachResponse) ProtoMessage()    {}
-func (*MsgDetachResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{40}
+// MsgOperatorBurnNFTResponse is the Msg/OperatorBurnNFT response type.
+type MsgOperatorBurnNFTResponse struct {
 }
-func (m *MsgDetachResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgOperatorBurnNFTResponse) Reset()         { *m = MsgOperatorBurnNFTResponse{} }
+func (m *MsgOperatorBurnNFTResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgOperatorBurnNFTResponse) ProtoMessage()    {}
+func (*MsgOperatorBurnNFTResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{38}
+}
+func (m *MsgOperatorBurnNFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDetachResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgOperatorBurnNFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDetachResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgOperatorBurnNFTResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2359,44 +2300,52 @@ func (m *MsgDetachResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgDetachResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDetachResponse.Merge(m, src)
+func (m *MsgOperatorBurnNFTResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOperatorBurnNFTResponse.Merge(m, src)
 }
-func (m *MsgDetachResponse) XXX_Size() int {
+func (m *MsgOperatorBurnNFTResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDetachResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDetachResponse.DiscardUnknown(m)
+func (m *MsgOperatorBurnNFTResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOperatorBurnNFTResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDetachResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgOperatorBurnNFTResponse proto.InternalMessageInfo
 
-// MsgOperatorAttach is the Msg/OperatorAttach request type.
-type MsgOperatorAttach struct {
+// MsgModify is the Msg/Modify request type.
+type MsgModify struct {
 	// contract id associated with the contract.
 	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	// address of the operator.
-	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
-	// address of the owner of the token.
-	From string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
-	// token id of the token to attach.
-	TokenId string `protobuf:"bytes,4,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
-	// to token id which one attachs the token to.
-	ToTokenId string `protobuf:"bytes,5,opt,name=to_token_id,json=toTokenId,proto3" json:"to_token_id,omitempty"`
+	// the address of the grantee which must have modify permission.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// token type of the token.
+	// refer to TokenType for the definition.
+	TokenType string `protobuf:"bytes,3,opt,name=token_type,json=tokenType,proto3" json:"token_type,omitempty"`
+	// token index of the token.
+	// if index is empty, it would modify the corresponding token type.
+	// if index is not empty, it would modify the corresponding nft.
+	// Note: if token type is of FTs, the index cannot be empty.
+	TokenIndex string `protobuf:"bytes,4,opt,name=token_index,json=tokenIndex,proto3" json:"token_index,omitempty"`
+	// changes to apply.
+	// possible attribute keys on modifying collection: name, uri, base_img_uri (deprecated), meta.
+	// possible attribute keys on modifying token type and token: name, meta.
+	// possible attribute keys on modifying non-fungible token type only: royalty_recipient, royalty_basis_points.
+	// possible attribute keys on modifying non-fungible token only: trait.<key>, where an empty value removes the trait.
+	Changes []Attribute `protobuf:"bytes,5,rep,name=changes,proto3" json:"changes"`
 }
 
-func (m *MsgOperatorAttach) Reset()         { *m = MsgOperatorAttach{} }
-func (m *MsgOperatorAttach) String() string { return proto.CompactTextString(m) }
-func (*MsgOperatorAttach) ProtoMessage()    {}
-func (*MsgOperatorAttach) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{41}
+func (m *MsgModify) Reset()         { *m = MsgModify{} }
+func (m *MsgModify) String() string { return proto.CompactTextString(m) }
+func (*MsgModify) ProtoMessage()    {}
+func (*MsgModify) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{39}
 }
-func (m *MsgOperatorAttach) XXX_Unmarshal(b []byte) error {
+func (m *MsgModify) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgOperatorAttach) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgModify) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgOperatorAttach.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgModify.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2406,69 +2355,69 @@ func (m *MsgOperatorAttach) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgOperatorAttach) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgOperatorAttach.Merge(m, src)
+func (m *MsgModify) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgModify.Merge(m, src)
 }
-func (m *MsgOperatorAttach) XXX_Size() int {
+func (m *MsgModify) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgOperatorAttach) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgOperatorAttach.DiscardUnknown(m)
+func (m *MsgModify) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgModify.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgOperatorAttach proto.InternalMessageInfo
+var xxx_messageInfo_MsgModify proto.InternalMessageInfo
 
-func (m *MsgOperatorAttach) GetContractId() string {
+func (m *MsgModify) GetContractId() string {
 	if m != nil {
 		return m.ContractId
 	}
 	return ""
 }
 
-func (m *MsgOperatorAttach) GetOperator() string {
+func (m *MsgModify) GetOwner() string {
 	if m != nil {
-		return m.Operator
+		return m.Owner
 	}
 	return ""
 }
 
-func (m *MsgOperatorAttach) GetFrom() string {
+func (m *MsgModify) GetTokenType() string {
 	if m != nil {
-		return m.From
+		return m.TokenType
 	}
 	return ""
 }
 
-func (m *MsgOperatorAttach) GetTokenId() string {
+func (m *MsgModify) GetTokenIndex() string {
 	if m != nil {
-		return m.TokenId
+		return m.TokenIndex
 	}
 	return ""
 }
 
-func (m *MsgOperatorAttach) GetToTokenId() string {
+func (m *MsgModify) GetChanges() []Attribute {
 	if m != nil {
-		return m.ToTokenId
+		return m.Changes
 	}
-	return ""
+	return nil
 }
 
-// MsgOperatorAttachResponse is the Msg/OperatorAttach response type.
-type MsgOperatorAttachResponse struct {
+// MsgModifyResponse is the Msg/Modify response type.
+type MsgModifyResponse struct {
 }
 
-func (m *MsgOperatorAttachResponse) Reset()         { *m = MsgOperatorAttachResponse{} }
-func (m *MsgOperatorAttachResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgOperatorAttachResponse) ProtoMessage()    {}
-func (*MsgOperatorAttachResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{42}
+func (m *MsgModifyResponse) Reset()         { *m = MsgModifyResponse{} }
+func (m *MsgModifyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgModifyResponse) ProtoMessage()    {}
+func (*MsgModifyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{40}
 }
-func (m *MsgOperatorAttachResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgModifyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgOperatorAttachResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgModifyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgOperatorAttachResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgModifyResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2478,42 +2427,42 @@ func (m *MsgOperatorAttachResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgOperatorAttachResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgOperatorAttachResponse.Merge(m, src)
+func (m *MsgModifyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgModifyResponse.Merge(m, src)
 }
-func (m *MsgOperatorAttachResponse) XXX_Size() int {
+func (m *MsgModifyResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgOperatorAttachResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgOperatorAttachResponse.DiscardUnknown(m)
+func (m *MsgModifyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgModifyResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgOperatorAttachResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgModifyResponse proto.InternalMessageInfo
 
-// MsgOperatorDetach is the Msg/OperatorDetach request type.
-type MsgOperatorDetach struct {
+// MsgGrantPermission is the Msg/GrantPermission request type.
+type MsgGrantPermission struct {
 	// contract id associated with the contract.
 	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	// address of the operator.
-	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
-	// address of the owner of the token.
-	From string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
-	// token id of the token to detach.
-	TokenId string `protobuf:"bytes,4,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// address of the granter which must have the permission to give, or be the admin of the contract.
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// address of the grantee.
+	To string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// permission on the contract.
+	Permission string `protobuf:"bytes,4,opt,name=permission,proto3" json:"permission,omitempty"`
 }
 
-func (m *MsgOperatorDetach) Reset()         { *m = MsgOperatorDetach{} }
-func (m *MsgOperatorDetach) String() string { return proto.CompactTextString(m) }
-func (*MsgOperatorDetach) ProtoMessage()    {}
-func (*MsgOperatorDetach) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{43}
+func (m *MsgGrantPermission) Reset()         { *m = MsgGrantPermission{} }
+func (m *MsgGrantPermission) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantPermission) ProtoMessage()    {}
+func (*MsgGrantPermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{41}
 }
-func (m *MsgOperatorDetach) XXX_Unmarshal(b []byte) error {
+func (m *MsgGrantPermission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgOperatorDetach) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgGrantPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgOperatorDetach.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgGrantPermission.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2523,62 +2472,62 @@ func (m *MsgOperatorDetach) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgOperatorDetach) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgOperatorDetach.Merge(m, src)
+func (m *MsgGrantPermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantPermission.Merge(m, src)
 }
-func (m *MsgOperatorDetach) XXX_Size() int {
+func (m *MsgGrantPermission) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgOperatorDetach) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgOperatorDetach.DiscardUnknown(m)
+func (m *MsgGrantPermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantPermission.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgOperatorDetach proto.InternalMessageInfo
+var xxx_messageInfo_MsgGrantPermission proto.InternalMessageInfo
 
-func (m *MsgOperatorDetach) GetContractId() string {
+func (m *MsgGrantPermission) GetContractId() string {
 	if m != nil {
 		return m.ContractId
 	}
 	return ""
 }
 
-func (m *MsgOperatorDetach) GetOperator() string {
+func (m *MsgGrantPermission) GetFrom() string {
 	if m != nil {
-		return m.Operator
+		return m.From
 	}
 	return ""
 }
 
-func (m *MsgOperatorDetach) GetFrom() string {
+func (m *MsgGrantPermission) GetTo() string {
 	if m != nil {
-		return m.From
+		return m.To
 	}
 	return ""
 }
 
-func (m *MsgOperatorDetach) GetTokenId() string {
+func (m *MsgGrantPermission) GetPermission() string {
 	if m != nil {
-		return m.TokenId
+		return m.Permission
 	}
 	return ""
 }
 
-// MsgOperatorDetachResponse is the Msg/OperatorDetach response type.
-type MsgOperatorDetachResponse struct {
+// MsgGrantPermissionResponse is the Msg/GrantPermission response type.
+type MsgGrantPermissionResponse struct {
 }
 
-func (m *MsgOperatorDetachResponse) Reset()         { *m = MsgOperatorDetachResponse{} }
-func (m *MsgOperatorDetachResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgOperatorDetachResponse) ProtoMessage()    {}
-func (*MsgOperatorDetachResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{44}
+func (m *MsgGrantPermissionResponse) Reset()         { *m = MsgGrantPermissionResponse{} }
+func (m *MsgGrantPermissionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantPermissionResponse) ProtoMessage()    {}
+func (*MsgGrantPermissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{42}
 }
-func (m *MsgOperatorDetachResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgGrantPermissionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgOperatorDetachResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgGrantPermissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgOperatorDetachResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgGrantPermissionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2588,2626 +2537,2518 @@ func (m *MsgOperatorDetachResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgOperatorDetachResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgOperatorDetachResponse.Merge(m, src)
+func (m *MsgGrantPermissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantPermissionResponse.Merge(m, src)
 }
-func (m *MsgOperatorDetachResponse) XXX_Size() int {
+func (m *MsgGrantPermissionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgOperatorDetachResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgOperatorDetachResponse.DiscardUnknown(m)
+func (m *MsgGrantPermissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantPermissionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgOperatorDetachResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgGrantPermissionResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgSendFT)(nil), "lbm.collection.v1.MsgSendFT")
-	proto.RegisterType((*MsgSendFTResponse)(nil), "lbm.collection.v1.MsgSendFTResponse")
-	proto.RegisterType((*MsgOperatorSendFT)(nil), "lbm.collection.v1.MsgOperatorSendFT")
-	proto.RegisterType((*MsgOperatorSendFTResponse)(nil), "lbm.collection.v1.MsgOperatorSendFTResponse")
-	proto.RegisterType((*MsgSendNFT)(nil), "lbm.collection.v1.MsgSendNFT")
-	proto.RegisterType((*MsgSendNFTResponse)(nil), "lbm.collection.v1.MsgSendNFTResponse")
-	proto.RegisterType((*MsgOperatorSendNFT)(nil), "lbm.collection.v1.MsgOperatorSendNFT")
-	proto.RegisterType((*MsgOperatorSendNFTResponse)(nil), "lbm.collection.v1.MsgOperatorSendNFTResponse")
-	proto.RegisterType((*MsgAuthorizeOperator)(nil), "lbm.collection.v1.MsgAuthorizeOperator")
-	proto.RegisterType((*MsgAuthorizeOperatorResponse)(nil), "lbm.collection.v1.MsgAuthorizeOperatorResponse")
-	proto.RegisterType((*MsgRevokeOperator)(nil), "lbm.collection.v1.MsgRevokeOperator")
-	proto.RegisterType((*MsgRevokeOperatorResponse)(nil), "lbm.collection.v1.MsgRevokeOperatorResponse")
-	proto.RegisterType((*MsgCreateContract)(nil), "lbm.collection.v1.MsgCreateContract")
-	proto.RegisterType((*MsgCreateContractResponse)(nil), "lbm.collection.v1.MsgCreateContractResponse")
-	proto.RegisterType((*MsgIssueFT)(nil), "lbm.collection.v1.MsgIssueFT")
-	proto.RegisterType((*MsgIssueFTResponse)(nil), "lbm.collection.v1.MsgIssueFTResponse")
-	proto.RegisterType((*MsgIssueNFT)(nil), "lbm.collection.v1.MsgIssueNFT")
-	proto.RegisterType((*MsgIssueNFTResponse)(nil), "lbm.collection.v1.MsgIssueNFTResponse")
-	proto.RegisterType((*MsgMintFT)(nil), "lbm.collection.v1.MsgMintFT")
-	proto.RegisterType((*MsgMintFTResponse)(nil), "lbm.collection.v1.MsgMintFTResponse")
-	proto.RegisterType((*MsgMintNFT)(nil), "lbm.collection.v1.MsgMintNFT")
-	proto.RegisterType((*MsgMintNFTResponse)(nil), "lbm.collection.v1.MsgMintNFTResponse")
-	proto.RegisterType((*MintNFTParam)(nil), "lbm.collection.v1.MintNFTParam")
-	proto.RegisterType((*MsgBurnFT)(nil), "lbm.collection.v1.MsgBurnFT")
-	proto.RegisterType((*MsgBurnFTResponse)(nil), "lbm.collection.v1.MsgBurnFTResponse")
-	proto.RegisterType((*MsgOperatorBurnFT)(nil), "lbm.collection.v1.MsgOperatorBurnFT")
-	proto.RegisterType((*MsgOperatorBurnFTResponse)(nil), "lbm.collection.v1.MsgOperatorBurnFTResponse")
-	proto.RegisterType((*MsgBurnNFT)(nil), "lbm.collection.v1.MsgBurnNFT")
-	proto.RegisterType((*MsgBurnNFTResponse)(nil), "lbm.collection.v1.MsgBurnNFTResponse")
-	proto.RegisterType((*MsgOperatorBurnNFT)(nil), "lbm.collection.v1.MsgOperatorBurnNFT")
-	proto.RegisterType((*MsgOperatorBurnNFTResponse)(nil), "lbm.collection.v1.MsgOperatorBurnNFTResponse")
-	proto.RegisterType((*MsgModify)(nil), "lbm.collection.v1.MsgModify")
-	proto.RegisterType((*MsgModifyResponse)(nil), "lbm.collection.v1.MsgModifyResponse")
-	proto.RegisterType((*MsgGrantPermission)(nil), "lbm.collection.v1.MsgGrantPermission")
-	proto.RegisterType((*MsgGrantPermissionResponse)(nil), "lbm.collection.v1.MsgGrantPermissionResponse")
-	proto.RegisterType((*MsgRevokePermission)(nil), "lbm.collection.v1.MsgRevokePermission")
-	proto.RegisterType((*MsgRevokePermissionResponse)(nil), "lbm.collection.v1.MsgRevokePermissionResponse")
-	proto.RegisterType((*MsgAttach)(nil), "lbm.collection.v1.MsgAttach")
-	proto.RegisterType((*MsgAttachResponse)(nil), "lbm.collection.v1.MsgAttachResponse")
-	proto.RegisterType((*MsgDetach)(nil), "lbm.collection.v1.MsgDetach")
-	proto.RegisterType((*MsgDetachResponse)(nil), "lbm.collection.v1.MsgDetachResponse")
-	proto.RegisterType((*MsgOperatorAttach)(nil), "lbm.collection.v1.MsgOperatorAttach")
-	proto.RegisterType((*MsgOperatorAttachResponse)(nil), "lbm.collection.v1.MsgOperatorAttachResponse")
-	proto.RegisterType((*MsgOperatorDetach)(nil), "lbm.collection.v1.MsgOperatorDetach")
-	proto.RegisterType((*MsgOperatorDetachResponse)(nil), "lbm.collection.v1.MsgOperatorDetachResponse")
+// MsgRevokePermission is the Msg/RevokePermission request type.
+type MsgRevokePermission struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// address of the grantee which abandons the permission.
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// permission on the contract.
+	Permission string `protobuf:"bytes,3,opt,name=permission,proto3" json:"permission,omitempty"`
 }
 
-func init() { proto.RegisterFile("lbm/collection/v1/tx.proto", fileDescriptor_eaee77977a3cfe12) }
-
-var fileDescriptor_eaee77977a3cfe12 = []byte{
-	// 1327 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x98, 0xcd, 0x6f, 0xe3, 0x44,
-	0x14, 0xc0, 0xeb, 0x3a, 0x49, 0xd3, 0x57, 0xd8, 0x0f, 0x6f, 0x05, 0xdd, 0xb4, 0x75, 0x2a, 0x8b,
-	0x2e, 0x15, 0x6a, 0x13, 0xb5, 0xc0, 0x6d, 0x41, 0x6a, 0x8b, 0x8a, 0x0a, 0xfd, 0x58, 0x85, 0x72,
-	0x01, 0x89, 0xca, 0x89, 0xa7, 0x89, 0xb7, 0xb1, 0x27, 0xb2, 0x27, 0xdd, 0x16, 0x0e, 0x48, 0x5c,
-	0xb8, 0x56, 0x5c, 0x39, 0x70, 0x45, 0xf0, 0x4f, 0x70, 0xdc, 0xe3, 0x1e, 0x11, 0x87, 0x05, 0xb5,
-	0x47, 0xfe, 0x08, 0xd0, 0x8c, 0xc7, 0x13, 0x8f, 0x93, 0x49, 0xdc, 0x92, 0xe5, 0x16, 0xcf, 0xbc,
-	0x99, 0xf7, 0x7b, 0x1f, 0x33, 0xef, 0x4d, 0xa0, 0xd4, 0xae, 0x7b, 0xd5, 0x06, 0x6e, 0xb7, 0x51,
-	0x83, 0xb8, 0xd8, 0xaf, 0x9e, 0xad, 0x57, 0xc9, 0x79, 0xa5, 0x13, 0x60, 0x82, 0x8d, 0xfb, 0xed,
-	0xba, 0x57, 0xe9, 0xcd, 0x55, 0xce, 0xd6, 0x4b, 0xb3, 0x4d, 0xdc, 0xc4, 0x6c, 0xb6, 0x4a, 0x7f,
-	0x45, 0x82, 0x25, 0xab, 0x7f, 0x93, 0xc4, 0x32, 0x26, 0x63, 0x7d, 0xaf, 0xc1, 0xf4, 0x7e, 0xd8,
-	0xfc, 0x0c, 0xf9, 0xce, 0xce, 0x91, 0x51, 0x86, 0x99, 0x06, 0xf6, 0x49, 0x60, 0x37, 0xc8, 0xb1,
-	0xeb, 0xcc, 0x69, 0x4b, 0xda, 0xca, 0x74, 0x0d, 0xe2, 0xa1, 0x5d, 0xc7, 0x30, 0x20, 0x77, 0x12,
-	0x60, 0x6f, 0x6e, 0x92, 0xcd, 0xb0, 0xdf, 0xc6, 0x1d, 0x98, 0x24, 0x78, 0x4e, 0x67, 0x23, 0x93,
-	0x04, 0x1b, 0xef, 0x43, 0xc1, 0xf6, 0x70, 0xd7, 0x27, 0x73, 0xb9, 0x25, 0x7d, 0x65, 0x66, 0xe3,
-	0xcd, 0x4a, 0x1f, 0x70, 0x65, 0x1b, 0xbb, 0xfe, 0x56, 0xee, 0xf9, 0xcb, 0xf2, 0x44, 0x8d, 0x0b,
-	0x5b, 0x0f, 0xe0, 0xbe, 0x00, 0xa9, 0xa1, 0xb0, 0x83, 0xfd, 0x10, 0x59, 0xbf, 0x6a, 0x6c, 0xf4,
-	0xb0, 0x83, 0x02, 0x9b, 0xe0, 0x20, 0x2b, 0x66, 0x09, 0x8a, 0x98, 0x2f, 0xe1, 0xa8, 0xe2, 0x5b,
-	0x98, 0xa0, 0xf7, 0x99, 0x90, 0x1b, 0x60, 0x42, 0xfe, 0x26, 0x26, 0xcc, 0xc3, 0xc3, 0x3e, 0x58,
-	0x61, 0x8a, 0x0f, 0xc0, 0xed, 0x3b, 0x18, 0x97, 0xa7, 0xe7, 0x61, 0x9a, 0xe0, 0x53, 0xe4, 0x1f,
-	0xbb, 0x4e, 0xc8, 0x9c, 0x3d, 0x5d, 0x2b, 0xb2, 0x81, 0x5d, 0x27, 0xb4, 0x66, 0xc1, 0xe8, 0xe9,
-	0x13, 0x14, 0x3f, 0x68, 0x6c, 0x38, 0xc9, 0x78, 0xf0, 0x7f, 0x78, 0x54, 0x42, 0xcd, 0xa7, 0x50,
-	0x17, 0xa0, 0xd4, 0xcf, 0x24, 0x90, 0x4f, 0x61, 0x76, 0x3f, 0x6c, 0x6e, 0x76, 0x49, 0x0b, 0x07,
-	0xee, 0xd7, 0x28, 0x16, 0x1b, 0xcd, 0xfc, 0x06, 0x14, 0x5a, 0xb8, 0xed, 0xa0, 0x98, 0x98, 0x7f,
-	0x49, 0xb6, 0xe8, 0xb2, 0x2d, 0x96, 0x09, 0x0b, 0x83, 0x94, 0x09, 0x98, 0x16, 0xcb, 0xc7, 0x1a,
-	0x3a, 0xc3, 0xa7, 0xaf, 0x98, 0x24, 0x4a, 0x26, 0x59, 0x93, 0xc0, 0x68, 0x30, 0x8c, 0xed, 0x00,
-	0xd9, 0x04, 0x6d, 0x73, 0x3d, 0xc6, 0x2c, 0xe4, 0xf1, 0x33, 0x1f, 0x05, 0x1c, 0x20, 0xfa, 0xa0,
-	0xd1, 0xf1, 0x6d, 0x0f, 0xc5, 0x89, 0x44, 0x7f, 0x1b, 0xf7, 0x40, 0xef, 0x06, 0x2e, 0x57, 0x49,
-	0x7f, 0x52, 0x29, 0x0f, 0x11, 0x9b, 0x47, 0x8c, 0xfd, 0xb6, 0x1e, 0x33, 0x02, 0x59, 0x49, 0x4c,
-	0x30, 0xd2, 0x66, 0xeb, 0x1f, 0x8d, 0x25, 0xfc, 0x6e, 0x18, 0x76, 0x51, 0xc6, 0x84, 0xef, 0xe3,
-	0x8c, 0xa9, 0xf4, 0x1e, 0x15, 0xf5, 0x99, 0x83, 0x1a, 0xae, 0x67, 0xb7, 0x43, 0x46, 0x9b, 0xaf,
-	0x89, 0x6f, 0x3a, 0xe7, 0xb9, 0x3e, 0xb1, 0xeb, 0x6d, 0x34, 0x97, 0x5f, 0xd2, 0x56, 0x8a, 0x35,
-	0xf1, 0xdd, 0xf3, 0x4e, 0x21, 0xe9, 0x9d, 0x28, 0x4f, 0xa7, 0x44, 0x9e, 0x7e, 0x22, 0x4e, 0x7e,
-	0x91, 0x8e, 0x6d, 0x6d, 0xd0, 0x03, 0xfe, 0xc7, 0xcb, 0xf2, 0x3b, 0x4d, 0x97, 0xb4, 0xba, 0xf5,
-	0x4a, 0x03, 0x7b, 0xd5, 0x1d, 0xd7, 0x0f, 0x1b, 0x2d, 0xd7, 0xae, 0x9e, 0xf0, 0x1f, 0x6b, 0xa1,
-	0x73, 0x5a, 0x25, 0x17, 0x1d, 0x14, 0x56, 0x76, 0x7d, 0x22, 0xae, 0x83, 0x2a, 0x3b, 0x6a, 0xdc,
-	0x01, 0xc2, 0x71, 0x0f, 0xa1, 0x18, 0x9f, 0x04, 0xee, 0x85, 0x29, 0x7e, 0x10, 0xac, 0x36, 0xcc,
-	0xc4, 0x0b, 0x0e, 0xc6, 0xe9, 0x32, 0x61, 0x7a, 0x2e, 0x61, 0xba, 0xf5, 0x1e, 0x3c, 0x48, 0x68,
-	0x13, 0x7c, 0x8b, 0x00, 0x11, 0x1f, 0x35, 0x88, 0x2b, 0x8d, 0xce, 0xee, 0xd1, 0x45, 0x07, 0x59,
-	0x97, 0x51, 0xc1, 0xd8, 0x77, 0x7d, 0x32, 0xae, 0x6b, 0xec, 0xc3, 0xac, 0x05, 0xe3, 0x75, 0x1a,
-	0x8c, 0x5f, 0xfe, 0x2c, 0xe7, 0xe9, 0x57, 0x98, 0xaa, 0x1c, 0x11, 0x91, 0x38, 0x21, 0x97, 0x51,
-	0xfa, 0xd1, 0xd1, 0xb1, 0xdd, 0xb7, 0x1f, 0x40, 0xa1, 0x63, 0x07, 0xb6, 0x17, 0x72, 0xd0, 0xf2,
-	0x00, 0x50, 0xae, 0xf0, 0x09, 0x95, 0x8b, 0xcb, 0x43, 0xb4, 0xc8, 0x5a, 0x67, 0xf9, 0xc0, 0x05,
-	0x84, 0xbf, 0xa5, 0x9b, 0x51, 0x4b, 0xdd, 0x8c, 0x9f, 0xc3, 0x6b, 0xc9, 0x0d, 0x47, 0x04, 0x27,
-	0x6b, 0x42, 0x58, 0xcf, 0x58, 0x0c, 0xb7, 0xba, 0x81, 0x7f, 0x5b, 0xd7, 0xf4, 0x2a, 0xa4, 0x7e,
-	0xf3, 0x22, 0x1f, 0x29, 0x16, 0xa1, 0xfa, 0x51, 0x2e, 0xf2, 0x59, 0xb1, 0x6e, 0x5a, 0x92, 0x6e,
-	0xd9, 0x97, 0xc8, 0x45, 0x3d, 0x85, 0xfe, 0x15, 0x4b, 0x32, 0x3a, 0x78, 0xeb, 0x24, 0x93, 0xe2,
-	0xaf, 0x0f, 0x2c, 0xe2, 0x7c, 0x7f, 0xa1, 0xf5, 0x3b, 0xb9, 0x88, 0x67, 0x56, 0x7f, 0x53, 0x8f,
-	0x0d, 0xed, 0x2f, 0xe4, 0xa2, 0x9d, 0x46, 0xfc, 0x8d, 0x5f, 0x13, 0xd8, 0x71, 0x4f, 0x2e, 0x46,
-	0x93, 0x89, 0x1b, 0x6a, 0x32, 0x79, 0x39, 0xcb, 0xd9, 0xae, 0xa7, 0xb3, 0xbd, 0x0c, 0x33, 0x1c,
-	0xcf, 0x77, 0xd0, 0x39, 0xbf, 0xdc, 0xa2, 0x15, 0xbb, 0x74, 0xc4, 0x78, 0x0c, 0x53, 0x8d, 0x96,
-	0xed, 0x37, 0x51, 0xc8, 0xfb, 0xb8, 0x85, 0x01, 0x21, 0xdf, 0x24, 0x24, 0x70, 0xeb, 0x5d, 0x82,
-	0x78, 0xdc, 0xe3, 0x25, 0xf1, 0xb5, 0xc2, 0x2c, 0x10, 0x76, 0x5d, 0x30, 0xcf, 0x7f, 0x1c, 0xd8,
-	0x3e, 0x79, 0x82, 0x02, 0xcf, 0x0d, 0x43, 0x17, 0xfb, 0xe3, 0xb9, 0x5d, 0x4c, 0x80, 0x8e, 0xd8,
-	0x32, 0xb6, 0xa6, 0x37, 0xc2, 0x1d, 0x9e, 0x52, 0x2d, 0xc0, 0x9e, 0xb2, 0xdb, 0x3c, 0x6a, 0x17,
-	0xfe, 0x2b, 0x99, 0x4c, 0xa2, 0xf7, 0x91, 0x2c, 0xc2, 0xfc, 0x00, 0x5d, 0x02, 0xe5, 0x1b, 0x16,
-	0xfa, 0x4d, 0x42, 0xec, 0x46, 0xeb, 0x76, 0x00, 0xc9, 0x1a, 0xa9, 0x4b, 0x35, 0xd2, 0x30, 0x69,
-	0xd0, 0x8f, 0xc5, 0x6c, 0x2e, 0x4e, 0x8a, 0x23, 0x5e, 0x43, 0xa3, 0xa8, 0x45, 0xca, 0x05, 0xd1,
-	0x97, 0x8c, 0xe8, 0x23, 0xf4, 0x2a, 0x88, 0xb8, 0xc6, 0x68, 0x73, 0xa1, 0xf1, 0x27, 0xf9, 0x4e,
-	0xcb, 0xea, 0x8c, 0x9b, 0x9e, 0xd0, 0x24, 0x56, 0x6e, 0xa8, 0xa3, 0xf2, 0x69, 0x47, 0xc9, 0xf7,
-	0x5a, 0xca, 0x61, 0xdf, 0x4a, 0xf4, 0x59, 0x1d, 0x37, 0x3e, 0xfa, 0x14, 0x9d, 0xec, 0xdc, 0x8d,
-	0xbf, 0xef, 0x82, 0xbe, 0x1f, 0x36, 0x8d, 0x3d, 0x28, 0xf0, 0x17, 0xe1, 0xa0, 0x83, 0x2d, 0x5e,
-	0x93, 0xa5, 0xb7, 0x86, 0xcd, 0x8a, 0x42, 0xec, 0xc0, 0x9d, 0xd4, 0x3b, 0x53, 0xb1, 0x4e, 0x96,
-	0x2a, 0xad, 0x66, 0x91, 0x12, 0x5a, 0x0e, 0x61, 0x2a, 0x7e, 0x74, 0x2d, 0xaa, 0xb1, 0x0e, 0x76,
-	0x8e, 0x4a, 0xcb, 0x43, 0xa7, 0xc5, 0x86, 0x4d, 0xb8, 0x9b, 0x7e, 0xcd, 0x2d, 0x8f, 0x26, 0xa2,
-	0x0a, 0xd6, 0x32, 0x89, 0x09, 0x45, 0x1e, 0xdc, 0xef, 0x7f, 0x84, 0xbd, 0x3d, 0x78, 0x8f, 0x3e,
-	0xc1, 0x52, 0x35, 0xa3, 0x60, 0x32, 0x1c, 0xa9, 0x67, 0x96, 0x22, 0x1c, 0xb2, 0x94, 0x2a, 0x1c,
-	0x83, 0x1f, 0x52, 0x54, 0x4b, 0xea, 0x15, 0xa5, 0xd0, 0x22, 0x4b, 0xa9, 0xb4, 0x28, 0x1e, 0x4b,
-	0x87, 0x30, 0x15, 0xbf, 0x83, 0x14, 0x41, 0xe7, 0xd3, 0xaa, 0xa0, 0xa7, 0x1f, 0x11, 0x35, 0x28,
-	0x8a, 0x67, 0x82, 0x39, 0x64, 0x09, 0x0d, 0xf3, 0xa3, 0xe1, 0xf3, 0x62, 0xcf, 0x3d, 0x28, 0xf0,
-	0xae, 0x5e, 0x71, 0x9a, 0xa2, 0x59, 0xd5, 0x69, 0x92, 0xfb, 0x6f, 0x6a, 0x72, 0xdc, 0x7b, 0x2f,
-	0xaa, 0x17, 0x0c, 0xc9, 0xf3, 0x74, 0x9f, 0xbc, 0x07, 0x05, 0xde, 0x19, 0x2a, 0xf0, 0xa2, 0x59,
-	0x15, 0x9e, 0xdc, 0xb8, 0x25, 0x0f, 0x3b, 0xdf, 0x75, 0xc4, 0x61, 0xe7, 0xbb, 0xaf, 0x66, 0x91,
-	0x4a, 0x3a, 0x21, 0x6e, 0xce, 0x16, 0xd5, 0x58, 0x43, 0x9c, 0x90, 0x6a, 0xab, 0x92, 0x87, 0x3d,
-	0xde, 0x78, 0x79, 0x34, 0x51, 0x86, 0xc3, 0x9e, 0x56, 0x44, 0x93, 0x21, 0xea, 0xdd, 0x54, 0xc9,
-	0xc0, 0x66, 0x95, 0xc9, 0x20, 0x75, 0x4d, 0x14, 0x3b, 0xdd, 0x32, 0x29, 0xb0, 0x53, 0x62, 0x2a,
-	0x6c, 0x45, 0x17, 0x64, 0x3c, 0x85, 0x7b, 0x7d, 0x2d, 0xd0, 0xa3, 0x61, 0x17, 0x42, 0x42, 0x55,
-	0x25, 0x9b, 0x5c, 0xd2, 0x45, 0xbc, 0xac, 0x2b, 0x5c, 0x14, 0xcd, 0xaa, 0x5c, 0x24, 0x57, 0x5c,
-	0xba, 0x1b, 0x2f, 0xb3, 0x8a, 0xdd, 0xa2, 0x59, 0xd5, 0x6e, 0x72, 0x85, 0x4c, 0xa6, 0x37, 0x67,
-	0x1c, 0x91, 0xde, 0x9c, 0x75, 0x35, 0x8b, 0xd4, 0x20, 0x2d, 0x9c, 0x7d, 0x84, 0x16, 0x6e, 0xc3,
-	0x6a, 0x16, 0xa9, 0x58, 0xcb, 0xd6, 0xa7, 0x3f, 0x5f, 0x99, 0x13, 0xcf, 0xaf, 0x4c, 0xed, 0xc5,
-	0x95, 0xa9, 0xfd, 0x75, 0x65, 0x6a, 0x97, 0xd7, 0xe6, 0xc4, 0x8b, 0x6b, 0x73, 0xe2, 0xf7, 0x6b,
-	0x73, 0xe2, 0x8b, 0xb5, 0x91, 0x7f, 0xcc, 0x9c, 0x27, 0xfe, 0xf5, 0xae, 0x17, 0xd8, 0xdf, 0xde,
-	0xef, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x9c, 0xcb, 0x66, 0x39, 0x61, 0x17, 0x00, 0x00,
+func (m *MsgRevokePermission) Reset()         { *m = MsgRevokePermission{} }
+func (m *MsgRevokePermission) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokePermission) ProtoMessage()    {}
+func (*MsgRevokePermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{43}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// SendFT defines a method to send fungible tokens from one account to another account.
-	// Fires:
-	// - EventSent
-	// - transfer_ft (deprecated, not typed)
-	SendFT(ctx context.Context, in *MsgSendFT, opts ...grpc.CallOption) (*MsgSendFTResponse, error)
-	// OperatorSendFT defines a method to send fungible tokens from one account to another account by the operator.
-	// Fires:
-	// - EventSent
-	// - transfer_ft_from (deprecated, not typed)
-	OperatorSendFT(ctx context.Context, in *MsgOperatorSendFT, opts ...grpc.CallOption) (*MsgOperatorSendFTResponse, error)
-	// SendNFT defines a method to send non-fungible tokens from one account to another account.
-	// Fires:
-	// - EventSent
-	// - transfer_nft (deprecated, not typed)
-	// - operation_transfer_nft (deprecated, not typed)
-	SendNFT(ctx context.Context, in *MsgSendNFT, opts ...grpc.CallOption) (*MsgSendNFTResponse, error)
-	// OperatorSendNFT defines a method to send non-fungible tokens from one account to another account by the operator.
-	// Fires:
-	// - EventSent
-	// - transfer_nft_from (deprecated, not typed)
-	// - operation_transfer_nft (deprecated, not typed)
-	OperatorSendNFT(ctx context.Context, in *MsgOperatorSendNFT, opts ...grpc.CallOption) (*MsgOperatorSendNFTResponse, error)
-	// AuthorizeOperator allows one to send tokens on behalf of the holder.
-	// Fires:
-	// - EventAuthorizedOperator
-	// - approve_collection (deprecated, not typed)
-	AuthorizeOperator(ctx context.Context, in *MsgAuthorizeOperator, opts ...grpc.CallOption) (*MsgAuthorizeOperatorResponse, error)
-	// RevokeOperator revokes the authorization of the operator to send the holder's token.
-	// Fires:
-	// - EventRevokedOperator
-	// - disapprove_collection (deprecated, not typed)
-	RevokeOperator(ctx context.Context, in *MsgRevokeOperator, opts ...grpc.CallOption) (*MsgRevokeOperatorResponse, error)
-	// CreateContract defines a method to create a contract for collection.
-	// it grants `mint`, `burn`, `modify` and `issue` permissions on the contract to its creator.
-	// Fires:
-	// - EventCreatedContract
-	// - create_collection (deprecated, not typed)
-	CreateContract(ctx context.Context, in *MsgCreateContract, opts ...grpc.CallOption) (*MsgCreateContractResponse, error)
-	// IssueFT defines a method to create a class of fungible token.
-	// Fires:
-	// - EventCreatedFTClass
-	// - EventMintedFT
-	// - issue_ft (deprecated, not typed)
-	// Note: it does not grant any permissions to its issuer.
-	IssueFT(ctx context.Context, in *MsgIssueFT, opts ...grpc.CallOption) (*MsgIssueFTResponse, error)
-	// IssueNFT defines a method to create a class of non-fungible token.
-	// Fires:
-	// - EventCreatedNFTClass
-	// - issue_nft (deprecated, not typed)
-	// Note: it DOES grant `mint` and `burn` permissions to its issuer.
-	IssueNFT(ctx context.Context, in *MsgIssueNFT, opts ...grpc.CallOption) (*MsgIssueNFTResponse, error)
-	// MintFT defines a method to mint fungible tokens.
-	// Fires:
-	// - EventMintedFT
-	// - mint_ft (deprecated, not typed)
-	MintFT(ctx context.Context, in *MsgMintFT, opts ...grpc.CallOption) (*MsgMintFTResponse, error)
-	// MintNFT defines a method to mint non-fungible tokens.
-	// Fires:
-	// - EventMintedNFT
-	// - mint_nft (deprecated, not typed)
-	MintNFT(ctx context.Context, in *MsgMintNFT, opts ...grpc.CallOption) (*MsgMintNFTResponse, error)
-	// BurnFT defines a method to burn fungible tokens.
-	// Fires:
-	// - EventBurned
-	// - burn_ft (deprecated, not typed)
-	// - burn_nft (deprecated, not typed)
-	// - operation_burn_nft (deprecated, not typed)
-	BurnFT(ctx context.Context, in *MsgBurnFT, opts ...grpc.CallOption) (*MsgBurnFTResponse, error)
-	// OperatorBurnFT defines a method to burn fungible tokens of the holder by the operator.
-	// Fires:
-	// - EventBurned
-	// - burn_ft_from (deprecated, not typed)
-	// - burn_nft_from (deprecated, not typed)
-	// - operation_burn_nft (deprecated, not typed)
-	OperatorBurnFT(ctx context.Context, in *MsgOperatorBurnFT, opts ...grpc.CallOption) (*MsgOperatorBurnFTResponse, error)
-	// BurnNFT defines a method to burn non-fungible tokens.
-	// Fires:
-	// - EventBurned
-	// - burn_ft (deprecated, not typed)
-	// - burn_nft (deprecated, not typed)
-	// - operation_burn_nft (deprecated, not typed)
-	BurnNFT(ctx context.Context, in *MsgBurnNFT, opts ...grpc.CallOption) (*MsgBurnNFTResponse, error)
-	// OperatorBurnNFT defines a method to burn non-fungible tokens of the holder by the operator.
-	// Fires:
-	// - EventBurned
-	// - burn_ft_from (deprecated, not typed)
-	// - burn_nft_from (deprecated, not typed)
-	// - operation_burn_nft (deprecated, not typed)
-	OperatorBurnNFT(ctx context.Context, in *MsgOperatorBurnNFT, opts ...grpc.CallOption) (*MsgOperatorBurnNFTResponse, error)
-	// Modify defines a method to modify metadata.
-	// Fires:
-	// - EventModifiedContract
-	// - modify_collection (deprecated, not typed)
-	// - EventModifiedTokenClass
-	// - modify_token_type (deprecated, not typed)
-	// - modify_token (deprecated, not typed)
-	// - EventModifiedNFT
-	Modify(ctx context.Context, in *MsgModify, opts ...grpc.CallOption) (*MsgModifyResponse, error)
-	// GrantPermission allows one to mint or burn tokens or modify metadata.
-	// Fires:
-	// - EventGranted
-	// - grant_perm (deprecated, not typed)
-	GrantPermission(ctx context.Context, in *MsgGrantPermission, opts ...grpc.CallOption) (*MsgGrantPermissionResponse, error)
-	// RevokePermission abandons a permission.
-	// Fires:
-	// - EventRenounced
-	// - revoke_perm (deprecated, not typed)
-	RevokePermission(ctx context.Context, in *MsgRevokePermission, opts ...grpc.CallOption) (*MsgRevokePermissionResponse, error)
-	// Attach defines a method to attach a token to another token.
-	// Fires:
-	// - EventAttach
-	// - attach (deprecated, not typed)
-	// - operation_root_changed (deprecated, not typed)
-	Attach(ctx context.Context, in *MsgAttach, opts ...grpc.CallOption) (*MsgAttachResponse, error)
-	// Detach defines a method to detach a token from another token.
-	// Fires:
-	// - EventDetach
-	// - detach (deprecated, not typed)
-	// - operation_root_changed (deprecated, not typed)
-	Detach(ctx context.Context, in *MsgDetach, opts ...grpc.CallOption) (*MsgDetachResponse, error)
-	// OperatorAttach defines a method to attach a token to another token by operator.
-	// Fires:
-	// - EventAttach
-	// - attach_from (deprecated, not typed)
-	// - operation_root_changed (deprecated, not typed)
-	OperatorAttach(ctx context.Context, in *MsgOperatorAttach, opts ...grpc.CallOption) (*MsgOperatorAttachResponse, error)
-	// OperatorDetach defines a method to detach a token from another token by operator.
-	// Fires:
-	// - EventDetach
-	// - detach_from (deprecated, not typed)
-	// - operation_root_changed (deprecated, not typed)
-	OperatorDetach(ctx context.Context, in *MsgOperatorDetach, opts ...grpc.CallOption) (*MsgOperatorDetachResponse, error)
+func (m *MsgRevokePermission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgRevokePermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokePermission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgRevokePermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokePermission.Merge(m, src)
 }
-
-func (c *msgClient) SendFT(ctx context.Context, in *MsgSendFT, opts ...grpc.CallOption) (*MsgSendFTResponse, error) {
-	out := new(MsgSendFTResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/SendFT", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRevokePermission) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokePermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokePermission.DiscardUnknown(m)
 }
 
-func (c *msgClient) OperatorSendFT(ctx context.Context, in *MsgOperatorSendFT, opts ...grpc.CallOption) (*MsgOperatorSendFTResponse, error) {
-	out := new(MsgOperatorSendFTResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/OperatorSendFT", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRevokePermission proto.InternalMessageInfo
+
+func (m *MsgRevokePermission) GetContractId() string {
+	if m != nil {
+		return m.ContractId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SendNFT(ctx context.Context, in *MsgSendNFT, opts ...grpc.CallOption) (*MsgSendNFTResponse, error) {
-	out := new(MsgSendNFTResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/SendNFT", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRevokePermission) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) OperatorSendNFT(ctx context.Context, in *MsgOperatorSendNFT, opts ...grpc.CallOption) (*MsgOperatorSendNFTResponse, error) {
-	out := new(MsgOperatorSendNFTResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/OperatorSendNFT", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRevokePermission) GetPermission() string {
+	if m != nil {
+		return m.Permission
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) AuthorizeOperator(ctx context.Context, in *MsgAuthorizeOperator, opts ...grpc.CallOption) (*MsgAuthorizeOperatorResponse, error) {
-	out := new(MsgAuthorizeOperatorResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/AuthorizeOperator", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgRevokePermissionResponse is the Msg/RevokePermission response type.
+type MsgRevokePermissionResponse struct {
 }
 
-func (c *msgClient) RevokeOperator(ctx context.Context, in *MsgRevokeOperator, opts ...grpc.CallOption) (*MsgRevokeOperatorResponse, error) {
-	out := new(MsgRevokeOperatorResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/RevokeOperator", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRevokePermissionResponse) Reset()         { *m = MsgRevokePermissionResponse{} }
+func (m *MsgRevokePermissionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokePermissionResponse) ProtoMessage()    {}
+func (*MsgRevokePermissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{44}
+}
+func (m *MsgRevokePermissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokePermissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokePermissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRevokePermissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokePermissionResponse.Merge(m, src)
+}
+func (m *MsgRevokePermissionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokePermissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokePermissionResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateContract(ctx context.Context, in *MsgCreateContract, opts ...grpc.CallOption) (*MsgCreateContractResponse, error) {
-	out := new(MsgCreateContractResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/CreateContract", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgRevokePermissionResponse proto.InternalMessageInfo
+
+// MsgAttach is the Msg/Attach request type.
+//
+// Signer: `from`
+type MsgAttach struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// address of the owner of the token.
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// token id of the token to attach.
+	TokenId string `protobuf:"bytes,3,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// to token id which one attachs the token to.
+	ToTokenId string `protobuf:"bytes,4,opt,name=to_token_id,json=toTokenId,proto3" json:"to_token_id,omitempty"`
 }
 
-func (c *msgClient) IssueFT(ctx context.Context, in *MsgIssueFT, opts ...grpc.CallOption) (*MsgIssueFTResponse, error) {
-	out := new(MsgIssueFTResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/IssueFT", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAttach) Reset()         { *m = MsgAttach{} }
+func (m *MsgAttach) String() string { return proto.CompactTextString(m) }
+func (*MsgAttach) ProtoMessage()    {}
+func (*MsgAttach) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{45}
+}
+func (m *MsgAttach) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAttach) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAttach.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgAttach) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAttach.Merge(m, src)
+}
+func (m *MsgAttach) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAttach) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAttach.DiscardUnknown(m)
 }
 
-func (c *msgClient) IssueNFT(ctx context.Context, in *MsgIssueNFT, opts ...grpc.CallOption) (*MsgIssueNFTResponse, error) {
-	out := new(MsgIssueNFTResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/IssueNFT", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAttach proto.InternalMessageInfo
+
+func (m *MsgAttach) GetContractId() string {
+	if m != nil {
+		return m.ContractId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) MintFT(ctx context.Context, in *MsgMintFT, opts ...grpc.CallOption) (*MsgMintFTResponse, error) {
-	out := new(MsgMintFTResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/MintFT", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAttach) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) MintNFT(ctx context.Context, in *MsgMintNFT, opts ...grpc.CallOption) (*MsgMintNFTResponse, error) {
-	out := new(MsgMintNFTResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/MintNFT", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAttach) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) BurnFT(ctx context.Context, in *MsgBurnFT, opts ...grpc.CallOption) (*MsgBurnFTResponse, error) {
-	out := new(MsgBurnFTResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/BurnFT", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAttach) GetToTokenId() string {
+	if m != nil {
+		return m.ToTokenId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) OperatorBurnFT(ctx context.Context, in *MsgOperatorBurnFT, opts ...grpc.CallOption) (*MsgOperatorBurnFTResponse, error) {
-	out := new(MsgOperatorBurnFTResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/OperatorBurnFT", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgAttachResponse is the Msg/Attach response type.
+type MsgAttachResponse struct {
 }
 
-func (c *msgClient) BurnNFT(ctx context.Context, in *MsgBurnNFT, opts ...grpc.CallOption) (*MsgBurnNFTResponse, error) {
-	out := new(MsgBurnNFTResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/BurnNFT", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAttachResponse) Reset()         { *m = MsgAttachResponse{} }
+func (m *MsgAttachResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAttachResponse) ProtoMessage()    {}
+func (*MsgAttachResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{46}
+}
+func (m *MsgAttachResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAttachResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAttachResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgAttachResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAttachResponse.Merge(m, src)
+}
+func (m *MsgAttachResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAttachResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAttachResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) OperatorBurnNFT(ctx context.Context, in *MsgOperatorBurnNFT, opts ...grpc.CallOption) (*MsgOperatorBurnNFTResponse, error) {
-	out := new(MsgOperatorBurnNFTResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/OperatorBurnNFT", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgAttachResponse proto.InternalMessageInfo
+
+// MsgDetach is the Msg/Detach request type.
+//
+// Signer: `from`
+type MsgDetach struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// address of the owner of the token.
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// token id of the token to detach.
+	TokenId string `protobuf:"bytes,3,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
 }
 
-func (c *msgClient) Modify(ctx context.Context, in *MsgModify, opts ...grpc.CallOption) (*MsgModifyResponse, error) {
-	out := new(MsgModifyResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/Modify", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDetach) Reset()         { *m = MsgDetach{} }
+func (m *MsgDetach) String() string { return proto.CompactTextString(m) }
+func (*MsgDetach) ProtoMessage()    {}
+func (*MsgDetach) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{47}
+}
+func (m *MsgDetach) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDetach) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDetach.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgDetach) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDetach.Merge(m, src)
+}
+func (m *MsgDetach) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDetach) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDetach.DiscardUnknown(m)
 }
 
-func (c *msgClient) GrantPermission(ctx context.Context, in *MsgGrantPermission, opts ...grpc.CallOption) (*MsgGrantPermissionResponse, error) {
-	out := new(MsgGrantPermissionResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/GrantPermission", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDetach proto.InternalMessageInfo
+
+func (m *MsgDetach) GetContractId() string {
+	if m != nil {
+		return m.ContractId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RevokePermission(ctx context.Context, in *MsgRevokePermission, opts ...grpc.CallOption) (*MsgRevokePermissionResponse, error) {
-	out := new(MsgRevokePermissionResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/RevokePermission", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDetach) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) Attach(ctx context.Context, in *MsgAttach, opts ...grpc.CallOption) (*MsgAttachResponse, error) {
-	out := new(MsgAttachResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/Attach", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDetach) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) Detach(ctx context.Context, in *MsgDetach, opts ...grpc.CallOption) (*MsgDetachResponse, error) {
-	out := new(MsgDetachResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/Detach", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgDetachResponse is the Msg/Detach response type.
+type MsgDetachResponse struct {
 }
 
-func (c *msgClient) OperatorAttach(ctx context.Context, in *MsgOperatorAttach, opts ...grpc.CallOption) (*MsgOperatorAttachResponse, error) {
-	out := new(MsgOperatorAttachResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/OperatorAttach", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgDetachResponse) Reset()         { *m = MsgDetachResponse{} }
+func (m *MsgDetachResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDetachResponse) ProtoMessage()    {}
+func (*MsgDetachResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{48}
 }
-
-func (c *msgClient) OperatorDetach(ctx context.Context, in *MsgOperatorDetach, opts ...grpc.CallOption) (*MsgOperatorDetachResponse, error) {
-	out := new(MsgOperatorDetachResponse)
-	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/OperatorDetach", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDetachResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDetachResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDetachResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// SendFT defines a method to send fungible tokens from one account to another account.
-	// Fires:
-	// - EventSent
-	// - transfer_ft (deprecated, not typed)
-	SendFT(context.Context, *MsgSendFT) (*MsgSendFTResponse, error)
-	// OperatorSendFT defines a method to send fungible tokens from one account to another account by the operator.
-	// Fires:
-	// - EventSent
-	// - transfer_ft_from (deprecated, not typed)
-	OperatorSendFT(context.Context, *MsgOperatorSendFT) (*MsgOperatorSendFTResponse, error)
-	// SendNFT defines a method to send non-fungible tokens from one account to another account.
-	// Fires:
-	// - EventSent
-	// - transfer_nft (deprecated, not typed)
-	// - operation_transfer_nft (deprecated, not typed)
-	SendNFT(context.Context, *MsgSendNFT) (*MsgSendNFTResponse, error)
-	// OperatorSendNFT defines a method to send non-fungible tokens from one account to another account by the operator.
-	// Fires:
-	// - EventSent
-	// - transfer_nft_from (deprecated, not typed)
-	// - operation_transfer_nft (deprecated, not typed)
-	OperatorSendNFT(context.Context, *MsgOperatorSendNFT) (*MsgOperatorSendNFTResponse, error)
-	// AuthorizeOperator allows one to send tokens on behalf of the holder.
-	// Fires:
-	// - EventAuthorizedOperator
-	// - approve_collection (deprecated, not typed)
-	AuthorizeOperator(context.Context, *MsgAuthorizeOperator) (*MsgAuthorizeOperatorResponse, error)
-	// RevokeOperator revokes the authorization of the operator to send the holder's token.
-	// Fires:
-	// - EventRevokedOperator
-	// - disapprove_collection (deprecated, not typed)
-	RevokeOperator(context.Context, *MsgRevokeOperator) (*MsgRevokeOperatorResponse, error)
-	// CreateContract defines a method to create a contract for collection.
-	// it grants `mint`, `burn`, `modify` and `issue` permissions on the contract to its creator.
-	// Fires:
-	// - EventCreatedContract
-	// - create_collection (deprecated, not typed)
-	CreateContract(context.Context, *MsgCreateContract) (*MsgCreateContractResponse, error)
-	// IssueFT defines a method to create a class of fungible token.
-	// Fires:
-	// - EventCreatedFTClass
-	// - EventMintedFT
-	// - issue_ft (deprecated, not typed)
-	// Note: it does not grant any permissions to its issuer.
-	IssueFT(context.Context, *MsgIssueFT) (*MsgIssueFTResponse, error)
-	// IssueNFT defines a method to create a class of non-fungible token.
-	// Fires:
-	// - EventCreatedNFTClass
-	// - issue_nft (deprecated, not typed)
-	// Note: it DOES grant `mint` and `burn` permissions to its issuer.
-	IssueNFT(context.Context, *MsgIssueNFT) (*MsgIssueNFTResponse, error)
-	// MintFT defines a method to mint fungible tokens.
-	// Fires:
-	// - EventMintedFT
-	// - mint_ft (deprecated, not typed)
-	MintFT(context.Context, *MsgMintFT) (*MsgMintFTResponse, error)
-	// MintNFT defines a method to mint non-fungible tokens.
-	// Fires:
-	// - EventMintedNFT
-	// - mint_nft (deprecated, not typed)
-	MintNFT(context.Context, *MsgMintNFT) (*MsgMintNFTResponse, error)
-	// BurnFT defines a method to burn fungible tokens.
-	// Fires:
-	// - EventBurned
-	// - burn_ft (deprecated, not typed)
-	// - burn_nft (deprecated, not typed)
-	// - operation_burn_nft (deprecated, not typed)
-	BurnFT(context.Context, *MsgBurnFT) (*MsgBurnFTResponse, error)
-	// OperatorBurnFT defines a method to burn fungible tokens of the holder by the operator.
-	// Fires:
-	// - EventBurned
-	// - burn_ft_from (deprecated, not typed)
-	// - burn_nft_from (deprecated, not typed)
-	// - operation_burn_nft (deprecated, not typed)
-	OperatorBurnFT(context.Context, *MsgOperatorBurnFT) (*MsgOperatorBurnFTResponse, error)
-	// BurnNFT defines a method to burn non-fungible tokens.
-	// Fires:
-	// - EventBurned
-	// - burn_ft (deprecated, not typed)
-	// - burn_nft (deprecated, not typed)
-	// - operation_burn_nft (deprecated, not typed)
-	BurnNFT(context.Context, *MsgBurnNFT) (*MsgBurnNFTResponse, error)
-	// OperatorBurnNFT defines a method to burn non-fungible tokens of the holder by the operator.
-	// Fires:
-	// - EventBurned
-	// - burn_ft_from (deprecated, not typed)
-	// - burn_nft_from (deprecated, not typed)
-	// - operation_burn_nft (deprecated, not typed)
-	OperatorBurnNFT(context.Context, *MsgOperatorBurnNFT) (*MsgOperatorBurnNFTResponse, error)
-	// Modify defines a method to modify metadata.
-	// Fires:
-	// - EventModifiedContract
-	// - modify_collection (deprecated, not typed)
-	// - EventModifiedTokenClass
-	// - modify_token_type (deprecated, not typed)
-	// - modify_token (deprecated, not typed)
-	// - EventModifiedNFT
-	Modify(context.Context, *MsgModify) (*MsgModifyResponse, error)
-	// GrantPermission allows one to mint or burn tokens or modify metadata.
-	// Fires:
-	// - EventGranted
-	// - grant_perm (deprecated, not typed)
-	GrantPermission(context.Context, *MsgGrantPermission) (*MsgGrantPermissionResponse, error)
-	// RevokePermission abandons a permission.
-	// Fires:
-	// - EventRenounced
-	// - revoke_perm (deprecated, not typed)
-	RevokePermission(context.Context, *MsgRevokePermission) (*MsgRevokePermissionResponse, error)
-	// Attach defines a method to attach a token to another token.
-	// Fires:
-	// - EventAttach
-	// - attach (deprecated, not typed)
-	// - operation_root_changed (deprecated, not typed)
-	Attach(context.Context, *MsgAttach) (*MsgAttachResponse, error)
-	// Detach defines a method to detach a token from another token.
-	// Fires:
-	// - EventDetach
-	// - detach (deprecated, not typed)
-	// - operation_root_changed (deprecated, not typed)
-	Detach(context.Context, *MsgDetach) (*MsgDetachResponse, error)
-	// OperatorAttach defines a method to attach a token to another token by operator.
-	// Fires:
-	// - EventAttach
-	// - attach_from (deprecated, not typed)
-	// - operation_root_changed (deprecated, not typed)
-	OperatorAttach(context.Context, *MsgOperatorAttach) (*MsgOperatorAttachResponse, error)
-	// OperatorDetach defines a method to detach a token from another token by operator.
-	// Fires:
-	// - EventDetach
-	// - detach_from (deprecated, not typed)
-	// - operation_root_changed (deprecated, not typed)
-	OperatorDetach(context.Context, *MsgOperatorDetach) (*MsgOperatorDetachResponse, error)
+func (m *MsgDetachResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDetachResponse.Merge(m, src)
 }
-
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgDetachResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (*UnimplementedMsgServer) SendFT(ctx context.Context, req *MsgSendFT) (*MsgSendFTResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendFT not implemented")
+func (m *MsgDetachResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDetachResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) OperatorSendFT(ctx context.Context, req *MsgOperatorSendFT) (*MsgOperatorSendFTResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OperatorSendFT not implemented")
+
+var xxx_messageInfo_MsgDetachResponse proto.InternalMessageInfo
+
+// MsgOperatorAttach is the Msg/OperatorAttach request type.
+type MsgOperatorAttach struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// address of the operator.
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// address of the owner of the token.
+	From string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// token id of the token to attach.
+	TokenId string `protobuf:"bytes,4,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// to token id which one attachs the token to.
+	ToTokenId string `protobuf:"bytes,5,opt,name=to_token_id,json=toTokenId,proto3" json:"to_token_id,omitempty"`
 }
-func (*UnimplementedMsgServer) SendNFT(ctx context.Context, req *MsgSendNFT) (*MsgSendNFTResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendNFT not implemented")
+
+func (m *MsgOperatorAttach) Reset()         { *m = MsgOperatorAttach{} }
+func (m *MsgOperatorAttach) String() string { return proto.CompactTextString(m) }
+func (*MsgOperatorAttach) ProtoMessage()    {}
+func (*MsgOperatorAttach) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{49}
 }
-func (*UnimplementedMsgServer) OperatorSendNFT(ctx context.Context, req *MsgOperatorSendNFT) (*MsgOperatorSendNFTResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OperatorSendNFT not implemented")
+func (m *MsgOperatorAttach) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) AuthorizeOperator(ctx context.Context, req *MsgAuthorizeOperator) (*MsgAuthorizeOperatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AuthorizeOperator not implemented")
+func (m *MsgOperatorAttach) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOperatorAttach.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) RevokeOperator(ctx context.Context, req *MsgRevokeOperator) (*MsgRevokeOperatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RevokeOperator not implemented")
+func (m *MsgOperatorAttach) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOperatorAttach.Merge(m, src)
 }
-func (*UnimplementedMsgServer) CreateContract(ctx context.Context, req *MsgCreateContract) (*MsgCreateContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateContract not implemented")
+func (m *MsgOperatorAttach) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) IssueFT(ctx context.Context, req *MsgIssueFT) (*MsgIssueFTResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IssueFT not implemented")
+func (m *MsgOperatorAttach) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOperatorAttach.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) IssueNFT(ctx context.Context, req *MsgIssueNFT) (*MsgIssueNFTResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IssueNFT not implemented")
+
+var xxx_messageInfo_MsgOperatorAttach proto.InternalMessageInfo
+
+func (m *MsgOperatorAttach) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) MintFT(ctx context.Context, req *MsgMintFT) (*MsgMintFTResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MintFT not implemented")
+
+func (m *MsgOperatorAttach) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) MintNFT(ctx context.Context, req *MsgMintNFT) (*MsgMintNFTResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MintNFT not implemented")
-}
-func (*UnimplementedMsgServer) BurnFT(ctx context.Context, req *MsgBurnFT) (*MsgBurnFTResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BurnFT not implemented")
-}
-func (*UnimplementedMsgServer) OperatorBurnFT(ctx context.Context, req *MsgOperatorBurnFT) (*MsgOperatorBurnFTResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OperatorBurnFT not implemented")
+
+func (m *MsgOperatorAttach) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) BurnNFT(ctx context.Context, req *MsgBurnNFT) (*MsgBurnNFTResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BurnNFT not implemented")
+
+func (m *MsgOperatorAttach) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) OperatorBurnNFT(ctx context.Context, req *MsgOperatorBurnNFT) (*MsgOperatorBurnNFTResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OperatorBurnNFT not implemented")
+
+func (m *MsgOperatorAttach) GetToTokenId() string {
+	if m != nil {
+		return m.ToTokenId
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) Modify(ctx context.Context, req *MsgModify) (*MsgModifyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Modify not implemented")
+
+// MsgOperatorAttachResponse is the Msg/OperatorAttach response type.
+type MsgOperatorAttachResponse struct {
 }
-func (*UnimplementedMsgServer) GrantPermission(ctx context.Context, req *MsgGrantPermission) (*MsgGrantPermissionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GrantPermission not implemented")
+
+func (m *MsgOperatorAttachResponse) Reset()         { *m = MsgOperatorAttachResponse{} }
+func (m *MsgOperatorAttachResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgOperatorAttachResponse) ProtoMessage()    {}
+func (*MsgOperatorAttachResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{50}
 }
-func (*UnimplementedMsgServer) RevokePermission(ctx context.Context, req *MsgRevokePermission) (*MsgRevokePermissionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RevokePermission not implemented")
+func (m *MsgOperatorAttachResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) Attach(ctx context.Context, req *MsgAttach) (*MsgAttachResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Attach not implemented")
+func (m *MsgOperatorAttachResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOperatorAttachResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) Detach(ctx context.Context, req *MsgDetach) (*MsgDetachResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Detach not implemented")
+func (m *MsgOperatorAttachResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOperatorAttachResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) OperatorAttach(ctx context.Context, req *MsgOperatorAttach) (*MsgOperatorAttachResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OperatorAttach not implemented")
+func (m *MsgOperatorAttachResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) OperatorDetach(ctx context.Context, req *MsgOperatorDetach) (*MsgOperatorDetachResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OperatorDetach not implemented")
+func (m *MsgOperatorAttachResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOperatorAttachResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgOperatorAttachResponse proto.InternalMessageInfo
 
-func _Msg_SendFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSendFT)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SendFT(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Msg/SendFT",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SendFT(ctx, req.(*MsgSendFT))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgOperatorDetach is the Msg/OperatorDetach request type.
+type MsgOperatorDetach struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// address of the operator.
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// address of the owner of the token.
+	From string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// token id of the token to detach.
+	TokenId string `protobuf:"bytes,4,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
 }
 
-func _Msg_OperatorSendFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgOperatorSendFT)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).OperatorSendFT(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Msg/OperatorSendFT",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).OperatorSendFT(ctx, req.(*MsgOperatorSendFT))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgOperatorDetach) Reset()         { *m = MsgOperatorDetach{} }
+func (m *MsgOperatorDetach) String() string { return proto.CompactTextString(m) }
+func (*MsgOperatorDetach) ProtoMessage()    {}
+func (*MsgOperatorDetach) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{51}
 }
-
-func _Msg_SendNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSendNFT)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SendNFT(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Msg/SendNFT",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SendNFT(ctx, req.(*MsgSendNFT))
+func (m *MsgOperatorDetach) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOperatorDetach) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOperatorDetach.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgOperatorDetach) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOperatorDetach.Merge(m, src)
+}
+func (m *MsgOperatorDetach) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOperatorDetach) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOperatorDetach.DiscardUnknown(m)
 }
 
-func _Msg_OperatorSendNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgOperatorSendNFT)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).OperatorSendNFT(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Msg/OperatorSendNFT",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).OperatorSendNFT(ctx, req.(*MsgOperatorSendNFT))
+var xxx_messageInfo_MsgOperatorDetach proto.InternalMessageInfo
+
+func (m *MsgOperatorDetach) GetContractId() string {
+	if m != nil {
+		return m.ContractId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_AuthorizeOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAuthorizeOperator)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AuthorizeOperator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Msg/AuthorizeOperator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AuthorizeOperator(ctx, req.(*MsgAuthorizeOperator))
+func (m *MsgOperatorDetach) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_RevokeOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRevokeOperator)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RevokeOperator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Msg/RevokeOperator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RevokeOperator(ctx, req.(*MsgRevokeOperator))
+func (m *MsgOperatorDetach) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CreateContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateContract)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Msg/CreateContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateContract(ctx, req.(*MsgCreateContract))
+func (m *MsgOperatorDetach) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_IssueFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgIssueFT)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).IssueFT(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Msg/IssueFT",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).IssueFT(ctx, req.(*MsgIssueFT))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgOperatorDetachResponse is the Msg/OperatorDetach response type.
+type MsgOperatorDetachResponse struct {
 }
 
-func _Msg_IssueNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgIssueNFT)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).IssueNFT(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Msg/IssueNFT",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).IssueNFT(ctx, req.(*MsgIssueNFT))
+func (m *MsgOperatorDetachResponse) Reset()         { *m = MsgOperatorDetachResponse{} }
+func (m *MsgOperatorDetachResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgOperatorDetachResponse) ProtoMessage()    {}
+func (*MsgOperatorDetachResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{52}
+}
+func (m *MsgOperatorDetachResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOperatorDetachResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOperatorDetachResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgOperatorDetachResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOperatorDetachResponse.Merge(m, src)
+}
+func (m *MsgOperatorDetachResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOperatorDetachResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOperatorDetachResponse.DiscardUnknown(m)
 }
 
-func _Msg_MintFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMintFT)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MintFT(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Msg/MintFT",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MintFT(ctx, req.(*MsgMintFT))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgOperatorDetachResponse proto.InternalMessageInfo
+
+// MsgSetNFTHistoryEnabled is the Msg/SetNFTHistoryEnabled request type.
+type MsgSetNFTHistoryEnabled struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// address of the grantee which has the modify permission.
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// whether to record the history of the non-fungible tokens.
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
 
-func _Msg_MintNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMintNFT)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MintNFT(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Msg/MintNFT",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MintNFT(ctx, req.(*MsgMintNFT))
+func (m *MsgSetNFTHistoryEnabled) Reset()         { *m = MsgSetNFTHistoryEnabled{} }
+func (m *MsgSetNFTHistoryEnabled) String() string { return proto.CompactTextString(m) }
+func (*MsgSetNFTHistoryEnabled) ProtoMessage()    {}
+func (*MsgSetNFTHistoryEnabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{53}
+}
+func (m *MsgSetNFTHistoryEnabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetNFTHistoryEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetNFTHistoryEnabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetNFTHistoryEnabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetNFTHistoryEnabled.Merge(m, src)
+}
+func (m *MsgSetNFTHistoryEnabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetNFTHistoryEnabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetNFTHistoryEnabled.DiscardUnknown(m)
 }
 
-func _Msg_BurnFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBurnFT)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).BurnFT(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Msg/BurnFT",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BurnFT(ctx, req.(*MsgBurnFT))
+var xxx_messageInfo_MsgSetNFTHistoryEnabled proto.InternalMessageInfo
+
+func (m *MsgSetNFTHistoryEnabled) GetContractId() string {
+	if m != nil {
+		return m.ContractId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_OperatorBurnFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgOperatorBurnFT)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).OperatorBurnFT(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Msg/OperatorBurnFT",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).OperatorBurnFT(ctx, req.(*MsgOperatorBurnFT))
+func (m *MsgSetNFTHistoryEnabled) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_BurnNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBurnNFT)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).BurnNFT(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Msg/BurnNFT",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BurnNFT(ctx, req.(*MsgBurnNFT))
+func (m *MsgSetNFTHistoryEnabled) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Msg_OperatorBurnNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgOperatorBurnNFT)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).OperatorBurnNFT(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Msg/OperatorBurnNFT",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).OperatorBurnNFT(ctx, req.(*MsgOperatorBurnNFT))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgSetNFTHistoryEnabledResponse is the Msg/SetNFTHistoryEnabled response type.
+type MsgSetNFTHistoryEnabledResponse struct {
 }
 
-func _Msg_Modify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgModify)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Modify(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Msg/Modify",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Modify(ctx, req.(*MsgModify))
+func (m *MsgSetNFTHistoryEnabledResponse) Reset()         { *m = MsgSetNFTHistoryEnabledResponse{} }
+func (m *MsgSetNFTHistoryEnabledResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetNFTHistoryEnabledResponse) ProtoMessage()    {}
+func (*MsgSetNFTHistoryEnabledResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{54}
+}
+func (m *MsgSetNFTHistoryEnabledResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetNFTHistoryEnabledResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetNFTHistoryEnabledResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetNFTHistoryEnabledResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetNFTHistoryEnabledResponse.Merge(m, src)
+}
+func (m *MsgSetNFTHistoryEnabledResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetNFTHistoryEnabledResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetNFTHistoryEnabledResponse.DiscardUnknown(m)
 }
 
-func _Msg_GrantPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgGrantPermission)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).GrantPermission(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Msg/GrantPermission",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).GrantPermission(ctx, req.(*MsgGrantPermission))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgSetNFTHistoryEnabledResponse proto.InternalMessageInfo
+
+// MsgProposeAdmin is the Msg/ProposeAdmin request type.
+type MsgProposeAdmin struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// address of the admin of the contract.
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// address of the account proposed as the next admin.
+	To string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
 }
 
-func _Msg_RevokePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRevokePermission)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgProposeAdmin) Reset()         { *m = MsgProposeAdmin{} }
+func (m *MsgProposeAdmin) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeAdmin) ProtoMessage()    {}
+func (*MsgProposeAdmin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{55}
+}
+func (m *MsgProposeAdmin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeAdmin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeAdmin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RevokePermission(ctx, in)
+}
+func (m *MsgProposeAdmin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeAdmin.Merge(m, src)
+}
+func (m *MsgProposeAdmin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeAdmin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeAdmin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProposeAdmin proto.InternalMessageInfo
+
+func (m *MsgProposeAdmin) GetContractId() string {
+	if m != nil {
+		return m.ContractId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Msg/RevokePermission",
+	return ""
+}
+
+func (m *MsgProposeAdmin) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RevokePermission(ctx, req.(*MsgRevokePermission))
+	return ""
+}
+
+func (m *MsgProposeAdmin) GetTo() string {
+	if m != nil {
+		return m.To
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_Attach_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAttach)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Attach(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Msg/Attach",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Attach(ctx, req.(*MsgAttach))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgProposeAdminResponse is the Msg/ProposeAdmin response type.
+type MsgProposeAdminResponse struct {
 }
 
-func _Msg_Detach_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDetach)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Detach(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Msg/Detach",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Detach(ctx, req.(*MsgDetach))
+func (m *MsgProposeAdminResponse) Reset()         { *m = MsgProposeAdminResponse{} }
+func (m *MsgProposeAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeAdminResponse) ProtoMessage()    {}
+func (*MsgProposeAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{56}
+}
+func (m *MsgProposeAdminResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeAdminResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgProposeAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeAdminResponse.Merge(m, src)
+}
+func (m *MsgProposeAdminResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeAdminResponse.DiscardUnknown(m)
 }
 
-func _Msg_OperatorAttach_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgOperatorAttach)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).OperatorAttach(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Msg/OperatorAttach",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).OperatorAttach(ctx, req.(*MsgOperatorAttach))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgProposeAdminResponse proto.InternalMessageInfo
+
+// MsgAcceptAdmin is the Msg/AcceptAdmin request type.
+type MsgAcceptAdmin struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// address of the account which has been proposed as the next admin.
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
 }
 
-func _Msg_OperatorDetach_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgOperatorDetach)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).OperatorDetach(ctx, in)
+func (m *MsgAcceptAdmin) Reset()         { *m = MsgAcceptAdmin{} }
+func (m *MsgAcceptAdmin) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptAdmin) ProtoMessage()    {}
+func (*MsgAcceptAdmin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{57}
+}
+func (m *MsgAcceptAdmin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptAdmin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptAdmin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Msg/OperatorDetach",
+}
+func (m *MsgAcceptAdmin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptAdmin.Merge(m, src)
+}
+func (m *MsgAcceptAdmin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptAdmin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptAdmin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptAdmin proto.InternalMessageInfo
+
+func (m *MsgAcceptAdmin) GetContractId() string {
+	if m != nil {
+		return m.ContractId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).OperatorDetach(ctx, req.(*MsgOperatorDetach))
+	return ""
+}
+
+func (m *MsgAcceptAdmin) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "lbm.collection.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SendFT",
-			Handler:    _Msg_SendFT_Handler,
-		},
-		{
-			MethodName: "OperatorSendFT",
-			Handler:    _Msg_OperatorSendFT_Handler,
-		},
-		{
-			MethodName: "SendNFT",
-			Handler:    _Msg_SendNFT_Handler,
-		},
-		{
-			MethodName: "OperatorSendNFT",
-			Handler:    _Msg_OperatorSendNFT_Handler,
-		},
-		{
-			MethodName: "AuthorizeOperator",
-			Handler:    _Msg_AuthorizeOperator_Handler,
-		},
-		{
-			MethodName: "RevokeOperator",
-			Handler:    _Msg_RevokeOperator_Handler,
-		},
-		{
-			MethodName: "CreateContract",
-			Handler:    _Msg_CreateContract_Handler,
-		},
-		{
-			MethodName: "IssueFT",
-			Handler:    _Msg_IssueFT_Handler,
-		},
-		{
-			MethodName: "IssueNFT",
-			Handler:    _Msg_IssueNFT_Handler,
-		},
-		{
-			MethodName: "MintFT",
-			Handler:    _Msg_MintFT_Handler,
-		},
-		{
-			MethodName: "MintNFT",
-			Handler:    _Msg_MintNFT_Handler,
-		},
-		{
-			MethodName: "BurnFT",
-			Handler:    _Msg_BurnFT_Handler,
-		},
-		{
-			MethodName: "OperatorBurnFT",
-			Handler:    _Msg_OperatorBurnFT_Handler,
-		},
-		{
-			MethodName: "BurnNFT",
-			Handler:    _Msg_BurnNFT_Handler,
-		},
-		{
-			MethodName: "OperatorBurnNFT",
-			Handler:    _Msg_OperatorBurnNFT_Handler,
-		},
-		{
-			MethodName: "Modify",
-			Handler:    _Msg_Modify_Handler,
-		},
-		{
-			MethodName: "GrantPermission",
-			Handler:    _Msg_GrantPermission_Handler,
-		},
-		{
-			MethodName: "RevokePermission",
-			Handler:    _Msg_RevokePermission_Handler,
-		},
-		{
-			MethodName: "Attach",
-			Handler:    _Msg_Attach_Handler,
-		},
-		{
-			MethodName: "Detach",
-			Handler:    _Msg_Detach_Handler,
-		},
-		{
-			MethodName: "OperatorAttach",
-			Handler:    _Msg_OperatorAttach_Handler,
-		},
-		{
-			MethodName: "OperatorDetach",
-			Handler:    _Msg_OperatorDetach_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "lbm/collection/v1/tx.proto",
+// MsgAcceptAdminResponse is the Msg/AcceptAdmin response type.
+type MsgAcceptAdminResponse struct {
 }
 
-func (m *MsgSendFT) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAcceptAdminResponse) Reset()         { *m = MsgAcceptAdminResponse{} }
+func (m *MsgAcceptAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptAdminResponse) ProtoMessage()    {}
+func (*MsgAcceptAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{58}
+}
+func (m *MsgAcceptAdminResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptAdminResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgAcceptAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptAdminResponse.Merge(m, src)
+}
+func (m *MsgAcceptAdminResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptAdminResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSendFT) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgAcceptAdminResponse proto.InternalMessageInfo
+
+// MsgRevokeGrant is the Msg/RevokeGrant request type.
+type MsgRevokeGrant struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// address of the admin of the contract.
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// address of the grantee whose permission would be revoked.
+	Grantee string `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// permission on the contract.
+	Permission string `protobuf:"bytes,4,opt,name=permission,proto3" json:"permission,omitempty"`
 }
 
-func (m *MsgSendFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+func (m *MsgRevokeGrant) Reset()         { *m = MsgRevokeGrant{} }
+func (m *MsgRevokeGrant) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeGrant) ProtoMessage()    {}
+func (*MsgRevokeGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{59}
+}
+func (m *MsgRevokeGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.To) > 0 {
-		i -= len(m.To)
-		copy(dAtA[i:], m.To)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ContractId) > 0 {
-		i -= len(m.ContractId)
-		copy(dAtA[i:], m.ContractId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgSendFTResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRevokeGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeGrant.Merge(m, src)
 }
-
-func (m *MsgSendFTResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevokeGrant) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgSendFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRevokeGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeGrant.DiscardUnknown(m)
 }
 
-func (m *MsgOperatorSendFT) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgRevokeGrant proto.InternalMessageInfo
 
-func (m *MsgOperatorSendFT) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevokeGrant) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
 }
 
-func (m *MsgOperatorSendFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.To) > 0 {
-		i -= len(m.To)
-		copy(dAtA[i:], m.To)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ContractId) > 0 {
-		i -= len(m.ContractId)
-		copy(dAtA[i:], m.ContractId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgRevokeGrant) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgOperatorSendFTResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevokeGrant) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgOperatorSendFTResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevokeGrant) GetPermission() string {
+	if m != nil {
+		return m.Permission
+	}
+	return ""
 }
 
-func (m *MsgOperatorSendFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgRevokeGrantResponse is the Msg/RevokeGrant response type.
+type MsgRevokeGrantResponse struct {
 }
 
-func (m *MsgSendNFT) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRevokeGrantResponse) Reset()         { *m = MsgRevokeGrantResponse{} }
+func (m *MsgRevokeGrantResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeGrantResponse) ProtoMessage()    {}
+func (*MsgRevokeGrantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{60}
 }
-
-func (m *MsgSendNFT) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevokeGrantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgSendNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenIds) > 0 {
-		for iNdEx := len(m.TokenIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.TokenIds[iNdEx])
-			copy(dAtA[i:], m.TokenIds[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.TokenIds[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+func (m *MsgRevokeGrantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeGrantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.To) > 0 {
-		i -= len(m.To)
-		copy(dAtA[i:], m.To)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ContractId) > 0 {
-		i -= len(m.ContractId)
-		copy(dAtA[i:], m.ContractId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgSendNFTResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgSendNFTResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevokeGrantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeGrantResponse.Merge(m, src)
 }
-
-func (m *MsgSendNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRevokeGrantResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgOperatorSendNFT) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRevokeGrantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeGrantResponse.DiscardUnknown(m)
 }
 
-func (m *MsgOperatorSendNFT) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_MsgRevokeGrantResponse proto.InternalMessageInfo
 
-func (m *MsgOperatorSendNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenIds) > 0 {
-		for iNdEx := len(m.TokenIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.TokenIds[iNdEx])
-			copy(dAtA[i:], m.TokenIds[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.TokenIds[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.To) > 0 {
-		i -= len(m.To)
-		copy(dAtA[i:], m.To)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ContractId) > 0 {
-		i -= len(m.ContractId)
-		copy(dAtA[i:], m.ContractId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterType((*MsgSendFT)(nil), "lbm.collection.v1.MsgSendFT")
+	proto.RegisterType((*MsgSendFTResponse)(nil), "lbm.collection.v1.MsgSendFTResponse")
+	proto.RegisterType((*MsgOperatorSendFT)(nil), "lbm.collection.v1.MsgOperatorSendFT")
+	proto.RegisterType((*MsgOperatorSendFTResponse)(nil), "lbm.collection.v1.MsgOperatorSendFTResponse")
+	proto.RegisterType((*MsgSendNFT)(nil), "lbm.collection.v1.MsgSendNFT")
+	proto.RegisterType((*MsgSendNFTResponse)(nil), "lbm.collection.v1.MsgSendNFTResponse")
+	proto.RegisterType((*MsgOperatorSendNFT)(nil), "lbm.collection.v1.MsgOperatorSendNFT")
+	proto.RegisterType((*MsgOperatorSendNFTResponse)(nil), "lbm.collection.v1.MsgOperatorSendNFTResponse")
+	proto.RegisterType((*MsgMultiSendFT)(nil), "lbm.collection.v1.MsgMultiSendFT")
+	proto.RegisterType((*FTOutput)(nil), "lbm.collection.v1.FTOutput")
+	proto.RegisterType((*MsgMultiSendFTResponse)(nil), "lbm.collection.v1.MsgMultiSendFTResponse")
+	proto.RegisterType((*MsgMultiSendNFT)(nil), "lbm.collection.v1.MsgMultiSendNFT")
+	proto.RegisterType((*NFTOutput)(nil), "lbm.collection.v1.NFTOutput")
+	proto.RegisterType((*MsgMultiSendNFTResponse)(nil), "lbm.collection.v1.MsgMultiSendNFTResponse")
+	proto.RegisterType((*MsgSellNFT)(nil), "lbm.collection.v1.MsgSellNFT")
+	proto.RegisterType((*MsgSellNFTResponse)(nil), "lbm.collection.v1.MsgSellNFTResponse")
+	proto.RegisterType((*MsgAuthorizeOperator)(nil), "lbm.collection.v1.MsgAuthorizeOperator")
+	proto.RegisterType((*MsgAuthorizeOperatorResponse)(nil), "lbm.collection.v1.MsgAuthorizeOperatorResponse")
+	proto.RegisterType((*MsgRevokeOperator)(nil), "lbm.collection.v1.MsgRevokeOperator")
+	proto.RegisterType((*MsgRevokeOperatorResponse)(nil), "lbm.collection.v1.MsgRevokeOperatorResponse")
+	proto.RegisterType((*MsgCreateContract)(nil), "lbm.collection.v1.MsgCreateContract")
+	proto.RegisterType((*MsgCreateContractResponse)(nil), "lbm.collection.v1.MsgCreateContractResponse")
+	proto.RegisterType((*MsgIssueFT)(nil), "lbm.collection.v1.MsgIssueFT")
+	proto.RegisterType((*MsgIssueFTResponse)(nil), "lbm.collection.v1.MsgIssueFTResponse")
+	proto.RegisterType((*MsgIssueNFT)(nil), "lbm.collection.v1.MsgIssueNFT")
+	proto.RegisterType((*MsgIssueNFTResponse)(nil), "lbm.collection.v1.MsgIssueNFTResponse")
+	proto.RegisterType((*MsgMintFT)(nil), "lbm.collection.v1.MsgMintFT")
+	proto.RegisterType((*MsgMintFTResponse)(nil), "lbm.collection.v1.MsgMintFTResponse")
+	proto.RegisterType((*MsgMintNFT)(nil), "lbm.collection.v1.MsgMintNFT")
+	proto.RegisterType((*MsgMintNFTResponse)(nil), "lbm.collection.v1.MsgMintNFTResponse")
+	proto.RegisterType((*MintNFTParam)(nil), "lbm.collection.v1.MintNFTParam")
+	proto.RegisterType((*MsgBurnFT)(nil), "lbm.collection.v1.MsgBurnFT")
+	proto.RegisterType((*MsgBurnFTResponse)(nil), "lbm.collection.v1.MsgBurnFTResponse")
+	proto.RegisterType((*MsgOperatorBurnFT)(nil), "lbm.collection.v1.MsgOperatorBurnFT")
+	proto.RegisterType((*MsgOperatorBurnFTResponse)(nil), "lbm.collection.v1.MsgOperatorBurnFTResponse")
+	proto.RegisterType((*MsgBurnNFT)(nil), "lbm.collection.v1.MsgBurnNFT")
+	proto.RegisterType((*MsgBurnNFTResponse)(nil), "lbm.collection.v1.MsgBurnNFTResponse")
+	proto.RegisterType((*MsgOperatorBurnNFT)(nil), "lbm.collection.v1.MsgOperatorBurnNFT")
+	proto.RegisterType((*MsgOperatorBurnNFTResponse)(nil), "lbm.collection.v1.MsgOperatorBurnNFTResponse")
+	proto.RegisterType((*MsgModify)(nil), "lbm.collection.v1.MsgModify")
+	proto.RegisterType((*MsgModifyResponse)(nil), "lbm.collection.v1.MsgModifyResponse")
+	proto.RegisterType((*MsgGrantPermission)(nil), "lbm.collection.v1.MsgGrantPermission")
+	proto.RegisterType((*MsgGrantPermissionResponse)(nil), "lbm.collection.v1.MsgGrantPermissionResponse")
+	proto.RegisterType((*MsgRevokePermission)(nil), "lbm.collection.v1.MsgRevokePermission")
+	proto.RegisterType((*MsgRevokePermissionResponse)(nil), "lbm.collection.v1.MsgRevokePermissionResponse")
+	proto.RegisterType((*MsgAttach)(nil), "lbm.collection.v1.MsgAttach")
+	proto.RegisterType((*MsgAttachResponse)(nil), "lbm.collection.v1.MsgAttachResponse")
+	proto.RegisterType((*MsgDetach)(nil), "lbm.collection.v1.MsgDetach")
+	proto.RegisterType((*MsgDetachResponse)(nil), "lbm.collection.v1.MsgDetachResponse")
+	proto.RegisterType((*MsgOperatorAttach)(nil), "lbm.collection.v1.MsgOperatorAttach")
+	proto.RegisterType((*MsgOperatorAttachResponse)(nil), "lbm.collection.v1.MsgOperatorAttachResponse")
+	proto.RegisterType((*MsgOperatorDetach)(nil), "lbm.collection.v1.MsgOperatorDetach")
+	proto.RegisterType((*MsgOperatorDetachResponse)(nil), "lbm.collection.v1.MsgOperatorDetachResponse")
+	proto.RegisterType((*MsgSetNFTHistoryEnabled)(nil), "lbm.collection.v1.MsgSetNFTHistoryEnabled")
+	proto.RegisterType((*MsgSetNFTHistoryEnabledResponse)(nil), "lbm.collection.v1.MsgSetNFTHistoryEnabledResponse")
+	proto.RegisterType((*MsgProposeAdmin)(nil), "lbm.collection.v1.MsgProposeAdmin")
+	proto.RegisterType((*MsgProposeAdminResponse)(nil), "lbm.collection.v1.MsgProposeAdminResponse")
+	proto.RegisterType((*MsgAcceptAdmin)(nil), "lbm.collection.v1.MsgAcceptAdmin")
+	proto.RegisterType((*MsgAcceptAdminResponse)(nil), "lbm.collection.v1.MsgAcceptAdminResponse")
+	proto.RegisterType((*MsgRevokeGrant)(nil), "lbm.collection.v1.MsgRevokeGrant")
+	proto.RegisterType((*MsgRevokeGrantResponse)(nil), "lbm.collection.v1.MsgRevokeGrantResponse")
 }
 
-func (m *MsgOperatorSendNFTResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() { proto.RegisterFile("lbm/collection/v1/tx.proto", fileDescriptor_eaee77977a3cfe12) }
+
+var fileDescriptor_eaee77977a3cfe12 = []byte{
+	// 1847 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0x4f, 0x6f, 0xdb, 0xc8,
+	0x15, 0x37, 0x2d, 0x4b, 0x96, 0x9f, 0x53, 0x27, 0x61, 0xdc, 0x44, 0xa6, 0x63, 0x29, 0x11, 0xec,
+	0x34, 0x31, 0x6c, 0x09, 0x76, 0x13, 0xa0, 0x40, 0xd3, 0x02, 0x76, 0x14, 0xb7, 0x6e, 0x63, 0x27,
+	0x55, 0x8c, 0x1c, 0x1a, 0x20, 0x06, 0x25, 0x8d, 0x25, 0xc6, 0x24, 0x47, 0x20, 0x47, 0x4e, 0xd4,
+	0x1e, 0x0a, 0x14, 0x05, 0x8a, 0xa2, 0x97, 0xa2, 0xd7, 0x3d, 0xec, 0x69, 0x81, 0xc5, 0x2e, 0xf6,
+	0x3b, 0xec, 0x31, 0xd8, 0x43, 0x90, 0xe3, 0x62, 0x0f, 0xd9, 0x85, 0x73, 0xdb, 0x2f, 0xb1, 0x8b,
+	0x19, 0x0e, 0xc7, 0x43, 0x8a, 0xa4, 0xe8, 0x7f, 0xb9, 0x89, 0xf3, 0xde, 0xbc, 0xf7, 0x7b, 0x7f,
+	0xe6, 0xcd, 0x7b, 0x03, 0x81, 0x66, 0x36, 0xac, 0x6a, 0x13, 0x9b, 0x26, 0x6a, 0x12, 0x03, 0xdb,
+	0xd5, 0x83, 0x95, 0x2a, 0x79, 0x5d, 0xe9, 0x3a, 0x98, 0x60, 0xf5, 0xb2, 0xd9, 0xb0, 0x2a, 0x47,
+	0xb4, 0xca, 0xc1, 0x8a, 0x36, 0xdd, 0xc6, 0x6d, 0xcc, 0xa8, 0x55, 0xfa, 0xcb, 0x63, 0xd4, 0x8a,
+	0x4d, 0xec, 0x5a, 0xd8, 0xad, 0x36, 0x74, 0x17, 0x55, 0x0f, 0x56, 0x1a, 0x88, 0xe8, 0x2b, 0xd5,
+	0x26, 0x36, 0x6c, 0x4e, 0x2f, 0x0f, 0x2a, 0x91, 0xc4, 0x32, 0x9e, 0xf2, 0xbf, 0x15, 0x98, 0xd8,
+	0x72, 0xdb, 0x4f, 0x91, 0xdd, 0xda, 0xd8, 0x51, 0x4b, 0x30, 0xd9, 0xc4, 0x36, 0x71, 0xf4, 0x26,
+	0xd9, 0x35, 0x5a, 0x05, 0xe5, 0x86, 0x72, 0x7b, 0xa2, 0x0e, 0xfe, 0xd2, 0x66, 0x4b, 0x55, 0x61,
+	0x6c, 0xcf, 0xc1, 0x56, 0x61, 0x94, 0x51, 0xd8, 0x6f, 0x75, 0x0a, 0x46, 0x09, 0x2e, 0x64, 0xd8,
+	0xca, 0x28, 0xc1, 0xea, 0x3d, 0xc8, 0xe9, 0x16, 0xee, 0xd9, 0xa4, 0x30, 0x76, 0x23, 0x73, 0x7b,
+	0x72, 0xf5, 0x5a, 0x65, 0xc0, 0xa0, 0xca, 0x03, 0x6c, 0xd8, 0xeb, 0x63, 0x6f, 0xde, 0x97, 0x46,
+	0xea, 0x9c, 0xb9, 0x7c, 0x05, 0x2e, 0x0b, 0x20, 0x75, 0xe4, 0x76, 0xb1, 0xed, 0xa2, 0xf2, 0x97,
+	0x0a, 0x5b, 0x7d, 0xdc, 0x45, 0x8e, 0x4e, 0xb0, 0x93, 0x16, 0xa6, 0x06, 0x79, 0xcc, 0xb7, 0x70,
+	0xa8, 0xe2, 0x5b, 0x98, 0x90, 0x19, 0x30, 0x61, 0x2c, 0xc2, 0x84, 0xec, 0x71, 0x4c, 0x98, 0x85,
+	0x99, 0x01, 0xb0, 0xc2, 0x14, 0x1b, 0x80, 0xdb, 0xb7, 0x7d, 0x56, 0x9e, 0x9e, 0x85, 0x09, 0x82,
+	0xf7, 0x91, 0xbd, 0x6b, 0xb4, 0x5c, 0xe6, 0xec, 0x89, 0x7a, 0x9e, 0x2d, 0x6c, 0xb6, 0xdc, 0xf2,
+	0x34, 0xa8, 0x47, 0xfa, 0x04, 0x8a, 0xff, 0x2b, 0xa0, 0x86, 0x30, 0x6e, 0x7f, 0x0c, 0x8f, 0x06,
+	0xa0, 0x66, 0x43, 0x50, 0xaf, 0x83, 0x36, 0x88, 0x49, 0x40, 0xfe, 0xa7, 0x02, 0x53, 0x5b, 0x6e,
+	0x7b, 0xab, 0x67, 0x12, 0xe3, 0x34, 0x79, 0xfa, 0x5b, 0x18, 0xc7, 0x3d, 0xd2, 0xed, 0x11, 0xb7,
+	0x90, 0x61, 0x51, 0x9d, 0x8d, 0x88, 0xea, 0xc6, 0xce, 0x63, 0xc6, 0xc3, 0x23, 0xeb, 0xef, 0x28,
+	0xff, 0x05, 0xf2, 0x3e, 0x89, 0xdb, 0xa6, 0x44, 0x64, 0xcb, 0xe8, 0x71, 0xb2, 0xa5, 0x00, 0x57,
+	0x83, 0x66, 0x09, 0x8b, 0xff, 0xa5, 0xc0, 0x45, 0x99, 0x74, 0xe2, 0x84, 0xb9, 0x1f, 0x36, 0xf9,
+	0x7a, 0x04, 0xb4, 0xed, 0x38, 0x9b, 0x7f, 0x03, 0x13, 0xdb, 0xb1, 0x46, 0x07, 0x02, 0x3a, 0x1a,
+	0x0a, 0xe8, 0x0c, 0x5c, 0x0b, 0xe1, 0x17, 0xb6, 0x7d, 0xa5, 0xf0, 0x73, 0x60, 0x9a, 0xa9, 0xcc,
+	0xba, 0x0a, 0x39, 0x17, 0x99, 0x26, 0xf2, 0xd3, 0x8e, 0x7f, 0xa9, 0xd3, 0x90, 0x6d, 0xf4, 0xfa,
+	0xc8, 0xe1, 0x59, 0xe7, 0x7d, 0xa8, 0x33, 0x90, 0xf7, 0x51, 0xf1, 0xe4, 0x1b, 0xe7, 0xa0, 0xd4,
+	0x7b, 0x90, 0xed, 0x3a, 0x46, 0x13, 0x15, 0xb2, 0x37, 0x94, 0xdb, 0x93, 0xab, 0x33, 0x15, 0xaf,
+	0x7a, 0x56, 0x68, 0xf5, 0xac, 0xf0, 0xea, 0x29, 0x87, 0xc9, 0xe3, 0x16, 0xc7, 0xc8, 0x34, 0x65,
+	0x2b, 0xf6, 0x61, 0x7a, 0xcb, 0x6d, 0xaf, 0xf5, 0x48, 0x07, 0x3b, 0xc6, 0xdf, 0x90, 0x9f, 0xba,
+	0xa9, 0xcc, 0xe9, 0x60, 0xb3, 0x75, 0x64, 0x8e, 0xf7, 0x15, 0x38, 0x5f, 0x99, 0xe0, 0xf9, 0x2a,
+	0x17, 0xe1, 0x7a, 0x94, 0x32, 0x01, 0xa6, 0xc3, 0x6a, 0x64, 0x1d, 0x1d, 0xe0, 0xfd, 0x73, 0x46,
+	0xe2, 0x15, 0xb8, 0xa0, 0x26, 0x01, 0xe3, 0x1b, 0xaf, 0x56, 0x3f, 0x70, 0x90, 0x4e, 0xd0, 0x03,
+	0xae, 0x88, 0xc6, 0x09, 0xbf, 0xb2, 0x91, 0xc3, 0x11, 0x78, 0x1f, 0x34, 0x59, 0x6d, 0xdd, 0x42,
+	0x7e, 0xb2, 0xd2, 0xdf, 0xea, 0x25, 0xc8, 0xf4, 0x1c, 0x83, 0xeb, 0xa4, 0x3f, 0x29, 0x97, 0x85,
+	0x88, 0xce, 0x23, 0xc9, 0x7e, 0xab, 0x08, 0x2e, 0x10, 0x47, 0x37, 0xc8, 0xae, 0xdb, 0xec, 0x20,
+	0x4b, 0xe7, 0x05, 0xba, 0x1c, 0x91, 0xd7, 0x3b, 0x94, 0xad, 0x86, 0xf6, 0x0c, 0xdb, 0xa0, 0x4b,
+	0xeb, 0x45, 0x1a, 0xd6, 0x1f, 0xdf, 0x97, 0xae, 0xca, 0xfb, 0x97, 0xb0, 0x65, 0x10, 0x64, 0x75,
+	0x49, 0xbf, 0x3e, 0xc9, 0xd6, 0x9f, 0xb2, 0xe5, 0xf2, 0x7d, 0x98, 0x19, 0xb0, 0xc5, 0xb7, 0x74,
+	0xa8, 0x6f, 0xcb, 0x3f, 0x79, 0x49, 0xbe, 0xe9, 0xba, 0x3d, 0x94, 0xf2, 0xec, 0x0e, 0xb8, 0xc3,
+	0x37, 0x3e, 0x23, 0x19, 0xaf, 0x41, 0xbe, 0x85, 0x9a, 0x86, 0xa5, 0x9b, 0x2e, 0x73, 0x4a, 0xb6,
+	0x2e, 0xbe, 0x29, 0xcd, 0x32, 0x6c, 0xa2, 0x37, 0x4c, 0x2f, 0xc5, 0xf3, 0x75, 0xf1, 0x7d, 0x14,
+	0x84, 0x9c, 0x1c, 0x04, 0xef, 0x48, 0x8f, 0x8b, 0x23, 0xfd, 0x27, 0x51, 0xc7, 0xf2, 0x74, 0x6d,
+	0x7d, 0x95, 0x3a, 0xec, 0xbb, 0xf7, 0xa5, 0xc5, 0xb6, 0x41, 0x3a, 0xbd, 0x46, 0xa5, 0x89, 0xad,
+	0xea, 0x86, 0x61, 0xbb, 0xcd, 0x8e, 0xa1, 0x57, 0xf7, 0xf8, 0x8f, 0x65, 0xb7, 0xb5, 0x5f, 0x25,
+	0xfd, 0x2e, 0x72, 0x2b, 0x9b, 0x36, 0x11, 0xc5, 0xad, 0x0a, 0xea, 0x91, 0x03, 0x84, 0xe3, 0xe4,
+	0xe3, 0xa9, 0x04, 0x8e, 0x67, 0xf9, 0x3f, 0xa3, 0x30, 0xe9, 0xef, 0xd8, 0x3e, 0x4b, 0x9f, 0x09,
+	0xdb, 0xc7, 0x64, 0xdb, 0xef, 0xc2, 0xb8, 0x83, 0xfb, 0xba, 0x49, 0xfa, 0xbc, 0x1e, 0x68, 0x11,
+	0x19, 0x54, 0xf7, 0x38, 0xea, 0x3e, 0xeb, 0x40, 0xf2, 0xe5, 0xce, 0x27, 0xf9, 0xee, 0xc2, 0x15,
+	0xc9, 0x15, 0xc2, 0x7b, 0x73, 0x00, 0x9e, 0xf7, 0xa8, 0xbb, 0xb9, 0x47, 0xbc, 0x22, 0xbc, 0xd3,
+	0xef, 0xa2, 0xf2, 0x5b, 0xaf, 0x95, 0xdb, 0x32, 0x6c, 0x72, 0x56, 0x0d, 0xc6, 0xef, 0xd3, 0xb6,
+	0x72, 0xbf, 0xa0, 0xe6, 0x7d, 0xf1, 0x7d, 0x29, 0x4b, 0xbf, 0x5c, 0x3f, 0x0b, 0xe8, 0xfd, 0x73,
+	0x80, 0x5c, 0x62, 0xd8, 0x6d, 0xee, 0xe5, 0x28, 0x57, 0x3d, 0xf3, 0x38, 0xa8, 0xed, 0xad, 0x9e,
+	0x89, 0xea, 0xfe, 0x16, 0xde, 0x11, 0x7a, 0xf6, 0x88, 0x2a, 0xf3, 0xd6, 0x3b, 0x5a, 0x74, 0xf5,
+	0xcc, 0xfa, 0xa8, 0xdf, 0x41, 0xae, 0xab, 0x3b, 0xba, 0xe5, 0x72, 0x33, 0x4b, 0x11, 0x28, 0xb9,
+	0xc2, 0x27, 0x94, 0xcf, 0xbf, 0xc8, 0xbd, 0x4d, 0xa7, 0xb4, 0x72, 0x85, 0x9d, 0x14, 0x2e, 0x5e,
+	0xc4, 0x3a, 0x70, 0xbd, 0x2a, 0xa1, 0xeb, 0xf5, 0x33, 0x05, 0x2e, 0xc8, 0x78, 0x86, 0x64, 0x46,
+	0xea, 0xa3, 0xb2, 0x0d, 0x39, 0x96, 0x86, 0xbe, 0x1f, 0xa2, 0xba, 0x85, 0x35, 0x42, 0x1c, 0xa3,
+	0xd1, 0x23, 0x68, 0xbd, 0xc0, 0x53, 0xfa, 0x92, 0xb7, 0x47, 0x4a, 0x66, 0x2e, 0xa5, 0xfc, 0x8a,
+	0x25, 0xe4, 0x7a, 0xcf, 0xb1, 0x4f, 0x1a, 0xa9, 0xa3, 0xd6, 0x2a, 0x73, 0xfc, 0x59, 0xc2, 0x53,
+	0x2c, 0x32, 0xe7, 0x93, 0xe0, 0x2c, 0x91, 0x16, 0xd6, 0x71, 0x3b, 0xdf, 0x13, 0x8e, 0x3f, 0xc1,
+	0xd9, 0x21, 0x04, 0xfd, 0x05, 0xcb, 0x79, 0xba, 0x78, 0xe2, 0x9c, 0x0f, 0x24, 0x54, 0x26, 0x72,
+	0x56, 0xe0, 0xf2, 0xe5, 0xc6, 0x5b, 0x0d, 0x61, 0x3a, 0x97, 0x59, 0x21, 0x71, 0x8c, 0x09, 0xce,
+	0x06, 0x61, 0x88, 0x5f, 0xf3, 0x9a, 0x87, 0x5b, 0xc6, 0x5e, 0x7f, 0x38, 0x32, 0x71, 0x17, 0x8c,
+	0xca, 0x77, 0x41, 0xf0, 0xf4, 0x64, 0xc2, 0xa7, 0xa7, 0x04, 0x93, 0x1c, 0x9e, 0xdd, 0x42, 0xaf,
+	0xf9, 0x35, 0xe2, 0xed, 0xd8, 0xa4, 0x2b, 0xf4, 0xfc, 0x37, 0x3b, 0xba, 0xdd, 0x46, 0x6e, 0x21,
+	0x9b, 0xe2, 0xdc, 0xf0, 0x2e, 0x9b, 0x6f, 0xf1, 0xab, 0x1c, 0xb3, 0x40, 0xd8, 0xd5, 0x67, 0x9e,
+	0xff, 0x83, 0xa3, 0xdb, 0xe4, 0x09, 0x72, 0x2c, 0xc3, 0x75, 0x0d, 0x6c, 0x9f, 0x4d, 0xb1, 0x2b,
+	0x02, 0x74, 0x85, 0x48, 0xdf, 0x9a, 0xa3, 0x15, 0xee, 0xf0, 0x90, 0x6a, 0x01, 0xec, 0x25, 0x5c,
+	0x11, 0x1d, 0xe0, 0x69, 0x91, 0x05, 0x91, 0x64, 0x06, 0x90, 0xcc, 0xc1, 0x6c, 0x84, 0x2e, 0x01,
+	0xe5, 0xef, 0x2c, 0xf4, 0x6b, 0x84, 0xe8, 0xcd, 0xce, 0xc9, 0x00, 0xc8, 0xed, 0x48, 0x26, 0x38,
+	0x2d, 0x14, 0x69, 0xd0, 0x77, 0x43, 0xb3, 0xc4, 0x04, 0xc1, 0x3b, 0xbc, 0x5d, 0xf1, 0xa2, 0xe6,
+	0x29, 0x17, 0x88, 0x9e, 0x33, 0x44, 0x35, 0x74, 0x1e, 0x88, 0xb8, 0xc6, 0x1a, 0x0a, 0x68, 0xfc,
+	0x34, 0x58, 0xd3, 0xd2, 0x3a, 0xe3, 0xb8, 0x27, 0x34, 0x61, 0xac, 0x0a, 0x39, 0x2a, 0x1b, 0x76,
+	0x54, 0xb0, 0xae, 0x85, 0x1c, 0xf6, 0x8f, 0x00, 0xfa, 0x1a, 0xfa, 0xd8, 0xe8, 0x43, 0xe8, 0x42,
+	0xce, 0xed, 0xb2, 0x29, 0xf6, 0x29, 0xa2, 0xf7, 0xec, 0x1f, 0x0d, 0x97, 0x60, 0xa7, 0xff, 0xd0,
+	0xa6, 0xfd, 0x74, 0xeb, 0x74, 0x18, 0x0b, 0x30, 0x8e, 0x3c, 0x39, 0x0c, 0x66, 0xbe, 0xee, 0x7f,
+	0x96, 0x6f, 0x42, 0x29, 0x46, 0xa3, 0x00, 0xf5, 0x8c, 0x3d, 0x0d, 0x3c, 0x71, 0x70, 0x17, 0xbb,
+	0x68, 0xad, 0x65, 0x19, 0x67, 0x53, 0x16, 0xf8, 0xc8, 0x2e, 0xcb, 0x15, 0x2a, 0x1f, 0xb2, 0xf7,
+	0x97, 0xb5, 0x66, 0x13, 0x75, 0xc9, 0xc9, 0x35, 0xf2, 0xf7, 0x0e, 0x49, 0x8c, 0x94, 0x06, 0x53,
+	0xe2, 0xa0, 0xb3, 0xc2, 0x73, 0x32, 0x93, 0x0a, 0x30, 0xde, 0xa6, 0xbb, 0x91, 0x5f, 0xc4, 0xfd,
+	0xcf, 0xa1, 0x35, 0xcf, 0x83, 0x26, 0x01, 0xf0, 0xa1, 0xad, 0xfe, 0xf7, 0x97, 0x90, 0xd9, 0x72,
+	0xdb, 0xea, 0x23, 0xc8, 0xf1, 0xb7, 0xa7, 0xa8, 0xe2, 0x2e, 0x1e, 0x2e, 0xb5, 0xf9, 0x24, 0xaa,
+	0x2f, 0x55, 0x6d, 0xc1, 0x54, 0xe8, 0x49, 0x33, 0x66, 0x5f, 0x90, 0x4b, 0x5b, 0x4a, 0xc3, 0x25,
+	0xb4, 0x3c, 0x86, 0x71, 0xff, 0xf5, 0x68, 0x2e, 0x1e, 0xd6, 0xf6, 0xc6, 0x8e, 0xb6, 0x90, 0x48,
+	0x16, 0x02, 0xdb, 0x70, 0x31, 0xfc, 0x70, 0xb8, 0x30, 0x1c, 0x11, 0x55, 0xb0, 0x9c, 0x8a, 0x4d,
+	0x28, 0x7a, 0x0e, 0x93, 0xf2, 0x73, 0xdf, 0xcd, 0xe8, 0xdd, 0x12, 0x8b, 0x76, 0x67, 0x28, 0x8b,
+	0x10, 0xfe, 0x02, 0x2e, 0x04, 0x5e, 0xd6, 0xca, 0x43, 0xb6, 0x52, 0xfc, 0x8b, 0xc3, 0x79, 0x82,
+	0x6e, 0x37, 0xcd, 0x44, 0xb7, 0x9b, 0x66, 0xa2, 0xdb, 0x03, 0x8f, 0x4d, 0xaa, 0x05, 0x97, 0x07,
+	0x5f, 0x9a, 0x7e, 0x15, 0xbd, 0x77, 0x80, 0x51, 0xab, 0xa6, 0x64, 0x94, 0x93, 0x33, 0xf4, 0x96,
+	0x14, 0x93, 0x9c, 0x41, 0x2e, 0x6d, 0x29, 0x0d, 0x97, 0xac, 0x25, 0xf4, 0x52, 0x14, 0xa3, 0x25,
+	0xc8, 0xa5, 0x2d, 0xa5, 0xe1, 0x92, 0x63, 0xe1, 0x3f, 0xc2, 0xc4, 0xc4, 0x82, 0x93, 0xb5, 0x85,
+	0x44, 0xb2, 0x10, 0x58, 0x87, 0xbc, 0x78, 0xa2, 0x28, 0x26, 0x6c, 0xa1, 0xe1, 0xbd, 0x95, 0x4c,
+	0x17, 0x32, 0x1f, 0x41, 0x8e, 0x0f, 0xed, 0x31, 0xb5, 0xc5, 0xa3, 0x6a, 0xf3, 0x49, 0x54, 0xd9,
+	0x64, 0x7f, 0x38, 0x9e, 0x8b, 0xdf, 0x90, 0x90, 0x7e, 0xe1, 0x51, 0xf4, 0x11, 0xe4, 0xf8, 0xac,
+	0x14, 0x03, 0xcf, 0xa3, 0x6a, 0xf3, 0x49, 0xd4, 0xa8, 0xd2, 0xc7, 0xa5, 0x0e, 0x29, 0x7d, 0x5c,
+	0xfa, 0x52, 0x1a, 0x2e, 0xd9, 0x09, 0xfe, 0xb8, 0x32, 0x17, 0x0f, 0x2b, 0xc1, 0x09, 0xa1, 0x41,
+	0x43, 0x2e, 0x7d, 0xbe, 0xe0, 0x85, 0xe1, 0x88, 0x52, 0x94, 0xbe, 0xb0, 0x22, 0x9a, 0x0c, 0xde,
+	0x34, 0x13, 0x97, 0x0c, 0x8c, 0xaa, 0xcd, 0x27, 0x51, 0x65, 0xd8, 0xe1, 0x21, 0x22, 0x06, 0x76,
+	0x88, 0x4d, 0x5b, 0x4e, 0xc5, 0x26, 0x14, 0xbd, 0x84, 0x4b, 0x03, 0x43, 0xc1, 0xad, 0xa4, 0x82,
+	0x20, 0xa9, 0xaa, 0xa4, 0xe3, 0x93, 0x5d, 0xc4, 0x1b, 0xdd, 0x18, 0x17, 0x79, 0x54, 0x6d, 0x3e,
+	0x89, 0x2a, 0x4b, 0xab, 0xa1, 0x24, 0x69, 0x35, 0x94, 0x24, 0x2d, 0xd8, 0x33, 0xca, 0xe9, 0xcd,
+	0x31, 0x0e, 0x49, 0x6f, 0x8e, 0x75, 0x29, 0x0d, 0x57, 0x94, 0x96, 0x1a, 0x4a, 0xa3, 0xa5, 0x86,
+	0xd2, 0x68, 0x09, 0xd9, 0x72, 0x00, 0xd3, 0x91, 0xcd, 0xef, 0x62, 0xdc, 0xb5, 0x35, 0xc8, 0xab,
+	0xad, 0xa6, 0xe7, 0x95, 0x2f, 0xe8, 0x40, 0x7f, 0x1b, 0x73, 0x41, 0xcb, 0x3c, 0xda, 0xe2, 0x70,
+	0x1e, 0xb9, 0xbb, 0x90, 0x9b, 0xd9, 0x98, 0xee, 0x42, 0x62, 0xd1, 0xee, 0x0c, 0x65, 0x91, 0x85,
+	0xcb, 0x8d, 0xec, 0xcd, 0xa4, 0xdc, 0x66, 0x2c, 0xda, 0x9d, 0xa1, 0x2c, 0xbe, 0xf0, 0xf5, 0x3f,
+	0x7f, 0x7e, 0x58, 0x1c, 0x79, 0x73, 0x58, 0x54, 0xde, 0x1d, 0x16, 0x95, 0x1f, 0x0e, 0x8b, 0xca,
+	0xff, 0x3e, 0x14, 0x47, 0xde, 0x7d, 0x28, 0x8e, 0x7c, 0xfb, 0xa1, 0x38, 0xf2, 0xd7, 0xe5, 0xa1,
+	0xef, 0xf4, 0xaf, 0xa5, 0x3f, 0x00, 0x34, 0x72, 0xec, 0x1f, 0x00, 0xbf, 0xfe, 0x79, 0x00, 0xc1,
+	0x5c, 0x41, 0x9f, 0x8c, 0x20, 0x00, 0x00,
 }
 
-func (m *MsgOperatorSendNFTResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// SendFT defines a method to send fungible tokens from one account to another account.
+	// Fires:
+	// - EventSent
+	// - transfer_ft (deprecated, not typed)
+	SendFT(ctx context.Context, in *MsgSendFT, opts ...grpc.CallOption) (*MsgSendFTResponse, error)
+	// OperatorSendFT defines a method to send fungible tokens from one account to another account by the operator.
+	// Fires:
+	// - EventSent
+	// - transfer_ft_from (deprecated, not typed)
+	OperatorSendFT(ctx context.Context, in *MsgOperatorSendFT, opts ...grpc.CallOption) (*MsgOperatorSendFTResponse, error)
+	// SendNFT defines a method to send non-fungible tokens from one account to another account.
+	// Fires:
+	// - EventSent
+	// - transfer_nft (deprecated, not typed)
+	// - operation_transfer_nft (deprecated, not typed)
+	SendNFT(ctx context.Context, in *MsgSendNFT, opts ...grpc.CallOption) (*MsgSendNFTResponse, error)
+	// OperatorSendNFT defines a method to send non-fungible tokens from one account to another account by the operator.
+	// Fires:
+	// - EventSent
+	// - transfer_nft_from (deprecated, not typed)
+	// - operation_transfer_nft (deprecated, not typed)
+	OperatorSendNFT(ctx context.Context, in *MsgOperatorSendNFT, opts ...grpc.CallOption) (*MsgOperatorSendNFTResponse, error)
+	// MultiSendFT defines a method to send fungible tokens from one account to multiple accounts.
+	// Fires:
+	// - EventSent (for each output)
+	// Note: the number of the outputs must not exceed `max_batch_size` of the params.
+	MultiSendFT(ctx context.Context, in *MsgMultiSendFT, opts ...grpc.CallOption) (*MsgMultiSendFTResponse, error)
+	// MultiSendNFT defines a method to send non-fungible tokens from one account to multiple accounts.
+	// Fires:
+	// - EventSent (for each output)
+	// Note: the number of the outputs must not exceed `max_batch_size` of the params.
+	MultiSendNFT(ctx context.Context, in *MsgMultiSendNFT, opts ...grpc.CallOption) (*MsgMultiSendNFTResponse, error)
+	// SellNFT defines a method to sell a non-fungible token in exchange for coins.
+	// The royalty of the token class, if any, is paid to its recipient out of the price.
+	// Fires:
+	// - EventSold
+	SellNFT(ctx context.Context, in *MsgSellNFT, opts ...grpc.CallOption) (*MsgSellNFTResponse, error)
+	// AuthorizeOperator allows one to send tokens on behalf of the holder.
+	// Fires:
+	// - EventAuthorizedOperator
+	// - approve_collection (deprecated, not typed)
+	AuthorizeOperator(ctx context.Context, in *MsgAuthorizeOperator, opts ...grpc.CallOption) (*MsgAuthorizeOperatorResponse, error)
+	// RevokeOperator revokes the authorization of the operator to send the holder's token.
+	// Fires:
+	// - EventRevokedOperator
+	// - disapprove_collection (deprecated, not typed)
+	RevokeOperator(ctx context.Context, in *MsgRevokeOperator, opts ...grpc.CallOption) (*MsgRevokeOperatorResponse, error)
+	// CreateContract defines a method to create a contract for collection.
+	// it grants `mint`, `burn`, `modify` and `issue` permissions on the contract to its creator.
+	// Fires:
+	// - EventCreatedContract
+	// - create_collection (deprecated, not typed)
+	CreateContract(ctx context.Context, in *MsgCreateContract, opts ...grpc.CallOption) (*MsgCreateContractResponse, error)
+	// IssueFT defines a method to create a class of fungible token.
+	// Fires:
+	// - EventCreatedFTClass
+	// - EventMintedFT
+	// - issue_ft (deprecated, not typed)
+	// Note: it does not grant any permissions to its issuer.
+	IssueFT(ctx context.Context, in *MsgIssueFT, opts ...grpc.CallOption) (*MsgIssueFTResponse, error)
+	// IssueNFT defines a method to create a class of non-fungible token.
+	// Fires:
+	// - EventCreatedNFTClass
+	// - issue_nft (deprecated, not typed)
+	// Note: it DOES grant `mint` and `burn` permissions to its issuer.
+	IssueNFT(ctx context.Context, in *MsgIssueNFT, opts ...grpc.CallOption) (*MsgIssueNFTResponse, error)
+	// MintFT defines a method to mint fungible tokens.
+	// Fires:
+	// - EventMintedFT
+	// - mint_ft (deprecated, not typed)
+	MintFT(ctx context.Context, in *MsgMintFT, opts ...grpc.CallOption) (*MsgMintFTResponse, error)
+	// MintNFT defines a method to mint non-fungible tokens.
+	// Fires:
+	// - EventMintedNFT
+	// - mint_nft (deprecated, not typed)
+	MintNFT(ctx context.Context, in *MsgMintNFT, opts ...grpc.CallOption) (*MsgMintNFTResponse, error)
+	// BurnFT defines a method to burn fungible tokens.
+	// Fires:
+	// - EventBurned
+	// - burn_ft (deprecated, not typed)
+	// - burn_nft (deprecated, not typed)
+	// - operation_burn_nft (deprecated, not typed)
+	BurnFT(ctx context.Context, in *MsgBurnFT, opts ...grpc.CallOption) (*MsgBurnFTResponse, error)
+	// OperatorBurnFT defines a method to burn fungible tokens of the holder by the operator.
+	// Fires:
+	// - EventBurned
+	// - burn_ft_from (deprecated, not typed)
+	// - burn_nft_from (deprecated, not typed)
+	// - operation_burn_nft (deprecated, not typed)
+	OperatorBurnFT(ctx context.Context, in *MsgOperatorBurnFT, opts ...grpc.CallOption) (*MsgOperatorBurnFTResponse, error)
+	// BurnNFT defines a method to burn non-fungible tokens.
+	// Fires:
+	// - EventBurned
+	// - burn_ft (deprecated, not typed)
+	// - burn_nft (deprecated, not typed)
+	// - operation_burn_nft (deprecated, not typed)
+	BurnNFT(ctx context.Context, in *MsgBurnNFT, opts ...grpc.CallOption) (*MsgBurnNFTResponse, error)
+	// OperatorBurnNFT defines a method to burn non-fungible tokens of the holder by the operator.
+	// Fires:
+	// - EventBurned
+	// - burn_ft_from (deprecated, not typed)
+	// - burn_nft_from (deprecated, not typed)
+	// - operation_burn_nft (deprecated, not typed)
+	OperatorBurnNFT(ctx context.Context, in *MsgOperatorBurnNFT, opts ...grpc.CallOption) (*MsgOperatorBurnNFTResponse, error)
+	// Modify defines a method to modify metadata.
+	// Fires:
+	// - EventModifiedContract
+	// - modify_collection (deprecated, not typed)
+	// - EventModifiedTokenClass
+	// - modify_token_type (deprecated, not typed)
+	// - modify_token (deprecated, not typed)
+	// - EventModifiedNFT
+	Modify(ctx context.Context, in *MsgModify, opts ...grpc.CallOption) (*MsgModifyResponse, error)
+	// GrantPermission allows one to mint or burn tokens or modify metadata.
+	// Fires:
+	// - EventGranted
+	// - grant_perm (deprecated, not typed)
+	GrantPermission(ctx context.Context, in *MsgGrantPermission, opts ...grpc.CallOption) (*MsgGrantPermissionResponse, error)
+	// RevokePermission abandons a permission.
+	// Fires:
+	// - EventRenounced
+	// - revoke_perm (deprecated, not typed)
+	RevokePermission(ctx context.Context, in *MsgRevokePermission, opts ...grpc.CallOption) (*MsgRevokePermissionResponse, error)
+	// Attach defines a method to attach a token to another token.
+	// Fires:
+	// - EventAttach
+	// - attach (deprecated, not typed)
+	// - operation_root_changed (deprecated, not typed)
+	Attach(ctx context.Context, in *MsgAttach, opts ...grpc.CallOption) (*MsgAttachResponse, error)
+	// Detach defines a method to detach a token from another token.
+	// Fires:
+	// - EventDetach
+	// - detach (deprecated, not typed)
+	// - operation_root_changed (deprecated, not typed)
+	Detach(ctx context.Context, in *MsgDetach, opts ...grpc.CallOption) (*MsgDetachResponse, error)
+	// OperatorAttach defines a method to attach a token to another token by operator.
+	// Fires:
+	// - EventAttach
+	// - attach_from (deprecated, not typed)
+	// - operation_root_changed (deprecated, not typed)
+	OperatorAttach(ctx context.Context, in *MsgOperatorAttach, opts ...grpc.CallOption) (*MsgOperatorAttachResponse, error)
+	// OperatorDetach defines a method to detach a token from another token by operator.
+	// Fires:
+	// - EventDetach
+	// - detach_from (deprecated, not typed)
+	// - operation_root_changed (deprecated, not typed)
+	OperatorDetach(ctx context.Context, in *MsgOperatorDetach, opts ...grpc.CallOption) (*MsgOperatorDetachResponse, error)
+	// SetNFTHistoryEnabled defines a method to enable or disable recording the history of the
+	// non-fungible tokens of the contract.
+	// Fires:
+	// - EventSetNFTHistoryEnabled
+	SetNFTHistoryEnabled(ctx context.Context, in *MsgSetNFTHistoryEnabled, opts ...grpc.CallOption) (*MsgSetNFTHistoryEnabledResponse, error)
+	// ProposeAdmin defines a method to propose the next admin of a contract.
+	// Fires:
+	// - EventProposedAdmin
+	ProposeAdmin(ctx context.Context, in *MsgProposeAdmin, opts ...grpc.CallOption) (*MsgProposeAdminResponse, error)
+	// AcceptAdmin defines a method to accept the admin role of a contract, which has been proposed.
+	// The grants of the previous admin are moved to the new admin.
+	// Fires:
+	// - EventAcceptedAdmin
+	// - EventRenounced
+	// - EventGranted
+	AcceptAdmin(ctx context.Context, in *MsgAcceptAdmin, opts ...grpc.CallOption) (*MsgAcceptAdminResponse, error)
+	// RevokeGrant defines a method for the admin to revoke a permission of a grantee.
+	// Fires:
+	// - EventRevokedPermission
+	RevokeGrant(ctx context.Context, in *MsgRevokeGrant, opts ...grpc.CallOption) (*MsgRevokeGrantResponse, error)
 }
 
-func (m *MsgOperatorSendNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgAuthorizeOperator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SendFT(ctx context.Context, in *MsgSendFT, opts ...grpc.CallOption) (*MsgSendFTResponse, error) {
+	out := new(MsgSendFTResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/SendFT", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgAuthorizeOperator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) OperatorSendFT(ctx context.Context, in *MsgOperatorSendFT, opts ...grpc.CallOption) (*MsgOperatorSendFTResponse, error) {
+	out := new(MsgOperatorSendFTResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/OperatorSendFT", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgAuthorizeOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Holder) > 0 {
-		i -= len(m.Holder)
-		copy(dAtA[i:], m.Holder)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Holder)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ContractId) > 0 {
-		i -= len(m.ContractId)
-		copy(dAtA[i:], m.ContractId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) SendNFT(ctx context.Context, in *MsgSendNFT, opts ...grpc.CallOption) (*MsgSendNFTResponse, error) {
+	out := new(MsgSendNFTResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/SendNFT", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgAuthorizeOperatorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) OperatorSendNFT(ctx context.Context, in *MsgOperatorSendNFT, opts ...grpc.CallOption) (*MsgOperatorSendNFTResponse, error) {
+	out := new(MsgOperatorSendNFTResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/OperatorSendNFT", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgAuthorizeOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MultiSendFT(ctx context.Context, in *MsgMultiSendFT, opts ...grpc.CallOption) (*MsgMultiSendFTResponse, error) {
+	out := new(MsgMultiSendFTResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/MultiSendFT", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgAuthorizeOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) MultiSendNFT(ctx context.Context, in *MsgMultiSendNFT, opts ...grpc.CallOption) (*MsgMultiSendNFTResponse, error) {
+	out := new(MsgMultiSendNFTResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/MultiSendNFT", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRevokeOperator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SellNFT(ctx context.Context, in *MsgSellNFT, opts ...grpc.CallOption) (*MsgSellNFTResponse, error) {
+	out := new(MsgSellNFTResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/SellNFT", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRevokeOperator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AuthorizeOperator(ctx context.Context, in *MsgAuthorizeOperator, opts ...grpc.CallOption) (*MsgAuthorizeOperatorResponse, error) {
+	out := new(MsgAuthorizeOperatorResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/AuthorizeOperator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRevokeOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Holder) > 0 {
-		i -= len(m.Holder)
-		copy(dAtA[i:], m.Holder)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Holder)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ContractId) > 0 {
-		i -= len(m.ContractId)
-		copy(dAtA[i:], m.ContractId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) RevokeOperator(ctx context.Context, in *MsgRevokeOperator, opts ...grpc.CallOption) (*MsgRevokeOperatorResponse, error) {
+	out := new(MsgRevokeOperatorResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/RevokeOperator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRevokeOperatorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateContract(ctx context.Context, in *MsgCreateContract, opts ...grpc.CallOption) (*MsgCreateContractResponse, error) {
+	out := new(MsgCreateContractResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/CreateContract", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRevokeOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) IssueFT(ctx context.Context, in *MsgIssueFT, opts ...grpc.CallOption) (*MsgIssueFTResponse, error) {
+	out := new(MsgIssueFTResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/IssueFT", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRevokeOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) IssueNFT(ctx context.Context, in *MsgIssueNFT, opts ...grpc.CallOption) (*MsgIssueNFTResponse, error) {
+	out := new(MsgIssueNFTResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/IssueNFT", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreateContract) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MintFT(ctx context.Context, in *MsgMintFT, opts ...grpc.CallOption) (*MsgMintFTResponse, error) {
+	out := new(MsgMintFTResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/MintFT", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCreateContract) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MintNFT(ctx context.Context, in *MsgMintNFT, opts ...grpc.CallOption) (*MsgMintNFTResponse, error) {
+	out := new(MsgMintNFTResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/MintNFT", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreateContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Meta) > 0 {
-		i -= len(m.Meta)
-		copy(dAtA[i:], m.Meta)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Meta)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Uri) > 0 {
-		i -= len(m.Uri)
-		copy(dAtA[i:], m.Uri)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Uri)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+func (c *msgClient) BurnFT(ctx context.Context, in *MsgBurnFT, opts ...grpc.CallOption) (*MsgBurnFTResponse, error) {
+	out := new(MsgBurnFTResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/BurnFT", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) OperatorBurnFT(ctx context.Context, in *MsgOperatorBurnFT, opts ...grpc.CallOption) (*MsgOperatorBurnFTResponse, error) {
+	out := new(MsgOperatorBurnFTResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/OperatorBurnFT", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCreateContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) BurnNFT(ctx context.Context, in *MsgBurnNFT, opts ...grpc.CallOption) (*MsgBurnNFTResponse, error) {
+	out := new(MsgBurnNFTResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/BurnNFT", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCreateContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) OperatorBurnNFT(ctx context.Context, in *MsgOperatorBurnNFT, opts ...grpc.CallOption) (*MsgOperatorBurnNFTResponse, error) {
+	out := new(MsgOperatorBurnNFTResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/OperatorBurnNFT", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreateContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractId) > 0 {
-		i -= len(m.ContractId)
-		copy(dAtA[i:], m.ContractId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) Modify(ctx context.Context, in *MsgModify, opts ...grpc.CallOption) (*MsgModifyResponse, error) {
+	out := new(MsgModifyResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/Modify", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgIssueFT) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) GrantPermission(ctx context.Context, in *MsgGrantPermission, opts ...grpc.CallOption) (*MsgGrantPermissionResponse, error) {
+	out := new(MsgGrantPermissionResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/GrantPermission", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgIssueFT) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RevokePermission(ctx context.Context, in *MsgRevokePermission, opts ...grpc.CallOption) (*MsgRevokePermissionResponse, error) {
+	out := new(MsgRevokePermissionResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/RevokePermission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgIssueFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x42
-	if len(m.To) > 0 {
-		i -= len(m.To)
-		copy(dAtA[i:], m.To)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Mintable {
-		i--
-		if m.Mintable {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Decimals != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Decimals))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Meta) > 0 {
-		i -= len(m.Meta)
-		copy(dAtA[i:], m.Meta)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Meta)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ContractId) > 0 {
-		i -= len(m.ContractId)
-		copy(dAtA[i:], m.ContractId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgIssueFTResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Attach(ctx context.Context, in *MsgAttach, opts ...grpc.CallOption) (*MsgAttachResponse, error) {
+	out := new(MsgAttachResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/Attach", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgIssueFTResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgIssueFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenId) > 0 {
-		i -= len(m.TokenId)
-		copy(dAtA[i:], m.TokenId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgIssueNFT) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Detach(ctx context.Context, in *MsgDetach, opts ...grpc.CallOption) (*MsgDetachResponse, error) {
+	out := new(MsgDetachResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/Detach", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgIssueNFT) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgIssueNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Meta) > 0 {
-		i -= len(m.Meta)
-		copy(dAtA[i:], m.Meta)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Meta)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ContractId) > 0 {
-		i -= len(m.ContractId)
-		copy(dAtA[i:], m.ContractId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgIssueNFTResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) OperatorAttach(ctx context.Context, in *MsgOperatorAttach, opts ...grpc.CallOption) (*MsgOperatorAttachResponse, error) {
+	out := new(MsgOperatorAttachResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/OperatorAttach", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgIssueNFTResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgIssueNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenType) > 0 {
-		i -= len(m.TokenType)
-		copy(dAtA[i:], m.TokenType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenType)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) OperatorDetach(ctx context.Context, in *MsgOperatorDetach, opts ...grpc.CallOption) (*MsgOperatorDetachResponse, error) {
+	out := new(MsgOperatorDetachResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/OperatorDetach", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgMintFT) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetNFTHistoryEnabled(ctx context.Context, in *MsgSetNFTHistoryEnabled, opts ...grpc.CallOption) (*MsgSetNFTHistoryEnabledResponse, error) {
+	out := new(MsgSetNFTHistoryEnabledResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/SetNFTHistoryEnabled", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgMintFT) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgMintFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.To) > 0 {
-		i -= len(m.To)
-		copy(dAtA[i:], m.To)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ContractId) > 0 {
-		i -= len(m.ContractId)
-		copy(dAtA[i:], m.ContractId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) ProposeAdmin(ctx context.Context, in *MsgProposeAdmin, opts ...grpc.CallOption) (*MsgProposeAdminResponse, error) {
+	out := new(MsgProposeAdminResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/ProposeAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgMintFTResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AcceptAdmin(ctx context.Context, in *MsgAcceptAdmin, opts ...grpc.CallOption) (*MsgAcceptAdminResponse, error) {
+	out := new(MsgAcceptAdminResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/AcceptAdmin", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgMintFTResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgMintFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgMintNFT) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RevokeGrant(ctx context.Context, in *MsgRevokeGrant, opts ...grpc.CallOption) (*MsgRevokeGrantResponse, error) {
+	out := new(MsgRevokeGrantResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/RevokeGrant", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgMintNFT) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// SendFT defines a method to send fungible tokens from one account to another account.
+	// Fires:
+	// - EventSent
+	// - transfer_ft (deprecated, not typed)
+	SendFT(context.Context, *MsgSendFT) (*MsgSendFTResponse, error)
+	// OperatorSendFT defines a method to send fungible tokens from one account to another account by the operator.
+	// Fires:
+	// - EventSent
+	// - transfer_ft_from (deprecated, not typed)
+	OperatorSendFT(context.Context, *MsgOperatorSendFT) (*MsgOperatorSendFTResponse, error)
+	// SendNFT defines a method to send non-fungible tokens from one account to another account.
+	// Fires:
+	// - EventSent
+	// - transfer_nft (deprecated, not typed)
+	// - operation_transfer_nft (deprecated, not typed)
+	SendNFT(context.Context, *MsgSendNFT) (*MsgSendNFTResponse, error)
+	// OperatorSendNFT defines a method to send non-fungible tokens from one account to another account by the operator.
+	// Fires:
+	// - EventSent
+	// - transfer_nft_from (deprecated, not typed)
+	// - operation_transfer_nft (deprecated, not typed)
+	OperatorSendNFT(context.Context, *MsgOperatorSendNFT) (*MsgOperatorSendNFTResponse, error)
+	// MultiSendFT defines a method to send fungible tokens from one account to multiple accounts.
+	// Fires:
+	// - EventSent (for each output)
+	// Note: the number of the outputs must not exceed `max_batch_size` of the params.
+	MultiSendFT(context.Context, *MsgMultiSendFT) (*MsgMultiSendFTResponse, error)
+	// MultiSendNFT defines a method to send non-fungible tokens from one account to multiple accounts.
+	// Fires:
+	// - EventSent (for each output)
+	// Note: the number of the outputs must not exceed `max_batch_size` of the params.
+	MultiSendNFT(context.Context, *MsgMultiSendNFT) (*MsgMultiSendNFTResponse, error)
+	// SellNFT defines a method to sell a non-fungible token in exchange for coins.
+	// The royalty of the token class, if any, is paid to its recipient out of the price.
+	// Fires:
+	// - EventSold
+	SellNFT(context.Context, *MsgSellNFT) (*MsgSellNFTResponse, error)
+	// AuthorizeOperator allows one to send tokens on behalf of the holder.
+	// Fires:
+	// - EventAuthorizedOperator
+	// - approve_collection (deprecated, not typed)
+	AuthorizeOperator(context.Context, *MsgAuthorizeOperator) (*MsgAuthorizeOperatorResponse, error)
+	// RevokeOperator revokes the authorization of the operator to send the holder's token.
+	// Fires:
+	// - EventRevokedOperator
+	// - disapprove_collection (deprecated, not typed)
+	RevokeOperator(context.Context, *MsgRevokeOperator) (*MsgRevokeOperatorResponse, error)
+	// CreateContract defines a method to create a contract for collection.
+	// it grants `mint`, `burn`, `modify` and `issue` permissions on the contract to its creator.
+	// Fires:
+	// - EventCreatedContract
+	// - create_collection (deprecated, not typed)
+	CreateContract(context.Context, *MsgCreateContract) (*MsgCreateContractResponse, error)
+	// IssueFT defines a method to create a class of fungible token.
+	// Fires:
+	// - EventCreatedFTClass
+	// - EventMintedFT
+	// - issue_ft (deprecated, not typed)
+	// Note: it does not grant any permissions to its issuer.
+	IssueFT(context.Context, *MsgIssueFT) (*MsgIssueFTResponse, error)
+	// IssueNFT defines a method to create a class of non-fungible token.
+	// Fires:
+	// - EventCreatedNFTClass
+	// - issue_nft (deprecated, not typed)
+	// Note: it DOES grant `mint` and `burn` permissions to its issuer.
+	IssueNFT(context.Context, *MsgIssueNFT) (*MsgIssueNFTResponse, error)
+	// MintFT defines a method to mint fungible tokens.
+	// Fires:
+	// - EventMintedFT
+	// - mint_ft (deprecated, not typed)
+	MintFT(context.Context, *MsgMintFT) (*MsgMintFTResponse, error)
+	// MintNFT defines a method to mint non-fungible tokens.
+	// Fires:
+	// - EventMintedNFT
+	// - mint_nft (deprecated, not typed)
+	MintNFT(context.Context, *MsgMintNFT) (*MsgMintNFTResponse, error)
+	// BurnFT defines a method to burn fungible tokens.
+	// Fires:
+	// - EventBurned
+	// - burn_ft (deprecated, not typed)
+	// - burn_nft (deprecated, not typed)
+	// - operation_burn_nft (deprecated, not typed)
+	BurnFT(context.Context, *MsgBurnFT) (*MsgBurnFTResponse, error)
+	// OperatorBurnFT defines a method to burn fungible tokens of the holder by the operator.
+	// Fires:
+	// - EventBurned
+	// - burn_ft_from (deprecated, not typed)
+	// - burn_nft_from (deprecated, not typed)
+	// - operation_burn_nft (deprecated, not typed)
+	OperatorBurnFT(context.Context, *MsgOperatorBurnFT) (*MsgOperatorBurnFTResponse, error)
+	// BurnNFT defines a method to burn non-fungible tokens.
+	// Fires:
+	// - EventBurned
+	// - burn_ft (deprecated, not typed)
+	// - burn_nft (deprecated, not typed)
+	// - operation_burn_nft (deprecated, not typed)
+	BurnNFT(context.Context, *MsgBurnNFT) (*MsgBurnNFTResponse, error)
+	// OperatorBurnNFT defines a method to burn non-fungible tokens of the holder by the operator.
+	// Fires:
+	// - EventBurned
+	// - burn_ft_from (deprecated, not typed)
+	// - burn_nft_from (deprecated, not typed)
+	// - operation_burn_nft (deprecated, not typed)
+	OperatorBurnNFT(context.Context, *MsgOperatorBurnNFT) (*MsgOperatorBurnNFTResponse, error)
+	// Modify defines a method to modify metadata.
+	// Fires:
+	// - EventModifiedContract
+	// - modify_collection (deprecated, not typed)
+	// - EventModifiedTokenClass
+	// - modify_token_type (deprecated, not typed)
+	// - modify_token (deprecated, not typed)
+	// - EventModifiedNFT
+	Modify(context.Context, *MsgModify) (*MsgModifyResponse, error)
+	// GrantPermission allows one to mint or burn tokens or modify metadata.
+	// Fires:
+	// - EventGranted
+	// - grant_perm (deprecated, not typed)
+	GrantPermission(context.Context, *MsgGrantPermission) (*MsgGrantPermissionResponse, error)
+	// RevokePermission abandons a permission.
+	// Fires:
+	// - EventRenounced
+	// - revoke_perm (deprecated, not typed)
+	RevokePermission(context.Context, *MsgRevokePermission) (*MsgRevokePermissionResponse, error)
+	// Attach defines a method to attach a token to another token.
+	// Fires:
+	// - EventAttach
+	// - attach (deprecated, not typed)
+	// - operation_root_changed (deprecated, not typed)
+	Attach(context.Context, *MsgAttach) (*MsgAttachResponse, error)
+	// Detach defines a method to detach a token from another token.
+	// Fires:
+	// - EventDetach
+	// - detach (deprecated, not typed)
+	// - operation_root_changed (deprecated, not typed)
+	Detach(context.Context, *MsgDetach) (*MsgDetachResponse, error)
+	// OperatorAttach defines a method to attach a token to another token by operator.
+	// Fires:
+	// - EventAttach
+	// - attach_from (deprecated, not typed)
+	// - operation_root_changed (deprecated, not typed)
+	OperatorAttach(context.Context, *MsgOperatorAttach) (*MsgOperatorAttachResponse, error)
+	// OperatorDetach defines a method to detach a token from another token by operator.
+	// Fires:
+	// - EventDetach
+	// - detach_from (deprecated, not typed)
+	// - operation_root_changed (deprecated, not typed)
+	OperatorDetach(context.Context, *MsgOperatorDetach) (*MsgOperatorDetachResponse, error)
+	// SetNFTHistoryEnabled defines a method to enable or disable recording the history of the
+	// non-fungible tokens of the contract.
+	// Fires:
+	// - EventSetNFTHistoryEnabled
+	SetNFTHistoryEnabled(context.Context, *MsgSetNFTHistoryEnabled) (*MsgSetNFTHistoryEnabledResponse, error)
+	// ProposeAdmin defines a method to propose the next admin of a contract.
+	// Fires:
+	// - EventProposedAdmin
+	ProposeAdmin(context.Context, *MsgProposeAdmin) (*MsgProposeAdminResponse, error)
+	// AcceptAdmin defines a method to accept the admin role of a contract, which has been proposed.
+	// The grants of the previous admin are moved to the new admin.
+	// Fires:
+	// - EventAcceptedAdmin
+	// - EventRenounced
+	// - EventGranted
+	AcceptAdmin(context.Context, *MsgAcceptAdmin) (*MsgAcceptAdminResponse, error)
+	// RevokeGrant defines a method for the admin to revoke a permission of a grantee.
+	// Fires:
+	// - EventRevokedPermission
+	RevokeGrant(context.Context, *MsgRevokeGrant) (*MsgRevokeGrantResponse, error)
 }
 
-func (m *MsgMintNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Params) > 0 {
-		for iNdEx := len(m.Params) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Params[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.To) > 0 {
-		i -= len(m.To)
-		copy(dAtA[i:], m.To)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ContractId) > 0 {
-		i -= len(m.ContractId)
-		copy(dAtA[i:], m.ContractId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgMintNFTResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) SendFT(ctx context.Context, req *MsgSendFT) (*MsgSendFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendFT not implemented")
 }
-
-func (m *MsgMintNFTResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) OperatorSendFT(ctx context.Context, req *MsgOperatorSendFT) (*MsgOperatorSendFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatorSendFT not implemented")
 }
-
-func (m *MsgMintNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenIds) > 0 {
-		for iNdEx := len(m.TokenIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.TokenIds[iNdEx])
-			copy(dAtA[i:], m.TokenIds[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.TokenIds[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) SendNFT(ctx context.Context, req *MsgSendNFT) (*MsgSendNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendNFT not implemented")
 }
-
-func (m *MintNFTParam) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) OperatorSendNFT(ctx context.Context, req *MsgOperatorSendNFT) (*MsgOperatorSendNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatorSendNFT not implemented")
 }
-
-func (m *MintNFTParam) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) MultiSendFT(ctx context.Context, req *MsgMultiSendFT) (*MsgMultiSendFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiSendFT not implemented")
 }
-
-func (m *MintNFTParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Meta) > 0 {
-		i -= len(m.Meta)
-		copy(dAtA[i:], m.Meta)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Meta)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.TokenType) > 0 {
-		i -= len(m.TokenType)
-		copy(dAtA[i:], m.TokenType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenType)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) MultiSendNFT(ctx context.Context, req *MsgMultiSendNFT) (*MsgMultiSendNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiSendNFT not implemented")
 }
-
-func (m *MsgBurnFT) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) SellNFT(ctx context.Context, req *MsgSellNFT) (*MsgSellNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SellNFT not implemented")
+}
+func (*UnimplementedMsgServer) AuthorizeOperator(ctx context.Context, req *MsgAuthorizeOperator) (*MsgAuthorizeOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizeOperator not implemented")
+}
+func (*UnimplementedMsgServer) RevokeOperator(ctx context.Context, req *MsgRevokeOperator) (*MsgRevokeOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeOperator not implemented")
+}
+func (*UnimplementedMsgServer) CreateContract(ctx context.Context, req *MsgCreateContract) (*MsgCreateContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateContract not implemented")
+}
+func (*UnimplementedMsgServer) IssueFT(ctx context.Context, req *MsgIssueFT) (*MsgIssueFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueFT not implemented")
+}
+func (*UnimplementedMsgServer) IssueNFT(ctx context.Context, req *MsgIssueNFT) (*MsgIssueNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueNFT not implemented")
+}
+func (*UnimplementedMsgServer) MintFT(ctx context.Context, req *MsgMintFT) (*MsgMintFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintFT not implemented")
+}
+func (*UnimplementedMsgServer) MintNFT(ctx context.Context, req *MsgMintNFT) (*MsgMintNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintNFT not implemented")
+}
+func (*UnimplementedMsgServer) BurnFT(ctx context.Context, req *MsgBurnFT) (*MsgBurnFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnFT not implemented")
+}
+func (*UnimplementedMsgServer) OperatorBurnFT(ctx context.Context, req *MsgOperatorBurnFT) (*MsgOperatorBurnFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatorBurnFT not implemented")
+}
+func (*UnimplementedMsgServer) BurnNFT(ctx context.Context, req *MsgBurnNFT) (*MsgBurnNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnNFT not implemented")
+}
+func (*UnimplementedMsgServer) OperatorBurnNFT(ctx context.Context, req *MsgOperatorBurnNFT) (*MsgOperatorBurnNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatorBurnNFT not implemented")
+}
+func (*UnimplementedMsgServer) Modify(ctx context.Context, req *MsgModify) (*MsgModifyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Modify not implemented")
+}
+func (*UnimplementedMsgServer) GrantPermission(ctx context.Context, req *MsgGrantPermission) (*MsgGrantPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantPermission not implemented")
+}
+func (*UnimplementedMsgServer) RevokePermission(ctx context.Context, req *MsgRevokePermission) (*MsgRevokePermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokePermission not implemented")
+}
+func (*UnimplementedMsgServer) Attach(ctx context.Context, req *MsgAttach) (*MsgAttachResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Attach not implemented")
+}
+func (*UnimplementedMsgServer) Detach(ctx context.Context, req *MsgDetach) (*MsgDetachResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Detach not implemented")
+}
+func (*UnimplementedMsgServer) OperatorAttach(ctx context.Context, req *MsgOperatorAttach) (*MsgOperatorAttachResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatorAttach not implemented")
+}
+func (*UnimplementedMsgServer) OperatorDetach(ctx context.Context, req *MsgOperatorDetach) (*MsgOperatorDetachResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatorDetach not implemented")
+}
+func (*UnimplementedMsgServer) SetNFTHistoryEnabled(ctx context.Context, req *MsgSetNFTHistoryEnabled) (*MsgSetNFTHistoryEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNFTHistoryEnabled not implemented")
+}
+func (*UnimplementedMsgServer) ProposeAdmin(ctx context.Context, req *MsgProposeAdmin) (*MsgProposeAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeAdmin not implemented")
+}
+func (*UnimplementedMsgServer) AcceptAdmin(ctx context.Context, req *MsgAcceptAdmin) (*MsgAcceptAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptAdmin not implemented")
+}
+func (*UnimplementedMsgServer) RevokeGrant(ctx context.Context, req *MsgRevokeGrant) (*MsgRevokeGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeGrant not implemented")
 }
 
-func (m *MsgBurnFT) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgBurnFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+func _Msg_SendFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendFT)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).SendFT(ctx, in)
 	}
-	if len(m.ContractId) > 0 {
-		i -= len(m.ContractId)
-		copy(dAtA[i:], m.ContractId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Msg/SendFT",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendFT(ctx, req.(*MsgSendFT))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBurnFTResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_OperatorSendFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOperatorSendFT)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgBurnFTResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgBurnFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).OperatorSendFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Msg/OperatorSendFT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OperatorSendFT(ctx, req.(*MsgOperatorSendFT))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgOperatorBurnFT) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SendNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendNFT)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgOperatorBurnFT) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).SendNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Msg/SendNFT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendNFT(ctx, req.(*MsgSendNFT))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgOperatorBurnFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+func _Msg_OperatorSendNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOperatorSendNFT)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).OperatorSendNFT(ctx, in)
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Msg/OperatorSendNFT",
 	}
-	if len(m.ContractId) > 0 {
-		i -= len(m.ContractId)
-		copy(dAtA[i:], m.ContractId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OperatorSendNFT(ctx, req.(*MsgOperatorSendNFT))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgOperatorBurnFTResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_MultiSendFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMultiSendFT)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgOperatorBurnFTResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).MultiSendFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Msg/MultiSendFT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MultiSendFT(ctx, req.(*MsgMultiSendFT))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgOperatorBurnFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_MultiSendNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMultiSendNFT)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MultiSendNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Msg/MultiSendNFT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MultiSendNFT(ctx, req.(*MsgMultiSendNFT))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBurnNFT) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SellNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSellNFT)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).SellNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Msg/SellNFT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SellNFT(ctx, req.(*MsgSellNFT))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBurnNFT) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_AuthorizeOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAuthorizeOperator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AuthorizeOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Msg/AuthorizeOperator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AuthorizeOperator(ctx, req.(*MsgAuthorizeOperator))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBurnNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenIds) > 0 {
-		for iNdEx := len(m.TokenIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.TokenIds[iNdEx])
-			copy(dAtA[i:], m.TokenIds[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.TokenIds[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+func _Msg_RevokeOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeOperator)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeOperator(ctx, in)
 	}
-	if len(m.ContractId) > 0 {
-		i -= len(m.ContractId)
-		copy(dAtA[i:], m.ContractId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Msg/RevokeOperator",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeOperator(ctx, req.(*MsgRevokeOperator))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBurnNFTResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CreateContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateContract)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).CreateContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Msg/CreateContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateContract(ctx, req.(*MsgCreateContract))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBurnNFTResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_IssueFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgIssueFT)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).IssueFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Msg/IssueFT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).IssueFT(ctx, req.(*MsgIssueFT))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBurnNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_IssueNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgIssueNFT)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).IssueNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Msg/IssueNFT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).IssueNFT(ctx, req.(*MsgIssueNFT))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgOperatorBurnNFT) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_MintFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMintFT)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).MintFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Msg/MintFT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MintFT(ctx, req.(*MsgMintFT))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgOperatorBurnNFT) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_MintNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMintNFT)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MintNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Msg/MintNFT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MintNFT(ctx, req.(*MsgMintNFT))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgOperatorBurnNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenIds) > 0 {
-		for iNdEx := len(m.TokenIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.TokenIds[iNdEx])
-			copy(dAtA[i:], m.TokenIds[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.TokenIds[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
+func _Msg_BurnFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBurnFT)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).BurnFT(ctx, in)
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Msg/BurnFT",
 	}
-	if len(m.ContractId) > 0 {
-		i -= len(m.ContractId)
-		copy(dAtA[i:], m.ContractId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BurnFT(ctx, req.(*MsgBurnFT))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgOperatorBurnNFTResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_OperatorBurnFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOperatorBurnFT)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).OperatorBurnFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Msg/OperatorBurnFT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OperatorBurnFT(ctx, req.(*MsgOperatorBurnFT))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgOperatorBurnNFTResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgOperatorBurnNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgModify) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_BurnNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBurnNFT)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgModify) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).BurnNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Msg/BurnNFT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BurnNFT(ctx, req.(*MsgBurnNFT))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgModify) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Changes) > 0 {
-		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.TokenIndex) > 0 {
-		i -= len(m.TokenIndex)
-		copy(dAtA[i:], m.TokenIndex)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenIndex)))
-		i--
-		dAtA[i] = 0x22
+func _Msg_OperatorBurnNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOperatorBurnNFT)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.TokenType) > 0 {
-		i -= len(m.TokenType)
-		copy(dAtA[i:], m.TokenType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenType)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).OperatorBurnNFT(ctx, in)
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Msg/OperatorBurnNFT",
 	}
-	if len(m.ContractId) > 0 {
-		i -= len(m.ContractId)
-		copy(dAtA[i:], m.ContractId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OperatorBurnNFT(ctx, req.(*MsgOperatorBurnNFT))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgModifyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_Modify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgModify)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).Modify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Msg/Modify",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Modify(ctx, req.(*MsgModify))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgModifyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_GrantPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantPermission)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Msg/GrantPermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantPermission(ctx, req.(*MsgGrantPermission))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgModifyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_RevokePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokePermission)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokePermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Msg/RevokePermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokePermission(ctx, req.(*MsgRevokePermission))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgGrantPermission) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_Attach_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAttach)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).Attach(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Msg/Attach",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Attach(ctx, req.(*MsgAttach))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgGrantPermission) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_Detach_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDetach)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Detach(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Msg/Detach",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Detach(ctx, req.(*MsgDetach))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgGrantPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Permission) > 0 {
-		i -= len(m.Permission)
-		copy(dAtA[i:], m.Permission)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Permission)))
-		i--
-		dAtA[i] = 0x22
+func _Msg_OperatorAttach_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOperatorAttach)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.To) > 0 {
-		i -= len(m.To)
-		copy(dAtA[i:], m.To)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).OperatorAttach(ctx, in)
 	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Msg/OperatorAttach",
 	}
-	if len(m.ContractId) > 0 {
-		i -= len(m.ContractId)
-		copy(dAtA[i:], m.ContractId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OperatorAttach(ctx, req.(*MsgOperatorAttach))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgGrantPermissionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_OperatorDetach_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOperatorDetach)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).OperatorDetach(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Msg/OperatorDetach",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OperatorDetach(ctx, req.(*MsgOperatorDetach))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgGrantPermissionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_SetNFTHistoryEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetNFTHistoryEnabled)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetNFTHistoryEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Msg/SetNFTHistoryEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetNFTHistoryEnabled(ctx, req.(*MsgSetNFTHistoryEnabled))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgGrantPermissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_ProposeAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Msg/ProposeAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeAdmin(ctx, req.(*MsgProposeAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRevokePermission) Marshal() (dAtA []byte, err error) {
+func _Msg_AcceptAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Msg/AcceptAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptAdmin(ctx, req.(*MsgAcceptAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeGrant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Msg/RevokeGrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeGrant(ctx, req.(*MsgRevokeGrant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lbm.collection.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SendFT",
+			Handler:    _Msg_SendFT_Handler,
+		},
+		{
+			MethodName: "OperatorSendFT",
+			Handler:    _Msg_OperatorSendFT_Handler,
+		},
+		{
+			MethodName: "SendNFT",
+			Handler:    _Msg_SendNFT_Handler,
+		},
+		{
+			MethodName: "OperatorSendNFT",
+			Handler:    _Msg_OperatorSendNFT_Handler,
+		},
+		{
+			MethodName: "MultiSendFT",
+			Handler:    _Msg_MultiSendFT_Handler,
+		},
+		{
+			MethodName: "MultiSendNFT",
+			Handler:    _Msg_MultiSendNFT_Handler,
+		},
+		{
+			MethodName: "SellNFT",
+			Handler:    _Msg_SellNFT_Handler,
+		},
+		{
+			MethodName: "AuthorizeOperator",
+			Handler:    _Msg_AuthorizeOperator_Handler,
+		},
+		{
+			MethodName: "RevokeOperator",
+			Handler:    _Msg_RevokeOperator_Handler,
+		},
+		{
+			MethodName: "CreateContract",
+			Handler:    _Msg_CreateContract_Handler,
+		},
+		{
+			MethodName: "IssueFT",
+			Handler:    _Msg_IssueFT_Handler,
+		},
+		{
+			MethodName: "IssueNFT",
+			Handler:    _Msg_IssueNFT_Handler,
+		},
+		{
+			MethodName: "MintFT",
+			Handler:    _Msg_MintFT_Handler,
+		},
+		{
+			MethodName: "MintNFT",
+			Handler:    _Msg_MintNFT_Handler,
+		},
+		{
+			MethodName: "BurnFT",
+			Handler:    _Msg_BurnFT_Handler,
+		},
+		{
+			MethodName: "OperatorBurnFT",
+			Handler:    _Msg_OperatorBurnFT_Handler,
+		},
+		{
+			MethodName: "BurnNFT",
+			Handler:    _Msg_BurnNFT_Handler,
+		},
+		{
+			MethodName: "OperatorBurnNFT",
+			Handler:    _Msg_OperatorBurnNFT_Handler,
+		},
+		{
+			MethodName: "Modify",
+			Handler:    _Msg_Modify_Handler,
+		},
+		{
+			MethodName: "GrantPermission",
+			Handler:    _Msg_GrantPermission_Handler,
+		},
+		{
+			MethodName: "RevokePermission",
+			Handler:    _Msg_RevokePermission_Handler,
+		},
+		{
+			MethodName: "Attach",
+			Handler:    _Msg_Attach_Handler,
+		},
+		{
+			MethodName: "Detach",
+			Handler:    _Msg_Detach_Handler,
+		},
+		{
+			MethodName: "OperatorAttach",
+			Handler:    _Msg_OperatorAttach_Handler,
+		},
+		{
+			MethodName: "OperatorDetach",
+			Handler:    _Msg_OperatorDetach_Handler,
+		},
+		{
+			MethodName: "SetNFTHistoryEnabled",
+			Handler:    _Msg_SetNFTHistoryEnabled_Handler,
+		},
+		{
+			MethodName: "ProposeAdmin",
+			Handler:    _Msg_ProposeAdmin_Handler,
+		},
+		{
+			MethodName: "AcceptAdmin",
+			Handler:    _Msg_AcceptAdmin_Handler,
+		},
+		{
+			MethodName: "RevokeGrant",
+			Handler:    _Msg_RevokeGrant_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lbm/collection/v1/tx.proto",
+}
+
+func (m *MsgSendFT) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5217,20 +5058,34 @@ func (m *MsgRevokePermission) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRevokePermission) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSendFT) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRevokePermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSendFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Permission) > 0 {
-		i -= len(m.Permission)
-		copy(dAtA[i:], m.Permission)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Permission)))
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -5251,7 +5106,7 @@ func (m *MsgRevokePermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRevokePermissionResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSendFTResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5261,12 +5116,12 @@ func (m *MsgRevokePermissionResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRevokePermissionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSendFTResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRevokePermissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSendFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5274,7 +5129,7 @@ func (m *MsgRevokePermissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAttach) Marshal() (dAtA []byte, err error) {
+func (m *MsgOperatorSendFT) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5284,35 +5139,49 @@ func (m *MsgAttach) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAttach) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgOperatorSendFT) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAttach) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgOperatorSendFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ToTokenId) > 0 {
-		i -= len(m.ToTokenId)
-		copy(dAtA[i:], m.ToTokenId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ToTokenId)))
-		i--
-		dAtA[i] = 0x22
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if len(m.TokenId) > 0 {
-		i -= len(m.TokenId)
-		copy(dAtA[i:], m.TokenId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenId)))
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x22
 	}
 	if len(m.From) > 0 {
 		i -= len(m.From)
 		copy(dAtA[i:], m.From)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
 		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
 		dAtA[i] = 0x12
 	}
 	if len(m.ContractId) > 0 {
@@ -5325,7 +5194,7 @@ func (m *MsgAttach) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAttachResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgOperatorSendFTResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5335,12 +5204,12 @@ func (m *MsgAttachResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAttachResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgOperatorSendFTResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAttachResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgOperatorSendFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5348,7 +5217,7 @@ func (m *MsgAttachResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDetach) Marshal() (dAtA []byte, err error) {
+func (m *MsgSendNFT) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5358,20 +5227,29 @@ func (m *MsgDetach) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDetach) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSendNFT) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDetach) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSendNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TokenId) > 0 {
-		i -= len(m.TokenId)
-		copy(dAtA[i:], m.TokenId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenId)))
+	if len(m.TokenIds) > 0 {
+		for iNdEx := len(m.TokenIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TokenIds[iNdEx])
+			copy(dAtA[i:], m.TokenIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TokenIds[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -5392,7 +5270,7 @@ func (m *MsgDetach) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDetachResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSendNFTResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5402,12 +5280,12 @@ func (m *MsgDetachResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDetachResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSendNFTResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDetachResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSendNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5415,7 +5293,7 @@ func (m *MsgDetachResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgOperatorAttach) Marshal() (dAtA []byte, err error) {
+func (m *MsgOperatorSendNFT) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5425,27 +5303,29 @@ func (m *MsgOperatorAttach) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgOperatorAttach) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgOperatorSendNFT) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgOperatorAttach) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgOperatorSendNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ToTokenId) > 0 {
-		i -= len(m.ToTokenId)
-		copy(dAtA[i:], m.ToTokenId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ToTokenId)))
-		i--
-		dAtA[i] = 0x2a
+	if len(m.TokenIds) > 0 {
+		for iNdEx := len(m.TokenIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TokenIds[iNdEx])
+			copy(dAtA[i:], m.TokenIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TokenIds[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if len(m.TokenId) > 0 {
-		i -= len(m.TokenId)
-		copy(dAtA[i:], m.TokenId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenId)))
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -5473,7 +5353,7 @@ func (m *MsgOperatorAttach) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgOperatorAttachResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgOperatorSendNFTResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5483,12 +5363,12 @@ func (m *MsgOperatorAttachResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgOperatorAttachResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgOperatorSendNFTResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgOperatorAttachResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgOperatorSendNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5496,7 +5376,7 @@ func (m *MsgOperatorAttachResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgOperatorDetach) Marshal() (dAtA []byte, err error) {
+func (m *MsgMultiSendFT) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5506,35 +5386,35 @@ func (m *MsgOperatorDetach) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgOperatorDetach) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgMultiSendFT) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgOperatorDetach) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgMultiSendFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TokenId) > 0 {
-		i -= len(m.TokenId)
-		copy(dAtA[i:], m.TokenId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenId)))
-		i--
-		dAtA[i] = 0x22
+	if len(m.Outputs) > 0 {
+		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
 	if len(m.From) > 0 {
 		i -= len(m.From)
 		copy(dAtA[i:], m.From)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
 		dAtA[i] = 0x12
 	}
 	if len(m.ContractId) > 0 {
@@ -5547,7 +5427,7 @@ func (m *MsgOperatorDetach) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgOperatorDetachResponse) Marshal() (dAtA []byte, err error) {
+func (m *FTOutput) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5557,850 +5437,4782 @@ func (m *MsgOperatorDetachResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgOperatorDetachResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *FTOutput) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgOperatorDetachResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FTOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgMultiSendFTResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSendFT) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+
+func (m *MsgMultiSendFTResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSendFTResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgMultiSendFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgOperatorSendFT) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMultiSendNFT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiSendNFT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiSendNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Outputs) > 0 {
+		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgOperatorSendFTResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *NFTOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSendNFT) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *NFTOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NFTOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
 	if len(m.TokenIds) > 0 {
-		for _, s := range m.TokenIds {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.TokenIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TokenIds[iNdEx])
+			copy(dAtA[i:], m.TokenIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TokenIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSendNFTResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMultiSendNFTResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiSendNFTResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiSendNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgOperatorSendNFT) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSellNFT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSellNFT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSellNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.TokenIds) > 0 {
-		for _, s := range m.TokenIds {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgOperatorSendNFTResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSellNFTResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSellNFTResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSellNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAuthorizeOperator) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAuthorizeOperator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAuthorizeOperator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAuthorizeOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Holder)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAuthorizeOperatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAuthorizeOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAuthorizeOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAuthorizeOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRevokeOperator) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRevokeOperator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeOperator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Holder)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRevokeOperatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRevokeOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCreateContract) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRevokeOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Uri)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Meta)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgCreateContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgIssueFT) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCreateContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Meta)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.TraitSchema) > 0 {
+		for iNdEx := len(m.TraitSchema) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TraitSchema[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if m.Decimals != 0 {
-		n += 1 + sovTx(uint64(m.Decimals))
+	if len(m.Meta) > 0 {
+		i -= len(m.Meta)
+		copy(dAtA[i:], m.Meta)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Meta)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Mintable {
-		n += 2
+	if len(m.Uri) > 0 {
+		i -= len(m.Uri)
+		copy(dAtA[i:], m.Uri)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Uri)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgIssueFTResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.TokenId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgCreateContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgIssueNFT) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Meta)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgCreateContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgIssueNFTResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCreateContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TokenType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMintFT) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+func (m *MsgIssueFT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgMintFTResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgIssueFT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgMintNFT) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgIssueFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x42
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if len(m.Params) > 0 {
-		for _, e := range m.Params {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if m.Mintable {
+		i--
+		if m.Mintable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
-}
-
-func (m *MsgMintNFTResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.TokenIds) > 0 {
-		for _, s := range m.TokenIds {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
-}
-
-func (m *MintNFTParam) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Decimals != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x20
 	}
-	var l int
-	_ = l
-	l = len(m.TokenType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Meta) > 0 {
+		i -= len(m.Meta)
+		copy(dAtA[i:], m.Meta)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Meta)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Meta)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgBurnFT) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgIssueFTResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgIssueFTResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIssueFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgBurnFTResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgIssueNFT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgOperatorBurnFT) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgIssueNFT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIssueNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.TraitSchema) > 0 {
+		for iNdEx := len(m.TraitSchema) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TraitSchema[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Royalty != nil {
+		{
+			size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Meta) > 0 {
+		i -= len(m.Meta)
+		copy(dAtA[i:], m.Meta)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Meta)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgOperatorBurnFTResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgIssueNFTResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgBurnNFT) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgIssueNFTResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIssueNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.TokenIds) > 0 {
-		for _, s := range m.TokenIds {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.TokenType) > 0 {
+		i -= len(m.TokenType)
+		copy(dAtA[i:], m.TokenType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenType)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgBurnNFTResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMintFT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgOperatorBurnNFT) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgMintFT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Vesting != nil {
+		{
+			size, err := m.Vesting.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.TokenIds) > 0 {
-		for _, s := range m.TokenIds {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgOperatorBurnNFTResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMintFTResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgModify) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TokenType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TokenIndex)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Changes) > 0 {
-		for _, e := range m.Changes {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+func (m *MsgMintFTResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgModifyResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgMintFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgGrantPermission) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMintNFT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMintNFT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Vesting != nil {
+		{
+			size, err := m.Vesting.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Params) > 0 {
+		for iNdEx := len(m.Params) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Params[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Permission)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgGrantPermissionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMintNFTResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRevokePermission) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgMintNFTResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Permission)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.TokenIds) > 0 {
+		for iNdEx := len(m.TokenIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TokenIds[iNdEx])
+			copy(dAtA[i:], m.TokenIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TokenIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRevokePermissionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MintNFTParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAttach) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MintNFTParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintNFTParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Traits) > 0 {
+		for iNdEx := len(m.Traits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Traits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Meta) > 0 {
+		i -= len(m.Meta)
+		copy(dAtA[i:], m.Meta)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Meta)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.TokenId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ToTokenId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.TokenType) > 0 {
+		i -= len(m.TokenType)
+		copy(dAtA[i:], m.TokenType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenType)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAttachResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgBurnFT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDetach) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgBurnFT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.TokenId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDetachResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgBurnFTResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnFTResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgOperatorAttach) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgOperatorBurnFT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOperatorBurnFT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOperatorBurnFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.TokenId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ToTokenId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgOperatorAttachResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgOperatorBurnFTResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOperatorBurnFTResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOperatorBurnFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgOperatorDetach) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgBurnNFT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnNFT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.TokenIds) > 0 {
+		for iNdEx := len(m.TokenIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TokenIds[iNdEx])
+			copy(dAtA[i:], m.TokenIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TokenIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.TokenId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurnNFTResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnNFTResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOperatorBurnNFT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOperatorBurnNFT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOperatorBurnNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenIds) > 0 {
+		for iNdEx := len(m.TokenIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TokenIds[iNdEx])
+			copy(dAtA[i:], m.TokenIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TokenIds[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOperatorBurnNFTResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOperatorBurnNFTResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOperatorBurnNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgModify) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgModify) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModify) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TokenIndex) > 0 {
+		i -= len(m.TokenIndex)
+		copy(dAtA[i:], m.TokenIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenIndex)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TokenType) > 0 {
+		i -= len(m.TokenType)
+		copy(dAtA[i:], m.TokenType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgModifyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgModifyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModifyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantPermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantPermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Permission) > 0 {
+		i -= len(m.Permission)
+		copy(dAtA[i:], m.Permission)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Permission)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantPermissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantPermissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantPermissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokePermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokePermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokePermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Permission) > 0 {
+		i -= len(m.Permission)
+		copy(dAtA[i:], m.Permission)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Permission)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokePermissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokePermissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokePermissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAttach) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAttach) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAttach) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToTokenId) > 0 {
+		i -= len(m.ToTokenId)
+		copy(dAtA[i:], m.ToTokenId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToTokenId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAttachResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAttachResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAttachResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDetach) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDetach) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDetach) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDetachResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDetachResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDetachResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOperatorAttach) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOperatorAttach) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOperatorAttach) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToTokenId) > 0 {
+		i -= len(m.ToTokenId)
+		copy(dAtA[i:], m.ToTokenId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToTokenId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOperatorAttachResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOperatorAttachResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOperatorAttachResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOperatorDetach) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOperatorDetach) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOperatorDetach) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOperatorDetachResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOperatorDetachResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOperatorDetachResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetNFTHistoryEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetNFTHistoryEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetNFTHistoryEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetNFTHistoryEnabledResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetNFTHistoryEnabledResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetNFTHistoryEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeAdmin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeAdmin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptAdmin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptAdmin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Permission) > 0 {
+		i -= len(m.Permission)
+		copy(dAtA[i:], m.Permission)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Permission)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeGrantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeGrantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeGrantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSendFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSendFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgOperatorSendFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgOperatorSendFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSendNFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.TokenIds) > 0 {
+		for _, s := range m.TokenIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSendNFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgOperatorSendNFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.TokenIds) > 0 {
+		for _, s := range m.TokenIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgOperatorSendNFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMultiSendFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Outputs) > 0 {
+		for _, e := range m.Outputs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FTOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMultiSendFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMultiSendNFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Outputs) > 0 {
+		for _, e := range m.Outputs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NFTOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.TokenIds) > 0 {
+		for _, s := range m.TokenIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMultiSendNFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSellNFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSellNFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAuthorizeOperator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAuthorizeOperatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeOperator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeOperatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Meta)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.TraitSchema) > 0 {
+		for _, e := range m.TraitSchema {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgIssueFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Meta)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovTx(uint64(m.Decimals))
+	}
+	if m.Mintable {
+		n += 2
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgIssueFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgIssueNFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Meta)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Royalty != nil {
+		l = m.Royalty.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.TraitSchema) > 0 {
+		for _, e := range m.TraitSchema {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgIssueNFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMintFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Vesting != nil {
+		l = m.Vesting.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMintFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMintNFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Params) > 0 {
+		for _, e := range m.Params {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Vesting != nil {
+		l = m.Vesting.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMintNFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TokenIds) > 0 {
+		for _, s := range m.TokenIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MintNFTParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Meta)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Traits) > 0 {
+		for _, e := range m.Traits {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBurnFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBurnFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgOperatorBurnFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgOperatorBurnFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBurnNFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.TokenIds) > 0 {
+		for _, s := range m.TokenIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBurnNFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgOperatorBurnNFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.TokenIds) > 0 {
+		for _, s := range m.TokenIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgOperatorBurnNFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgModify) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgModifyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGrantPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Permission)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGrantPermissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokePermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Permission)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokePermissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAttach) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToTokenId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAttachResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDetach) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDetachResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgOperatorAttach) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToTokenId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgOperatorAttachResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgOperatorDetach) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgOperatorDetachResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetNFTHistoryEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetNFTHistoryEnabledResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProposeAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProposeAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Permission)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeGrantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSendFT) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendFT: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendFT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendFTResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendFTResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOperatorSendFT) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOperatorSendFT: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOperatorSendFT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOperatorSendFTResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOperatorSendFTResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOperatorSendFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendNFT) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendNFT: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIds = append(m.TokenIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendNFTResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendNFTResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOperatorSendNFT) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOperatorSendNFT: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOperatorSendNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIds = append(m.TokenIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOperatorSendNFTResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOperatorSendNFTResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOperatorSendNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiSendFT) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiSendFT: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiSendFT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outputs = append(m.Outputs, FTOutput{})
+			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FTOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FTOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FTOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiSendFTResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiSendFTResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiSendFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiSendNFT) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiSendNFT: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiSendNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outputs = append(m.Outputs, NFTOutput{})
+			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NFTOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NFTOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NFTOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIds = append(m.TokenIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgOperatorDetachResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgMultiSendNFTResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiSendNFTResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiSendNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgSendFT) Unmarshal(dAtA []byte) error {
+func (m *MsgSellNFT) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6423,10 +10235,10 @@ func (m *MsgSendFT) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendFT: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSellNFT: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendFT: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSellNFT: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6463,7 +10275,7 @@ func (m *MsgSendFT) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6491,11 +10303,11 @@ func (m *MsgSendFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.Seller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6523,11 +10335,43 @@ func (m *MsgSendFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			m.Buyer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6554,8 +10398,7 @@ func (m *MsgSendFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6580,7 +10423,7 @@ func (m *MsgSendFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendFTResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSellNFTResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6603,10 +10446,10 @@ func (m *MsgSendFTResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendFTResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSellNFTResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSellNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6630,7 +10473,7 @@ func (m *MsgSendFTResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgOperatorSendFT) Unmarshal(dAtA []byte) error {
+func (m *MsgAuthorizeOperator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6653,10 +10496,10 @@ func (m *MsgOperatorSendFT) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOperatorSendFT: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAuthorizeOperator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOperatorSendFT: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAuthorizeOperator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6692,6 +10535,38 @@ func (m *MsgOperatorSendFT) Unmarshal(dAtA []byte) error {
 			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
@@ -6723,9 +10598,109 @@ func (m *MsgOperatorSendFT) Unmarshal(dAtA []byte) error {
 			}
 			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAuthorizeOperatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAuthorizeOperatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAuthorizeOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeOperator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeOperator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeOperator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6753,11 +10728,11 @@ func (m *MsgOperatorSendFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6785,13 +10760,13 @@ func (m *MsgOperatorSendFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			m.Holder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6801,25 +10776,23 @@ func (m *MsgOperatorSendFT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6842,7 +10815,7 @@ func (m *MsgOperatorSendFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgOperatorSendFTResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeOperatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6865,10 +10838,10 @@ func (m *MsgOperatorSendFTResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOperatorSendFTResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeOperatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOperatorSendFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6892,7 +10865,7 @@ func (m *MsgOperatorSendFTResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendNFT) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6915,15 +10888,15 @@ func (m *MsgSendNFT) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendNFT: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6951,11 +10924,11 @@ func (m *MsgSendNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractId = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6983,11 +10956,11 @@ func (m *MsgSendNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7015,11 +10988,11 @@ func (m *MsgSendNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			m.Uri = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7047,7 +11020,41 @@ func (m *MsgSendNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenIds = append(m.TokenIds, string(dAtA[iNdEx:postIndex]))
+			m.Meta = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraitSchema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraitSchema = append(m.TraitSchema, TraitDefinition{})
+			if err := m.TraitSchema[len(m.TraitSchema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7070,7 +11077,7 @@ func (m *MsgSendNFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendNFTResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7093,12 +11100,44 @@ func (m *MsgSendNFTResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendNFTResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7120,7 +11159,7 @@ func (m *MsgSendNFTResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgOperatorSendNFT) Unmarshal(dAtA []byte) error {
+func (m *MsgIssueFT) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7143,10 +11182,10 @@ func (m *MsgOperatorSendNFT) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOperatorSendNFT: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgIssueFT: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOperatorSendNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgIssueFT: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7183,7 +11222,7 @@ func (m *MsgOperatorSendNFT) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7211,11 +11250,11 @@ func (m *MsgOperatorSendNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7243,9 +11282,80 @@ func (m *MsgOperatorSendNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.Meta = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mintable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Mintable = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
@@ -7277,9 +11387,9 @@ func (m *MsgOperatorSendNFT) Unmarshal(dAtA []byte) error {
 			}
 			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7307,7 +11417,9 @@ func (m *MsgOperatorSendNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenIds = append(m.TokenIds, string(dAtA[iNdEx:postIndex]))
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7330,7 +11442,7 @@ func (m *MsgOperatorSendNFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgOperatorSendNFTResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgIssueFTResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7340,25 +11452,57 @@ func (m *MsgOperatorSendNFTResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTx
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIssueFTResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIssueFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOperatorSendNFTResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOperatorSendNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.TokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7380,7 +11524,7 @@ func (m *MsgOperatorSendNFTResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAuthorizeOperator) Unmarshal(dAtA []byte) error {
+func (m *MsgIssueNFT) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7403,10 +11547,10 @@ func (m *MsgAuthorizeOperator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAuthorizeOperator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgIssueNFT: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAuthorizeOperator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgIssueNFT: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7443,7 +11587,7 @@ func (m *MsgAuthorizeOperator) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7471,11 +11615,11 @@ func (m *MsgAuthorizeOperator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holder = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7503,7 +11647,109 @@ func (m *MsgAuthorizeOperator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Meta = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Royalty == nil {
+				m.Royalty = &Royalty{}
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraitSchema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraitSchema = append(m.TraitSchema, TraitDefinition{})
+			if err := m.TraitSchema[len(m.TraitSchema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7526,7 +11772,7 @@ func (m *MsgAuthorizeOperator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAuthorizeOperatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgIssueNFTResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7549,12 +11795,44 @@ func (m *MsgAuthorizeOperatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAuthorizeOperatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgIssueNFTResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAuthorizeOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgIssueNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7576,7 +11854,7 @@ func (m *MsgAuthorizeOperatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokeOperator) Unmarshal(dAtA []byte) error {
+func (m *MsgMintFT) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7599,10 +11877,10 @@ func (m *MsgRevokeOperator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokeOperator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMintFT: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokeOperator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMintFT: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7639,7 +11917,7 @@ func (m *MsgRevokeOperator) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7667,11 +11945,11 @@ func (m *MsgRevokeOperator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holder = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7699,7 +11977,77 @@ func (m *MsgRevokeOperator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Vesting == nil {
+				m.Vesting = &VestingSchedule{}
+			}
+			if err := m.Vesting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7722,7 +12070,7 @@ func (m *MsgRevokeOperator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokeOperatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMintFTResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7745,10 +12093,10 @@ func (m *MsgRevokeOperatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokeOperatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMintFTResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokeOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMintFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7772,7 +12120,7 @@ func (m *MsgRevokeOperatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateContract) Unmarshal(dAtA []byte) error {
+func (m *MsgMintNFT) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7795,15 +12143,15 @@ func (m *MsgCreateContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMintNFT: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMintNFT: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7831,11 +12179,11 @@ func (m *MsgCreateContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7863,11 +12211,11 @@ func (m *MsgCreateContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7895,13 +12243,13 @@ func (m *MsgCreateContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uri = string(dAtA[iNdEx:postIndex])
+			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7911,23 +12259,61 @@ func (m *MsgCreateContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Meta = string(dAtA[iNdEx:postIndex])
+			m.Params = append(m.Params, MintNFTParam{})
+			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Vesting == nil {
+				m.Vesting = &VestingSchedule{}
+			}
+			if err := m.Vesting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7950,7 +12336,7 @@ func (m *MsgCreateContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMintNFTResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7973,15 +12359,15 @@ func (m *MsgCreateContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMintNFTResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMintNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8009,7 +12395,7 @@ func (m *MsgCreateContractResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractId = string(dAtA[iNdEx:postIndex])
+			m.TokenIds = append(m.TokenIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8032,7 +12418,7 @@ func (m *MsgCreateContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgIssueFT) Unmarshal(dAtA []byte) error {
+func (m *MintNFTParam) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8055,15 +12441,15 @@ func (m *MsgIssueFT) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgIssueFT: wiretype end group for non-group")
+			return fmt.Errorf("proto: MintNFTParam: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgIssueFT: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MintNFTParam: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8091,7 +12477,7 @@ func (m *MsgIssueFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractId = string(dAtA[iNdEx:postIndex])
+			m.TokenType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -8158,10 +12544,10 @@ func (m *MsgIssueFT) Unmarshal(dAtA []byte) error {
 			m.Meta = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Traits", wireType)
 			}
-			m.Decimals = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8171,34 +12557,79 @@ func (m *MsgIssueFT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Decimals |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mintable", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthTx
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.Mintable = bool(v != 0)
-		case 6:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Traits = append(m.Traits, Attribute{})
+			if err := m.Traits[len(m.Traits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnFT) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnFT: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnFT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8226,11 +12657,11 @@ func (m *MsgIssueFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8258,13 +12689,13 @@ func (m *MsgIssueFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8274,23 +12705,23 @@ func (m *MsgIssueFT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8315,7 +12746,7 @@ func (m *MsgIssueFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgIssueFTResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBurnFTResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8338,44 +12769,12 @@ func (m *MsgIssueFTResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgIssueFTResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBurnFTResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgIssueFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBurnFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8397,7 +12796,7 @@ func (m *MsgIssueFTResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgIssueNFT) Unmarshal(dAtA []byte) error {
+func (m *MsgOperatorBurnFT) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8420,10 +12819,10 @@ func (m *MsgIssueNFT) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgIssueNFT: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgOperatorBurnFT: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgIssueNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgOperatorBurnFT: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8460,7 +12859,7 @@ func (m *MsgIssueNFT) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8488,11 +12887,11 @@ func (m *MsgIssueNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8520,13 +12919,13 @@ func (m *MsgIssueNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Meta = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8536,23 +12935,25 @@ func (m *MsgIssueNFT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8575,7 +12976,7 @@ func (m *MsgIssueNFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgIssueNFTResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgOperatorBurnFTResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8598,44 +12999,12 @@ func (m *MsgIssueNFTResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgIssueNFTResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgOperatorBurnFTResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgIssueNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgOperatorBurnFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8657,7 +13026,7 @@ func (m *MsgIssueNFTResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintFT) Unmarshal(dAtA []byte) error {
+func (m *MsgBurnNFT) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8680,10 +13049,10 @@ func (m *MsgMintFT) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintFT: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBurnNFT: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintFT: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBurnNFT: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8752,7 +13121,7 @@ func (m *MsgMintFT) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8780,41 +13149,7 @@ func (m *MsgMintFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = append(m.Amount, Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenIds = append(m.TokenIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8837,7 +13172,7 @@ func (m *MsgMintFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintFTResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBurnNFTResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8860,10 +13195,10 @@ func (m *MsgMintFTResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintFTResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBurnNFTResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBurnNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8887,7 +13222,7 @@ func (m *MsgMintFTResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintNFT) Unmarshal(dAtA []byte) error {
+func (m *MsgOperatorBurnNFT) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8910,10 +13245,10 @@ func (m *MsgMintNFT) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintNFT: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgOperatorBurnNFT: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgOperatorBurnNFT: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8950,7 +13285,7 @@ func (m *MsgMintNFT) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8978,11 +13313,11 @@ func (m *MsgMintNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9010,13 +13345,13 @@ func (m *MsgMintNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIds", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9026,25 +13361,23 @@ func (m *MsgMintNFT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Params = append(m.Params, MintNFTParam{})
-			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenIds = append(m.TokenIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9067,7 +13400,7 @@ func (m *MsgMintNFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintNFTResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgOperatorBurnNFTResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9090,44 +13423,12 @@ func (m *MsgMintNFTResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintNFTResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgOperatorBurnNFTResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgOperatorBurnNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIds", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenIds = append(m.TokenIds, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9149,7 +13450,7 @@ func (m *MsgMintNFTResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MintNFTParam) Unmarshal(dAtA []byte) error {
+func (m *MsgModify) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9172,15 +13473,15 @@ func (m *MintNFTParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MintNFTParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgModify: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MintNFTParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgModify: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9208,43 +13509,11 @@ func (m *MintNFTParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenType = string(dAtA[iNdEx:postIndex])
+			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9261,72 +13530,22 @@ func (m *MintNFTParam) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Meta = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgBurnFT) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnFT: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnFT: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9354,11 +13573,11 @@ func (m *MsgBurnFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractId = string(dAtA[iNdEx:postIndex])
+			m.TokenType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIndex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9386,11 +13605,11 @@ func (m *MsgBurnFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.TokenIndex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9417,8 +13636,8 @@ func (m *MsgBurnFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Changes = append(m.Changes, Attribute{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9443,7 +13662,7 @@ func (m *MsgBurnFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnFTResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgModifyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9466,10 +13685,10 @@ func (m *MsgBurnFTResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnFTResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgModifyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgModifyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9493,7 +13712,7 @@ func (m *MsgBurnFTResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgOperatorBurnFT) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantPermission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9516,10 +13735,10 @@ func (m *MsgOperatorBurnFT) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOperatorBurnFT: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantPermission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOperatorBurnFT: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantPermission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9556,7 +13775,7 @@ func (m *MsgOperatorBurnFT) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9584,11 +13803,11 @@ func (m *MsgOperatorBurnFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9616,13 +13835,13 @@ func (m *MsgOperatorBurnFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9632,25 +13851,23 @@ func (m *MsgOperatorBurnFT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Permission = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9673,7 +13890,7 @@ func (m *MsgOperatorBurnFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgOperatorBurnFTResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantPermissionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9696,10 +13913,10 @@ func (m *MsgOperatorBurnFTResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOperatorBurnFTResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantPermissionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOperatorBurnFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantPermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9723,7 +13940,7 @@ func (m *MsgOperatorBurnFTResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnNFT) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokePermission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9746,10 +13963,10 @@ func (m *MsgBurnNFT) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnNFT: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokePermission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokePermission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9818,7 +14035,7 @@ func (m *MsgBurnNFT) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9846,7 +14063,7 @@ func (m *MsgBurnNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenIds = append(m.TokenIds, string(dAtA[iNdEx:postIndex]))
+			m.Permission = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9869,7 +14086,7 @@ func (m *MsgBurnNFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnNFTResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokePermissionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9892,10 +14109,10 @@ func (m *MsgBurnNFTResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnNFTResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokePermissionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokePermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9919,7 +14136,7 @@ func (m *MsgBurnNFTResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgOperatorBurnNFT) Unmarshal(dAtA []byte) error {
+func (m *MsgAttach) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9942,10 +14159,10 @@ func (m *MsgOperatorBurnNFT) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOperatorBurnNFT: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAttach: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOperatorBurnNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAttach: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9982,7 +14199,7 @@ func (m *MsgOperatorBurnNFT) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10010,11 +14227,11 @@ func (m *MsgOperatorBurnNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10042,11 +14259,11 @@ func (m *MsgOperatorBurnNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.TokenId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToTokenId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10074,7 +14291,7 @@ func (m *MsgOperatorBurnNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenIds = append(m.TokenIds, string(dAtA[iNdEx:postIndex]))
+			m.ToTokenId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10097,7 +14314,7 @@ func (m *MsgOperatorBurnNFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgOperatorBurnNFTResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAttachResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10120,10 +14337,10 @@ func (m *MsgOperatorBurnNFTResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOperatorBurnNFTResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAttachResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOperatorBurnNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAttachResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10147,7 +14364,7 @@ func (m *MsgOperatorBurnNFTResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgModify) Unmarshal(dAtA []byte) error {
+func (m *MsgDetach) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10170,10 +14387,10 @@ func (m *MsgModify) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgModify: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDetach: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgModify: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDetach: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10210,7 +14427,7 @@ func (m *MsgModify) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10238,43 +14455,11 @@ func (m *MsgModify) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10302,41 +14487,7 @@ func (m *MsgModify) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenIndex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Changes = append(m.Changes, Attribute{})
-			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10359,7 +14510,7 @@ func (m *MsgModify) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgModifyResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDetachResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10382,10 +14533,10 @@ func (m *MsgModifyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgModifyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDetachResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgModifyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDetachResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10409,7 +14560,7 @@ func (m *MsgModifyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGrantPermission) Unmarshal(dAtA []byte) error {
+func (m *MsgOperatorAttach) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10432,10 +14583,10 @@ func (m *MsgGrantPermission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGrantPermission: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgOperatorAttach: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGrantPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgOperatorAttach: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10471,6 +14622,38 @@ func (m *MsgGrantPermission) Unmarshal(dAtA []byte) error {
 			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
@@ -10502,9 +14685,9 @@ func (m *MsgGrantPermission) Unmarshal(dAtA []byte) error {
 			}
 			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10532,11 +14715,11 @@ func (m *MsgGrantPermission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			m.TokenId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToTokenId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10564,7 +14747,7 @@ func (m *MsgGrantPermission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Permission = string(dAtA[iNdEx:postIndex])
+			m.ToTokenId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10587,7 +14770,7 @@ func (m *MsgGrantPermission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGrantPermissionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgOperatorAttachResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10610,10 +14793,10 @@ func (m *MsgGrantPermissionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGrantPermissionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgOperatorAttachResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGrantPermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgOperatorAttachResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10637,7 +14820,7 @@ func (m *MsgGrantPermissionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokePermission) Unmarshal(dAtA []byte) error {
+func (m *MsgOperatorDetach) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10660,10 +14843,10 @@ func (m *MsgRevokePermission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokePermission: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgOperatorDetach: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokePermission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgOperatorDetach: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10699,6 +14882,38 @@ func (m *MsgRevokePermission) Unmarshal(dAtA []byte) error {
 			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
@@ -10730,9 +14945,9 @@ func (m *MsgRevokePermission) Unmarshal(dAtA []byte) error {
 			}
 			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10760,7 +14975,7 @@ func (m *MsgRevokePermission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Permission = string(dAtA[iNdEx:postIndex])
+			m.TokenId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10783,7 +14998,7 @@ func (m *MsgRevokePermission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokePermissionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgOperatorDetachResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10806,10 +15021,10 @@ func (m *MsgRevokePermissionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokePermissionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgOperatorDetachResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokePermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgOperatorDetachResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10833,7 +15048,7 @@ func (m *MsgRevokePermissionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAttach) Unmarshal(dAtA []byte) error {
+func (m *MsgSetNFTHistoryEnabled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10856,10 +15071,10 @@ func (m *MsgAttach) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAttach: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetNFTHistoryEnabled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAttach: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetNFTHistoryEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10896,7 +15111,7 @@ func (m *MsgAttach) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10924,45 +15139,13 @@ func (m *MsgAttach) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToTokenId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10972,24 +15155,12 @@ func (m *MsgAttach) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ToTokenId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -11011,7 +15182,7 @@ func (m *MsgAttach) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAttachResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetNFTHistoryEnabledResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11034,10 +15205,10 @@ func (m *MsgAttachResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAttachResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetNFTHistoryEnabledResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAttachResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetNFTHistoryEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11061,7 +15232,7 @@ func (m *MsgAttachResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDetach) Unmarshal(dAtA []byte) error {
+func (m *MsgProposeAdmin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11084,10 +15255,10 @@ func (m *MsgDetach) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDetach: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProposeAdmin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDetach: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProposeAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11156,7 +15327,7 @@ func (m *MsgDetach) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11184,7 +15355,7 @@ func (m *MsgDetach) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenId = string(dAtA[iNdEx:postIndex])
+			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11207,7 +15378,7 @@ func (m *MsgDetach) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDetachResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgProposeAdminResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11230,10 +15401,10 @@ func (m *MsgDetachResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDetachResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProposeAdminResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDetachResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProposeAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11257,7 +15428,7 @@ func (m *MsgDetachResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgOperatorAttach) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptAdmin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11280,10 +15451,10 @@ func (m *MsgOperatorAttach) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOperatorAttach: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptAdmin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOperatorAttach: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11319,38 +15490,6 @@ func (m *MsgOperatorAttach) Unmarshal(dAtA []byte) error {
 			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
@@ -11382,70 +15521,6 @@ func (m *MsgOperatorAttach) Unmarshal(dAtA []byte) error {
 			}
 			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToTokenId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ToTokenId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -11467,7 +15542,7 @@ func (m *MsgOperatorAttach) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgOperatorAttachResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptAdminResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11490,10 +15565,10 @@ func (m *MsgOperatorAttachResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOperatorAttachResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptAdminResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOperatorAttachResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11517,7 +15592,7 @@ func (m *MsgOperatorAttachResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgOperatorDetach) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeGrant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11540,10 +15615,10 @@ func (m *MsgOperatorDetach) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOperatorDetach: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeGrant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOperatorDetach: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeGrant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11580,7 +15655,7 @@ func (m *MsgOperatorDetach) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11608,11 +15683,11 @@ func (m *MsgOperatorDetach) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11640,11 +15715,11 @@ func (m *MsgOperatorDetach) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11672,7 +15747,7 @@ func (m *MsgOperatorDetach) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenId = string(dAtA[iNdEx:postIndex])
+			m.Permission = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11695,7 +15770,7 @@ func (m *MsgOperatorDetach) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgOperatorDetachResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeGrantResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11718,10 +15793,10 @@ func (m *MsgOperatorDetachResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOperatorDetachResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeGrantResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOperatorDetachResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeGrantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
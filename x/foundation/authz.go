@@ -1,6 +1,8 @@
 package foundation
 
 import (
+	"time"
+
 	"github.com/gogo/protobuf/proto"
 
 	sdk "github.com/Finschia/finschia-sdk/types"
@@ -46,14 +48,82 @@ func (a ReceiveFromTreasuryAuthorization) MsgTypeURL() string {
 }
 
 func (a ReceiveFromTreasuryAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (AcceptResponse, error) {
-	_, ok := msg.(*MsgWithdrawFromTreasury)
+	mWithdraw, ok := msg.(*MsgWithdrawFromTreasury)
 	if !ok {
 		return AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
 	}
 
-	return AcceptResponse{Accept: true}, nil
+	now := ctx.BlockTime()
+	if a.Expiration != nil && !now.Before(*a.Expiration) {
+		return AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("authorization expired")
+	}
+
+	// no limit
+	if a.SpendLimit.Empty() {
+		return AcceptResponse{Accept: true}, nil
+	}
+
+	updated := a
+	if a.Period == nil {
+		limitLeft, isNegative := a.SpendLimit.SafeSub(mWithdraw.Amount)
+		if isNegative {
+			return AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrap("requested amount is more than spend limit")
+		}
+		if limitLeft.IsZero() {
+			return AcceptResponse{Accept: true, Delete: true}, nil
+		}
+
+		updated.SpendLimit = limitLeft
+		return AcceptResponse{Accept: true, Updated: &updated}, nil
+	}
+
+	updated.tryResetPeriod(now)
+	canSpendLeft, isNegative := updated.PeriodCanSpend.SafeSub(mWithdraw.Amount)
+	if isNegative {
+		return AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrap("requested amount is more than period can spend")
+	}
+
+	updated.PeriodCanSpend = canSpendLeft
+	return AcceptResponse{Accept: true, Updated: &updated}, nil
+}
+
+// tryResetPeriod starts a new period with the full budget of spend limit,
+// if the current period has ended.
+func (a *ReceiveFromTreasuryAuthorization) tryResetPeriod(now time.Time) {
+	if a.PeriodReset != nil && now.Before(*a.PeriodReset) {
+		return
+	}
+
+	a.PeriodCanSpend = a.SpendLimit
+	periodReset := now.Add(*a.Period)
+	a.PeriodReset = &periodReset
 }
 
 func (a ReceiveFromTreasuryAuthorization) ValidateBasic() error {
+	if err := a.SpendLimit.Validate(); err != nil {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid spend limit: %s", err)
+	}
+
+	if a.Period == nil {
+		if !a.PeriodCanSpend.Empty() || a.PeriodReset != nil {
+			return sdkerrors.ErrInvalidRequest.Wrap("period fields set without period")
+		}
+		return nil
+	}
+
+	if *a.Period <= 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("non-positive period")
+	}
+	if a.SpendLimit.Empty() {
+		return sdkerrors.ErrInvalidRequest.Wrap("period set without spend limit")
+	}
+
+	if err := a.PeriodCanSpend.Validate(); err != nil {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid period can spend: %s", err)
+	}
+	if !a.SpendLimit.IsAllGTE(a.PeriodCanSpend) {
+		return sdkerrors.ErrInvalidCoins.Wrap("period can spend exceeds spend limit")
+	}
+
 	return nil
 }
@@ -5,18 +5,25 @@ package foundation
 
 import (
 	fmt "fmt"
+	github_com_Finschia_finschia_sdk_types "github.com/Finschia/finschia-sdk/types"
+	types "github.com/Finschia/finschia-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,8 +32,25 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // ReceiveFromTreasuryAuthorization allows the grantee to receive coins
-// up to receive_limit from the treasury.
+// up to spend_limit from the treasury.
 type ReceiveFromTreasuryAuthorization struct {
+	// spend_limit specifies the maximum amount of coins per denom that the
+	// grantee can receive. If period is empty, it will be updated as coins are
+	// received. If it is empty, there is no spend limit and any amount of coins
+	// can be received.
+	SpendLimit github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,1,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"spend_limit"`
+	// period specifies an optional time duration after which the budget of
+	// spend_limit is reset.
+	Period *time.Duration `protobuf:"bytes,2,opt,name=period,proto3,stdduration" json:"period,omitempty"`
+	// period_can_spend is the amount of coins left to be received before the
+	// period_reset time.
+	PeriodCanSpend github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,3,rep,name=period_can_spend,json=periodCanSpend,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"period_can_spend"`
+	// period_reset is the time at which the current period ends and a new one
+	// begins. It is calculated on the first acceptance after the last period
+	// ended.
+	PeriodReset *time.Time `protobuf:"bytes,4,opt,name=period_reset,json=periodReset,proto3,stdtime" json:"period_reset,omitempty"`
+	// expiration specifies an optional time when this authorization expires.
+	Expiration *time.Time `protobuf:"bytes,5,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
 }
 
 func (m *ReceiveFromTreasuryAuthorization) Reset()         { *m = ReceiveFromTreasuryAuthorization{} }
@@ -62,6 +86,41 @@ func (m *ReceiveFromTreasuryAuthorization) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ReceiveFromTreasuryAuthorization proto.InternalMessageInfo
 
+func (m *ReceiveFromTreasuryAuthorization) GetSpendLimit() github_com_Finschia_finschia_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func (m *ReceiveFromTreasuryAuthorization) GetPeriod() *time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return nil
+}
+
+func (m *ReceiveFromTreasuryAuthorization) GetPeriodCanSpend() github_com_Finschia_finschia_sdk_types.Coins {
+	if m != nil {
+		return m.PeriodCanSpend
+	}
+	return nil
+}
+
+func (m *ReceiveFromTreasuryAuthorization) GetPeriodReset() *time.Time {
+	if m != nil {
+		return m.PeriodReset
+	}
+	return nil
+}
+
+func (m *ReceiveFromTreasuryAuthorization) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ReceiveFromTreasuryAuthorization)(nil), "lbm.foundation.v1.ReceiveFromTreasuryAuthorization")
 }
@@ -69,20 +128,34 @@ func init() {
 func init() { proto.RegisterFile("lbm/foundation/v1/authz.proto", fileDescriptor_8bdb89c90659aa0e) }
 
 var fileDescriptor_8bdb89c90659aa0e = []byte{
-	// 204 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcd, 0x49, 0xca, 0xd5,
-	0x4f, 0xcb, 0x2f, 0xcd, 0x4b, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x2f, 0x33, 0xd4, 0x4f, 0x2c,
-	0x2d, 0xc9, 0xa8, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0xcc, 0x49, 0xca, 0xd5, 0x43,
-	0x48, 0xeb, 0x95, 0x19, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x65, 0xf5, 0x41, 0x2c, 0x88,
-	0x42, 0x29, 0xc9, 0xe4, 0xfc, 0xe2, 0xdc, 0xfc, 0xe2, 0x78, 0x88, 0x04, 0x84, 0x03, 0x91, 0x52,
-	0x4a, 0xe6, 0x52, 0x08, 0x4a, 0x4d, 0x4e, 0xcd, 0x2c, 0x4b, 0x75, 0x2b, 0xca, 0xcf, 0x0d, 0x29,
-	0x4a, 0x4d, 0x2c, 0x2e, 0x2d, 0xaa, 0x74, 0x2c, 0x2d, 0xc9, 0xc8, 0x2f, 0xca, 0xac, 0x02, 0x9b,
-	0x6b, 0x65, 0x7f, 0x69, 0x8b, 0xae, 0x75, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e,
-	0xae, 0xbe, 0x5b, 0x66, 0x5e, 0x71, 0x72, 0x46, 0x66, 0xa2, 0x7e, 0x1a, 0x94, 0xa1, 0x5b, 0x9c,
-	0x92, 0xad, 0x5f, 0x81, 0xe4, 0x52, 0x3d, 0x14, 0x03, 0x9c, 0xdc, 0x4f, 0x3c, 0x92, 0x63, 0xbc,
-	0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63,
-	0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x97, 0x24, 0x63, 0x93, 0xd8, 0xc0, 0x8e, 0x36, 0x06, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0x3d, 0x24, 0x13, 0x54, 0x19, 0x01, 0x00, 0x00,
+	// 422 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0x31, 0x8f, 0xd3, 0x30,
+	0x14, 0xc7, 0x1b, 0xae, 0xdc, 0xe0, 0x22, 0x04, 0x11, 0x43, 0xae, 0x12, 0x69, 0xc5, 0xc4, 0x40,
+	0x6d, 0x05, 0x90, 0x90, 0x60, 0x00, 0x52, 0x74, 0x2c, 0x4c, 0xe1, 0x26, 0x96, 0xc8, 0x49, 0xdc,
+	0xc4, 0x22, 0xf6, 0x8b, 0x6c, 0x27, 0xba, 0xde, 0xa7, 0xe8, 0xc8, 0x67, 0x60, 0xe6, 0x43, 0xdc,
+	0x78, 0x62, 0x62, 0xe2, 0x50, 0xfb, 0x45, 0x50, 0xec, 0x54, 0x1c, 0x30, 0x00, 0x03, 0xdb, 0x7b,
+	0xfa, 0xbf, 0xf7, 0xfe, 0xbf, 0xbf, 0x6c, 0x74, 0xb7, 0xce, 0x04, 0x59, 0x41, 0x2b, 0x0b, 0x6a,
+	0x38, 0x48, 0xd2, 0x45, 0x84, 0xb6, 0xa6, 0x3a, 0xc3, 0x8d, 0x02, 0x03, 0xfe, 0xed, 0x3a, 0x13,
+	0xf8, 0x87, 0x8c, 0xbb, 0x68, 0x7a, 0xa7, 0x84, 0x12, 0xac, 0x4a, 0xfa, 0xca, 0x0d, 0x4e, 0x8f,
+	0x72, 0xd0, 0x02, 0x74, 0xea, 0x04, 0xd7, 0x0c, 0x52, 0xe8, 0x3a, 0x92, 0x51, 0xcd, 0x48, 0x17,
+	0x65, 0xcc, 0xd0, 0x88, 0xe4, 0xc0, 0xe5, 0x5e, 0x2f, 0x01, 0xca, 0x9a, 0x11, 0xdb, 0x65, 0xed,
+	0x8a, 0x14, 0xad, 0x72, 0x6e, 0x4e, 0x9f, 0xfd, 0xaa, 0x1b, 0x2e, 0x98, 0x36, 0x54, 0x34, 0x6e,
+	0xe0, 0xde, 0x66, 0x8c, 0xe6, 0x09, 0xcb, 0x19, 0xef, 0xd8, 0xb1, 0x02, 0x71, 0xa2, 0x18, 0xd5,
+	0xad, 0x5a, 0xbf, 0x6c, 0x4d, 0x05, 0x8a, 0x9f, 0xd9, 0x5b, 0x7e, 0x83, 0x26, 0xba, 0x61, 0xb2,
+	0x48, 0x6b, 0x2e, 0xb8, 0x09, 0xbc, 0xf9, 0xc1, 0xfd, 0xc9, 0xc3, 0x23, 0x3c, 0x90, 0xf6, 0x6c,
+	0x78, 0x60, 0xc3, 0x4b, 0xe0, 0x32, 0x7e, 0x7c, 0xfe, 0x75, 0x36, 0xfa, 0x78, 0x39, 0x7b, 0x50,
+	0x72, 0x53, 0xb5, 0x19, 0xce, 0x41, 0x90, 0x63, 0x2e, 0x75, 0x5e, 0x71, 0x4a, 0x56, 0x43, 0xb1,
+	0xd0, 0xc5, 0x7b, 0x62, 0xd6, 0x0d, 0xd3, 0x76, 0x49, 0x27, 0xc8, 0x7a, 0xbc, 0xe9, 0x2d, 0xfc,
+	0x27, 0xe8, 0xb0, 0x61, 0x8a, 0x43, 0x11, 0x5c, 0x9b, 0x7b, 0xd6, 0xcc, 0x05, 0xc1, 0xfb, 0x20,
+	0xf8, 0xd5, 0x10, 0x34, 0x1e, 0x7f, 0xb8, 0x9c, 0x79, 0xc9, 0x30, 0xee, 0xaf, 0xd1, 0x2d, 0x57,
+	0xa5, 0x39, 0x95, 0xa9, 0xbd, 0x18, 0x1c, 0xfc, 0x1f, 0xde, 0x9b, 0xce, 0x68, 0x49, 0xe5, 0xdb,
+	0xde, 0xc6, 0x5f, 0xa2, 0x1b, 0x83, 0xb5, 0x62, 0x9a, 0x99, 0x60, 0x6c, 0xc9, 0xa7, 0xbf, 0x91,
+	0x9f, 0xec, 0x9f, 0x20, 0x1e, 0x6f, 0x7a, 0xf4, 0x89, 0xdb, 0x4a, 0xfa, 0x25, 0xff, 0x05, 0x42,
+	0xec, 0xb4, 0xe1, 0x2e, 0x5b, 0x70, 0xfd, 0x2f, 0x4f, 0x5c, 0xd9, 0x79, 0xfa, 0xfc, 0xf3, 0xa7,
+	0xc5, 0xb3, 0x3f, 0x06, 0x39, 0xbd, 0xf2, 0x71, 0xf1, 0x4f, 0xaf, 0x1d, 0xbf, 0x3e, 0xdf, 0x86,
+	0xde, 0xc5, 0x36, 0xf4, 0xbe, 0x6d, 0x43, 0x6f, 0xb3, 0x0b, 0x47, 0x17, 0xbb, 0x70, 0xf4, 0x65,
+	0x17, 0x8e, 0xde, 0x2d, 0xfe, 0xe9, 0x6c, 0x76, 0x68, 0x79, 0x1f, 0x7d, 0x1f, 0x00, 0x7e, 0xbd,
+	0xd4, 0xbb, 0x28, 0x03, 0x00, 0x00,
 }
 
 func (m *ReceiveFromTreasuryAuthorization) Marshal() (dAtA []byte, err error) {
@@ -105,6 +178,64 @@ func (m *ReceiveFromTreasuryAuthorization) MarshalToSizedBuffer(dAtA []byte) (in
 	_ = i
 	var l int
 	_ = l
+	if m.Expiration != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintAuthz(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PeriodReset != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.PeriodReset, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.PeriodReset):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintAuthz(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PeriodCanSpend) > 0 {
+		for iNdEx := len(m.PeriodCanSpend) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodCanSpend[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Period != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Period, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Period):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintAuthz(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -125,6 +256,30 @@ func (m *ReceiveFromTreasuryAuthorization) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.Period != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Period)
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.PeriodCanSpend) > 0 {
+		for _, e := range m.PeriodCanSpend {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.PeriodReset != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.PeriodReset)
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovAuthz(uint64(l))
+	}
 	return n
 }
 
@@ -163,6 +318,182 @@ func (m *ReceiveFromTreasuryAuthorization) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: ReceiveFromTreasuryAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Period == nil {
+				m.Period = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodCanSpend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodCanSpend = append(m.PeriodCanSpend, types.Coin{})
+			if err := m.PeriodCanSpend[len(m.PeriodCanSpend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PeriodReset == nil {
+				m.PeriodReset = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthz(dAtA[iNdEx:])
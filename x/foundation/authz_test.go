@@ -2,17 +2,29 @@ package foundation_test
 
 import (
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
 
 	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/x/foundation"
-	"github.com/stretchr/testify/require"
 )
 
 func TestReceiveFromTreasuryAuthorization(t *testing.T) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	period := time.Hour
+	later := now.Add(period)
+	earlier := now.Add(-period)
+	limit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(2)))
+	amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt()))
+
 	testCases := map[string]struct {
-		msg    sdk.Msg
-		valid  bool
-		accept bool
+		authorization foundation.ReceiveFromTreasuryAuthorization
+		msg           sdk.Msg
+		valid         bool
+		accept        bool
+		delete        bool
+		updated       foundation.Authorization
 	}{
 		"valid": {
 			msg:    &foundation.MsgWithdrawFromTreasury{},
@@ -22,13 +34,125 @@ func TestReceiveFromTreasuryAuthorization(t *testing.T) {
 		"msg mismatch": {
 			msg: &foundation.MsgVote{},
 		},
+		"within spend limit": {
+			authorization: foundation.ReceiveFromTreasuryAuthorization{
+				SpendLimit: limit,
+			},
+			msg: &foundation.MsgWithdrawFromTreasury{
+				Amount: amount,
+			},
+			valid:  true,
+			accept: true,
+			updated: &foundation.ReceiveFromTreasuryAuthorization{
+				SpendLimit: amount,
+			},
+		},
+		"spend limit used up": {
+			authorization: foundation.ReceiveFromTreasuryAuthorization{
+				SpendLimit: amount,
+			},
+			msg: &foundation.MsgWithdrawFromTreasury{
+				Amount: amount,
+			},
+			valid:  true,
+			accept: true,
+			delete: true,
+		},
+		"exceeds spend limit": {
+			authorization: foundation.ReceiveFromTreasuryAuthorization{
+				SpendLimit: amount,
+			},
+			msg: &foundation.MsgWithdrawFromTreasury{
+				Amount: limit,
+			},
+		},
+		"denom not in spend limit": {
+			authorization: foundation.ReceiveFromTreasuryAuthorization{
+				SpendLimit: limit,
+			},
+			msg: &foundation.MsgWithdrawFromTreasury{
+				Amount: sdk.NewCoins(sdk.NewCoin("nonexistent", sdk.OneInt())),
+			},
+		},
+		"new period": {
+			authorization: foundation.ReceiveFromTreasuryAuthorization{
+				SpendLimit: limit,
+				Period:     &period,
+			},
+			msg: &foundation.MsgWithdrawFromTreasury{
+				Amount: amount,
+			},
+			valid:  true,
+			accept: true,
+			updated: &foundation.ReceiveFromTreasuryAuthorization{
+				SpendLimit:     limit,
+				Period:         &period,
+				PeriodCanSpend: amount,
+				PeriodReset:    &later,
+			},
+		},
+		"within period": {
+			authorization: foundation.ReceiveFromTreasuryAuthorization{
+				SpendLimit:     limit,
+				Period:         &period,
+				PeriodCanSpend: amount,
+				PeriodReset:    &later,
+			},
+			msg: &foundation.MsgWithdrawFromTreasury{
+				Amount: amount,
+			},
+			valid:  true,
+			accept: true,
+			updated: &foundation.ReceiveFromTreasuryAuthorization{
+				SpendLimit:  limit,
+				Period:      &period,
+				PeriodReset: &later,
+			},
+		},
+		"exceeds period can spend": {
+			authorization: foundation.ReceiveFromTreasuryAuthorization{
+				SpendLimit:     limit,
+				Period:         &period,
+				PeriodCanSpend: amount,
+				PeriodReset:    &later,
+			},
+			msg: &foundation.MsgWithdrawFromTreasury{
+				Amount: limit,
+			},
+		},
+		"period reset": {
+			authorization: foundation.ReceiveFromTreasuryAuthorization{
+				SpendLimit:     limit,
+				Period:         &period,
+				PeriodCanSpend: sdk.Coins{},
+				PeriodReset:    &now,
+			},
+			msg: &foundation.MsgWithdrawFromTreasury{
+				Amount: limit,
+			},
+			valid:  true,
+			accept: true,
+			updated: &foundation.ReceiveFromTreasuryAuthorization{
+				SpendLimit:  limit,
+				Period:      &period,
+				PeriodReset: &later,
+			},
+		},
+		"expired": {
+			authorization: foundation.ReceiveFromTreasuryAuthorization{
+				Expiration: &earlier,
+			},
+			msg: &foundation.MsgWithdrawFromTreasury{
+				Amount: amount,
+			},
+		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			authorization := &foundation.ReceiveFromTreasuryAuthorization{}
+			ctx := sdk.Context{}.WithBlockTime(now)
 
-			resp, err := authorization.Accept(sdk.Context{}, tc.msg)
+			resp, err := tc.authorization.Accept(ctx, tc.msg)
 			if !tc.valid {
 				require.Error(t, err)
 				return
@@ -36,6 +160,78 @@ func TestReceiveFromTreasuryAuthorization(t *testing.T) {
 			require.NoError(t, err)
 
 			require.Equal(t, tc.accept, resp.Accept)
+			require.Equal(t, tc.delete, resp.Delete)
+			require.Equal(t, tc.updated, resp.Updated)
+		})
+	}
+}
+
+func TestReceiveFromTreasuryAuthorizationValidateBasic(t *testing.T) {
+	period := time.Hour
+	negativePeriod := -period
+	limit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(2)))
+	amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt()))
+
+	testCases := map[string]struct {
+		authorization foundation.ReceiveFromTreasuryAuthorization
+		valid         bool
+	}{
+		"no limit": {
+			valid: true,
+		},
+		"spend limit": {
+			authorization: foundation.ReceiveFromTreasuryAuthorization{
+				SpendLimit: limit,
+			},
+			valid: true,
+		},
+		"periodic spend limit": {
+			authorization: foundation.ReceiveFromTreasuryAuthorization{
+				SpendLimit:     limit,
+				Period:         &period,
+				PeriodCanSpend: amount,
+			},
+			valid: true,
+		},
+		"invalid spend limit": {
+			authorization: foundation.ReceiveFromTreasuryAuthorization{
+				SpendLimit: sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.ZeroInt()}},
+			},
+		},
+		"period can spend without period": {
+			authorization: foundation.ReceiveFromTreasuryAuthorization{
+				SpendLimit:     limit,
+				PeriodCanSpend: amount,
+			},
+		},
+		"non-positive period": {
+			authorization: foundation.ReceiveFromTreasuryAuthorization{
+				SpendLimit: limit,
+				Period:     &negativePeriod,
+			},
+		},
+		"period without spend limit": {
+			authorization: foundation.ReceiveFromTreasuryAuthorization{
+				Period: &period,
+			},
+		},
+		"period can spend exceeds spend limit": {
+			authorization: foundation.ReceiveFromTreasuryAuthorization{
+				SpendLimit:     amount,
+				Period:         &period,
+				PeriodCanSpend: limit,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.authorization.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
 		})
 	}
 }
@@ -22,6 +22,8 @@ func NewQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		NewQueryCmdParams(),
 		NewQueryCmdTreasury(),
+		NewQueryCmdTreasuryStream(),
+		NewQueryCmdTreasuryStreams(),
 		NewQueryCmdFoundationInfo(),
 		NewQueryCmdMember(),
 		NewQueryCmdMembers(),
@@ -30,6 +32,8 @@ func NewQueryCmd() *cobra.Command {
 		NewQueryCmdVote(),
 		NewQueryCmdVotes(),
 		NewQueryCmdTallyResult(),
+		NewQueryCmdVoteDelegation(),
+		NewQueryCmdVoteDelegations(),
 		NewQueryCmdCensorships(),
 		NewQueryCmdGrants(),
 	)
@@ -95,6 +99,78 @@ func NewQueryCmdTreasury() *cobra.Command {
 	return cmd
 }
 
+// NewQueryCmdTreasuryStream returns an active treasury stream.
+func NewQueryCmdTreasuryStream() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "treasury-stream [stream-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a treasury stream",
+		Long: `Query an active treasury stream
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := foundation.NewQueryClient(clientCtx)
+
+			streamID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := foundation.QueryTreasuryStreamRequest{StreamId: streamID}
+			res, err := queryClient.TreasuryStream(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewQueryCmdTreasuryStreams returns all the active treasury streams.
+func NewQueryCmdTreasuryStreams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "treasury-streams",
+		Args:  cobra.NoArgs,
+		Short: "Query all treasury streams",
+		Long: `Query all the active treasury streams
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := foundation.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := foundation.QueryTreasuryStreamsRequest{
+				Pagination: pageReq,
+			}
+			res, err := queryClient.TreasuryStreams(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "treasury streams")
+
+	return cmd
+}
+
 // NewQueryCmdFoundationInfo returns the information of the foundation.
 func NewQueryCmdFoundationInfo() *cobra.Command {
 	cmd := &cobra.Command{
@@ -357,6 +433,73 @@ func NewQueryCmdTallyResult() *cobra.Command {
 	return cmd
 }
 
+// NewQueryCmdVoteDelegation returns the vote delegation of a member.
+func NewQueryCmdVoteDelegation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-delegation [delegator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the vote delegation of a member",
+		Long: `Query the vote delegation of a member
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := foundation.NewQueryClient(clientCtx)
+
+			req := foundation.QueryVoteDelegationRequest{Delegator: args[0]}
+			res, err := queryClient.VoteDelegation(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewQueryCmdVoteDelegations returns all the active vote delegations.
+func NewQueryCmdVoteDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-delegations",
+		Args:  cobra.NoArgs,
+		Short: "Query all vote delegations",
+		Long: `Query all the active vote delegations
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := foundation.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := foundation.QueryVoteDelegationsRequest{
+				Pagination: pageReq,
+			}
+			res, err := queryClient.VoteDelegations(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "vote delegations")
+
+	return cmd
+}
+
 // NewQueryCmdCensorships returns the query censorships command.
 func NewQueryCmdCensorships() *cobra.Command {
 	cmd := &cobra.Command{
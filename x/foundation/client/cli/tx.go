@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -23,6 +24,11 @@ const (
 	ExecTry  = "try"
 )
 
+// Treasury stream flags
+const (
+	FlagCliffTime = "cliff-time"
+)
+
 func validateGenerateOnly(cmd *cobra.Command) error {
 	generateOnly, err := cmd.Flags().GetBool(flags.FlagGenerateOnly)
 	if err != nil {
@@ -134,6 +140,8 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		NewTxCmdFundTreasury(),
 		NewTxCmdWithdrawFromTreasury(),
+		NewTxCmdCreateTreasuryStream(),
+		NewTxCmdCancelTreasuryStream(),
 		NewTxCmdUpdateMembers(),
 		NewTxCmdUpdateDecisionPolicy(),
 		NewTxCmdSubmitProposal(),
@@ -141,6 +149,8 @@ func NewTxCmd() *cobra.Command {
 		NewTxCmdVote(),
 		NewTxCmdExec(),
 		NewTxCmdLeaveFoundation(),
+		NewTxCmdDelegateVote(),
+		NewTxCmdRevokeVoteDelegation(),
 		NewTxCmdGrant(),
 		NewTxCmdRevoke(),
 	)
@@ -224,6 +234,107 @@ func NewTxCmdWithdrawFromTreasury() *cobra.Command {
 	return cmd
 }
 
+func NewTxCmdCreateTreasuryStream() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-treasury-stream [authority] [recipient] [amount] [start-time] [end-time]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Create a stream paying out coins from the treasury over time",
+		Long: `Create a stream paying out coins from the treasury over time
+
+The amount is paid out linearly from start-time to end-time, both in RFC3339
+format (e.g. 2023-01-02T15:04:05Z). Nothing is paid out before the cliff time,
+which defaults to start-time.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateGenerateOnly(cmd); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			startTime, err := time.Parse(time.RFC3339, args[3])
+			if err != nil {
+				return err
+			}
+
+			endTime, err := time.Parse(time.RFC3339, args[4])
+			if err != nil {
+				return err
+			}
+
+			cliffTime := startTime
+			if cliffStr, _ := cmd.Flags().GetString(FlagCliffTime); cliffStr != "" {
+				if cliffTime, err = time.Parse(time.RFC3339, cliffStr); err != nil {
+					return err
+				}
+			}
+
+			msg := foundation.MsgCreateTreasuryStream{
+				Authority: args[0],
+				Recipient: args[1],
+				Amount:    amount,
+				StartTime: startTime,
+				EndTime:   endTime,
+				CliffTime: cliffTime,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagCliffTime, "", "The time before which nothing is paid out, in RFC3339 format")
+
+	return cmd
+}
+
+func NewTxCmdCancelTreasuryStream() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-treasury-stream [authority] [stream-id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Cancel a treasury stream",
+		Long: `Cancel a treasury stream, returning the remainder to the treasury
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateGenerateOnly(cmd); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			streamID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := foundation.MsgCancelTreasuryStream{
+				Authority: args[0],
+				StreamId:  streamID,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func NewTxCmdUpdateMembers() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update-members [authority] [members-json]",
@@ -236,17 +347,16 @@ Example of the content of members-json:
 [
   {
     "address": "addr1",
-    "participating": true,
+    "weight": "1",
     "metadata": "some new metadata"
   },
   {
     "address": "addr2",
-    "participating": false,
-    "metadata": "some metadata"
+    "remove": true
   }
 ]
 
-Set a member's participating to false to delete it.
+Set a member's remove to true to delete it.
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := validateGenerateOnly(cmd); err != nil {
@@ -579,6 +689,85 @@ func NewTxCmdLeaveFoundation() *cobra.Command {
 	return cmd
 }
 
+func NewTxCmdDelegateVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate-vote [delegator] [delegate] [start-time] [end-time]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Delegate the vote of a member to another member",
+		Long: `Delegate the vote of a member to another member
+
+The delegation is active from start-time to end-time, both in RFC3339 format
+(e.g. 2023-01-02T15:04:05Z). If there is already a delegation of the member,
+then it will be overwritten.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			delegator := args[0]
+			if err := cmd.Flags().Set(flags.FlagFrom, delegator); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			startTime, err := time.Parse(time.RFC3339, args[2])
+			if err != nil {
+				return err
+			}
+
+			endTime, err := time.Parse(time.RFC3339, args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := foundation.MsgDelegateVote{
+				Delegator: delegator,
+				Delegate:  args[1],
+				StartTime: startTime,
+				EndTime:   endTime,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdRevokeVoteDelegation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-vote-delegation [delegator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Revoke the vote delegation of a member",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			delegator := args[0]
+			if err := cmd.Flags().Set(flags.FlagFrom, delegator); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := foundation.MsgRevokeVoteDelegation{
+				Delegator: delegator,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func NewTxCmdGrant() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "grant [authority] [grantee] [authorization-json]",
@@ -590,10 +779,14 @@ Example of the content of authorization-json:
 
 {
   "@type": "/lbm.foundation.v1.ReceiveFromTreasuryAuthorization",
-  "receive_limit": [
-    "denom": "stake",
-    "amount": "10000"
-  ]
+  "spend_limit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ],
+  "period": "86400s",
+  "expiration": "2030-01-01T00:00:00Z"
 }
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -2,6 +2,7 @@ package testutil
 
 import (
 	"fmt"
+	"time"
 
 	ostcli "github.com/Finschia/ostracon/libs/cli"
 	"github.com/gogo/protobuf/proto"
@@ -103,6 +104,113 @@ func (s *IntegrationTestSuite) TestNewQueryCmdTreasury() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewQueryCmdTreasuryStream() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", ostcli.OutputFlag),
+	}
+
+	testCases := map[string]struct {
+		args     []string
+		valid    bool
+		expected *foundation.QueryTreasuryStreamResponse
+	}{
+		"valid query": {
+			[]string{
+				fmt.Sprintf("%d", s.streamID),
+			},
+			true,
+			&foundation.QueryTreasuryStreamResponse{
+				Stream: &foundation.TreasuryStream{
+					Id:        s.streamID,
+					Recipient: s.stranger.String(),
+					Amount:    sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.OneInt())),
+					Paid:      sdk.Coins{},
+					StartTime: time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC),
+					EndTime:   time.Date(2101, 1, 1, 0, 0, 0, 0, time.UTC),
+					CliffTime: time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		"wrong number of args": {
+			[]string{
+				fmt.Sprintf("%d", s.streamID),
+				"extra",
+			},
+			false,
+			nil,
+		},
+		"stream not found": {
+			[]string{
+				fmt.Sprintf("%d", s.streamID+1),
+			},
+			false,
+			nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdTreasuryStream()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual foundation.QueryTreasuryStreamResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			s.Require().Equal(tc.expected, &actual)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewQueryCmdTreasuryStreams() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", ostcli.OutputFlag),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid query": {
+			[]string{},
+			true,
+		},
+		"wrong number of args": {
+			[]string{
+				"extra",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdTreasuryStreams()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual foundation.QueryTreasuryStreamsResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			s.Require().Len(actual.Streams, 1)
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestNewQueryCmdFoundationInfo() {
 	val := s.network.Validators[0]
 	commonArgs := []string{
@@ -163,6 +271,7 @@ func (s *IntegrationTestSuite) TestNewQueryCmdMember() {
 			true,
 			&foundation.Member{
 				Address:  s.permanentMember.String(),
+				Weight:   sdk.OneDec(),
 				Metadata: "permanent member",
 			},
 		},
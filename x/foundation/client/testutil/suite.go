@@ -35,6 +35,7 @@ type IntegrationTestSuite struct {
 	stranger        sdk.AccAddress
 
 	proposalID uint64
+	streamID   uint64
 }
 
 var commonArgs = []string{
@@ -72,10 +73,12 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	foundationData.Members = []foundation.Member{
 		{
 			Address:  s.leavingMember.String(),
+			Weight:   sdk.OneDec(),
 			Metadata: "leaving member",
 		},
 		{
 			Address:  s.permanentMember.String(),
+			Weight:   sdk.OneDec(),
 			Metadata: "permanent member",
 		},
 	}
@@ -131,6 +134,18 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	s.vote(s.proposalID, []sdk.AccAddress{s.leavingMember, s.permanentMember})
 	s.Require().NoError(s.network.WaitForNextBlock())
 
+	// create a treasury stream, which starts in the far future
+	startTime := time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.submitProposal(&foundation.MsgCreateTreasuryStream{
+		Authority: s.authority.String(),
+		Recipient: s.stranger.String(),
+		Amount:    sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.OneInt())),
+		StartTime: startTime,
+		EndTime:   startTime.AddDate(1, 0, 0),
+		CliffTime: startTime,
+	}, true)
+	s.streamID = 1
+
 	s.setupHeight, err = s.network.LatestHeight()
 	s.Require().NoError(err)
 	s.Require().NoError(s.network.WaitForNextBlock())
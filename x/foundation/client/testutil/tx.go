@@ -109,13 +109,147 @@ func (s *IntegrationTestSuite) TestNewTxCmdWithdrawFromTreasury() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewTxCmdCreateTreasuryStream() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s", flags.FlagGenerateOnly),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				s.authority.String(),
+				s.stranger.String(),
+				sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.OneInt())).String(),
+				"2023-01-01T00:00:00Z",
+				"2024-01-01T00:00:00Z",
+			},
+			true,
+		},
+		"valid transaction with cliff": {
+			[]string{
+				s.authority.String(),
+				s.stranger.String(),
+				sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.OneInt())).String(),
+				"2023-01-01T00:00:00Z",
+				"2024-01-01T00:00:00Z",
+				fmt.Sprintf("--%s=%s", cli.FlagCliffTime, "2023-07-01T00:00:00Z"),
+			},
+			true,
+		},
+		"wrong number of args": {
+			[]string{
+				s.authority.String(),
+				s.stranger.String(),
+				sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.OneInt())).String(),
+				"2023-01-01T00:00:00Z",
+				"2024-01-01T00:00:00Z",
+				"extra",
+			},
+			false,
+		},
+		"invalid time format": {
+			[]string{
+				s.authority.String(),
+				s.stranger.String(),
+				sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.OneInt())).String(),
+				"2023-01-01",
+				"2024-01-01",
+			},
+			false,
+		},
+		"end time before start time": {
+			[]string{
+				s.authority.String(),
+				s.stranger.String(),
+				sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.OneInt())).String(),
+				"2024-01-01T00:00:00Z",
+				"2023-01-01T00:00:00Z",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdCreateTreasuryStream()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res txtypes.Tx
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewTxCmdCancelTreasuryStream() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s", flags.FlagGenerateOnly),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				s.authority.String(),
+				fmt.Sprint(s.streamID),
+			},
+			true,
+		},
+		"wrong number of args": {
+			[]string{
+				s.authority.String(),
+				fmt.Sprint(s.streamID),
+				"extra",
+			},
+			false,
+		},
+		"invalid stream id": {
+			[]string{
+				s.authority.String(),
+				"0",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdCancelTreasuryStream()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res txtypes.Tx
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out)
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestNewTxCmdUpdateMembers() {
 	val := s.network.Validators[0]
 	commonArgs := []string{
 		fmt.Sprintf("--%s", flags.FlagGenerateOnly),
 	}
 
-	updates := `[{"address":"%s"}]`
+	updates := `[{"address":"%s","weight":"1"}]`
 	testCases := map[string]struct {
 		args  []string
 		valid bool
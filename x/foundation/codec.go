@@ -20,9 +20,13 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgExec{}, "lbm-sdk/MsgExec")
 	legacy.RegisterAminoMsg(cdc, &MsgLeaveFoundation{}, "lbm-sdk/MsgLeaveFoundation")
 	legacy.RegisterAminoMsg(cdc, &MsgWithdrawProposal{}, "lbm-sdk/MsgWithdrawProposal")
+	legacy.RegisterAminoMsg(cdc, &MsgDelegateVote{}, "lbm-sdk/MsgDelegateVote")
+	legacy.RegisterAminoMsg(cdc, &MsgRevokeVoteDelegation{}, "lbm-sdk/MsgRevokeVoteDelegation")
 
 	// proposal from foundation operator
 	legacy.RegisterAminoMsg(cdc, &MsgWithdrawFromTreasury{}, "lbm-sdk/MsgWithdrawFromTreasury")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateTreasuryStream{}, "lbm-sdk/MsgCreateTreasuryStream")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelTreasuryStream{}, "lbm-sdk/MsgCancelTreasuryStream")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateMembers{}, "lbm-sdk/MsgUpdateMembers")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateDecisionPolicy{}, "lbm-sdk/MsgUpdateDecisionPolicy")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateCensorship{}, "lbm-sdk/MsgUpdateCensorship")
@@ -42,6 +46,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgFundTreasury{},
 		&MsgWithdrawFromTreasury{},
+		&MsgCreateTreasuryStream{},
+		&MsgCancelTreasuryStream{},
 		&MsgUpdateMembers{},
 		&MsgUpdateDecisionPolicy{},
 		&MsgSubmitProposal{},
@@ -49,6 +55,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgVote{},
 		&MsgExec{},
 		&MsgLeaveFoundation{},
+		&MsgDelegateVote{},
+		&MsgRevokeVoteDelegation{},
 		&MsgUpdateCensorship{},
 		&MsgGrant{},
 		&MsgRevoke{},
@@ -2,12 +2,16 @@ package foundation
 
 import (
 	"time"
+
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 )
 
 // Config is a config struct used for intialising the group module to avoid using globals.
 type Config struct {
 	// MaxExecutionPeriod defines the max duration after a proposal's voting period ends that members can send a MsgExec to execute the proposal.
 	MaxExecutionPeriod time.Duration
+	// MaxRetryPeriod defines the max duration after the first failed execution of a proposal that members can send a MsgExec to execute the proposal again.
+	MaxRetryPeriod time.Duration
 	// MaxMetadataLen defines the max length of the metadata bytes field for various entities within the foundation module. Defaults to 255 if not explicitly set.
 	MaxMetadataLen uint64
 }
@@ -15,6 +19,15 @@ type Config struct {
 func DefaultConfig() Config {
 	return Config{
 		MaxExecutionPeriod: 2 * 7 * 24 * time.Hour, // two weeks
+		MaxRetryPeriod:     7 * 24 * time.Hour,     // one week
 		MaxMetadataLen:     255,
 	}
 }
+
+// ValidateBasic validates the config.
+func (c Config) ValidateBasic() error {
+	if c.MaxRetryPeriod <= 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("non-positive max retry period: %s", c.MaxRetryPeriod)
+	}
+	return nil
+}
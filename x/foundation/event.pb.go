@@ -133,6 +133,168 @@ func (m *EventWithdrawFromTreasury) GetAmount() github_com_Finschia_finschia_sdk
 	return nil
 }
 
+// EventCreateTreasuryStream is an event emitted when a treasury stream is created.
+type EventCreateTreasuryStream struct {
+	Stream TreasuryStream `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream"`
+}
+
+func (m *EventCreateTreasuryStream) Reset()         { *m = EventCreateTreasuryStream{} }
+func (m *EventCreateTreasuryStream) String() string { return proto.CompactTextString(m) }
+func (*EventCreateTreasuryStream) ProtoMessage()    {}
+func (*EventCreateTreasuryStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b66c645bbb34fbc, []int{2}
+}
+func (m *EventCreateTreasuryStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCreateTreasuryStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCreateTreasuryStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCreateTreasuryStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCreateTreasuryStream.Merge(m, src)
+}
+func (m *EventCreateTreasuryStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCreateTreasuryStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCreateTreasuryStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCreateTreasuryStream proto.InternalMessageInfo
+
+func (m *EventCreateTreasuryStream) GetStream() TreasuryStream {
+	if m != nil {
+		return m.Stream
+	}
+	return TreasuryStream{}
+}
+
+// EventCancelTreasuryStream is an event emitted when a treasury stream is canceled.
+type EventCancelTreasuryStream struct {
+	// stream_id is the unique ID of the stream.
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// remainder is the amount returned to the treasury.
+	Remainder github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,2,rep,name=remainder,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"remainder"`
+}
+
+func (m *EventCancelTreasuryStream) Reset()         { *m = EventCancelTreasuryStream{} }
+func (m *EventCancelTreasuryStream) String() string { return proto.CompactTextString(m) }
+func (*EventCancelTreasuryStream) ProtoMessage()    {}
+func (*EventCancelTreasuryStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b66c645bbb34fbc, []int{3}
+}
+func (m *EventCancelTreasuryStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCancelTreasuryStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCancelTreasuryStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCancelTreasuryStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCancelTreasuryStream.Merge(m, src)
+}
+func (m *EventCancelTreasuryStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCancelTreasuryStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCancelTreasuryStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCancelTreasuryStream proto.InternalMessageInfo
+
+func (m *EventCancelTreasuryStream) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
+}
+
+func (m *EventCancelTreasuryStream) GetRemainder() github_com_Finschia_finschia_sdk_types.Coins {
+	if m != nil {
+		return m.Remainder
+	}
+	return nil
+}
+
+// EventPayTreasuryStream is an event emitted when a treasury stream pays out the accrual.
+type EventPayTreasuryStream struct {
+	// stream_id is the unique ID of the stream.
+	StreamId  uint64                                       `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	Recipient string                                       `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount    github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"amount"`
+}
+
+func (m *EventPayTreasuryStream) Reset()         { *m = EventPayTreasuryStream{} }
+func (m *EventPayTreasuryStream) String() string { return proto.CompactTextString(m) }
+func (*EventPayTreasuryStream) ProtoMessage()    {}
+func (*EventPayTreasuryStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b66c645bbb34fbc, []int{4}
+}
+func (m *EventPayTreasuryStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPayTreasuryStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPayTreasuryStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPayTreasuryStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPayTreasuryStream.Merge(m, src)
+}
+func (m *EventPayTreasuryStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPayTreasuryStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPayTreasuryStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPayTreasuryStream proto.InternalMessageInfo
+
+func (m *EventPayTreasuryStream) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
+}
+
+func (m *EventPayTreasuryStream) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *EventPayTreasuryStream) GetAmount() github_com_Finschia_finschia_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 // EventUpdateMembers is an event emitted when the members have been updated.
 type EventUpdateMembers struct {
 	MemberUpdates []MemberRequest `protobuf:"bytes,1,rep,name=member_updates,json=memberUpdates,proto3" json:"member_updates"`
@@ -142,7 +304,7 @@ func (m *EventUpdateMembers) Reset()         { *m = EventUpdateMembers{} }
 func (m *EventUpdateMembers) String() string { return proto.CompactTextString(m) }
 func (*EventUpdateMembers) ProtoMessage()    {}
 func (*EventUpdateMembers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{2}
+	return fileDescriptor_2b66c645bbb34fbc, []int{5}
 }
 func (m *EventUpdateMembers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -187,7 +349,7 @@ func (m *EventUpdateDecisionPolicy) Reset()         { *m = EventUpdateDecisionPo
 func (m *EventUpdateDecisionPolicy) String() string { return proto.CompactTextString(m) }
 func (*EventUpdateDecisionPolicy) ProtoMessage()    {}
 func (*EventUpdateDecisionPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{3}
+	return fileDescriptor_2b66c645bbb34fbc, []int{6}
 }
 func (m *EventUpdateDecisionPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -226,7 +388,7 @@ func (m *EventSubmitProposal) Reset()         { *m = EventSubmitProposal{} }
 func (m *EventSubmitProposal) String() string { return proto.CompactTextString(m) }
 func (*EventSubmitProposal) ProtoMessage()    {}
 func (*EventSubmitProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{4}
+	return fileDescriptor_2b66c645bbb34fbc, []int{7}
 }
 func (m *EventSubmitProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -272,7 +434,7 @@ func (m *EventWithdrawProposal) Reset()         { *m = EventWithdrawProposal{} }
 func (m *EventWithdrawProposal) String() string { return proto.CompactTextString(m) }
 func (*EventWithdrawProposal) ProtoMessage()    {}
 func (*EventWithdrawProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{5}
+	return fileDescriptor_2b66c645bbb34fbc, []int{8}
 }
 func (m *EventWithdrawProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -317,7 +479,7 @@ func (m *EventVote) Reset()         { *m = EventVote{} }
 func (m *EventVote) String() string { return proto.CompactTextString(m) }
 func (*EventVote) ProtoMessage()    {}
 func (*EventVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{6}
+	return fileDescriptor_2b66c645bbb34fbc, []int{9}
 }
 func (m *EventVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -361,13 +523,15 @@ type EventExec struct {
 	Result ProposalExecutorResult `protobuf:"varint,2,opt,name=result,proto3,enum=lbm.foundation.v1.ProposalExecutorResult" json:"result,omitempty"`
 	// logs contains error logs in case the execution result is FAILURE.
 	Logs string `protobuf:"bytes,3,opt,name=logs,proto3" json:"logs,omitempty"`
+	// message_results contains the per-message results of the execution.
+	MessageResults []MessageResult `protobuf:"bytes,4,rep,name=message_results,json=messageResults,proto3" json:"message_results"`
 }
 
 func (m *EventExec) Reset()         { *m = EventExec{} }
 func (m *EventExec) String() string { return proto.CompactTextString(m) }
 func (*EventExec) ProtoMessage()    {}
 func (*EventExec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{7}
+	return fileDescriptor_2b66c645bbb34fbc, []int{10}
 }
 func (m *EventExec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -417,6 +581,13 @@ func (m *EventExec) GetLogs() string {
 	return ""
 }
 
+func (m *EventExec) GetMessageResults() []MessageResult {
+	if m != nil {
+		return m.MessageResults
+	}
+	return nil
+}
+
 // EventLeaveFoundation is an event emitted when a foundation member leaves the foundation.
 type EventLeaveFoundation struct {
 	// address is the account address of the foundation member.
@@ -427,7 +598,7 @@ func (m *EventLeaveFoundation) Reset()         { *m = EventLeaveFoundation{} }
 func (m *EventLeaveFoundation) String() string { return proto.CompactTextString(m) }
 func (*EventLeaveFoundation) ProtoMessage()    {}
 func (*EventLeaveFoundation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{8}
+	return fileDescriptor_2b66c645bbb34fbc, []int{11}
 }
 func (m *EventLeaveFoundation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -463,6 +634,98 @@ func (m *EventLeaveFoundation) GetAddress() string {
 	return ""
 }
 
+// EventDelegateVote is an event emitted when a member delegates its vote.
+type EventDelegateVote struct {
+	Delegation VoteDelegation `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation"`
+}
+
+func (m *EventDelegateVote) Reset()         { *m = EventDelegateVote{} }
+func (m *EventDelegateVote) String() string { return proto.CompactTextString(m) }
+func (*EventDelegateVote) ProtoMessage()    {}
+func (*EventDelegateVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b66c645bbb34fbc, []int{12}
+}
+func (m *EventDelegateVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDelegateVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDelegateVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDelegateVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDelegateVote.Merge(m, src)
+}
+func (m *EventDelegateVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDelegateVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDelegateVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDelegateVote proto.InternalMessageInfo
+
+func (m *EventDelegateVote) GetDelegation() VoteDelegation {
+	if m != nil {
+		return m.Delegation
+	}
+	return VoteDelegation{}
+}
+
+// EventRevokeVoteDelegation is an event emitted when a member revokes its vote
+// delegation.
+type EventRevokeVoteDelegation struct {
+	// delegator is the account address of the member.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+}
+
+func (m *EventRevokeVoteDelegation) Reset()         { *m = EventRevokeVoteDelegation{} }
+func (m *EventRevokeVoteDelegation) String() string { return proto.CompactTextString(m) }
+func (*EventRevokeVoteDelegation) ProtoMessage()    {}
+func (*EventRevokeVoteDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b66c645bbb34fbc, []int{13}
+}
+func (m *EventRevokeVoteDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRevokeVoteDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRevokeVoteDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRevokeVoteDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRevokeVoteDelegation.Merge(m, src)
+}
+func (m *EventRevokeVoteDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRevokeVoteDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRevokeVoteDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRevokeVoteDelegation proto.InternalMessageInfo
+
+func (m *EventRevokeVoteDelegation) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
 // EventUpdateCensorship is emitted when a censorship information updated.
 type EventUpdateCensorship struct {
 	Censorship Censorship `protobuf:"bytes,1,opt,name=censorship,proto3" json:"censorship"`
@@ -472,7 +735,7 @@ func (m *EventUpdateCensorship) Reset()         { *m = EventUpdateCensorship{} }
 func (m *EventUpdateCensorship) String() string { return proto.CompactTextString(m) }
 func (*EventUpdateCensorship) ProtoMessage()    {}
 func (*EventUpdateCensorship) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{9}
+	return fileDescriptor_2b66c645bbb34fbc, []int{14}
 }
 func (m *EventUpdateCensorship) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -520,7 +783,7 @@ func (m *EventGrant) Reset()         { *m = EventGrant{} }
 func (m *EventGrant) String() string { return proto.CompactTextString(m) }
 func (*EventGrant) ProtoMessage()    {}
 func (*EventGrant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{10}
+	return fileDescriptor_2b66c645bbb34fbc, []int{15}
 }
 func (m *EventGrant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -575,7 +838,7 @@ func (m *EventRevoke) Reset()         { *m = EventRevoke{} }
 func (m *EventRevoke) String() string { return proto.CompactTextString(m) }
 func (*EventRevoke) ProtoMessage()    {}
 func (*EventRevoke) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{11}
+	return fileDescriptor_2b66c645bbb34fbc, []int{16}
 }
 func (m *EventRevoke) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -621,6 +884,9 @@ func (m *EventRevoke) GetMsgTypeUrl() string {
 func init() {
 	proto.RegisterType((*EventFundTreasury)(nil), "lbm.foundation.v1.EventFundTreasury")
 	proto.RegisterType((*EventWithdrawFromTreasury)(nil), "lbm.foundation.v1.EventWithdrawFromTreasury")
+	proto.RegisterType((*EventCreateTreasuryStream)(nil), "lbm.foundation.v1.EventCreateTreasuryStream")
+	proto.RegisterType((*EventCancelTreasuryStream)(nil), "lbm.foundation.v1.EventCancelTreasuryStream")
+	proto.RegisterType((*EventPayTreasuryStream)(nil), "lbm.foundation.v1.EventPayTreasuryStream")
 	proto.RegisterType((*EventUpdateMembers)(nil), "lbm.foundation.v1.EventUpdateMembers")
 	proto.RegisterType((*EventUpdateDecisionPolicy)(nil), "lbm.foundation.v1.EventUpdateDecisionPolicy")
 	proto.RegisterType((*EventSubmitProposal)(nil), "lbm.foundation.v1.EventSubmitProposal")
@@ -628,6 +894,8 @@ func init() {
 	proto.RegisterType((*EventVote)(nil), "lbm.foundation.v1.EventVote")
 	proto.RegisterType((*EventExec)(nil), "lbm.foundation.v1.EventExec")
 	proto.RegisterType((*EventLeaveFoundation)(nil), "lbm.foundation.v1.EventLeaveFoundation")
+	proto.RegisterType((*EventDelegateVote)(nil), "lbm.foundation.v1.EventDelegateVote")
+	proto.RegisterType((*EventRevokeVoteDelegation)(nil), "lbm.foundation.v1.EventRevokeVoteDelegation")
 	proto.RegisterType((*EventUpdateCensorship)(nil), "lbm.foundation.v1.EventUpdateCensorship")
 	proto.RegisterType((*EventGrant)(nil), "lbm.foundation.v1.EventGrant")
 	proto.RegisterType((*EventRevoke)(nil), "lbm.foundation.v1.EventRevoke")
@@ -636,52 +904,63 @@ func init() {
 func init() { proto.RegisterFile("lbm/foundation/v1/event.proto", fileDescriptor_2b66c645bbb34fbc) }
 
 var fileDescriptor_2b66c645bbb34fbc = []byte{
-	// 720 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0xc1, 0x4f, 0x13, 0x4f,
-	0x14, 0xc7, 0xbb, 0xd0, 0xf0, 0xfb, 0x31, 0x95, 0x1a, 0x56, 0x8c, 0x05, 0x43, 0xdb, 0xec, 0x09,
-	0x13, 0xbb, 0x6b, 0xd1, 0x18, 0x43, 0xa2, 0x09, 0x45, 0x4a, 0x48, 0x24, 0xc1, 0x15, 0x34, 0x31,
-	0x26, 0xcd, 0xec, 0xee, 0x74, 0x3b, 0x61, 0x77, 0xdf, 0x3a, 0x33, 0xbb, 0x52, 0xae, 0x5e, 0x3c,
-	0x72, 0xf0, 0x6c, 0x3c, 0x7b, 0xe6, 0x8f, 0x20, 0x9c, 0x38, 0x7a, 0x52, 0x03, 0xff, 0x88, 0xd9,
-	0xd9, 0x69, 0xa1, 0x01, 0xc1, 0x93, 0xb7, 0xf7, 0x66, 0xde, 0xf7, 0xfb, 0x3e, 0xf3, 0x66, 0x06,
-	0xcd, 0x07, 0x4e, 0x68, 0x75, 0x21, 0x89, 0x3c, 0x2c, 0x28, 0x44, 0x56, 0xda, 0xb4, 0x48, 0x4a,
-	0x22, 0x61, 0xc6, 0x0c, 0x04, 0xe8, 0xd3, 0x81, 0x13, 0x9a, 0x67, 0xdb, 0x66, 0xda, 0x9c, 0x9b,
-	0xf1, 0xc1, 0x07, 0xb9, 0x6b, 0x65, 0x51, 0x5e, 0x38, 0x37, 0xeb, 0x03, 0xf8, 0x01, 0xb1, 0x64,
-	0xe6, 0x24, 0x5d, 0x0b, 0x47, 0xfd, 0xc1, 0x96, 0x0b, 0x3c, 0x04, 0xde, 0xc9, 0x35, 0x79, 0xa2,
-	0xb6, 0xaa, 0x79, 0x66, 0x39, 0x98, 0x13, 0x2b, 0x6d, 0x3a, 0x44, 0xe0, 0xa6, 0xe5, 0x02, 0x8d,
-	0xd4, 0xbe, 0x71, 0x91, 0xee, 0x1c, 0x8c, 0xac, 0x31, 0xf6, 0x35, 0x34, 0xbd, 0x9a, 0x21, 0xb7,
-	0x93, 0xc8, 0xdb, 0x62, 0x04, 0xf3, 0x84, 0xf5, 0x75, 0x1d, 0x15, 0xbb, 0x0c, 0xc2, 0x8a, 0x56,
-	0xd7, 0x16, 0x26, 0x6d, 0x19, 0xeb, 0x3e, 0x9a, 0xc0, 0x21, 0x24, 0x91, 0xa8, 0x8c, 0xd5, 0xc7,
-	0x17, 0x4a, 0x8b, 0xb3, 0xa6, 0x82, 0xc9, 0xda, 0x9b, 0xaa, 0xbd, 0xb9, 0x02, 0x34, 0x6a, 0x3d,
-	0x3a, 0xfc, 0x51, 0x2b, 0x7c, 0xfb, 0x59, 0xbb, 0xef, 0x53, 0xd1, 0x4b, 0x1c, 0xd3, 0x85, 0xd0,
-	0x6a, 0xd3, 0x88, 0xbb, 0x3d, 0x8a, 0xad, 0xae, 0x0a, 0x1a, 0xdc, 0xdb, 0xb1, 0x44, 0x3f, 0x26,
-	0x5c, 0x8a, 0xb8, 0xad, 0xec, 0x8d, 0xcf, 0x1a, 0x9a, 0x95, 0x48, 0x6f, 0xa8, 0xe8, 0x79, 0x0c,
-	0x7f, 0x68, 0x33, 0x08, 0x87, 0x68, 0x65, 0x34, 0x26, 0x40, 0x81, 0x8d, 0x09, 0xf8, 0x77, 0x58,
-	0x2e, 0xd2, 0x25, 0xd5, 0x76, 0xec, 0x61, 0x41, 0x36, 0x48, 0xe8, 0x10, 0xc6, 0xf5, 0x0d, 0x54,
-	0x0e, 0x65, 0xd8, 0x49, 0xe4, 0x3a, 0xaf, 0x68, 0x12, 0xa3, 0x6e, 0x5e, 0xb8, 0x7b, 0x33, 0xd7,
-	0xd8, 0xe4, 0x7d, 0x42, 0xb8, 0x68, 0x15, 0x33, 0x1a, 0x7b, 0x2a, 0x57, 0xe7, 0xa6, 0xdc, 0x10,
-	0xea, 0xe8, 0x79, 0xfe, 0x9c, 0xb8, 0x94, 0x53, 0x88, 0x36, 0x21, 0xa0, 0x6e, 0x5f, 0x7f, 0x89,
-	0x6e, 0x7a, 0x6a, 0xa5, 0x13, 0xcb, 0x25, 0x39, 0x87, 0xd2, 0xe2, 0x8c, 0x99, 0xbf, 0x1f, 0x73,
-	0xf0, 0x7e, 0xcc, 0xe5, 0xa8, 0xdf, 0xd2, 0x8f, 0x0e, 0x1a, 0xe5, 0x51, 0x0b, 0xbb, 0xec, 0x8d,
-	0xe4, 0x4b, 0xc5, 0x4f, 0x5f, 0x6b, 0x05, 0x63, 0x0b, 0xdd, 0x92, 0x5d, 0x5f, 0x25, 0x4e, 0x48,
-	0xc5, 0x26, 0x83, 0x18, 0x38, 0x0e, 0xf4, 0xa7, 0xe8, 0xff, 0x58, 0xc5, 0xaa, 0xd1, 0xdd, 0x4b,
-	0x4e, 0x35, 0x28, 0x57, 0x07, 0x1a, 0x4a, 0x8c, 0x27, 0xe8, 0xf6, 0xc8, 0x35, 0x0e, 0x7d, 0x6b,
-	0xa8, 0x34, 0x28, 0xea, 0x50, 0x4f, 0x5a, 0x17, 0x6d, 0x34, 0x58, 0x5a, 0xf7, 0x8c, 0x67, 0x68,
-	0x52, 0x2a, 0x5f, 0x83, 0x20, 0x7a, 0x13, 0x15, 0x53, 0x10, 0x44, 0x11, 0xdc, 0xb9, 0x84, 0x20,
-	0x2b, 0x53, 0xdd, 0x65, 0xa9, 0xf1, 0x51, 0x53, 0x06, 0xab, 0xbb, 0xc4, 0xbd, 0xb6, 0x9d, 0xbe,
-	0x8c, 0x26, 0x18, 0xe1, 0x49, 0x90, 0x3d, 0x21, 0x6d, 0xa1, 0xbc, 0x78, 0xef, 0x8a, 0x53, 0x66,
-	0x8e, 0x89, 0x00, 0x66, 0x4b, 0x81, 0xad, 0x84, 0xd9, 0x87, 0x09, 0xc0, 0xe7, 0x95, 0xf1, 0xfc,
-	0xc3, 0x64, 0xb1, 0xf1, 0x00, 0xcd, 0x48, 0x88, 0x17, 0x04, 0xa7, 0xa4, 0x3d, 0x74, 0xd3, 0x2b,
-	0xe8, 0x3f, 0xec, 0x79, 0x8c, 0x70, 0xae, 0x9e, 0xf1, 0x20, 0x35, 0xde, 0xa9, 0x89, 0xe5, 0xb7,
-	0xbf, 0x42, 0x22, 0x0e, 0x8c, 0xf7, 0x68, 0xac, 0xaf, 0x20, 0xe4, 0x0e, 0x33, 0x35, 0x89, 0xf9,
-	0x4b, 0x28, 0xcf, 0x24, 0x6a, 0x1e, 0xe7, 0x64, 0xc6, 0x17, 0x0d, 0x21, 0x69, 0xbf, 0xc6, 0x70,
-	0x24, 0x32, 0x0c, 0x3f, 0x0b, 0x08, 0x19, 0x60, 0xa8, 0x54, 0x4f, 0xd1, 0x14, 0x4e, 0x44, 0x0f,
-	0x18, 0xdd, 0x93, 0xce, 0x72, 0x2c, 0x7f, 0x7a, 0x65, 0x4b, 0x47, 0x07, 0x8d, 0xc7, 0xd7, 0x7e,
-	0xa8, 0x5d, 0x2b, 0x73, 0xdc, 0x33, 0x97, 0xcf, 0xfb, 0xda, 0xa3, 0x6d, 0x8c, 0x75, 0x54, 0x92,
-	0x7c, 0x36, 0x49, 0x61, 0x87, 0x5c, 0x01, 0x58, 0x47, 0x37, 0x42, 0xee, 0x77, 0xb2, 0x5f, 0xda,
-	0x49, 0x58, 0x20, 0xf9, 0x26, 0x6d, 0x14, 0x72, 0x7f, 0xab, 0x1f, 0x93, 0x6d, 0x16, 0xb4, 0xd6,
-	0x0e, 0x4f, 0xaa, 0xda, 0xf1, 0x49, 0x55, 0xfb, 0x75, 0x52, 0xd5, 0xf6, 0x4f, 0xab, 0x85, 0xe3,
-	0xd3, 0x6a, 0xe1, 0xfb, 0x69, 0xb5, 0xf0, 0xb6, 0xf1, 0x17, 0xac, 0x67, 0x43, 0x75, 0x26, 0xe4,
-	0x61, 0x1f, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xdd, 0x6e, 0x65, 0xdb, 0xea, 0x05, 0x00, 0x00,
+	// 889 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x26, 0x56, 0x68, 0x5e, 0xa8, 0xab, 0x2e, 0x29, 0x38, 0x2d, 0x75, 0xc2, 0x9e, 0x8a,
+	0x44, 0x76, 0x71, 0x40, 0x08, 0x2a, 0x01, 0x4a, 0x9c, 0x26, 0x8a, 0x44, 0x45, 0xd8, 0xa6, 0x20,
+	0xa1, 0x48, 0xd6, 0x78, 0xf7, 0x65, 0x3d, 0xea, 0xce, 0xce, 0x32, 0x33, 0x6b, 0xea, 0xfe, 0x02,
+	0x8e, 0x3d, 0x70, 0x46, 0xdc, 0x90, 0x38, 0xf7, 0xc8, 0x0f, 0xa8, 0x7a, 0xea, 0x81, 0x03, 0x27,
+	0x40, 0xc9, 0x1f, 0x41, 0x3b, 0x33, 0xeb, 0xb5, 0x69, 0xe4, 0xc2, 0x21, 0xbd, 0xbd, 0xf7, 0xe6,
+	0xfb, 0xde, 0xfb, 0xe6, 0xbd, 0x99, 0x07, 0x37, 0xd3, 0x01, 0x0b, 0x4e, 0x78, 0x91, 0xc5, 0x44,
+	0x51, 0x9e, 0x05, 0xa3, 0x6e, 0x80, 0x23, 0xcc, 0x94, 0x9f, 0x0b, 0xae, 0xb8, 0x7b, 0x35, 0x1d,
+	0x30, 0xbf, 0x3e, 0xf6, 0x47, 0xdd, 0xeb, 0xab, 0x09, 0x4f, 0xb8, 0x3e, 0x0d, 0x4a, 0xcb, 0x00,
+	0xaf, 0xaf, 0x25, 0x9c, 0x27, 0x29, 0x06, 0xda, 0x1b, 0x14, 0x27, 0x01, 0xc9, 0xc6, 0xd5, 0x51,
+	0xc4, 0x25, 0xe3, 0xb2, 0x6f, 0x38, 0xc6, 0xb1, 0x47, 0x1d, 0xe3, 0x05, 0x03, 0x22, 0x31, 0x18,
+	0x75, 0x07, 0xa8, 0x48, 0x37, 0x88, 0x38, 0xcd, 0xec, 0xb9, 0xf7, 0xa2, 0xba, 0xda, 0x33, 0x18,
+	0xef, 0xb1, 0x03, 0x57, 0xef, 0x94, 0x92, 0xf7, 0x8a, 0x2c, 0x3e, 0x12, 0x48, 0x64, 0x21, 0xc6,
+	0xae, 0x0b, 0xcd, 0x13, 0xc1, 0x59, 0xdb, 0xd9, 0x70, 0x6e, 0x2d, 0x87, 0xda, 0x76, 0x13, 0x58,
+	0x22, 0x8c, 0x17, 0x99, 0x6a, 0x2f, 0x6c, 0x2c, 0xde, 0x5a, 0xd9, 0x5a, 0xf3, 0xad, 0x98, 0xb2,
+	0xbc, 0x6f, 0xcb, 0xfb, 0x3d, 0x4e, 0xb3, 0x9d, 0x0f, 0x9f, 0xfe, 0xb9, 0xde, 0xf8, 0xf5, 0xaf,
+	0xf5, 0xf7, 0x12, 0xaa, 0x86, 0xc5, 0xc0, 0x8f, 0x38, 0x0b, 0xf6, 0x68, 0x26, 0xa3, 0x21, 0x25,
+	0xc1, 0x89, 0x35, 0x36, 0x65, 0xfc, 0x20, 0x50, 0xe3, 0x1c, 0xa5, 0x26, 0xc9, 0xd0, 0xa6, 0xf7,
+	0x7e, 0x74, 0x60, 0x4d, 0x4b, 0xfa, 0x86, 0xaa, 0x61, 0x2c, 0xc8, 0xf7, 0x7b, 0x82, 0xb3, 0x89,
+	0xb4, 0x16, 0x2c, 0x28, 0x6e, 0x85, 0x2d, 0x28, 0xfe, 0xea, 0x64, 0x1d, 0x5b, 0x55, 0x3d, 0x81,
+	0x44, 0x61, 0xa5, 0xe7, 0x9e, 0x12, 0x48, 0x98, 0xfb, 0x39, 0x2c, 0x49, 0x6d, 0x69, 0x65, 0x2b,
+	0x5b, 0xef, 0xf8, 0x2f, 0x8c, 0xde, 0x9f, 0xa5, 0xec, 0x34, 0x4b, 0x35, 0xa1, 0xa5, 0x79, 0xbf,
+	0x54, 0x97, 0xee, 0x91, 0x2c, 0xc2, 0xf4, 0x5f, 0xe9, 0x6f, 0xc0, 0xb2, 0xc1, 0xf5, 0x69, 0xac,
+	0x2b, 0x34, 0xc3, 0x4b, 0x26, 0x70, 0x10, 0xbb, 0x0c, 0x96, 0x05, 0x32, 0x42, 0xb3, 0x18, 0xc5,
+	0x45, 0x35, 0xa1, 0xae, 0xe0, 0xfd, 0xe6, 0xc0, 0x9b, 0x5a, 0xe9, 0x21, 0x19, 0xff, 0x1f, 0x99,
+	0x6f, 0x97, 0x32, 0x23, 0x9a, 0x53, 0xd4, 0xb3, 0x2a, 0xe7, 0x57, 0x07, 0xa6, 0xc6, 0xb8, 0x78,
+	0xb1, 0x63, 0x8c, 0xc0, 0xd5, 0xea, 0xef, 0xe7, 0x31, 0x51, 0x78, 0x17, 0xd9, 0x00, 0x85, 0x74,
+	0xef, 0x42, 0x8b, 0x69, 0xb3, 0x5f, 0xe8, 0xb8, 0x6c, 0x3b, 0x5a, 0xc6, 0xc6, 0x39, 0x73, 0x34,
+	0x9c, 0x10, 0xbf, 0x2b, 0x50, 0x2a, 0x3b, 0xc6, 0xcb, 0x86, 0x6d, 0x92, 0x4a, 0x4f, 0xd9, 0x61,
+	0x1a, 0x7f, 0x17, 0x23, 0x2a, 0x29, 0xcf, 0x0e, 0x79, 0x4a, 0xa3, 0xb1, 0xfb, 0x15, 0x5c, 0x89,
+	0x6d, 0xa4, 0x9f, 0xeb, 0x90, 0x7d, 0x34, 0xab, 0xbe, 0x59, 0x03, 0x7e, 0xb5, 0x06, 0xfc, 0xed,
+	0x6c, 0xbc, 0xe3, 0x3e, 0x7b, 0xb2, 0xd9, 0x9a, 0x4d, 0x11, 0xb6, 0xe2, 0x19, 0xff, 0x76, 0xf3,
+	0x87, 0x9f, 0xd7, 0x1b, 0xde, 0x11, 0xbc, 0xa1, 0xab, 0xde, 0x2b, 0x06, 0x8c, 0xaa, 0x43, 0xc1,
+	0x73, 0x2e, 0x49, 0xea, 0x7e, 0x0a, 0x97, 0x72, 0x6b, 0xdb, 0x42, 0x37, 0xce, 0xb9, 0x55, 0x05,
+	0xb7, 0x17, 0x9a, 0x50, 0xbc, 0x8f, 0xe1, 0xda, 0xcc, 0x6f, 0x9c, 0xe4, 0x5d, 0x87, 0x95, 0x0a,
+	0x54, 0xcf, 0x1b, 0xaa, 0xd0, 0x41, 0xec, 0x7d, 0x06, 0xcb, 0x9a, 0xf9, 0x35, 0x57, 0xe8, 0x76,
+	0xa1, 0x39, 0xe2, 0x0a, 0xad, 0x82, 0xb7, 0xce, 0x51, 0x50, 0xc2, 0x6c, 0x75, 0x0d, 0xf5, 0x7e,
+	0x77, 0x6c, 0x82, 0x3b, 0x0f, 0x31, 0x7a, 0x69, 0x39, 0x77, 0x1b, 0x96, 0x04, 0xca, 0x22, 0x35,
+	0xaf, 0xab, 0xb5, 0xf5, 0xee, 0x9c, 0x5b, 0x96, 0x19, 0x0b, 0xc5, 0x45, 0xa8, 0x09, 0xa1, 0x25,
+	0x96, 0x7b, 0x2f, 0xe5, 0x89, 0x6c, 0x2f, 0x9a, 0xbd, 0x57, 0xda, 0xee, 0x97, 0x70, 0x85, 0xa1,
+	0x94, 0x24, 0xc1, 0xbe, 0x41, 0xc9, 0x76, 0x73, 0xce, 0xdb, 0xd0, 0x48, 0x93, 0xd6, 0x5e, 0xa6,
+	0xc5, 0xa6, 0x83, 0xd2, 0x7b, 0x1f, 0x56, 0xf5, 0xad, 0xbe, 0x40, 0x32, 0xc2, 0xbd, 0x09, 0xdd,
+	0x6d, 0xc3, 0x6b, 0x24, 0x8e, 0x05, 0x4a, 0x69, 0xd7, 0x5b, 0xe5, 0x7a, 0xc7, 0x76, 0x47, 0xef,
+	0x62, 0x8a, 0x09, 0x51, 0xa8, 0x1b, 0xba, 0x0f, 0x10, 0x1b, 0x9f, 0xf2, 0x6c, 0xce, 0xda, 0x29,
+	0xc1, 0xbb, 0x13, 0xa0, 0xd5, 0x34, 0x45, 0xf5, 0x3e, 0xb1, 0x8f, 0x35, 0xc4, 0x11, 0x7f, 0x80,
+	0xb3, 0xf0, 0xf2, 0xd7, 0x5a, 0x28, 0x17, 0x56, 0x56, 0x1d, 0xf0, 0x8e, 0xe1, 0xda, 0xd4, 0x3b,
+	0xef, 0x61, 0x26, 0xb9, 0x90, 0x43, 0x9a, 0xbb, 0x3d, 0x80, 0x68, 0xe2, 0x59, 0x71, 0x37, 0xcf,
+	0x11, 0x57, 0x53, 0x2a, 0x61, 0x35, 0xcd, 0xfb, 0xc9, 0x01, 0xd0, 0xe9, 0xf7, 0x05, 0xc9, 0x54,
+	0xd9, 0x9f, 0xa4, 0x34, 0x10, 0xab, 0xfe, 0x58, 0xd7, 0x1d, 0xc1, 0x65, 0x52, 0xa8, 0x21, 0x17,
+	0xf4, 0x91, 0xe9, 0xc6, 0xc2, 0x9c, 0xff, 0x74, 0xfb, 0xd9, 0x93, 0xcd, 0x8f, 0x5e, 0xba, 0x3a,
+	0x1e, 0x06, 0x65, 0xc6, 0x47, 0xfe, 0xf6, 0x74, 0xde, 0x70, 0xb6, 0x8c, 0x77, 0x00, 0x2b, 0x53,
+	0x9d, 0x9b, 0x23, 0x70, 0x03, 0x5e, 0x67, 0x32, 0xe9, 0x97, 0xfb, 0xa8, 0x5f, 0x88, 0xd4, 0xae,
+	0x3f, 0x60, 0x32, 0x39, 0x1a, 0xe7, 0x78, 0x5f, 0xa4, 0x3b, 0xfb, 0x4f, 0x4f, 0x3b, 0xce, 0xf3,
+	0xd3, 0x8e, 0xf3, 0xf7, 0x69, 0xc7, 0x79, 0x7c, 0xd6, 0x69, 0x3c, 0x3f, 0xeb, 0x34, 0xfe, 0x38,
+	0xeb, 0x34, 0xbe, 0xdd, 0xfc, 0x0f, 0x5a, 0xeb, 0xa6, 0x0e, 0x96, 0xf4, 0x65, 0x3f, 0xf8, 0x67,
+	0x00, 0x3f, 0x8b, 0x52, 0x59, 0x9b, 0x08, 0x00, 0x00,
 }
 
 func (m *EventFundTreasury) Marshal() (dAtA []byte, err error) {
@@ -772,7 +1051,7 @@ func (m *EventWithdrawFromTreasury) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *EventUpdateMembers) Marshal() (dAtA []byte, err error) {
+func (m *EventCreateTreasuryStream) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -782,34 +1061,30 @@ func (m *EventUpdateMembers) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventUpdateMembers) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventCreateTreasuryStream) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventUpdateMembers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventCreateTreasuryStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.MemberUpdates) > 0 {
-		for iNdEx := len(m.MemberUpdates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MemberUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvent(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.Stream.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *EventUpdateDecisionPolicy) Marshal() (dAtA []byte, err error) {
+func (m *EventCancelTreasuryStream) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -819,32 +1094,39 @@ func (m *EventUpdateDecisionPolicy) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventUpdateDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventCancelTreasuryStream) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventUpdateDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventCancelTreasuryStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DecisionPolicy != nil {
-		{
-			size, err := m.DecisionPolicy.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Remainder) > 0 {
+		for iNdEx := len(m.Remainder) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remainder[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
 		}
+	}
+	if m.StreamId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.StreamId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventSubmitProposal) Marshal() (dAtA []byte, err error) {
+func (m *EventPayTreasuryStream) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -854,30 +1136,46 @@ func (m *EventSubmitProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventSubmitProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventPayTreasuryStream) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventPayTreasuryStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StreamId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventWithdrawProposal) Marshal() (dAtA []byte, err error) {
+func (m *EventUpdateMembers) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -887,26 +1185,131 @@ func (m *EventWithdrawProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventWithdrawProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventUpdateMembers) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventWithdrawProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventUpdateMembers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ProposalId != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.ProposalId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *EventVote) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
+	if len(m.MemberUpdates) > 0 {
+		for iNdEx := len(m.MemberUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MemberUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateDecisionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DecisionPolicy != nil {
+		{
+			size, err := m.DecisionPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSubmitProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSubmitProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventWithdrawProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventWithdrawProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventWithdrawProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
@@ -958,6 +1361,20 @@ func (m *EventExec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MessageResults) > 0 {
+		for iNdEx := len(m.MessageResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MessageResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Logs) > 0 {
 		i -= len(m.Logs)
 		copy(dAtA[i:], m.Logs)
@@ -1008,6 +1425,69 @@ func (m *EventLeaveFoundation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventDelegateVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDelegateVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDelegateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRevokeVoteDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRevokeVoteDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRevokeVoteDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventUpdateCensorship) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1169,6 +1649,57 @@ func (m *EventWithdrawFromTreasury) Size() (n int) {
 	return n
 }
 
+func (m *EventCreateTreasuryStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stream.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventCancelTreasuryStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		n += 1 + sovEvent(uint64(m.StreamId))
+	}
+	if len(m.Remainder) > 0 {
+		for _, e := range m.Remainder {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventPayTreasuryStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		n += 1 + sovEvent(uint64(m.StreamId))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *EventUpdateMembers) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1247,6 +1778,12 @@ func (m *EventExec) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
+	if len(m.MessageResults) > 0 {
+		for _, e := range m.MessageResults {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1263,6 +1800,30 @@ func (m *EventLeaveFoundation) Size() (n int) {
 	return n
 }
 
+func (m *EventDelegateVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Delegation.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventRevokeVoteDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
 func (m *EventUpdateCensorship) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1305,16 +1866,331 @@ func (m *EventRevoke) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventFundTreasury) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFundTreasury: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFundTreasury: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventWithdrawFromTreasury) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventWithdrawFromTreasury: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventWithdrawFromTreasury: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCreateTreasuryStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreateTreasuryStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreateTreasuryStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EventFundTreasury) Unmarshal(dAtA []byte) error {
+func (m *EventCancelTreasuryStream) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1337,17 +2213,17 @@ func (m *EventFundTreasury) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventFundTreasury: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCancelTreasuryStream: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventFundTreasury: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCancelTreasuryStream: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
 			}
-			var stringLen uint64
+			m.StreamId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1357,27 +2233,14 @@ func (m *EventFundTreasury) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StreamId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.From = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Remainder", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1404,8 +2267,8 @@ func (m *EventFundTreasury) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Remainder = append(m.Remainder, types.Coin{})
+			if err := m.Remainder[len(m.Remainder)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1430,7 +2293,7 @@ func (m *EventFundTreasury) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventWithdrawFromTreasury) Unmarshal(dAtA []byte) error {
+func (m *EventPayTreasuryStream) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1453,15 +2316,34 @@ func (m *EventWithdrawFromTreasury) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventWithdrawFromTreasury: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPayTreasuryStream: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventWithdrawFromTreasury: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPayTreasuryStream: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1489,9 +2371,9 @@ func (m *EventWithdrawFromTreasury) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -2050,6 +2932,40 @@ func (m *EventExec) Unmarshal(dAtA []byte) error {
 			}
 			m.Logs = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageResults = append(m.MessageResults, MessageResult{})
+			if err := m.MessageResults[len(m.MessageResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -2153,6 +3069,171 @@ func (m *EventLeaveFoundation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventDelegateVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDelegateVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDelegateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRevokeVoteDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRevokeVoteDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRevokeVoteDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventUpdateCensorship) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -10,12 +10,16 @@ type (
 	// foundation module.
 	AuthKeeper interface {
 		GetModuleAccount(ctx sdk.Context, name string) authtypes.ModuleAccountI
+
+		// only used for simulation
+		GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
 	}
 
 	// BankKeeper defines the bank module interface contract needed by the
 	// foundation module.
 	BankKeeper interface {
 		GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+		SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 
 		SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 		SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
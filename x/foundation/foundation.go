@@ -103,6 +103,10 @@ func (m Member) ValidateBasic() error {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid member address: %s", m.Address)
 	}
 
+	if err := validateWeight(m.Weight); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -112,6 +116,31 @@ func (m MemberRequest) ValidateBasic() error {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid member address: %s", m.Address)
 	}
 
+	// the weight of the member to remove is ignored
+	if !m.Remove {
+		if err := validateWeight(m.GetWeight()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetWeight returns the weight of the member, which defaults to one for the requests omitting it,
+// as the members used to have one vote each.
+func (m MemberRequest) GetWeight() sdk.Dec {
+	if m.Weight.IsNil() {
+		return sdk.OneDec()
+	}
+
+	return m.Weight
+}
+
+func validateWeight(weight sdk.Dec) error {
+	if weight.IsNil() || !weight.IsPositive() {
+		return sdkerrors.ErrInvalidRequest.Wrap("weight must be positive")
+	}
+
 	return nil
 }
 
@@ -149,9 +178,7 @@ func NewTallyResult(yes, abstain, no, veto sdk.Dec) TallyResult {
 	}
 }
 
-func (t *TallyResult) Add(option VoteOption) error {
-	weight := sdk.OneDec()
-
+func (t *TallyResult) Add(option VoteOption, weight sdk.Dec) error {
 	switch option {
 	case VOTE_OPTION_YES:
 		t.YesCount = t.YesCount.Add(weight)
@@ -504,6 +531,96 @@ func (p Pool) ValidateBasic() error {
 	return nil
 }
 
+func (s TreasuryStream) ValidateBasic() error {
+	if s.Id == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("id must be > 0")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(s.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", s.Recipient)
+	}
+
+	if !s.Amount.IsValid() || !s.Amount.IsAllPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrap(s.Amount.String())
+	}
+
+	if !s.Paid.IsValid() || !s.Paid.IsAllLTE(s.Amount) {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid paid amount: %s", s.Paid)
+	}
+
+	if err := validateStreamSchedule(s.StartTime, s.EndTime, s.CliffTime); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validateStreamSchedule(start, end, cliff time.Time) error {
+	if !end.After(start) {
+		return sdkerrors.ErrInvalidRequest.Wrap("end time must be after start time")
+	}
+
+	if cliff.Before(start) || cliff.After(end) {
+		return sdkerrors.ErrInvalidRequest.Wrap("cliff time must be between start time and end time")
+	}
+
+	return nil
+}
+
+// Accrued returns the amount of the stream accrued at the given time,
+// including the amount already paid out.
+func (s TreasuryStream) Accrued(now time.Time) sdk.Coins {
+	if now.Before(s.CliffTime) {
+		return sdk.NewCoins()
+	}
+	if !now.Before(s.EndTime) {
+		return s.Amount
+	}
+
+	elapsed := int64(now.Sub(s.StartTime))
+	duration := int64(s.EndTime.Sub(s.StartTime))
+
+	accrued := make([]sdk.Coin, 0, len(s.Amount))
+	for _, coin := range s.Amount {
+		amount := coin.Amount.MulRaw(elapsed).QuoRaw(duration)
+		accrued = append(accrued, sdk.NewCoin(coin.Denom, amount))
+	}
+
+	return sdk.NewCoins(accrued...)
+}
+
+// Remainder returns the amount of the stream not paid out yet.
+func (s TreasuryStream) Remainder() sdk.Coins {
+	return s.Amount.Sub(s.Paid)
+}
+
+func (d VoteDelegation) ValidateBasic() error {
+	delegator, err := sdk.AccAddressFromBech32(d.Delegator)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", d.Delegator)
+	}
+
+	delegate, err := sdk.AccAddressFromBech32(d.Delegate)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegate address: %s", d.Delegate)
+	}
+
+	if delegator.Equals(delegate) {
+		return sdkerrors.ErrInvalidRequest.Wrap("delegator cannot delegate to itself")
+	}
+
+	if !d.EndTime.After(d.StartTime) {
+		return sdkerrors.ErrInvalidRequest.Wrap("end time must be after start time")
+	}
+
+	return nil
+}
+
+// IsActive returns whether the delegation is active at the given time.
+func (d VoteDelegation) IsActive(now time.Time) bool {
+	return !now.Before(d.StartTime) && now.Before(d.EndTime)
+}
+
 // Members defines a repeated slice of Member objects.
 type Members struct {
 	Members []Member
@@ -528,6 +645,15 @@ func (ms Members) ValidateBasic() error {
 	return nil
 }
 
+// TotalWeight returns the sum of the weights of the members.
+func (ms Members) TotalWeight() sdk.Dec {
+	total := sdk.ZeroDec()
+	for _, member := range ms.Members {
+		total = total.Add(member.Weight)
+	}
+	return total
+}
+
 // MemberRequests defines a repeated slice of MemberRequest objects.
 type MemberRequests struct {
 	Members []MemberRequest
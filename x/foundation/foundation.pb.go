@@ -4,6 +4,7 @@
 package foundation
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	types "github.com/Finschia/finschia-sdk/codec/types"
 	github_com_Finschia_finschia_sdk_types "github.com/Finschia/finschia-sdk/types"
@@ -277,7 +278,7 @@ func (m *Censorship) GetAuthority() CensorshipAuthority {
 	return CensorshipAuthorityUnspecified
 }
 
-// Member represents a foundation member with an account address and metadata.
+// Member represents a foundation member with an account address, weight and metadata.
 type Member struct {
 	// address is the member's account address.
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
@@ -285,6 +286,8 @@ type Member struct {
 	Metadata string `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	// added_at is a timestamp specifying when a member was added.
 	AddedAt time.Time `protobuf:"bytes,4,opt,name=added_at,json=addedAt,proto3,stdtime" json:"added_at"`
+	// weight is the member's voting weight that should be greater than 0.
+	Weight github_com_Finschia_finschia_sdk_types.Dec `protobuf:"bytes,5,opt,name=weight,proto3,customtype=github.com/Finschia/finschia-sdk/types.Dec" json:"weight"`
 }
 
 func (m *Member) Reset()         { *m = Member{} }
@@ -351,6 +354,9 @@ type MemberRequest struct {
 	Remove bool `protobuf:"varint,2,opt,name=remove,proto3" json:"remove,omitempty"`
 	// metadata is any arbitrary metadata attached to the member.
 	Metadata string `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// weight is the member's voting weight that should be greater than 0.
+	// It defaults to 1 if omitted, and is ignored if remove is true.
+	Weight github_com_Finschia_finschia_sdk_types.Dec `protobuf:"bytes,4,opt,name=weight,proto3,customtype=github.com/Finschia/finschia-sdk/types.Dec" json:"weight"`
 }
 
 func (m *MemberRequest) Reset()         { *m = MemberRequest{} }
@@ -632,7 +638,7 @@ type FoundationInfo struct {
 	// this version is incremented and will cause proposals based on older versions
 	// of the foundation to fail
 	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
-	// total_weight is the number of the foundation members.
+	// total_weight is the sum of the weights of the foundation members.
 	TotalWeight github_com_Finschia_finschia_sdk_types.Dec `protobuf:"bytes,2,opt,name=total_weight,json=totalWeight,proto3,customtype=github.com/Finschia/finschia-sdk/types.Dec" json:"total_weight"`
 	// decision_policy specifies the foundation's decision policy.
 	DecisionPolicy *types.Any `protobuf:"bytes,3,opt,name=decision_policy,json=decisionPolicy,proto3" json:"decision_policy,omitempty"`
@@ -704,6 +710,14 @@ type Proposal struct {
 	ExecutorResult ProposalExecutorResult `protobuf:"varint,9,opt,name=executor_result,json=executorResult,proto3,enum=lbm.foundation.v1.ProposalExecutorResult" json:"executor_result,omitempty"`
 	// messages is a list of Msgs that will be executed if the proposal passes.
 	Messages []*types.Any `protobuf:"bytes,10,rep,name=messages,proto3" json:"messages,omitempty"`
+	// message_results contains the per-message results of the last execution.
+	// As the messages are executed atomically, the results are recorded up to
+	// the first failing message.
+	MessageResults []MessageResult `protobuf:"bytes,11,rep,name=message_results,json=messageResults,proto3" json:"message_results"`
+	// retry_deadline is the timestamp after which a failed proposal cannot be
+	// executed again. It is set on the first failed execution, and is never
+	// before voting_period_end + max_execution_period.
+	RetryDeadline *time.Time `protobuf:"bytes,12,opt,name=retry_deadline,json=retryDeadline,proto3,stdtime" json:"retry_deadline,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -739,6 +753,70 @@ func (m *Proposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Proposal proto.InternalMessageInfo
 
+// MessageResult represents the execution result of a message in a proposal.
+type MessageResult struct {
+	// result is the execution result of the message.
+	Result ProposalExecutorResult `protobuf:"varint,1,opt,name=result,proto3,enum=lbm.foundation.v1.ProposalExecutorResult" json:"result,omitempty"`
+	// data is the data returned by the message handler.
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// error contains the error log in case the result is FAILURE.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *MessageResult) Reset()         { *m = MessageResult{} }
+func (m *MessageResult) String() string { return proto.CompactTextString(m) }
+func (*MessageResult) ProtoMessage()    {}
+func (*MessageResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1980496a233f02f4, []int{10}
+}
+func (m *MessageResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageResult.Merge(m, src)
+}
+func (m *MessageResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageResult proto.InternalMessageInfo
+
+func (m *MessageResult) GetResult() ProposalExecutorResult {
+	if m != nil {
+		return m.Result
+	}
+	return PROPOSAL_EXECUTOR_RESULT_UNSPECIFIED
+}
+
+func (m *MessageResult) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *MessageResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 // TallyResult represents the sum of votes for each vote option.
 type TallyResult struct {
 	// yes_count is the sum of yes votes.
@@ -755,7 +833,7 @@ func (m *TallyResult) Reset()         { *m = TallyResult{} }
 func (m *TallyResult) String() string { return proto.CompactTextString(m) }
 func (*TallyResult) ProtoMessage()    {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1980496a233f02f4, []int{10}
+	return fileDescriptor_1980496a233f02f4, []int{11}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -796,13 +874,16 @@ type Vote struct {
 	Metadata string `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	// submit_time is the timestamp when the vote was submitted.
 	SubmitTime time.Time `protobuf:"bytes,5,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time"`
+	// delegators are the account addresses of the members whose votes are cast
+	// by this vote through their delegations.
+	Delegators []string `protobuf:"bytes,6,rep,name=delegators,proto3" json:"delegators,omitempty"`
 }
 
 func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1980496a233f02f4, []int{11}
+	return fileDescriptor_1980496a233f02f4, []int{12}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -866,6 +947,88 @@ func (m *Vote) GetSubmitTime() time.Time {
 	return time.Time{}
 }
 
+func (m *Vote) GetDelegators() []string {
+	if m != nil {
+		return m.Delegators
+	}
+	return nil
+}
+
+// VoteDelegation represents a delegation of a member's vote to another member
+// for a time range.
+type VoteDelegation struct {
+	// delegator is the account address of the member delegating the vote.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// delegate is the account address of the member voting on behalf of the
+	// delegator.
+	Delegate string `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	// start_time is the timestamp from which the delegation is active.
+	StartTime time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time is the timestamp until which the delegation is active.
+	EndTime time.Time `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *VoteDelegation) Reset()         { *m = VoteDelegation{} }
+func (m *VoteDelegation) String() string { return proto.CompactTextString(m) }
+func (*VoteDelegation) ProtoMessage()    {}
+func (*VoteDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1980496a233f02f4, []int{13}
+}
+func (m *VoteDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteDelegation.Merge(m, src)
+}
+func (m *VoteDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteDelegation proto.InternalMessageInfo
+
+func (m *VoteDelegation) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *VoteDelegation) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
+func (m *VoteDelegation) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *VoteDelegation) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
 // Pool is used for tracking treasury.
 type Pool struct {
 	Treasury github_com_Finschia_finschia_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=treasury,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.DecCoins" json:"treasury"`
@@ -875,7 +1038,7 @@ func (m *Pool) Reset()         { *m = Pool{} }
 func (m *Pool) String() string { return proto.CompactTextString(m) }
 func (*Pool) ProtoMessage()    {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1980496a233f02f4, []int{12}
+	return fileDescriptor_1980496a233f02f4, []int{14}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -911,6 +1074,108 @@ func (m *Pool) GetTreasury() github_com_Finschia_finschia_sdk_types.DecCoins {
 	return nil
 }
 
+// TreasuryStream defines a scheduled withdrawal from the treasury, which pays
+// out the amount to the recipient linearly from start_time to end_time.
+type TreasuryStream struct {
+	// id is the unique ID of the stream.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// recipient is the account address receiving the payouts.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the total amount of the stream.
+	Amount github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"amount"`
+	// paid is the amount already paid out to the recipient.
+	Paid github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,4,rep,name=paid,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"paid"`
+	// start_time is the time the accrual starts.
+	StartTime time.Time `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time is the time the whole amount has been accrued.
+	EndTime time.Time `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// cliff_time is the time before which nothing is paid out.
+	// The accrual up to the cliff is paid out at once.
+	CliffTime time.Time `protobuf:"bytes,7,opt,name=cliff_time,json=cliffTime,proto3,stdtime" json:"cliff_time"`
+}
+
+func (m *TreasuryStream) Reset()         { *m = TreasuryStream{} }
+func (m *TreasuryStream) String() string { return proto.CompactTextString(m) }
+func (*TreasuryStream) ProtoMessage()    {}
+func (*TreasuryStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1980496a233f02f4, []int{15}
+}
+func (m *TreasuryStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TreasuryStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TreasuryStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TreasuryStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreasuryStream.Merge(m, src)
+}
+func (m *TreasuryStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *TreasuryStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreasuryStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TreasuryStream proto.InternalMessageInfo
+
+func (m *TreasuryStream) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TreasuryStream) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *TreasuryStream) GetAmount() github_com_Finschia_finschia_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *TreasuryStream) GetPaid() github_com_Finschia_finschia_sdk_types.Coins {
+	if m != nil {
+		return m.Paid
+	}
+	return nil
+}
+
+func (m *TreasuryStream) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *TreasuryStream) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *TreasuryStream) GetCliffTime() time.Time {
+	if m != nil {
+		return m.CliffTime
+	}
+	return time.Time{}
+}
+
 // FoundationExecProposal is x/gov proposal to trigger the x/foundation messages on behalf of x/gov.
 type FoundationExecProposal struct {
 	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -924,7 +1189,7 @@ func (m *FoundationExecProposal) Reset()         { *m = FoundationExecProposal{}
 func (m *FoundationExecProposal) String() string { return proto.CompactTextString(m) }
 func (*FoundationExecProposal) ProtoMessage()    {}
 func (*FoundationExecProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1980496a233f02f4, []int{13}
+	return fileDescriptor_1980496a233f02f4, []int{16}
 }
 func (m *FoundationExecProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -989,9 +1254,12 @@ func init() {
 	proto.RegisterType((*OutsourcingDecisionPolicy)(nil), "lbm.foundation.v1.OutsourcingDecisionPolicy")
 	proto.RegisterType((*FoundationInfo)(nil), "lbm.foundation.v1.FoundationInfo")
 	proto.RegisterType((*Proposal)(nil), "lbm.foundation.v1.Proposal")
+	proto.RegisterType((*MessageResult)(nil), "lbm.foundation.v1.MessageResult")
 	proto.RegisterType((*TallyResult)(nil), "lbm.foundation.v1.TallyResult")
 	proto.RegisterType((*Vote)(nil), "lbm.foundation.v1.Vote")
+	proto.RegisterType((*VoteDelegation)(nil), "lbm.foundation.v1.VoteDelegation")
 	proto.RegisterType((*Pool)(nil), "lbm.foundation.v1.Pool")
+	proto.RegisterType((*TreasuryStream)(nil), "lbm.foundation.v1.TreasuryStream")
 	proto.RegisterType((*FoundationExecProposal)(nil), "lbm.foundation.v1.FoundationExecProposal")
 }
 
@@ -1000,102 +1268,118 @@ func init() {
 }
 
 var fileDescriptor_1980496a233f02f4 = []byte{
-	// 1506 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4d, 0x6c, 0x1b, 0xc5,
-	0x17, 0xcf, 0xda, 0x4e, 0x62, 0x3f, 0x27, 0x8e, 0x3b, 0xcd, 0xbf, 0x75, 0xdc, 0xd6, 0x76, 0xad,
-	0xea, 0xaf, 0x50, 0x29, 0x36, 0x09, 0x42, 0x88, 0x5e, 0x90, 0x3f, 0x36, 0x8d, 0x4b, 0xeb, 0x75,
-	0xc7, 0xeb, 0x84, 0x22, 0xa1, 0xd5, 0xda, 0x3b, 0xb1, 0x57, 0xd8, 0x3b, 0xee, 0xce, 0xd8, 0x89,
-	0x4f, 0x48, 0x9c, 0xaa, 0x5e, 0xe8, 0x91, 0x4b, 0x25, 0x24, 0x2e, 0x88, 0x33, 0x12, 0x88, 0x2b,
-	0x12, 0xaa, 0x38, 0xa0, 0x8a, 0x0b, 0xa8, 0x87, 0x16, 0xa5, 0x67, 0x8e, 0xdc, 0xd1, 0x7e, 0xf9,
-	0x2b, 0x4e, 0x68, 0x53, 0x71, 0xf3, 0x9b, 0xf7, 0xde, 0x6f, 0x7e, 0xef, 0x73, 0xd6, 0x90, 0x6e,
-	0xd7, 0x3b, 0xd9, 0x7d, 0xda, 0x33, 0x34, 0x95, 0xeb, 0xd4, 0xc8, 0xf6, 0x37, 0xc7, 0xa4, 0x4c,
-	0xd7, 0xa4, 0x9c, 0xa2, 0x73, 0xed, 0x7a, 0x27, 0x33, 0x76, 0xda, 0xdf, 0x8c, 0xaf, 0x36, 0x69,
-	0x93, 0xda, 0xda, 0xac, 0xf5, 0xcb, 0x31, 0x8c, 0x27, 0x9a, 0x94, 0x36, 0xdb, 0x24, 0x6b, 0x4b,
-	0xf5, 0xde, 0x7e, 0x56, 0xeb, 0x99, 0x63, 0x40, 0xf1, 0xe4, 0xb4, 0x9e, 0xeb, 0x1d, 0xc2, 0xb8,
-	0xda, 0xe9, 0xba, 0x06, 0x6b, 0xd3, 0x06, 0xaa, 0x31, 0xf0, 0xb0, 0x1b, 0x94, 0x75, 0x28, 0xcb,
-	0xd6, 0x55, 0x46, 0xb2, 0xfd, 0xcd, 0x3a, 0xe1, 0xea, 0x66, 0xb6, 0x41, 0x75, 0x0f, 0x7b, 0xcd,
-	0xd1, 0x2b, 0x0e, 0x29, 0x47, 0x70, 0x54, 0x69, 0x1d, 0x16, 0x2a, 0xaa, 0xa9, 0x76, 0x18, 0xba,
-	0x07, 0x91, 0x51, 0x1c, 0x0a, 0x57, 0x0f, 0x63, 0x42, 0x4a, 0x58, 0x0f, 0xe5, 0xb7, 0x9e, 0x3c,
-	0x4f, 0xce, 0x3d, 0x7b, 0x9e, 0xbc, 0xde, 0xd4, 0x79, 0xab, 0x57, 0xcf, 0x34, 0x68, 0x27, 0xbb,
-	0xad, 0x1b, 0xac, 0xd1, 0xd2, 0xd5, 0xec, 0xbe, 0xfb, 0x63, 0x83, 0x69, 0x9f, 0x66, 0xf9, 0xa0,
-	0x4b, 0x58, 0xa6, 0x48, 0x1a, 0x78, 0x79, 0x84, 0x24, 0xab, 0x87, 0xb7, 0x02, 0x41, 0x5f, 0xd4,
-	0x9f, 0xe6, 0x00, 0x05, 0x62, 0x30, 0x6a, 0xb2, 0x96, 0xde, 0x45, 0x29, 0x58, 0xea, 0xb0, 0xa6,
-	0x62, 0xf9, 0x28, 0x3d, 0xb3, 0xed, 0x5c, 0x86, 0xa1, 0xc3, 0x9a, 0xf2, 0xa0, 0x4b, 0x6a, 0x66,
-	0x1b, 0x15, 0x21, 0xa4, 0xf6, 0x78, 0x8b, 0x9a, 0x3a, 0x1f, 0xc4, 0x7c, 0x29, 0x61, 0x3d, 0xb2,
-	0xf5, 0xff, 0xcc, 0xb1, 0x74, 0x67, 0x46, 0x98, 0x39, 0xcf, 0x1a, 0x8f, 0x1c, 0xd3, 0x9f, 0xc1,
-	0xc2, 0x1d, 0xd2, 0xa9, 0x13, 0x13, 0xc5, 0x60, 0x51, 0xd5, 0x34, 0x93, 0x30, 0xe6, 0x5e, 0xe6,
-	0x89, 0x28, 0x0e, 0xc1, 0x0e, 0xe1, 0xaa, 0xa6, 0x72, 0xd5, 0xbe, 0x28, 0x84, 0x87, 0x32, 0xfa,
-	0x00, 0x82, 0xaa, 0xa6, 0x11, 0x4d, 0x51, 0x79, 0x2c, 0x90, 0x12, 0xd6, 0xc3, 0x5b, 0xf1, 0x8c,
-	0x53, 0x89, 0x8c, 0x57, 0x89, 0x8c, 0xec, 0x95, 0x2a, 0x1f, 0xb4, 0x92, 0xf5, 0xe8, 0x45, 0x52,
-	0xb0, 0xc1, 0x89, 0x96, 0xe3, 0xe9, 0x4f, 0x60, 0xd9, 0x21, 0x80, 0xc9, 0xfd, 0x1e, 0x61, 0xfc,
-	0x14, 0x1e, 0x17, 0x60, 0xc1, 0x24, 0x1d, 0xda, 0x27, 0x36, 0x8b, 0x20, 0x76, 0xa5, 0x09, 0x7e,
-	0xfe, 0x49, 0x7e, 0xe9, 0x9f, 0x04, 0xb8, 0x28, 0xb7, 0x4c, 0xc2, 0x5a, 0xb4, 0xad, 0x15, 0x49,
-	0x43, 0x67, 0x3a, 0x35, 0x2a, 0xb4, 0xad, 0x37, 0x06, 0xa8, 0x02, 0x21, 0xee, 0xa9, 0xde, 0xa0,
-	0x9a, 0x23, 0x10, 0x94, 0x87, 0xc5, 0x03, 0xdd, 0xd0, 0xe8, 0x01, 0xb3, 0x29, 0x86, 0xb7, 0xd6,
-	0x67, 0x54, 0x64, 0x92, 0xc5, 0x9e, 0x63, 0x8f, 0x3d, 0xc7, 0x1b, 0xe8, 0xb7, 0xef, 0x36, 0x22,
-	0x93, 0x36, 0xe9, 0x9f, 0x05, 0x88, 0x55, 0x88, 0xd9, 0x20, 0x06, 0x57, 0x9b, 0x64, 0x2a, 0x0c,
-	0x0c, 0xd0, 0x1d, 0xea, 0xde, 0x20, 0x8e, 0x31, 0x94, 0xff, 0x2c, 0x90, 0x1f, 0x04, 0xf8, 0xdf,
-	0x4c, 0x37, 0xb4, 0x03, 0xcb, 0x7d, 0xca, 0x75, 0xa3, 0xa9, 0x74, 0x89, 0xa9, 0x53, 0xa7, 0x20,
-	0xe1, 0xad, 0xb5, 0x63, 0xdd, 0x54, 0x74, 0x17, 0x83, 0xd3, 0x4c, 0x5f, 0x5a, 0xcd, 0xb4, 0xe4,
-	0x78, 0x56, 0x6c, 0x47, 0x54, 0x83, 0xd5, 0x8e, 0x6e, 0x28, 0xe4, 0x90, 0x34, 0x7a, 0xf6, 0xb0,
-	0xba, 0x80, 0xbe, 0x57, 0x07, 0x44, 0x1d, 0xdd, 0x10, 0x3d, 0x7f, 0x07, 0x36, 0x7d, 0x17, 0xd6,
-	0xa4, 0x1e, 0x67, 0xb4, 0x67, 0x36, 0x74, 0xa3, 0x39, 0x55, 0x83, 0x14, 0x84, 0x35, 0xc2, 0x1a,
-	0xa6, 0xde, 0xb5, 0x3c, 0xdc, 0xc6, 0x1d, 0x3f, 0x9a, 0x99, 0x8d, 0x67, 0x02, 0x44, 0xb6, 0x87,
-	0x29, 0x2d, 0x19, 0xfb, 0xd4, 0xea, 0xfe, 0x3e, 0x31, 0x99, 0x07, 0x12, 0xc0, 0x9e, 0x88, 0x6a,
-	0xb0, 0xc4, 0x29, 0x57, 0xdb, 0xca, 0x01, 0xd1, 0x9b, 0x2d, 0xee, 0x4c, 0xe2, 0x99, 0x0a, 0x1d,
-	0xb6, 0x71, 0xf6, 0x6c, 0x18, 0x74, 0x17, 0x56, 0x34, 0x97, 0x95, 0xd2, 0xb5, 0x69, 0xd9, 0x33,
-	0x14, 0xde, 0x5a, 0x3d, 0x96, 0xa8, 0x9c, 0x31, 0xc8, 0xa3, 0x5f, 0x8e, 0x85, 0x81, 0x23, 0xda,
-	0x84, 0x7c, 0x23, 0xf0, 0xe0, 0xab, 0xe4, 0x5c, 0xfa, 0xfb, 0x00, 0x04, 0x2b, 0x26, 0xed, 0x52,
-	0xa6, 0xb6, 0x51, 0x04, 0x7c, 0xba, 0xe6, 0x46, 0xe4, 0xd3, 0xb5, 0x53, 0x57, 0xca, 0x65, 0x08,
-	0x75, 0x6d, 0x3f, 0x62, 0xb2, 0x98, 0x3f, 0xe5, 0x5f, 0x0f, 0xe1, 0xd1, 0x01, 0x12, 0x21, 0xcc,
-	0x7a, 0xf5, 0x8e, 0xce, 0x15, 0xeb, 0x05, 0x78, 0xad, 0x9d, 0x03, 0x8e, 0xa3, 0xa5, 0x42, 0x1b,
-	0x80, 0xc6, 0xd6, 0xb9, 0x97, 0xf2, 0x79, 0x9b, 0xe0, 0xb9, 0x91, 0x66, 0xd7, 0x4d, 0xfe, 0xfb,
-	0xb0, 0xc0, 0xb8, 0xca, 0x7b, 0x2c, 0xb6, 0x60, 0x6f, 0xda, 0xab, 0x33, 0xc6, 0xc1, 0x0b, 0xb6,
-	0x6a, 0x1b, 0x62, 0xd7, 0x01, 0x61, 0x40, 0xfb, 0xba, 0xa1, 0xb6, 0x15, 0xae, 0xb6, 0xdb, 0x03,
-	0xc5, 0x24, 0xac, 0xd7, 0xe6, 0xb1, 0x45, 0x9b, 0x77, 0x62, 0x06, 0x8c, 0x6c, 0x99, 0x61, 0xdb,
-	0x2a, 0x1f, 0xb0, 0xb8, 0xe3, 0xa8, 0xed, 0x3f, 0x76, 0x8e, 0x2a, 0x70, 0x6e, 0x62, 0x58, 0x14,
-	0x62, 0x68, 0xb1, 0xe0, 0x6b, 0xa4, 0x62, 0x65, 0x7c, 0x62, 0x44, 0x43, 0x43, 0x18, 0x56, 0x9c,
-	0x81, 0xa1, 0xa6, 0x47, 0x31, 0x64, 0x47, 0xfa, 0xd6, 0x29, 0x91, 0x8a, 0xae, 0x87, 0xc3, 0x0a,
-	0x47, 0xc8, 0x84, 0x8c, 0xde, 0xb6, 0x8a, 0xcc, 0x98, 0xda, 0x24, 0x2c, 0x06, 0x29, 0xff, 0x49,
-	0x3d, 0x85, 0x87, 0x56, 0x6e, 0xe7, 0xfc, 0xe5, 0x83, 0xf0, 0x78, 0xb4, 0x12, 0x84, 0x06, 0x84,
-	0x29, 0x0d, 0xda, 0x33, 0xf8, 0x1b, 0xec, 0xb7, 0xe0, 0x80, 0xb0, 0x82, 0x85, 0x81, 0xf6, 0x60,
-	0x59, 0xad, 0x33, 0xae, 0xea, 0x86, 0x0b, 0x7a, 0xf6, 0x59, 0x5a, 0x72, 0x81, 0x1c, 0xe0, 0x3b,
-	0x10, 0x34, 0xa8, 0x8b, 0xe9, 0x3f, 0x33, 0xe6, 0xa2, 0x41, 0x1d, 0x38, 0x05, 0x90, 0x41, 0x95,
-	0x03, 0x9d, 0xb7, 0x94, 0x3e, 0xe1, 0x1e, 0x70, 0xe0, 0xcc, 0xc0, 0x2b, 0x06, 0xdd, 0xd3, 0x79,
-	0x6b, 0x97, 0x70, 0xe7, 0x02, 0x37, 0xdf, 0xbf, 0x0b, 0x10, 0xd8, 0xa5, 0x9c, 0xa0, 0x24, 0x84,
-	0xbb, 0x6e, 0x69, 0x95, 0xe1, 0xb8, 0x82, 0x77, 0x54, 0xd2, 0xd0, 0x2a, 0xcc, 0xf7, 0x29, 0x27,
-	0xa6, 0x3b, 0xb3, 0x8e, 0x80, 0xde, 0x85, 0x05, 0xea, 0xec, 0x3d, 0xbf, 0xdd, 0x32, 0x57, 0x66,
-	0xb4, 0x8c, 0x85, 0x2f, 0xd9, 0x46, 0xd8, 0x35, 0x9e, 0xd8, 0x01, 0x81, 0xa9, 0x1d, 0x30, 0x35,
-	0xe5, 0xf3, 0x67, 0x9b, 0xf2, 0xf4, 0x00, 0x02, 0x15, 0x4a, 0xdb, 0xe8, 0x3e, 0x04, 0xb9, 0x49,
-	0x54, 0xd6, 0x33, 0x07, 0x31, 0xc1, 0xee, 0xc4, 0xcb, 0x19, 0xf7, 0x3b, 0xcf, 0xfa, 0x28, 0xcc,
-	0xb8, 0x1f, 0x85, 0x56, 0x92, 0x0a, 0x54, 0x37, 0xf2, 0xef, 0x59, 0x68, 0xdf, 0xbe, 0x48, 0x66,
-	0x5f, 0x3d, 0xb9, 0x96, 0x1f, 0xc3, 0xc3, 0x6b, 0xd2, 0x9f, 0x0b, 0x70, 0x61, 0xb4, 0xdb, 0xad,
-	0x49, 0x19, 0x2e, 0xc3, 0x55, 0x98, 0xe7, 0x3a, 0x6f, 0xbb, 0x6f, 0x35, 0x76, 0x84, 0xe9, 0x27,
-	0xc4, 0x77, 0xec, 0x09, 0x99, 0x98, 0x27, 0xff, 0xab, 0xcc, 0xd3, 0xf5, 0xbf, 0x05, 0x38, 0x3f,
-	0xe3, 0x03, 0x10, 0xed, 0x40, 0xaa, 0x20, 0x96, 0xab, 0x12, 0xae, 0xee, 0x94, 0x2a, 0x4a, 0xae,
-	0x26, 0xef, 0x48, 0xb8, 0x24, 0xdf, 0x53, 0x6a, 0xe5, 0x6a, 0x45, 0x2c, 0x94, 0xb6, 0x4b, 0x62,
-	0x31, 0x3a, 0x17, 0x4f, 0x3f, 0x7c, 0x9c, 0x4a, 0xcc, 0x70, 0xaf, 0x19, 0xac, 0x4b, 0x1a, 0xfa,
-	0xbe, 0x4e, 0x34, 0xb4, 0x0d, 0xc9, 0x99, 0x48, 0x37, 0xa5, 0x5d, 0x11, 0x97, 0x73, 0xe5, 0x82,
-	0x18, 0x15, 0xe2, 0x57, 0x1f, 0x3e, 0x4e, 0x5d, 0x99, 0x01, 0x74, 0x93, 0xf6, 0x89, 0x69, 0xa8,
-	0x46, 0x83, 0x9c, 0x88, 0xb3, 0x2d, 0xd5, 0xca, 0xc5, 0x9c, 0x5c, 0x92, 0xca, 0x51, 0xdf, 0x89,
-	0x38, 0xa3, 0x3c, 0xc7, 0x03, 0x0f, 0xbe, 0x4e, 0xcc, 0x5d, 0xff, 0x42, 0x00, 0x18, 0x75, 0x1c,
-	0xba, 0x04, 0x17, 0x77, 0x25, 0x59, 0x54, 0xa4, 0x8a, 0x05, 0x34, 0x19, 0x25, 0x3a, 0x0f, 0x2b,
-	0xe3, 0xca, 0x7b, 0x62, 0x35, 0x2a, 0xa0, 0x8b, 0x70, 0x7e, 0xfc, 0x30, 0x97, 0xaf, 0xca, 0xb9,
-	0x52, 0x39, 0xea, 0x43, 0x08, 0x22, 0xe3, 0x8a, 0xb2, 0x14, 0xf5, 0xa3, 0xcb, 0x10, 0x9b, 0x3c,
-	0x53, 0xf6, 0x4a, 0xf2, 0x8e, 0xb2, 0x2b, 0xca, 0x52, 0x34, 0xe0, 0x32, 0xfa, 0x55, 0x80, 0xc8,
-	0xe4, 0x03, 0x81, 0x92, 0x70, 0xa9, 0x82, 0xa5, 0x8a, 0x54, 0xcd, 0xdd, 0x56, 0xaa, 0x72, 0x4e,
-	0xae, 0x55, 0xa7, 0x98, 0x5d, 0x81, 0xb5, 0x69, 0x83, 0x6a, 0x2d, 0x7f, 0xa7, 0x24, 0xcb, 0x62,
-	0x31, 0x2a, 0x58, 0xd7, 0x4e, 0xab, 0x73, 0x85, 0x82, 0x58, 0xb1, 0xb4, 0xbe, 0x59, 0x5a, 0x2c,
-	0xde, 0x12, 0x0b, 0x96, 0xd6, 0x6f, 0x65, 0xe4, 0x98, 0x6f, 0x5e, 0xc2, 0x96, 0x32, 0x30, 0xeb,
-	0x5e, 0x2b, 0xa0, 0x22, 0xce, 0xed, 0x95, 0xa3, 0xf3, 0x6e, 0x40, 0x3f, 0x0a, 0x70, 0x61, 0xf6,
-	0x3b, 0x80, 0xd6, 0xe1, 0xda, 0xd0, 0x5f, 0xfc, 0x48, 0x2c, 0xd4, 0x64, 0x09, 0x2b, 0x58, 0xac,
-	0xd6, 0x6e, 0xcb, 0x53, 0x11, 0x5e, 0x83, 0xd4, 0x89, 0x96, 0x65, 0x49, 0x56, 0x70, 0xad, 0x1c,
-	0x15, 0x4e, 0xb5, 0xaa, 0xd6, 0x0a, 0x05, 0xb1, 0x5a, 0x8d, 0xfa, 0x4e, 0xb5, 0xda, 0xce, 0x95,
-	0x6e, 0xd7, 0xb0, 0x18, 0xf5, 0x3b, 0xe4, 0xf3, 0x1f, 0x7e, 0x73, 0x94, 0x10, 0x9e, 0x1c, 0x25,
-	0x84, 0xa7, 0x47, 0x09, 0xe1, 0xcf, 0xa3, 0x84, 0xf0, 0xe8, 0x65, 0x62, 0xee, 0xe9, 0xcb, 0xc4,
-	0xdc, 0x1f, 0x2f, 0x13, 0x73, 0x1f, 0x6f, 0xfc, 0xeb, 0xd4, 0x1f, 0x8e, 0xfd, 0xd3, 0xad, 0x2f,
-	0xd8, 0xc3, 0xf7, 0xce, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xcd, 0xef, 0x06, 0x11, 0x10, 0x0f,
-	0x00, 0x00,
+	// 1774 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4d, 0x6c, 0x23, 0x49,
+	0x15, 0x4e, 0xdb, 0x8e, 0x63, 0x3f, 0x27, 0x8e, 0xb7, 0x26, 0xcc, 0x38, 0xd9, 0x8c, 0xe3, 0xb5,
+	0x56, 0x28, 0x8c, 0x18, 0x9b, 0x09, 0x20, 0xc4, 0x5e, 0x56, 0xfe, 0xe9, 0x4c, 0x3c, 0xcc, 0xd8,
+	0xde, 0x76, 0x3b, 0x61, 0xb8, 0xb4, 0xda, 0xee, 0x8a, 0x53, 0xc2, 0xdd, 0xe5, 0xed, 0x2a, 0x67,
+	0xc6, 0x12, 0x27, 0x4e, 0xab, 0xbd, 0xb0, 0x47, 0x2e, 0x2b, 0x21, 0x71, 0x01, 0xce, 0x1c, 0x10,
+	0x57, 0x24, 0xb4, 0x02, 0x09, 0x0d, 0x5c, 0x40, 0x7b, 0xd8, 0x45, 0x33, 0x67, 0x8e, 0x88, 0x2b,
+	0xaa, 0xea, 0x6a, 0xff, 0xc5, 0x93, 0x9d, 0x24, 0xda, 0x9b, 0xdf, 0xdf, 0x57, 0xdf, 0x7b, 0xf5,
+	0xea, 0x55, 0xb5, 0xa1, 0x30, 0xe8, 0xba, 0xa5, 0x53, 0x3a, 0xf2, 0x1c, 0x9b, 0x13, 0xea, 0x95,
+	0xce, 0x1f, 0xcc, 0x48, 0xc5, 0xa1, 0x4f, 0x39, 0x45, 0x6f, 0x0d, 0xba, 0x6e, 0x71, 0x46, 0x7b,
+	0xfe, 0x60, 0x67, 0xab, 0x4f, 0xfb, 0x54, 0x5a, 0x4b, 0xe2, 0x57, 0xe0, 0xb8, 0x93, 0xeb, 0x53,
+	0xda, 0x1f, 0xe0, 0x92, 0x94, 0xba, 0xa3, 0xd3, 0x92, 0x33, 0xf2, 0x67, 0x80, 0x76, 0xf6, 0x16,
+	0xed, 0x9c, 0xb8, 0x98, 0x71, 0xdb, 0x1d, 0x2a, 0x87, 0xed, 0x45, 0x07, 0xdb, 0x1b, 0x87, 0xd8,
+	0x3d, 0xca, 0x5c, 0xca, 0x4a, 0x5d, 0x9b, 0xe1, 0xd2, 0xf9, 0x83, 0x2e, 0xe6, 0xf6, 0x83, 0x52,
+	0x8f, 0x92, 0x10, 0x7b, 0x3b, 0xb0, 0x5b, 0x01, 0xa9, 0x40, 0x08, 0x4c, 0x05, 0x02, 0xf1, 0x96,
+	0xed, 0xdb, 0x2e, 0x43, 0x4f, 0x21, 0x3d, 0xcd, 0xc3, 0xe2, 0xf6, 0xf3, 0xac, 0x96, 0xd7, 0xf6,
+	0x93, 0x95, 0x83, 0xcf, 0xbe, 0xd8, 0x5b, 0xf9, 0xfc, 0x8b, 0xbd, 0x7b, 0x7d, 0xc2, 0xcf, 0x46,
+	0xdd, 0x62, 0x8f, 0xba, 0xa5, 0x43, 0xe2, 0xb1, 0xde, 0x19, 0xb1, 0x4b, 0xa7, 0xea, 0xc7, 0x7d,
+	0xe6, 0xfc, 0xb4, 0xc4, 0xc7, 0x43, 0xcc, 0x8a, 0x35, 0xdc, 0x33, 0x36, 0xa6, 0x48, 0xa6, 0xfd,
+	0xfc, 0x51, 0x2c, 0x11, 0xc9, 0x44, 0x0b, 0x1c, 0xa0, 0x8a, 0x3d, 0x46, 0x7d, 0x76, 0x46, 0x86,
+	0x28, 0x0f, 0xeb, 0x2e, 0xeb, 0x5b, 0x22, 0xc6, 0x1a, 0xf9, 0x83, 0x60, 0x31, 0x03, 0x5c, 0xd6,
+	0x37, 0xc7, 0x43, 0xdc, 0xf1, 0x07, 0xa8, 0x06, 0x49, 0x7b, 0xc4, 0xcf, 0xa8, 0x4f, 0xf8, 0x38,
+	0x1b, 0xc9, 0x6b, 0xfb, 0xe9, 0x83, 0x6f, 0x16, 0x2f, 0x94, 0xbb, 0x38, 0xc5, 0x2c, 0x87, 0xde,
+	0xc6, 0x34, 0xb0, 0xf0, 0x57, 0x0d, 0xe2, 0x4f, 0xb0, 0xdb, 0xc5, 0x3e, 0xca, 0xc2, 0x9a, 0xed,
+	0x38, 0x3e, 0x66, 0x4c, 0xad, 0x16, 0x8a, 0x68, 0x07, 0x12, 0x2e, 0xe6, 0xb6, 0x63, 0x73, 0x5b,
+	0xae, 0x94, 0x34, 0x26, 0x32, 0x7a, 0x1f, 0x12, 0xb6, 0xe3, 0x60, 0xc7, 0xb2, 0x79, 0x36, 0x96,
+	0xd7, 0xf6, 0x53, 0x07, 0x3b, 0xc5, 0x60, 0x2b, 0x8a, 0xe1, 0x56, 0x14, 0xcd, 0x70, 0xaf, 0x2a,
+	0x09, 0x51, 0xad, 0x4f, 0xbe, 0xdc, 0xd3, 0x24, 0x38, 0x76, 0xca, 0x1c, 0x3d, 0x82, 0xf8, 0x33,
+	0x4c, 0xfa, 0x67, 0x3c, 0xbb, 0x7a, 0xed, 0x82, 0x2a, 0x84, 0xc2, 0x6f, 0x35, 0xd8, 0x08, 0xb2,
+	0x31, 0xf0, 0x87, 0x23, 0xcc, 0xf8, 0x25, 0x49, 0xdd, 0x86, 0xb8, 0x8f, 0x5d, 0x7a, 0x8e, 0x65,
+	0x4a, 0x09, 0x43, 0x49, 0x73, 0xc9, 0x46, 0x17, 0x92, 0x9d, 0x72, 0x8d, 0xdd, 0x98, 0xeb, 0x9f,
+	0x34, 0xb8, 0x63, 0x9e, 0xf9, 0x98, 0x9d, 0xd1, 0x81, 0x53, 0xc3, 0x3d, 0xc2, 0x08, 0xf5, 0x5a,
+	0x74, 0x40, 0x7a, 0x63, 0xd4, 0x82, 0x24, 0x0f, 0x4d, 0x37, 0xe8, 0xb3, 0x29, 0x08, 0xaa, 0xc0,
+	0xda, 0x33, 0xe2, 0x39, 0xf4, 0x19, 0x93, 0xe9, 0xa6, 0x0e, 0xf6, 0x97, 0xf4, 0xca, 0x3c, 0x8b,
+	0x93, 0xc0, 0xdf, 0x08, 0x03, 0xdf, 0x43, 0xff, 0xf8, 0xfd, 0xfd, 0xf4, 0xbc, 0x4f, 0xe1, 0xcf,
+	0x1a, 0x64, 0x5b, 0xd8, 0xef, 0x61, 0x8f, 0xdb, 0x7d, 0xbc, 0x90, 0x86, 0x01, 0x30, 0x9c, 0xd8,
+	0x6e, 0x90, 0xc7, 0x0c, 0xca, 0xd7, 0x96, 0xc8, 0x1f, 0x34, 0xf8, 0xc6, 0xd2, 0x30, 0x74, 0x04,
+	0x1b, 0xe7, 0x94, 0x13, 0xaf, 0x6f, 0x0d, 0xb1, 0x4f, 0x68, 0xb0, 0x21, 0xa9, 0x83, 0xed, 0x0b,
+	0x6d, 0x5e, 0x53, 0x23, 0x2b, 0xe8, 0xf2, 0x5f, 0x8a, 0x2e, 0x5f, 0x0f, 0x22, 0x5b, 0x32, 0x10,
+	0x75, 0x60, 0xcb, 0x25, 0x9e, 0x85, 0x9f, 0xe3, 0xde, 0x48, 0x8e, 0x11, 0x05, 0x18, 0x79, 0x73,
+	0x40, 0xe4, 0x12, 0x4f, 0x0f, 0xe3, 0x03, 0xd8, 0xc2, 0x07, 0xb0, 0xdd, 0x1c, 0x71, 0x46, 0x47,
+	0x7e, 0x8f, 0x78, 0xfd, 0x85, 0x3d, 0xc8, 0x43, 0xca, 0xc1, 0xac, 0xe7, 0x93, 0xa1, 0x88, 0x50,
+	0x87, 0x60, 0x56, 0xb5, 0xb4, 0x1a, 0x9f, 0x6b, 0x90, 0x3e, 0x9c, 0x94, 0xb4, 0xee, 0x9d, 0x52,
+	0x71, 0x92, 0xce, 0xb1, 0xcf, 0x42, 0x90, 0x98, 0x11, 0x8a, 0xa8, 0x03, 0xeb, 0x9c, 0x72, 0x7b,
+	0x60, 0xa9, 0xb3, 0x11, 0xb9, 0xf6, 0x46, 0xa7, 0x24, 0xce, 0x89, 0x84, 0x41, 0x1f, 0xc0, 0xa6,
+	0xa3, 0x58, 0x59, 0x43, 0x49, 0x4b, 0x9e, 0xc7, 0xd4, 0xc1, 0xd6, 0x85, 0x42, 0x95, 0xbd, 0x71,
+	0x05, 0xfd, 0xe5, 0x42, 0x1a, 0x46, 0xda, 0x99, 0x93, 0xdf, 0x8b, 0x7d, 0xf4, 0xab, 0xbd, 0x95,
+	0xc2, 0xdf, 0x57, 0x21, 0xd1, 0xf2, 0xe9, 0x90, 0x32, 0x7b, 0x80, 0xd2, 0x10, 0x21, 0x8e, 0xca,
+	0x28, 0x42, 0x9c, 0x4b, 0x67, 0xdd, 0x2e, 0x24, 0x87, 0x32, 0x0e, 0xfb, 0x2c, 0x1b, 0xcd, 0x47,
+	0xf7, 0x93, 0xc6, 0x54, 0x81, 0x74, 0x48, 0xb1, 0x51, 0xd7, 0x25, 0xdc, 0x12, 0x77, 0xd3, 0x95,
+	0x86, 0x21, 0x04, 0x81, 0xc2, 0x84, 0xee, 0x03, 0x9a, 0xb9, 0x68, 0xc2, 0x92, 0xaf, 0x4a, 0x82,
+	0x6f, 0x4d, 0x2d, 0xc7, 0xaa, 0xf8, 0x3f, 0x84, 0x38, 0xe3, 0x36, 0x1f, 0xb1, 0x6c, 0x5c, 0xde,
+	0x01, 0xef, 0x2c, 0x39, 0x0e, 0x61, 0xb2, 0x6d, 0xe9, 0x68, 0xa8, 0x00, 0x64, 0x00, 0x3a, 0x25,
+	0x9e, 0x3d, 0xb0, 0xb8, 0x3d, 0x18, 0x8c, 0x2d, 0x1f, 0xb3, 0xd1, 0x80, 0x67, 0xd7, 0x24, 0xef,
+	0xdc, 0x12, 0x18, 0x53, 0xb8, 0x19, 0xd2, 0xab, 0x12, 0x13, 0xdc, 0x8d, 0x8c, 0x8c, 0x9f, 0xd1,
+	0xa3, 0x16, 0xbc, 0x35, 0x77, 0x58, 0x2c, 0xec, 0x39, 0xd9, 0xc4, 0x15, 0x4a, 0xb1, 0x39, 0x7b,
+	0x62, 0x74, 0xcf, 0x41, 0x06, 0x6c, 0x06, 0x07, 0x86, 0xfa, 0x21, 0xc5, 0xa4, 0xcc, 0xf4, 0x5b,
+	0x97, 0x64, 0xaa, 0xab, 0x88, 0x80, 0x95, 0x91, 0xc6, 0x73, 0x32, 0xfa, 0x8e, 0xd8, 0x64, 0xc6,
+	0xec, 0x3e, 0x66, 0x59, 0xc8, 0x47, 0x5f, 0xd7, 0x53, 0xc6, 0xc4, 0x0b, 0x35, 0x61, 0x53, 0xfd,
+	0x56, 0x24, 0x58, 0x36, 0x25, 0x03, 0xf3, 0x4b, 0x58, 0x3c, 0x09, 0x3c, 0xe7, 0x4a, 0x95, 0x76,
+	0x67, 0x95, 0x0c, 0x3d, 0x84, 0xb4, 0x8f, 0xb9, 0x3f, 0xb6, 0x1c, 0x6c, 0x3b, 0x03, 0xe2, 0xe1,
+	0xec, 0xfa, 0x57, 0x56, 0x29, 0x26, 0x2b, 0xb4, 0x21, 0xe3, 0x6a, 0x2a, 0x4c, 0xf5, 0xf4, 0xcf,
+	0xc4, 0xc5, 0x37, 0xb3, 0x00, 0x2a, 0x8b, 0xeb, 0x4d, 0x56, 0x4b, 0xbb, 0x6a, 0xb5, 0x54, 0x20,
+	0x42, 0x10, 0x9b, 0x1c, 0x83, 0x75, 0x43, 0xfe, 0x46, 0x5b, 0xb0, 0x8a, 0x7d, 0x9f, 0xfa, 0xea,
+	0x6a, 0x0c, 0x84, 0xc2, 0x7f, 0x22, 0x90, 0x9a, 0xed, 0x82, 0x26, 0x24, 0xc7, 0x98, 0x59, 0x3d,
+	0x3a, 0xf2, 0xf8, 0x0d, 0xe6, 0x7e, 0x62, 0x8c, 0x59, 0x55, 0x60, 0xa0, 0x13, 0xd8, 0xb0, 0xbb,
+	0x8c, 0xdb, 0xc4, 0x53, 0xa0, 0xd7, 0x9f, 0x31, 0xeb, 0x0a, 0x28, 0x00, 0x7e, 0x02, 0x09, 0x8f,
+	0x2a, 0xcc, 0xe8, 0xb5, 0x31, 0xd7, 0x3c, 0x1a, 0xc0, 0x59, 0x80, 0x3c, 0x6a, 0x3d, 0x23, 0xfc,
+	0xcc, 0x3a, 0xc7, 0x3c, 0x04, 0xbe, 0xfe, 0x63, 0x61, 0xd3, 0xa3, 0x27, 0x84, 0x9f, 0x1d, 0x63,
+	0x1e, 0x2c, 0xa0, 0x76, 0xfb, 0x7f, 0x1a, 0xc4, 0x8e, 0x29, 0xc7, 0x68, 0x0f, 0x52, 0x43, 0xb5,
+	0x89, 0xd6, 0x64, 0x8c, 0x41, 0xa8, 0xaa, 0x3b, 0x62, 0xbf, 0xce, 0x29, 0xc7, 0xbe, 0x9a, 0x65,
+	0x81, 0x80, 0xbe, 0x0f, 0x71, 0x1a, 0xdc, 0x07, 0x51, 0xd9, 0x1c, 0x77, 0x97, 0x34, 0x87, 0xc0,
+	0x6f, 0x4a, 0x27, 0x43, 0x39, 0xcf, 0xcd, 0xc6, 0xd8, 0xc2, 0x6c, 0x5c, 0x98, 0x7e, 0xab, 0xd7,
+	0x9c, 0x7e, 0x39, 0x00, 0x07, 0x0f, 0x70, 0xdf, 0xe6, 0xd4, 0x17, 0x23, 0x4d, 0xcc, 0xd8, 0x19,
+	0x4d, 0xe1, 0x85, 0x06, 0x69, 0xc1, 0xac, 0x16, 0xa8, 0x04, 0xab, 0x5d, 0x48, 0x4e, 0x1c, 0xd4,
+	0xfd, 0x36, 0x55, 0x08, 0xce, 0x4a, 0xc0, 0xe1, 0x3c, 0x0f, 0x65, 0x54, 0x05, 0x60, 0xdc, 0xf6,
+	0x15, 0xe5, 0xe8, 0x15, 0x28, 0x27, 0x65, 0x9c, 0x64, 0xfc, 0x3e, 0x24, 0xb0, 0xe7, 0x5c, 0x7d,
+	0xe6, 0xaf, 0x61, 0xcf, 0x11, 0xfa, 0xc2, 0x18, 0x62, 0x2d, 0x4a, 0x07, 0xe8, 0x43, 0x48, 0x70,
+	0x1f, 0xdb, 0x6c, 0xe4, 0x8f, 0xb3, 0x9a, 0x9c, 0x2d, 0xbb, 0x45, 0xf5, 0x31, 0x22, 0xbe, 0x5c,
+	0x8a, 0xea, 0xcb, 0x45, 0xf4, 0x45, 0x95, 0x12, 0xaf, 0xf2, 0x03, 0x01, 0xf5, 0xbb, 0x2f, 0xf7,
+	0x4a, 0x6f, 0xde, 0x4f, 0x22, 0x8e, 0x19, 0x93, 0x65, 0x0a, 0xff, 0x8c, 0x42, 0xda, 0x54, 0x42,
+	0x5b, 0x68, 0xdd, 0x0b, 0xf7, 0xe1, 0x2e, 0x24, 0x7d, 0xdc, 0x23, 0x43, 0x82, 0xc3, 0x53, 0x67,
+	0x4c, 0x15, 0xa8, 0x0f, 0x71, 0xdb, 0x55, 0x87, 0x27, 0x2a, 0xdf, 0x30, 0xcb, 0x18, 0x4b, 0xba,
+	0xdf, 0x53, 0x74, 0xbf, 0xfd, 0x86, 0x74, 0x03, 0xae, 0x0a, 0x1e, 0xf5, 0x20, 0x36, 0xb4, 0x89,
+	0x93, 0x8d, 0x7d, 0x3d, 0xcb, 0x48, 0xf0, 0x85, 0x7e, 0x58, 0xbd, 0x79, 0x3f, 0xc4, 0xaf, 0xd1,
+	0x0f, 0x82, 0x45, 0x6f, 0x40, 0x4e, 0x4f, 0x03, 0x88, 0xb5, 0xab, 0xb0, 0x90, 0x71, 0xb2, 0xa9,
+	0x7e, 0xae, 0xc1, 0xed, 0xe9, 0x03, 0x4e, 0x0c, 0xf8, 0xc9, 0x8b, 0x67, 0x0b, 0x56, 0x39, 0xe1,
+	0x03, 0xf5, 0x20, 0x37, 0x02, 0x61, 0xf1, 0x9d, 0x18, 0xb9, 0xf0, 0x4e, 0x9c, 0xbb, 0x34, 0xa3,
+	0x6f, 0x72, 0x69, 0xde, 0xfb, 0xaf, 0x06, 0xb7, 0x96, 0x7c, 0x7f, 0xa2, 0x23, 0xc8, 0x57, 0xf5,
+	0x46, 0xbb, 0x69, 0xb4, 0x8f, 0xea, 0x2d, 0xab, 0xdc, 0x31, 0x8f, 0x9a, 0x46, 0xdd, 0x7c, 0x6a,
+	0x75, 0x1a, 0xed, 0x96, 0x5e, 0xad, 0x1f, 0xd6, 0xf5, 0x5a, 0x66, 0x65, 0xa7, 0xf0, 0xf1, 0xa7,
+	0xf9, 0xdc, 0x92, 0xf0, 0x8e, 0xc7, 0x86, 0xb8, 0x47, 0x4e, 0x09, 0x76, 0xd0, 0x21, 0xec, 0x2d,
+	0x45, 0x7a, 0xd8, 0x3c, 0xd6, 0x8d, 0x46, 0xb9, 0x51, 0xd5, 0x33, 0xda, 0xce, 0x3b, 0x1f, 0x7f,
+	0x9a, 0xbf, 0xbb, 0x04, 0xe8, 0x21, 0x3d, 0xc7, 0xbe, 0x67, 0x7b, 0x3d, 0xfc, 0x5a, 0x9c, 0xc3,
+	0x66, 0xa7, 0x51, 0x2b, 0x9b, 0xf5, 0x66, 0x23, 0x13, 0x79, 0x2d, 0xce, 0xb4, 0xce, 0x3b, 0xb1,
+	0x8f, 0x7e, 0x9d, 0x5b, 0xb9, 0xf7, 0x0b, 0x0d, 0x60, 0x3a, 0x3e, 0xd1, 0xdb, 0x70, 0xe7, 0xb8,
+	0x69, 0xea, 0x56, 0xb3, 0x25, 0x80, 0xe6, 0xb3, 0x44, 0xb7, 0x60, 0x73, 0xd6, 0xf8, 0x54, 0x6f,
+	0x67, 0x34, 0x74, 0x07, 0x6e, 0xcd, 0x2a, 0xcb, 0x95, 0xb6, 0x59, 0xae, 0x37, 0x32, 0x11, 0x84,
+	0x20, 0x3d, 0x6b, 0x68, 0x34, 0x33, 0x51, 0xb4, 0x0b, 0xd9, 0x79, 0x9d, 0x75, 0x52, 0x37, 0x8f,
+	0xac, 0x63, 0xdd, 0x6c, 0x66, 0x62, 0x8a, 0xd1, 0xdf, 0x34, 0x48, 0xcf, 0xbf, 0x02, 0xd1, 0x1e,
+	0xbc, 0xdd, 0x32, 0x9a, 0xad, 0x66, 0xbb, 0xfc, 0xd8, 0x6a, 0x9b, 0x65, 0xb3, 0xd3, 0x5e, 0x60,
+	0x76, 0x17, 0xb6, 0x17, 0x1d, 0xda, 0x9d, 0xca, 0x93, 0xba, 0x69, 0xea, 0xb5, 0x8c, 0x26, 0x96,
+	0x5d, 0x34, 0x97, 0xab, 0x55, 0xbd, 0x25, 0xac, 0x91, 0x65, 0x56, 0x43, 0x7f, 0xa4, 0x57, 0x85,
+	0x35, 0x2a, 0x2a, 0x72, 0x21, 0xb6, 0xd2, 0x34, 0x84, 0x31, 0xb6, 0x6c, 0x5d, 0x91, 0x50, 0xcd,
+	0x28, 0x9f, 0x34, 0x32, 0xab, 0x2a, 0xa1, 0x3f, 0x6a, 0x70, 0x7b, 0xf9, 0xf3, 0x05, 0xed, 0xc3,
+	0xbb, 0x93, 0x78, 0xfd, 0xc7, 0x7a, 0xb5, 0x63, 0x36, 0x0d, 0xcb, 0xd0, 0xdb, 0x9d, 0xc7, 0xe6,
+	0x42, 0x86, 0xef, 0x42, 0xfe, 0xb5, 0x9e, 0x8d, 0xa6, 0x69, 0x19, 0x9d, 0x46, 0x46, 0xbb, 0xd4,
+	0xab, 0xdd, 0xa9, 0x56, 0xf5, 0x76, 0x3b, 0x13, 0xb9, 0xd4, 0xeb, 0xb0, 0x5c, 0x7f, 0xdc, 0x31,
+	0xf4, 0x4c, 0x34, 0x20, 0x5f, 0xf9, 0xd1, 0x6f, 0x5e, 0xe6, 0xb4, 0xcf, 0x5e, 0xe6, 0xb4, 0x17,
+	0x2f, 0x73, 0xda, 0xbf, 0x5f, 0xe6, 0xb4, 0x4f, 0x5e, 0xe5, 0x56, 0x5e, 0xbc, 0xca, 0xad, 0xfc,
+	0xeb, 0x55, 0x6e, 0xe5, 0x27, 0xf7, 0xbf, 0x72, 0x72, 0x3d, 0x9f, 0xf9, 0xa3, 0xad, 0x1b, 0x97,
+	0x87, 0xef, 0xbb, 0xff, 0x1f, 0x00, 0x00, 0x05, 0x7d, 0x94, 0x8f, 0x13, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -1177,6 +1461,9 @@ func (this *Member) Equal(that interface{}) bool {
 	if !this.AddedAt.Equal(that1.AddedAt) {
 		return false
 	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
 	return true
 }
 func (this *MemberRequest) Equal(that interface{}) bool {
@@ -1207,6 +1494,9 @@ func (this *MemberRequest) Equal(that interface{}) bool {
 	if this.Metadata != that1.Metadata {
 		return false
 	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
 	return true
 }
 func (this *ThresholdDecisionPolicy) Equal(that interface{}) bool {
@@ -1403,6 +1693,51 @@ func (this *Proposal) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.MessageResults) != len(that1.MessageResults) {
+		return false
+	}
+	for i := range this.MessageResults {
+		if !this.MessageResults[i].Equal(&that1.MessageResults[i]) {
+			return false
+		}
+	}
+	if that1.RetryDeadline == nil {
+		if this.RetryDeadline != nil {
+			return false
+		}
+	} else if !this.RetryDeadline.Equal(*that1.RetryDeadline) {
+		return false
+	}
+	return true
+}
+func (this *MessageResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MessageResult)
+	if !ok {
+		that2, ok := that.(MessageResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Result != that1.Result {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
 	return true
 }
 func (this *TallyResult) Equal(that interface{}) bool {
@@ -1472,6 +1807,47 @@ func (this *Vote) Equal(that interface{}) bool {
 	if !this.SubmitTime.Equal(that1.SubmitTime) {
 		return false
 	}
+	if len(this.Delegators) != len(that1.Delegators) {
+		return false
+	}
+	for i := range this.Delegators {
+		if this.Delegators[i] != that1.Delegators[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *VoteDelegation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VoteDelegation)
+	if !ok {
+		that2, ok := that.(VoteDelegation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Delegator != that1.Delegator {
+		return false
+	}
+	if this.Delegate != that1.Delegate {
+		return false
+	}
+	if !this.StartTime.Equal(that1.StartTime) {
+		return false
+	}
+	if !this.EndTime.Equal(that1.EndTime) {
+		return false
+	}
 	return true
 }
 func (this *Pool) Equal(that interface{}) bool {
@@ -1503,6 +1879,58 @@ func (this *Pool) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TreasuryStream) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TreasuryStream)
+	if !ok {
+		that2, ok := that.(TreasuryStream)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	if len(this.Paid) != len(that1.Paid) {
+		return false
+	}
+	for i := range this.Paid {
+		if !this.Paid[i].Equal(&that1.Paid[i]) {
+			return false
+		}
+	}
+	if !this.StartTime.Equal(that1.StartTime) {
+		return false
+	}
+	if !this.EndTime.Equal(that1.EndTime) {
+		return false
+	}
+	if !this.CliffTime.Equal(that1.CliffTime) {
+		return false
+	}
+	return true
+}
 func (this *FoundationExecProposal) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1626,6 +2054,16 @@ func (m *Member) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFoundation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.AddedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.AddedAt):])
 	if err1 != nil {
 		return 0, err1
@@ -1671,6 +2109,16 @@ func (m *MemberRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFoundation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.Metadata) > 0 {
 		i -= len(m.Metadata)
 		copy(dAtA[i:], m.Metadata)
@@ -1927,6 +2375,30 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RetryDeadline != nil {
+		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RetryDeadline, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RetryDeadline):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintFoundation(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.MessageResults) > 0 {
+		for iNdEx := len(m.MessageResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MessageResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFoundation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.Messages) > 0 {
 		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1946,12 +2418,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x48
 	}
-	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingPeriodEnd):])
-	if err7 != nil {
-		return 0, err7
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingPeriodEnd):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n7
-	i = encodeVarintFoundation(dAtA, i, uint64(n7))
+	i -= n8
+	i = encodeVarintFoundation(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0x42
 	{
@@ -1974,12 +2446,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x28
 	}
-	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
-	if err9 != nil {
-		return 0, err9
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
+	if err10 != nil {
+		return 0, err10
 	}
-	i -= n9
-	i = encodeVarintFoundation(dAtA, i, uint64(n9))
+	i -= n10
+	i = encodeVarintFoundation(dAtA, i, uint64(n10))
 	i--
 	dAtA[i] = 0x22
 	if len(m.Proposers) > 0 {
@@ -2006,6 +2478,48 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MessageResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintFoundation(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintFoundation(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Result != 0 {
+		i = encodeVarintFoundation(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TallyResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2089,12 +2603,21 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
-	if err10 != nil {
-		return 0, err10
+	if len(m.Delegators) > 0 {
+		for iNdEx := len(m.Delegators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Delegators[iNdEx])
+			copy(dAtA[i:], m.Delegators[iNdEx])
+			i = encodeVarintFoundation(dAtA, i, uint64(len(m.Delegators[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	i -= n10
-	i = encodeVarintFoundation(dAtA, i, uint64(n10))
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintFoundation(dAtA, i, uint64(n11))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Metadata) > 0 {
@@ -2124,6 +2647,59 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VoteDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintFoundation(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x22
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintFoundation(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintFoundation(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintFoundation(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Pool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2161,6 +2737,93 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TreasuryStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TreasuryStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TreasuryStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CliffTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CliffTime):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintFoundation(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x3a
+	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintFoundation(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x32
+	n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintFoundation(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Paid) > 0 {
+		for iNdEx := len(m.Paid) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Paid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFoundation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFoundation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintFoundation(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintFoundation(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *FoundationExecProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2266,6 +2929,8 @@ func (m *Member) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.AddedAt)
 	n += 1 + l + sovFoundation(uint64(l))
+	l = m.Weight.Size()
+	n += 1 + l + sovFoundation(uint64(l))
 	return n
 }
 
@@ -2286,6 +2951,8 @@ func (m *MemberRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovFoundation(uint64(l))
 	}
+	l = m.Weight.Size()
+	n += 1 + l + sovFoundation(uint64(l))
 	return n
 }
 
@@ -2403,6 +3070,36 @@ func (m *Proposal) Size() (n int) {
 			n += 1 + l + sovFoundation(uint64(l))
 		}
 	}
+	if len(m.MessageResults) > 0 {
+		for _, e := range m.MessageResults {
+			l = e.Size()
+			n += 1 + l + sovFoundation(uint64(l))
+		}
+	}
+	if m.RetryDeadline != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.RetryDeadline)
+		n += 1 + l + sovFoundation(uint64(l))
+	}
+	return n
+}
+
+func (m *MessageResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != 0 {
+		n += 1 + sovFoundation(uint64(m.Result))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovFoundation(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovFoundation(uint64(l))
+	}
 	return n
 }
 
@@ -2445,6 +3142,33 @@ func (m *Vote) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime)
 	n += 1 + l + sovFoundation(uint64(l))
+	if len(m.Delegators) > 0 {
+		for _, s := range m.Delegators {
+			l = len(s)
+			n += 1 + l + sovFoundation(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VoteDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovFoundation(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovFoundation(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovFoundation(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovFoundation(uint64(l))
 	return n
 }
 
@@ -2463,6 +3187,40 @@ func (m *Pool) Size() (n int) {
 	return n
 }
 
+func (m *TreasuryStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovFoundation(uint64(m.Id))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovFoundation(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovFoundation(uint64(l))
+		}
+	}
+	if len(m.Paid) > 0 {
+		for _, e := range m.Paid {
+			l = e.Size()
+			n += 1 + l + sovFoundation(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovFoundation(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovFoundation(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CliffTime)
+	n += 1 + l + sovFoundation(uint64(l))
+	return n
+}
+
 func (m *FoundationExecProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2803,6 +3561,40 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFoundation(dAtA[iNdEx:])
@@ -2937,10 +3729,44 @@ func (m *MemberRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFoundation(dAtA[iNdEx:])
-			if err != nil {
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFoundation(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
@@ -3837,6 +4663,211 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageResults = append(m.MessageResults, MessageResult{})
+			if err := m.MessageResults[len(m.MessageResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryDeadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryDeadline == nil {
+				m.RetryDeadline = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.RetryDeadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFoundation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFoundation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= ProposalExecutorResult(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFoundation(dAtA[iNdEx:])
@@ -4208,6 +5239,38 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegators = append(m.Delegators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFoundation(dAtA[iNdEx:])
@@ -4229,7 +5292,7 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pool) Unmarshal(dAtA []byte) error {
+func (m *VoteDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4252,17 +5315,17 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+			return fmt.Errorf("proto: VoteDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VoteDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFoundation
@@ -4272,13 +5335,193 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFoundation
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFoundation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFoundation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFoundation
 			}
 			postIndex := iNdEx + msglen
 			if postIndex < 0 {
@@ -4313,6 +5556,274 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TreasuryStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFoundation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TreasuryStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TreasuryStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Paid = append(m.Paid, types1.Coin{})
+			if err := m.Paid[len(m.Paid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CliffTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFoundation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *FoundationExecProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -14,26 +14,26 @@ import (
 func TestTallyResult(t *testing.T) {
 	result := foundation.DefaultTallyResult()
 
-	err := result.Add(foundation.VOTE_OPTION_UNSPECIFIED)
+	err := result.Add(foundation.VOTE_OPTION_UNSPECIFIED, sdk.OneDec())
 	require.Error(t, err)
 
-	err = result.Add(foundation.VOTE_OPTION_YES)
+	err = result.Add(foundation.VOTE_OPTION_YES, sdk.OneDec())
 	require.NoError(t, err)
 	require.Equal(t, sdk.OneDec(), result.YesCount)
 
-	result.Add(foundation.VOTE_OPTION_ABSTAIN)
+	err = result.Add(foundation.VOTE_OPTION_ABSTAIN, sdk.NewDec(2))
 	require.NoError(t, err)
-	require.Equal(t, sdk.OneDec(), result.AbstainCount)
+	require.Equal(t, sdk.NewDec(2), result.AbstainCount)
 
-	result.Add(foundation.VOTE_OPTION_NO)
+	err = result.Add(foundation.VOTE_OPTION_NO, sdk.NewDec(3))
 	require.NoError(t, err)
-	require.Equal(t, sdk.OneDec(), result.NoCount)
+	require.Equal(t, sdk.NewDec(3), result.NoCount)
 
-	result.Add(foundation.VOTE_OPTION_NO_WITH_VETO)
+	err = result.Add(foundation.VOTE_OPTION_NO_WITH_VETO, sdk.NewDec(4))
 	require.NoError(t, err)
-	require.Equal(t, sdk.OneDec(), result.NoWithVetoCount)
+	require.Equal(t, sdk.NewDec(4), result.NoWithVetoCount)
 
-	require.Equal(t, sdk.NewDec(4), result.TotalCounts())
+	require.Equal(t, sdk.NewDec(10), result.TotalCounts())
 }
 
 func TestThresholdDecisionPolicy(t *testing.T) {
@@ -357,9 +357,11 @@ func TestMembers(t *testing.T) {
 			members: []foundation.Member{
 				{
 					Address: addrs[0].String(),
+					Weight:  sdk.OneDec(),
 				},
 				{
 					Address: addrs[1].String(),
+					Weight:  sdk.NewDec(2),
 				},
 			},
 			valid: true,
@@ -367,13 +369,30 @@ func TestMembers(t *testing.T) {
 		"invalid member": {
 			members: []foundation.Member{{}},
 		},
+		"empty weight": {
+			members: []foundation.Member{
+				{
+					Address: addrs[0].String(),
+				},
+			},
+		},
+		"zero weight": {
+			members: []foundation.Member{
+				{
+					Address: addrs[0].String(),
+					Weight:  sdk.ZeroDec(),
+				},
+			},
+		},
 		"duplicate members": {
 			members: []foundation.Member{
 				{
 					Address: addrs[0].String(),
+					Weight:  sdk.OneDec(),
 				},
 				{
 					Address: addrs[0].String(),
+					Weight:  sdk.OneDec(),
 				},
 			},
 		},
@@ -406,6 +425,7 @@ func TestMemberRequests(t *testing.T) {
 			members: []foundation.MemberRequest{
 				{
 					Address: addrs[0].String(),
+					Weight:  sdk.OneDec(),
 				},
 				{
 					Address: addrs[1].String(),
@@ -414,13 +434,38 @@ func TestMemberRequests(t *testing.T) {
 			},
 			valid: true,
 		},
+		"default weight": {
+			members: []foundation.MemberRequest{
+				{
+					Address: addrs[0].String(),
+				},
+			},
+			valid: true,
+		},
+		"zero weight": {
+			members: []foundation.MemberRequest{
+				{
+					Address: addrs[0].String(),
+					Weight:  sdk.ZeroDec(),
+				},
+			},
+		},
 		"invalid member": {
 			members: []foundation.MemberRequest{{}},
 		},
+		"negative weight": {
+			members: []foundation.MemberRequest{
+				{
+					Address: addrs[0].String(),
+					Weight:  sdk.NewDec(-1),
+				},
+			},
+		},
 		"duplicate requests": {
 			members: []foundation.MemberRequest{
 				{
 					Address: addrs[0].String(),
+					Weight:  sdk.OneDec(),
 				},
 				{
 					Address: addrs[0].String(),
@@ -635,3 +680,66 @@ func TestOutsourcingDecisionPolicyAllow(t *testing.T) {
 		})
 	}
 }
+
+func TestTreasuryStreamAccrued(t *testing.T) {
+	startTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	endTime := startTime.Add(1000 * time.Second)
+	amount := sdk.NewCoins(
+		sdk.NewCoin("foo", sdk.NewInt(1000)),
+		sdk.NewCoin("bar", sdk.NewInt(10)),
+	)
+
+	testCases := map[string]struct {
+		cliffTime time.Time
+		now       time.Time
+		accrued   sdk.Coins
+	}{
+		"before start": {
+			cliffTime: startTime,
+			now:       startTime.Add(-time.Second),
+			accrued:   sdk.NewCoins(),
+		},
+		"in the middle": {
+			cliffTime: startTime,
+			now:       startTime.Add(150 * time.Second),
+			accrued: sdk.NewCoins(
+				sdk.NewCoin("foo", sdk.NewInt(150)),
+				sdk.NewCoin("bar", sdk.NewInt(1)),
+			),
+		},
+		"before cliff": {
+			cliffTime: startTime.Add(500 * time.Second),
+			now:       startTime.Add(499 * time.Second),
+			accrued:   sdk.NewCoins(),
+		},
+		"on cliff": {
+			cliffTime: startTime.Add(500 * time.Second),
+			now:       startTime.Add(500 * time.Second),
+			accrued: sdk.NewCoins(
+				sdk.NewCoin("foo", sdk.NewInt(500)),
+				sdk.NewCoin("bar", sdk.NewInt(5)),
+			),
+		},
+		"after end": {
+			cliffTime: startTime,
+			now:       endTime.Add(time.Second),
+			accrued:   amount,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			stream := foundation.TreasuryStream{
+				Id:        1,
+				Recipient: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+				Amount:    amount,
+				StartTime: startTime,
+				EndTime:   endTime,
+				CliffTime: tc.cliffTime,
+			}
+			require.NoError(t, stream.ValidateBasic())
+
+			require.Equal(t, tc.accrued, stream.Accrued(tc.now))
+		})
+	}
+}
@@ -104,13 +104,13 @@ func ValidateGenesis(data GenesisState) error {
 	// Is x/foundation outsourcing the proposal feature
 	isOutsourcing := info.TotalWeight.IsZero()
 
-	if realWeight := sdk.NewDec(int64(len(data.Members))); !info.TotalWeight.Equal(realWeight) {
-		return sdkerrors.ErrInvalidRequest.Wrapf("total weight not match, %s != %s", info.TotalWeight, realWeight)
-	}
 	members := Members{Members: data.Members}
 	if err := members.ValidateBasic(); err != nil {
 		return err
 	}
+	if realWeight := members.TotalWeight(); !info.TotalWeight.Equal(realWeight) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("total weight not match, %s != %s", info.TotalWeight, realWeight)
+	}
 
 	if isOutsourcing && len(data.Proposals) != 0 {
 		return sdkerrors.ErrInvalidRequest.Wrap("outsourcing policy not allows proposals")
@@ -147,6 +147,12 @@ func ValidateGenesis(data GenesisState) error {
 		if err := validateVoteOption(vote.Option); err != nil {
 			return err
 		}
+
+		for _, delegator := range vote.Delegators {
+			if _, err := sdk.AccAddressFromBech32(delegator); err != nil {
+				return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", delegator)
+			}
+		}
 	}
 
 	seenURLs := map[string]bool{}
@@ -179,12 +185,55 @@ func ValidateGenesis(data GenesisState) error {
 		if _, err := sdk.AccAddressFromBech32(ga.Grantee); err != nil {
 			return err
 		}
+
+		if err := auth.ValidateBasic(); err != nil {
+			return err
+		}
 	}
 
 	if err := data.Pool.ValidateBasic(); err != nil {
 		return err
 	}
 
+	streamIDs := map[uint64]bool{}
+	for _, stream := range data.Streams {
+		id := stream.Id
+		if id > data.PreviousStreamId {
+			return sdkerrors.ErrInvalidRequest.Wrapf("stream %d has not yet been created", id)
+		}
+		if streamIDs[id] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicated stream id of %d", id)
+		}
+		streamIDs[id] = true
+
+		if err := stream.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	memberAddrs := map[string]bool{}
+	for _, member := range data.Members {
+		memberAddrs[member.Address] = true
+	}
+	delegators := map[string]bool{}
+	for _, delegation := range data.VoteDelegations {
+		if err := delegation.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if !memberAddrs[delegation.Delegator] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("delegator is not a member: %s", delegation.Delegator)
+		}
+		if !memberAddrs[delegation.Delegate] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("delegate is not a member: %s", delegation.Delegate)
+		}
+
+		if delegators[delegation.Delegator] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicated vote delegation of %s", delegation.Delegator)
+		}
+		delegators[delegation.Delegator] = true
+	}
+
 	return nil
 }
 
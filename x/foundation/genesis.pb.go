@@ -44,6 +44,12 @@ type GenesisState struct {
 	// pool
 	Pool        Pool         `protobuf:"bytes,8,opt,name=pool,proto3" json:"pool"`
 	Censorships []Censorship `protobuf:"bytes,10,rep,name=censorships,proto3" json:"censorships"`
+	// it is used to get the next treasury stream ID.
+	PreviousStreamId uint64 `protobuf:"varint,11,opt,name=previous_stream_id,json=previousStreamId,proto3" json:"previous_stream_id,omitempty"`
+	// streams is the list of the active treasury streams.
+	Streams []TreasuryStream `protobuf:"bytes,12,rep,name=streams,proto3" json:"streams"`
+	// vote_delegations is the list of the vote delegations.
+	VoteDelegations []VoteDelegation `protobuf:"bytes,13,rep,name=vote_delegations,json=voteDelegations,proto3" json:"vote_delegations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -126,40 +132,44 @@ func init() {
 func init() { proto.RegisterFile("lbm/foundation/v1/genesis.proto", fileDescriptor_c5e13dd78b24d473) }
 
 var fileDescriptor_c5e13dd78b24d473 = []byte{
-	// 517 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0x87, 0x6d, 0xea, 0xe6, 0xcf, 0x15, 0x10, 0x9c, 0x22, 0x71, 0x2d, 0xc2, 0x09, 0x91, 0x90,
-	0xba, 0xc4, 0x26, 0x74, 0x40, 0x94, 0xa1, 0x6a, 0x10, 0x8d, 0x0a, 0x42, 0x8a, 0x52, 0x89, 0x81,
-	0xa5, 0xb2, 0x93, 0x8b, 0x73, 0x22, 0xbe, 0xd7, 0xf2, 0x9d, 0x23, 0x0a, 0x5f, 0x80, 0x91, 0x8f,
-	0xd0, 0x91, 0x15, 0x89, 0x0f, 0x51, 0x31, 0x55, 0x4c, 0x4c, 0x08, 0x25, 0x0b, 0x1f, 0x03, 0xe5,
-	0x7c, 0x6e, 0x1c, 0x62, 0x06, 0x36, 0x5f, 0xde, 0xe7, 0x79, 0xdf, 0xdf, 0x9d, 0xde, 0xa0, 0xfa,
-	0xc4, 0x0f, 0xdd, 0x11, 0x24, 0x7c, 0xe8, 0x49, 0x06, 0xdc, 0x9d, 0xb6, 0xdd, 0x80, 0x72, 0x2a,
-	0x98, 0x70, 0xa2, 0x18, 0x24, 0xe0, 0xdb, 0x13, 0x3f, 0x74, 0x96, 0x80, 0x33, 0x6d, 0xef, 0xd4,
-	0x02, 0x08, 0x40, 0x55, 0xdd, 0xc5, 0x57, 0x0a, 0xee, 0x34, 0xd7, 0x3b, 0xe5, 0xb4, 0x94, 0xd9,
-	0x1e, 0x80, 0x08, 0x41, 0x9c, 0xa6, 0x72, 0x7a, 0xc8, 0x4a, 0x01, 0x40, 0x30, 0xa1, 0xae, 0x3a,
-	0xf9, 0xc9, 0xc8, 0xf5, 0xf8, 0x59, 0x5a, 0x6a, 0x7e, 0xb7, 0xd0, 0xf5, 0x6e, 0x1a, 0xea, 0x44,
-	0x7a, 0x92, 0xe2, 0xc7, 0xa8, 0x14, 0x79, 0xb1, 0x17, 0x0a, 0x62, 0x36, 0xcc, 0xdd, 0xad, 0x47,
-	0xdb, 0xce, 0x5a, 0x48, 0xa7, 0xa7, 0x80, 0x8e, 0x75, 0xf1, 0xb3, 0x6e, 0xf4, 0x35, 0x8e, 0xbb,
-	0x08, 0x2d, 0x29, 0x72, 0x4d, 0xc9, 0xf7, 0x0b, 0xe4, 0xa3, 0xab, 0xd3, 0x31, 0x1f, 0x81, 0x6e,
-	0x92, 0x53, 0xf1, 0x13, 0x54, 0x0e, 0x69, 0xe8, 0xd3, 0x58, 0x90, 0x8d, 0xc6, 0xc6, 0x3f, 0x22,
-	0xbc, 0x52, 0x84, 0xb6, 0x33, 0x1e, 0x3f, 0x44, 0xb5, 0x28, 0xa6, 0x53, 0x06, 0x89, 0x7a, 0x87,
-	0x08, 0x84, 0x37, 0x39, 0x65, 0x43, 0x62, 0x35, 0xcc, 0x5d, 0xab, 0x8f, 0xb3, 0x5a, 0x4f, 0x97,
-	0x8e, 0x87, 0xf8, 0x00, 0x55, 0x33, 0x50, 0x90, 0x4d, 0x35, 0xee, 0x6e, 0xd1, 0x8d, 0x35, 0xa3,
-	0x07, 0x2e, 0x1d, 0xbc, 0x87, 0x36, 0xa7, 0x20, 0xa9, 0x20, 0x25, 0x25, 0xdf, 0x29, 0x90, 0x5f,
-	0x83, 0xa4, 0x5a, 0x4c, 0x59, 0x7c, 0x82, 0x6e, 0x7a, 0x89, 0x1c, 0x43, 0xcc, 0xde, 0x2b, 0x4a,
-	0x90, 0xb2, 0xb2, 0x1f, 0x14, 0xd8, 0xdd, 0xd8, 0xe3, 0xf2, 0x30, 0x4f, 0xeb, 0x5e, 0x7f, 0xb5,
-	0xc0, 0x6d, 0x64, 0x45, 0x00, 0x13, 0x52, 0x51, 0x4f, 0x5f, 0x14, 0xa4, 0x07, 0x90, 0xdd, 0x40,
-	0xa1, 0xf8, 0x39, 0xda, 0x1a, 0x50, 0x2e, 0x20, 0x16, 0x63, 0x16, 0x09, 0x82, 0x54, 0x88, 0x7b,
-	0x05, 0xe6, 0xb3, 0x2b, 0x4a, 0xfb, 0x79, 0x6f, 0xbf, 0xf2, 0xf1, 0xbc, 0x6e, 0xfc, 0x3e, 0xaf,
-	0x1b, 0x2f, 0xac, 0x4a, 0xf5, 0x16, 0x6a, 0x7e, 0x31, 0x11, 0x5e, 0x8f, 0x8d, 0x09, 0x2a, 0x07,
-	0x8b, 0x5f, 0x29, 0x55, 0xbb, 0x55, 0xed, 0x67, 0x47, 0xfc, 0x01, 0xdd, 0x58, 0xb9, 0x8c, 0x5e,
-	0x9f, 0x9a, 0x93, 0x2e, 0xae, 0x93, 0x2d, 0xae, 0x73, 0xc8, 0xcf, 0x3a, 0x07, 0xdf, 0xbe, 0xb6,
-	0x9e, 0x06, 0x4c, 0x8e, 0x13, 0xdf, 0x19, 0x40, 0xe8, 0x1e, 0x31, 0x2e, 0x06, 0x63, 0xe6, 0xb9,
-	0x23, 0xfd, 0xd1, 0x12, 0xc3, 0xb7, 0xee, 0xbb, 0xfc, 0x3f, 0x64, 0x25, 0x47, 0x7f, 0x75, 0xd6,
-	0xbe, 0xb5, 0x48, 0xdf, 0x79, 0xf9, 0x79, 0x66, 0x9b, 0x17, 0x33, 0xdb, 0xbc, 0x9c, 0xd9, 0xe6,
-	0xaf, 0x99, 0x6d, 0x7e, 0x9a, 0xdb, 0xc6, 0xe5, 0xdc, 0x36, 0x7e, 0xcc, 0x6d, 0xe3, 0x4d, 0xeb,
-	0xbf, 0xe6, 0xf9, 0x25, 0x15, 0x78, 0xef, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x52, 0x37, 0xe3,
-	0x2e, 0x02, 0x04, 0x00, 0x00,
+	// 585 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xbd, 0x8e, 0xd3, 0x40,
+	0x10, 0x80, 0x6d, 0xe2, 0xcb, 0xcf, 0xe6, 0x0e, 0xc2, 0x2a, 0x12, 0xbe, 0x43, 0x38, 0x21, 0x12,
+	0xd2, 0x15, 0xc4, 0x26, 0x5c, 0x81, 0x38, 0x8a, 0x53, 0x02, 0x5c, 0x14, 0x10, 0x52, 0x94, 0x20,
+	0x0a, 0x9a, 0xc8, 0x8e, 0x37, 0x8e, 0x85, 0xed, 0xb1, 0xbc, 0x76, 0x44, 0xe0, 0x05, 0x28, 0xe9,
+	0x68, 0xaf, 0xa4, 0x45, 0xe2, 0x21, 0x4e, 0x54, 0x57, 0x52, 0x21, 0x94, 0x34, 0x3c, 0x06, 0xf2,
+	0x7a, 0x9d, 0x1f, 0x92, 0x14, 0x74, 0x5e, 0xcf, 0xf7, 0xcd, 0x8c, 0x67, 0x3d, 0xa8, 0xe2, 0x18,
+	0xae, 0x36, 0x82, 0xc8, 0x33, 0xf5, 0xd0, 0x06, 0x4f, 0x9b, 0x34, 0x34, 0x8b, 0x78, 0x84, 0xda,
+	0x54, 0xf5, 0x03, 0x08, 0x01, 0xdf, 0x74, 0x0c, 0x57, 0x5d, 0x02, 0xea, 0xa4, 0x71, 0x54, 0xb6,
+	0xc0, 0x02, 0x16, 0xd5, 0xe2, 0xa7, 0x04, 0x3c, 0xaa, 0x6d, 0x66, 0x5a, 0xd1, 0x12, 0xe6, 0x70,
+	0x08, 0xd4, 0x05, 0x3a, 0x48, 0xe4, 0xe4, 0x90, 0x86, 0x2c, 0x00, 0xcb, 0x21, 0x1a, 0x3b, 0x19,
+	0xd1, 0x48, 0xd3, 0xbd, 0x69, 0x12, 0xaa, 0x7d, 0xc9, 0xa2, 0xfd, 0x76, 0xd2, 0x54, 0x3f, 0xd4,
+	0x43, 0x82, 0x1f, 0xa1, 0xac, 0xaf, 0x07, 0xba, 0x4b, 0x65, 0xb1, 0x2a, 0x1e, 0x17, 0x1f, 0x1e,
+	0xaa, 0x1b, 0x4d, 0xaa, 0x5d, 0x06, 0xb4, 0xa4, 0xcb, 0x5f, 0x15, 0xa1, 0xc7, 0x71, 0xdc, 0x46,
+	0x68, 0x49, 0xc9, 0xd7, 0x98, 0x7c, 0x77, 0x8b, 0x7c, 0xbe, 0x38, 0x75, 0xbc, 0x11, 0xf0, 0x24,
+	0x2b, 0x2a, 0x7e, 0x8c, 0x72, 0x2e, 0x71, 0x0d, 0x12, 0x50, 0x39, 0x53, 0xcd, 0xec, 0x68, 0xe1,
+	0x15, 0x23, 0xb8, 0x9d, 0xf2, 0xf8, 0x01, 0x2a, 0xfb, 0x01, 0x99, 0xd8, 0x10, 0xb1, 0x39, 0xf8,
+	0x40, 0x75, 0x67, 0x60, 0x9b, 0xb2, 0x54, 0x15, 0x8f, 0xa5, 0x1e, 0x4e, 0x63, 0x5d, 0x1e, 0xea,
+	0x98, 0xf8, 0x0c, 0x15, 0x52, 0x90, 0xca, 0x7b, 0xac, 0xdc, 0xed, 0x6d, 0x5f, 0xcc, 0x19, 0x5e,
+	0x70, 0xe9, 0xe0, 0x13, 0xb4, 0x37, 0x81, 0x90, 0x50, 0x39, 0xcb, 0xe4, 0x5b, 0x5b, 0xe4, 0x37,
+	0x10, 0x12, 0x2e, 0x26, 0x2c, 0xee, 0xa3, 0xeb, 0x7a, 0x14, 0x8e, 0x21, 0xb0, 0x3f, 0x30, 0x8a,
+	0xca, 0x39, 0x66, 0xdf, 0xdb, 0x62, 0xb7, 0x03, 0xdd, 0x0b, 0x9b, 0xab, 0x34, 0xcf, 0xf5, 0x4f,
+	0x0a, 0xdc, 0x40, 0x92, 0x0f, 0xe0, 0xc8, 0xf9, 0xaa, 0xb8, 0xa3, 0x91, 0x2e, 0x40, 0xfa, 0x05,
+	0x0c, 0xc5, 0xcf, 0x51, 0x71, 0x48, 0x3c, 0x0a, 0x01, 0x1d, 0xdb, 0x3e, 0x95, 0x11, 0x6b, 0xe2,
+	0xce, 0x16, 0xf3, 0xe9, 0x82, 0xe2, 0xfe, 0xaa, 0x87, 0xef, 0xa3, 0xc5, 0x68, 0x07, 0x34, 0x0c,
+	0x88, 0xee, 0xc6, 0x43, 0x2f, 0xb2, 0xa1, 0x97, 0xd2, 0x48, 0x9f, 0x05, 0x3a, 0x26, 0x6e, 0xa2,
+	0x5c, 0x02, 0x51, 0x79, 0xbf, 0x9a, 0xd9, 0xf1, 0x97, 0xbc, 0x0e, 0x88, 0x4e, 0xa3, 0x60, 0x9a,
+	0x58, 0xe9, 0x3d, 0x73, 0x0f, 0xf7, 0x50, 0x29, 0x1e, 0xe4, 0xc0, 0x24, 0x0e, 0xb1, 0xf8, 0x04,
+	0x0f, 0x76, 0xe6, 0x8a, 0xe7, 0xff, 0x6c, 0x41, 0xf2, 0x5c, 0x37, 0x26, 0x6b, 0x6f, 0xe9, 0x69,
+	0xfe, 0xd3, 0x45, 0x45, 0xf8, 0x73, 0x51, 0x11, 0x5e, 0x48, 0xf9, 0x42, 0x09, 0xd5, 0xbe, 0x89,
+	0x08, 0x6f, 0xce, 0x1e, 0xcb, 0x28, 0x67, 0xc5, 0x6f, 0x09, 0x61, 0x0b, 0x52, 0xe8, 0xa5, 0x47,
+	0xfc, 0x11, 0x1d, 0xac, 0xdd, 0x08, 0xdf, 0x81, 0xb2, 0x9a, 0x6c, 0x9f, 0x9a, 0x6e, 0x9f, 0xda,
+	0xf4, 0xa6, 0xad, 0xb3, 0x1f, 0xdf, 0xeb, 0x4f, 0x2c, 0x3b, 0x1c, 0x47, 0x86, 0x3a, 0x04, 0x57,
+	0x3b, 0xb7, 0x3d, 0x3a, 0x1c, 0xdb, 0xba, 0x36, 0xe2, 0x0f, 0x75, 0x6a, 0xbe, 0xd3, 0xde, 0xaf,
+	0xae, 0xf9, 0x5a, 0x1f, 0xbd, 0xf5, 0x5a, 0xa7, 0x52, 0xdc, 0x7d, 0xeb, 0xe5, 0xd7, 0x99, 0x22,
+	0x5e, 0xce, 0x14, 0xf1, 0x6a, 0xa6, 0x88, 0xbf, 0x67, 0x8a, 0xf8, 0x79, 0xae, 0x08, 0x57, 0x73,
+	0x45, 0xf8, 0x39, 0x57, 0x84, 0xb7, 0xf5, 0xff, 0xaa, 0x67, 0x64, 0x59, 0xc3, 0x27, 0x7f, 0x07,
+	0x00, 0x1f, 0x91, 0x66, 0x20, 0xc7, 0x04, 0x00, 0x00,
 }
 
 func (this *GrantAuthorization) Equal(that interface{}) bool {
@@ -209,6 +219,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VoteDelegations) > 0 {
+		for iNdEx := len(m.VoteDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Streams) > 0 {
+		for iNdEx := len(m.Streams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Streams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.PreviousStreamId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PreviousStreamId))
+		i--
+		dAtA[i] = 0x58
+	}
 	if len(m.Censorships) > 0 {
 		for iNdEx := len(m.Censorships) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -415,6 +458,21 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.PreviousStreamId != 0 {
+		n += 1 + sovGenesis(uint64(m.PreviousStreamId))
+	}
+	if len(m.Streams) > 0 {
+		for _, e := range m.Streams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VoteDelegations) > 0 {
+		for _, e := range m.VoteDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -758,6 +816,93 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStreamId", wireType)
+			}
+			m.PreviousStreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousStreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Streams = append(m.Streams, TreasuryStream{})
+			if err := m.Streams[len(m.Streams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteDelegations = append(m.VoteDelegations, VoteDelegation{})
+			if err := m.VoteDelegations[len(m.VoteDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
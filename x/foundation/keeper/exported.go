@@ -4,6 +4,7 @@ import (
 	"github.com/Finschia/finschia-sdk/baseapp"
 	"github.com/Finschia/finschia-sdk/codec"
 	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/kv"
 	"github.com/Finschia/finschia-sdk/x/foundation"
 	"github.com/Finschia/finschia-sdk/x/foundation/keeper/internal"
 	govtypes "github.com/Finschia/finschia-sdk/x/gov/types"
@@ -13,6 +14,7 @@ import (
 type Keeper interface {
 	GetAuthority() string
 	Accept(ctx sdk.Context, grantee sdk.AccAddress, msg sdk.Msg) error
+	Revoke(ctx sdk.Context, grantee sdk.AccAddress, msgTypeURL string) error
 
 	InitGenesis(ctx sdk.Context, gs *foundation.GenesisState) error
 	ExportGenesis(ctx sdk.Context) *foundation.GenesisState
@@ -57,6 +59,10 @@ func (k keeper) Accept(ctx sdk.Context, grantee sdk.AccAddress, msg sdk.Msg) err
 	return k.impl.Accept(ctx, grantee, msg)
 }
 
+func (k keeper) Revoke(ctx sdk.Context, grantee sdk.AccAddress, msgTypeURL string) error {
+	return k.impl.Revoke(ctx, grantee, msgTypeURL)
+}
+
 func (k keeper) InitGenesis(ctx sdk.Context, gs *foundation.GenesisState) error {
 	return k.impl.InitGenesis(ctx, gs)
 }
@@ -80,6 +86,10 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	internal.RegisterInvariants(ir, impl)
 }
 
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return internal.NewDecodeStore(cdc)
+}
+
 func BeginBlocker(ctx sdk.Context, k Keeper) {
 	impl := k.(*keeper).impl
 	internal.BeginBlocker(ctx, impl)
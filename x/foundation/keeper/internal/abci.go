@@ -9,13 +9,15 @@ import (
 )
 
 // BeginBlocker withdraws rewards from fee-collector before the distribution
-// module's withdraw.
+// module's withdraw, and pays out the accrual of the treasury streams.
 func BeginBlocker(ctx sdk.Context, k Keeper) {
 	defer telemetry.ModuleMeasureSince(foundation.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
 	if err := k.CollectFoundationTax(ctx); err != nil {
 		panic(err)
 	}
+
+	k.PayTreasuryStreams(ctx)
 }
 
 func EndBlocker(ctx sdk.Context, k Keeper) {
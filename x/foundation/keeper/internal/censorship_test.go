@@ -187,3 +187,59 @@ func (s *KeeperTestSuite) TestAccept() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestAcceptBudget() {
+	testCases := map[string]struct {
+		spendLimit sdk.Coins
+		amount     sdk.Coins
+		valid      bool
+		remaining  sdk.Coins
+	}{
+		"budget consumed": {
+			spendLimit: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(2))),
+			amount:     sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())),
+			valid:      true,
+			remaining:  sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())),
+		},
+		"budget exhausted": {
+			spendLimit: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())),
+			amount:     sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())),
+			valid:      true,
+		},
+		"budget exceeded": {
+			spendLimit: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())),
+			amount:     sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(2))),
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			grantee := s.members[0]
+			err := s.impl.Grant(ctx, grantee, &foundation.ReceiveFromTreasuryAuthorization{
+				SpendLimit: tc.spendLimit,
+			})
+			s.Require().NoError(err)
+
+			err = s.impl.Accept(ctx, grantee, &foundation.MsgWithdrawFromTreasury{
+				Authority: s.authority.String(),
+				To:        grantee.String(),
+				Amount:    tc.amount,
+			})
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			authorization, err := s.impl.GetAuthorization(ctx, grantee, foundation.ReceiveFromTreasuryAuthorization{}.MsgTypeURL())
+			if tc.remaining.Empty() {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.remaining, authorization.(*foundation.ReceiveFromTreasuryAuthorization).SpendLimit)
+		})
+	}
+}
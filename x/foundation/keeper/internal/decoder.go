@@ -0,0 +1,88 @@
+package internal
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/Finschia/finschia-sdk/codec"
+	"github.com/Finschia/finschia-sdk/types/kv"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding foundation type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], foundationInfoKey):
+			var infoA, infoB foundation.FoundationInfo
+			cdc.MustUnmarshal(kvA.Value, &infoA)
+			cdc.MustUnmarshal(kvB.Value, &infoB)
+			return fmt.Sprintf("%v\n%v", infoA, infoB)
+
+		case bytes.Equal(kvA.Key[:1], memberKeyPrefix):
+			var memberA, memberB foundation.Member
+			cdc.MustUnmarshal(kvA.Value, &memberA)
+			cdc.MustUnmarshal(kvB.Value, &memberB)
+			return fmt.Sprintf("%v\n%v", memberA, memberB)
+
+		case bytes.Equal(kvA.Key[:1], previousProposalIDKey),
+			bytes.Equal(kvA.Key[:1], previousStreamIDKey):
+			return fmt.Sprintf("%d\n%d", Uint64FromBytes(kvA.Value), Uint64FromBytes(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], proposalKeyPrefix):
+			var proposalA, proposalB foundation.Proposal
+			cdc.MustUnmarshal(kvA.Value, &proposalA)
+			cdc.MustUnmarshal(kvB.Value, &proposalB)
+			return fmt.Sprintf("%v\n%v", proposalA, proposalB)
+
+		case bytes.Equal(kvA.Key[:1], voteKeyPrefix):
+			var voteA, voteB foundation.Vote
+			cdc.MustUnmarshal(kvA.Value, &voteA)
+			cdc.MustUnmarshal(kvB.Value, &voteB)
+			return fmt.Sprintf("%v\n%v", voteA, voteB)
+
+		case bytes.Equal(kvA.Key[:1], voteDelegationKeyPrefix):
+			var delegationA, delegationB foundation.VoteDelegation
+			cdc.MustUnmarshal(kvA.Value, &delegationA)
+			cdc.MustUnmarshal(kvB.Value, &delegationB)
+			return fmt.Sprintf("%v\n%v", delegationA, delegationB)
+
+		case bytes.Equal(kvA.Key[:1], censorshipKeyPrefix):
+			var censorshipA, censorshipB foundation.Censorship
+			cdc.MustUnmarshal(kvA.Value, &censorshipA)
+			cdc.MustUnmarshal(kvB.Value, &censorshipB)
+			return fmt.Sprintf("%v\n%v", censorshipA, censorshipB)
+
+		case bytes.Equal(kvA.Key[:1], grantKeyPrefix):
+			var authorizationA, authorizationB foundation.Authorization
+			if err := cdc.UnmarshalInterface(kvA.Value, &authorizationA); err != nil {
+				panic(err)
+			}
+			if err := cdc.UnmarshalInterface(kvB.Value, &authorizationB); err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%v\n%v", authorizationA, authorizationB)
+
+		case bytes.Equal(kvA.Key[:1], poolKey):
+			var poolA, poolB foundation.Pool
+			cdc.MustUnmarshal(kvA.Value, &poolA)
+			cdc.MustUnmarshal(kvB.Value, &poolB)
+			return fmt.Sprintf("%v\n%v", poolA, poolB)
+
+		case bytes.Equal(kvA.Key[:1], streamKeyPrefix):
+			var streamA, streamB foundation.TreasuryStream
+			cdc.MustUnmarshal(kvA.Value, &streamA)
+			cdc.MustUnmarshal(kvB.Value, &streamB)
+			return fmt.Sprintf("%v\n%v", streamA, streamB)
+
+		case bytes.Equal(kvA.Key[:1], proposalByVPEndKeyPrefix),
+			bytes.Equal(kvA.Key[:1], proposalByRetryDeadlineKeyPrefix):
+			// the values are empty, the keys carry the data
+			return fmt.Sprintf("%X\n%X", kvA.Key, kvB.Key)
+
+		default:
+			panic(fmt.Sprintf("invalid foundation key %X", kvA.Key))
+		}
+	}
+}
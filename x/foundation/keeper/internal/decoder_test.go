@@ -0,0 +1,96 @@
+package internal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/Finschia/finschia-sdk/simapp"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/kv"
+	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+	"github.com/Finschia/finschia-sdk/x/foundation/keeper/internal"
+)
+
+func TestDecodeStore(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	key := app.GetKey(foundation.StoreKey)
+	impl := internal.NewKeeper(
+		app.AppCodec(),
+		key,
+		app.MsgServiceRouter(),
+		app.AccountKeeper,
+		app.BankKeeper,
+		authtypes.FeeCollectorName,
+		foundation.DefaultConfig(),
+		foundation.DefaultAuthority().String(),
+		app.GetSubspace(foundation.ModuleName),
+	)
+	dec := internal.NewDecodeStore(app.AppCodec())
+
+	authority := foundation.DefaultAuthority()
+	members := []sdk.AccAddress{sdk.AccAddress("alice"), sdk.AccAddress("bob")}
+	stranger := sdk.AccAddress("stranger")
+
+	for _, member := range members {
+		impl.SetMember(ctx, foundation.Member{
+			Address: member.String(),
+			Weight:  sdk.OneDec(),
+		})
+	}
+	info := foundation.DefaultFoundation()
+	info.TotalWeight = sdk.NewDec(int64(len(members)))
+	require.NoError(t, info.SetDecisionPolicy(workingPolicy()))
+	impl.SetFoundationInfo(ctx, info)
+
+	amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	impl.SetPool(ctx, foundation.Pool{
+		Treasury: sdk.NewDecCoinsFromCoins(amount...),
+	})
+	_, err := impl.CreateTreasuryStream(ctx, stranger, amount, ctx.BlockTime(), ctx.BlockTime().Add(time.Hour), ctx.BlockTime())
+	require.NoError(t, err)
+
+	msgTypeURL := sdk.MsgTypeURL((*foundation.MsgWithdrawFromTreasury)(nil))
+	impl.SetCensorship(ctx, foundation.Censorship{
+		MsgTypeUrl: msgTypeURL,
+		Authority:  foundation.CensorshipAuthorityFoundation,
+	})
+	require.NoError(t, impl.Grant(ctx, stranger, &foundation.ReceiveFromTreasuryAuthorization{}))
+
+	id, err := impl.SubmitProposal(ctx, []string{members[0].String()}, "", []sdk.Msg{
+		&foundation.MsgWithdrawFromTreasury{
+			Authority: authority.String(),
+			To:        stranger.String(),
+			Amount:    amount,
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, impl.DelegateVote(ctx, foundation.VoteDelegation{
+		Delegator: members[1].String(),
+		Delegate:  members[0].String(),
+		StartTime: ctx.BlockTime(),
+		EndTime:   ctx.BlockTime().Add(time.Hour),
+	}))
+	require.NoError(t, impl.Vote(ctx, foundation.Vote{
+		ProposalId: *id,
+		Voter:      members[0].String(),
+		Option:     foundation.VOTE_OPTION_YES,
+	}))
+
+	// every entry in the store must be decodable
+	store := ctx.KVStore(key)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		pair := kv.Pair{Key: iterator.Key(), Value: iterator.Value()}
+		require.NotPanics(t, func() { dec(pair, pair) }, "%X", pair.Key)
+	}
+
+	invalid := kv.Pair{Key: []byte{0x99}, Value: []byte{0x99}}
+	require.Panics(t, func() { dec(invalid, invalid) })
+}
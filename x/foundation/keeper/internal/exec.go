@@ -46,14 +46,30 @@ func (k Keeper) Exec(ctx sdk.Context, proposalID uint64) error {
 	var logs string
 	if proposal.Status == foundation.PROPOSAL_STATUS_ACCEPTED &&
 		proposal.ExecutorResult != foundation.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
+		if proposal.RetryDeadline != nil && ctx.BlockTime().After(*proposal.RetryDeadline) {
+			return sdkerrors.ErrInvalidRequest.Wrapf("retry window of proposal %d has passed", proposalID)
+		}
+
 		logger := ctx.Logger().With("module", fmt.Sprintf("x/%s", foundation.ModuleName))
 		// Caching context so that we don't update the store in case of failure.
 		cacheCtx, flush := ctx.CacheContext()
 
-		if results, err := k.doExecuteMsgs(cacheCtx, *proposal); err != nil {
+		results, err := k.doExecuteMsgs(cacheCtx, *proposal)
+		proposal.MessageResults = newMessageResults(results, err)
+		if err != nil {
 			proposal.ExecutorResult = foundation.PROPOSAL_EXECUTOR_RESULT_FAILURE
 			logs = fmt.Sprintf("proposal execution failed on proposal %d, because of error %s", proposalID, err.Error())
 			logger.Info("proposal execution failed", "cause", err, "proposalID", proposal.Id)
+
+			// the retry window starts from the first failure, and never ends before the execution period
+			if proposal.RetryDeadline == nil {
+				retryDeadline := ctx.BlockTime().Add(k.config.MaxRetryPeriod)
+				if expiry := proposal.VotingPeriodEnd.Add(k.config.MaxExecutionPeriod); retryDeadline.Before(expiry) {
+					retryDeadline = expiry
+				}
+				proposal.RetryDeadline = &retryDeadline
+				k.addProposalToRetryQueue(ctx, *proposal)
+			}
 		} else {
 			proposal.ExecutorResult = foundation.PROPOSAL_EXECUTOR_RESULT_SUCCESS
 			flush()
@@ -73,9 +89,10 @@ func (k Keeper) Exec(ctx sdk.Context, proposalID uint64) error {
 	}
 
 	if err := ctx.EventManager().EmitTypedEvent(&foundation.EventExec{
-		ProposalId: proposal.Id,
-		Logs:       logs,
-		Result:     proposal.ExecutorResult,
+		ProposalId:     proposal.Id,
+		Logs:           logs,
+		Result:         proposal.ExecutorResult,
+		MessageResults: proposal.MessageResults,
 	}); err != nil {
 		panic(err)
 	}
@@ -84,9 +101,11 @@ func (k Keeper) Exec(ctx sdk.Context, proposalID uint64) error {
 }
 
 // doExecuteMsgs routes the messages to the registered handlers.
+// On failure, it returns the results of the messages executed before the
+// failing one.
 func (k Keeper) doExecuteMsgs(ctx sdk.Context, proposal foundation.Proposal) ([]sdk.Result, error) {
 	msgs := proposal.GetMsgs()
-	results := make([]sdk.Result, len(msgs))
+	results := make([]sdk.Result, 0, len(msgs))
 
 	authority := sdk.MustAccAddressFromBech32(k.GetAuthority())
 	if err := ensureMsgAuthz(msgs, authority); err != nil {
@@ -96,15 +115,37 @@ func (k Keeper) doExecuteMsgs(ctx sdk.Context, proposal foundation.Proposal) ([]
 	for i, msg := range msgs {
 		handler := k.router.Handler(msg)
 		if handler == nil {
-			return nil, sdkerrors.ErrUnknownRequest.Wrapf("no message handler found for %q", sdk.MsgTypeURL(msg))
+			return results, sdkerrors.ErrUnknownRequest.Wrapf("no message handler found for %q", sdk.MsgTypeURL(msg))
 		}
 		r, err := handler(ctx, msg)
 		if err != nil {
-			return nil, sdkerrors.Wrapf(err, "message %q at position %d", msg, i)
+			return results, sdkerrors.Wrapf(err, "message %q at position %d", msg, i)
 		}
-		if r != nil {
-			results[i] = *r
+		if r == nil {
+			r = &sdk.Result{}
 		}
+		results = append(results, *r)
 	}
 	return results, nil
 }
+
+// newMessageResults converts the results of doExecuteMsgs into the
+// per-message results, appending the failure of the next message if any.
+func newMessageResults(results []sdk.Result, err error) []foundation.MessageResult {
+	messageResults := make([]foundation.MessageResult, 0, len(results)+1)
+	for _, res := range results {
+		messageResults = append(messageResults, foundation.MessageResult{
+			Result: foundation.PROPOSAL_EXECUTOR_RESULT_SUCCESS,
+			Data:   res.Data,
+		})
+	}
+
+	if err != nil {
+		messageResults = append(messageResults, foundation.MessageResult{
+			Result: foundation.PROPOSAL_EXECUTOR_RESULT_FAILURE,
+			Error:  err.Error(),
+		})
+	}
+
+	return messageResults
+}
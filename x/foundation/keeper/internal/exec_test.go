@@ -1,5 +1,11 @@
 package internal_test
 
+import (
+	"time"
+
+	"github.com/Finschia/finschia-sdk/x/foundation"
+)
+
 func (s *KeeperTestSuite) TestExec() {
 	testCases := map[string]struct {
 		proposalID uint64
@@ -42,3 +48,70 @@ func (s *KeeperTestSuite) TestExec() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestExecRetry() {
+	ctx, _ := s.ctx.CacheContext()
+
+	err := s.impl.Exec(ctx, s.noHandlerProposal)
+	s.Require().NoError(err)
+
+	proposal, err := s.impl.GetProposal(ctx, s.noHandlerProposal)
+	s.Require().NoError(err)
+	s.Require().Equal(foundation.PROPOSAL_EXECUTOR_RESULT_FAILURE, proposal.ExecutorResult)
+	s.Require().Len(proposal.MessageResults, 1)
+	s.Require().Equal(foundation.PROPOSAL_EXECUTOR_RESULT_FAILURE, proposal.MessageResults[0].Result)
+	s.Require().NotEmpty(proposal.MessageResults[0].Error)
+
+	// the window of a proposal failing early is the execution period
+	retryDeadline := proposal.VotingPeriodEnd.Add(foundation.DefaultConfig().MaxExecutionPeriod)
+	s.Require().True(ctx.BlockTime().Add(foundation.DefaultConfig().MaxRetryPeriod).Before(retryDeadline))
+	s.Require().NotNil(proposal.RetryDeadline)
+	s.Require().Equal(retryDeadline, *proposal.RetryDeadline)
+
+	// retry within the window does not extend it
+	ctx = ctx.WithBlockTime(retryDeadline)
+	err = s.impl.Exec(ctx, s.noHandlerProposal)
+	s.Require().NoError(err)
+
+	proposal, err = s.impl.GetProposal(ctx, s.noHandlerProposal)
+	s.Require().NoError(err)
+	s.Require().Equal(retryDeadline, *proposal.RetryDeadline)
+
+	// retry after the window
+	ctx = ctx.WithBlockTime(retryDeadline.Add(time.Nanosecond))
+	err = s.impl.Exec(ctx, s.noHandlerProposal)
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestPruneFailedProposal() {
+	ctx, _ := s.ctx.CacheContext()
+
+	proposal, err := s.impl.GetProposal(ctx, s.noHandlerProposal)
+	s.Require().NoError(err)
+	expiry := proposal.VotingPeriodEnd.Add(foundation.DefaultConfig().MaxExecutionPeriod)
+
+	// the execution fails right before the proposal expires
+	ctx = ctx.WithBlockTime(expiry.Add(-time.Hour))
+	err = s.impl.Exec(ctx, s.noHandlerProposal)
+	s.Require().NoError(err)
+	proposal, err = s.impl.GetProposal(ctx, s.noHandlerProposal)
+	s.Require().NoError(err)
+	s.Require().Equal(foundation.PROPOSAL_EXECUTOR_RESULT_FAILURE, proposal.ExecutorResult)
+	retryDeadline := *proposal.RetryDeadline
+	s.Require().Equal(ctx.BlockTime().Add(foundation.DefaultConfig().MaxRetryPeriod), retryDeadline)
+
+	// it is kept for the whole retry window
+	for _, now := range []time.Time{expiry, retryDeadline} {
+		ctx = ctx.WithBlockTime(now)
+		s.impl.PruneExpiredProposals(ctx)
+		_, err = s.impl.GetProposal(ctx, s.noHandlerProposal)
+		s.Require().NoError(err)
+	}
+	err = s.impl.Exec(ctx, s.noHandlerProposal)
+	s.Require().NoError(err)
+
+	ctx = ctx.WithBlockTime(retryDeadline.Add(time.Nanosecond))
+	s.impl.PruneExpiredProposals(ctx)
+	_, err = s.impl.GetProposal(ctx, s.noHandlerProposal)
+	s.Require().Error(err)
+}
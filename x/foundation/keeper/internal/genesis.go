@@ -26,7 +26,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *foundation.GenesisState) erro
 		}
 
 		k.setProposal(ctx, proposal)
-		k.addProposalToVPEndQueue(ctx, proposal)
+		if proposal.RetryDeadline != nil {
+			k.addProposalToRetryQueue(ctx, proposal)
+		} else {
+			k.addProposalToVPEndQueue(ctx, proposal)
+		}
 	}
 
 	for _, vote := range data.Votes {
@@ -48,6 +52,16 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *foundation.GenesisState) erro
 
 	k.SetPool(ctx, data.Pool)
 
+	k.setPreviousStreamID(ctx, data.PreviousStreamId)
+
+	for _, stream := range data.Streams {
+		k.setStream(ctx, stream)
+	}
+
+	for _, delegation := range data.VoteDelegations {
+		k.setVoteDelegation(ctx, delegation)
+	}
+
 	return nil
 }
 
@@ -70,6 +84,9 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *foundation.GenesisState {
 		Censorships:        k.GetCensorships(ctx),
 		Authorizations:     k.GetGrants(ctx),
 		Pool:               k.GetPool(ctx),
+		PreviousStreamId:   k.getPreviousStreamID(ctx),
+		Streams:            k.GetTreasuryStreams(ctx),
+		VoteDelegations:    k.GetVoteDelegations(ctx),
 	}
 }
 
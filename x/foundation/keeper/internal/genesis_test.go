@@ -69,6 +69,7 @@ func TestImportExportGenesis(t *testing.T) {
 				Members: []foundation.Member{
 					{
 						Address: member.String(),
+						Weight:  sdk.OneDec(),
 					},
 				},
 			},
@@ -82,6 +83,7 @@ func TestImportExportGenesis(t *testing.T) {
 				Members: []foundation.Member{
 					{
 						Address: member.String(),
+						Weight:  sdk.OneDec(),
 					},
 				},
 			},
@@ -96,6 +98,7 @@ func TestImportExportGenesis(t *testing.T) {
 				Members: []foundation.Member{
 					{
 						Address: member.String(),
+						Weight:  sdk.OneDec(),
 					},
 				},
 				PreviousProposalId: 1,
@@ -124,6 +127,7 @@ func TestImportExportGenesis(t *testing.T) {
 				Members: []foundation.Member{
 					{
 						Address: member.String(),
+						Weight:  sdk.OneDec(),
 					},
 				},
 				PreviousProposalId: 1,
@@ -199,6 +203,39 @@ func TestImportExportGenesis(t *testing.T) {
 				},
 			},
 		},
+		"streams": {
+			init: &foundation.GenesisState{
+				Params:           foundation.DefaultParams(),
+				Foundation:       foundation.DefaultFoundation(),
+				PreviousStreamId: 1,
+				Streams: []foundation.TreasuryStream{
+					{
+						Id:        1,
+						Recipient: stranger.String(),
+						Amount:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())),
+						StartTime: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+						EndTime:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+						CliffTime: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+			valid: true,
+			export: &foundation.GenesisState{
+				Params:           foundation.DefaultParams(),
+				Foundation:       foundation.DefaultFoundation(),
+				PreviousStreamId: 1,
+				Streams: []foundation.TreasuryStream{
+					{
+						Id:        1,
+						Recipient: stranger.String(),
+						Amount:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())),
+						StartTime: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+						EndTime:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+						CliffTime: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+		},
 		"member of long metadata": {
 			init: &foundation.GenesisState{
 				Params: foundation.DefaultParams(),
@@ -209,6 +246,7 @@ func TestImportExportGenesis(t *testing.T) {
 				Members: []foundation.Member{
 					{
 						Address:  member.String(),
+						Weight:   sdk.OneDec(),
 						Metadata: string(make([]rune, 256)),
 					},
 				},
@@ -224,6 +262,7 @@ func TestImportExportGenesis(t *testing.T) {
 				Members: []foundation.Member{
 					{
 						Address: member.String(),
+						Weight:  sdk.OneDec(),
 					},
 				},
 				PreviousProposalId: 1,
@@ -247,6 +286,7 @@ func TestImportExportGenesis(t *testing.T) {
 				Members: []foundation.Member{
 					{
 						Address: member.String(),
+						Weight:  sdk.OneDec(),
 					},
 				},
 				PreviousProposalId: 1,
@@ -49,6 +49,42 @@ func (s queryServer) Treasury(c context.Context, req *foundation.QueryTreasuryRe
 	return &foundation.QueryTreasuryResponse{Amount: amount}, nil
 }
 
+func (s queryServer) TreasuryStream(c context.Context, req *foundation.QueryTreasuryStreamRequest) (*foundation.QueryTreasuryStreamResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	stream, err := s.keeper.GetTreasuryStream(ctx, req.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &foundation.QueryTreasuryStreamResponse{Stream: stream}, nil
+}
+
+func (s queryServer) TreasuryStreams(c context.Context, req *foundation.QueryTreasuryStreamsRequest) (*foundation.QueryTreasuryStreamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var streams []foundation.TreasuryStream
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	streamStore := prefix.NewStore(store, streamKeyPrefix)
+	pageRes, err := query.Paginate(streamStore, req.Pagination, func(key []byte, value []byte) error {
+		var stream foundation.TreasuryStream
+		s.keeper.cdc.MustUnmarshal(value, &stream)
+		streams = append(streams, stream)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &foundation.QueryTreasuryStreamsResponse{Streams: streams, Pagination: pageRes}, nil
+}
+
 func (s queryServer) FoundationInfo(c context.Context, req *foundation.QueryFoundationInfoRequest) (*foundation.QueryFoundationInfoResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -195,6 +231,52 @@ func (s queryServer) TallyResult(c context.Context, req *foundation.QueryTallyRe
 	return &foundation.QueryTallyResultResponse{Tally: tally}, nil
 }
 
+func (s queryServer) VoteDelegation(c context.Context, req *foundation.QueryVoteDelegationRequest) (*foundation.QueryVoteDelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	delegator, err := sdk.AccAddressFromBech32(req.Delegator)
+	if err != nil {
+		return nil, err
+	}
+	delegation, err := s.keeper.GetVoteDelegation(ctx, delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &foundation.QueryVoteDelegationResponse{Delegation: delegation}, nil
+}
+
+func (s queryServer) VoteDelegations(c context.Context, req *foundation.QueryVoteDelegationsRequest) (*foundation.QueryVoteDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var delegations []foundation.VoteDelegation
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	delegationStore := prefix.NewStore(store, voteDelegationKeyPrefix)
+	pageRes, err := query.FilteredPaginate(delegationStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var delegation foundation.VoteDelegation
+		s.keeper.cdc.MustUnmarshal(value, &delegation)
+		if !delegation.IsActive(ctx.BlockTime()) {
+			return false, nil
+		}
+
+		if accumulate {
+			delegations = append(delegations, delegation)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &foundation.QueryVoteDelegationsResponse{Delegations: delegations, Pagination: pageRes}, nil
+}
+
 func (s queryServer) Censorships(c context.Context, req *foundation.QueryCensorshipsRequest) (*foundation.QueryCensorshipsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -31,9 +31,15 @@ func ModuleAccountInvariant(k Keeper) sdk.Invariant {
 		treasuryAcc := k.authKeeper.GetModuleAccount(ctx, foundation.TreasuryName)
 		balance := k.bankKeeper.GetAllBalances(ctx, treasuryAcc.GetAddress())
 
-		treasury := k.GetTreasury(ctx)
-		msg := fmt.Sprintf("coins in the treasury; expected %s, got %s\n", treasury, balance)
-		broken := !treasury.IsEqual(sdk.NewDecCoinsFromCoins(balance...))
+		// the remainders of the streams are reserved in the treasury account
+		expected := k.GetTreasury(ctx)
+		k.iterateStreams(ctx, func(stream foundation.TreasuryStream) (stop bool) {
+			expected = expected.Add(sdk.NewDecCoinsFromCoins(stream.Remainder()...)...)
+			return false
+		})
+
+		msg := fmt.Sprintf("coins in the treasury; expected %s, got %s\n", expected, balance)
+		broken := !expected.IsEqual(sdk.NewDecCoinsFromCoins(balance...))
 
 		return sdk.FormatInvariant(foundation.ModuleName, moduleAccountInvariant, msg), broken
 	}
@@ -45,7 +51,11 @@ func TotalWeightInvariant(k Keeper) sdk.Invariant {
 		ctx, _ = ctx.CacheContext()
 
 		expected := k.GetFoundationInfo(ctx).TotalWeight
-		real := sdk.NewDec(int64(len(k.GetMembers(ctx))))
+		real := sdk.ZeroDec()
+		k.iterateMembers(ctx, func(member foundation.Member) (stop bool) {
+			real = real.Add(member.Weight)
+			return false
+		})
 
 		msg := fmt.Sprintf("total weight of foundation; expected %s, got %s\n", expected, real)
 		broken := !real.Equal(expected)
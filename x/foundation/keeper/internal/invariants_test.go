@@ -1,6 +1,8 @@
 package internal_test
 
 import (
+	"time"
+
 	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/x/foundation"
 	"github.com/Finschia/finschia-sdk/x/foundation/keeper/internal"
@@ -14,6 +16,15 @@ func (s *KeeperTestSuite) TestModuleAccountInvariant() {
 		"invariant not broken": {
 			valid: true,
 		},
+		"treasury reserved for a stream": {
+			malleate: func(ctx sdk.Context) {
+				amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, s.balance))
+				startTime := ctx.BlockTime()
+				_, err := s.impl.CreateTreasuryStream(ctx, s.stranger, amount, startTime, startTime.Add(time.Hour), startTime)
+				s.Require().NoError(err)
+			},
+			valid: true,
+		},
 		"treasury differs from the balance": {
 			malleate: func(ctx sdk.Context) {
 				balance := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, s.balance.Add(sdk.OneInt())))
@@ -46,7 +57,7 @@ func (s *KeeperTestSuite) TestTotalWeightInvariant() {
 		"invariant not broken": {
 			valid: true,
 		},
-		"total weight differs from the number of foundation members": {
+		"total weight differs from the sum of the member weights": {
 			malleate: func(ctx sdk.Context) {
 				info := s.impl.GetFoundationInfo(ctx)
 				numMembers := len(s.impl.GetMembers(ctx))
@@ -54,6 +65,14 @@ func (s *KeeperTestSuite) TestTotalWeightInvariant() {
 				s.impl.SetFoundationInfo(ctx, info)
 			},
 		},
+		"member weight changed": {
+			malleate: func(ctx sdk.Context) {
+				member, err := s.impl.GetMember(ctx, s.members[0])
+				s.Require().NoError(err)
+				member.Weight = member.Weight.Add(sdk.OneDec())
+				s.impl.SetMember(ctx, *member)
+			},
+		},
 	}
 
 	for name, tc := range testCases {
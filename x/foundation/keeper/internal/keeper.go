@@ -60,6 +60,10 @@ func NewKeeper(
 		panic("x/foundation authority must be the module account")
 	}
 
+	if err := config.ValidateBasic(); err != nil {
+		panic(err)
+	}
+
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(foundation.ParamKeyTable())
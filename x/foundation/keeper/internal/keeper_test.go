@@ -126,6 +126,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	for i := range s.members {
 		member := foundation.Member{
 			Address: s.members[i].String(),
+			Weight:  sdk.OneDec(),
 		}
 		s.impl.SetMember(s.ctx, member)
 	}
@@ -262,8 +263,9 @@ func TestNewKeeper(t *testing.T) {
 	authority := foundation.DefaultAuthority()
 
 	testCases := map[string]struct {
-		authority sdk.AccAddress
-		panics    bool
+		authority     sdk.AccAddress
+		noRetryPeriod bool
+		panics        bool
 	}{
 		"default authority": {
 			authority: authority,
@@ -275,14 +277,23 @@ func TestNewKeeper(t *testing.T) {
 			authority: createAddress(),
 			panics:    true,
 		},
+		"no retry period": {
+			authority:     authority,
+			noRetryPeriod: true,
+			panics:        true,
+		},
 	}
 
 	for name, tc := range testCases {
 		tc := tc
 		t.Run(name, func(t *testing.T) {
+			config := foundation.DefaultConfig()
+			if tc.noRetryPeriod {
+				config.MaxRetryPeriod = 0
+			}
 			newKeeper := func() keeper.Keeper {
 				app := simapp.Setup(false)
-				return keeper.NewKeeper(app.AppCodec(), sdk.NewKVStoreKey(foundation.StoreKey), app.MsgServiceRouter(), app.AccountKeeper, app.BankKeeper, authtypes.FeeCollectorName, foundation.DefaultConfig(), tc.authority.String(), app.GetSubspace(foundation.ModuleName))
+				return keeper.NewKeeper(app.AppCodec(), sdk.NewKVStoreKey(foundation.StoreKey), app.MsgServiceRouter(), app.AccountKeeper, app.BankKeeper, authtypes.FeeCollectorName, config, tc.authority.String(), app.GetSubspace(foundation.ModuleName))
 			}
 
 			if tc.panics {
@@ -19,11 +19,16 @@ var (
 	proposalKeyPrefix        = []byte{0x12}
 	proposalByVPEndKeyPrefix = []byte{0x13}
 	voteKeyPrefix            = []byte{0x14}
+	voteDelegationKeyPrefix  = []byte{0x15}
+
+	proposalByRetryDeadlineKeyPrefix = []byte{0x16}
 
 	censorshipKeyPrefix = []byte{0x20}
 	grantKeyPrefix      = []byte{0x21}
 
-	poolKey = []byte{0x30}
+	poolKey             = []byte{0x30}
+	previousStreamIDKey = []byte{0x31}
+	streamKeyPrefix     = []byte{0x32}
 
 	// deprecatedGovMintKey Deprecated. Don't use it again.
 	deprecatedGovMintKey = []byte{0x40}
@@ -68,6 +73,17 @@ func proposalKey(id uint64) []byte {
 	return key
 }
 
+// voteDelegationKey key for the vote delegation of a specific member from the store
+func voteDelegationKey(delegator sdk.AccAddress) []byte {
+	prefix := voteDelegationKeyPrefix
+	key := make([]byte, len(prefix)+len(delegator))
+
+	copy(key, prefix)
+	copy(key[len(prefix):], delegator)
+
+	return key
+}
+
 func voteKey(proposalID uint64, voter sdk.AccAddress) []byte {
 	prefix := voteKeyPrefix
 	idBz := Uint64ToBytes(proposalID)
@@ -118,6 +134,51 @@ func splitProposalByVPEndKey(key []byte) (vpEnd time.Time, id uint64) {
 	return
 }
 
+func proposalByRetryDeadlineKey(deadline time.Time, id uint64) []byte {
+	prefix := proposalByRetryDeadlineKeyPrefix
+	deadlineBz := sdk.FormatTimeBytes(deadline)
+	idBz := Uint64ToBytes(id)
+	key := make([]byte, len(prefix)+lenTime+len(idBz))
+
+	begin := 0
+	copy(key[begin:], prefix)
+
+	begin += len(prefix)
+	copy(key[begin:], deadlineBz)
+
+	begin += len(deadlineBz)
+	copy(key[begin:], idBz)
+
+	return key
+}
+
+func splitProposalByRetryDeadlineKey(key []byte) (deadline time.Time, id uint64) {
+	prefix := proposalByRetryDeadlineKeyPrefix
+	begin := len(prefix)
+	end := begin + lenTime
+	deadline, err := sdk.ParseTimeBytes(key[begin:end])
+	if err != nil {
+		panic(err)
+	}
+
+	begin = end
+	id = Uint64FromBytes(key[begin:])
+
+	return
+}
+
+// streamKey key for a specific treasury stream from the store
+func streamKey(id uint64) []byte {
+	prefix := streamKeyPrefix
+	idBz := Uint64ToBytes(id)
+	key := make([]byte, len(prefix)+len(idBz))
+
+	copy(key, prefix)
+	copy(key[len(prefix):], idBz)
+
+	return key
+}
+
 // memberKey key for a specific member from the store
 func censorshipKey(url string) []byte {
 	prefix := censorshipKeyPrefix
@@ -55,32 +55,36 @@ func (k Keeper) SetFoundationInfo(ctx sdk.Context, info foundation.FoundationInf
 func (k Keeper) UpdateMembers(ctx sdk.Context, members []foundation.MemberRequest) error {
 	weightUpdate := sdk.ZeroDec()
 	for _, request := range members {
-		new := foundation.Member{
-			Address:  request.Address,
-			Metadata: request.Metadata,
-			AddedAt:  ctx.BlockTime(),
-		}
-		if err := new.ValidateBasic(); err != nil {
+		if err := request.ValidateBasic(); err != nil {
 			panic(err)
 		}
-		if err := validateMetadata(new.Metadata, k.config); err != nil {
+		if err := validateMetadata(request.Metadata, k.config); err != nil {
 			return err
 		}
 
-		addr := sdk.MustAccAddressFromBech32(new.Address)
+		addr := sdk.MustAccAddressFromBech32(request.Address)
 		old, err := k.GetMember(ctx, addr)
 		if err != nil && request.Remove { // the member must exist
 			return err
 		}
+
+		addedAt := ctx.BlockTime()
 		if err == nil { // overwrite
-			weightUpdate = weightUpdate.Sub(sdk.OneDec())
-			new.AddedAt = old.AddedAt
+			weightUpdate = weightUpdate.Sub(old.Weight)
+			addedAt = old.AddedAt
 		}
 
 		if request.Remove {
 			k.deleteMember(ctx, addr)
+			k.pruneVoteDelegations(ctx, addr)
 		} else {
-			weightUpdate = weightUpdate.Add(sdk.OneDec())
+			new := foundation.Member{
+				Address:  request.Address,
+				Metadata: request.Metadata,
+				AddedAt:  addedAt,
+				Weight:   request.GetWeight(),
+			}
+			weightUpdate = weightUpdate.Add(new.Weight)
 			k.SetMember(ctx, new)
 		}
 	}
@@ -49,16 +49,48 @@ func (s *KeeperTestSuite) TestUpdateDecisionPolicy() {
 
 func (s *KeeperTestSuite) TestUpdateMembers() {
 	testCases := map[string]struct {
-		updates []foundation.MemberRequest
-		valid   bool
+		updates     []foundation.MemberRequest
+		valid       bool
+		totalWeight sdk.Dec
 	}{
 		"add a new member": {
 			updates: []foundation.MemberRequest{
 				{
 					Address: s.stranger.String(),
+					Weight:  sdk.OneDec(),
 				},
 			},
-			valid: true,
+			valid:       true,
+			totalWeight: sdk.NewDec(int64(len(s.members) + 1)),
+		},
+		"add a new member without weight": {
+			updates: []foundation.MemberRequest{
+				{
+					Address: s.stranger.String(),
+				},
+			},
+			valid:       true,
+			totalWeight: sdk.NewDec(int64(len(s.members) + 1)),
+		},
+		"add a new member with weight": {
+			updates: []foundation.MemberRequest{
+				{
+					Address: s.stranger.String(),
+					Weight:  sdk.NewDec(3),
+				},
+			},
+			valid:       true,
+			totalWeight: sdk.NewDec(int64(len(s.members) + 3)),
+		},
+		"update the weight of a member": {
+			updates: []foundation.MemberRequest{
+				{
+					Address: s.members[0].String(),
+					Weight:  sdk.MustNewDecFromStr("0.5"),
+				},
+			},
+			valid:       true,
+			totalWeight: sdk.NewDec(int64(len(s.members))).Sub(sdk.MustNewDecFromStr("0.5")),
 		},
 		"remove a member": {
 			updates: []foundation.MemberRequest{
@@ -67,7 +99,8 @@ func (s *KeeperTestSuite) TestUpdateMembers() {
 					Remove:  true,
 				},
 			},
-			valid: true,
+			valid:       true,
+			totalWeight: sdk.NewDec(int64(len(s.members) - 1)),
 		},
 		"remove a non-member": {
 			updates: []foundation.MemberRequest{
@@ -81,6 +114,7 @@ func (s *KeeperTestSuite) TestUpdateMembers() {
 			updates: []foundation.MemberRequest{
 				{
 					Address:  s.stranger.String(),
+					Weight:   sdk.OneDec(),
 					Metadata: string(make([]rune, 256)),
 				},
 			},
@@ -92,11 +126,14 @@ func (s *KeeperTestSuite) TestUpdateMembers() {
 			ctx, _ := s.ctx.CacheContext()
 
 			err := s.impl.UpdateMembers(ctx, tc.updates)
-			if tc.valid {
-				s.Require().NoError(err)
-			} else {
+			if !tc.valid {
 				s.Require().Error(err)
+				return
 			}
+			s.Require().NoError(err)
+
+			info := s.impl.GetFoundationInfo(ctx)
+			s.Require().Equal(tc.totalWeight, info.TotalWeight)
 		})
 	}
 }
@@ -0,0 +1,7 @@
+package v3
+
+var (
+	foundationInfoKey = []byte{0x01}
+
+	memberKeyPrefix = []byte{0x10}
+)
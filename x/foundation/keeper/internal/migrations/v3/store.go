@@ -0,0 +1,81 @@
+package v3
+
+import (
+	"github.com/Finschia/finschia-sdk/codec"
+	storetypes "github.com/Finschia/finschia-sdk/store/types"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+)
+
+// MigrateStore performs in-place store migrations from v2 to v3.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	// migrate members
+	totalWeight, err := migrateMembers(store, cdc)
+	if err != nil {
+		return err
+	}
+
+	// migrate foundation info
+	if err := migrateFoundationInfo(store, cdc, totalWeight); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// migrateMembers gives the existing members the weight of one.
+func migrateMembers(store storetypes.KVStore, cdc codec.BinaryCodec) (sdk.Dec, error) {
+	totalWeight := sdk.ZeroDec()
+
+	iterator := sdk.KVStorePrefixIterator(store, memberKeyPrefix)
+	defer iterator.Close()
+
+	var members []foundation.Member
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var member foundation.Member
+		if err := cdc.Unmarshal(iterator.Value(), &member); err != nil {
+			return sdk.Dec{}, err
+		}
+
+		members = append(members, member)
+		keys = append(keys, iterator.Key())
+	}
+
+	for i, member := range members {
+		member.Weight = sdk.OneDec()
+		bz, err := cdc.Marshal(&member)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		store.Set(keys[i], bz)
+
+		totalWeight = totalWeight.Add(member.Weight)
+	}
+
+	return totalWeight, nil
+}
+
+func migrateFoundationInfo(store storetypes.KVStore, cdc codec.BinaryCodec, totalWeight sdk.Dec) error {
+	bz := store.Get(foundationInfoKey)
+	if bz == nil {
+		return sdkerrors.ErrNotFound.Wrap("foundation info not found")
+	}
+
+	var info foundation.FoundationInfo
+	if err := cdc.Unmarshal(bz, &info); err != nil {
+		return err
+	}
+
+	info.TotalWeight = totalWeight
+	bz, err := cdc.Marshal(&info)
+	if err != nil {
+		return err
+	}
+	store.Set(foundationInfoKey, bz)
+
+	return nil
+}
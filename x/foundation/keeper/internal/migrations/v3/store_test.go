@@ -0,0 +1,95 @@
+package v3_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/crypto/keys/secp256k1"
+	simappparams "github.com/Finschia/finschia-sdk/simapp/params"
+	"github.com/Finschia/finschia-sdk/testutil"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+
+	"github.com/Finschia/finschia-sdk/x/foundation/keeper/internal/migrations/v3"
+)
+
+func TestMigrateStore(t *testing.T) {
+	foundationKey := sdk.NewKVStoreKey(foundation.StoreKey)
+	newKey := sdk.NewTransientStoreKey("transient_test")
+	encCfg := simappparams.MakeTestEncodingConfig()
+	foundation.RegisterInterfaces(encCfg.InterfaceRegistry)
+	ctx := testutil.DefaultContext(foundationKey, newKey)
+
+	addrs := make([]sdk.AccAddress, 3)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	for name, tc := range map[string]struct {
+		malleate func(ctx sdk.Context)
+		valid    bool
+		members  int
+	}{
+		"valid": {
+			malleate: func(ctx sdk.Context) {
+				store := ctx.KVStore(foundationKey)
+
+				// set old members which have no weight
+				for _, addr := range addrs {
+					bz := encCfg.Marshaler.MustMarshal(&foundation.Member{
+						Address: addr.String(),
+					})
+					store.Set(append([]byte{0x10}, addr...), bz)
+				}
+
+				info := foundation.FoundationInfo{
+					Version:     1,
+					TotalWeight: sdk.NewDec(int64(len(addrs))),
+				}.WithDecisionPolicy(&foundation.ThresholdDecisionPolicy{
+					Threshold: sdk.OneDec(),
+					Windows: &foundation.DecisionPolicyWindows{
+						VotingPeriod: time.Hour,
+					},
+				})
+				store.Set([]byte{0x01}, encCfg.Marshaler.MustMarshal(info))
+			},
+			valid:   true,
+			members: len(addrs),
+		},
+		"no foundation info found": {},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			if tc.malleate != nil {
+				tc.malleate(ctx)
+			}
+
+			// migrate
+			err := v3.MigrateStore(ctx, foundationKey, encCfg.Marshaler)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			store := ctx.KVStore(foundationKey)
+			members := foundation.Members{}
+			iterator := sdk.KVStorePrefixIterator(store, []byte{0x10})
+			defer iterator.Close()
+			for ; iterator.Valid(); iterator.Next() {
+				var member foundation.Member
+				encCfg.Marshaler.MustUnmarshal(iterator.Value(), &member)
+				require.Equal(t, sdk.OneDec(), member.Weight)
+				members.Members = append(members.Members, member)
+			}
+			require.Len(t, members.Members, tc.members)
+			require.NoError(t, members.ValidateBasic())
+
+			var info foundation.FoundationInfo
+			encCfg.Marshaler.MustUnmarshal(store.Get([]byte{0x01}), &info)
+			require.Equal(t, members.TotalWeight(), info.TotalWeight)
+		})
+	}
+}
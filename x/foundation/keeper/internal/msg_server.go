@@ -69,6 +69,67 @@ func (s msgServer) WithdrawFromTreasury(c context.Context, req *foundation.MsgWi
 	return &foundation.MsgWithdrawFromTreasuryResponse{}, nil
 }
 
+// CreateTreasuryStream defines a method to create a stream paying out coins
+// from the treasury over time.
+func (s msgServer) CreateTreasuryStream(c context.Context, req *foundation.MsgCreateTreasuryStream) (*foundation.MsgCreateTreasuryStreamResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := s.keeper.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	// a stream is a withdrawal from the treasury over time
+	recipient := sdk.MustAccAddressFromBech32(req.Recipient)
+	if err := s.keeper.Accept(ctx, recipient, &foundation.MsgWithdrawFromTreasury{
+		Authority: req.Authority,
+		To:        req.Recipient,
+		Amount:    req.Amount,
+	}); err != nil {
+		return nil, err
+	}
+
+	id, err := s.keeper.CreateTreasuryStream(ctx, recipient, req.Amount, req.StartTime, req.EndTime, req.CliffTime)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := s.keeper.GetTreasuryStream(ctx, *id)
+	if err != nil {
+		panic(err)
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&foundation.EventCreateTreasuryStream{
+		Stream: *stream,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &foundation.MsgCreateTreasuryStreamResponse{StreamId: *id}, nil
+}
+
+// CancelTreasuryStream defines a method to cancel a treasury stream,
+// returning the remainder to the treasury.
+func (s msgServer) CancelTreasuryStream(c context.Context, req *foundation.MsgCancelTreasuryStream) (*foundation.MsgCancelTreasuryStreamResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := s.keeper.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	remainder, err := s.keeper.CancelTreasuryStream(ctx, req.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&foundation.EventCancelTreasuryStream{
+		StreamId:  req.StreamId,
+		Remainder: remainder,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &foundation.MsgCancelTreasuryStreamResponse{}, nil
+}
+
 func (s msgServer) UpdateMembers(c context.Context, req *foundation.MsgUpdateMembers) (*foundation.MsgUpdateMembersResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -255,6 +316,49 @@ func (s msgServer) LeaveFoundation(c context.Context, req *foundation.MsgLeaveFo
 	return &foundation.MsgLeaveFoundationResponse{}, nil
 }
 
+func (s msgServer) DelegateVote(c context.Context, req *foundation.MsgDelegateVote) (*foundation.MsgDelegateVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := s.keeper.validateMembers(ctx, []string{req.Delegator, req.Delegate}); err != nil {
+		return nil, err
+	}
+
+	delegation := foundation.VoteDelegation{
+		Delegator: req.Delegator,
+		Delegate:  req.Delegate,
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+	}
+	if err := s.keeper.DelegateVote(ctx, delegation); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&foundation.EventDelegateVote{
+		Delegation: delegation,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &foundation.MsgDelegateVoteResponse{}, nil
+}
+
+func (s msgServer) RevokeVoteDelegation(c context.Context, req *foundation.MsgRevokeVoteDelegation) (*foundation.MsgRevokeVoteDelegationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	delegator := sdk.MustAccAddressFromBech32(req.Delegator)
+	if err := s.keeper.RevokeVoteDelegation(ctx, delegator); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&foundation.EventRevokeVoteDelegation{
+		Delegator: req.Delegator,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &foundation.MsgRevokeVoteDelegationResponse{}, nil
+}
+
 func (s msgServer) UpdateCensorship(c context.Context, req *foundation.MsgUpdateCensorship) (*foundation.MsgUpdateCensorshipResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
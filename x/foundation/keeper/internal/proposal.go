@@ -89,11 +89,15 @@ func (k Keeper) WithdrawProposal(ctx sdk.Context, proposalID uint64) error {
 func (k Keeper) pruneProposal(ctx sdk.Context, proposal foundation.Proposal) {
 	k.pruneVotes(ctx, proposal.Id)
 	k.removeProposalFromVPEndQueue(ctx, proposal)
+	if proposal.RetryDeadline != nil {
+		k.removeProposalFromRetryQueue(ctx, proposal)
+	}
 	k.deleteProposal(ctx, proposal.Id)
 }
 
 // PruneExpiredProposals prunes all proposals which are expired,
 // i.e. whose `submit_time + voting_period + max_execution_period` is smaller than (or equal to) now.
+// The failed proposals are in the retry queue instead, and are pruned once their retry deadline has passed.
 func (k Keeper) PruneExpiredProposals(ctx sdk.Context) {
 	votingPeriodEnd := ctx.BlockTime().Add(-k.config.MaxExecutionPeriod).Add(time.Nanosecond)
 
@@ -103,6 +107,11 @@ func (k Keeper) PruneExpiredProposals(ctx sdk.Context) {
 		return false
 	})
 
+	k.iterateProposalsByRetryDeadline(ctx, ctx.BlockTime().Add(-time.Nanosecond), func(proposal foundation.Proposal) (stop bool) {
+		proposals = append(proposals, proposal)
+		return false
+	})
+
 	for _, proposal := range proposals {
 		k.pruneProposal(ctx, proposal)
 	}
@@ -172,6 +181,25 @@ func (k Keeper) iterateProposalsByVPEnd(ctx sdk.Context, endTime time.Time, fn f
 	}
 }
 
+func (k Keeper) iterateProposalsByRetryDeadline(ctx sdk.Context, deadline time.Time, fn func(proposal foundation.Proposal) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(proposalByRetryDeadlineKeyPrefix, sdk.PrefixEndBytes(append(proposalByRetryDeadlineKeyPrefix, sdk.FormatTimeBytes(deadline)...)))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		_, id := splitProposalByRetryDeadlineKey(iter.Key())
+
+		proposal, err := k.GetProposal(ctx, id)
+		if err != nil {
+			panic(err)
+		}
+
+		if fn(*proposal) {
+			break
+		}
+	}
+}
+
 func (k Keeper) UpdateTallyOfVPEndProposals(ctx sdk.Context) {
 	var proposals []foundation.Proposal
 	k.iterateProposalsByVPEnd(ctx, ctx.BlockTime(), func(proposal foundation.Proposal) (stop bool) {
@@ -234,6 +262,21 @@ func (k Keeper) removeProposalFromVPEndQueue(ctx sdk.Context, proposal foundatio
 	store.Delete(key)
 }
 
+// addProposalToRetryQueue moves a failed proposal from the queue by voting period end into the queue by retry deadline.
+func (k Keeper) addProposalToRetryQueue(ctx sdk.Context, proposal foundation.Proposal) {
+	k.removeProposalFromVPEndQueue(ctx, proposal)
+
+	store := ctx.KVStore(k.storeKey)
+	key := proposalByRetryDeadlineKey(*proposal.RetryDeadline, proposal.Id)
+	store.Set(key, []byte{})
+}
+
+func (k Keeper) removeProposalFromRetryQueue(ctx sdk.Context, proposal foundation.Proposal) {
+	store := ctx.KVStore(k.storeKey)
+	key := proposalByRetryDeadlineKey(*proposal.RetryDeadline, proposal.Id)
+	store.Delete(key)
+}
+
 func validateActorForProposal(address string, proposal foundation.Proposal) error {
 	for _, proposer := range proposal.Proposers {
 		if address == proposer {
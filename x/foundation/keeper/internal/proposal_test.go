@@ -113,6 +113,7 @@ func TestAbortProposal(t *testing.T) {
 	}
 	impl.SetMember(ctx, foundation.Member{
 		Address: members[0].String(),
+		Weight:  sdk.OneDec(),
 	})
 
 	info := foundation.DefaultFoundation()
@@ -129,6 +130,7 @@ func TestAbortProposal(t *testing.T) {
 		err = impl.UpdateMembers(ctx, []foundation.MemberRequest{
 			{
 				Address: newMember.String(),
+				Weight:  sdk.OneDec(),
 			},
 		})
 		require.NoError(t, err)
@@ -51,11 +51,13 @@ func (k Keeper) tally(ctx sdk.Context, p foundation.Proposal) (foundation.TallyR
 	}
 
 	tallyResult := foundation.DefaultTallyResult()
+	// a delegator is counted at most once, even if recorded in the votes of several delegates
+	counted := map[string]bool{}
 	var errIter error
 	k.iterateVotes(ctx, p.Id, func(vote foundation.Vote) (stop bool) {
 		voter := sdk.MustAccAddressFromBech32(vote.Voter)
 
-		_, err := k.GetMember(ctx, voter)
+		member, err := k.GetMember(ctx, voter)
 		switch {
 		case sdkerrors.ErrNotFound.Is(err):
 			// If the member left the foundation after voting, then we simply skip the
@@ -67,10 +69,34 @@ func (k Keeper) tally(ctx sdk.Context, p foundation.Proposal) (foundation.TallyR
 			return true
 		}
 
-		if err := tallyResult.Add(vote.Option); err != nil {
+		if err := tallyResult.Add(vote.Option, member.Weight); err != nil {
 			panic(err)
 		}
 
+		// count the weights delegated to the voter
+		for _, delegator := range vote.Delegators {
+			delegatorAddr := sdk.MustAccAddressFromBech32(delegator)
+
+			// the own vote of the delegator takes precedence
+			if k.hasVote(ctx, p.Id, delegatorAddr) || counted[delegator] {
+				continue
+			}
+			counted[delegator] = true
+
+			delegatorMember, err := k.GetMember(ctx, delegatorAddr)
+			switch {
+			case sdkerrors.ErrNotFound.Is(err):
+				continue
+			case err != nil:
+				errIter = err
+				return true
+			}
+
+			if err := tallyResult.Add(vote.Option, delegatorMember.Weight); err != nil {
+				panic(err)
+			}
+		}
+
 		return false
 	})
 
@@ -1,6 +1,8 @@
 package internal
 
 import (
+	"time"
+
 	sdk "github.com/Finschia/finschia-sdk/types"
 	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 	"github.com/Finschia/finschia-sdk/x/foundation"
@@ -65,3 +67,157 @@ func (k Keeper) SetPool(ctx sdk.Context, pool foundation.Pool) {
 	key := poolKey
 	store.Set(key, bz)
 }
+
+func (k Keeper) newStreamID(ctx sdk.Context) uint64 {
+	id := k.getPreviousStreamID(ctx) + 1
+	k.setPreviousStreamID(ctx, id)
+
+	return id
+}
+
+func (k Keeper) getPreviousStreamID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(previousStreamIDKey)
+	if len(bz) == 0 {
+		return 0
+	}
+	return Uint64FromBytes(bz)
+}
+
+func (k Keeper) setPreviousStreamID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(previousStreamIDKey, Uint64ToBytes(id))
+}
+
+// CreateTreasuryStream reserves the amount of the stream from the treasury,
+// which would be paid out to the recipient over time.
+func (k Keeper) CreateTreasuryStream(ctx sdk.Context, recipient sdk.AccAddress, amt sdk.Coins, startTime, endTime, cliffTime time.Time) (*uint64, error) {
+	pool := k.GetPool(ctx)
+	remains, hasNeg := pool.Treasury.SafeSub(sdk.NewDecCoinsFromCoins(amt...))
+	if hasNeg {
+		return nil, sdkerrors.ErrInsufficientFunds.Wrapf("not enough coins in treasury, %s", pool.Treasury)
+	}
+	pool.Treasury = remains
+	k.SetPool(ctx, pool)
+
+	id := k.newStreamID(ctx)
+	stream := foundation.TreasuryStream{
+		Id:        id,
+		Recipient: recipient.String(),
+		Amount:    amt,
+		StartTime: startTime,
+		EndTime:   endTime,
+		CliffTime: cliffTime,
+	}
+	k.setStream(ctx, stream)
+
+	return &id, nil
+}
+
+// CancelTreasuryStream deletes the stream, returning the remainder to the
+// treasury.
+func (k Keeper) CancelTreasuryStream(ctx sdk.Context, id uint64) (sdk.Coins, error) {
+	stream, err := k.GetTreasuryStream(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	remainder := stream.Remainder()
+	pool := k.GetPool(ctx)
+	pool.Treasury = pool.Treasury.Add(sdk.NewDecCoinsFromCoins(remainder...)...)
+	k.SetPool(ctx, pool)
+
+	k.deleteStream(ctx, id)
+
+	return remainder, nil
+}
+
+// PayTreasuryStreams pays out the accrual of the streams since the last
+// payout. The streams fully paid out are deleted from the state.
+func (k Keeper) PayTreasuryStreams(ctx sdk.Context) {
+	now := ctx.BlockTime()
+	for _, stream := range k.GetTreasuryStreams(ctx) {
+		due, hasNeg := stream.Accrued(now).SafeSub(stream.Paid)
+		if hasNeg || due.IsZero() {
+			continue
+		}
+
+		// the recipient may not be able to receive the coins for now,
+		// so retry it on the next block.
+		cacheCtx, flush := ctx.CacheContext()
+		recipient := sdk.MustAccAddressFromBech32(stream.Recipient)
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, foundation.TreasuryName, recipient, due); err != nil {
+			k.Logger(ctx).Error("failed to pay out treasury stream", "stream", stream.Id, "error", err)
+			continue
+		}
+		flush()
+
+		stream.Paid = stream.Paid.Add(due...)
+		if stream.Remainder().IsZero() {
+			k.deleteStream(ctx, stream.Id)
+		} else {
+			k.setStream(ctx, stream)
+		}
+
+		if err := ctx.EventManager().EmitTypedEvent(&foundation.EventPayTreasuryStream{
+			StreamId:  stream.Id,
+			Recipient: stream.Recipient,
+			Amount:    due,
+		}); err != nil {
+			panic(err)
+		}
+	}
+}
+
+func (k Keeper) GetTreasuryStream(ctx sdk.Context, id uint64) (*foundation.TreasuryStream, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := streamKey(id)
+	bz := store.Get(key)
+	if bz == nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("No stream for id: %d", id)
+	}
+
+	var stream foundation.TreasuryStream
+	k.cdc.MustUnmarshal(bz, &stream)
+
+	return &stream, nil
+}
+
+func (k Keeper) setStream(ctx sdk.Context, stream foundation.TreasuryStream) {
+	store := ctx.KVStore(k.storeKey)
+	key := streamKey(stream.Id)
+
+	bz := k.cdc.MustMarshal(&stream)
+	store.Set(key, bz)
+}
+
+func (k Keeper) deleteStream(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	key := streamKey(id)
+	store.Delete(key)
+}
+
+func (k Keeper) iterateStreams(ctx sdk.Context, fn func(stream foundation.TreasuryStream) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, streamKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var stream foundation.TreasuryStream
+		k.cdc.MustUnmarshal(iterator.Value(), &stream)
+
+		if stop := fn(stream); stop {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetTreasuryStreams(ctx sdk.Context) []foundation.TreasuryStream {
+	var streams []foundation.TreasuryStream
+	k.iterateStreams(ctx, func(stream foundation.TreasuryStream) (stop bool) {
+		streams = append(streams, stream)
+		return false
+	})
+
+	return streams
+}
@@ -1,6 +1,8 @@
 package internal_test
 
 import (
+	"time"
+
 	sdk "github.com/Finschia/finschia-sdk/types"
 	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
 	"github.com/Finschia/finschia-sdk/x/foundation"
@@ -148,3 +150,157 @@ func (s *KeeperTestSuite) TestWithdrawFromTreasury() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestCreateTreasuryStream() {
+	testCases := map[string]struct {
+		amount sdk.Int
+		valid  bool
+	}{
+		"valid amount": {
+			amount: s.balance,
+			valid:  true,
+		},
+		"insufficient coins": {
+			amount: s.balance.Add(sdk.OneInt()),
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			before := s.impl.GetTreasury(ctx)
+
+			amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, tc.amount))
+			startTime := ctx.BlockTime()
+			id, err := s.impl.CreateTreasuryStream(ctx, s.stranger, amount, startTime, startTime.Add(time.Hour), startTime)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(id)
+
+			stream, err := s.impl.GetTreasuryStream(ctx, *id)
+			s.Require().NoError(err)
+			s.Require().Equal(s.stranger.String(), stream.Recipient)
+			s.Require().Equal(amount, stream.Amount)
+			s.Require().True(stream.Paid.IsZero())
+
+			// the amount is reserved, but not sent yet
+			expectedAfter := before.Sub(sdk.NewDecCoinsFromCoins(amount...))
+			poolAfter := s.impl.GetTreasury(ctx)
+			s.Require().Equal(sdk.NewDecCoins(expectedAfter...), sdk.NewDecCoins(poolAfter...))
+			balanceAfter := sdk.NewDecCoinsFromCoins(s.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(foundation.TreasuryName))...)
+			s.Require().Equal(sdk.NewDecCoins(before...), sdk.NewDecCoins(balanceAfter...))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCancelTreasuryStream() {
+	ctx, _ := s.ctx.CacheContext()
+
+	amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)))
+	startTime := ctx.BlockTime()
+	id, err := s.impl.CreateTreasuryStream(ctx, s.stranger, amount, startTime, startTime.Add(1000*time.Second), startTime)
+	s.Require().NoError(err)
+
+	// pay out the first half
+	ctx = ctx.WithBlockTime(startTime.Add(500 * time.Second))
+	s.impl.PayTreasuryStreams(ctx)
+
+	testCases := map[string]struct {
+		id        uint64
+		valid     bool
+		remainder sdk.Coins
+	}{
+		"valid request": {
+			id:        *id,
+			valid:     true,
+			remainder: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(500))),
+		},
+		"stream not found": {
+			id: *id + 1,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := ctx.CacheContext()
+
+			before := s.impl.GetTreasury(ctx)
+
+			remainder, err := s.impl.CancelTreasuryStream(ctx, tc.id)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.remainder, remainder)
+
+			_, err = s.impl.GetTreasuryStream(ctx, tc.id)
+			s.Require().Error(err)
+
+			expectedAfter := before.Add(sdk.NewDecCoinsFromCoins(remainder...)...)
+			poolAfter := s.impl.GetTreasury(ctx)
+			s.Require().Equal(sdk.NewDecCoins(expectedAfter...), sdk.NewDecCoins(poolAfter...))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestPayTreasuryStreams() {
+	amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)))
+	startTime := s.ctx.BlockTime()
+	endTime := startTime.Add(1000 * time.Second)
+	cliffTime := startTime.Add(100 * time.Second)
+
+	testCases := map[string]struct {
+		elapsed  time.Duration
+		paid     sdk.Int
+		finished bool
+	}{
+		"before cliff": {
+			elapsed: 99 * time.Second,
+			paid:    sdk.ZeroInt(),
+		},
+		"after cliff": {
+			elapsed: 300 * time.Second,
+			paid:    sdk.NewInt(300),
+		},
+		"after end": {
+			elapsed:  1001 * time.Second,
+			paid:     sdk.NewInt(1000),
+			finished: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			id, err := s.impl.CreateTreasuryStream(ctx, s.stranger, amount, startTime, endTime, cliffTime)
+			s.Require().NoError(err)
+
+			balanceBefore := s.bankKeeper.GetAllBalances(ctx, s.stranger)
+
+			ctx = ctx.WithBlockTime(startTime.Add(tc.elapsed))
+			s.impl.PayTreasuryStreams(ctx)
+
+			paid := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, tc.paid))
+			balanceAfter := s.bankKeeper.GetAllBalances(ctx, s.stranger)
+			s.Require().Equal(balanceBefore.Add(paid...), balanceAfter)
+
+			stream, err := s.impl.GetTreasuryStream(ctx, *id)
+			if tc.finished {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().True(paid.IsEqual(stream.Paid))
+
+			// paying out again in the same block must be no-op
+			s.impl.PayTreasuryStreams(ctx)
+			s.Require().Equal(balanceAfter, s.bankKeeper.GetAllBalances(ctx, s.stranger))
+		})
+	}
+}
@@ -30,6 +30,7 @@ func (k Keeper) Vote(ctx sdk.Context, vote foundation.Vote) error {
 		return sdkerrors.ErrInvalidRequest.Wrap("voting period has ended already")
 	}
 
+	vote.Delegators = k.getActiveDelegators(ctx, vote.ProposalId, voter)
 	vote.SubmitTime = ctx.BlockTime()
 	k.setVote(ctx, vote)
 
@@ -109,3 +110,124 @@ func (k Keeper) pruneVotes(ctx sdk.Context, proposalID uint64) {
 		store.Delete(key)
 	}
 }
+
+func (k Keeper) DelegateVote(ctx sdk.Context, delegation foundation.VoteDelegation) error {
+	if err := delegation.ValidateBasic(); err != nil {
+		return err
+	}
+
+	k.setVoteDelegation(ctx, delegation)
+
+	return nil
+}
+
+func (k Keeper) RevokeVoteDelegation(ctx sdk.Context, delegator sdk.AccAddress) error {
+	if _, err := k.GetVoteDelegation(ctx, delegator); err != nil {
+		return err
+	}
+
+	k.deleteVoteDelegation(ctx, delegator)
+
+	return nil
+}
+
+// getActiveDelegators returns the addresses of the members who have active
+// delegations to the delegate, and have neither voted on the proposal by
+// themselves nor been recorded in the vote of another delegate, e.g. before
+// re-delegating.
+func (k Keeper) getActiveDelegators(ctx sdk.Context, proposalID uint64, delegate sdk.AccAddress) []string {
+	represented := map[string]bool{}
+	k.iterateVotes(ctx, proposalID, func(vote foundation.Vote) (stop bool) {
+		for _, delegator := range vote.Delegators {
+			represented[delegator] = true
+		}
+		return false
+	})
+
+	var delegators []string
+	k.iterateVoteDelegations(ctx, func(delegation foundation.VoteDelegation) (stop bool) {
+		if delegation.Delegate != delegate.String() || !delegation.IsActive(ctx.BlockTime()) {
+			return false
+		}
+		if represented[delegation.Delegator] {
+			return false
+		}
+
+		delegator := sdk.MustAccAddressFromBech32(delegation.Delegator)
+		if k.hasVote(ctx, proposalID, delegator) {
+			return false
+		}
+
+		delegators = append(delegators, delegation.Delegator)
+		return false
+	})
+
+	return delegators
+}
+
+func (k Keeper) GetVoteDelegation(ctx sdk.Context, delegator sdk.AccAddress) (*foundation.VoteDelegation, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := voteDelegationKey(delegator)
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return nil, sdkerrors.ErrNotFound.Wrapf("No vote delegation of %s", delegator)
+	}
+
+	var delegation foundation.VoteDelegation
+	k.cdc.MustUnmarshal(bz, &delegation)
+
+	return &delegation, nil
+}
+
+func (k Keeper) setVoteDelegation(ctx sdk.Context, delegation foundation.VoteDelegation) {
+	store := ctx.KVStore(k.storeKey)
+	delegator := sdk.MustAccAddressFromBech32(delegation.Delegator)
+	key := voteDelegationKey(delegator)
+	bz := k.cdc.MustMarshal(&delegation)
+	store.Set(key, bz)
+}
+
+func (k Keeper) deleteVoteDelegation(ctx sdk.Context, delegator sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	key := voteDelegationKey(delegator)
+	store.Delete(key)
+}
+
+func (k Keeper) iterateVoteDelegations(ctx sdk.Context, fn func(delegation foundation.VoteDelegation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, voteDelegationKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation foundation.VoteDelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &delegation)
+		if stop := fn(delegation); stop {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetVoteDelegations(ctx sdk.Context) []foundation.VoteDelegation {
+	var delegations []foundation.VoteDelegation
+	k.iterateVoteDelegations(ctx, func(delegation foundation.VoteDelegation) (stop bool) {
+		delegations = append(delegations, delegation)
+		return false
+	})
+
+	return delegations
+}
+
+// pruneVoteDelegations prunes all the vote delegations from or to the member.
+func (k Keeper) pruneVoteDelegations(ctx sdk.Context, member sdk.AccAddress) {
+	var delegators []sdk.AccAddress
+	k.iterateVoteDelegations(ctx, func(delegation foundation.VoteDelegation) (stop bool) {
+		if delegation.Delegator == member.String() || delegation.Delegate == member.String() {
+			delegators = append(delegators, sdk.MustAccAddressFromBech32(delegation.Delegator))
+		}
+		return false
+	})
+
+	for _, delegator := range delegators {
+		k.deleteVoteDelegation(ctx, delegator)
+	}
+}
@@ -84,3 +84,200 @@ func (s *KeeperTestSuite) TestVote() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestWeightedTally() {
+	ctx, _ := s.ctx.CacheContext()
+
+	// the first member has not voted on the active proposal yet
+	member, err := s.impl.GetMember(ctx, s.members[0])
+	s.Require().NoError(err)
+	member.Weight = sdk.NewDec(5)
+	s.impl.SetMember(ctx, *member)
+
+	err = s.impl.Vote(ctx, foundation.Vote{
+		ProposalId: s.activeProposal,
+		Voter:      s.members[0].String(),
+		Option:     foundation.VOTE_OPTION_NO,
+	})
+	s.Require().NoError(err)
+
+	res, err := s.queryServer.TallyResult(sdk.WrapSDKContext(ctx), &foundation.QueryTallyResultRequest{
+		ProposalId: s.activeProposal,
+	})
+	s.Require().NoError(err)
+
+	expected := foundation.NewTallyResult(sdk.NewDec(int64(len(s.members)-1)), sdk.ZeroDec(), sdk.NewDec(5), sdk.ZeroDec())
+	s.Require().Equal(expected, res.Tally)
+}
+
+func (s *KeeperTestSuite) TestDelegateVote() {
+	testCases := map[string]struct {
+		delegator sdk.AccAddress
+		delegate  sdk.AccAddress
+		duration  time.Duration
+		valid     bool
+	}{
+		"valid request": {
+			delegator: s.members[0],
+			delegate:  s.members[1],
+			duration:  time.Hour,
+			valid:     true,
+		},
+		"delegate to itself": {
+			delegator: s.members[0],
+			delegate:  s.members[0],
+			duration:  time.Hour,
+		},
+		"empty period": {
+			delegator: s.members[0],
+			delegate:  s.members[1],
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			delegation := foundation.VoteDelegation{
+				Delegator: tc.delegator.String(),
+				Delegate:  tc.delegate.String(),
+				StartTime: ctx.BlockTime(),
+				EndTime:   ctx.BlockTime().Add(tc.duration),
+			}
+			err := s.impl.DelegateVote(ctx, delegation)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			got, err := s.impl.GetVoteDelegation(ctx, tc.delegator)
+			s.Require().NoError(err)
+			s.Require().Equal(delegation, *got)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRevokeVoteDelegation() {
+	testCases := map[string]struct {
+		delegated bool
+		valid     bool
+	}{
+		"valid request": {
+			delegated: true,
+			valid:     true,
+		},
+		"no such a delegation": {},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			if tc.delegated {
+				err := s.impl.DelegateVote(ctx, foundation.VoteDelegation{
+					Delegator: s.members[0].String(),
+					Delegate:  s.members[1].String(),
+					StartTime: ctx.BlockTime(),
+					EndTime:   ctx.BlockTime().Add(time.Hour),
+				})
+				s.Require().NoError(err)
+			}
+
+			err := s.impl.RevokeVoteDelegation(ctx, s.members[0])
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			_, err = s.impl.GetVoteDelegation(ctx, s.members[0])
+			s.Require().Error(err)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestDelegatedTally() {
+	testCases := map[string]struct {
+		delegatorVotes       bool
+		delegatorRedelegates bool
+		expected             foundation.TallyResult
+	}{
+		"delegated weight counted": {
+			expected: foundation.NewTallyResult(sdk.NewDec(2), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		},
+		"delegator voted on its own": {
+			delegatorVotes: true,
+			expected:       foundation.NewTallyResult(sdk.OneDec(), sdk.ZeroDec(), sdk.OneDec(), sdk.ZeroDec()),
+		},
+		"delegator re-delegated after the vote": {
+			delegatorRedelegates: true,
+			expected:             foundation.NewTallyResult(sdk.NewDec(2), sdk.ZeroDec(), sdk.OneDec(), sdk.ZeroDec()),
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			err := s.impl.DelegateVote(ctx, foundation.VoteDelegation{
+				Delegator: s.members[0].String(),
+				Delegate:  s.members[1].String(),
+				StartTime: ctx.BlockTime(),
+				EndTime:   ctx.BlockTime().Add(time.Hour),
+			})
+			s.Require().NoError(err)
+
+			proposalID, err := s.impl.SubmitProposal(ctx, []string{s.members[1].String()}, "", []sdk.Msg{newMsgCreateDog("shiba")})
+			s.Require().NoError(err)
+
+			err = s.impl.Vote(ctx, foundation.Vote{
+				ProposalId: *proposalID,
+				Voter:      s.members[1].String(),
+				Option:     foundation.VOTE_OPTION_YES,
+			})
+			s.Require().NoError(err)
+
+			vote, err := s.impl.GetVote(ctx, *proposalID, s.members[1])
+			s.Require().NoError(err)
+			s.Require().Equal([]string{s.members[0].String()}, vote.Delegators)
+
+			if tc.delegatorVotes {
+				err = s.impl.Vote(ctx, foundation.Vote{
+					ProposalId: *proposalID,
+					Voter:      s.members[0].String(),
+					Option:     foundation.VOTE_OPTION_NO,
+				})
+				s.Require().NoError(err)
+			}
+
+			if tc.delegatorRedelegates {
+				err = s.impl.DelegateVote(ctx, foundation.VoteDelegation{
+					Delegator: s.members[0].String(),
+					Delegate:  s.members[2].String(),
+					StartTime: ctx.BlockTime(),
+					EndTime:   ctx.BlockTime().Add(time.Hour),
+				})
+				s.Require().NoError(err)
+
+				err = s.impl.Vote(ctx, foundation.Vote{
+					ProposalId: *proposalID,
+					Voter:      s.members[2].String(),
+					Option:     foundation.VOTE_OPTION_NO,
+				})
+				s.Require().NoError(err)
+
+				// the delegator is already represented by the vote of its former delegate
+				vote, err := s.impl.GetVote(ctx, *proposalID, s.members[2])
+				s.Require().NoError(err)
+				s.Require().Empty(vote.Delegators)
+			}
+
+			res, err := s.queryServer.TallyResult(sdk.WrapSDKContext(ctx), &foundation.QueryTallyResultRequest{
+				ProposalId: *proposalID,
+			})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expected, res.Tally)
+		})
+	}
+}
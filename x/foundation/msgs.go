@@ -85,6 +85,86 @@ func (m MsgWithdrawFromTreasury) GetSignBytes() []byte {
 	return sdk.MustSortJSON(codec.ModuleCdc.MustMarshalJSON(&m))
 }
 
+var _ sdk.Msg = (*MsgCreateTreasuryStream)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgCreateTreasuryStream) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", m.Authority)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", m.Recipient)
+	}
+
+	if !m.Amount.IsValid() || !m.Amount.IsAllPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrap(m.Amount.String())
+	}
+
+	if err := validateStreamSchedule(m.StartTime, m.EndTime, m.CliffTime); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg.
+func (m MsgCreateTreasuryStream) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgCreateTreasuryStream) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgCreateTreasuryStream) Route() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgCreateTreasuryStream) GetSignBytes() []byte {
+	return sdk.MustSortJSON(codec.ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgCancelTreasuryStream)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgCancelTreasuryStream) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", m.Authority)
+	}
+
+	if m.StreamId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty stream id")
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg.
+func (m MsgCancelTreasuryStream) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgCancelTreasuryStream) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgCancelTreasuryStream) Route() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgCancelTreasuryStream) GetSignBytes() []byte {
+	return sdk.MustSortJSON(codec.ModuleCdc.MustMarshalJSON(&m))
+}
+
 var _ sdk.Msg = (*MsgUpdateMembers)(nil)
 
 // ValidateBasic implements Msg.
@@ -409,6 +489,76 @@ func (m MsgLeaveFoundation) GetSignBytes() []byte {
 	return sdk.MustSortJSON(codec.ModuleCdc.MustMarshalJSON(&m))
 }
 
+var _ sdk.Msg = (*MsgDelegateVote)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgDelegateVote) ValidateBasic() error {
+	delegation := VoteDelegation{
+		Delegator: m.Delegator,
+		Delegate:  m.Delegate,
+		StartTime: m.StartTime,
+		EndTime:   m.EndTime,
+	}
+	if err := delegation.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg.
+func (m MsgDelegateVote) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Delegator)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgDelegateVote) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgDelegateVote) Route() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgDelegateVote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(codec.ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgRevokeVoteDelegation)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgRevokeVoteDelegation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Delegator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", m.Delegator)
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg.
+func (m MsgRevokeVoteDelegation) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Delegator)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgRevokeVoteDelegation) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgRevokeVoteDelegation) Route() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgRevokeVoteDelegation) GetSignBytes() []byte {
+	return sdk.MustSortJSON(codec.ModuleCdc.MustMarshalJSON(&m))
+}
+
 var _ sdk.Msg = (*MsgUpdateCensorship)(nil)
 
 // ValidateBasic implements Msg.
@@ -111,6 +111,156 @@ func TestMsgWithdrawFromTreasury(t *testing.T) {
 	}
 }
 
+func TestMsgCreateTreasuryStream(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	startTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	endTime := startTime.AddDate(1, 0, 0)
+
+	testCases := map[string]struct {
+		authority sdk.AccAddress
+		recipient sdk.AccAddress
+		amount    sdk.Int
+		startTime time.Time
+		endTime   time.Time
+		cliffTime time.Time
+		valid     bool
+	}{
+		"valid msg": {
+			authority: addrs[0],
+			recipient: addrs[1],
+			amount:    sdk.OneInt(),
+			startTime: startTime,
+			endTime:   endTime,
+			cliffTime: startTime,
+			valid:     true,
+		},
+		"valid msg with cliff": {
+			authority: addrs[0],
+			recipient: addrs[1],
+			amount:    sdk.OneInt(),
+			startTime: startTime,
+			endTime:   endTime,
+			cliffTime: endTime,
+			valid:     true,
+		},
+		"empty authority": {
+			recipient: addrs[1],
+			amount:    sdk.OneInt(),
+			startTime: startTime,
+			endTime:   endTime,
+			cliffTime: startTime,
+		},
+		"empty recipient": {
+			authority: addrs[0],
+			amount:    sdk.OneInt(),
+			startTime: startTime,
+			endTime:   endTime,
+			cliffTime: startTime,
+		},
+		"zero amount": {
+			authority: addrs[0],
+			recipient: addrs[1],
+			amount:    sdk.ZeroInt(),
+			startTime: startTime,
+			endTime:   endTime,
+			cliffTime: startTime,
+		},
+		"end time not after start time": {
+			authority: addrs[0],
+			recipient: addrs[1],
+			amount:    sdk.OneInt(),
+			startTime: startTime,
+			endTime:   startTime,
+			cliffTime: startTime,
+		},
+		"cliff time before start time": {
+			authority: addrs[0],
+			recipient: addrs[1],
+			amount:    sdk.OneInt(),
+			startTime: startTime,
+			endTime:   endTime,
+			cliffTime: startTime.Add(-time.Second),
+		},
+		"cliff time after end time": {
+			authority: addrs[0],
+			recipient: addrs[1],
+			amount:    sdk.OneInt(),
+			startTime: startTime,
+			endTime:   endTime,
+			cliffTime: endTime.Add(time.Second),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := foundation.MsgCreateTreasuryStream{
+				Authority: tc.authority.String(),
+				Recipient: tc.recipient.String(),
+				Amount:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, tc.amount)),
+				StartTime: tc.startTime,
+				EndTime:   tc.endTime,
+				CliffTime: tc.cliffTime,
+			}
+
+			err := msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, []sdk.AccAddress{tc.authority}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgCancelTreasuryStream(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 1)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		authority sdk.AccAddress
+		id        uint64
+		valid     bool
+	}{
+		"valid msg": {
+			authority: addrs[0],
+			id:        1,
+			valid:     true,
+		},
+		"empty authority": {
+			id: 1,
+		},
+		"empty stream id": {
+			authority: addrs[0],
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := foundation.MsgCancelTreasuryStream{
+				Authority: tc.authority.String(),
+				StreamId:  tc.id,
+			}
+
+			err := msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, []sdk.AccAddress{tc.authority}, msg.GetSigners())
+		})
+	}
+}
+
 func TestMsgUpdateMembers(t *testing.T) {
 	addrs := make([]sdk.AccAddress, 2)
 	for i := range addrs {
@@ -126,12 +276,14 @@ func TestMsgUpdateMembers(t *testing.T) {
 			authority: addrs[0],
 			members: []foundation.MemberRequest{{
 				Address: addrs[1].String(),
+				Weight:  sdk.OneDec(),
 			}},
 			valid: true,
 		},
 		"empty authority": {
 			members: []foundation.MemberRequest{{
 				Address: addrs[1].String(),
+				Weight:  sdk.OneDec(),
 			}},
 		},
 		"empty requests": {
@@ -507,6 +659,109 @@ func TestMsgLeaveFoundation(t *testing.T) {
 	}
 }
 
+func TestMsgDelegateVote(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	startTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	endTime := startTime.Add(7 * 24 * time.Hour)
+
+	testCases := map[string]struct {
+		delegator sdk.AccAddress
+		delegate  sdk.AccAddress
+		startTime time.Time
+		endTime   time.Time
+		valid     bool
+	}{
+		"valid msg": {
+			delegator: addrs[0],
+			delegate:  addrs[1],
+			startTime: startTime,
+			endTime:   endTime,
+			valid:     true,
+		},
+		"empty delegator": {
+			delegate:  addrs[1],
+			startTime: startTime,
+			endTime:   endTime,
+		},
+		"empty delegate": {
+			delegator: addrs[0],
+			startTime: startTime,
+			endTime:   endTime,
+		},
+		"delegate to itself": {
+			delegator: addrs[0],
+			delegate:  addrs[0],
+			startTime: startTime,
+			endTime:   endTime,
+		},
+		"end time before start time": {
+			delegator: addrs[0],
+			delegate:  addrs[1],
+			startTime: endTime,
+			endTime:   startTime,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := foundation.MsgDelegateVote{
+				Delegator: tc.delegator.String(),
+				Delegate:  tc.delegate.String(),
+				StartTime: tc.startTime,
+				EndTime:   tc.endTime,
+			}
+
+			err := msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, []sdk.AccAddress{tc.delegator}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgRevokeVoteDelegation(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 1)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		delegator sdk.AccAddress
+		valid     bool
+	}{
+		"valid msg": {
+			delegator: addrs[0],
+			valid:     true,
+		},
+		"empty delegator": {},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := foundation.MsgRevokeVoteDelegation{
+				Delegator: tc.delegator.String(),
+			}
+
+			err := msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, []sdk.AccAddress{tc.delegator}, msg.GetSigners())
+		})
+	}
+}
+
 func TestMsgGrant(t *testing.T) {
 	addrs := make([]sdk.AccAddress, 2)
 	for i := range addrs {
@@ -651,6 +906,37 @@ func TestAminoJSON(t *testing.T) {
 			&foundation.MsgLeaveFoundation{Address: addrs[0].String()},
 			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgLeaveFoundation\",\"value\":{\"address\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String()),
 		},
+		"MsgDelegateVote": {
+			&foundation.MsgDelegateVote{
+				Delegator: addrs[0].String(),
+				Delegate:  addrs[1].String(),
+				StartTime: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+				EndTime:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgDelegateVote\",\"value\":{\"delegate\":\"%s\",\"delegator\":\"%s\",\"end_time\":\"2024-01-01T00:00:00Z\",\"start_time\":\"2023-01-01T00:00:00Z\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[1].String(), addrs[0].String()),
+		},
+		"MsgRevokeVoteDelegation": {
+			&foundation.MsgRevokeVoteDelegation{Delegator: addrs[0].String()},
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgRevokeVoteDelegation\",\"value\":{\"delegator\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String()),
+		},
+		"MsgCreateTreasuryStream": {
+			&foundation.MsgCreateTreasuryStream{
+				Authority: addrs[0].String(),
+				Recipient: addrs[1].String(),
+				Amount:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())),
+				StartTime: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+				EndTime:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				CliffTime: time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC),
+			},
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgCreateTreasuryStream\",\"value\":{\"amount\":[{\"amount\":\"1\",\"denom\":\"stake\"}],\"authority\":\"%s\",\"cliff_time\":\"2023-07-01T00:00:00Z\",\"end_time\":\"2024-01-01T00:00:00Z\",\"recipient\":\"%s\",\"start_time\":\"2023-01-01T00:00:00Z\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), addrs[1].String()),
+		},
+		"MsgCancelTreasuryStream": {
+			&foundation.MsgCancelTreasuryStream{
+				Authority: addrs[0].String(),
+				StreamId:  1,
+			},
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgCancelTreasuryStream\",\"value\":{\"authority\":\"%s\",\"stream_id\":\"1\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String()),
+		},
 		"MsgWithdrawProposal": {
 			&foundation.MsgWithdrawProposal{
 				ProposalId: 1,
@@ -694,9 +980,10 @@ func TestMsgSubmitProposalAminoJSON(t *testing.T) {
 				Authority: addrs[0].String(),
 				MemberUpdates: []foundation.MemberRequest{{
 					Address: addrs[1].String(),
+					Weight:  sdk.OneDec(),
 				}},
 			},
-			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSubmitProposal\",\"value\":{\"exec\":1,\"messages\":[{\"type\":\"lbm-sdk/MsgUpdateMembers\",\"value\":{\"authority\":\"%s\",\"member_updates\":[{\"address\":\"%s\"}]}}],\"metadata\":\"MsgUpdateMembers\",\"proposers\":[\"%s\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), addrs[1].String(), proposer.String()),
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSubmitProposal\",\"value\":{\"exec\":1,\"messages\":[{\"type\":\"lbm-sdk/MsgUpdateMembers\",\"value\":{\"authority\":\"%s\",\"member_updates\":[{\"address\":\"%s\",\"weight\":\"1.000000000000000000\"}]}}],\"metadata\":\"MsgUpdateMembers\",\"proposers\":[\"%s\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), addrs[1].String(), proposer.String()),
 		},
 		"MsgUpdateCensorship": {
 			&foundation.MsgUpdateCensorship{
@@ -803,7 +1090,7 @@ func TestMsgGrantAminoJson(t *testing.T) {
 	}{
 		"ReceiveFromTreasuryAuthorization": {
 			&foundation.ReceiveFromTreasuryAuthorization{},
-			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSubmitProposal\",\"value\":{\"exec\":1,\"messages\":[{\"type\":\"lbm-sdk/MsgGrant\",\"value\":{\"authority\":\"%s\",\"authorization\":{\"type\":\"lbm-sdk/ReceiveFromTreasuryAuthorization\",\"value\":{}},\"grantee\":\"%s\"}}],\"metadata\":\"ReceiveFromTreasuryAuthorization\",\"proposers\":[\"%s\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", operator.String(), grantee.String(), proposer.String()),
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSubmitProposal\",\"value\":{\"exec\":1,\"messages\":[{\"type\":\"lbm-sdk/MsgGrant\",\"value\":{\"authority\":\"%s\",\"authorization\":{\"type\":\"lbm-sdk/ReceiveFromTreasuryAuthorization\",\"value\":{\"period_can_spend\":[],\"spend_limit\":[]}},\"grantee\":\"%s\"}}],\"metadata\":\"ReceiveFromTreasuryAuthorization\",\"proposers\":[\"%s\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", operator.String(), grantee.String(), proposer.String()),
 		},
 	}
 
@@ -200,6 +200,199 @@ func (m *QueryTreasuryResponse) GetAmount() github_com_Finschia_finschia_sdk_typ
 	return nil
 }
 
+// QueryTreasuryStreamRequest is the Query/TreasuryStream request type.
+type QueryTreasuryStreamRequest struct {
+	// stream_id is the unique ID of a stream.
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+}
+
+func (m *QueryTreasuryStreamRequest) Reset()         { *m = QueryTreasuryStreamRequest{} }
+func (m *QueryTreasuryStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTreasuryStreamRequest) ProtoMessage()    {}
+func (*QueryTreasuryStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a18e20c0e403af, []int{4}
+}
+func (m *QueryTreasuryStreamRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTreasuryStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTreasuryStreamRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTreasuryStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTreasuryStreamRequest.Merge(m, src)
+}
+func (m *QueryTreasuryStreamRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTreasuryStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTreasuryStreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTreasuryStreamRequest proto.InternalMessageInfo
+
+func (m *QueryTreasuryStreamRequest) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
+}
+
+// QueryTreasuryStreamResponse is the Query/TreasuryStream response type.
+type QueryTreasuryStreamResponse struct {
+	// stream is the treasury stream info.
+	Stream *TreasuryStream `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+}
+
+func (m *QueryTreasuryStreamResponse) Reset()         { *m = QueryTreasuryStreamResponse{} }
+func (m *QueryTreasuryStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTreasuryStreamResponse) ProtoMessage()    {}
+func (*QueryTreasuryStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a18e20c0e403af, []int{5}
+}
+func (m *QueryTreasuryStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTreasuryStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTreasuryStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTreasuryStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTreasuryStreamResponse.Merge(m, src)
+}
+func (m *QueryTreasuryStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTreasuryStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTreasuryStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTreasuryStreamResponse proto.InternalMessageInfo
+
+func (m *QueryTreasuryStreamResponse) GetStream() *TreasuryStream {
+	if m != nil {
+		return m.Stream
+	}
+	return nil
+}
+
+// QueryTreasuryStreamsRequest is the Query/TreasuryStreams request type.
+type QueryTreasuryStreamsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTreasuryStreamsRequest) Reset()         { *m = QueryTreasuryStreamsRequest{} }
+func (m *QueryTreasuryStreamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTreasuryStreamsRequest) ProtoMessage()    {}
+func (*QueryTreasuryStreamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a18e20c0e403af, []int{6}
+}
+func (m *QueryTreasuryStreamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTreasuryStreamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTreasuryStreamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTreasuryStreamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTreasuryStreamsRequest.Merge(m, src)
+}
+func (m *QueryTreasuryStreamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTreasuryStreamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTreasuryStreamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTreasuryStreamsRequest proto.InternalMessageInfo
+
+func (m *QueryTreasuryStreamsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTreasuryStreamsResponse is the Query/TreasuryStreams response type.
+type QueryTreasuryStreamsResponse struct {
+	// streams are the active treasury streams.
+	Streams []TreasuryStream `protobuf:"bytes,1,rep,name=streams,proto3" json:"streams"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTreasuryStreamsResponse) Reset()         { *m = QueryTreasuryStreamsResponse{} }
+func (m *QueryTreasuryStreamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTreasuryStreamsResponse) ProtoMessage()    {}
+func (*QueryTreasuryStreamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a18e20c0e403af, []int{7}
+}
+func (m *QueryTreasuryStreamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTreasuryStreamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTreasuryStreamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTreasuryStreamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTreasuryStreamsResponse.Merge(m, src)
+}
+func (m *QueryTreasuryStreamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTreasuryStreamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTreasuryStreamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTreasuryStreamsResponse proto.InternalMessageInfo
+
+func (m *QueryTreasuryStreamsResponse) GetStreams() []TreasuryStream {
+	if m != nil {
+		return m.Streams
+	}
+	return nil
+}
+
+func (m *QueryTreasuryStreamsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryFoundationInfoRequest is the Query/FoundationInfo request type.
 type QueryFoundationInfoRequest struct {
 }
@@ -208,7 +401,7 @@ func (m *QueryFoundationInfoRequest) Reset()         { *m = QueryFoundationInfoR
 func (m *QueryFoundationInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFoundationInfoRequest) ProtoMessage()    {}
 func (*QueryFoundationInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15a18e20c0e403af, []int{4}
+	return fileDescriptor_15a18e20c0e403af, []int{8}
 }
 func (m *QueryFoundationInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -247,7 +440,7 @@ func (m *QueryFoundationInfoResponse) Reset()         { *m = QueryFoundationInfo
 func (m *QueryFoundationInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFoundationInfoResponse) ProtoMessage()    {}
 func (*QueryFoundationInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15a18e20c0e403af, []int{5}
+	return fileDescriptor_15a18e20c0e403af, []int{9}
 }
 func (m *QueryFoundationInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -292,7 +485,7 @@ func (m *QueryMemberRequest) Reset()         { *m = QueryMemberRequest{} }
 func (m *QueryMemberRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryMemberRequest) ProtoMessage()    {}
 func (*QueryMemberRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15a18e20c0e403af, []int{6}
+	return fileDescriptor_15a18e20c0e403af, []int{10}
 }
 func (m *QueryMemberRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -338,7 +531,7 @@ func (m *QueryMemberResponse) Reset()         { *m = QueryMemberResponse{} }
 func (m *QueryMemberResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMemberResponse) ProtoMessage()    {}
 func (*QueryMemberResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15a18e20c0e403af, []int{7}
+	return fileDescriptor_15a18e20c0e403af, []int{11}
 }
 func (m *QueryMemberResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -384,7 +577,7 @@ func (m *QueryMembersRequest) Reset()         { *m = QueryMembersRequest{} }
 func (m *QueryMembersRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryMembersRequest) ProtoMessage()    {}
 func (*QueryMembersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15a18e20c0e403af, []int{8}
+	return fileDescriptor_15a18e20c0e403af, []int{12}
 }
 func (m *QueryMembersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -432,7 +625,7 @@ func (m *QueryMembersResponse) Reset()         { *m = QueryMembersResponse{} }
 func (m *QueryMembersResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMembersResponse) ProtoMessage()    {}
 func (*QueryMembersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15a18e20c0e403af, []int{9}
+	return fileDescriptor_15a18e20c0e403af, []int{13}
 }
 func (m *QueryMembersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -485,7 +678,7 @@ func (m *QueryProposalRequest) Reset()         { *m = QueryProposalRequest{} }
 func (m *QueryProposalRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryProposalRequest) ProtoMessage()    {}
 func (*QueryProposalRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15a18e20c0e403af, []int{10}
+	return fileDescriptor_15a18e20c0e403af, []int{14}
 }
 func (m *QueryProposalRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -531,7 +724,7 @@ func (m *QueryProposalResponse) Reset()         { *m = QueryProposalResponse{} }
 func (m *QueryProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryProposalResponse) ProtoMessage()    {}
 func (*QueryProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15a18e20c0e403af, []int{11}
+	return fileDescriptor_15a18e20c0e403af, []int{15}
 }
 func (m *QueryProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -577,7 +770,7 @@ func (m *QueryProposalsRequest) Reset()         { *m = QueryProposalsRequest{} }
 func (m *QueryProposalsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryProposalsRequest) ProtoMessage()    {}
 func (*QueryProposalsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15a18e20c0e403af, []int{12}
+	return fileDescriptor_15a18e20c0e403af, []int{16}
 }
 func (m *QueryProposalsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -625,7 +818,7 @@ func (m *QueryProposalsResponse) Reset()         { *m = QueryProposalsResponse{}
 func (m *QueryProposalsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryProposalsResponse) ProtoMessage()    {}
 func (*QueryProposalsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15a18e20c0e403af, []int{13}
+	return fileDescriptor_15a18e20c0e403af, []int{17}
 }
 func (m *QueryProposalsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -680,7 +873,7 @@ func (m *QueryVoteRequest) Reset()         { *m = QueryVoteRequest{} }
 func (m *QueryVoteRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVoteRequest) ProtoMessage()    {}
 func (*QueryVoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15a18e20c0e403af, []int{14}
+	return fileDescriptor_15a18e20c0e403af, []int{18}
 }
 func (m *QueryVoteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -733,7 +926,7 @@ func (m *QueryVoteResponse) Reset()         { *m = QueryVoteResponse{} }
 func (m *QueryVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVoteResponse) ProtoMessage()    {}
 func (*QueryVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15a18e20c0e403af, []int{15}
+	return fileDescriptor_15a18e20c0e403af, []int{19}
 }
 func (m *QueryVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -781,7 +974,7 @@ func (m *QueryVotesRequest) Reset()         { *m = QueryVotesRequest{} }
 func (m *QueryVotesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVotesRequest) ProtoMessage()    {}
 func (*QueryVotesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15a18e20c0e403af, []int{16}
+	return fileDescriptor_15a18e20c0e403af, []int{20}
 }
 func (m *QueryVotesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -836,7 +1029,7 @@ func (m *QueryVotesResponse) Reset()         { *m = QueryVotesResponse{} }
 func (m *QueryVotesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVotesResponse) ProtoMessage()    {}
 func (*QueryVotesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15a18e20c0e403af, []int{17}
+	return fileDescriptor_15a18e20c0e403af, []int{21}
 }
 func (m *QueryVotesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -879,24 +1072,24 @@ func (m *QueryVotesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-// QueryTallyResultRequest is the Query/TallyResult request type.
-type QueryTallyResultRequest struct {
-	// proposal_id is the unique id of a proposal.
-	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+// QueryVoteDelegationRequest is the Query/VoteDelegation request type.
+type QueryVoteDelegationRequest struct {
+	// delegator is the account address of the member.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
 }
 
-func (m *QueryTallyResultRequest) Reset()         { *m = QueryTallyResultRequest{} }
-func (m *QueryTallyResultRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryTallyResultRequest) ProtoMessage()    {}
-func (*QueryTallyResultRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15a18e20c0e403af, []int{18}
+func (m *QueryVoteDelegationRequest) Reset()         { *m = QueryVoteDelegationRequest{} }
+func (m *QueryVoteDelegationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteDelegationRequest) ProtoMessage()    {}
+func (*QueryVoteDelegationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a18e20c0e403af, []int{22}
 }
-func (m *QueryTallyResultRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryVoteDelegationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTallyResultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryVoteDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTallyResultRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryVoteDelegationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -906,43 +1099,43 @@ func (m *QueryTallyResultRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryTallyResultRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTallyResultRequest.Merge(m, src)
+func (m *QueryVoteDelegationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteDelegationRequest.Merge(m, src)
 }
-func (m *QueryTallyResultRequest) XXX_Size() int {
+func (m *QueryVoteDelegationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTallyResultRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTallyResultRequest.DiscardUnknown(m)
+func (m *QueryVoteDelegationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteDelegationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTallyResultRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryVoteDelegationRequest proto.InternalMessageInfo
 
-func (m *QueryTallyResultRequest) GetProposalId() uint64 {
+func (m *QueryVoteDelegationRequest) GetDelegator() string {
 	if m != nil {
-		return m.ProposalId
+		return m.Delegator
 	}
-	return 0
+	return ""
 }
 
-// QueryTallyResultResponse is the Query/TallyResult response type.
-type QueryTallyResultResponse struct {
-	// tally defines the requested tally.
-	Tally TallyResult `protobuf:"bytes,1,opt,name=tally,proto3" json:"tally"`
+// QueryVoteDelegationResponse is the Query/VoteDelegation response type.
+type QueryVoteDelegationResponse struct {
+	// delegation is the vote delegation of the member.
+	Delegation *VoteDelegation `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation,omitempty"`
 }
 
-func (m *QueryTallyResultResponse) Reset()         { *m = QueryTallyResultResponse{} }
-func (m *QueryTallyResultResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryTallyResultResponse) ProtoMessage()    {}
-func (*QueryTallyResultResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15a18e20c0e403af, []int{19}
+func (m *QueryVoteDelegationResponse) Reset()         { *m = QueryVoteDelegationResponse{} }
+func (m *QueryVoteDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteDelegationResponse) ProtoMessage()    {}
+func (*QueryVoteDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a18e20c0e403af, []int{23}
 }
-func (m *QueryTallyResultResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryVoteDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTallyResultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryVoteDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTallyResultResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryVoteDelegationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -952,43 +1145,43 @@ func (m *QueryTallyResultResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryTallyResultResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTallyResultResponse.Merge(m, src)
+func (m *QueryVoteDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteDelegationResponse.Merge(m, src)
 }
-func (m *QueryTallyResultResponse) XXX_Size() int {
+func (m *QueryVoteDelegationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTallyResultResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTallyResultResponse.DiscardUnknown(m)
+func (m *QueryVoteDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteDelegationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTallyResultResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryVoteDelegationResponse proto.InternalMessageInfo
 
-func (m *QueryTallyResultResponse) GetTally() TallyResult {
+func (m *QueryVoteDelegationResponse) GetDelegation() *VoteDelegation {
 	if m != nil {
-		return m.Tally
+		return m.Delegation
 	}
-	return TallyResult{}
+	return nil
 }
 
-// QueryCensorshipsRequest is the request type for the Query/Censorships RPC method.
-type QueryCensorshipsRequest struct {
+// QueryVoteDelegationsRequest is the Query/VoteDelegations request type.
+type QueryVoteDelegationsRequest struct {
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryCensorshipsRequest) Reset()         { *m = QueryCensorshipsRequest{} }
-func (m *QueryCensorshipsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryCensorshipsRequest) ProtoMessage()    {}
-func (*QueryCensorshipsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15a18e20c0e403af, []int{20}
+func (m *QueryVoteDelegationsRequest) Reset()         { *m = QueryVoteDelegationsRequest{} }
+func (m *QueryVoteDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteDelegationsRequest) ProtoMessage()    {}
+func (*QueryVoteDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a18e20c0e403af, []int{24}
 }
-func (m *QueryCensorshipsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryVoteDelegationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCensorshipsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryVoteDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCensorshipsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryVoteDelegationsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -998,45 +1191,45 @@ func (m *QueryCensorshipsRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryCensorshipsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCensorshipsRequest.Merge(m, src)
+func (m *QueryVoteDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteDelegationsRequest.Merge(m, src)
 }
-func (m *QueryCensorshipsRequest) XXX_Size() int {
+func (m *QueryVoteDelegationsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCensorshipsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCensorshipsRequest.DiscardUnknown(m)
+func (m *QueryVoteDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteDelegationsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryCensorshipsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryVoteDelegationsRequest proto.InternalMessageInfo
 
-func (m *QueryCensorshipsRequest) GetPagination() *query.PageRequest {
+func (m *QueryVoteDelegationsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryCensorshipsResponse is the response type for the Query/Censorships RPC method.
-type QueryCensorshipsResponse struct {
-	// authorizations is a list of grants granted for grantee.
-	Censorships []Censorship `protobuf:"bytes,1,rep,name=censorships,proto3" json:"censorships"`
+// QueryVoteDelegationsResponse is the Query/VoteDelegations response type.
+type QueryVoteDelegationsResponse struct {
+	// delegations are the active vote delegations.
+	Delegations []VoteDelegation `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations"`
 	// pagination defines the pagination in the response.
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryCensorshipsResponse) Reset()         { *m = QueryCensorshipsResponse{} }
-func (m *QueryCensorshipsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryCensorshipsResponse) ProtoMessage()    {}
-func (*QueryCensorshipsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15a18e20c0e403af, []int{21}
+func (m *QueryVoteDelegationsResponse) Reset()         { *m = QueryVoteDelegationsResponse{} }
+func (m *QueryVoteDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteDelegationsResponse) ProtoMessage()    {}
+func (*QueryVoteDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a18e20c0e403af, []int{25}
 }
-func (m *QueryCensorshipsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryVoteDelegationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCensorshipsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryVoteDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCensorshipsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryVoteDelegationsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1046,53 +1239,246 @@ func (m *QueryCensorshipsResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryCensorshipsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCensorshipsResponse.Merge(m, src)
+func (m *QueryVoteDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteDelegationsResponse.Merge(m, src)
 }
-func (m *QueryCensorshipsResponse) XXX_Size() int {
+func (m *QueryVoteDelegationsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCensorshipsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCensorshipsResponse.DiscardUnknown(m)
+func (m *QueryVoteDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteDelegationsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryCensorshipsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryVoteDelegationsResponse proto.InternalMessageInfo
 
-func (m *QueryCensorshipsResponse) GetCensorships() []Censorship {
+func (m *QueryVoteDelegationsResponse) GetDelegations() []VoteDelegation {
 	if m != nil {
-		return m.Censorships
+		return m.Delegations
 	}
 	return nil
 }
 
-func (m *QueryCensorshipsResponse) GetPagination() *query.PageResponse {
+func (m *QueryVoteDelegationsResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryGrantsRequest is the request type for the Query/Grants RPC method.
-type QueryGrantsRequest struct {
-	Grantee string `protobuf:"bytes,1,opt,name=grantee,proto3" json:"grantee,omitempty"`
-	// Optional, msg_type_url, when set, will query only grants matching given msg type.
-	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
-	// pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryTallyResultRequest is the Query/TallyResult request type.
+type QueryTallyResultRequest struct {
+	// proposal_id is the unique id of a proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 }
 
-func (m *QueryGrantsRequest) Reset()         { *m = QueryGrantsRequest{} }
-func (m *QueryGrantsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGrantsRequest) ProtoMessage()    {}
-func (*QueryGrantsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15a18e20c0e403af, []int{22}
+func (m *QueryTallyResultRequest) Reset()         { *m = QueryTallyResultRequest{} }
+func (m *QueryTallyResultRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTallyResultRequest) ProtoMessage()    {}
+func (*QueryTallyResultRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a18e20c0e403af, []int{26}
 }
-func (m *QueryGrantsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryTallyResultRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGrantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTallyResultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGrantsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTallyResultRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTallyResultRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTallyResultRequest.Merge(m, src)
+}
+func (m *QueryTallyResultRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTallyResultRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTallyResultRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTallyResultRequest proto.InternalMessageInfo
+
+func (m *QueryTallyResultRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QueryTallyResultResponse is the Query/TallyResult response type.
+type QueryTallyResultResponse struct {
+	// tally defines the requested tally.
+	Tally TallyResult `protobuf:"bytes,1,opt,name=tally,proto3" json:"tally"`
+}
+
+func (m *QueryTallyResultResponse) Reset()         { *m = QueryTallyResultResponse{} }
+func (m *QueryTallyResultResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTallyResultResponse) ProtoMessage()    {}
+func (*QueryTallyResultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a18e20c0e403af, []int{27}
+}
+func (m *QueryTallyResultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTallyResultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTallyResultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTallyResultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTallyResultResponse.Merge(m, src)
+}
+func (m *QueryTallyResultResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTallyResultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTallyResultResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTallyResultResponse proto.InternalMessageInfo
+
+func (m *QueryTallyResultResponse) GetTally() TallyResult {
+	if m != nil {
+		return m.Tally
+	}
+	return TallyResult{}
+}
+
+// QueryCensorshipsRequest is the request type for the Query/Censorships RPC method.
+type QueryCensorshipsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCensorshipsRequest) Reset()         { *m = QueryCensorshipsRequest{} }
+func (m *QueryCensorshipsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCensorshipsRequest) ProtoMessage()    {}
+func (*QueryCensorshipsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a18e20c0e403af, []int{28}
+}
+func (m *QueryCensorshipsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCensorshipsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCensorshipsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCensorshipsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCensorshipsRequest.Merge(m, src)
+}
+func (m *QueryCensorshipsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCensorshipsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCensorshipsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCensorshipsRequest proto.InternalMessageInfo
+
+func (m *QueryCensorshipsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryCensorshipsResponse is the response type for the Query/Censorships RPC method.
+type QueryCensorshipsResponse struct {
+	// authorizations is a list of grants granted for grantee.
+	Censorships []Censorship `protobuf:"bytes,1,rep,name=censorships,proto3" json:"censorships"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCensorshipsResponse) Reset()         { *m = QueryCensorshipsResponse{} }
+func (m *QueryCensorshipsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCensorshipsResponse) ProtoMessage()    {}
+func (*QueryCensorshipsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a18e20c0e403af, []int{29}
+}
+func (m *QueryCensorshipsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCensorshipsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCensorshipsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCensorshipsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCensorshipsResponse.Merge(m, src)
+}
+func (m *QueryCensorshipsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCensorshipsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCensorshipsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCensorshipsResponse proto.InternalMessageInfo
+
+func (m *QueryCensorshipsResponse) GetCensorships() []Censorship {
+	if m != nil {
+		return m.Censorships
+	}
+	return nil
+}
+
+func (m *QueryCensorshipsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGrantsRequest is the request type for the Query/Grants RPC method.
+type QueryGrantsRequest struct {
+	Grantee string `protobuf:"bytes,1,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// Optional, msg_type_url, when set, will query only grants matching given msg type.
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGrantsRequest) Reset()         { *m = QueryGrantsRequest{} }
+func (m *QueryGrantsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGrantsRequest) ProtoMessage()    {}
+func (*QueryGrantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a18e20c0e403af, []int{30}
+}
+func (m *QueryGrantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGrantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGrantsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1147,7 +1533,7 @@ func (m *QueryGrantsResponse) Reset()         { *m = QueryGrantsResponse{} }
 func (m *QueryGrantsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGrantsResponse) ProtoMessage()    {}
 func (*QueryGrantsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15a18e20c0e403af, []int{23}
+	return fileDescriptor_15a18e20c0e403af, []int{31}
 }
 func (m *QueryGrantsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1195,6 +1581,10 @@ func init() {
 	proto.RegisterType((*QueryParamsResponse)(nil), "lbm.foundation.v1.QueryParamsResponse")
 	proto.RegisterType((*QueryTreasuryRequest)(nil), "lbm.foundation.v1.QueryTreasuryRequest")
 	proto.RegisterType((*QueryTreasuryResponse)(nil), "lbm.foundation.v1.QueryTreasuryResponse")
+	proto.RegisterType((*QueryTreasuryStreamRequest)(nil), "lbm.foundation.v1.QueryTreasuryStreamRequest")
+	proto.RegisterType((*QueryTreasuryStreamResponse)(nil), "lbm.foundation.v1.QueryTreasuryStreamResponse")
+	proto.RegisterType((*QueryTreasuryStreamsRequest)(nil), "lbm.foundation.v1.QueryTreasuryStreamsRequest")
+	proto.RegisterType((*QueryTreasuryStreamsResponse)(nil), "lbm.foundation.v1.QueryTreasuryStreamsResponse")
 	proto.RegisterType((*QueryFoundationInfoRequest)(nil), "lbm.foundation.v1.QueryFoundationInfoRequest")
 	proto.RegisterType((*QueryFoundationInfoResponse)(nil), "lbm.foundation.v1.QueryFoundationInfoResponse")
 	proto.RegisterType((*QueryMemberRequest)(nil), "lbm.foundation.v1.QueryMemberRequest")
@@ -1209,6 +1599,10 @@ func init() {
 	proto.RegisterType((*QueryVoteResponse)(nil), "lbm.foundation.v1.QueryVoteResponse")
 	proto.RegisterType((*QueryVotesRequest)(nil), "lbm.foundation.v1.QueryVotesRequest")
 	proto.RegisterType((*QueryVotesResponse)(nil), "lbm.foundation.v1.QueryVotesResponse")
+	proto.RegisterType((*QueryVoteDelegationRequest)(nil), "lbm.foundation.v1.QueryVoteDelegationRequest")
+	proto.RegisterType((*QueryVoteDelegationResponse)(nil), "lbm.foundation.v1.QueryVoteDelegationResponse")
+	proto.RegisterType((*QueryVoteDelegationsRequest)(nil), "lbm.foundation.v1.QueryVoteDelegationsRequest")
+	proto.RegisterType((*QueryVoteDelegationsResponse)(nil), "lbm.foundation.v1.QueryVoteDelegationsResponse")
 	proto.RegisterType((*QueryTallyResultRequest)(nil), "lbm.foundation.v1.QueryTallyResultRequest")
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "lbm.foundation.v1.QueryTallyResultResponse")
 	proto.RegisterType((*QueryCensorshipsRequest)(nil), "lbm.foundation.v1.QueryCensorshipsRequest")
@@ -1220,85 +1614,100 @@ func init() {
 func init() { proto.RegisterFile("lbm/foundation/v1/query.proto", fileDescriptor_15a18e20c0e403af) }
 
 var fileDescriptor_15a18e20c0e403af = []byte{
-	// 1241 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0xb4, 0x89, 0x93, 0x8c, 0xa1, 0xa2, 0x43, 0x68, 0x13, 0x27, 0x71, 0xd2, 0x6d, 0x7e,
-	0x91, 0x92, 0x1d, 0xec, 0x22, 0xa5, 0xa4, 0x87, 0xb6, 0x29, 0x24, 0xe4, 0x00, 0x0a, 0x56, 0xe9,
-	0xa1, 0x12, 0xb2, 0xd6, 0xf6, 0xc6, 0x59, 0xe1, 0xdd, 0x71, 0x77, 0x76, 0x23, 0xdc, 0xe0, 0x4b,
-	0x0f, 0x95, 0xb8, 0xa0, 0x42, 0x2e, 0x08, 0x2e, 0x88, 0x63, 0xcf, 0xfc, 0x11, 0x15, 0xa7, 0x4a,
-	0xbd, 0x70, 0xe1, 0x87, 0x12, 0xfe, 0x10, 0xb4, 0x33, 0x6f, 0xec, 0x5d, 0x7b, 0xd7, 0x76, 0x8b,
-	0x6f, 0xbb, 0x33, 0xef, 0xbd, 0xef, 0x7b, 0xef, 0xcd, 0xcc, 0xfb, 0xf0, 0x7c, 0xad, 0x64, 0xd3,
-	0x03, 0xe6, 0x3b, 0x15, 0xc3, 0xb3, 0x98, 0x43, 0x8f, 0x72, 0xf4, 0xa1, 0x6f, 0xba, 0x0d, 0xbd,
-	0xee, 0x32, 0x8f, 0x91, 0x8b, 0xb5, 0x92, 0xad, 0xb7, 0xb7, 0xf5, 0xa3, 0x5c, 0x66, 0xbd, 0xcc,
-	0xb8, 0xcd, 0x38, 0x2d, 0x19, 0xdc, 0x94, 0xb6, 0xf4, 0x28, 0x57, 0x32, 0x3d, 0x23, 0x47, 0xeb,
-	0x46, 0xd5, 0x72, 0xa4, 0xa1, 0x70, 0xcf, 0xcc, 0x55, 0x19, 0xab, 0xd6, 0x4c, 0x6a, 0xd4, 0x2d,
-	0x6a, 0x38, 0x0e, 0xf3, 0xc4, 0x26, 0x87, 0x5d, 0xad, 0x1b, 0x3b, 0x04, 0x25, 0x6d, 0xb2, 0x61,
-	0x34, 0x85, 0x53, 0x66, 0x96, 0xda, 0x9f, 0x01, 0x04, 0xf1, 0x57, 0xf2, 0x0f, 0xa8, 0xe1, 0x34,
-	0xd4, 0x96, 0x74, 0x2d, 0x8a, 0x3f, 0x2a, 0x7f, 0x60, 0x6b, 0xaa, 0xca, 0xaa, 0x4c, 0xae, 0x07,
-	0x5f, 0x72, 0x55, 0x9b, 0xc2, 0xe4, 0xf3, 0x20, 0x9f, 0x7d, 0xc3, 0x35, 0x6c, 0x5e, 0x30, 0x1f,
-	0xfa, 0x26, 0xf7, 0xb4, 0xcf, 0xf0, 0xdb, 0x91, 0x55, 0x5e, 0x67, 0x0e, 0x37, 0xc9, 0x26, 0x4e,
-	0xd5, 0xc5, 0xca, 0x34, 0x5a, 0x44, 0x6b, 0xe9, 0xfc, 0x8c, 0xde, 0x55, 0x2a, 0x5d, 0xba, 0x6c,
-	0x8f, 0x3e, 0xff, 0x6b, 0x61, 0xa4, 0x00, 0xe6, 0xda, 0x25, 0x3c, 0x25, 0xe2, 0xdd, 0x73, 0x4d,
-	0x83, 0xfb, 0x6e, 0x43, 0xe1, 0x3c, 0x41, 0xf8, 0x9d, 0x8e, 0x0d, 0x80, 0xb2, 0x71, 0xca, 0xb0,
-	0x99, 0xef, 0x78, 0xd3, 0x68, 0xf1, 0xfc, 0x5a, 0x3a, 0x3f, 0xa7, 0x43, 0x32, 0x41, 0x51, 0x74,
-	0x28, 0x8a, 0xfe, 0x91, 0x59, 0xbe, 0xcb, 0x2c, 0x67, 0x7b, 0x33, 0x40, 0x7b, 0xf6, 0xf7, 0x02,
-	0xad, 0x5a, 0xde, 0xa1, 0x5f, 0xd2, 0xcb, 0xcc, 0xa6, 0x3b, 0x96, 0xc3, 0xcb, 0x87, 0x96, 0x41,
-	0x0f, 0xe0, 0x63, 0x83, 0x57, 0xbe, 0xa2, 0x5e, 0xa3, 0x6e, 0x72, 0xe5, 0xc7, 0x0b, 0x00, 0xa2,
-	0xcd, 0xe1, 0x8c, 0xe0, 0xb1, 0xd3, 0xca, 0x65, 0xcf, 0x39, 0x60, 0x8a, 0xe6, 0x03, 0x3c, 0x1b,
-	0xbb, 0x0b, 0x5c, 0x6f, 0xe2, 0x51, 0xcb, 0x39, 0x60, 0x50, 0x94, 0x2b, 0x31, 0x45, 0x89, 0x3a,
-	0x42, 0x71, 0x84, 0x93, 0xa6, 0x43, 0x03, 0x3e, 0x35, 0xed, 0x92, 0xe9, 0x02, 0x22, 0x99, 0xc6,
-	0xe3, 0x46, 0xa5, 0xe2, 0x9a, 0x5c, 0x96, 0x7a, 0xb2, 0xa0, 0x7e, 0xb5, 0x4f, 0xa0, 0x35, 0xca,
-	0x1e, 0x38, 0xe4, 0x70, 0xca, 0x16, 0x2b, 0x3d, 0x5a, 0x03, 0x2e, 0x60, 0xa8, 0x7d, 0x19, 0x89,
-	0xa4, 0x7a, 0x4f, 0x76, 0x30, 0x6e, 0x9f, 0x69, 0x88, 0xb6, 0x12, 0xa9, 0xbe, 0xbc, 0x2c, 0xaa,
-	0x07, 0xfb, 0x46, 0xd5, 0x04, 0xdf, 0x42, 0xc8, 0x53, 0xfb, 0x09, 0x41, 0xd3, 0x5b, 0xf1, 0x81,
-	0xea, 0x87, 0x78, 0x5c, 0x32, 0xe0, 0xd0, 0xdb, 0x64, 0xae, 0x50, 0x29, 0x65, 0x4f, 0x76, 0x23,
-	0xdc, 0xce, 0x09, 0x6e, 0xab, 0x7d, 0xb9, 0x49, 0xdc, 0x08, 0xb9, 0x4d, 0xe0, 0xb6, 0xef, 0xb2,
-	0x3a, 0xe3, 0x46, 0x4d, 0x25, 0xbf, 0x80, 0xd3, 0x75, 0x58, 0x2a, 0x5a, 0x15, 0x91, 0xfd, 0x68,
-	0x01, 0xab, 0xa5, 0xbd, 0x8a, 0xb6, 0x0f, 0x07, 0xb6, 0xed, 0xd8, 0xba, 0x1b, 0x13, 0xca, 0x0c,
-	0x8a, 0x36, 0x1b, 0x77, 0x3b, 0x94, 0x5b, 0xcb, 0x58, 0x2b, 0x76, 0x44, 0x1c, 0x7a, 0x23, 0x7e,
-	0x45, 0xf8, 0x52, 0x27, 0x02, 0x90, 0xbe, 0x85, 0x27, 0x15, 0x0f, 0xd5, 0x8c, 0x5e, 0xac, 0xa1,
-	0x1d, 0x6d, 0x9f, 0xe1, 0x35, 0x64, 0x0f, 0xbf, 0x25, 0x38, 0xde, 0x67, 0x9e, 0x39, 0x68, 0x33,
-	0xc8, 0x14, 0x1e, 0x3b, 0x62, 0x9e, 0xe9, 0x0a, 0xe0, 0xc9, 0x82, 0xfc, 0xd1, 0x6e, 0xe3, 0x8b,
-	0xa1, 0x50, 0x90, 0xe9, 0x35, 0x3c, 0x1a, 0xec, 0x42, 0x19, 0x2f, 0xc7, 0x24, 0x29, 0xcc, 0x85,
-	0x91, 0xf6, 0x4d, 0x28, 0x02, 0x1f, 0x98, 0xcd, 0x4e, 0x4c, 0x2d, 0x5e, 0xa7, 0x5f, 0x3f, 0x20,
-	0x78, 0x12, 0x00, 0x1e, 0x32, 0xb8, 0x2e, 0x93, 0x55, 0x7d, 0x4a, 0x4a, 0x01, 0x7a, 0x24, 0x6d,
-	0x87, 0xd7, 0x9f, 0x2d, 0x7c, 0x59, 0x3e, 0xd4, 0x46, 0xad, 0x16, 0xbc, 0xd2, 0x7e, 0xcd, 0x1b,
-	0xf8, 0xce, 0xdc, 0xc7, 0xd3, 0xdd, 0xbe, 0x90, 0xd5, 0x16, 0x1e, 0xf3, 0x82, 0x65, 0x68, 0x4c,
-	0x36, 0x26, 0xab, 0x90, 0x9b, 0x4a, 0x4e, 0xb8, 0x68, 0x06, 0x70, 0xba, 0x6b, 0x3a, 0x9c, 0xb9,
-	0xfc, 0xd0, 0xaa, 0x0f, 0xfd, 0xee, 0x3c, 0x43, 0xc0, 0x3d, 0x82, 0x01, 0xdc, 0x3f, 0xc6, 0xe9,
-	0x72, 0x7b, 0x19, 0xfa, 0x32, 0x1f, 0x93, 0x41, 0xdb, 0x19, 0x12, 0x08, 0xfb, 0x0d, 0xaf, 0x47,
-	0x3f, 0xaa, 0x83, 0xb3, 0xeb, 0x1a, 0x8e, 0xc7, 0x43, 0xb3, 0xa4, 0x1a, 0x2c, 0x98, 0xa6, 0x9a,
-	0x25, 0xf0, 0x4b, 0x16, 0xf1, 0x1b, 0x36, 0xaf, 0x16, 0x83, 0x99, 0x58, 0xf4, 0xdd, 0x1a, 0x5c,
-	0x23, 0x6c, 0xf3, 0xea, 0xbd, 0x46, 0xdd, 0xfc, 0xc2, 0xad, 0x75, 0xd4, 0xf1, 0xfc, 0x6b, 0xd7,
-	0xf1, 0x4f, 0x04, 0xc3, 0x46, 0x51, 0x83, 0x12, 0x36, 0xf1, 0x05, 0xc3, 0xf7, 0x0e, 0x99, 0x6b,
-	0x3d, 0x92, 0x32, 0x09, 0xaa, 0x38, 0xa5, 0x4b, 0x8d, 0xa3, 0x2b, 0x8d, 0xa3, 0xdf, 0x71, 0x1a,
-	0xdb, 0xb7, 0x7e, 0xff, 0x6d, 0xe3, 0x66, 0xdf, 0x11, 0xff, 0x75, 0x58, 0x51, 0xdd, 0x09, 0x47,
-	0x2f, 0x74, 0x80, 0x0d, 0xad, 0xf4, 0xf9, 0x97, 0x6f, 0xe2, 0x31, 0x91, 0x1f, 0x79, 0x84, 0x53,
-	0x52, 0x02, 0x91, 0xe5, 0x98, 0x93, 0xd0, 0xad, 0xb5, 0x32, 0x2b, 0xfd, 0xcc, 0x24, 0x9c, 0x76,
-	0xe5, 0xf1, 0xcb, 0x7f, 0x4f, 0xce, 0xcd, 0x92, 0x19, 0xda, 0x2d, 0x21, 0xa5, 0xcc, 0x22, 0x8f,
-	0x11, 0x9e, 0x50, 0x4a, 0x8a, 0xac, 0x26, 0xc5, 0xed, 0x10, 0x61, 0x99, 0xb5, 0xfe, 0x86, 0x40,
-	0xe1, 0xaa, 0xa0, 0x30, 0x4f, 0x66, 0x63, 0x28, 0x78, 0x0a, 0xf7, 0x67, 0x84, 0x2f, 0x44, 0xf5,
-	0x0e, 0xd9, 0x48, 0x42, 0x88, 0x95, 0x5b, 0x19, 0x7d, 0x50, 0x73, 0xa0, 0xb5, 0x2e, 0x68, 0x2d,
-	0x11, 0x8d, 0xf6, 0x12, 0xd7, 0xc5, 0x40, 0x6e, 0x91, 0xa7, 0x08, 0xa7, 0xa4, 0xb6, 0x48, 0xee,
-	0x4f, 0x44, 0x8a, 0x25, 0xf7, 0x27, 0xaa, 0xc0, 0xb4, 0x4d, 0xc1, 0x22, 0x47, 0x68, 0x6f, 0x16,
-	0x20, 0x65, 0xe8, 0x31, 0x08, 0xba, 0x26, 0xf9, 0x16, 0xe1, 0x71, 0xd0, 0x48, 0xa4, 0x0f, 0x58,
-	0xeb, 0xd0, 0xac, 0xf6, 0xb5, 0x03, 0x56, 0x1b, 0x82, 0xd5, 0x2a, 0x59, 0x1e, 0x88, 0x15, 0xf9,
-	0x1e, 0xe1, 0x09, 0x35, 0xed, 0x93, 0x4f, 0x50, 0x87, 0x6a, 0x4a, 0x3e, 0x41, 0x9d, 0x2a, 0x49,
-	0xcb, 0x0b, 0x3a, 0xef, 0x91, 0xf5, 0xb8, 0x43, 0xac, 0x54, 0x05, 0x3d, 0x0e, 0xcd, 0x93, 0x26,
-	0x79, 0x82, 0xf0, 0x64, 0x4b, 0xba, 0x90, 0xbe, 0x58, 0xad, 0x1a, 0xbd, 0x3b, 0x80, 0x25, 0xd0,
-	0x5a, 0x12, 0xb4, 0xb2, 0x64, 0xae, 0x17, 0x2d, 0x72, 0x82, 0xf0, 0x68, 0x30, 0x62, 0xc9, 0xd5,
-	0xa4, 0xc8, 0x21, 0xf5, 0x92, 0x59, 0xea, 0x6d, 0x04, 0xc8, 0xb7, 0x05, 0xf2, 0x16, 0xb9, 0x31,
-	0x78, 0x41, 0xa8, 0x18, 0xed, 0xf4, 0x58, 0xa8, 0x9d, 0x26, 0xf9, 0x0e, 0xe1, 0x31, 0xa1, 0x14,
-	0x48, 0x4f, 0xc4, 0x56, 0x59, 0x96, 0xfb, 0x58, 0x01, 0xb1, 0x1b, 0x82, 0x58, 0x9e, 0xbc, 0xff,
-	0xaa, 0xc4, 0xc8, 0x2f, 0x08, 0xa7, 0x43, 0x33, 0x9b, 0xac, 0x27, 0xbe, 0x2f, 0x5d, 0x5a, 0x22,
-	0x73, 0x6d, 0x20, 0xdb, 0xff, 0x41, 0x51, 0x28, 0x87, 0xa0, 0x66, 0xe9, 0xd0, 0x44, 0x4f, 0xa6,
-	0xd8, 0x2d, 0x2d, 0x92, 0x29, 0xc6, 0x48, 0x04, 0x6d, 0x45, 0x50, 0x5c, 0x24, 0xd9, 0x18, 0x8a,
-	0x61, 0x0d, 0x70, 0x82, 0x70, 0x4a, 0x8e, 0xc6, 0xe4, 0x67, 0x29, 0x32, 0xd5, 0x93, 0x9f, 0xa5,
-	0xe8, 0x84, 0xd5, 0xb6, 0x04, 0x83, 0x0f, 0x48, 0x3e, 0x86, 0x81, 0xd0, 0x01, 0x9c, 0x1e, 0x83,
-	0x1e, 0x68, 0xd2, 0xe3, 0xb0, 0x1c, 0x68, 0x6e, 0xef, 0x3e, 0x3f, 0xcd, 0xa2, 0x17, 0xa7, 0x59,
-	0xf4, 0xcf, 0x69, 0x16, 0x3d, 0x3d, 0xcb, 0x8e, 0xbc, 0x38, 0xcb, 0x8e, 0xfc, 0x71, 0x96, 0x1d,
-	0x79, 0xb0, 0xf1, 0x4a, 0x53, 0xb8, 0x94, 0x12, 0x63, 0xfc, 0xfa, 0x7f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x2b, 0x30, 0x28, 0x91, 0x7a, 0x11, 0x00, 0x00,
+	// 1482 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xb6, 0x89, 0x93, 0xbc, 0x7c, 0xd5, 0x2f, 0x9d, 0xa6, 0x6d, 0xba, 0x4e, 0xdd, 0x74,
+	0xdb, 0xfc, 0x20, 0x21, 0x3b, 0x24, 0x45, 0x4a, 0x9b, 0x1e, 0xda, 0xa6, 0x25, 0x25, 0x07, 0x50,
+	0x30, 0xa5, 0x42, 0x95, 0x90, 0x59, 0xc7, 0x13, 0xc7, 0xc2, 0xde, 0x75, 0x77, 0xd6, 0x11, 0xa9,
+	0xf1, 0xa5, 0x87, 0x4a, 0x5c, 0x50, 0xa1, 0x17, 0x04, 0x07, 0x10, 0x27, 0xd4, 0x23, 0xe2, 0x8f,
+	0xa8, 0x38, 0x55, 0xe2, 0xc2, 0x85, 0x1f, 0x6a, 0xb9, 0xf2, 0x3f, 0xa0, 0x9d, 0x79, 0xb3, 0xde,
+	0xb5, 0xd7, 0xde, 0x6d, 0x31, 0xa7, 0x78, 0xdf, 0xbc, 0x1f, 0x9f, 0xf7, 0xde, 0xec, 0xdb, 0xcf,
+	0x0b, 0x9c, 0xae, 0x16, 0x6b, 0x74, 0xd7, 0x69, 0xd8, 0x25, 0xcb, 0xab, 0x38, 0x36, 0xdd, 0x5f,
+	0xa1, 0x77, 0x1b, 0xcc, 0x3d, 0x30, 0xeb, 0xae, 0xe3, 0x39, 0xe4, 0x68, 0xb5, 0x58, 0x33, 0xdb,
+	0xc7, 0xe6, 0xfe, 0x8a, 0xbe, 0xb8, 0xe3, 0xf0, 0x9a, 0xc3, 0x69, 0xd1, 0xe2, 0x4c, 0xea, 0xd2,
+	0xfd, 0x95, 0x22, 0xf3, 0xac, 0x15, 0x5a, 0xb7, 0xca, 0x15, 0x5b, 0x2a, 0x0a, 0x73, 0x7d, 0xba,
+	0xec, 0x38, 0xe5, 0x2a, 0xa3, 0x56, 0xbd, 0x42, 0x2d, 0xdb, 0x76, 0x3c, 0x71, 0xc8, 0xf1, 0xd4,
+	0xe8, 0x8e, 0xdd, 0x7e, 0x42, 0x9d, 0x5c, 0x38, 0x9a, 0x8a, 0xb3, 0xe3, 0x54, 0xd4, 0xf9, 0x29,
+	0x8c, 0x20, 0x9e, 0x8a, 0x8d, 0x5d, 0x6a, 0xd9, 0x07, 0xea, 0x48, 0x9a, 0x16, 0xc4, 0x13, 0x95,
+	0x0f, 0x78, 0x34, 0x59, 0x76, 0xca, 0x8e, 0x94, 0xfb, 0xbf, 0xa4, 0xd4, 0x98, 0x04, 0xf2, 0xae,
+	0x9f, 0xcf, 0xb6, 0xe5, 0x5a, 0x35, 0x9e, 0x67, 0x77, 0x1b, 0x8c, 0x7b, 0xc6, 0x3b, 0x70, 0x2c,
+	0x22, 0xe5, 0x75, 0xc7, 0xe6, 0x8c, 0xac, 0x41, 0xa6, 0x2e, 0x24, 0x53, 0xda, 0x8c, 0xb6, 0x30,
+	0xb1, 0x7a, 0xca, 0xec, 0x2a, 0x95, 0x29, 0x4d, 0x36, 0x86, 0x9f, 0xfc, 0x7e, 0x66, 0x28, 0x8f,
+	0xea, 0xc6, 0x09, 0x98, 0x14, 0xfe, 0x6e, 0xb9, 0xcc, 0xe2, 0x0d, 0xf7, 0x40, 0xc5, 0x79, 0xa0,
+	0xc1, 0xf1, 0x8e, 0x03, 0x0c, 0x55, 0x83, 0x8c, 0x55, 0x73, 0x1a, 0xb6, 0x37, 0xa5, 0xcd, 0x1c,
+	0x5e, 0x98, 0x58, 0x9d, 0x36, 0x31, 0x19, 0xbf, 0x28, 0x26, 0x16, 0xc5, 0xbc, 0xc1, 0x76, 0xae,
+	0x3b, 0x15, 0x7b, 0x63, 0xcd, 0x8f, 0xf6, 0xf8, 0x8f, 0x33, 0xb4, 0x5c, 0xf1, 0xf6, 0x1a, 0x45,
+	0x73, 0xc7, 0xa9, 0xd1, 0xcd, 0x8a, 0xcd, 0x77, 0xf6, 0x2a, 0x16, 0xdd, 0xc5, 0x1f, 0xcb, 0xbc,
+	0xf4, 0x31, 0xf5, 0x0e, 0xea, 0x8c, 0x2b, 0x3b, 0x9e, 0xc7, 0x20, 0xc6, 0x25, 0xd0, 0x23, 0x38,
+	0xde, 0xf3, 0x5c, 0x66, 0xd5, 0x10, 0x26, 0xc9, 0xc2, 0x38, 0x17, 0x82, 0x42, 0xa5, 0x24, 0x52,
+	0x1f, 0xce, 0x8f, 0x49, 0xc1, 0x56, 0xc9, 0xf8, 0x00, 0xb2, 0xb1, 0xa6, 0x98, 0xc8, 0x25, 0xc8,
+	0x48, 0x55, 0xac, 0xd9, 0xd9, 0x98, 0x9a, 0x75, 0x98, 0xa2, 0x81, 0xc1, 0x62, 0x3d, 0xab, 0x26,
+	0x91, 0x4d, 0x80, 0xf6, 0xe5, 0x43, 0xef, 0x73, 0x91, 0x32, 0xc9, 0x5b, 0xad, 0x8a, 0xb5, 0x6d,
+	0x95, 0x19, 0xda, 0xe6, 0x43, 0x96, 0xc6, 0x63, 0x0d, 0xa6, 0xe3, 0xe3, 0x60, 0x0a, 0xd7, 0x60,
+	0x54, 0x22, 0xe2, 0xd8, 0x8c, 0xe4, 0x1c, 0xb0, 0xff, 0xca, 0x8e, 0xdc, 0x8c, 0x60, 0x3d, 0x24,
+	0xb0, 0xce, 0x27, 0x62, 0x95, 0xf1, 0x23, 0x60, 0xa7, 0xb1, 0x51, 0x9b, 0x41, 0xf0, 0x2d, 0x7b,
+	0xd7, 0x51, 0xf7, 0xe9, 0x0e, 0x64, 0x63, 0x4f, 0x31, 0x91, 0xcb, 0x30, 0x5c, 0xb1, 0x77, 0x9d,
+	0x3e, 0x9d, 0x88, 0x1a, 0x62, 0x16, 0xc2, 0xc8, 0x30, 0xf1, 0x4d, 0x79, 0x9b, 0xd5, 0x8a, 0xcc,
+	0x55, 0x4d, 0x98, 0x82, 0x51, 0xab, 0x54, 0x72, 0x19, 0x97, 0xef, 0xc4, 0x78, 0x5e, 0x3d, 0x1a,
+	0x6f, 0xc1, 0xb1, 0x88, 0x3e, 0x62, 0x58, 0x81, 0x4c, 0x4d, 0x48, 0xfa, 0xbc, 0x43, 0x68, 0x82,
+	0x8a, 0xc6, 0x87, 0x11, 0x4f, 0x03, 0xef, 0xff, 0xd7, 0x1a, 0x4c, 0x46, 0xfd, 0x07, 0x57, 0x77,
+	0x54, 0x22, 0x50, 0x7d, 0xef, 0x8d, 0x55, 0xf5, 0x1b, 0xf5, 0x07, 0xd7, 0xef, 0x35, 0xc4, 0xb6,
+	0xed, 0x3a, 0x75, 0x87, 0x5b, 0x55, 0x95, 0xfc, 0x19, 0x98, 0xa8, 0xa3, 0xa8, 0xfd, 0x52, 0x82,
+	0x12, 0x6d, 0x95, 0x8c, 0x6d, 0x38, 0xde, 0x61, 0x18, 0x0c, 0xb1, 0x31, 0xa5, 0x86, 0x45, 0xcb,
+	0xc6, 0x8d, 0x31, 0x65, 0x16, 0x28, 0x1b, 0x85, 0x0e, 0x8f, 0x03, 0x6f, 0xc4, 0xf7, 0x1a, 0x9c,
+	0xe8, 0x8c, 0x80, 0xa0, 0xaf, 0xc0, 0xb8, 0xc2, 0xa1, 0x9a, 0xd1, 0x0f, 0x35, 0xb6, 0xa3, 0x6d,
+	0x33, 0xb8, 0x86, 0x6c, 0xc1, 0x2b, 0x02, 0xe3, 0x6d, 0xc7, 0x63, 0x69, 0x9b, 0x41, 0x26, 0x61,
+	0x64, 0xdf, 0xf1, 0x98, 0x2b, 0x02, 0x8f, 0xe7, 0xe5, 0x83, 0x71, 0x15, 0x8e, 0x86, 0x5c, 0x61,
+	0xa6, 0x4b, 0x30, 0xec, 0x9f, 0x62, 0x19, 0x4f, 0xc6, 0x24, 0x29, 0xd4, 0x85, 0x92, 0xf1, 0x69,
+	0xc8, 0x03, 0x4f, 0x8d, 0x66, 0x33, 0xa6, 0x16, 0x2f, 0xd3, 0xaf, 0x2f, 0x35, 0x20, 0xe1, 0xf0,
+	0x98, 0xc1, 0x05, 0x99, 0xac, 0xea, 0x53, 0xaf, 0x14, 0xb0, 0x47, 0x52, 0x77, 0x70, 0xfd, 0x59,
+	0xc7, 0x01, 0xe9, 0x87, 0xb8, 0xc1, 0xaa, 0xac, 0x2c, 0xc4, 0xaa, 0x36, 0xd3, 0x30, 0x5e, 0x92,
+	0x42, 0xc7, 0xc5, 0x81, 0xd5, 0x16, 0x18, 0x1f, 0x41, 0x36, 0xd6, 0x36, 0xf8, 0x0e, 0x40, 0x29,
+	0x90, 0xf6, 0x19, 0xa2, 0x1d, 0xe6, 0x21, 0xa3, 0xe0, 0x93, 0x16, 0x55, 0x19, 0xf8, 0x9b, 0xf4,
+	0xa3, 0xfa, 0xa4, 0x75, 0xc5, 0xc1, 0x54, 0xb6, 0x60, 0xa2, 0x8d, 0xaa, 0xdf, 0x67, 0x2d, 0xea,
+	0x00, 0x7b, 0x16, 0xb6, 0x1d, 0x64, 0xe7, 0x4e, 0xca, 0xcf, 0xb0, 0x55, 0xad, 0xfa, 0x44, 0xa8,
+	0x51, 0xf5, 0x52, 0x4f, 0xbb, 0xdb, 0x30, 0xd5, 0x6d, 0x8b, 0xb9, 0xae, 0xc3, 0x88, 0xe7, 0x8b,
+	0xb1, 0x9e, 0xb9, 0xb8, 0x8f, 0x77, 0xdb, 0x4c, 0x5d, 0x4b, 0x61, 0x62, 0x58, 0x88, 0xe9, 0x3a,
+	0xb3, 0xb9, 0xe3, 0xf2, 0xbd, 0x4a, 0xfd, 0xbf, 0xa0, 0x1f, 0x53, 0xdd, 0x31, 0x10, 0xfb, 0x9b,
+	0x30, 0xb1, 0xd3, 0x16, 0x63, 0x9f, 0x4e, 0xc7, 0x64, 0xd0, 0x36, 0x56, 0x3d, 0x0a, 0xd9, 0x0d,
+	0xae, 0x47, 0x5f, 0xa9, 0x57, 0xfe, 0xa6, 0x6b, 0xd9, 0x1e, 0x0f, 0xb1, 0x80, 0xb2, 0x2f, 0x60,
+	0x4c, 0xb1, 0x00, 0x7c, 0x24, 0x33, 0xf0, 0xbf, 0x1a, 0x2f, 0x17, 0x7c, 0xda, 0x59, 0x68, 0xb8,
+	0x55, 0x1c, 0x80, 0x50, 0xe3, 0xe5, 0x5b, 0x07, 0x75, 0xf6, 0xbe, 0x5b, 0xed, 0xa8, 0xe3, 0xe1,
+	0x97, 0xae, 0xe3, 0x6f, 0x1a, 0x1c, 0x8b, 0x40, 0xc3, 0x12, 0xb6, 0xe0, 0x88, 0xd5, 0xf0, 0xf6,
+	0x1c, 0xb7, 0x72, 0x2f, 0x72, 0xdb, 0x27, 0x4d, 0xb9, 0x46, 0x98, 0x6a, 0x8d, 0x30, 0xaf, 0xd9,
+	0x07, 0x1b, 0x57, 0x7e, 0xfe, 0x69, 0xf9, 0x72, 0x22, 0x8b, 0xfe, 0x24, 0xbc, 0xb4, 0x5c, 0x0b,
+	0x7b, 0xcf, 0x77, 0x04, 0x1b, 0x58, 0xe9, 0x57, 0xff, 0x26, 0x30, 0x22, 0xf2, 0x23, 0xf7, 0x20,
+	0x23, 0xb7, 0x0c, 0x32, 0x1b, 0x73, 0x13, 0xba, 0xd7, 0x19, 0x7d, 0x2e, 0x49, 0x4d, 0x86, 0x33,
+	0xce, 0xde, 0xff, 0xe5, 0xaf, 0x47, 0x87, 0xb2, 0xe4, 0x14, 0xed, 0xde, 0xd2, 0xe4, 0x26, 0x43,
+	0xee, 0x6b, 0x30, 0xa6, 0xa8, 0x2e, 0x99, 0xef, 0xe5, 0xb7, 0x63, 0xcf, 0xd1, 0x17, 0x92, 0x15,
+	0x11, 0xc2, 0x39, 0x01, 0xe1, 0x34, 0xc9, 0xc6, 0x40, 0xf0, 0x54, 0xdc, 0x1f, 0x34, 0x38, 0x12,
+	0xe5, 0xdb, 0x64, 0x39, 0x29, 0x42, 0x64, 0xa3, 0xd1, 0xcd, 0xb4, 0xea, 0x08, 0x6b, 0x4d, 0xc0,
+	0x5a, 0x21, 0xb4, 0x0f, 0x2c, 0x8a, 0x64, 0x9f, 0x36, 0x83, 0x65, 0xa9, 0x45, 0xbe, 0xd5, 0xe0,
+	0xff, 0x51, 0x9f, 0x9c, 0xa4, 0x0c, 0x1e, 0xb4, 0x8f, 0xa6, 0xd6, 0x47, 0xb4, 0x4b, 0x02, 0xed,
+	0x2c, 0x39, 0x97, 0x02, 0x2d, 0xf9, 0x46, 0x83, 0x23, 0x51, 0xda, 0xdf, 0xbb, 0x98, 0xb1, 0x5b,
+	0x87, 0x6e, 0xa6, 0x55, 0x47, 0x78, 0x8b, 0x02, 0xde, 0x79, 0x62, 0xd0, 0x7e, 0xff, 0x0c, 0x28,
+	0xf8, 0x5b, 0x07, 0x79, 0xa8, 0x41, 0x46, 0x52, 0xec, 0xde, 0x97, 0x3d, 0xb2, 0x91, 0xe8, 0x73,
+	0x49, 0x6a, 0x29, 0x5a, 0x1a, 0x42, 0x81, 0x8c, 0x9e, 0x36, 0x71, 0xaf, 0x69, 0x91, 0xcf, 0x34,
+	0x18, 0x95, 0xbe, 0x38, 0x49, 0x08, 0x16, 0xb4, 0x70, 0x3e, 0x51, 0x0f, 0x51, 0x2d, 0x0b, 0x54,
+	0xf3, 0x64, 0x36, 0x15, 0x2a, 0xf2, 0x85, 0x06, 0x63, 0x8a, 0xf4, 0xf6, 0x7e, 0x1d, 0x3b, 0x96,
+	0x07, 0x7d, 0x21, 0x59, 0x11, 0xe1, 0xac, 0x0a, 0x38, 0xaf, 0x91, 0xc5, 0xb8, 0x89, 0x80, 0xca,
+	0x9c, 0x36, 0x43, 0x1f, 0xe7, 0x16, 0x79, 0xa0, 0xc1, 0xb8, 0x72, 0xc4, 0x49, 0x62, 0xac, 0xa0,
+	0x46, 0xaf, 0xa6, 0xd0, 0x44, 0x58, 0xe7, 0x05, 0xac, 0x1c, 0x99, 0xee, 0x07, 0x8b, 0x3c, 0xd2,
+	0x60, 0xd8, 0xe7, 0x2f, 0xe4, 0x5c, 0x2f, 0xcf, 0x21, 0x12, 0xaf, 0x9f, 0xef, 0xaf, 0x84, 0x91,
+	0xaf, 0x8a, 0xc8, 0xeb, 0xe4, 0x62, 0xfa, 0x82, 0x50, 0xc1, 0x70, 0x69, 0xd3, 0xff, 0xe3, 0xb6,
+	0xc8, 0xe7, 0x1a, 0x8c, 0xf8, 0x2e, 0x39, 0xe9, 0x1b, 0x31, 0x28, 0xcb, 0x6c, 0x82, 0x16, 0x02,
+	0xbb, 0x28, 0x80, 0xad, 0x92, 0xd7, 0x5f, 0x14, 0x18, 0xf9, 0x4e, 0x83, 0x89, 0x10, 0x01, 0x22,
+	0x8b, 0x3d, 0xc7, 0x4d, 0x17, 0x31, 0xd3, 0x97, 0x52, 0xe9, 0xfe, 0x0b, 0x88, 0x82, 0x86, 0x89,
+	0x81, 0x1f, 0x65, 0xa2, 0xbd, 0x67, 0x54, 0x2c, 0xf1, 0xd7, 0xcd, 0xb4, 0xea, 0x29, 0xa6, 0x83,
+	0x5f, 0xb6, 0x42, 0x88, 0x02, 0xd3, 0x66, 0xb0, 0x42, 0xc8, 0x81, 0x1f, 0xf5, 0xd9, 0x67, 0xe0,
+	0xc7, 0xaf, 0x01, 0x3a, 0x4d, 0xad, 0x9f, 0x62, 0xe0, 0x77, 0xa2, 0xf5, 0x2f, 0xe0, 0x44, 0x88,
+	0x6b, 0xf6, 0xee, 0x77, 0x37, 0xe9, 0xd5, 0x97, 0x52, 0xe9, 0x22, 0xaa, 0x39, 0x81, 0x6a, 0x86,
+	0xe4, 0x62, 0x50, 0x85, 0xd9, 0xe9, 0x23, 0x0d, 0x32, 0x92, 0xb4, 0xf5, 0x9e, 0xf1, 0x11, 0xbe,
+	0xa9, 0xcf, 0x25, 0xa9, 0x21, 0x82, 0x75, 0x81, 0xe0, 0x0d, 0xb2, 0x1a, 0x83, 0x40, 0x30, 0x54,
+	0x4e, 0x9b, 0xe2, 0x2f, 0x63, 0x2d, 0xda, 0x0c, 0x13, 0xd5, 0xd6, 0xc6, 0xcd, 0x27, 0xcf, 0x72,
+	0xda, 0xd3, 0x67, 0x39, 0xed, 0xcf, 0x67, 0x39, 0xed, 0xe1, 0xf3, 0xdc, 0xd0, 0xd3, 0xe7, 0xb9,
+	0xa1, 0x5f, 0x9f, 0xe7, 0x86, 0xee, 0x2c, 0xbf, 0x10, 0x3f, 0x2c, 0x66, 0x04, 0xc1, 0xbc, 0xf0,
+	0xcf, 0x00, 0x07, 0x93, 0xd4, 0x73, 0x77, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1317,6 +1726,10 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// Treasury queries the foundation treasury.
 	Treasury(ctx context.Context, in *QueryTreasuryRequest, opts ...grpc.CallOption) (*QueryTreasuryResponse, error)
+	// TreasuryStream queries an active treasury stream based on stream id.
+	TreasuryStream(ctx context.Context, in *QueryTreasuryStreamRequest, opts ...grpc.CallOption) (*QueryTreasuryStreamResponse, error)
+	// TreasuryStreams queries all the active treasury streams.
+	TreasuryStreams(ctx context.Context, in *QueryTreasuryStreamsRequest, opts ...grpc.CallOption) (*QueryTreasuryStreamsResponse, error)
 	// FoundationInfo queries foundation info.
 	FoundationInfo(ctx context.Context, in *QueryFoundationInfoRequest, opts ...grpc.CallOption) (*QueryFoundationInfoResponse, error)
 	// Member queries a member of the foundation
@@ -1333,6 +1746,10 @@ type QueryClient interface {
 	Votes(ctx context.Context, in *QueryVotesRequest, opts ...grpc.CallOption) (*QueryVotesResponse, error)
 	// TallyResult queries the tally of a proposal votes.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// VoteDelegation queries the vote delegation of a member.
+	VoteDelegation(ctx context.Context, in *QueryVoteDelegationRequest, opts ...grpc.CallOption) (*QueryVoteDelegationResponse, error)
+	// VoteDelegations queries all the active vote delegations.
+	VoteDelegations(ctx context.Context, in *QueryVoteDelegationsRequest, opts ...grpc.CallOption) (*QueryVoteDelegationsResponse, error)
 	// Censorships queries the censorship informations.
 	Censorships(ctx context.Context, in *QueryCensorshipsRequest, opts ...grpc.CallOption) (*QueryCensorshipsResponse, error)
 	// Returns list of authorizations, granted to the grantee.
@@ -1365,6 +1782,24 @@ func (c *queryClient) Treasury(ctx context.Context, in *QueryTreasuryRequest, op
 	return out, nil
 }
 
+func (c *queryClient) TreasuryStream(ctx context.Context, in *QueryTreasuryStreamRequest, opts ...grpc.CallOption) (*QueryTreasuryStreamResponse, error) {
+	out := new(QueryTreasuryStreamResponse)
+	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Query/TreasuryStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TreasuryStreams(ctx context.Context, in *QueryTreasuryStreamsRequest, opts ...grpc.CallOption) (*QueryTreasuryStreamsResponse, error) {
+	out := new(QueryTreasuryStreamsResponse)
+	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Query/TreasuryStreams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) FoundationInfo(ctx context.Context, in *QueryFoundationInfoRequest, opts ...grpc.CallOption) (*QueryFoundationInfoResponse, error) {
 	out := new(QueryFoundationInfoResponse)
 	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Query/FoundationInfo", in, out, opts...)
@@ -1437,6 +1872,24 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) VoteDelegation(ctx context.Context, in *QueryVoteDelegationRequest, opts ...grpc.CallOption) (*QueryVoteDelegationResponse, error) {
+	out := new(QueryVoteDelegationResponse)
+	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Query/VoteDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VoteDelegations(ctx context.Context, in *QueryVoteDelegationsRequest, opts ...grpc.CallOption) (*QueryVoteDelegationsResponse, error) {
+	out := new(QueryVoteDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Query/VoteDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Censorships(ctx context.Context, in *QueryCensorshipsRequest, opts ...grpc.CallOption) (*QueryCensorshipsResponse, error) {
 	out := new(QueryCensorshipsResponse)
 	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Query/Censorships", in, out, opts...)
@@ -1461,6 +1914,10 @@ type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// Treasury queries the foundation treasury.
 	Treasury(context.Context, *QueryTreasuryRequest) (*QueryTreasuryResponse, error)
+	// TreasuryStream queries an active treasury stream based on stream id.
+	TreasuryStream(context.Context, *QueryTreasuryStreamRequest) (*QueryTreasuryStreamResponse, error)
+	// TreasuryStreams queries all the active treasury streams.
+	TreasuryStreams(context.Context, *QueryTreasuryStreamsRequest) (*QueryTreasuryStreamsResponse, error)
 	// FoundationInfo queries foundation info.
 	FoundationInfo(context.Context, *QueryFoundationInfoRequest) (*QueryFoundationInfoResponse, error)
 	// Member queries a member of the foundation
@@ -1477,6 +1934,10 @@ type QueryServer interface {
 	Votes(context.Context, *QueryVotesRequest) (*QueryVotesResponse, error)
 	// TallyResult queries the tally of a proposal votes.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// VoteDelegation queries the vote delegation of a member.
+	VoteDelegation(context.Context, *QueryVoteDelegationRequest) (*QueryVoteDelegationResponse, error)
+	// VoteDelegations queries all the active vote delegations.
+	VoteDelegations(context.Context, *QueryVoteDelegationsRequest) (*QueryVoteDelegationsResponse, error)
 	// Censorships queries the censorship informations.
 	Censorships(context.Context, *QueryCensorshipsRequest) (*QueryCensorshipsResponse, error)
 	// Returns list of authorizations, granted to the grantee.
@@ -1493,6 +1954,12 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) Treasury(ctx context.Context, req *QueryTreasuryRequest) (*QueryTreasuryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Treasury not implemented")
 }
+func (*UnimplementedQueryServer) TreasuryStream(ctx context.Context, req *QueryTreasuryStreamRequest) (*QueryTreasuryStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TreasuryStream not implemented")
+}
+func (*UnimplementedQueryServer) TreasuryStreams(ctx context.Context, req *QueryTreasuryStreamsRequest) (*QueryTreasuryStreamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TreasuryStreams not implemented")
+}
 func (*UnimplementedQueryServer) FoundationInfo(ctx context.Context, req *QueryFoundationInfoRequest) (*QueryFoundationInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FoundationInfo not implemented")
 }
@@ -1517,6 +1984,12 @@ func (*UnimplementedQueryServer) Votes(ctx context.Context, req *QueryVotesReque
 func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (*UnimplementedQueryServer) VoteDelegation(ctx context.Context, req *QueryVoteDelegationRequest) (*QueryVoteDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteDelegation not implemented")
+}
+func (*UnimplementedQueryServer) VoteDelegations(ctx context.Context, req *QueryVoteDelegationsRequest) (*QueryVoteDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteDelegations not implemented")
+}
 func (*UnimplementedQueryServer) Censorships(ctx context.Context, req *QueryCensorshipsRequest) (*QueryCensorshipsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Censorships not implemented")
 }
@@ -1564,6 +2037,42 @@ func _Query_Treasury_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TreasuryStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTreasuryStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TreasuryStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.foundation.v1.Query/TreasuryStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TreasuryStream(ctx, req.(*QueryTreasuryStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TreasuryStreams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTreasuryStreamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TreasuryStreams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.foundation.v1.Query/TreasuryStreams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TreasuryStreams(ctx, req.(*QueryTreasuryStreamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_FoundationInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryFoundationInfoRequest)
 	if err := dec(in); err != nil {
@@ -1708,31 +2217,67 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_Censorships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCensorshipsRequest)
+func _Query_VoteDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteDelegationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).Censorships(ctx, in)
+		return srv.(QueryServer).VoteDelegation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lbm.foundation.v1.Query/Censorships",
+		FullMethod: "/lbm.foundation.v1.Query/VoteDelegation",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Censorships(ctx, req.(*QueryCensorshipsRequest))
+		return srv.(QueryServer).VoteDelegation(ctx, req.(*QueryVoteDelegationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_Grants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGrantsRequest)
+func _Query_VoteDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteDelegationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).Grants(ctx, in)
+		return srv.(QueryServer).VoteDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.foundation.v1.Query/VoteDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteDelegations(ctx, req.(*QueryVoteDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Censorships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCensorshipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Censorships(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.foundation.v1.Query/Censorships",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Censorships(ctx, req.(*QueryCensorshipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Grants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Grants(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
@@ -1756,6 +2301,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Treasury",
 			Handler:    _Query_Treasury_Handler,
 		},
+		{
+			MethodName: "TreasuryStream",
+			Handler:    _Query_TreasuryStream_Handler,
+		},
+		{
+			MethodName: "TreasuryStreams",
+			Handler:    _Query_TreasuryStreams_Handler,
+		},
 		{
 			MethodName: "FoundationInfo",
 			Handler:    _Query_FoundationInfo_Handler,
@@ -1788,6 +2341,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "VoteDelegation",
+			Handler:    _Query_VoteDelegation_Handler,
+		},
+		{
+			MethodName: "VoteDelegations",
+			Handler:    _Query_VoteDelegations_Handler,
+		},
 		{
 			MethodName: "Censorships",
 			Handler:    _Query_Censorships_Handler,
@@ -1917,6 +2478,153 @@ func (m *QueryTreasuryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryTreasuryStreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTreasuryStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTreasuryStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTreasuryStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTreasuryStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTreasuryStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Stream != nil {
+		{
+			size, err := m.Stream.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTreasuryStreamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTreasuryStreamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTreasuryStreamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTreasuryStreamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTreasuryStreamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTreasuryStreamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Streams) > 0 {
+		for iNdEx := len(m.Streams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Streams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryFoundationInfoRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2428,7 +3136,7 @@ func (m *QueryVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTallyResultRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryVoteDelegationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2438,25 +3146,27 @@ func (m *QueryTallyResultRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTallyResultRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryVoteDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTallyResultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryVoteDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ProposalId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTallyResultResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryVoteDelegationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2466,30 +3176,32 @@ func (m *QueryTallyResultResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTallyResultResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryVoteDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryVoteDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Tally.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Delegation != nil {
+		{
+			size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCensorshipsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryVoteDelegationsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2499,12 +3211,12 @@ func (m *QueryCensorshipsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryCensorshipsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryVoteDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCensorshipsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryVoteDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2524,7 +3236,7 @@ func (m *QueryCensorshipsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCensorshipsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryVoteDelegationsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2534,12 +3246,12 @@ func (m *QueryCensorshipsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryCensorshipsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryVoteDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCensorshipsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryVoteDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2556,10 +3268,10 @@ func (m *QueryCensorshipsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Censorships) > 0 {
-		for iNdEx := len(m.Censorships) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Censorships[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2573,7 +3285,7 @@ func (m *QueryCensorshipsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGrantsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTallyResultRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2583,46 +3295,25 @@ func (m *QueryGrantsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGrantsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTallyResultRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTallyResultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.MsgTypeUrl) > 0 {
-		i -= len(m.MsgTypeUrl)
-		copy(dAtA[i:], m.MsgTypeUrl)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Grantee) > 0 {
-		i -= len(m.Grantee)
-		copy(dAtA[i:], m.Grantee)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGrantsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTallyResultResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2632,24 +3323,190 @@ func (m *QueryGrantsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGrantsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTallyResultResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	{
+		size, err := m.Tally.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCensorshipsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCensorshipsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCensorshipsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCensorshipsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCensorshipsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCensorshipsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Censorships) > 0 {
+		for iNdEx := len(m.Censorships) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Censorships[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGrantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGrantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGrantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGrantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
 		dAtA[i] = 0x12
@@ -2726,6 +3583,63 @@ func (m *QueryTreasuryResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryTreasuryStreamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		n += 1 + sovQuery(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *QueryTreasuryStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Stream != nil {
+		l = m.Stream.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTreasuryStreamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTreasuryStreamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Streams) > 0 {
+		for _, e := range m.Streams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryFoundationInfoRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2925,30 +3839,33 @@ func (m *QueryVotesResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryTallyResultRequest) Size() (n int) {
+func (m *QueryVoteDelegationRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalId))
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryTallyResultResponse) Size() (n int) {
+func (m *QueryVoteDelegationResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Tally.Size()
-	n += 1 + l + sovQuery(uint64(l))
+	if m.Delegation != nil {
+		l = m.Delegation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryCensorshipsRequest) Size() (n int) {
+func (m *QueryVoteDelegationsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2961,14 +3878,14 @@ func (m *QueryCensorshipsRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryCensorshipsResponse) Size() (n int) {
+func (m *QueryVoteDelegationsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Censorships) > 0 {
-		for _, e := range m.Censorships {
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -2980,41 +3897,96 @@ func (m *QueryCensorshipsResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryGrantsRequest) Size() (n int) {
+func (m *QueryTallyResultRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.MsgTypeUrl)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
 	}
 	return n
 }
 
-func (m *QueryGrantsResponse) Size() (n int) {
+func (m *QueryTallyResultResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Authorizations) > 0 {
-		for _, e := range m.Authorizations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
+	l = m.Tally.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCensorshipsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCensorshipsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Censorships) > 0 {
+		for _, e := range m.Censorships {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGrantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGrantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Authorizations) > 0 {
+		for _, e := range m.Authorizations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
@@ -3293,7 +4265,7 @@ func (m *QueryTreasuryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFoundationInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTreasuryStreamRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3316,12 +4288,710 @@ func (m *QueryFoundationInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFoundationInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTreasuryStreamRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFoundationInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTreasuryStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTreasuryStreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTreasuryStreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTreasuryStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stream == nil {
+				m.Stream = &TreasuryStream{}
+			}
+			if err := m.Stream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTreasuryStreamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTreasuryStreamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTreasuryStreamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTreasuryStreamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTreasuryStreamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTreasuryStreamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Streams = append(m.Streams, TreasuryStream{})
+			if err := m.Streams[len(m.Streams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFoundationInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFoundationInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFoundationInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFoundationInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFoundationInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFoundationInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMemberRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMemberRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMemberRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMemberResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMemberResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Member == nil {
+				m.Member = &Member{}
+			}
+			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMembersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMembersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3343,7 +5013,7 @@ func (m *QueryFoundationInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFoundationInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMembersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3366,15 +5036,15 @@ func (m *QueryFoundationInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFoundationInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMembersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFoundationInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3401,7 +5071,44 @@ func (m *QueryFoundationInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Members = append(m.Members, Member{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3426,7 +5133,7 @@ func (m *QueryFoundationInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMemberRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3449,17 +5156,17 @@ func (m *QueryMemberRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMemberRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMemberRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var stringLen uint64
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3469,24 +5176,11 @@ func (m *QueryMemberRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3508,7 +5202,7 @@ func (m *QueryMemberRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMemberResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3531,15 +5225,15 @@ func (m *QueryMemberResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMemberResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3566,10 +5260,10 @@ func (m *QueryMemberResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Member == nil {
-				m.Member = &Member{}
+			if m.Proposal == nil {
+				m.Proposal = &Proposal{}
 			}
-			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3594,7 +5288,7 @@ func (m *QueryMemberResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMembersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3617,10 +5311,10 @@ func (m *QueryMembersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMembersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3680,7 +5374,7 @@ func (m *QueryMembersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMembersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3703,15 +5397,15 @@ func (m *QueryMembersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMembersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3738,8 +5432,8 @@ func (m *QueryMembersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, Member{})
-			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Proposals = append(m.Proposals, Proposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3800,7 +5494,7 @@ func (m *QueryMembersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3823,10 +5517,10 @@ func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVoteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3848,6 +5542,38 @@ func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3869,7 +5595,7 @@ func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3892,15 +5618,15 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3927,10 +5653,10 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Proposal == nil {
-				m.Proposal = &Proposal{}
+			if m.Vote == nil {
+				m.Vote = &Vote{}
 			}
-			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Vote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3955,7 +5681,7 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVotesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3978,13 +5704,32 @@ func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVotesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -4041,7 +5786,7 @@ func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVotesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4064,15 +5809,15 @@ func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVotesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4099,8 +5844,8 @@ func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposals = append(m.Proposals, Proposal{})
-			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Votes = append(m.Votes, Vote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4161,7 +5906,7 @@ func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVoteDelegationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4184,34 +5929,15 @@ func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVoteDelegationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVoteDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
-			}
-			m.ProposalId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4239,7 +5965,7 @@ func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4262,7 +5988,7 @@ func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVoteDelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4285,15 +6011,15 @@ func (m *QueryVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVoteDelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVoteDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4320,10 +6046,10 @@ func (m *QueryVoteResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Vote == nil {
-				m.Vote = &Vote{}
+			if m.Delegation == nil {
+				m.Delegation = &VoteDelegation{}
 			}
-			if err := m.Vote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4348,7 +6074,7 @@ func (m *QueryVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVotesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVoteDelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4371,32 +6097,13 @@ func (m *QueryVotesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVoteDelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVoteDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
-			}
-			m.ProposalId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -4453,7 +6160,7 @@ func (m *QueryVotesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVotesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVoteDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4476,15 +6183,15 @@ func (m *QueryVotesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVoteDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVoteDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4511,8 +6218,8 @@ func (m *QueryVotesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Votes = append(m.Votes, Vote{})
-			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Delegations = append(m.Delegations, VoteDelegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,103 @@
+package simulation
+
+import (
+	"math/rand"
+	"time"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/module"
+	simtypes "github.com/Finschia/finschia-sdk/types/simulation"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+)
+
+// Simulation parameter constants
+const (
+	FoundationTax = "foundation_tax"
+	Members       = "members"
+	Threshold     = "threshold"
+	VotingPeriod  = "voting_period"
+)
+
+// GenFoundationTax returns a randomized foundation tax.
+func GenFoundationTax(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(101)), 2)
+}
+
+// GenMembers returns randomized members of the foundation, chosen from the
+// simulation accounts.
+func GenMembers(r *rand.Rand, accs []simtypes.Account) []foundation.Member {
+	const maxMembers = 10
+
+	numMembers := simtypes.RandIntBetween(r, 1, maxMembers+1)
+	if numMembers > len(accs) {
+		numMembers = len(accs)
+	}
+
+	members := make([]foundation.Member, 0, numMembers)
+	for _, i := range r.Perm(len(accs))[:numMembers] {
+		members = append(members, foundation.Member{
+			Address: accs[i].Address.String(),
+			Weight:  sdk.OneDec(),
+		})
+	}
+
+	return members
+}
+
+// GenThreshold returns a randomized threshold of the decision policy, which
+// is not greater than the total weight.
+func GenThreshold(r *rand.Rand, totalWeight sdk.Dec) sdk.Dec {
+	return sdk.NewDec(int64(simtypes.RandIntBetween(r, 1, int(totalWeight.TruncateInt64())+1)))
+}
+
+// GenVotingPeriod returns a randomized voting period of the decision policy.
+func GenVotingPeriod(r *rand.Rand) time.Duration {
+	return time.Duration(simtypes.RandIntBetween(r, 1, 2*60*60*24*2)) * time.Second
+}
+
+// RandomizedGenState generates a random GenesisState for foundation.
+func RandomizedGenState(simState *module.SimulationState) {
+	var foundationTax sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, FoundationTax, &foundationTax, simState.Rand,
+		func(r *rand.Rand) { foundationTax = GenFoundationTax(r) },
+	)
+
+	var members []foundation.Member
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, Members, &members, simState.Rand,
+		func(r *rand.Rand) { members = GenMembers(r, simState.Accounts) },
+	)
+
+	totalWeight := foundation.Members{Members: members}.TotalWeight()
+
+	var threshold sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, Threshold, &threshold, simState.Rand,
+		func(r *rand.Rand) { threshold = GenThreshold(r, totalWeight) },
+	)
+
+	var votingPeriod time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, VotingPeriod, &votingPeriod, simState.Rand,
+		func(r *rand.Rand) { votingPeriod = GenVotingPeriod(r) },
+	)
+
+	info := foundation.DefaultFoundation()
+	info.TotalWeight = totalWeight
+	if err := info.SetDecisionPolicy(&foundation.ThresholdDecisionPolicy{
+		Threshold: threshold,
+		Windows: &foundation.DecisionPolicyWindows{
+			VotingPeriod: votingPeriod,
+		},
+	}); err != nil {
+		panic(err)
+	}
+
+	genesis := foundation.DefaultGenesisState()
+	genesis.Params.FoundationTax = foundationTax
+	genesis.Foundation = info
+	genesis.Members = members
+
+	simState.GenState[foundation.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
@@ -0,0 +1,350 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/Finschia/finschia-sdk/baseapp"
+	"github.com/Finschia/finschia-sdk/codec"
+	simappparams "github.com/Finschia/finschia-sdk/simapp/params"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	simtypes "github.com/Finschia/finschia-sdk/types/simulation"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+	"github.com/Finschia/finschia-sdk/x/foundation/keeper"
+	"github.com/Finschia/finschia-sdk/x/simulation"
+)
+
+// foundation message types
+var (
+	TypeMsgFundTreasury     = sdk.MsgTypeURL(&foundation.MsgFundTreasury{})
+	TypeMsgSubmitProposal   = sdk.MsgTypeURL(&foundation.MsgSubmitProposal{})
+	TypeMsgWithdrawProposal = sdk.MsgTypeURL(&foundation.MsgWithdrawProposal{})
+	TypeMsgVote             = sdk.MsgTypeURL(&foundation.MsgVote{})
+	TypeMsgExec             = sdk.MsgTypeURL(&foundation.MsgExec{})
+)
+
+// Simulation operation weights constants
+//
+//nolint:gosec
+const (
+	OpWeightMsgFundTreasury     = "op_weight_msg_foundation_fund_treasury"
+	OpWeightMsgSubmitProposal   = "op_weight_msg_foundation_submit_proposal"
+	OpWeightMsgWithdrawProposal = "op_weight_msg_foundation_withdraw_proposal"
+	OpWeightMsgVote             = "op_weight_msg_foundation_vote"
+	OpWeightMsgExec             = "op_weight_msg_foundation_exec"
+)
+
+// foundation operations weights
+const (
+	WeightFundTreasury     = 20
+	WeightSubmitProposal   = 20
+	WeightWithdrawProposal = 5
+	WeightVote             = 50
+	WeightExec             = 20
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak foundation.AuthKeeper, bk foundation.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgFundTreasury     int
+		weightMsgSubmitProposal   int
+		weightMsgWithdrawProposal int
+		weightMsgVote             int
+		weightMsgExec             int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgFundTreasury, &weightMsgFundTreasury, nil,
+		func(_ *rand.Rand) {
+			weightMsgFundTreasury = WeightFundTreasury
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgSubmitProposal, &weightMsgSubmitProposal, nil,
+		func(_ *rand.Rand) {
+			weightMsgSubmitProposal = WeightSubmitProposal
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgWithdrawProposal, &weightMsgWithdrawProposal, nil,
+		func(_ *rand.Rand) {
+			weightMsgWithdrawProposal = WeightWithdrawProposal
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgVote, &weightMsgVote, nil,
+		func(_ *rand.Rand) {
+			weightMsgVote = WeightVote
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgExec, &weightMsgExec, nil,
+		func(_ *rand.Rand) {
+			weightMsgExec = WeightExec
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgFundTreasury,
+			SimulateMsgFundTreasury(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSubmitProposal,
+			SimulateMsgSubmitProposal(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgWithdrawProposal,
+			SimulateMsgWithdrawProposal(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgVote,
+			SimulateMsgVote(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgExec,
+			SimulateMsgExec(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgFundTreasury generates a MsgFundTreasury with random values.
+func SimulateMsgFundTreasury(ak foundation.AuthKeeper, bk foundation.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		from, _ := simtypes.RandomAcc(r, accs)
+
+		amount := simtypes.RandSubsetCoins(r, bk.SpendableCoins(ctx, from.Address))
+		if amount.Empty() {
+			return simtypes.NoOpMsg(foundation.ModuleName, TypeMsgFundTreasury, "no spendable coins"), nil, nil
+		}
+
+		msg := &foundation.MsgFundTreasury{
+			From:   from.Address.String(),
+			Amount: amount,
+		}
+
+		return deliver(r, app, ctx, ak, bk, msg, TypeMsgFundTreasury, from, amount)
+	}
+}
+
+// SimulateMsgSubmitProposal generates a MsgSubmitProposal with random values.
+// The proposal withdraws coins from the treasury.
+func SimulateMsgSubmitProposal(ak foundation.AuthKeeper, bk foundation.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if !hasProposalFeature(ctx, k) {
+			return simtypes.NoOpMsg(foundation.ModuleName, TypeMsgSubmitProposal, "proposals are outsourced"), nil, nil
+		}
+
+		proposer, ok := randMember(r, ctx, k, accs)
+		if !ok {
+			return simtypes.NoOpMsg(foundation.ModuleName, TypeMsgSubmitProposal, "no members"), nil, nil
+		}
+		to, _ := simtypes.RandomAcc(r, accs)
+
+		// it may exceed the treasury, which makes the execution fail
+		treasury, _ := queryTreasury(ctx, k).TruncateDecimal()
+		amount := simtypes.RandSubsetCoins(r, treasury)
+		if amount.Empty() {
+			amount = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt()))
+		}
+
+		msg := &foundation.MsgSubmitProposal{
+			Proposers: []string{proposer.Address.String()},
+			Metadata:  simtypes.RandStringOfLength(r, r.Intn(100)),
+		}
+		if r.Intn(2) == 0 {
+			msg.Exec = foundation.Exec_EXEC_TRY
+		}
+		if err := msg.SetMsgs([]sdk.Msg{
+			&foundation.MsgWithdrawFromTreasury{
+				Authority: k.GetAuthority(),
+				To:        to.Address.String(),
+				Amount:    amount,
+			},
+		}); err != nil {
+			return simtypes.NoOpMsg(foundation.ModuleName, TypeMsgSubmitProposal, "unable to set msgs"), nil, err
+		}
+
+		return deliver(r, app, ctx, ak, bk, msg, TypeMsgSubmitProposal, proposer, nil)
+	}
+}
+
+// SimulateMsgWithdrawProposal generates a MsgWithdrawProposal with random values.
+func SimulateMsgWithdrawProposal(ak foundation.AuthKeeper, bk foundation.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		proposal, ok := findProposal(r, ctx, k, func(proposal foundation.Proposal) bool {
+			return proposal.Status == foundation.PROPOSAL_STATUS_SUBMITTED
+		})
+		if !ok {
+			return simtypes.NoOpMsg(foundation.ModuleName, TypeMsgWithdrawProposal, "no proposals to withdraw"), nil, nil
+		}
+
+		proposer, ok := simtypes.FindAccount(accs, sdk.MustAccAddressFromBech32(proposal.Proposers[r.Intn(len(proposal.Proposers))]))
+		if !ok {
+			return simtypes.NoOpMsg(foundation.ModuleName, TypeMsgWithdrawProposal, "proposer not found"), nil, nil
+		}
+
+		msg := &foundation.MsgWithdrawProposal{
+			ProposalId: proposal.Id,
+			Address:    proposer.Address.String(),
+		}
+
+		return deliver(r, app, ctx, ak, bk, msg, TypeMsgWithdrawProposal, proposer, nil)
+	}
+}
+
+// SimulateMsgVote generates a MsgVote with random values.
+func SimulateMsgVote(ak foundation.AuthKeeper, bk foundation.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		proposal, ok := findProposal(r, ctx, k, func(proposal foundation.Proposal) bool {
+			return proposal.Status == foundation.PROPOSAL_STATUS_SUBMITTED &&
+				ctx.BlockTime().Before(proposal.VotingPeriodEnd)
+		})
+		if !ok {
+			return simtypes.NoOpMsg(foundation.ModuleName, TypeMsgVote, "no proposals to vote"), nil, nil
+		}
+
+		voter, ok := randMember(r, ctx, k, accs)
+		if !ok {
+			return simtypes.NoOpMsg(foundation.ModuleName, TypeMsgVote, "no members"), nil, nil
+		}
+		if _, err := keeper.NewQueryServer(k).Vote(sdk.WrapSDKContext(ctx), &foundation.QueryVoteRequest{
+			ProposalId: proposal.Id,
+			Voter:      voter.Address.String(),
+		}); err == nil {
+			return simtypes.NoOpMsg(foundation.ModuleName, TypeMsgVote, "already voted"), nil, nil
+		}
+
+		msg := &foundation.MsgVote{
+			ProposalId: proposal.Id,
+			Voter:      voter.Address.String(),
+			Option:     randVoteOption(r),
+			Metadata:   simtypes.RandStringOfLength(r, r.Intn(100)),
+		}
+		if r.Intn(2) == 0 {
+			msg.Exec = foundation.Exec_EXEC_TRY
+		}
+
+		return deliver(r, app, ctx, ak, bk, msg, TypeMsgVote, voter, nil)
+	}
+}
+
+// SimulateMsgExec generates a MsgExec with random values.
+func SimulateMsgExec(ak foundation.AuthKeeper, bk foundation.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		proposal, ok := findProposal(r, ctx, k, func(proposal foundation.Proposal) bool {
+			if proposal.RetryDeadline != nil && ctx.BlockTime().After(*proposal.RetryDeadline) {
+				return false
+			}
+
+			return proposal.Status == foundation.PROPOSAL_STATUS_SUBMITTED ||
+				proposal.Status == foundation.PROPOSAL_STATUS_ACCEPTED
+		})
+		if !ok {
+			return simtypes.NoOpMsg(foundation.ModuleName, TypeMsgExec, "no proposals to exec"), nil, nil
+		}
+
+		signer, ok := randMember(r, ctx, k, accs)
+		if !ok {
+			return simtypes.NoOpMsg(foundation.ModuleName, TypeMsgExec, "no members"), nil, nil
+		}
+
+		msg := &foundation.MsgExec{
+			ProposalId: proposal.Id,
+			Signer:     signer.Address.String(),
+		}
+
+		return deliver(r, app, ctx, ak, bk, msg, TypeMsgExec, signer, nil)
+	}
+}
+
+func deliver(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak foundation.AuthKeeper, bk foundation.BankKeeper, msg sdk.Msg, msgType string, signer simtypes.Account, spent sdk.Coins) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+		Cdc:             nil,
+		Msg:             msg,
+		MsgType:         msgType,
+		CoinsSpentInMsg: spent,
+		Context:         ctx,
+		SimAccount:      signer,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      foundation.ModuleName,
+	}
+
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
+
+// hasProposalFeature returns whether x/foundation handles the proposals by
+// itself, instead of outsourcing them.
+func hasProposalFeature(ctx sdk.Context, k keeper.Keeper) bool {
+	res, err := keeper.NewQueryServer(k).FoundationInfo(sdk.WrapSDKContext(ctx), &foundation.QueryFoundationInfoRequest{})
+	if err != nil {
+		return false
+	}
+
+	return res.Info.TotalWeight.IsPositive()
+}
+
+// queryTreasury returns the coins in the treasury.
+func queryTreasury(ctx sdk.Context, k keeper.Keeper) sdk.DecCoins {
+	res, err := keeper.NewQueryServer(k).Treasury(sdk.WrapSDKContext(ctx), &foundation.QueryTreasuryRequest{})
+	if err != nil {
+		return nil
+	}
+
+	return res.Amount
+}
+
+// randMember returns a random member of the foundation.
+func randMember(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) (simtypes.Account, bool) {
+	res, err := keeper.NewQueryServer(k).Members(sdk.WrapSDKContext(ctx), &foundation.QueryMembersRequest{})
+	if err != nil || len(res.Members) == 0 {
+		return simtypes.Account{}, false
+	}
+
+	member := sdk.MustAccAddressFromBech32(res.Members[r.Intn(len(res.Members))].Address)
+	return simtypes.FindAccount(accs, member)
+}
+
+// findProposal returns a proposal which satisfies the filter.
+func findProposal(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, filter func(proposal foundation.Proposal) bool) (foundation.Proposal, bool) {
+	res, err := keeper.NewQueryServer(k).Proposals(sdk.WrapSDKContext(ctx), &foundation.QueryProposalsRequest{})
+	if err != nil || len(res.Proposals) == 0 {
+		return foundation.Proposal{}, false
+	}
+
+	offset := r.Intn(len(res.Proposals))
+	for i := range res.Proposals {
+		proposal := res.Proposals[(offset+i)%len(res.Proposals)]
+		if filter(proposal) {
+			return proposal, true
+		}
+	}
+
+	return foundation.Proposal{}, false
+}
+
+// randVoteOption returns a random vote option except the unspecified one.
+func randVoteOption(r *rand.Rand) foundation.VoteOption {
+	options := []foundation.VoteOption{
+		foundation.VOTE_OPTION_YES,
+		foundation.VOTE_OPTION_ABSTAIN,
+		foundation.VOTE_OPTION_NO,
+		foundation.VOTE_OPTION_NO_WITH_VETO,
+	}
+
+	return options[r.Intn(len(options))]
+}
@@ -0,0 +1,184 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	ocabci "github.com/Finschia/ostracon/abci/types"
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/Finschia/finschia-sdk/simapp"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	simtypes "github.com/Finschia/finschia-sdk/types/simulation"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+	fdncodec "github.com/Finschia/finschia-sdk/x/foundation/codec"
+	"github.com/Finschia/finschia-sdk/x/foundation/simulation"
+)
+
+type SimTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *simapp.SimApp
+}
+
+func (s *SimTestSuite) SetupTest() {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+	s.app = app
+	s.ctx = app.BaseApp.NewContext(checkTx, tmproto.Header{
+		Time: time.Now(),
+	})
+}
+
+func (s *SimTestSuite) getTestingAccounts(r *rand.Rand, n int) []simtypes.Account {
+	accounts := simtypes.RandomAccounts(r, n)
+
+	initAmt := sdk.TokensFromConsensusPower(200, sdk.DefaultPowerReduction)
+	initCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initAmt))
+
+	// add coins to the accounts
+	for _, account := range accounts {
+		err := simapp.FundAccount(s.app, s.ctx, account.Address, initCoins)
+		s.Require().NoError(err)
+	}
+
+	return accounts
+}
+
+// setMembers makes the accounts the members of the foundation.
+func (s *SimTestSuite) setMembers(accounts []simtypes.Account) {
+	members := make([]foundation.Member, len(accounts))
+	for i, account := range accounts {
+		members[i] = foundation.Member{
+			Address: account.Address.String(),
+			Weight:  sdk.OneDec(),
+		}
+	}
+
+	info := foundation.DefaultFoundation()
+	info.TotalWeight = sdk.NewDec(int64(len(members)))
+	err := info.SetDecisionPolicy(&foundation.ThresholdDecisionPolicy{
+		Threshold: info.TotalWeight,
+		Windows: &foundation.DecisionPolicyWindows{
+			VotingPeriod: time.Hour,
+		},
+	})
+	s.Require().NoError(err)
+
+	err = s.app.FoundationKeeper.InitGenesis(s.ctx, &foundation.GenesisState{
+		Params:     foundation.DefaultParams(),
+		Foundation: info,
+		Members:    members,
+	})
+	s.Require().NoError(err)
+}
+
+func (s *SimTestSuite) TestWeightedOperations() {
+	app, ctx := s.app, s.ctx
+
+	cdc := app.AppCodec()
+	appParams := make(simtypes.AppParams)
+
+	weightedOps := simulation.WeightedOperations(appParams, cdc, app.AccountKeeper, app.BankKeeper, app.FoundationKeeper)
+
+	r := rand.New(rand.NewSource(1))
+	accs := s.getTestingAccounts(r, 3)
+	s.setMembers(accs)
+
+	expected := []struct {
+		weight    int
+		opMsgName string
+	}{
+		{simulation.WeightFundTreasury, simulation.TypeMsgFundTreasury},
+		{simulation.WeightSubmitProposal, simulation.TypeMsgSubmitProposal},
+		{simulation.WeightWithdrawProposal, simulation.TypeMsgWithdrawProposal},
+		{simulation.WeightVote, simulation.TypeMsgVote},
+		{simulation.WeightExec, simulation.TypeMsgExec},
+	}
+	s.Require().Len(weightedOps, len(expected))
+
+	// begin a new block
+	app.BeginBlock(ocabci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash}})
+
+	for i, w := range weightedOps {
+		operationMsg, _, err := w.Op()(r, app.BaseApp, ctx, accs, ctx.ChainID())
+		s.Require().NoError(err)
+
+		// the following checks are very much dependent from the ordering of the output given
+		// by WeightedOperations. if the ordering in WeightedOperations changes some tests
+		// will fail
+		s.Require().Equal(expected[i].weight, w.Weight(), "weight should be the same")
+		s.Require().Equal(expected[i].opMsgName, operationMsg.Name, "operation Msg name should be the same")
+	}
+}
+
+func (s *SimTestSuite) TestSimulateMsgSubmitProposal() {
+	app, ctx := s.app, s.ctx
+
+	r := rand.New(rand.NewSource(1))
+	accounts := s.getTestingAccounts(r, 3)
+
+	// proposals are outsourced by default
+	op := simulation.SimulateMsgSubmitProposal(app.AccountKeeper, app.BankKeeper, app.FoundationKeeper)
+	operationMsg, _, err := op(r, app.BaseApp, ctx, accounts, "")
+	s.Require().NoError(err)
+	s.Require().False(operationMsg.OK)
+
+	s.setMembers(accounts)
+
+	// begin a new block
+	app.BeginBlock(ocabci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash}})
+
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	s.Require().NoError(err)
+
+	var msg foundation.MsgSubmitProposal
+	err = fdncodec.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+	s.Require().NoError(err)
+
+	s.Require().True(operationMsg.OK)
+	s.Require().Equal(simulation.TypeMsgSubmitProposal, operationMsg.Name)
+	s.Require().Len(msg.Proposers, 1)
+	s.Require().Len(futureOperations, 0)
+}
+
+func (s *SimTestSuite) TestSimulateMsgVote() {
+	app, ctx := s.app, s.ctx
+
+	r := rand.New(rand.NewSource(1))
+	accounts := s.getTestingAccounts(r, 3)
+	s.setMembers(accounts)
+
+	// no proposals yet
+	op := simulation.SimulateMsgVote(app.AccountKeeper, app.BankKeeper, app.FoundationKeeper)
+	operationMsg, _, err := op(r, app.BaseApp, ctx, accounts, "")
+	s.Require().NoError(err)
+	s.Require().False(operationMsg.OK)
+
+	// begin a new block, whose time is used for the voting period
+	app.BeginBlock(ocabci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash, Time: ctx.BlockTime()}})
+
+	submit := simulation.SimulateMsgSubmitProposal(app.AccountKeeper, app.BankKeeper, app.FoundationKeeper)
+	operationMsg, _, err = submit(r, app.BaseApp, ctx, accounts, "")
+	s.Require().NoError(err)
+	s.Require().True(operationMsg.OK)
+
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	s.Require().NoError(err)
+
+	var msg foundation.MsgVote
+	err = fdncodec.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+	s.Require().NoError(err)
+
+	s.Require().True(operationMsg.OK)
+	s.Require().Equal(simulation.TypeMsgVote, operationMsg.Name)
+	s.Require().Equal(uint64(1), msg.ProposalId)
+	s.Require().Len(futureOperations, 0)
+}
+
+func TestSimTestSuite(t *testing.T) {
+	suite.Run(t, new(SimTestSuite))
+}
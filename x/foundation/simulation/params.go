@@ -0,0 +1,22 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	simtypes "github.com/Finschia/finschia-sdk/types/simulation"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+	"github.com/Finschia/finschia-sdk/x/simulation"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(foundation.ModuleName, foundation.ParamKeyFoundationTax,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenFoundationTax(r))
+			},
+		),
+	}
+}
@@ -12,19 +12,23 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -218,6 +222,167 @@ func (m *MsgWithdrawFromTreasuryResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgWithdrawFromTreasuryResponse proto.InternalMessageInfo
 
+// MsgCreateTreasuryStream is the Msg/CreateTreasuryStream request type.
+type MsgCreateTreasuryStream struct {
+	// authority is the address of the privileged account.
+	Authority string                                       `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Recipient string                                       `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount    github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"amount"`
+	StartTime time.Time                                    `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	EndTime   time.Time                                    `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	CliffTime time.Time                                    `protobuf:"bytes,6,opt,name=cliff_time,json=cliffTime,proto3,stdtime" json:"cliff_time"`
+}
+
+func (m *MsgCreateTreasuryStream) Reset()         { *m = MsgCreateTreasuryStream{} }
+func (m *MsgCreateTreasuryStream) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateTreasuryStream) ProtoMessage()    {}
+func (*MsgCreateTreasuryStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec2105611cae3ff, []int{4}
+}
+func (m *MsgCreateTreasuryStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateTreasuryStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateTreasuryStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateTreasuryStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateTreasuryStream.Merge(m, src)
+}
+func (m *MsgCreateTreasuryStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateTreasuryStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateTreasuryStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateTreasuryStream proto.InternalMessageInfo
+
+// MsgCreateTreasuryStreamResponse is the Msg/CreateTreasuryStream response type.
+type MsgCreateTreasuryStreamResponse struct {
+	// stream_id is the unique ID of the stream.
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+}
+
+func (m *MsgCreateTreasuryStreamResponse) Reset()         { *m = MsgCreateTreasuryStreamResponse{} }
+func (m *MsgCreateTreasuryStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateTreasuryStreamResponse) ProtoMessage()    {}
+func (*MsgCreateTreasuryStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec2105611cae3ff, []int{5}
+}
+func (m *MsgCreateTreasuryStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateTreasuryStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateTreasuryStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateTreasuryStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateTreasuryStreamResponse.Merge(m, src)
+}
+func (m *MsgCreateTreasuryStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateTreasuryStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateTreasuryStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateTreasuryStreamResponse proto.InternalMessageInfo
+
+// MsgCancelTreasuryStream is the Msg/CancelTreasuryStream request type.
+type MsgCancelTreasuryStream struct {
+	// authority is the address of the privileged account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// stream_id is the unique ID of the stream.
+	StreamId uint64 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+}
+
+func (m *MsgCancelTreasuryStream) Reset()         { *m = MsgCancelTreasuryStream{} }
+func (m *MsgCancelTreasuryStream) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelTreasuryStream) ProtoMessage()    {}
+func (*MsgCancelTreasuryStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec2105611cae3ff, []int{6}
+}
+func (m *MsgCancelTreasuryStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelTreasuryStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelTreasuryStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelTreasuryStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelTreasuryStream.Merge(m, src)
+}
+func (m *MsgCancelTreasuryStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelTreasuryStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelTreasuryStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelTreasuryStream proto.InternalMessageInfo
+
+// MsgCancelTreasuryStreamResponse is the Msg/CancelTreasuryStream response type.
+type MsgCancelTreasuryStreamResponse struct {
+}
+
+func (m *MsgCancelTreasuryStreamResponse) Reset()         { *m = MsgCancelTreasuryStreamResponse{} }
+func (m *MsgCancelTreasuryStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelTreasuryStreamResponse) ProtoMessage()    {}
+func (*MsgCancelTreasuryStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec2105611cae3ff, []int{7}
+}
+func (m *MsgCancelTreasuryStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelTreasuryStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelTreasuryStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelTreasuryStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelTreasuryStreamResponse.Merge(m, src)
+}
+func (m *MsgCancelTreasuryStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelTreasuryStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelTreasuryStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelTreasuryStreamResponse proto.InternalMessageInfo
+
 // MsgUpdateMembers is the Msg/UpdateMembers request type.
 type MsgUpdateMembers struct {
 	// authority is the address of the privileged account.
@@ -231,7 +396,7 @@ func (m *MsgUpdateMembers) Reset()         { *m = MsgUpdateMembers{} }
 func (m *MsgUpdateMembers) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateMembers) ProtoMessage()    {}
 func (*MsgUpdateMembers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{4}
+	return fileDescriptor_5ec2105611cae3ff, []int{8}
 }
 func (m *MsgUpdateMembers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -268,7 +433,7 @@ func (m *MsgUpdateMembersResponse) Reset()         { *m = MsgUpdateMembersRespon
 func (m *MsgUpdateMembersResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateMembersResponse) ProtoMessage()    {}
 func (*MsgUpdateMembersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{5}
+	return fileDescriptor_5ec2105611cae3ff, []int{9}
 }
 func (m *MsgUpdateMembersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -309,7 +474,7 @@ func (m *MsgUpdateDecisionPolicy) Reset()         { *m = MsgUpdateDecisionPolicy
 func (m *MsgUpdateDecisionPolicy) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateDecisionPolicy) ProtoMessage()    {}
 func (*MsgUpdateDecisionPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{6}
+	return fileDescriptor_5ec2105611cae3ff, []int{10}
 }
 func (m *MsgUpdateDecisionPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -346,7 +511,7 @@ func (m *MsgUpdateDecisionPolicyResponse) Reset()         { *m = MsgUpdateDecisi
 func (m *MsgUpdateDecisionPolicyResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateDecisionPolicyResponse) ProtoMessage()    {}
 func (*MsgUpdateDecisionPolicyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{7}
+	return fileDescriptor_5ec2105611cae3ff, []int{11}
 }
 func (m *MsgUpdateDecisionPolicyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -394,7 +559,7 @@ func (m *MsgSubmitProposal) Reset()         { *m = MsgSubmitProposal{} }
 func (m *MsgSubmitProposal) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitProposal) ProtoMessage()    {}
 func (*MsgSubmitProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{8}
+	return fileDescriptor_5ec2105611cae3ff, []int{12}
 }
 func (m *MsgSubmitProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -433,7 +598,7 @@ func (m *MsgSubmitProposalResponse) Reset()         { *m = MsgSubmitProposalResp
 func (m *MsgSubmitProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitProposalResponse) ProtoMessage()    {}
 func (*MsgSubmitProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{9}
+	return fileDescriptor_5ec2105611cae3ff, []int{13}
 }
 func (m *MsgSubmitProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -474,7 +639,7 @@ func (m *MsgWithdrawProposal) Reset()         { *m = MsgWithdrawProposal{} }
 func (m *MsgWithdrawProposal) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawProposal) ProtoMessage()    {}
 func (*MsgWithdrawProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{10}
+	return fileDescriptor_5ec2105611cae3ff, []int{14}
 }
 func (m *MsgWithdrawProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -511,7 +676,7 @@ func (m *MsgWithdrawProposalResponse) Reset()         { *m = MsgWithdrawProposal
 func (m *MsgWithdrawProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawProposalResponse) ProtoMessage()    {}
 func (*MsgWithdrawProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{11}
+	return fileDescriptor_5ec2105611cae3ff, []int{15}
 }
 func (m *MsgWithdrawProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -559,7 +724,7 @@ func (m *MsgVote) Reset()         { *m = MsgVote{} }
 func (m *MsgVote) String() string { return proto.CompactTextString(m) }
 func (*MsgVote) ProtoMessage()    {}
 func (*MsgVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{12}
+	return fileDescriptor_5ec2105611cae3ff, []int{16}
 }
 func (m *MsgVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -596,7 +761,7 @@ func (m *MsgVoteResponse) Reset()         { *m = MsgVoteResponse{} }
 func (m *MsgVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgVoteResponse) ProtoMessage()    {}
 func (*MsgVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{13}
+	return fileDescriptor_5ec2105611cae3ff, []int{17}
 }
 func (m *MsgVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -637,7 +802,7 @@ func (m *MsgExec) Reset()         { *m = MsgExec{} }
 func (m *MsgExec) String() string { return proto.CompactTextString(m) }
 func (*MsgExec) ProtoMessage()    {}
 func (*MsgExec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{14}
+	return fileDescriptor_5ec2105611cae3ff, []int{18}
 }
 func (m *MsgExec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -674,7 +839,7 @@ func (m *MsgExecResponse) Reset()         { *m = MsgExecResponse{} }
 func (m *MsgExecResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExecResponse) ProtoMessage()    {}
 func (*MsgExecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{15}
+	return fileDescriptor_5ec2105611cae3ff, []int{19}
 }
 func (m *MsgExecResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -713,7 +878,7 @@ func (m *MsgLeaveFoundation) Reset()         { *m = MsgLeaveFoundation{} }
 func (m *MsgLeaveFoundation) String() string { return proto.CompactTextString(m) }
 func (*MsgLeaveFoundation) ProtoMessage()    {}
 func (*MsgLeaveFoundation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{16}
+	return fileDescriptor_5ec2105611cae3ff, []int{20}
 }
 func (m *MsgLeaveFoundation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -750,7 +915,7 @@ func (m *MsgLeaveFoundationResponse) Reset()         { *m = MsgLeaveFoundationRe
 func (m *MsgLeaveFoundationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLeaveFoundationResponse) ProtoMessage()    {}
 func (*MsgLeaveFoundationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{17}
+	return fileDescriptor_5ec2105611cae3ff, []int{21}
 }
 func (m *MsgLeaveFoundationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -779,6 +944,165 @@ func (m *MsgLeaveFoundationResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgLeaveFoundationResponse proto.InternalMessageInfo
 
+// MsgDelegateVote is the Msg/DelegateVote request type.
+type MsgDelegateVote struct {
+	// delegator is the account address of the member delegating the vote.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// delegate is the account address of the member voting on behalf of the
+	// delegator.
+	Delegate string `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	// start_time is the timestamp from which the delegation is active.
+	StartTime time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time is the timestamp until which the delegation is active.
+	EndTime time.Time `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *MsgDelegateVote) Reset()         { *m = MsgDelegateVote{} }
+func (m *MsgDelegateVote) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateVote) ProtoMessage()    {}
+func (*MsgDelegateVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec2105611cae3ff, []int{22}
+}
+func (m *MsgDelegateVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateVote.Merge(m, src)
+}
+func (m *MsgDelegateVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateVote proto.InternalMessageInfo
+
+// MsgDelegateVoteResponse is the Msg/DelegateVote response type.
+type MsgDelegateVoteResponse struct {
+}
+
+func (m *MsgDelegateVoteResponse) Reset()         { *m = MsgDelegateVoteResponse{} }
+func (m *MsgDelegateVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateVoteResponse) ProtoMessage()    {}
+func (*MsgDelegateVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec2105611cae3ff, []int{23}
+}
+func (m *MsgDelegateVoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateVoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateVoteResponse.Merge(m, src)
+}
+func (m *MsgDelegateVoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateVoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateVoteResponse proto.InternalMessageInfo
+
+// MsgRevokeVoteDelegation is the Msg/RevokeVoteDelegation request type.
+type MsgRevokeVoteDelegation struct {
+	// delegator is the account address of the member.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+}
+
+func (m *MsgRevokeVoteDelegation) Reset()         { *m = MsgRevokeVoteDelegation{} }
+func (m *MsgRevokeVoteDelegation) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeVoteDelegation) ProtoMessage()    {}
+func (*MsgRevokeVoteDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec2105611cae3ff, []int{24}
+}
+func (m *MsgRevokeVoteDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeVoteDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeVoteDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeVoteDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeVoteDelegation.Merge(m, src)
+}
+func (m *MsgRevokeVoteDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeVoteDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeVoteDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeVoteDelegation proto.InternalMessageInfo
+
+// MsgRevokeVoteDelegationResponse is the Msg/RevokeVoteDelegation response type.
+type MsgRevokeVoteDelegationResponse struct {
+}
+
+func (m *MsgRevokeVoteDelegationResponse) Reset()         { *m = MsgRevokeVoteDelegationResponse{} }
+func (m *MsgRevokeVoteDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeVoteDelegationResponse) ProtoMessage()    {}
+func (*MsgRevokeVoteDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec2105611cae3ff, []int{25}
+}
+func (m *MsgRevokeVoteDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeVoteDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeVoteDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeVoteDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeVoteDelegationResponse.Merge(m, src)
+}
+func (m *MsgRevokeVoteDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeVoteDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeVoteDelegationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeVoteDelegationResponse proto.InternalMessageInfo
+
 // MsgUpdateCensorship is the Msg/UpdateCensorship request type.
 type MsgUpdateCensorship struct {
 	// authority over the target censorship.
@@ -791,7 +1115,7 @@ func (m *MsgUpdateCensorship) Reset()         { *m = MsgUpdateCensorship{} }
 func (m *MsgUpdateCensorship) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateCensorship) ProtoMessage()    {}
 func (*MsgUpdateCensorship) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{18}
+	return fileDescriptor_5ec2105611cae3ff, []int{26}
 }
 func (m *MsgUpdateCensorship) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -828,7 +1152,7 @@ func (m *MsgUpdateCensorshipResponse) Reset()         { *m = MsgUpdateCensorship
 func (m *MsgUpdateCensorshipResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateCensorshipResponse) ProtoMessage()    {}
 func (*MsgUpdateCensorshipResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{19}
+	return fileDescriptor_5ec2105611cae3ff, []int{27}
 }
 func (m *MsgUpdateCensorshipResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -870,7 +1194,7 @@ func (m *MsgGrant) Reset()         { *m = MsgGrant{} }
 func (m *MsgGrant) String() string { return proto.CompactTextString(m) }
 func (*MsgGrant) ProtoMessage()    {}
 func (*MsgGrant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{20}
+	return fileDescriptor_5ec2105611cae3ff, []int{28}
 }
 func (m *MsgGrant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -907,7 +1231,7 @@ func (m *MsgGrantResponse) Reset()         { *m = MsgGrantResponse{} }
 func (m *MsgGrantResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgGrantResponse) ProtoMessage()    {}
 func (*MsgGrantResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{21}
+	return fileDescriptor_5ec2105611cae3ff, []int{29}
 }
 func (m *MsgGrantResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -948,7 +1272,7 @@ func (m *MsgRevoke) Reset()         { *m = MsgRevoke{} }
 func (m *MsgRevoke) String() string { return proto.CompactTextString(m) }
 func (*MsgRevoke) ProtoMessage()    {}
 func (*MsgRevoke) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{22}
+	return fileDescriptor_5ec2105611cae3ff, []int{30}
 }
 func (m *MsgRevoke) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -985,7 +1309,7 @@ func (m *MsgRevokeResponse) Reset()         { *m = MsgRevokeResponse{} }
 func (m *MsgRevokeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRevokeResponse) ProtoMessage()    {}
 func (*MsgRevokeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{23}
+	return fileDescriptor_5ec2105611cae3ff, []int{31}
 }
 func (m *MsgRevokeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1020,6 +1344,10 @@ func init() {
 	proto.RegisterType((*MsgFundTreasuryResponse)(nil), "lbm.foundation.v1.MsgFundTreasuryResponse")
 	proto.RegisterType((*MsgWithdrawFromTreasury)(nil), "lbm.foundation.v1.MsgWithdrawFromTreasury")
 	proto.RegisterType((*MsgWithdrawFromTreasuryResponse)(nil), "lbm.foundation.v1.MsgWithdrawFromTreasuryResponse")
+	proto.RegisterType((*MsgCreateTreasuryStream)(nil), "lbm.foundation.v1.MsgCreateTreasuryStream")
+	proto.RegisterType((*MsgCreateTreasuryStreamResponse)(nil), "lbm.foundation.v1.MsgCreateTreasuryStreamResponse")
+	proto.RegisterType((*MsgCancelTreasuryStream)(nil), "lbm.foundation.v1.MsgCancelTreasuryStream")
+	proto.RegisterType((*MsgCancelTreasuryStreamResponse)(nil), "lbm.foundation.v1.MsgCancelTreasuryStreamResponse")
 	proto.RegisterType((*MsgUpdateMembers)(nil), "lbm.foundation.v1.MsgUpdateMembers")
 	proto.RegisterType((*MsgUpdateMembersResponse)(nil), "lbm.foundation.v1.MsgUpdateMembersResponse")
 	proto.RegisterType((*MsgUpdateDecisionPolicy)(nil), "lbm.foundation.v1.MsgUpdateDecisionPolicy")
@@ -1034,6 +1362,10 @@ func init() {
 	proto.RegisterType((*MsgExecResponse)(nil), "lbm.foundation.v1.MsgExecResponse")
 	proto.RegisterType((*MsgLeaveFoundation)(nil), "lbm.foundation.v1.MsgLeaveFoundation")
 	proto.RegisterType((*MsgLeaveFoundationResponse)(nil), "lbm.foundation.v1.MsgLeaveFoundationResponse")
+	proto.RegisterType((*MsgDelegateVote)(nil), "lbm.foundation.v1.MsgDelegateVote")
+	proto.RegisterType((*MsgDelegateVoteResponse)(nil), "lbm.foundation.v1.MsgDelegateVoteResponse")
+	proto.RegisterType((*MsgRevokeVoteDelegation)(nil), "lbm.foundation.v1.MsgRevokeVoteDelegation")
+	proto.RegisterType((*MsgRevokeVoteDelegationResponse)(nil), "lbm.foundation.v1.MsgRevokeVoteDelegationResponse")
 	proto.RegisterType((*MsgUpdateCensorship)(nil), "lbm.foundation.v1.MsgUpdateCensorship")
 	proto.RegisterType((*MsgUpdateCensorshipResponse)(nil), "lbm.foundation.v1.MsgUpdateCensorshipResponse")
 	proto.RegisterType((*MsgGrant)(nil), "lbm.foundation.v1.MsgGrant")
@@ -1045,77 +1377,94 @@ func init() {
 func init() { proto.RegisterFile("lbm/foundation/v1/tx.proto", fileDescriptor_5ec2105611cae3ff) }
 
 var fileDescriptor_5ec2105611cae3ff = []byte{
-	// 1117 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x73, 0xdb, 0x44,
-	0x14, 0xb7, 0x12, 0x37, 0x1f, 0x2f, 0x89, 0x93, 0x6c, 0x33, 0xd4, 0x51, 0x5b, 0xc7, 0xa8, 0xa5,
-	0x93, 0x49, 0x1b, 0x89, 0x04, 0x38, 0xc1, 0x0c, 0xd3, 0xa4, 0x31, 0x93, 0x99, 0x9a, 0x06, 0x35,
-	0xe1, 0xeb, 0x80, 0x47, 0xb6, 0x36, 0x8a, 0xa8, 0xa5, 0x15, 0x5a, 0xc9, 0xd8, 0x70, 0xe0, 0xca,
-	0x70, 0x60, 0xf8, 0x33, 0x18, 0x86, 0x03, 0x87, 0xde, 0xe1, 0x98, 0xe9, 0xa9, 0x47, 0x4e, 0x7c,
-	0x24, 0xff, 0x08, 0xa3, 0xd5, 0x6a, 0x23, 0xd9, 0xf2, 0x07, 0xcc, 0x70, 0xd3, 0xee, 0xfb, 0xbd,
-	0xdf, 0x7b, 0xbf, 0xb7, 0x4f, 0xef, 0x81, 0xdc, 0x6e, 0x3a, 0xda, 0x29, 0x09, 0x5d, 0xd3, 0x08,
-	0x6c, 0xe2, 0x6a, 0x9d, 0x1d, 0x2d, 0xe8, 0xaa, 0x9e, 0x4f, 0x02, 0x82, 0x56, 0xdb, 0x4d, 0x47,
-	0xbd, 0xb2, 0xa9, 0x9d, 0x1d, 0x79, 0xcd, 0x22, 0x16, 0x61, 0x56, 0x2d, 0xfa, 0x8a, 0x81, 0xb2,
-	0x32, 0x48, 0x92, 0x72, 0x8b, 0x31, 0x95, 0x16, 0xa1, 0x0e, 0xa1, 0x5a, 0xd3, 0xa0, 0x58, 0xeb,
-	0xec, 0x34, 0x71, 0x60, 0xec, 0x68, 0x2d, 0x62, 0x27, 0xf6, 0x75, 0x8b, 0x10, 0xab, 0x8d, 0x35,
-	0x76, 0x6a, 0x86, 0xa7, 0x9a, 0xe1, 0xf6, 0x12, 0x53, 0xec, 0xda, 0x88, 0xe3, 0xc6, 0x87, 0xd8,
-	0xa4, 0x7c, 0x2f, 0xc1, 0x72, 0x9d, 0x5a, 0xb5, 0xd0, 0x35, 0x8f, 0x7d, 0x6c, 0xd0, 0xd0, 0xef,
-	0x21, 0x04, 0xc5, 0x53, 0x9f, 0x38, 0x65, 0xa9, 0x2a, 0x6d, 0xce, 0xeb, 0xec, 0x1b, 0x59, 0x30,
-	0x63, 0x38, 0x24, 0x74, 0x83, 0xf2, 0x54, 0x75, 0x7a, 0x73, 0x61, 0x77, 0x5d, 0xe5, 0x34, 0x51,
-	0x3a, 0x2a, 0x4f, 0x47, 0xdd, 0x27, 0xb6, 0xbb, 0xf7, 0xe6, 0xf9, 0x1f, 0x1b, 0x85, 0x9f, 0xfe,
-	0xdc, 0x78, 0x60, 0xd9, 0xc1, 0x59, 0xd8, 0x54, 0x5b, 0xc4, 0xd1, 0x6a, 0xb6, 0x4b, 0x5b, 0x67,
-	0xb6, 0xa1, 0x9d, 0xf2, 0x8f, 0x6d, 0x6a, 0x3e, 0xd3, 0x82, 0x9e, 0x87, 0x29, 0x73, 0xa2, 0x3a,
-	0xa7, 0x57, 0xd6, 0xe1, 0x46, 0x5f, 0x3e, 0x3a, 0xa6, 0x1e, 0x71, 0x29, 0x56, 0x7e, 0x91, 0x98,
-	0xed, 0x23, 0x3b, 0x38, 0x33, 0x7d, 0xe3, 0xcb, 0x9a, 0x4f, 0x1c, 0x91, 0xf3, 0x2d, 0x98, 0x37,
-	0xc2, 0xe0, 0x8c, 0xf8, 0x76, 0xd0, 0xe3, 0x89, 0x5f, 0x5d, 0xa0, 0x12, 0x4c, 0x05, 0xa4, 0x3c,
-	0xc5, 0xae, 0xa7, 0x02, 0x92, 0x52, 0x33, 0xfd, 0xff, 0xaa, 0x79, 0x15, 0x36, 0x86, 0x64, 0x2c,
-	0x54, 0x7d, 0x03, 0x2b, 0x75, 0x6a, 0x9d, 0x78, 0xa6, 0x11, 0xe0, 0x3a, 0x76, 0x9a, 0xd8, 0xa7,
-	0x63, 0xd4, 0xd4, 0xa1, 0xe4, 0x30, 0x60, 0x23, 0x64, 0x5e, 0x94, 0xbf, 0x49, 0x55, 0x1d, 0xe8,
-	0x37, 0x35, 0x66, 0xd4, 0xf1, 0x17, 0x21, 0xa6, 0xc1, 0x5e, 0x31, 0x12, 0xa3, 0x2f, 0xc5, 0xde,
-	0x71, 0x48, 0xaa, 0xc8, 0x50, 0xee, 0x4f, 0x40, 0x24, 0xf7, 0x5d, 0x5c, 0xf2, 0xd8, 0xf8, 0x08,
-	0xb7, 0x6c, 0x6a, 0x13, 0xf7, 0x88, 0xb4, 0xed, 0xd6, 0xb8, 0x92, 0x7f, 0x00, 0xcb, 0x26, 0xc7,
-	0x37, 0x3c, 0xe6, 0xc0, 0xea, 0xbf, 0xb0, 0xbb, 0xa6, 0xc6, 0x8d, 0xaa, 0x26, 0x8d, 0xaa, 0x3e,
-	0x74, 0x7b, 0x7b, 0xe8, 0xc5, 0xf3, 0xed, 0x52, 0x36, 0x80, 0x5e, 0x32, 0x33, 0x67, 0x5e, 0xcc,
-	0xbc, 0x5c, 0x44, 0xbe, 0x3f, 0x4b, 0xb0, 0x5a, 0xa7, 0xd6, 0xd3, 0xb0, 0xe9, 0xd8, 0xc1, 0x91,
-	0x4f, 0x3c, 0x42, 0x8d, 0x76, 0x94, 0xa9, 0xc7, 0xbe, 0xb1, 0x4f, 0xcb, 0x52, 0x75, 0x3a, 0xca,
-	0x54, 0x5c, 0x20, 0x19, 0xe6, 0x1c, 0x1c, 0x18, 0xa6, 0x11, 0x18, 0xbc, 0x45, 0xc4, 0x19, 0xbd,
-	0x1e, 0xd9, 0x28, 0x35, 0x2c, 0x4c, 0x79, 0xab, 0xe4, 0xa6, 0xaf, 0x0b, 0x14, 0xba, 0x0f, 0x45,
-	0xdc, 0xc5, 0xad, 0x72, 0xb1, 0x2a, 0x6d, 0x96, 0x76, 0x6f, 0xe4, 0x3c, 0xc9, 0x41, 0x17, 0xb7,
-	0x74, 0x06, 0x52, 0xde, 0x81, 0xf5, 0x81, 0x6c, 0x13, 0x2d, 0x68, 0x03, 0x16, 0x3c, 0x7e, 0xd7,
-	0xb0, 0x4d, 0x56, 0xe1, 0xa2, 0x0e, 0xc9, 0xd5, 0xa1, 0xa9, 0x1c, 0xc1, 0xf5, 0x54, 0x73, 0x09,
-	0xb5, 0xe3, 0xfc, 0x50, 0x19, 0x66, 0x0d, 0xd3, 0xf4, 0x31, 0xa5, 0x5c, 0x6f, 0x72, 0x54, 0x6e,
-	0xc3, 0xcd, 0x1c, 0x46, 0x51, 0xdd, 0xdf, 0x24, 0x98, 0xad, 0x53, 0xeb, 0x43, 0x12, 0x8c, 0xcf,
-	0x0e, 0xad, 0xc1, 0xb5, 0x0e, 0x09, 0xb0, 0xcf, 0x63, 0xc4, 0x07, 0xf4, 0x16, 0xcc, 0x10, 0x2f,
-	0xaa, 0x44, 0x79, 0x9a, 0x15, 0xe8, 0x76, 0x4e, 0x81, 0x22, 0xfe, 0x27, 0x0c, 0xa4, 0x73, 0x70,
-	0xe6, 0x8d, 0x8a, 0x7d, 0x6f, 0x94, 0x54, 0xfc, 0xda, 0x24, 0x15, 0x5f, 0x65, 0xe3, 0x2e, 0x8a,
-	0x20, 0x54, 0xed, 0x31, 0x51, 0x11, 0x66, 0xbc, 0xa8, 0x57, 0x60, 0x86, 0xda, 0x96, 0x2b, 0x54,
-	0xf1, 0x13, 0xa7, 0x65, 0x71, 0x12, 0x5a, 0x15, 0x50, 0x9d, 0x5a, 0x8f, 0xb1, 0xd1, 0xc1, 0x35,
-	0x91, 0x4d, 0xba, 0xf6, 0x52, 0xb6, 0xf6, 0xb7, 0x40, 0x1e, 0xc4, 0x0b, 0xb6, 0x2e, 0x7b, 0xeb,
-	0xb8, 0xf7, 0xf7, 0xb1, 0x4b, 0x89, 0x4f, 0xcf, 0x6c, 0x6f, 0xcc, 0x3f, 0xb8, 0x0f, 0xd0, 0x12,
-	0x58, 0xfe, 0xfb, 0xe5, 0x15, 0xfc, 0x8a, 0x90, 0x4f, 0x88, 0x94, 0x1b, 0xef, 0x89, 0xfe, 0xc8,
-	0x22, 0xb1, 0x5f, 0x25, 0x98, 0xab, 0x53, 0xeb, 0x3d, 0xdf, 0x70, 0x83, 0x31, 0xe9, 0x94, 0x61,
-	0xd6, 0x8a, 0x60, 0x18, 0x27, 0x7d, 0xc7, 0x8f, 0xe8, 0x6b, 0x58, 0xe2, 0xb0, 0xaf, 0x0c, 0xd1,
-	0x1c, 0xc3, 0x46, 0xc5, 0xbb, 0x2f, 0x9e, 0x6f, 0xbf, 0x3d, 0x76, 0x1a, 0x77, 0xd3, 0x1b, 0xf4,
-	0x61, 0x9a, 0x5c, 0xcf, 0xc6, 0x52, 0x10, 0x1b, 0xc0, 0x4c, 0x80, 0x50, 0x85, 0x61, 0xbe, 0x4e,
-	0x2d, 0x1d, 0x77, 0xc8, 0x33, 0xfc, 0x9f, 0x55, 0x55, 0x61, 0xd1, 0xa1, 0x56, 0x23, 0xda, 0x0b,
-	0x8d, 0xd0, 0x6f, 0x33, 0x51, 0xf3, 0x3a, 0x38, 0xd4, 0x3a, 0xee, 0x79, 0xf8, 0xc4, 0x6f, 0x2b,
-	0xd7, 0xd9, 0xb4, 0x8a, 0xc3, 0x24, 0xb1, 0xb7, 0xb6, 0xa0, 0xc8, 0x9a, 0x71, 0x0d, 0x56, 0x0e,
-	0x3e, 0x3e, 0xd8, 0x6f, 0x9c, 0xbc, 0xff, 0xf4, 0xe8, 0x60, 0xff, 0xb0, 0x76, 0x78, 0xf0, 0x68,
-	0xa5, 0x80, 0x16, 0x61, 0x8e, 0xdd, 0x1e, 0xeb, 0x9f, 0xac, 0x48, 0xbb, 0xdf, 0xce, 0xc3, 0x74,
-	0x9d, 0x5a, 0xe8, 0x33, 0x58, 0xcc, 0xac, 0x70, 0x25, 0x6f, 0x15, 0x64, 0xd7, 0xaa, 0xbc, 0x35,
-	0x1e, 0x23, 0x66, 0x51, 0x07, 0xd6, 0x72, 0xd7, 0xee, 0x10, 0x8e, 0x3c, 0xac, 0xbc, 0x3b, 0x39,
-	0x56, 0xc4, 0x35, 0x60, 0x29, 0xbb, 0x19, 0xef, 0xe4, 0x93, 0x64, 0x40, 0xf2, 0xfd, 0x09, 0x40,
-	0x69, 0x69, 0xb9, 0xeb, 0x6d, 0x6b, 0x14, 0x49, 0x16, 0x3b, 0x4c, 0xda, 0xa8, 0x55, 0x85, 0x4c,
-	0x28, 0xf5, 0xad, 0xa9, 0xbb, 0xf9, 0x2c, 0x59, 0x94, 0xfc, 0x60, 0x12, 0x94, 0x88, 0xf2, 0x39,
-	0xac, 0x0c, 0x2c, 0x88, 0x7b, 0xa3, 0x1f, 0x42, 0x44, 0x52, 0x27, 0xc3, 0x89, 0x58, 0x35, 0x28,
-	0xb2, 0xd5, 0x20, 0xe7, 0xfb, 0x45, 0x36, 0x59, 0x19, 0x6e, 0x4b, 0xf3, 0xb0, 0x1f, 0x60, 0x08,
-	0x4f, 0x64, 0x1b, 0xc6, 0x93, 0x9e, 0xc0, 0xc8, 0x82, 0xe5, 0xfe, 0xf1, 0xfb, 0x5a, 0xbe, 0x5b,
-	0x1f, 0x4c, 0xde, 0x9e, 0x08, 0x96, 0x2e, 0xf2, 0xc0, 0x64, 0xbe, 0x37, 0xaa, 0x25, 0xae, 0x70,
-	0xc3, 0x8a, 0x3c, 0x6c, 0xde, 0xa2, 0x43, 0xb8, 0x16, 0xcf, 0xda, 0x9b, 0xf9, 0x8e, 0xcc, 0x28,
-	0xdf, 0x19, 0x61, 0x14, 0x54, 0x8f, 0x61, 0x26, 0x99, 0x70, 0xf9, 0xf0, 0xd8, 0x2a, 0xdf, 0x1d,
-	0x65, 0x4d, 0xd8, 0xf6, 0x9e, 0x9c, 0xff, 0x5d, 0x29, 0xfc, 0x78, 0x51, 0x29, 0x9c, 0x5f, 0x54,
-	0xa4, 0x97, 0x17, 0x15, 0xe9, 0xaf, 0x8b, 0x8a, 0xf4, 0xc3, 0x65, 0xa5, 0xf0, 0xf2, 0xb2, 0x52,
-	0xf8, 0xfd, 0xb2, 0x52, 0xf8, 0x74, 0xfb, 0x5f, 0x0d, 0xed, 0xe6, 0x0c, 0x9b, 0xfa, 0x6f, 0xfc,
-	0x13, 0x00, 0x00, 0xff, 0xff, 0x0b, 0x03, 0x03, 0x8d, 0x62, 0x0d, 0x00, 0x00,
+	// 1384 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x73, 0xdb, 0xc4,
+	0x17, 0x8f, 0x62, 0xe7, 0x87, 0x5f, 0xd2, 0x34, 0x51, 0x33, 0xdf, 0x3a, 0x6a, 0x6a, 0xe7, 0xab,
+	0x96, 0x4e, 0x27, 0x6d, 0x64, 0x62, 0x60, 0x38, 0xc0, 0xd0, 0x69, 0xdc, 0x84, 0xc9, 0x4c, 0x4d,
+	0x83, 0x9a, 0xf2, 0xeb, 0x50, 0xcf, 0xda, 0xda, 0x28, 0xa2, 0x96, 0x56, 0x68, 0xd7, 0x26, 0x81,
+	0x03, 0x67, 0x38, 0x30, 0xfd, 0x33, 0x18, 0x86, 0x03, 0x87, 0xde, 0xe1, 0xd8, 0xe9, 0xa9, 0xdc,
+	0x38, 0x51, 0x48, 0xff, 0x11, 0x46, 0xab, 0xd5, 0x46, 0xb2, 0xe5, 0x1f, 0x29, 0x03, 0xb7, 0xdd,
+	0x7d, 0x9f, 0xf7, 0x79, 0xef, 0xed, 0x7b, 0xbb, 0xef, 0x81, 0xd6, 0x6e, 0xba, 0x95, 0x03, 0xd2,
+	0xf1, 0x2c, 0xc4, 0x1c, 0xe2, 0x55, 0xba, 0x9b, 0x15, 0x76, 0x64, 0xf8, 0x01, 0x61, 0x44, 0x5d,
+	0x6a, 0x37, 0x5d, 0xe3, 0x54, 0x66, 0x74, 0x37, 0xb5, 0x65, 0x9b, 0xd8, 0x84, 0x4b, 0x2b, 0xe1,
+	0x2a, 0x02, 0x6a, 0x7a, 0x3f, 0x49, 0x42, 0x2d, 0xc2, 0x94, 0x5a, 0x84, 0xba, 0x84, 0x56, 0x9a,
+	0x88, 0xe2, 0x4a, 0x77, 0xb3, 0x89, 0x19, 0xda, 0xac, 0xb4, 0x88, 0x13, 0xcb, 0x57, 0x6c, 0x42,
+	0xec, 0x36, 0xae, 0xf0, 0x5d, 0xb3, 0x73, 0x50, 0x41, 0xde, 0xb1, 0x10, 0x95, 0x7b, 0x45, 0xcc,
+	0x71, 0x31, 0x65, 0xc8, 0xf5, 0x63, 0xdd, 0x88, 0xbb, 0x11, 0x39, 0x16, 0x6d, 0x22, 0x91, 0xfe,
+	0xbd, 0x02, 0xe7, 0xeb, 0xd4, 0xde, 0xe9, 0x78, 0xd6, 0x7e, 0x80, 0x11, 0xed, 0x04, 0xc7, 0xaa,
+	0x0a, 0xf9, 0x83, 0x80, 0xb8, 0x45, 0x65, 0x4d, 0xb9, 0x5e, 0x30, 0xf9, 0x5a, 0xb5, 0x61, 0x1a,
+	0xb9, 0xa4, 0xe3, 0xb1, 0xe2, 0xe4, 0x5a, 0xee, 0xfa, 0x5c, 0x75, 0xc5, 0x10, 0x34, 0xa1, 0xbf,
+	0x86, 0xf0, 0xd7, 0xa8, 0x11, 0xc7, 0xdb, 0x7a, 0xf3, 0xe9, 0x1f, 0xe5, 0x89, 0x1f, 0x5f, 0x94,
+	0x6f, 0xda, 0x0e, 0x3b, 0xec, 0x34, 0x8d, 0x16, 0x71, 0x2b, 0x3b, 0x8e, 0x47, 0x5b, 0x87, 0x0e,
+	0xaa, 0x1c, 0x88, 0xc5, 0x06, 0xb5, 0x1e, 0x55, 0xd8, 0xb1, 0x8f, 0x29, 0x57, 0xa2, 0xa6, 0xa0,
+	0xd7, 0x57, 0xe0, 0x62, 0x8f, 0x3f, 0x26, 0xa6, 0x3e, 0xf1, 0x28, 0xd6, 0x7f, 0x56, 0xb8, 0xec,
+	0x63, 0x87, 0x1d, 0x5a, 0x01, 0xfa, 0x72, 0x27, 0x20, 0xae, 0xf4, 0x79, 0x15, 0x0a, 0xa8, 0xc3,
+	0x0e, 0x49, 0xe0, 0xb0, 0x63, 0xe1, 0xf8, 0xe9, 0x81, 0xba, 0x00, 0x93, 0x8c, 0x14, 0x27, 0xf9,
+	0xf1, 0x24, 0x23, 0x89, 0x68, 0x72, 0xff, 0x6e, 0x34, 0xff, 0x87, 0xf2, 0x00, 0x8f, 0x65, 0x54,
+	0xdf, 0xe6, 0x78, 0x54, 0xb5, 0x00, 0x23, 0x86, 0x63, 0xe9, 0x7d, 0x16, 0x60, 0xe4, 0x8e, 0x88,
+	0x6a, 0x15, 0x0a, 0x01, 0x6e, 0x39, 0xbe, 0x83, 0x79, 0x5a, 0xb8, 0x54, 0x1e, 0xfc, 0x67, 0x31,
+	0xaa, 0x35, 0x00, 0xca, 0x50, 0xc0, 0x1a, 0x61, 0xd9, 0x15, 0xf3, 0x6b, 0xca, 0xf5, 0xb9, 0xaa,
+	0x66, 0x44, 0x35, 0x69, 0xc4, 0x35, 0x69, 0xec, 0xc7, 0x35, 0xb9, 0x35, 0x1b, 0x5a, 0x7b, 0xfc,
+	0xa2, 0xac, 0x98, 0x05, 0xae, 0x17, 0x4a, 0xd4, 0x5b, 0x30, 0x8b, 0x3d, 0x2b, 0xa2, 0x98, 0x3a,
+	0x03, 0xc5, 0x0c, 0xf6, 0x2c, 0x4e, 0x50, 0x03, 0x68, 0xb5, 0x9d, 0x83, 0x83, 0x88, 0x62, 0xfa,
+	0x2c, 0x5e, 0x70, 0xbd, 0x50, 0xa2, 0xbf, 0x07, 0xe5, 0x01, 0xa9, 0x88, 0xd3, 0xa5, 0x5e, 0x82,
+	0x02, 0xe5, 0x27, 0x0d, 0xc7, 0xe2, 0x29, 0xc9, 0x9b, 0xb3, 0xd1, 0xc1, 0xae, 0xa5, 0xef, 0x47,
+	0xa9, 0x44, 0x5e, 0x0b, 0xb7, 0xcf, 0x94, 0xca, 0x14, 0xeb, 0x64, 0x0f, 0x6b, 0x54, 0x44, 0x59,
+	0xac, 0xb2, 0x88, 0xbe, 0x81, 0xc5, 0x3a, 0xb5, 0x1f, 0xf8, 0x16, 0x62, 0xb8, 0x8e, 0xdd, 0x26,
+	0x0e, 0xe8, 0x08, 0x8b, 0x75, 0x58, 0x70, 0x39, 0xb0, 0xd1, 0xe1, 0x5a, 0x54, 0x3c, 0xec, 0x35,
+	0xa3, 0xef, 0x57, 0x33, 0x22, 0x46, 0x13, 0x7f, 0xd1, 0xc1, 0x94, 0x6d, 0xe5, 0xc3, 0x9b, 0x33,
+	0xcf, 0x45, 0xda, 0x91, 0x49, 0xaa, 0x6b, 0x50, 0xec, 0x75, 0x40, 0x3a, 0xf7, 0x5d, 0xf4, 0x6e,
+	0x23, 0xe1, 0x1d, 0xdc, 0x72, 0xa8, 0x43, 0xbc, 0x3d, 0xd2, 0x76, 0x5a, 0xa3, 0xde, 0xed, 0x87,
+	0x70, 0xde, 0x12, 0xf8, 0x86, 0xcf, 0x15, 0xf8, 0xe5, 0xcc, 0x55, 0x97, 0xfb, 0x32, 0x7b, 0xdb,
+	0x3b, 0xde, 0x52, 0x9f, 0x3d, 0xd9, 0x58, 0x48, 0x1b, 0x30, 0x17, 0xac, 0xd4, 0x5e, 0x5c, 0x66,
+	0x96, 0x2f, 0xd2, 0xdf, 0x9f, 0x14, 0x58, 0xaa, 0x53, 0xfb, 0x7e, 0xa7, 0xe9, 0x3a, 0x6c, 0x2f,
+	0x20, 0x3e, 0xa1, 0xa8, 0x1d, 0x7a, 0xea, 0xf3, 0x35, 0x0e, 0x68, 0x51, 0x59, 0xcb, 0x85, 0x9e,
+	0xca, 0x03, 0x55, 0x83, 0x59, 0x17, 0x33, 0x64, 0x21, 0x86, 0xc4, 0x53, 0x94, 0x7b, 0xf5, 0xf5,
+	0x50, 0x46, 0x29, 0xb2, 0x31, 0x15, 0x6f, 0x31, 0xd3, 0x7d, 0x53, 0xa2, 0xd4, 0x1b, 0x90, 0xc7,
+	0x47, 0xb8, 0xc5, 0x1f, 0xd3, 0x42, 0xf5, 0x62, 0x46, 0x4a, 0xb6, 0x8f, 0x70, 0xcb, 0xe4, 0x20,
+	0xfd, 0x5d, 0x58, 0xe9, 0xf3, 0x56, 0x96, 0x6b, 0x19, 0xe6, 0x7c, 0x71, 0x76, 0x5a, 0xb0, 0x10,
+	0x1f, 0xed, 0x5a, 0xfa, 0x1e, 0x5c, 0x48, 0xfc, 0x50, 0x32, 0xda, 0x51, 0x7a, 0x6a, 0x11, 0x66,
+	0x90, 0x65, 0x05, 0x98, 0x52, 0x11, 0x6f, 0xbc, 0xd5, 0x2f, 0xc3, 0xa5, 0x0c, 0x46, 0x79, 0xbb,
+	0xbf, 0x2a, 0x30, 0x53, 0xa7, 0xf6, 0x47, 0x84, 0x8d, 0xf6, 0x4e, 0x5d, 0x86, 0xa9, 0x2e, 0x61,
+	0x38, 0x10, 0x36, 0xa2, 0x8d, 0xfa, 0x16, 0x4c, 0x13, 0x3f, 0xbc, 0x89, 0x62, 0x8e, 0x5f, 0xd0,
+	0xe5, 0x8c, 0x0b, 0x0a, 0xf9, 0xef, 0x71, 0x90, 0x29, 0xc0, 0xa9, 0x1c, 0xe5, 0x7b, 0x72, 0x14,
+	0xdf, 0xf8, 0xd4, 0x38, 0x37, 0xbe, 0xc4, 0x7b, 0x66, 0x68, 0x41, 0x46, 0xb5, 0xc5, 0x83, 0x0a,
+	0x31, 0xa3, 0x83, 0xfa, 0x1f, 0x4c, 0x53, 0xc7, 0xf6, 0x64, 0x54, 0x62, 0x27, 0x68, 0xb9, 0x9d,
+	0x98, 0xd6, 0x00, 0xb5, 0x4e, 0xed, 0xbb, 0x18, 0x75, 0xf1, 0x8e, 0xf4, 0x26, 0x79, 0xf7, 0x4a,
+	0xfa, 0xee, 0x57, 0x41, 0xeb, 0xc7, 0x4b, 0xb6, 0xdf, 0xa2, 0x66, 0x7f, 0x07, 0xb7, 0xb1, 0x8d,
+	0x18, 0xe6, 0x29, 0x58, 0x85, 0x82, 0x15, 0xed, 0x49, 0x10, 0x3f, 0x40, 0x79, 0x10, 0x5e, 0x99,
+	0xd8, 0xe0, 0xb8, 0xac, 0xe3, 0x7d, 0xcf, 0xbf, 0x9f, 0xfb, 0xe7, 0xff, 0x7e, 0xfe, 0x15, 0xfe,
+	0x7d, 0x31, 0x2f, 0x24, 0x43, 0x92, 0xe1, 0xbe, 0xcd, 0x45, 0x26, 0xee, 0x92, 0x47, 0x5c, 0x20,
+	0x40, 0xe1, 0x0d, 0x0e, 0x8d, 0x5a, 0xfc, 0x11, 0x59, 0x8a, 0x92, 0xfb, 0x08, 0x2e, 0xc8, 0x6f,
+	0xa4, 0x86, 0x3d, 0x4a, 0x02, 0x7a, 0xe8, 0xf8, 0x23, 0xbe, 0xb3, 0xb0, 0x47, 0x49, 0xac, 0xf8,
+	0xc9, 0xb2, 0x6a, 0xf7, 0x94, 0x50, 0x7c, 0xb6, 0x09, 0x35, 0xf1, 0xbc, 0x7a, 0x2d, 0x4b, 0xc7,
+	0x7e, 0x51, 0x60, 0xb6, 0x4e, 0xed, 0xf7, 0x03, 0xe4, 0xb1, 0x11, 0xee, 0x14, 0x61, 0xc6, 0x0e,
+	0x61, 0x38, 0xce, 0x6d, 0xbc, 0x55, 0xbf, 0x86, 0x73, 0x02, 0xf6, 0x15, 0x92, 0xef, 0x6c, 0xd0,
+	0xaf, 0x7b, 0xeb, 0xd9, 0x93, 0x8d, 0x77, 0x46, 0x4e, 0x0e, 0x47, 0xc9, 0x91, 0xf7, 0x76, 0x92,
+	0xdc, 0x4c, 0xdb, 0xd2, 0x55, 0x58, 0x8c, 0x03, 0x90, 0x51, 0x61, 0x28, 0xc8, 0x8c, 0xbc, 0x72,
+	0x54, 0x6b, 0x30, 0xef, 0x52, 0xbb, 0x11, 0xce, 0x30, 0x8d, 0x4e, 0xd0, 0xe6, 0x41, 0x15, 0x4c,
+	0x70, 0xa9, 0xbd, 0x7f, 0xec, 0xe3, 0x07, 0x41, 0x5b, 0xbf, 0x00, 0x4b, 0xd2, 0x4c, 0x6c, 0x7b,
+	0x7d, 0x1d, 0xf2, 0xfc, 0x5d, 0x2f, 0xc3, 0xe2, 0xf6, 0x27, 0xdb, 0xb5, 0xc6, 0x83, 0x0f, 0xee,
+	0xef, 0x6d, 0xd7, 0x76, 0x77, 0x76, 0xb7, 0xef, 0x2c, 0x4e, 0xa8, 0xf3, 0x30, 0xcb, 0x4f, 0xf7,
+	0xcd, 0x4f, 0x17, 0x95, 0xea, 0xf3, 0x79, 0xc8, 0xd5, 0xa9, 0xad, 0x3e, 0x84, 0xf9, 0xd4, 0x48,
+	0xad, 0x67, 0x75, 0xd5, 0xf4, 0x98, 0xab, 0xad, 0x8f, 0xc6, 0xc8, 0x6f, 0xbd, 0x0b, 0xcb, 0x99,
+	0x63, 0xf0, 0x00, 0x8e, 0x2c, 0xac, 0x56, 0x1d, 0x1f, 0x9b, 0xb4, 0x9b, 0x39, 0xa8, 0x0e, 0xb0,
+	0x9b, 0x85, 0xd5, 0xaa, 0xe3, 0x63, 0x53, 0x76, 0xb3, 0xa6, 0xaa, 0x41, 0x76, 0x33, 0xb0, 0x5a,
+	0x75, 0x7c, 0xac, 0xb4, 0x8b, 0xe0, 0x5c, 0x7a, 0xa8, 0xba, 0x92, 0x4d, 0x92, 0x02, 0x69, 0x37,
+	0xc6, 0x00, 0x25, 0x43, 0xcb, 0x9c, 0x8c, 0xd6, 0x87, 0x91, 0xa4, 0xb1, 0x5a, 0x75, 0x7c, 0xac,
+	0xb4, 0x6b, 0xc1, 0x42, 0xcf, 0x84, 0x73, 0x35, 0x9b, 0x25, 0x8d, 0xd2, 0x6e, 0x8e, 0x83, 0x92,
+	0x56, 0x3e, 0x87, 0xc5, 0xbe, 0xd9, 0xe2, 0xda, 0xf0, 0xc2, 0x93, 0x96, 0x8c, 0xf1, 0x70, 0xd2,
+	0xd6, 0x0e, 0xe4, 0x79, 0x4b, 0xd3, 0xb2, 0xf5, 0x42, 0x99, 0xa6, 0x0f, 0x96, 0x25, 0x79, 0xf8,
+	0x83, 0x1f, 0xc0, 0x13, 0xca, 0x34, 0x7d, 0xb0, 0x4c, 0xf2, 0xd8, 0x70, 0xbe, 0xb7, 0x73, 0xbf,
+	0x96, 0xad, 0xd6, 0x03, 0xd3, 0x36, 0xc6, 0x82, 0x49, 0x43, 0x0f, 0x61, 0x3e, 0xd5, 0xd3, 0x07,
+	0x38, 0x97, 0xc4, 0x68, 0xeb, 0xa3, 0x31, 0xc9, 0x12, 0xcd, 0xec, 0xa2, 0x03, 0x38, 0xb2, 0xb0,
+	0x5a, 0x75, 0x7c, 0x6c, 0xb2, 0x78, 0xfa, 0x3a, 0xec, 0xb5, 0x61, 0xa5, 0x7e, 0x8a, 0xd3, 0x8c,
+	0xf1, 0x70, 0xd2, 0xd6, 0x2e, 0x4c, 0x45, 0x3d, 0xf3, 0x52, 0xb6, 0x22, 0x17, 0x6a, 0x57, 0x86,
+	0x08, 0x25, 0xd5, 0x5d, 0x98, 0x8e, 0x3b, 0xd5, 0xb0, 0xa0, 0xb5, 0xab, 0xc3, 0xa4, 0x31, 0xdb,
+	0xd6, 0xbd, 0xa7, 0x7f, 0x95, 0x26, 0x7e, 0x38, 0x29, 0x4d, 0x3c, 0x3d, 0x29, 0x29, 0xcf, 0x4f,
+	0x4a, 0xca, 0x9f, 0x27, 0x25, 0xe5, 0xf1, 0xcb, 0xd2, 0xc4, 0xf3, 0x97, 0xa5, 0x89, 0xdf, 0x5f,
+	0x96, 0x26, 0x3e, 0xdb, 0x38, 0x53, 0xf3, 0x6d, 0x4e, 0xf3, 0xee, 0xfd, 0xc6, 0xdf, 0x03, 0x00,
+	0xb2, 0x06, 0xb0, 0x8f, 0xdb, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1134,6 +1483,12 @@ type MsgClient interface {
 	FundTreasury(ctx context.Context, in *MsgFundTreasury, opts ...grpc.CallOption) (*MsgFundTreasuryResponse, error)
 	// WithdrawFromTreasury defines a method to withdraw coins from the treasury.
 	WithdrawFromTreasury(ctx context.Context, in *MsgWithdrawFromTreasury, opts ...grpc.CallOption) (*MsgWithdrawFromTreasuryResponse, error)
+	// CreateTreasuryStream defines a method to create a stream paying out coins
+	// from the treasury over time.
+	CreateTreasuryStream(ctx context.Context, in *MsgCreateTreasuryStream, opts ...grpc.CallOption) (*MsgCreateTreasuryStreamResponse, error)
+	// CancelTreasuryStream defines a method to cancel a treasury stream,
+	// returning the remainder to the treasury.
+	CancelTreasuryStream(ctx context.Context, in *MsgCancelTreasuryStream, opts ...grpc.CallOption) (*MsgCancelTreasuryStreamResponse, error)
 	// UpdateMembers updates the foundation members.
 	UpdateMembers(ctx context.Context, in *MsgUpdateMembers, opts ...grpc.CallOption) (*MsgUpdateMembersResponse, error)
 	// UpdateDecisionPolicy allows a group policy's decision policy to be updated.
@@ -1148,6 +1503,12 @@ type MsgClient interface {
 	Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error)
 	// LeaveFoundation allows a member to leave the foundation.
 	LeaveFoundation(ctx context.Context, in *MsgLeaveFoundation, opts ...grpc.CallOption) (*MsgLeaveFoundationResponse, error)
+	// DelegateVote allows a member to delegate its vote to another member for a
+	// time range. If there is already a delegation of the member, then it will
+	// be overwritten.
+	DelegateVote(ctx context.Context, in *MsgDelegateVote, opts ...grpc.CallOption) (*MsgDelegateVoteResponse, error)
+	// RevokeVoteDelegation revokes the vote delegation of a member.
+	RevokeVoteDelegation(ctx context.Context, in *MsgRevokeVoteDelegation, opts ...grpc.CallOption) (*MsgRevokeVoteDelegationResponse, error)
 	// UpdateCensorship updates censorship information.
 	UpdateCensorship(ctx context.Context, in *MsgUpdateCensorship, opts ...grpc.CallOption) (*MsgUpdateCensorshipResponse, error)
 	// Grant grants the provided authorization to the grantee with authority of
@@ -1185,6 +1546,24 @@ func (c *msgClient) WithdrawFromTreasury(ctx context.Context, in *MsgWithdrawFro
 	return out, nil
 }
 
+func (c *msgClient) CreateTreasuryStream(ctx context.Context, in *MsgCreateTreasuryStream, opts ...grpc.CallOption) (*MsgCreateTreasuryStreamResponse, error) {
+	out := new(MsgCreateTreasuryStreamResponse)
+	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Msg/CreateTreasuryStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelTreasuryStream(ctx context.Context, in *MsgCancelTreasuryStream, opts ...grpc.CallOption) (*MsgCancelTreasuryStreamResponse, error) {
+	out := new(MsgCancelTreasuryStreamResponse)
+	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Msg/CancelTreasuryStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateMembers(ctx context.Context, in *MsgUpdateMembers, opts ...grpc.CallOption) (*MsgUpdateMembersResponse, error) {
 	out := new(MsgUpdateMembersResponse)
 	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Msg/UpdateMembers", in, out, opts...)
@@ -1248,6 +1627,24 @@ func (c *msgClient) LeaveFoundation(ctx context.Context, in *MsgLeaveFoundation,
 	return out, nil
 }
 
+func (c *msgClient) DelegateVote(ctx context.Context, in *MsgDelegateVote, opts ...grpc.CallOption) (*MsgDelegateVoteResponse, error) {
+	out := new(MsgDelegateVoteResponse)
+	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Msg/DelegateVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeVoteDelegation(ctx context.Context, in *MsgRevokeVoteDelegation, opts ...grpc.CallOption) (*MsgRevokeVoteDelegationResponse, error) {
+	out := new(MsgRevokeVoteDelegationResponse)
+	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Msg/RevokeVoteDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateCensorship(ctx context.Context, in *MsgUpdateCensorship, opts ...grpc.CallOption) (*MsgUpdateCensorshipResponse, error) {
 	out := new(MsgUpdateCensorshipResponse)
 	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Msg/UpdateCensorship", in, out, opts...)
@@ -1281,6 +1678,12 @@ type MsgServer interface {
 	FundTreasury(context.Context, *MsgFundTreasury) (*MsgFundTreasuryResponse, error)
 	// WithdrawFromTreasury defines a method to withdraw coins from the treasury.
 	WithdrawFromTreasury(context.Context, *MsgWithdrawFromTreasury) (*MsgWithdrawFromTreasuryResponse, error)
+	// CreateTreasuryStream defines a method to create a stream paying out coins
+	// from the treasury over time.
+	CreateTreasuryStream(context.Context, *MsgCreateTreasuryStream) (*MsgCreateTreasuryStreamResponse, error)
+	// CancelTreasuryStream defines a method to cancel a treasury stream,
+	// returning the remainder to the treasury.
+	CancelTreasuryStream(context.Context, *MsgCancelTreasuryStream) (*MsgCancelTreasuryStreamResponse, error)
 	// UpdateMembers updates the foundation members.
 	UpdateMembers(context.Context, *MsgUpdateMembers) (*MsgUpdateMembersResponse, error)
 	// UpdateDecisionPolicy allows a group policy's decision policy to be updated.
@@ -1295,6 +1698,12 @@ type MsgServer interface {
 	Exec(context.Context, *MsgExec) (*MsgExecResponse, error)
 	// LeaveFoundation allows a member to leave the foundation.
 	LeaveFoundation(context.Context, *MsgLeaveFoundation) (*MsgLeaveFoundationResponse, error)
+	// DelegateVote allows a member to delegate its vote to another member for a
+	// time range. If there is already a delegation of the member, then it will
+	// be overwritten.
+	DelegateVote(context.Context, *MsgDelegateVote) (*MsgDelegateVoteResponse, error)
+	// RevokeVoteDelegation revokes the vote delegation of a member.
+	RevokeVoteDelegation(context.Context, *MsgRevokeVoteDelegation) (*MsgRevokeVoteDelegationResponse, error)
 	// UpdateCensorship updates censorship information.
 	UpdateCensorship(context.Context, *MsgUpdateCensorship) (*MsgUpdateCensorshipResponse, error)
 	// Grant grants the provided authorization to the grantee with authority of
@@ -1316,6 +1725,12 @@ func (*UnimplementedMsgServer) FundTreasury(ctx context.Context, req *MsgFundTre
 func (*UnimplementedMsgServer) WithdrawFromTreasury(ctx context.Context, req *MsgWithdrawFromTreasury) (*MsgWithdrawFromTreasuryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFromTreasury not implemented")
 }
+func (*UnimplementedMsgServer) CreateTreasuryStream(ctx context.Context, req *MsgCreateTreasuryStream) (*MsgCreateTreasuryStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTreasuryStream not implemented")
+}
+func (*UnimplementedMsgServer) CancelTreasuryStream(ctx context.Context, req *MsgCancelTreasuryStream) (*MsgCancelTreasuryStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTreasuryStream not implemented")
+}
 func (*UnimplementedMsgServer) UpdateMembers(ctx context.Context, req *MsgUpdateMembers) (*MsgUpdateMembersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateMembers not implemented")
 }
@@ -1337,6 +1752,12 @@ func (*UnimplementedMsgServer) Exec(ctx context.Context, req *MsgExec) (*MsgExec
 func (*UnimplementedMsgServer) LeaveFoundation(ctx context.Context, req *MsgLeaveFoundation) (*MsgLeaveFoundationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaveFoundation not implemented")
 }
+func (*UnimplementedMsgServer) DelegateVote(ctx context.Context, req *MsgDelegateVote) (*MsgDelegateVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateVote not implemented")
+}
+func (*UnimplementedMsgServer) RevokeVoteDelegation(ctx context.Context, req *MsgRevokeVoteDelegation) (*MsgRevokeVoteDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeVoteDelegation not implemented")
+}
 func (*UnimplementedMsgServer) UpdateCensorship(ctx context.Context, req *MsgUpdateCensorship) (*MsgUpdateCensorshipResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCensorship not implemented")
 }
@@ -1387,53 +1808,89 @@ func _Msg_WithdrawFromTreasury_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_UpdateMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateMembers)
+func _Msg_CreateTreasuryStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateTreasuryStream)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).UpdateMembers(ctx, in)
+		return srv.(MsgServer).CreateTreasuryStream(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lbm.foundation.v1.Msg/UpdateMembers",
+		FullMethod: "/lbm.foundation.v1.Msg/CreateTreasuryStream",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateMembers(ctx, req.(*MsgUpdateMembers))
+		return srv.(MsgServer).CreateTreasuryStream(ctx, req.(*MsgCreateTreasuryStream))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_UpdateDecisionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateDecisionPolicy)
+func _Msg_CancelTreasuryStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelTreasuryStream)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).UpdateDecisionPolicy(ctx, in)
+		return srv.(MsgServer).CancelTreasuryStream(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lbm.foundation.v1.Msg/UpdateDecisionPolicy",
+		FullMethod: "/lbm.foundation.v1.Msg/CancelTreasuryStream",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateDecisionPolicy(ctx, req.(*MsgUpdateDecisionPolicy))
+		return srv.(MsgServer).CancelTreasuryStream(ctx, req.(*MsgCancelTreasuryStream))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_SubmitProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitProposal)
+func _Msg_UpdateMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMembers)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).SubmitProposal(ctx, in)
+		return srv.(MsgServer).UpdateMembers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lbm.foundation.v1.Msg/SubmitProposal",
+		FullMethod: "/lbm.foundation.v1.Msg/UpdateMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMembers(ctx, req.(*MsgUpdateMembers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateDecisionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDecisionPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDecisionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.foundation.v1.Msg/UpdateDecisionPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDecisionPolicy(ctx, req.(*MsgUpdateDecisionPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.foundation.v1.Msg/SubmitProposal",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(MsgServer).SubmitProposal(ctx, req.(*MsgSubmitProposal))
@@ -1513,6 +1970,42 @@ func _Msg_LeaveFoundation_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DelegateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.foundation.v1.Msg/DelegateVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateVote(ctx, req.(*MsgDelegateVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeVoteDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeVoteDelegation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeVoteDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.foundation.v1.Msg/RevokeVoteDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeVoteDelegation(ctx, req.(*MsgRevokeVoteDelegation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateCensorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateCensorship)
 	if err := dec(in); err != nil {
@@ -1579,6 +2072,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "WithdrawFromTreasury",
 			Handler:    _Msg_WithdrawFromTreasury_Handler,
 		},
+		{
+			MethodName: "CreateTreasuryStream",
+			Handler:    _Msg_CreateTreasuryStream_Handler,
+		},
+		{
+			MethodName: "CancelTreasuryStream",
+			Handler:    _Msg_CancelTreasuryStream_Handler,
+		},
 		{
 			MethodName: "UpdateMembers",
 			Handler:    _Msg_UpdateMembers_Handler,
@@ -1607,6 +2108,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LeaveFoundation",
 			Handler:    _Msg_LeaveFoundation_Handler,
 		},
+		{
+			MethodName: "DelegateVote",
+			Handler:    _Msg_DelegateVote_Handler,
+		},
+		{
+			MethodName: "RevokeVoteDelegation",
+			Handler:    _Msg_RevokeVoteDelegation_Handler,
+		},
 		{
 			MethodName: "UpdateCensorship",
 			Handler:    _Msg_UpdateCensorship_Handler,
@@ -1765,6 +2274,167 @@ func (m *MsgWithdrawFromTreasuryResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCreateTreasuryStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateTreasuryStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateTreasuryStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CliffTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CliffTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateTreasuryStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateTreasuryStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateTreasuryStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelTreasuryStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelTreasuryStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelTreasuryStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelTreasuryStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelTreasuryStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelTreasuryStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgUpdateMembers) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2227,7 +2897,7 @@ func (m *MsgLeaveFoundationResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateCensorship) Marshal() (dAtA []byte, err error) {
+func (m *MsgDelegateVote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2237,37 +2907,50 @@ func (m *MsgUpdateCensorship) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateCensorship) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDelegateVote) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateCensorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDelegateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Censorship.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err5 != nil {
+		return 0, err5
 	}
+	i -= n5
+	i = encodeVarintTx(dAtA, i, uint64(n5))
 	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	dAtA[i] = 0x22
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTx(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateCensorshipResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDelegateVoteResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2277,12 +2960,12 @@ func (m *MsgUpdateCensorshipResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateCensorshipResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDelegateVoteResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateCensorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDelegateVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2290,7 +2973,7 @@ func (m *MsgUpdateCensorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgGrant) Marshal() (dAtA []byte, err error) {
+func (m *MsgRevokeVoteDelegation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2300,46 +2983,27 @@ func (m *MsgGrant) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgGrant) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRevokeVoteDelegation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRevokeVoteDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Authorization != nil {
-		{
-			size, err := m.Authorization.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Grantee) > 0 {
-		i -= len(m.Grantee)
-		copy(dAtA[i:], m.Grantee)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgGrantResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgRevokeVoteDelegationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2349,12 +3013,12 @@ func (m *MsgGrantResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgGrantResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRevokeVoteDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgGrantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRevokeVoteDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2362,7 +3026,7 @@ func (m *MsgGrantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRevoke) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateCensorship) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2372,21 +3036,156 @@ func (m *MsgRevoke) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRevoke) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateCensorship) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRevoke) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateCensorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.MsgTypeUrl) > 0 {
-		i -= len(m.MsgTypeUrl)
-		copy(dAtA[i:], m.MsgTypeUrl)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrl)))
-		i--
+	{
+		size, err := m.Censorship.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCensorshipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCensorshipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCensorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Authorization != nil {
+		{
+			size, err := m.Authorization.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevoke) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevoke) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevoke) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
 		dAtA[i] = 0x1a
 	}
 	if len(m.Grantee) > 0 {
@@ -2500,6 +3299,72 @@ func (m *MsgWithdrawFromTreasuryResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCreateTreasuryStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CliffTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateTreasuryStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		n += 1 + sovTx(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *MsgCancelTreasuryStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StreamId != 0 {
+		n += 1 + sovTx(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *MsgCancelTreasuryStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgUpdateMembers) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2701,6 +3566,58 @@ func (m *MsgLeaveFoundationResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgDelegateVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDelegateVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeVoteDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeVoteDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgUpdateCensorship) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3155,7 +4072,7 @@ func (m *MsgWithdrawFromTreasuryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateMembers) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateTreasuryStream) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3178,10 +4095,10 @@ func (m *MsgUpdateMembers) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMembers: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateTreasuryStream: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMembers: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateTreasuryStream: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3218,9 +4135,9 @@ func (m *MsgUpdateMembers) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemberUpdates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3230,28 +4147,159 @@ func (m *MsgUpdateMembers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MemberUpdates = append(m.MemberUpdates, MemberRequest{})
-			if err := m.MemberUpdates[len(m.MemberUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CliffTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
 			if err != nil {
 				return err
@@ -3271,7 +4319,7 @@ func (m *MsgUpdateMembers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateMembersResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateTreasuryStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3294,12 +4342,31 @@ func (m *MsgUpdateMembersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMembersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateTreasuryStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateTreasuryStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3321,7 +4388,7 @@ func (m *MsgUpdateMembersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateDecisionPolicy) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelTreasuryStream) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3344,10 +4411,10 @@ func (m *MsgUpdateDecisionPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateDecisionPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelTreasuryStream: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelTreasuryStream: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3383,10 +4450,10 @@ func (m *MsgUpdateDecisionPolicy) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
 			}
-			var msglen int
+			m.StreamId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3396,28 +4463,11 @@ func (m *MsgUpdateDecisionPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.StreamId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DecisionPolicy == nil {
-				m.DecisionPolicy = &types1.Any{}
-			}
-			if err := m.DecisionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3439,7 +4489,7 @@ func (m *MsgUpdateDecisionPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateDecisionPolicyResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelTreasuryStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3462,10 +4512,10 @@ func (m *MsgUpdateDecisionPolicyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateDecisionPolicyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelTreasuryStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateDecisionPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelTreasuryStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3489,7 +4539,7 @@ func (m *MsgUpdateDecisionPolicyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateMembers) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3512,15 +4562,15 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateMembers: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateMembers: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3548,13 +4598,13 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposers = append(m.Proposers, string(dAtA[iNdEx:postIndex]))
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberUpdates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3564,59 +4614,784 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.MemberUpdates = append(m.MemberUpdates, MemberRequest{})
+			if err := m.MemberUpdates[len(m.MemberUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMembersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMembersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DecisionPolicy == nil {
+				m.DecisionPolicy = &types1.Any{}
+			}
+			if err := m.DecisionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDecisionPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDecisionPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDecisionPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposers = append(m.Proposers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types1.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exec", wireType)
+			}
+			m.Exec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exec |= Exec(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Messages = append(m.Messages, &types1.Any{})
-			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Exec", wireType)
 			}
@@ -3656,7 +5431,7 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3679,31 +5454,12 @@ func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
-			}
-			m.ProposalId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3725,7 +5481,7 @@ func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawProposal) Unmarshal(dAtA []byte) error {
+func (m *MsgExec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3748,10 +5504,10 @@ func (m *MsgWithdrawProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3775,7 +5531,7 @@ func (m *MsgWithdrawProposal) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3803,7 +5559,7 @@ func (m *MsgWithdrawProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3826,7 +5582,7 @@ func (m *MsgWithdrawProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3849,10 +5605,10 @@ func (m *MsgWithdrawProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExecResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3876,7 +5632,7 @@ func (m *MsgWithdrawProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVote) Unmarshal(dAtA []byte) error {
+func (m *MsgLeaveFoundation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3899,85 +5655,15 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLeaveFoundation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLeaveFoundation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
-			}
-			m.ProposalId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
-			}
-			m.Option = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Option |= VoteOption(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4005,27 +5691,8 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exec", wireType)
-			}
-			m.Exec = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Exec |= Exec(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4047,7 +5714,7 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgLeaveFoundationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4070,10 +5737,10 @@ func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLeaveFoundationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLeaveFoundationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4097,7 +5764,7 @@ func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExec) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4120,17 +5787,17 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExec: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
-			m.ProposalId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4140,14 +5807,27 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4175,7 +5855,73 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4198,7 +5944,7 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateVoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4221,10 +5967,10 @@ func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateVoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4248,7 +5994,7 @@ func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLeaveFoundation) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeVoteDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4271,15 +6017,15 @@ func (m *MsgLeaveFoundation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLeaveFoundation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeVoteDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLeaveFoundation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeVoteDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4307,7 +6053,7 @@ func (m *MsgLeaveFoundation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4330,7 +6076,7 @@ func (m *MsgLeaveFoundation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLeaveFoundationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeVoteDelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4353,10 +6099,10 @@ func (m *MsgLeaveFoundationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLeaveFoundationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeVoteDelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLeaveFoundationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeVoteDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
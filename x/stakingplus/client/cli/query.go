@@ -0,0 +1,147 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Finschia/finschia-sdk/client"
+	"github.com/Finschia/finschia-sdk/client/flags"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	stakingcli "github.com/Finschia/finschia-sdk/x/staking/client/cli"
+	"github.com/Finschia/finschia-sdk/x/stakingplus"
+)
+
+// Allow-list flags
+const (
+	FlagValidator = "validator"
+)
+
+// NewQueryCmd returns the query commands of the staking module,
+// including the ones of stakingplus.
+func NewQueryCmd() *cobra.Command {
+	queryCmd := stakingcli.GetQueryCmd()
+
+	queryCmd.AddCommand(
+		NewQueryCmdAllowListEnabled(),
+		NewQueryCmdAllowedDelegators(),
+		NewQueryCmdIsAllowedDelegator(),
+	)
+
+	return queryCmd
+}
+
+func NewQueryCmdAllowListEnabled() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allow-list-enabled",
+		Args:  cobra.NoArgs,
+		Short: "Query whether the delegator allow-list is enforced",
+		Long: `Query whether the delegator allow-list is enforced
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := stakingplus.NewQueryClient(clientCtx)
+
+			req := stakingplus.QueryAllowListEnabledRequest{}
+			res, err := queryClient.AllowListEnabled(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewQueryCmdAllowedDelegators() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allowed-delegators",
+		Args:  cobra.NoArgs,
+		Short: "Query the delegators on the allow-list",
+		Long: `Query the delegators on the allow-list
+
+It queries the allow-list of the validator given by --validator, or the global
+allow-list if the flag is omitted.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := stakingplus.NewQueryClient(clientCtx)
+
+			validator, err := cmd.Flags().GetString(FlagValidator)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := stakingplus.QueryAllowedDelegatorsRequest{
+				ValidatorAddress: validator,
+				Pagination:       pageReq,
+			}
+			res, err := queryClient.AllowedDelegators(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "allowed delegators")
+	cmd.Flags().String(FlagValidator, "", "operator address of the validator")
+	return cmd
+}
+
+func NewQueryCmdIsAllowedDelegator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "is-allowed-delegator [validator-address] [delegator-address]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query whether a delegator may delegate to a validator",
+		Long: `Query whether a delegator may delegate to a validator
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := stakingplus.NewQueryClient(clientCtx)
+
+			validator := args[0]
+			if _, err := sdk.ValAddressFromBech32(validator); err != nil {
+				return err
+			}
+
+			delegator := args[1]
+			if _, err := sdk.AccAddressFromBech32(delegator); err != nil {
+				return err
+			}
+
+			req := stakingplus.QueryIsAllowedDelegatorRequest{
+				ValidatorAddress: validator,
+				DelegatorAddress: delegator,
+			}
+			res, err := queryClient.IsAllowedDelegator(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
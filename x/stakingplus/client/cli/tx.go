@@ -0,0 +1,213 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Finschia/finschia-sdk/client"
+	"github.com/Finschia/finschia-sdk/client/flags"
+	"github.com/Finschia/finschia-sdk/client/tx"
+	stakingcli "github.com/Finschia/finschia-sdk/x/staking/client/cli"
+	"github.com/Finschia/finschia-sdk/x/stakingplus"
+)
+
+// Remove validator flags
+const (
+	FlagTombstone = "tombstone"
+)
+
+func validateGenerateOnly(cmd *cobra.Command) error {
+	generateOnly, err := cmd.Flags().GetBool(flags.FlagGenerateOnly)
+	if err != nil {
+		return err
+	}
+	if !generateOnly {
+		return fmt.Errorf("you must use it with the flag --%s", flags.FlagGenerateOnly)
+	}
+	return nil
+}
+
+// NewTxCmd returns the transaction commands of the staking module,
+// including the ones of stakingplus.
+func NewTxCmd() *cobra.Command {
+	txCmd := stakingcli.NewTxCmd()
+
+	txCmd.AddCommand(
+		NewTxCmdRemoveValidator(),
+		NewTxCmdSetAllowListEnabled(),
+		NewTxCmdAllowDelegators(),
+		NewTxCmdDisallowDelegators(),
+	)
+
+	return txCmd
+}
+
+func NewTxCmdRemoveValidator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-validator [authority] [validator-address] [reason]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Remove a validator from the validator set",
+		Long: `Remove a validator from the validator set
+
+The validator gets jailed and loses its authorization to create a validator.
+If --tombstone is given, the validator would be tombstoned, so it can never be
+unjailed.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateGenerateOnly(cmd); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tombstone, err := cmd.Flags().GetBool(FlagTombstone)
+			if err != nil {
+				return err
+			}
+
+			msg := stakingplus.MsgRemoveValidator{
+				Authority:        args[0],
+				ValidatorAddress: args[1],
+				Tombstone:        tombstone,
+				Reason:           args[2],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Bool(FlagTombstone, false, "tombstone the validator")
+	return cmd
+}
+
+func NewTxCmdSetAllowListEnabled() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-allow-list-enabled [authority] [enabled]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Enable or disable the delegator allow-list",
+		Long: `Enable or disable the delegator allow-list
+
+If enabled, only the delegators on the global allow-list or on the allow-list
+of the validator may delegate to the validator.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateGenerateOnly(cmd); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := stakingplus.MsgSetAllowListEnabled{
+				Authority: args[0],
+				Enabled:   enabled,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdAllowDelegators() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allow-delegators [authority] [delegator-address] [...]",
+		Args:  cobra.MinimumNArgs(2),
+		Short: "Add delegators to the allow-list",
+		Long: `Add delegators to the allow-list
+
+It updates the allow-list of the validator given by --validator, or the global
+allow-list if the flag is omitted.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateGenerateOnly(cmd); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			validator, err := cmd.Flags().GetString(FlagValidator)
+			if err != nil {
+				return err
+			}
+
+			msg := stakingplus.MsgAllowDelegators{
+				Authority:          args[0],
+				ValidatorAddress:   validator,
+				DelegatorAddresses: args[1:],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagValidator, "", "operator address of the validator")
+	return cmd
+}
+
+func NewTxCmdDisallowDelegators() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disallow-delegators [authority] [delegator-address] [...]",
+		Args:  cobra.MinimumNArgs(2),
+		Short: "Remove delegators from the allow-list",
+		Long: `Remove delegators from the allow-list
+
+It updates the allow-list of the validator given by --validator, or the global
+allow-list if the flag is omitted. The existing delegations are kept intact.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateGenerateOnly(cmd); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			validator, err := cmd.Flags().GetString(FlagValidator)
+			if err != nil {
+				return err
+			}
+
+			msg := stakingplus.MsgDisallowDelegators{
+				Authority:          args[0],
+				ValidatorAddress:   validator,
+				DelegatorAddresses: args[1:],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagValidator, "", "operator address of the validator")
+	return cmd
+}
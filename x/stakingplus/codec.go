@@ -2,7 +2,11 @@ package stakingplus
 
 import (
 	"github.com/Finschia/finschia-sdk/codec"
+	"github.com/Finschia/finschia-sdk/codec/legacy"
 	"github.com/Finschia/finschia-sdk/codec/types"
+	cryptocodec "github.com/Finschia/finschia-sdk/crypto/codec"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/msgservice"
 	authzcodec "github.com/Finschia/finschia-sdk/x/authz/codec"
 	"github.com/Finschia/finschia-sdk/x/foundation"
 	fdncodec "github.com/Finschia/finschia-sdk/x/foundation/codec"
@@ -12,17 +16,40 @@ import (
 // RegisterLegacyAminoCodec registers the necessary x/authz interfaces and concrete types
 // on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveValidator{}, "lbm-sdk/MsgRemoveValidator")
+	legacy.RegisterAminoMsg(cdc, &MsgSetAllowListEnabled{}, "lbm-sdk/MsgSetAllowListEnabled")
+	legacy.RegisterAminoMsg(cdc, &MsgAllowDelegators{}, "lbm-sdk/MsgAllowDelegators")
+	legacy.RegisterAminoMsg(cdc, &MsgDisallowDelegators{}, "lbm-sdk/MsgDisallowDelegators")
+
 	cdc.RegisterConcrete(&CreateValidatorAuthorization{}, "lbm-sdk/CreateValidatorAuthorization", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRemoveValidator{},
+		&MsgSetAllowListEnabled{},
+		&MsgAllowDelegators{},
+		&MsgDisallowDelegators{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+
 	registry.RegisterImplementations(
 		(*foundation.Authorization)(nil),
 		&CreateValidatorAuthorization{},
 	)
 }
 
+var (
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
 func init() {
+	RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	sdk.RegisterLegacyAminoCodec(amino)
+
 	// Register all Amino interfaces and concrete types on the authz  and gov Amino codec so that this can later be
 	// used to properly serialize MsgGrant, MsgExec and MsgSubmitProposal instances
 	RegisterLegacyAminoCodec(authzcodec.Amino)
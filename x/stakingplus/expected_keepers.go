@@ -1,10 +1,25 @@
 package stakingplus
 
 import (
+	"time"
+
 	sdk "github.com/Finschia/finschia-sdk/types"
+	slashingtypes "github.com/Finschia/finschia-sdk/x/slashing/types"
 )
 
 // FoundationKeeper defines the expected foundation keeper
 type FoundationKeeper interface {
+	GetAuthority() string
 	Accept(ctx sdk.Context, grantee sdk.AccAddress, msg sdk.Msg) error
+	Revoke(ctx sdk.Context, grantee sdk.AccAddress, msgTypeURL string) error
+}
+
+// SlashingKeeper defines the expected slashing keeper
+type SlashingKeeper interface {
+	HasValidatorSigningInfo(ctx sdk.Context, consAddr sdk.ConsAddress) bool
+	SetValidatorSigningInfo(ctx sdk.Context, address sdk.ConsAddress, info slashingtypes.ValidatorSigningInfo)
+	JailUntil(ctx sdk.Context, consAddr sdk.ConsAddress, jailTime time.Time)
+	Tombstone(ctx sdk.Context, consAddr sdk.ConsAddress)
+	IsTombstoned(ctx sdk.Context, consAddr sdk.ConsAddress) bool
+	DowntimeJailDuration(ctx sdk.Context) time.Duration
 }
@@ -0,0 +1,35 @@
+package stakingplus
+
+import (
+	"fmt"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+)
+
+// DefaultGenesisState returns a default stakingplus genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate performs basic validation of the delegator allow-list.
+func (gs GenesisState) Validate() error {
+	seen := map[AllowedDelegator]bool{}
+	for _, allowed := range gs.AllowedDelegators {
+		if len(allowed.ValidatorAddress) != 0 {
+			if _, err := sdk.ValAddressFromBech32(allowed.ValidatorAddress); err != nil {
+				return err
+			}
+		}
+
+		if _, err := sdk.AccAddressFromBech32(allowed.DelegatorAddress); err != nil {
+			return err
+		}
+
+		if seen[allowed] {
+			return fmt.Errorf("duplicate allowed delegator: %s on %s", allowed.DelegatorAddress, allowed.ValidatorAddress)
+		}
+		seen[allowed] = true
+	}
+
+	return nil
+}
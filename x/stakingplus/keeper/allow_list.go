@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/stakingplus"
+)
+
+// Keys for stakingplus store.
+var (
+	allowListEnabledKey        = []byte{0x01}
+	allowedDelegatorsKeyPrefix = []byte{0x02}
+)
+
+// allowedDelegatorsKey returns the key prefix of the allow-list of a validator.
+// An empty validator address refers to the global allow-list.
+func allowedDelegatorsKey(valAddr sdk.ValAddress) []byte {
+	key := make([]byte, len(allowedDelegatorsKeyPrefix)+1+len(valAddr))
+	copy(key, allowedDelegatorsKeyPrefix)
+	key[len(allowedDelegatorsKeyPrefix)] = byte(len(valAddr))
+	copy(key[len(allowedDelegatorsKeyPrefix)+1:], valAddr)
+
+	return key
+}
+
+// allowedDelegatorKey returns the key of a delegator on the allow-list of a validator.
+func allowedDelegatorKey(valAddr sdk.ValAddress, delAddr sdk.AccAddress) []byte {
+	return append(allowedDelegatorsKey(valAddr), delAddr...)
+}
+
+// GetAllowListEnabled returns whether the delegator allow-list is enforced or not.
+func (k Keeper) GetAllowListEnabled(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(allowListEnabledKey)
+}
+
+// SetAllowListEnabled enables or disables the delegator allow-list.
+func (k Keeper) SetAllowListEnabled(ctx sdk.Context, enabled bool) {
+	store := ctx.KVStore(k.storeKey)
+	if enabled {
+		store.Set(allowListEnabledKey, []byte{})
+	} else {
+		store.Delete(allowListEnabledKey)
+	}
+}
+
+// HasAllowedDelegator returns whether the delegator is on the allow-list of
+// the validator or not. An empty validator address refers to the global allow-list.
+func (k Keeper) HasAllowedDelegator(ctx sdk.Context, valAddr sdk.ValAddress, delAddr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(allowedDelegatorKey(valAddr, delAddr))
+}
+
+// SetAllowedDelegator puts the delegator on the allow-list of the validator.
+func (k Keeper) SetAllowedDelegator(ctx sdk.Context, valAddr sdk.ValAddress, delAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(allowedDelegatorKey(valAddr, delAddr), []byte{})
+}
+
+// DeleteAllowedDelegator removes the delegator from the allow-list of the validator.
+func (k Keeper) DeleteAllowedDelegator(ctx sdk.Context, valAddr sdk.ValAddress, delAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(allowedDelegatorKey(valAddr, delAddr))
+}
+
+// IsAllowedDelegator returns whether the delegator may delegate to the validator.
+// The operator of the validator may always delegate to its own validator.
+func (k Keeper) IsAllowedDelegator(ctx sdk.Context, valAddr sdk.ValAddress, delAddr sdk.AccAddress) bool {
+	if !k.GetAllowListEnabled(ctx) {
+		return true
+	}
+
+	if delAddr.Equals(sdk.AccAddress(valAddr)) {
+		return true
+	}
+
+	return k.HasAllowedDelegator(ctx, nil, delAddr) || k.HasAllowedDelegator(ctx, valAddr, delAddr)
+}
+
+// iterateAllowedDelegators iterates over all the delegators on the allow-lists.
+func (k Keeper) iterateAllowedDelegators(ctx sdk.Context, fn func(allowed stakingplus.AllowedDelegator) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, allowedDelegatorsKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		valAddr, delAddr := splitAllowedDelegatorKey(iterator.Key())
+
+		if fn(newAllowedDelegator(valAddr, delAddr)) {
+			break
+		}
+	}
+}
+
+func splitAllowedDelegatorKey(key []byte) (valAddr sdk.ValAddress, delAddr sdk.AccAddress) {
+	begin := len(allowedDelegatorsKeyPrefix) + 1
+	end := begin + int(key[begin-1])
+	valAddr = key[begin:end]
+
+	delAddr = key[end:]
+
+	return
+}
+
+// parseAllowListValidator parses the validator address of an allow-list.
+// An empty string refers to the global allow-list.
+func parseAllowListValidator(validatorAddress string) (sdk.ValAddress, error) {
+	if len(validatorAddress) == 0 {
+		return nil, nil
+	}
+
+	return sdk.ValAddressFromBech32(validatorAddress)
+}
+
+func newAllowedDelegator(valAddr sdk.ValAddress, delAddr sdk.AccAddress) stakingplus.AllowedDelegator {
+	allowed := stakingplus.AllowedDelegator{
+		DelegatorAddress: delAddr.String(),
+	}
+	if len(valAddr) != 0 {
+		allowed.ValidatorAddress = valAddr.String()
+	}
+
+	return allowed
+}
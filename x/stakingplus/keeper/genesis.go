@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/stakingplus"
+)
+
+// InitGenesis initializes the stakingplus specific state from the genesis.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *stakingplus.GenesisState) {
+	k.SetAllowListEnabled(ctx, data.AllowListEnabled)
+
+	for _, allowed := range data.AllowedDelegators {
+		valAddr, err := parseAllowListValidator(allowed.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		delAddr := sdk.MustAccAddressFromBech32(allowed.DelegatorAddress)
+
+		k.SetAllowedDelegator(ctx, valAddr, delAddr)
+	}
+}
+
+// ExportGenesis returns the stakingplus specific state for the genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *stakingplus.GenesisState {
+	var allowedDelegators []stakingplus.AllowedDelegator
+	k.iterateAllowedDelegators(ctx, func(allowed stakingplus.AllowedDelegator) (stop bool) {
+		allowedDelegators = append(allowedDelegators, allowed)
+		return false
+	})
+
+	return &stakingplus.GenesisState{
+		AllowListEnabled:  k.GetAllowListEnabled(ctx),
+		AllowedDelegators: allowedDelegators,
+	}
+}
@@ -0,0 +1,31 @@
+package keeper_test
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/stakingplus"
+)
+
+func (s *KeeperTestSuite) TestImportExportGenesis() {
+	ctx, _ := s.ctx.CacheContext()
+
+	genesis := &stakingplus.GenesisState{
+		AllowListEnabled: true,
+		AllowedDelegators: []stakingplus.AllowedDelegator{
+			{
+				DelegatorAddress: s.stranger.String(),
+			},
+			{
+				ValidatorAddress: sdk.ValAddress(s.grantee).String(),
+				DelegatorAddress: s.stranger.String(),
+			},
+		},
+	}
+	s.Require().NoError(genesis.Validate())
+
+	s.plusKeeper.InitGenesis(ctx, genesis)
+	s.Require().True(s.plusKeeper.IsAllowedDelegator(ctx, sdk.ValAddress(s.grantee), s.stranger))
+	s.Require().False(s.plusKeeper.IsAllowedDelegator(ctx, sdk.ValAddress(s.stranger), s.grantee))
+
+	exported := s.plusKeeper.ExportGenesis(ctx)
+	s.Require().Equal(genesis, exported)
+}
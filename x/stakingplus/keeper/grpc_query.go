@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Finschia/finschia-sdk/store/prefix"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/types/query"
+	"github.com/Finschia/finschia-sdk/x/stakingplus"
+)
+
+type queryServer struct {
+	keeper Keeper
+}
+
+// NewQueryServer returns an implementation of the stakingplus QueryServer interface
+// for the provided Keeper.
+func NewQueryServer(keeper Keeper) stakingplus.QueryServer {
+	return &queryServer{
+		keeper: keeper,
+	}
+}
+
+var _ stakingplus.QueryServer = queryServer{}
+
+// AllowListEnabled queries whether the delegator allow-list is enforced or not.
+func (s queryServer) AllowListEnabled(c context.Context, req *stakingplus.QueryAllowListEnabledRequest) (*stakingplus.QueryAllowListEnabledResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	enabled := s.keeper.GetAllowListEnabled(ctx)
+
+	return &stakingplus.QueryAllowListEnabledResponse{Enabled: enabled}, nil
+}
+
+// AllowedDelegators queries the delegators on the allow-list of a validator.
+func (s queryServer) AllowedDelegators(c context.Context, req *stakingplus.QueryAllowedDelegatorsRequest) (*stakingplus.QueryAllowedDelegatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := parseAllowListValidator(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, sdkerrors.ErrInvalidAddress.Wrap(req.ValidatorAddress).Error())
+	}
+
+	var allowedDelegators []stakingplus.AllowedDelegator
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	allowListStore := prefix.NewStore(store, allowedDelegatorsKey(valAddr))
+	pageRes, err := query.Paginate(allowListStore, req.Pagination, func(key []byte, _ []byte) error {
+		allowedDelegators = append(allowedDelegators, newAllowedDelegator(valAddr, key))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &stakingplus.QueryAllowedDelegatorsResponse{AllowedDelegators: allowedDelegators, Pagination: pageRes}, nil
+}
+
+// IsAllowedDelegator queries whether the delegator may delegate to the validator or not.
+func (s queryServer) IsAllowedDelegator(c context.Context, req *stakingplus.QueryIsAllowedDelegatorRequest) (*stakingplus.QueryIsAllowedDelegatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, sdkerrors.ErrInvalidAddress.Wrap(req.ValidatorAddress).Error())
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, sdkerrors.ErrInvalidAddress.Wrap(req.DelegatorAddress).Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	allowed := s.keeper.IsAllowedDelegator(ctx, valAddr, delAddr)
+
+	return &stakingplus.QueryIsAllowedDelegatorResponse{Allowed: allowed}, nil
+}
@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/query"
+	"github.com/Finschia/finschia-sdk/x/stakingplus"
+)
+
+func (s *KeeperTestSuite) TestQueryAllowListEnabled() {
+	ctx, _ := s.ctx.CacheContext()
+
+	// nil request
+	_, err := s.queryServer.AllowListEnabled(sdk.WrapSDKContext(ctx), nil)
+	s.Require().Error(err)
+
+	for _, enabled := range []bool{true, false} {
+		s.plusKeeper.SetAllowListEnabled(ctx, enabled)
+
+		res, err := s.queryServer.AllowListEnabled(sdk.WrapSDKContext(ctx), &stakingplus.QueryAllowListEnabledRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal(enabled, res.Enabled)
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryAllowedDelegators() {
+	ctx, _ := s.ctx.CacheContext()
+	valAddr := sdk.ValAddress(s.grantee)
+
+	// the global allow-list
+	s.plusKeeper.SetAllowedDelegator(ctx, nil, s.stranger)
+	s.plusKeeper.SetAllowedDelegator(ctx, nil, s.grantee)
+
+	// the allow-list of the validator
+	s.plusKeeper.SetAllowedDelegator(ctx, valAddr, s.stranger)
+
+	// nil request
+	_, err := s.queryServer.AllowedDelegators(sdk.WrapSDKContext(ctx), nil)
+	s.Require().Error(err)
+
+	// invalid validator
+	_, err = s.queryServer.AllowedDelegators(sdk.WrapSDKContext(ctx), &stakingplus.QueryAllowedDelegatorsRequest{
+		ValidatorAddress: "invalid",
+	})
+	s.Require().Error(err)
+
+	res, err := s.queryServer.AllowedDelegators(sdk.WrapSDKContext(ctx), &stakingplus.QueryAllowedDelegatorsRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.AllowedDelegators, 1)
+	s.Require().EqualValues(2, res.Pagination.Total)
+	s.Require().Empty(res.AllowedDelegators[0].ValidatorAddress)
+
+	res, err = s.queryServer.AllowedDelegators(sdk.WrapSDKContext(ctx), &stakingplus.QueryAllowedDelegatorsRequest{
+		ValidatorAddress: valAddr.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]stakingplus.AllowedDelegator{{
+		ValidatorAddress: valAddr.String(),
+		DelegatorAddress: s.stranger.String(),
+	}}, res.AllowedDelegators)
+}
+
+func (s *KeeperTestSuite) TestQueryIsAllowedDelegator() {
+	ctx, _ := s.ctx.CacheContext()
+	valAddr := sdk.ValAddress(s.grantee)
+
+	s.plusKeeper.SetAllowListEnabled(ctx, true)
+	s.plusKeeper.SetAllowedDelegator(ctx, valAddr, s.stranger)
+
+	testCases := map[string]struct {
+		validator string
+		delegator string
+		valid     bool
+		allowed   bool
+	}{
+		"allowed delegator": {
+			validator: valAddr.String(),
+			delegator: s.stranger.String(),
+			valid:     true,
+			allowed:   true,
+		},
+		"not allowed delegator": {
+			validator: sdk.ValAddress(s.stranger).String(),
+			delegator: s.grantee.String(),
+			valid:     true,
+		},
+		"invalid validator": {
+			validator: "invalid",
+			delegator: s.stranger.String(),
+		},
+		"invalid delegator": {
+			validator: valAddr.String(),
+			delegator: "invalid",
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			res, err := s.queryServer.IsAllowedDelegator(sdk.WrapSDKContext(ctx), &stakingplus.QueryIsAllowedDelegatorRequest{
+				ValidatorAddress: tc.validator,
+				DelegatorAddress: tc.delegator,
+			})
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.allowed, res.Allowed)
+		})
+	}
+}
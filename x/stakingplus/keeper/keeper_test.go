@@ -8,10 +8,12 @@ import (
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	"github.com/Finschia/finschia-sdk/crypto/keys/ed25519"
 	"github.com/Finschia/finschia-sdk/crypto/keys/secp256k1"
 	"github.com/Finschia/finschia-sdk/simapp"
 	sdk "github.com/Finschia/finschia-sdk/types"
 	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/foundation"
 	minttypes "github.com/Finschia/finschia-sdk/x/mint/types"
 	stakingkeeper "github.com/Finschia/finschia-sdk/x/staking/keeper"
 	stakingtypes "github.com/Finschia/finschia-sdk/x/staking/types"
@@ -24,9 +26,14 @@ type KeeperTestSuite struct {
 	suite.Suite
 	ctx sdk.Context
 
-	app       *simapp.SimApp
-	keeper    stakingkeeper.Keeper
-	msgServer stakingtypes.MsgServer
+	app           *simapp.SimApp
+	keeper        stakingkeeper.Keeper
+	plusKeeper    keeper.Keeper
+	msgServer     stakingtypes.MsgServer
+	msgServerPlus stakingplus.MsgServer
+	queryServer   stakingplus.QueryServer
+
+	authority sdk.AccAddress
 
 	stranger sdk.AccAddress
 	grantee  sdk.AccAddress
@@ -43,7 +50,13 @@ func (s *KeeperTestSuite) SetupTest() {
 	s.ctx = s.app.BaseApp.NewContext(checkTx, tmproto.Header{})
 	s.keeper = s.app.StakingKeeper
 
-	s.msgServer = keeper.NewMsgServerImpl(s.keeper, foundationKeeper)
+	s.plusKeeper = keeper.NewKeeper(s.app.AppCodec(), s.app.GetKey(stakingplus.StoreKey))
+
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper, s.plusKeeper, foundationKeeper)
+	s.msgServerPlus = keeper.NewMsgServerPlusImpl(s.keeper, s.plusKeeper, s.app.SlashingKeeper, foundationKeeper)
+	s.queryServer = keeper.NewQueryServer(s.plusKeeper)
+
+	s.authority = foundation.DefaultAuthority()
 
 	createAddress := func() sdk.AccAddress {
 		return sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
@@ -77,11 +90,53 @@ func (s *KeeperTestSuite) SetupTest() {
 	foundationKeeper.
 		EXPECT().
 		Accept(gomock.Any(), s.grantee, NewCreateValidatorAuthorizationMatcher(s.grantee)).
-		Return(nil)
+		Return(nil).
+		AnyTimes()
 	foundationKeeper.
 		EXPECT().
 		Accept(gomock.Any(), gomock.Any(), gomock.Any()).
-		Return(sdkerrors.ErrUnauthorized)
+		Return(sdkerrors.ErrUnauthorized).
+		AnyTimes()
+
+	foundationKeeper.
+		EXPECT().
+		GetAuthority().
+		Return(s.authority.String()).
+		AnyTimes()
+
+	// revoke Msg/CreateValidator from grantee
+	foundationKeeper.
+		EXPECT().
+		Revoke(gomock.Any(), s.grantee, sdk.MsgTypeURL((*stakingtypes.MsgCreateValidator)(nil))).
+		Return(nil).
+		AnyTimes()
+	foundationKeeper.
+		EXPECT().
+		Revoke(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(sdkerrors.ErrUnauthorized).
+		AnyTimes()
+}
+
+// createValidator creates a validator operated by the operator, bypassing the censorship.
+func (s *KeeperTestSuite) createValidator(ctx sdk.Context, operator sdk.AccAddress) sdk.ValAddress {
+	valAddr := sdk.ValAddress(operator)
+
+	pk := ed25519.GenPrivKey().PubKey()
+	delegation := sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())
+	req, err := stakingtypes.NewMsgCreateValidator(
+		valAddr,
+		pk,
+		delegation,
+		stakingtypes.Description{},
+		stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		delegation.Amount,
+	)
+	s.Require().NoError(err)
+
+	_, err = stakingkeeper.NewMsgServerImpl(s.keeper).CreateValidator(sdk.WrapSDKContext(ctx), req)
+	s.Require().NoError(err)
+
+	return valAddr
 }
 
 func TestKeeperTestSuite(t *testing.T) {
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/module"
+	"github.com/Finschia/finschia-sdk/x/stakingplus"
+	v2 "github.com/Finschia/finschia-sdk/x/stakingplus/keeper/migrations/v2"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper     Keeper
+	stakingKey sdk.StoreKey
+}
+
+// NewMigrator returns a new Migrator. The store key must be the one of the
+// staking module, which used to keep the stakingplus specific state.
+func NewMigrator(keeper Keeper, stakingKey sdk.StoreKey) Migrator {
+	return Migrator{
+		keeper:     keeper,
+		stakingKey: stakingKey,
+	}
+}
+
+func (m Migrator) Register(register func(moduleName string, fromVersion uint64, handler module.MigrationHandler) error) error {
+	for fromVersion, handler := range map[uint64]module.MigrationHandler{
+		1: func(ctx sdk.Context) error {
+			return v2.MigrateStore(ctx, m.stakingKey, m.keeper.storeKey)
+		},
+	} {
+		if err := register(stakingplus.ModuleName, fromVersion, handler); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
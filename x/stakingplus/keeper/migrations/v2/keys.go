@@ -0,0 +1,11 @@
+package v2
+
+var (
+	// the keys in the store of the staking module
+	legacyAllowListEnabledKey        = []byte{0xa0}
+	legacyAllowedDelegatorsKeyPrefix = []byte{0xa1}
+
+	// the keys in the store of the stakingplus module
+	allowListEnabledKey        = []byte{0x01}
+	allowedDelegatorsKeyPrefix = []byte{0x02}
+)
@@ -0,0 +1,35 @@
+package v2
+
+import (
+	storetypes "github.com/Finschia/finschia-sdk/store/types"
+	sdk "github.com/Finschia/finschia-sdk/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2.
+// It moves the delegator allow-lists out of the store of the staking module,
+// which they used to share, into the store of the stakingplus module.
+func MigrateStore(ctx sdk.Context, stakingKey, storeKey storetypes.StoreKey) error {
+	stakingStore := ctx.KVStore(stakingKey)
+	store := ctx.KVStore(storeKey)
+
+	if stakingStore.Has(legacyAllowListEnabledKey) {
+		store.Set(allowListEnabledKey, []byte{})
+		stakingStore.Delete(legacyAllowListEnabledKey)
+	}
+
+	iterator := sdk.KVStorePrefixIterator(stakingStore, legacyAllowedDelegatorsKeyPrefix)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		newKey := append(append([]byte{}, allowedDelegatorsKeyPrefix...), key[len(legacyAllowedDelegatorsKeyPrefix):]...)
+		store.Set(newKey, []byte{})
+		stakingStore.Delete(key)
+	}
+
+	return nil
+}
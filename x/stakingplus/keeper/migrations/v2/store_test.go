@@ -0,0 +1,66 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/Finschia/ostracon/libs/log"
+
+	"github.com/Finschia/finschia-sdk/store"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	stakingtypes "github.com/Finschia/finschia-sdk/x/staking/types"
+	"github.com/Finschia/finschia-sdk/x/stakingplus"
+
+	"github.com/Finschia/finschia-sdk/x/stakingplus/keeper/migrations/v2"
+)
+
+func TestMigrateStore(t *testing.T) {
+	stakingKey := sdk.NewKVStoreKey(stakingtypes.StoreKey)
+	plusKey := sdk.NewKVStoreKey(stakingplus.StoreKey)
+
+	db := dbm.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db)
+	stateStore.MountStoreWithDB(stakingKey, sdk.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(plusKey, sdk.StoreTypeIAVL, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+
+	valAddr := sdk.ValAddress("validator")
+	delAddr := sdk.AccAddress("delegator")
+	allowedDelegatorKey := func(prefix byte, valAddr sdk.ValAddress, delAddr sdk.AccAddress) []byte {
+		key := []byte{prefix, byte(len(valAddr))}
+		key = append(key, valAddr...)
+		return append(key, delAddr...)
+	}
+
+	stakingStore := ctx.KVStore(stakingKey)
+	stakingStore.Set([]byte{0xa0}, []byte{})
+	stakingStore.Set(allowedDelegatorKey(0xa1, nil, delAddr), []byte{})
+	stakingStore.Set(allowedDelegatorKey(0xa1, valAddr, delAddr), []byte{})
+
+	// a key of the staking module
+	validatorKey := stakingtypes.GetValidatorKey(valAddr)
+	stakingStore.Set(validatorKey, []byte("validator"))
+
+	// migrate
+	err := v2.MigrateStore(ctx, stakingKey, plusKey)
+	require.NoError(t, err)
+
+	// the state moves to the store of stakingplus
+	plusStore := ctx.KVStore(plusKey)
+	require.True(t, plusStore.Has([]byte{0x01}))
+	require.True(t, plusStore.Has(allowedDelegatorKey(0x02, nil, delAddr)))
+	require.True(t, plusStore.Has(allowedDelegatorKey(0x02, valAddr, delAddr)))
+
+	// nothing of stakingplus is left in the store of staking
+	require.False(t, stakingStore.Has([]byte{0xa0}))
+	iterator := sdk.KVStorePrefixIterator(stakingStore, []byte{0xa1})
+	require.False(t, iterator.Valid())
+	require.NoError(t, iterator.Close())
+
+	// the state of staking is kept
+	require.Equal(t, []byte("validator"), stakingStore.Get(validatorKey))
+}
@@ -2,10 +2,13 @@ package keeper
 
 import (
 	"github.com/Finschia/finschia-sdk/types/errors"
+	evidencetypes "github.com/Finschia/finschia-sdk/x/evidence/types"
+	slashingtypes "github.com/Finschia/finschia-sdk/x/slashing/types"
 	stakingkeeper "github.com/Finschia/finschia-sdk/x/staking/keeper"
 	stakingtypes "github.com/Finschia/finschia-sdk/x/staking/types"
 
 	"context"
+	"time"
 
 	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/x/stakingplus"
@@ -14,15 +17,17 @@ import (
 type msgServer struct {
 	stakingtypes.MsgServer
 
-	fk stakingplus.FoundationKeeper
+	plusKeeper Keeper
+	fk         stakingplus.FoundationKeeper
 }
 
 // NewMsgServerImpl returns an implementation of the staking MsgServer interface
 // for the provided Keeper.
-func NewMsgServerImpl(keeper stakingkeeper.Keeper, fk stakingplus.FoundationKeeper) stakingtypes.MsgServer {
+func NewMsgServerImpl(keeper stakingkeeper.Keeper, plusKeeper Keeper, fk stakingplus.FoundationKeeper) stakingtypes.MsgServer {
 	return &msgServer{
-		MsgServer: stakingkeeper.NewMsgServerImpl(keeper),
-		fk:        fk,
+		MsgServer:  stakingkeeper.NewMsgServerImpl(keeper),
+		plusKeeper: plusKeeper,
+		fk:         fk,
 	}
 }
 
@@ -42,3 +47,211 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *stakingtypes.MsgC
 
 	return k.MsgServer.CreateValidator(goCtx, msg)
 }
+
+func (k msgServer) Delegate(goCtx context.Context, msg *stakingtypes.MsgDelegate) (*stakingtypes.MsgDelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateDelegator(ctx, msg.DelegatorAddress, msg.ValidatorAddress); err != nil {
+		return nil, err
+	}
+
+	return k.MsgServer.Delegate(goCtx, msg)
+}
+
+func (k msgServer) BeginRedelegate(goCtx context.Context, msg *stakingtypes.MsgBeginRedelegate) (*stakingtypes.MsgBeginRedelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateDelegator(ctx, msg.DelegatorAddress, msg.ValidatorDstAddress); err != nil {
+		return nil, err
+	}
+
+	return k.MsgServer.BeginRedelegate(goCtx, msg)
+}
+
+// validateDelegator checks whether the delegator may delegate to the validator
+// under the delegator allow-list.
+func (k msgServer) validateDelegator(ctx sdk.Context, delegatorAddress, validatorAddress string) error {
+	delAddr, err := sdk.AccAddressFromBech32(delegatorAddress)
+	if err != nil {
+		return errors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", delegatorAddress)
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(validatorAddress)
+	if err != nil {
+		return errors.ErrInvalidAddress.Wrapf("invalid validator address: %s", validatorAddress)
+	}
+
+	if !k.plusKeeper.IsAllowedDelegator(ctx, valAddr, delAddr) {
+		return errors.ErrUnauthorized.Wrapf("%s is not allowed to delegate to %s", delegatorAddress, validatorAddress)
+	}
+
+	return nil
+}
+
+type msgServerPlus struct {
+	keeper     stakingkeeper.Keeper
+	plusKeeper Keeper
+	sk         stakingplus.SlashingKeeper
+	fk         stakingplus.FoundationKeeper
+}
+
+// NewMsgServerPlusImpl returns an implementation of the stakingplus MsgServer interface
+// for the provided Keeper.
+func NewMsgServerPlusImpl(keeper stakingkeeper.Keeper, plusKeeper Keeper, sk stakingplus.SlashingKeeper, fk stakingplus.FoundationKeeper) stakingplus.MsgServer {
+	return &msgServerPlus{
+		keeper:     keeper,
+		plusKeeper: plusKeeper,
+		sk:         sk,
+		fk:         fk,
+	}
+}
+
+var _ stakingplus.MsgServer = msgServerPlus{}
+
+func (k msgServerPlus) validateAuthority(authority string) error {
+	if expected := k.fk.GetAuthority(); authority != expected {
+		return errors.ErrUnauthorized.Wrapf("invalid authority; expected %s, got %s", expected, authority)
+	}
+
+	return nil
+}
+
+func (k msgServerPlus) RemoveValidator(goCtx context.Context, msg *stakingplus.MsgRemoveValidator) (*stakingplus.MsgRemoveValidatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, found := k.keeper.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, stakingtypes.ErrNoValidatorFound
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	if !validator.IsJailed() {
+		k.keeper.Jail(ctx, consAddr)
+	}
+
+	// the validator which has never been bonded has no signing info yet
+	if !k.sk.HasValidatorSigningInfo(ctx, consAddr) {
+		info := slashingtypes.NewValidatorSigningInfo(consAddr, ctx.BlockHeight(), 0, time.Unix(0, 0), false, 0)
+		k.sk.SetValidatorSigningInfo(ctx, consAddr, info)
+	}
+
+	// the jail time of a tombstoned validator must not be overwritten
+	if !k.sk.IsTombstoned(ctx, consAddr) {
+		if msg.Tombstone {
+			k.sk.JailUntil(ctx, consAddr, evidencetypes.DoubleSignJailEndTime)
+			k.sk.Tombstone(ctx, consAddr)
+		} else {
+			k.sk.JailUntil(ctx, consAddr, ctx.BlockHeader().Time.Add(k.sk.DowntimeJailDuration(ctx)))
+		}
+	}
+
+	// the validator might have no authorization, e.g. it was created before
+	// x/foundation started to censor Msg/CreateValidator.
+	grantee := sdk.AccAddress(valAddr)
+	if err := k.fk.Revoke(ctx, grantee, sdk.MsgTypeURL((*stakingtypes.MsgCreateValidator)(nil))); err != nil && !errors.ErrUnauthorized.Is(err) {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&stakingplus.EventRemoveValidator{
+		ValidatorAddress: msg.ValidatorAddress,
+		Tombstoned:       k.sk.IsTombstoned(ctx, consAddr),
+		Reason:           msg.Reason,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &stakingplus.MsgRemoveValidatorResponse{}, nil
+}
+
+func (k msgServerPlus) SetAllowListEnabled(goCtx context.Context, msg *stakingplus.MsgSetAllowListEnabled) (*stakingplus.MsgSetAllowListEnabledResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	k.plusKeeper.SetAllowListEnabled(ctx, msg.Enabled)
+
+	if err := ctx.EventManager().EmitTypedEvent(&stakingplus.EventSetAllowListEnabled{
+		Enabled: msg.Enabled,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &stakingplus.MsgSetAllowListEnabledResponse{}, nil
+}
+
+func (k msgServerPlus) AllowDelegators(goCtx context.Context, msg *stakingplus.MsgAllowDelegators) (*stakingplus.MsgAllowDelegatorsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	valAddr, err := parseAllowListValidator(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, delegator := range msg.DelegatorAddresses {
+		delAddr := sdk.MustAccAddressFromBech32(delegator)
+		if k.plusKeeper.HasAllowedDelegator(ctx, valAddr, delAddr) {
+			return nil, errors.ErrInvalidRequest.Wrapf("%s is already allowed", delegator)
+		}
+
+		k.plusKeeper.SetAllowedDelegator(ctx, valAddr, delAddr)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&stakingplus.EventAllowDelegators{
+		ValidatorAddress:   msg.ValidatorAddress,
+		DelegatorAddresses: msg.DelegatorAddresses,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &stakingplus.MsgAllowDelegatorsResponse{}, nil
+}
+
+func (k msgServerPlus) DisallowDelegators(goCtx context.Context, msg *stakingplus.MsgDisallowDelegators) (*stakingplus.MsgDisallowDelegatorsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	valAddr, err := parseAllowListValidator(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, delegator := range msg.DelegatorAddresses {
+		delAddr := sdk.MustAccAddressFromBech32(delegator)
+		if !k.plusKeeper.HasAllowedDelegator(ctx, valAddr, delAddr) {
+			return nil, errors.ErrNotFound.Wrapf("%s is not allowed", delegator)
+		}
+
+		k.plusKeeper.DeleteAllowedDelegator(ctx, valAddr, delAddr)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&stakingplus.EventDisallowDelegators{
+		ValidatorAddress:   msg.ValidatorAddress,
+		DelegatorAddresses: msg.DelegatorAddresses,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &stakingplus.MsgDisallowDelegatorsResponse{}, nil
+}
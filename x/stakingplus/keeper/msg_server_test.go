@@ -3,7 +3,9 @@ package keeper_test
 import (
 	"github.com/Finschia/finschia-sdk/simapp"
 	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 	stakingtypes "github.com/Finschia/finschia-sdk/x/staking/types"
+	"github.com/Finschia/finschia-sdk/x/stakingplus"
 )
 
 func (s *KeeperTestSuite) TestMsgCreateValidator() {
@@ -46,3 +48,318 @@ func (s *KeeperTestSuite) TestMsgCreateValidator() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestMsgRemoveValidator() {
+	ctx, _ := s.ctx.CacheContext()
+	s.createValidator(ctx, s.grantee)
+
+	testCases := map[string]struct {
+		authority sdk.AccAddress
+		validator sdk.ValAddress
+		tombstone bool
+		valid     bool
+	}{
+		"valid request": {
+			authority: s.authority,
+			validator: sdk.ValAddress(s.grantee),
+			valid:     true,
+		},
+		"valid request (tombstone)": {
+			authority: s.authority,
+			validator: sdk.ValAddress(s.grantee),
+			tombstone: true,
+			valid:     true,
+		},
+		"invalid authority": {
+			authority: s.stranger,
+			validator: sdk.ValAddress(s.grantee),
+		},
+		"validator not found": {
+			authority: s.authority,
+			validator: sdk.ValAddress(s.stranger),
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := ctx.CacheContext()
+
+			req := &stakingplus.MsgRemoveValidator{
+				Authority:        tc.authority.String(),
+				ValidatorAddress: tc.validator.String(),
+				Tombstone:        tc.tombstone,
+				Reason:           "test",
+			}
+			res, err := s.msgServerPlus.RemoveValidator(sdk.WrapSDKContext(ctx), req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+
+			validator, found := s.keeper.GetValidator(ctx, tc.validator)
+			s.Require().True(found)
+			s.Require().True(validator.IsJailed())
+
+			consAddr, err := validator.GetConsAddr()
+			s.Require().NoError(err)
+			s.Require().Equal(tc.tombstone, s.app.SlashingKeeper.IsTombstoned(ctx, consAddr))
+
+			info, found := s.app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+			s.Require().True(found)
+			s.Require().True(info.JailedUntil.After(ctx.BlockHeader().Time))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgDelegate() {
+	ctx, _ := s.ctx.CacheContext()
+	valAddr := s.createValidator(ctx, s.grantee)
+
+	testCases := map[string]struct {
+		enabled   bool
+		global    bool
+		local     bool
+		delegator sdk.AccAddress
+		valid     bool
+	}{
+		"allow-list disabled": {
+			delegator: s.stranger,
+			valid:     true,
+		},
+		"not on the allow-list": {
+			enabled:   true,
+			delegator: s.stranger,
+		},
+		"on the global allow-list": {
+			enabled:   true,
+			global:    true,
+			delegator: s.stranger,
+			valid:     true,
+		},
+		"on the allow-list of the validator": {
+			enabled:   true,
+			local:     true,
+			delegator: s.stranger,
+			valid:     true,
+		},
+		"operator of the validator": {
+			enabled:   true,
+			delegator: s.grantee,
+			valid:     true,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := ctx.CacheContext()
+
+			s.plusKeeper.SetAllowListEnabled(ctx, tc.enabled)
+			if tc.global {
+				s.plusKeeper.SetAllowedDelegator(ctx, nil, tc.delegator)
+			}
+			if tc.local {
+				s.plusKeeper.SetAllowedDelegator(ctx, valAddr, tc.delegator)
+			}
+
+			req := stakingtypes.NewMsgDelegate(tc.delegator, valAddr, sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt()))
+			res, err := s.msgServer.Delegate(sdk.WrapSDKContext(ctx), req)
+			if !tc.valid {
+				s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgBeginRedelegate() {
+	ctx, _ := s.ctx.CacheContext()
+	srcAddr := s.createValidator(ctx, s.grantee)
+	dstAddr := s.createValidator(ctx, s.stranger)
+
+	// the operator of the source validator redelegates its self-delegation
+	amount := sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())
+	req := stakingtypes.NewMsgBeginRedelegate(s.grantee, srcAddr, dstAddr, amount)
+
+	testCases := map[string]struct {
+		local bool
+		valid bool
+	}{
+		"on the allow-list of the destination": {
+			local: true,
+			valid: true,
+		},
+		"not on the allow-list of the destination": {},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := ctx.CacheContext()
+
+			s.plusKeeper.SetAllowListEnabled(ctx, true)
+			if tc.local {
+				s.plusKeeper.SetAllowedDelegator(ctx, dstAddr, s.grantee)
+			}
+
+			res, err := s.msgServer.BeginRedelegate(sdk.WrapSDKContext(ctx), req)
+			if !tc.valid {
+				s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgSetAllowListEnabled() {
+	testCases := map[string]struct {
+		authority sdk.AccAddress
+		valid     bool
+	}{
+		"valid request": {
+			authority: s.authority,
+			valid:     true,
+		},
+		"invalid authority": {
+			authority: s.stranger,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			req := &stakingplus.MsgSetAllowListEnabled{
+				Authority: tc.authority.String(),
+				Enabled:   true,
+			}
+			res, err := s.msgServerPlus.SetAllowListEnabled(sdk.WrapSDKContext(ctx), req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+
+			s.Require().True(s.plusKeeper.GetAllowListEnabled(ctx))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgAllowDelegators() {
+	valAddr := sdk.ValAddress(s.grantee)
+
+	testCases := map[string]struct {
+		authority sdk.AccAddress
+		validator sdk.ValAddress
+		allowed   bool
+		valid     bool
+	}{
+		"valid request (global)": {
+			authority: s.authority,
+			valid:     true,
+		},
+		"valid request (validator)": {
+			authority: s.authority,
+			validator: valAddr,
+			valid:     true,
+		},
+		"invalid authority": {
+			authority: s.stranger,
+		},
+		"already allowed": {
+			authority: s.authority,
+			validator: valAddr,
+			allowed:   true,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			if tc.allowed {
+				s.plusKeeper.SetAllowedDelegator(ctx, tc.validator, s.stranger)
+			}
+
+			req := &stakingplus.MsgAllowDelegators{
+				Authority:          tc.authority.String(),
+				DelegatorAddresses: []string{s.stranger.String()},
+			}
+			if !tc.validator.Empty() {
+				req.ValidatorAddress = tc.validator.String()
+			}
+			res, err := s.msgServerPlus.AllowDelegators(sdk.WrapSDKContext(ctx), req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+
+			s.Require().True(s.plusKeeper.HasAllowedDelegator(ctx, tc.validator, s.stranger))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgDisallowDelegators() {
+	valAddr := sdk.ValAddress(s.grantee)
+
+	testCases := map[string]struct {
+		authority sdk.AccAddress
+		validator sdk.ValAddress
+		allowed   bool
+		valid     bool
+	}{
+		"valid request (global)": {
+			authority: s.authority,
+			allowed:   true,
+			valid:     true,
+		},
+		"valid request (validator)": {
+			authority: s.authority,
+			validator: valAddr,
+			allowed:   true,
+			valid:     true,
+		},
+		"invalid authority": {
+			authority: s.stranger,
+			allowed:   true,
+		},
+		"not allowed": {
+			authority: s.authority,
+			validator: valAddr,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			if tc.allowed {
+				s.plusKeeper.SetAllowedDelegator(ctx, tc.validator, s.stranger)
+			}
+
+			req := &stakingplus.MsgDisallowDelegators{
+				Authority:          tc.authority.String(),
+				DelegatorAddresses: []string{s.stranger.String()},
+			}
+			if !tc.validator.Empty() {
+				req.ValidatorAddress = tc.validator.String()
+			}
+			res, err := s.msgServerPlus.DisallowDelegators(sdk.WrapSDKContext(ctx), req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+
+			s.Require().False(s.plusKeeper.HasAllowedDelegator(ctx, tc.validator, s.stranger))
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package stakingplus
+
+const (
+	// ModuleName is the name of the module which keeps the stakingplus specific state.
+	// The staking messages themselves are still served under the staking module.
+	ModuleName = "stakingplus"
+
+	// StoreKey defines the primary module store key. It must not start with
+	// the store key of staking, so it is not ModuleName.
+	StoreKey = "lbm" + ModuleName
+)
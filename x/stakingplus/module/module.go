@@ -1,11 +1,15 @@
 package module
 
 import (
+	"context"
 	"encoding/json"
 
 	ocabci "github.com/Finschia/ostracon/abci/types"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/Finschia/finschia-sdk/client"
 	"github.com/Finschia/finschia-sdk/codec"
 	"github.com/Finschia/finschia-sdk/types/module"
 
@@ -13,6 +17,7 @@ import (
 	sdk "github.com/Finschia/finschia-sdk/types"
 
 	"github.com/Finschia/finschia-sdk/x/stakingplus"
+	"github.com/Finschia/finschia-sdk/x/stakingplus/client/cli"
 	"github.com/Finschia/finschia-sdk/x/stakingplus/keeper"
 
 	"github.com/Finschia/finschia-sdk/x/staking"
@@ -32,11 +37,34 @@ type AppModuleBasic struct {
 	staking.AppModuleBasic
 }
 
+func (b AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	b.AppModuleBasic.RegisterLegacyAminoCodec(cdc)
+	stakingplus.RegisterLegacyAminoCodec(cdc)
+}
+
 func (b AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	b.AppModuleBasic.RegisterInterfaces(registry)
 	stakingplus.RegisterInterfaces(registry)
 }
 
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the stakingplus module.
+func (b AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	b.AppModuleBasic.RegisterGRPCGatewayRoutes(clientCtx, mux)
+	if err := stakingplus.RegisterQueryHandlerClient(context.Background(), mux, stakingplus.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
+
+// GetTxCmd returns the root tx command for the stakingplus module.
+func (AppModuleBasic) GetTxCmd() *cobra.Command {
+	return cli.NewTxCmd()
+}
+
+// GetQueryCmd returns the root query command for the stakingplus module.
+func (AppModuleBasic) GetQueryCmd() *cobra.Command {
+	return cli.NewQueryCmd()
+}
+
 //____________________________________________________________________________
 
 // AppModule implements an application module for the stakingplus module.
@@ -44,24 +72,28 @@ type AppModule struct {
 	AppModuleBasic
 	impl staking.AppModule
 
-	keeper stakingkeeper.Keeper
-	ak     stakingtypes.AccountKeeper
-	bk     stakingtypes.BankKeeper
-	fk     stakingplus.FoundationKeeper
+	keeper     stakingkeeper.Keeper
+	plusKeeper keeper.Keeper
+	ak         stakingtypes.AccountKeeper
+	bk         stakingtypes.BankKeeper
+	sk         stakingplus.SlashingKeeper
+	fk         stakingplus.FoundationKeeper
 }
 
 // NewAppModule creates a new AppModule object
-func NewAppModule(cdc codec.Codec, keeper stakingkeeper.Keeper, ak stakingtypes.AccountKeeper, bk stakingtypes.BankKeeper, fk stakingplus.FoundationKeeper) AppModule {
+func NewAppModule(cdc codec.Codec, keeper stakingkeeper.Keeper, plusKeeper keeper.Keeper, ak stakingtypes.AccountKeeper, bk stakingtypes.BankKeeper, sk stakingplus.SlashingKeeper, fk stakingplus.FoundationKeeper) AppModule {
 	impl := staking.NewAppModule(cdc, keeper, ak, bk)
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{
 			impl.AppModuleBasic,
 		},
-		impl:   impl,
-		keeper: keeper,
-		ak:     ak,
-		bk:     bk,
-		fk:     fk,
+		impl:       impl,
+		keeper:     keeper,
+		plusKeeper: plusKeeper,
+		ak:         ak,
+		bk:         bk,
+		sk:         sk,
+		fk:         fk,
 	}
 }
 
@@ -87,19 +119,21 @@ func (am AppModule) LegacyQuerierHandler(legacyQuerierCdc *codec.LegacyAmino) sd
 
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
-	stakingtypes.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper, am.fk))
+	stakingtypes.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper, am.plusKeeper, am.fk))
+	stakingplus.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerPlusImpl(am.keeper, am.plusKeeper, am.sk, am.fk))
 	querier := stakingkeeper.Querier{Keeper: am.keeper}
 	stakingtypes.RegisterQueryServer(cfg.QueryServer(), querier)
+	stakingplus.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServer(am.plusKeeper))
 }
 
-// InitGenesis performs genesis initialization for the stakingplus module. It returns
-// no validator updates.
+// InitGenesis performs genesis initialization for the staking module. The
+// stakingplus specific state is initialized by PlusAppModule.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
 	return am.impl.InitGenesis(ctx, cdc, data)
 }
 
-// ExportGenesis returns the exported genesis state as raw bytes for the stakingplus
-// module.
+// ExportGenesis returns the exported genesis state as raw bytes for the staking
+// module. The stakingplus specific state is exported by PlusAppModule.
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
 	return am.impl.ExportGenesis(ctx, cdc)
 }
@@ -0,0 +1,128 @@
+package module
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/Finschia/finschia-sdk/client"
+	"github.com/Finschia/finschia-sdk/codec"
+	codectypes "github.com/Finschia/finschia-sdk/codec/types"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/module"
+
+	"github.com/Finschia/finschia-sdk/x/stakingplus"
+	"github.com/Finschia/finschia-sdk/x/stakingplus/keeper"
+)
+
+var (
+	_ module.AppModule      = PlusAppModule{}
+	_ module.AppModuleBasic = PlusAppModuleBasic{}
+)
+
+// PlusAppModuleBasic defines the basic application module which keeps the
+// stakingplus specific state. Its codec, services and commands are the ones of
+// AppModuleBasic.
+type PlusAppModuleBasic struct{}
+
+// Name returns the stakingplus module's name.
+func (PlusAppModuleBasic) Name() string {
+	return stakingplus.ModuleName
+}
+
+// RegisterLegacyAminoCodec does nothing, as AppModuleBasic registers the stakingplus types.
+func (PlusAppModuleBasic) RegisterLegacyAminoCodec(_ *codec.LegacyAmino) {}
+
+// RegisterInterfaces does nothing, as AppModuleBasic registers the stakingplus types.
+func (PlusAppModuleBasic) RegisterInterfaces(_ codectypes.InterfaceRegistry) {}
+
+// DefaultGenesis returns default genesis state as raw bytes for the stakingplus
+// module.
+func (PlusAppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(stakingplus.DefaultGenesisState())
+}
+
+// ValidateGenesis performs genesis state validation for the stakingplus module.
+func (PlusAppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
+	var data stakingplus.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &data); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", stakingplus.ModuleName, err)
+	}
+
+	return data.Validate()
+}
+
+// RegisterGRPCGatewayRoutes does nothing, as AppModuleBasic registers the stakingplus routes.
+func (PlusAppModuleBasic) RegisterGRPCGatewayRoutes(_ client.Context, _ *runtime.ServeMux) {}
+
+// GetTxCmd returns nil, as AppModuleBasic provides the stakingplus commands.
+func (PlusAppModuleBasic) GetTxCmd() *cobra.Command {
+	return nil
+}
+
+// GetQueryCmd returns nil, as AppModuleBasic provides the stakingplus commands.
+func (PlusAppModuleBasic) GetQueryCmd() *cobra.Command {
+	return nil
+}
+
+//____________________________________________________________________________
+
+// PlusAppModule implements an application module which keeps the stakingplus
+// specific state in its own store.
+type PlusAppModule struct {
+	PlusAppModuleBasic
+
+	keeper     keeper.Keeper
+	stakingKey sdk.StoreKey
+}
+
+// NewPlusAppModule creates a new PlusAppModule object. The store key must be
+// the one of the staking module, which used to keep the stakingplus specific state.
+func NewPlusAppModule(keeper keeper.Keeper, stakingKey sdk.StoreKey) PlusAppModule {
+	return PlusAppModule{
+		keeper:     keeper,
+		stakingKey: stakingKey,
+	}
+}
+
+// RegisterInvariants does nothing, there are no invariants to enforce
+func (PlusAppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+
+// Route is empty, as the messages are routed by AppModule
+func (PlusAppModule) Route() sdk.Route { return sdk.Route{} }
+
+// QuerierRoute returns an empty string, as the queries are routed by AppModule
+func (PlusAppModule) QuerierRoute() string { return "" }
+
+// LegacyQuerierHandler returns nil, as the queries are routed by AppModule
+func (PlusAppModule) LegacyQuerierHandler(_ *codec.LegacyAmino) sdk.Querier { return nil }
+
+// RegisterServices registers the migrations of the stakingplus module. Its
+// services are registered by AppModule.
+func (am PlusAppModule) RegisterServices(cfg module.Configurator) {
+	if err := keeper.NewMigrator(am.keeper, am.stakingKey).Register(cfg.RegisterMigration); err != nil {
+		panic(err)
+	}
+}
+
+// InitGenesis performs genesis initialization for the stakingplus module. It returns
+// no validator updates.
+func (am PlusAppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
+	var genesisState stakingplus.GenesisState
+	cdc.MustUnmarshalJSON(data, &genesisState)
+
+	am.keeper.InitGenesis(ctx, &genesisState)
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis returns the exported genesis state as raw bytes for the stakingplus
+// module.
+func (am PlusAppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(am.keeper.ExportGenesis(ctx))
+}
+
+// ConsensusVersion implements AppModule/ConsensusVersion.
+func (PlusAppModule) ConsensusVersion() uint64 { return 2 }
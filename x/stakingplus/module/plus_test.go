@@ -0,0 +1,66 @@
+package module
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/codec"
+	codectypes "github.com/Finschia/finschia-sdk/codec/types"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/stakingplus"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	delegator := sdk.AccAddress("delegator").String()
+	validator := sdk.ValAddress("validator").String()
+
+	testCases := map[string]struct {
+		allowedDelegators []stakingplus.AllowedDelegator
+		valid             bool
+	}{
+		"default genesis": {
+			valid: true,
+		},
+		"valid allowed delegators": {
+			allowedDelegators: []stakingplus.AllowedDelegator{
+				{DelegatorAddress: delegator},
+				{ValidatorAddress: validator, DelegatorAddress: delegator},
+			},
+			valid: true,
+		},
+		"invalid validator": {
+			allowedDelegators: []stakingplus.AllowedDelegator{
+				{ValidatorAddress: "invalid", DelegatorAddress: delegator},
+			},
+		},
+		"invalid delegator": {
+			allowedDelegators: []stakingplus.AllowedDelegator{
+				{ValidatorAddress: validator, DelegatorAddress: "invalid"},
+			},
+		},
+		"duplicate allowed delegator": {
+			allowedDelegators: []stakingplus.AllowedDelegator{
+				{ValidatorAddress: validator, DelegatorAddress: delegator},
+				{ValidatorAddress: validator, DelegatorAddress: delegator},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			bz := cdc.MustMarshalJSON(&stakingplus.GenesisState{AllowedDelegators: tc.allowedDelegators})
+			err := PlusAppModuleBasic{}.ValidateGenesis(cdc, nil, bz)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	// the genesis state of the staking module is not the one of stakingplus
+	err := PlusAppModuleBasic{}.ValidateGenesis(cdc, nil, []byte(`{"params":{}}`))
+	require.Error(t, err)
+}
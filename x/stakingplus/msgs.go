@@ -0,0 +1,171 @@
+package stakingplus
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	stakingtypes "github.com/Finschia/finschia-sdk/x/staking/types"
+)
+
+var _ sdk.Msg = (*MsgRemoveValidator)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgRemoveValidator) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", m.Authority)
+	}
+
+	if _, err := sdk.ValAddressFromBech32(m.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", m.ValidatorAddress)
+	}
+
+	if len(m.Reason) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty reason")
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg.
+func (m MsgRemoveValidator) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgRemoveValidator) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgRemoveValidator) Route() string {
+	return stakingtypes.RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgRemoveValidator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgSetAllowListEnabled)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgSetAllowListEnabled) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", m.Authority)
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg.
+func (m MsgSetAllowListEnabled) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgSetAllowListEnabled) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgSetAllowListEnabled) Route() string {
+	return stakingtypes.RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgSetAllowListEnabled) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgAllowDelegators)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgAllowDelegators) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", m.Authority)
+	}
+
+	return validateAllowListEntries(m.ValidatorAddress, m.DelegatorAddresses)
+}
+
+// GetSigners implements Msg.
+func (m MsgAllowDelegators) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgAllowDelegators) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgAllowDelegators) Route() string {
+	return stakingtypes.RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgAllowDelegators) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgDisallowDelegators)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgDisallowDelegators) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", m.Authority)
+	}
+
+	return validateAllowListEntries(m.ValidatorAddress, m.DelegatorAddresses)
+}
+
+// GetSigners implements Msg.
+func (m MsgDisallowDelegators) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgDisallowDelegators) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgDisallowDelegators) Route() string {
+	return stakingtypes.RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgDisallowDelegators) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// validateAllowListEntries validates the entries of an allow-list.
+// An empty validator address refers to the global allow-list.
+func validateAllowListEntries(validatorAddress string, delegatorAddresses []string) error {
+	if len(validatorAddress) != 0 {
+		if _, err := sdk.ValAddressFromBech32(validatorAddress); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", validatorAddress)
+		}
+	}
+
+	if len(delegatorAddresses) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty delegators")
+	}
+
+	seen := map[string]bool{}
+	for _, delegator := range delegatorAddresses {
+		if _, err := sdk.AccAddressFromBech32(delegator); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", delegator)
+		}
+
+		if seen[delegator] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate delegator: %s", delegator)
+		}
+		seen[delegator] = true
+	}
+
+	return nil
+}
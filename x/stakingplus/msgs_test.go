@@ -0,0 +1,147 @@
+package stakingplus_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/crypto/keys/secp256k1"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/stakingplus"
+)
+
+func TestMsgRemoveValidator(t *testing.T) {
+	authority := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	valAddr := sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	testCases := map[string]struct {
+		authority sdk.AccAddress
+		validator sdk.ValAddress
+		reason    string
+		valid     bool
+	}{
+		"valid msg": {
+			authority: authority,
+			validator: valAddr,
+			reason:    "double sign",
+			valid:     true,
+		},
+		"invalid authority": {
+			validator: valAddr,
+			reason:    "double sign",
+		},
+		"invalid validator": {
+			authority: authority,
+			reason:    "double sign",
+		},
+		"empty reason": {
+			authority: authority,
+			validator: valAddr,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := stakingplus.MsgRemoveValidator{
+				Authority:        tc.authority.String(),
+				ValidatorAddress: tc.validator.String(),
+				Reason:           tc.reason,
+			}
+
+			err := msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, []sdk.AccAddress{tc.authority}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgRemoveValidatorAminoJson(t *testing.T) {
+	authority := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	valAddr := sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	msg := stakingplus.MsgRemoveValidator{
+		Authority:        authority.String(),
+		ValidatorAddress: valAddr.String(),
+		Tombstone:        true,
+		Reason:           "double sign",
+	}
+	expected := fmt.Sprintf("{\"type\":\"lbm-sdk/MsgRemoveValidator\",\"value\":{\"authority\":\"%s\",\"reason\":\"double sign\",\"tombstone\":true,\"validator_address\":\"%s\"}}", authority.String(), valAddr.String())
+	require.Equal(t, expected, string(msg.GetSignBytes()))
+}
+
+func TestMsgAllowDelegators(t *testing.T) {
+	authority := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	valAddr := sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address())
+	delegator := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	testCases := map[string]struct {
+		authority  sdk.AccAddress
+		validator  string
+		delegators []string
+		valid      bool
+	}{
+		"valid msg (global)": {
+			authority:  authority,
+			delegators: []string{delegator.String()},
+			valid:      true,
+		},
+		"valid msg (validator)": {
+			authority:  authority,
+			validator:  valAddr.String(),
+			delegators: []string{delegator.String()},
+			valid:      true,
+		},
+		"invalid authority": {
+			delegators: []string{delegator.String()},
+		},
+		"invalid validator": {
+			authority:  authority,
+			validator:  "invalid",
+			delegators: []string{delegator.String()},
+		},
+		"empty delegators": {
+			authority: authority,
+		},
+		"invalid delegator": {
+			authority:  authority,
+			delegators: []string{"invalid"},
+		},
+		"duplicate delegators": {
+			authority:  authority,
+			delegators: []string{delegator.String(), delegator.String()},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msgs := []sdk.Msg{
+				&stakingplus.MsgAllowDelegators{
+					Authority:          tc.authority.String(),
+					ValidatorAddress:   tc.validator,
+					DelegatorAddresses: tc.delegators,
+				},
+				&stakingplus.MsgDisallowDelegators{
+					Authority:          tc.authority.String(),
+					ValidatorAddress:   tc.validator,
+					DelegatorAddresses: tc.delegators,
+				},
+			}
+			for _, msg := range msgs {
+				err := msg.ValidateBasic()
+				if !tc.valid {
+					require.Error(t, err)
+					continue
+				}
+				require.NoError(t, err)
+
+				require.Equal(t, []sdk.AccAddress{tc.authority}, msg.GetSigners())
+			}
+		})
+	}
+}
@@ -0,0 +1,1483 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lbm/stakingplus/v1/query.proto
+
+package stakingplus
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/Finschia/finschia-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryAllowListEnabledRequest is the Query/AllowListEnabled request type.
+type QueryAllowListEnabledRequest struct {
+}
+
+func (m *QueryAllowListEnabledRequest) Reset()         { *m = QueryAllowListEnabledRequest{} }
+func (m *QueryAllowListEnabledRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowListEnabledRequest) ProtoMessage()    {}
+func (*QueryAllowListEnabledRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c3be2b03ff7a5a0, []int{0}
+}
+func (m *QueryAllowListEnabledRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowListEnabledRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowListEnabledRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowListEnabledRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowListEnabledRequest.Merge(m, src)
+}
+func (m *QueryAllowListEnabledRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowListEnabledRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowListEnabledRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowListEnabledRequest proto.InternalMessageInfo
+
+// QueryAllowListEnabledResponse is the Query/AllowListEnabled response type.
+type QueryAllowListEnabledResponse struct {
+	// enabled is true if the delegator allow-list is enforced.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *QueryAllowListEnabledResponse) Reset()         { *m = QueryAllowListEnabledResponse{} }
+func (m *QueryAllowListEnabledResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowListEnabledResponse) ProtoMessage()    {}
+func (*QueryAllowListEnabledResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c3be2b03ff7a5a0, []int{1}
+}
+func (m *QueryAllowListEnabledResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowListEnabledResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowListEnabledResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowListEnabledResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowListEnabledResponse.Merge(m, src)
+}
+func (m *QueryAllowListEnabledResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowListEnabledResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowListEnabledResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowListEnabledResponse proto.InternalMessageInfo
+
+func (m *QueryAllowListEnabledResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+// QueryAllowedDelegatorsRequest is the Query/AllowedDelegators request type.
+type QueryAllowedDelegatorsRequest struct {
+	// validator_address is the operator address of the validator.
+	// empty value means the global allow-list.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllowedDelegatorsRequest) Reset()         { *m = QueryAllowedDelegatorsRequest{} }
+func (m *QueryAllowedDelegatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowedDelegatorsRequest) ProtoMessage()    {}
+func (*QueryAllowedDelegatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c3be2b03ff7a5a0, []int{2}
+}
+func (m *QueryAllowedDelegatorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowedDelegatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowedDelegatorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowedDelegatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowedDelegatorsRequest.Merge(m, src)
+}
+func (m *QueryAllowedDelegatorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowedDelegatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowedDelegatorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowedDelegatorsRequest proto.InternalMessageInfo
+
+func (m *QueryAllowedDelegatorsRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *QueryAllowedDelegatorsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllowedDelegatorsResponse is the Query/AllowedDelegators response type.
+type QueryAllowedDelegatorsResponse struct {
+	// allowed_delegators are the delegators on the allow-list.
+	AllowedDelegators []AllowedDelegator `protobuf:"bytes,1,rep,name=allowed_delegators,json=allowedDelegators,proto3" json:"allowed_delegators"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllowedDelegatorsResponse) Reset()         { *m = QueryAllowedDelegatorsResponse{} }
+func (m *QueryAllowedDelegatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowedDelegatorsResponse) ProtoMessage()    {}
+func (*QueryAllowedDelegatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c3be2b03ff7a5a0, []int{3}
+}
+func (m *QueryAllowedDelegatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowedDelegatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowedDelegatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowedDelegatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowedDelegatorsResponse.Merge(m, src)
+}
+func (m *QueryAllowedDelegatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowedDelegatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowedDelegatorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowedDelegatorsResponse proto.InternalMessageInfo
+
+func (m *QueryAllowedDelegatorsResponse) GetAllowedDelegators() []AllowedDelegator {
+	if m != nil {
+		return m.AllowedDelegators
+	}
+	return nil
+}
+
+func (m *QueryAllowedDelegatorsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryIsAllowedDelegatorRequest is the Query/IsAllowedDelegator request type.
+type QueryIsAllowedDelegatorRequest struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *QueryIsAllowedDelegatorRequest) Reset()         { *m = QueryIsAllowedDelegatorRequest{} }
+func (m *QueryIsAllowedDelegatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIsAllowedDelegatorRequest) ProtoMessage()    {}
+func (*QueryIsAllowedDelegatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c3be2b03ff7a5a0, []int{4}
+}
+func (m *QueryIsAllowedDelegatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIsAllowedDelegatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIsAllowedDelegatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIsAllowedDelegatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIsAllowedDelegatorRequest.Merge(m, src)
+}
+func (m *QueryIsAllowedDelegatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIsAllowedDelegatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIsAllowedDelegatorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIsAllowedDelegatorRequest proto.InternalMessageInfo
+
+func (m *QueryIsAllowedDelegatorRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *QueryIsAllowedDelegatorRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+// QueryIsAllowedDelegatorResponse is the Query/IsAllowedDelegator response type.
+type QueryIsAllowedDelegatorResponse struct {
+	// allowed is true if the delegator may delegate to the validator.
+	Allowed bool `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+}
+
+func (m *QueryIsAllowedDelegatorResponse) Reset()         { *m = QueryIsAllowedDelegatorResponse{} }
+func (m *QueryIsAllowedDelegatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIsAllowedDelegatorResponse) ProtoMessage()    {}
+func (*QueryIsAllowedDelegatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c3be2b03ff7a5a0, []int{5}
+}
+func (m *QueryIsAllowedDelegatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIsAllowedDelegatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIsAllowedDelegatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIsAllowedDelegatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIsAllowedDelegatorResponse.Merge(m, src)
+}
+func (m *QueryIsAllowedDelegatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIsAllowedDelegatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIsAllowedDelegatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIsAllowedDelegatorResponse proto.InternalMessageInfo
+
+func (m *QueryIsAllowedDelegatorResponse) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*QueryAllowListEnabledRequest)(nil), "lbm.stakingplus.v1.QueryAllowListEnabledRequest")
+	proto.RegisterType((*QueryAllowListEnabledResponse)(nil), "lbm.stakingplus.v1.QueryAllowListEnabledResponse")
+	proto.RegisterType((*QueryAllowedDelegatorsRequest)(nil), "lbm.stakingplus.v1.QueryAllowedDelegatorsRequest")
+	proto.RegisterType((*QueryAllowedDelegatorsResponse)(nil), "lbm.stakingplus.v1.QueryAllowedDelegatorsResponse")
+	proto.RegisterType((*QueryIsAllowedDelegatorRequest)(nil), "lbm.stakingplus.v1.QueryIsAllowedDelegatorRequest")
+	proto.RegisterType((*QueryIsAllowedDelegatorResponse)(nil), "lbm.stakingplus.v1.QueryIsAllowedDelegatorResponse")
+}
+
+func init() { proto.RegisterFile("lbm/stakingplus/v1/query.proto", fileDescriptor_2c3be2b03ff7a5a0) }
+
+var fileDescriptor_2c3be2b03ff7a5a0 = []byte{
+	// 553 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4d, 0x6f, 0xd3, 0x30,
+	0x18, 0xae, 0xcb, 0xf8, 0xf2, 0x2e, 0xab, 0xc5, 0xa1, 0xaa, 0x46, 0x56, 0x45, 0xd3, 0xa8, 0x98,
+	0xb0, 0x69, 0x77, 0x42, 0x9c, 0x36, 0xc1, 0x00, 0x89, 0x03, 0xe4, 0x36, 0x38, 0x54, 0x4e, 0x63,
+	0x32, 0x6b, 0x6e, 0x9c, 0xd5, 0x6e, 0xf9, 0x98, 0x76, 0xe1, 0x17, 0x20, 0xc1, 0x7f, 0x40, 0xe2,
+	0x5f, 0x20, 0x71, 0xd8, 0x71, 0x12, 0x17, 0x4e, 0x68, 0x6a, 0xf9, 0x21, 0xa8, 0xb1, 0x97, 0x65,
+	0x4d, 0xc2, 0xe8, 0x6e, 0xce, 0xfb, 0xbc, 0xaf, 0xdf, 0xe7, 0x79, 0xfc, 0xb4, 0xd0, 0x11, 0x7e,
+	0x9f, 0x28, 0x4d, 0xf7, 0x78, 0x14, 0xc6, 0x62, 0xa8, 0xc8, 0xa8, 0x4d, 0xf6, 0x87, 0x6c, 0xf0,
+	0x1e, 0xc7, 0x03, 0xa9, 0x25, 0x42, 0xc2, 0xef, 0xe3, 0x0c, 0x8e, 0x47, 0xed, 0xc6, 0xdd, 0x9e,
+	0x54, 0x7d, 0xa9, 0x88, 0x4f, 0x15, 0x33, 0xcd, 0x64, 0xd4, 0xf6, 0x99, 0xa6, 0x6d, 0x12, 0xd3,
+	0x90, 0x47, 0x54, 0x73, 0x19, 0x99, 0xf9, 0xc6, 0x72, 0x28, 0x65, 0x28, 0x18, 0xa1, 0x31, 0x27,
+	0x34, 0x8a, 0xa4, 0x4e, 0x40, 0x65, 0xd1, 0x5b, 0xa1, 0x0c, 0x65, 0x72, 0x24, 0xd3, 0x93, 0xad,
+	0xae, 0x16, 0x70, 0xca, 0x52, 0x48, 0xba, 0x5c, 0x07, 0x2e, 0xbf, 0x9c, 0xee, 0xde, 0x14, 0x42,
+	0xbe, 0x7d, 0xce, 0x95, 0x7e, 0x1c, 0x51, 0x5f, 0xb0, 0xc0, 0x63, 0xfb, 0x43, 0xa6, 0xb4, 0xfb,
+	0x00, 0xde, 0x2e, 0xc1, 0x55, 0x2c, 0x23, 0xc5, 0x50, 0x1d, 0x5e, 0x67, 0xa6, 0x54, 0x07, 0x4d,
+	0xd0, 0xba, 0xe1, 0x9d, 0x7e, 0xba, 0x5f, 0x40, 0x76, 0x96, 0x05, 0x8f, 0x98, 0x60, 0x21, 0xd5,
+	0x72, 0xa0, 0xec, 0xe5, 0x68, 0x1d, 0xd6, 0x46, 0x54, 0xf0, 0x60, 0x5a, 0xec, 0xd2, 0x20, 0x18,
+	0x30, 0xa5, 0x92, 0x5b, 0x6e, 0x7a, 0x4b, 0x29, 0xb0, 0x69, 0xea, 0x68, 0x1b, 0xc2, 0x33, 0x5f,
+	0xea, 0xd5, 0x26, 0x68, 0x2d, 0x76, 0xd6, 0xb0, 0x31, 0x11, 0x4f, 0x4d, 0xc4, 0xc6, 0x71, 0x6b,
+	0x22, 0x7e, 0x41, 0x43, 0x66, 0x17, 0x79, 0x99, 0x49, 0xf7, 0x07, 0x80, 0x4e, 0x19, 0x2d, 0xab,
+	0x69, 0x07, 0x22, 0x6a, 0xc0, 0x6e, 0x90, 0xa2, 0x75, 0xd0, 0xbc, 0xd2, 0x5a, 0xec, 0xac, 0xe2,
+	0xfc, 0x5b, 0xe2, 0xd9, 0xab, 0xb6, 0x16, 0x8e, 0x7e, 0xaf, 0x54, 0xbc, 0x1a, 0x9d, 0x5d, 0x81,
+	0x9e, 0x14, 0xa8, 0xb8, 0x73, 0xa1, 0x0a, 0xc3, 0xeb, 0x9c, 0x8c, 0x0f, 0x56, 0xc5, 0x33, 0x35,
+	0xbb, 0xfc, 0x52, 0xee, 0xae, 0xc3, 0x5a, 0x2a, 0x35, 0x6d, 0xae, 0x9a, 0xe6, 0x14, 0xb0, 0xcd,
+	0xee, 0x43, 0xb8, 0x52, 0xba, 0xfb, 0x2c, 0x16, 0x56, 0xfc, 0x69, 0x2c, 0xec, 0x67, 0xe7, 0xfb,
+	0x02, 0xbc, 0x9a, 0x4c, 0xa3, 0xaf, 0x00, 0x2e, 0xcd, 0xe6, 0x0a, 0xdd, 0x2f, 0xf2, 0xf7, 0x5f,
+	0x11, 0x6d, 0xb4, 0xe7, 0x98, 0x30, 0xec, 0x5c, 0xfc, 0xf1, 0xe7, 0x9f, 0xcf, 0xd5, 0x16, 0x5a,
+	0x23, 0x05, 0x3f, 0x92, 0x84, 0x68, 0x57, 0x70, 0xa5, 0xbb, 0x36, 0xca, 0xe8, 0x1b, 0x80, 0xb5,
+	0x5c, 0x5c, 0xd0, 0x05, 0x8b, 0x0b, 0x12, 0xdf, 0xe8, 0xcc, 0x33, 0xf2, 0xdf, 0x64, 0xcf, 0xe5,
+	0x14, 0x9d, 0x00, 0x88, 0xf2, 0x2f, 0x83, 0xca, 0x57, 0x97, 0x46, 0xa8, 0xb1, 0x31, 0xd7, 0x8c,
+	0xe5, 0x4b, 0x13, 0xbe, 0xaf, 0xd1, 0x4e, 0x11, 0xdf, 0x34, 0x78, 0x8a, 0x1c, 0xe4, 0xd2, 0x79,
+	0x58, 0x20, 0x87, 0x1c, 0xe4, 0x72, 0x79, 0xb8, 0xf5, 0xf4, 0x68, 0xec, 0x80, 0xe3, 0xb1, 0x03,
+	0x4e, 0xc6, 0x0e, 0xf8, 0x34, 0x71, 0x2a, 0xc7, 0x13, 0xa7, 0xf2, 0x6b, 0xe2, 0x54, 0x5e, 0xe1,
+	0x90, 0xeb, 0xdd, 0xa1, 0x8f, 0x7b, 0xb2, 0x4f, 0xb6, 0x79, 0xa4, 0x7a, 0xbb, 0x9c, 0x92, 0x37,
+	0xf6, 0x70, 0x4f, 0x05, 0x7b, 0xe4, 0x5d, 0x96, 0x92, 0x7f, 0x2d, 0xf9, 0x1b, 0xdc, 0xf8, 0x3b,
+	0x00, 0x38, 0x81, 0x2d, 0x15, 0xc2, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// AllowListEnabled queries whether the delegator allow-list is enforced or not.
+	AllowListEnabled(ctx context.Context, in *QueryAllowListEnabledRequest, opts ...grpc.CallOption) (*QueryAllowListEnabledResponse, error)
+	// AllowedDelegators queries the delegators on the allow-list of a validator.
+	// If the validator address is empty, it queries the global allow-list.
+	AllowedDelegators(ctx context.Context, in *QueryAllowedDelegatorsRequest, opts ...grpc.CallOption) (*QueryAllowedDelegatorsResponse, error)
+	// IsAllowedDelegator queries whether the delegator may delegate to the validator or not.
+	IsAllowedDelegator(ctx context.Context, in *QueryIsAllowedDelegatorRequest, opts ...grpc.CallOption) (*QueryIsAllowedDelegatorResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) AllowListEnabled(ctx context.Context, in *QueryAllowListEnabledRequest, opts ...grpc.CallOption) (*QueryAllowListEnabledResponse, error) {
+	out := new(QueryAllowListEnabledResponse)
+	err := c.cc.Invoke(ctx, "/lbm.stakingplus.v1.Query/AllowListEnabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllowedDelegators(ctx context.Context, in *QueryAllowedDelegatorsRequest, opts ...grpc.CallOption) (*QueryAllowedDelegatorsResponse, error) {
+	out := new(QueryAllowedDelegatorsResponse)
+	err := c.cc.Invoke(ctx, "/lbm.stakingplus.v1.Query/AllowedDelegators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IsAllowedDelegator(ctx context.Context, in *QueryIsAllowedDelegatorRequest, opts ...grpc.CallOption) (*QueryIsAllowedDelegatorResponse, error) {
+	out := new(QueryIsAllowedDelegatorResponse)
+	err := c.cc.Invoke(ctx, "/lbm.stakingplus.v1.Query/IsAllowedDelegator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// AllowListEnabled queries whether the delegator allow-list is enforced or not.
+	AllowListEnabled(context.Context, *QueryAllowListEnabledRequest) (*QueryAllowListEnabledResponse, error)
+	// AllowedDelegators queries the delegators on the allow-list of a validator.
+	// If the validator address is empty, it queries the global allow-list.
+	AllowedDelegators(context.Context, *QueryAllowedDelegatorsRequest) (*QueryAllowedDelegatorsResponse, error)
+	// IsAllowedDelegator queries whether the delegator may delegate to the validator or not.
+	IsAllowedDelegator(context.Context, *QueryIsAllowedDelegatorRequest) (*QueryIsAllowedDelegatorResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) AllowListEnabled(ctx context.Context, req *QueryAllowListEnabledRequest) (*QueryAllowListEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowListEnabled not implemented")
+}
+func (*UnimplementedQueryServer) AllowedDelegators(ctx context.Context, req *QueryAllowedDelegatorsRequest) (*QueryAllowedDelegatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowedDelegators not implemented")
+}
+func (*UnimplementedQueryServer) IsAllowedDelegator(ctx context.Context, req *QueryIsAllowedDelegatorRequest) (*QueryIsAllowedDelegatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsAllowedDelegator not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_AllowListEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowListEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowListEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.stakingplus.v1.Query/AllowListEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowListEnabled(ctx, req.(*QueryAllowListEnabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowedDelegators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowedDelegatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowedDelegators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.stakingplus.v1.Query/AllowedDelegators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowedDelegators(ctx, req.(*QueryAllowedDelegatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IsAllowedDelegator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIsAllowedDelegatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IsAllowedDelegator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.stakingplus.v1.Query/IsAllowedDelegator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IsAllowedDelegator(ctx, req.(*QueryIsAllowedDelegatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lbm.stakingplus.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AllowListEnabled",
+			Handler:    _Query_AllowListEnabled_Handler,
+		},
+		{
+			MethodName: "AllowedDelegators",
+			Handler:    _Query_AllowedDelegators_Handler,
+		},
+		{
+			MethodName: "IsAllowedDelegator",
+			Handler:    _Query_IsAllowedDelegator_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lbm/stakingplus/v1/query.proto",
+}
+
+func (m *QueryAllowListEnabledRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowListEnabledRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowListEnabledRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowListEnabledResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowListEnabledResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowListEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedDelegatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedDelegatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedDelegatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedDelegatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedDelegatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedDelegatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AllowedDelegators) > 0 {
+		for iNdEx := len(m.AllowedDelegators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedDelegators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsAllowedDelegatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsAllowedDelegatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsAllowedDelegatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsAllowedDelegatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsAllowedDelegatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsAllowedDelegatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Allowed {
+		i--
+		if m.Allowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAllowListEnabledRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllowListEnabledResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryAllowedDelegatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowedDelegatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedDelegators) > 0 {
+		for _, e := range m.AllowedDelegators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsAllowedDelegatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsAllowedDelegatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowed {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAllowListEnabledRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowListEnabledRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowListEnabledRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowListEnabledResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowListEnabledResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowListEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowedDelegatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowedDelegatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowedDelegatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowedDelegatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowedDelegatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowedDelegatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDelegators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDelegators = append(m.AllowedDelegators, AllowedDelegator{})
+			if err := m.AllowedDelegators[len(m.AllowedDelegators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIsAllowedDelegatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIsAllowedDelegatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIsAllowedDelegatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIsAllowedDelegatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIsAllowedDelegatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIsAllowedDelegatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Allowed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -6,9 +6,11 @@ package testutil
 
 import (
 	reflect "reflect"
+	time "time"
 
-	gomock "github.com/golang/mock/gomock"
 	types "github.com/Finschia/finschia-sdk/types"
+	types0 "github.com/Finschia/finschia-sdk/x/slashing/types"
+	gomock "github.com/golang/mock/gomock"
 )
 
 // MockFoundationKeeper is a mock of FoundationKeeper interface.
@@ -47,3 +49,132 @@ func (mr *MockFoundationKeeperMockRecorder) Accept(ctx, grantee, msg interface{}
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Accept", reflect.TypeOf((*MockFoundationKeeper)(nil).Accept), ctx, grantee, msg)
 }
+
+// GetAuthority mocks base method.
+func (m *MockFoundationKeeper) GetAuthority() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthority")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetAuthority indicates an expected call of GetAuthority.
+func (mr *MockFoundationKeeperMockRecorder) GetAuthority() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthority", reflect.TypeOf((*MockFoundationKeeper)(nil).GetAuthority))
+}
+
+// Revoke mocks base method.
+func (m *MockFoundationKeeper) Revoke(ctx types.Context, grantee types.AccAddress, msgTypeURL string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, grantee, msgTypeURL)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockFoundationKeeperMockRecorder) Revoke(ctx, grantee, msgTypeURL interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockFoundationKeeper)(nil).Revoke), ctx, grantee, msgTypeURL)
+}
+
+// MockSlashingKeeper is a mock of SlashingKeeper interface.
+type MockSlashingKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockSlashingKeeperMockRecorder
+}
+
+// MockSlashingKeeperMockRecorder is the mock recorder for MockSlashingKeeper.
+type MockSlashingKeeperMockRecorder struct {
+	mock *MockSlashingKeeper
+}
+
+// NewMockSlashingKeeper creates a new mock instance.
+func NewMockSlashingKeeper(ctrl *gomock.Controller) *MockSlashingKeeper {
+	mock := &MockSlashingKeeper{ctrl: ctrl}
+	mock.recorder = &MockSlashingKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSlashingKeeper) EXPECT() *MockSlashingKeeperMockRecorder {
+	return m.recorder
+}
+
+// DowntimeJailDuration mocks base method.
+func (m *MockSlashingKeeper) DowntimeJailDuration(ctx types.Context) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DowntimeJailDuration", ctx)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// DowntimeJailDuration indicates an expected call of DowntimeJailDuration.
+func (mr *MockSlashingKeeperMockRecorder) DowntimeJailDuration(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DowntimeJailDuration", reflect.TypeOf((*MockSlashingKeeper)(nil).DowntimeJailDuration), ctx)
+}
+
+// HasValidatorSigningInfo mocks base method.
+func (m *MockSlashingKeeper) HasValidatorSigningInfo(ctx types.Context, consAddr types.ConsAddress) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasValidatorSigningInfo", ctx, consAddr)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// HasValidatorSigningInfo indicates an expected call of HasValidatorSigningInfo.
+func (mr *MockSlashingKeeperMockRecorder) HasValidatorSigningInfo(ctx, consAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasValidatorSigningInfo", reflect.TypeOf((*MockSlashingKeeper)(nil).HasValidatorSigningInfo), ctx, consAddr)
+}
+
+// IsTombstoned mocks base method.
+func (m *MockSlashingKeeper) IsTombstoned(ctx types.Context, consAddr types.ConsAddress) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsTombstoned", ctx, consAddr)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsTombstoned indicates an expected call of IsTombstoned.
+func (mr *MockSlashingKeeperMockRecorder) IsTombstoned(ctx, consAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTombstoned", reflect.TypeOf((*MockSlashingKeeper)(nil).IsTombstoned), ctx, consAddr)
+}
+
+// JailUntil mocks base method.
+func (m *MockSlashingKeeper) JailUntil(ctx types.Context, consAddr types.ConsAddress, jailTime time.Time) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "JailUntil", ctx, consAddr, jailTime)
+}
+
+// JailUntil indicates an expected call of JailUntil.
+func (mr *MockSlashingKeeperMockRecorder) JailUntil(ctx, consAddr, jailTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JailUntil", reflect.TypeOf((*MockSlashingKeeper)(nil).JailUntil), ctx, consAddr, jailTime)
+}
+
+// SetValidatorSigningInfo mocks base method.
+func (m *MockSlashingKeeper) SetValidatorSigningInfo(ctx types.Context, address types.ConsAddress, info types0.ValidatorSigningInfo) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetValidatorSigningInfo", ctx, address, info)
+}
+
+// SetValidatorSigningInfo indicates an expected call of SetValidatorSigningInfo.
+func (mr *MockSlashingKeeperMockRecorder) SetValidatorSigningInfo(ctx, address, info interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetValidatorSigningInfo", reflect.TypeOf((*MockSlashingKeeper)(nil).SetValidatorSigningInfo), ctx, address, info)
+}
+
+// Tombstone mocks base method.
+func (m *MockSlashingKeeper) Tombstone(ctx types.Context, consAddr types.ConsAddress) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Tombstone", ctx, consAddr)
+}
+
+// Tombstone indicates an expected call of Tombstone.
+func (mr *MockSlashingKeeperMockRecorder) Tombstone(ctx, consAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tombstone", reflect.TypeOf((*MockSlashingKeeper)(nil).Tombstone), ctx, consAddr)
+}
@@ -0,0 +1,201 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Finschia/finschia-sdk/client"
+	"github.com/Finschia/finschia-sdk/client/flags"
+	"github.com/Finschia/finschia-sdk/version"
+	"github.com/Finschia/finschia-sdk/x/swap"
+)
+
+const (
+	FlagDenom      = "denom"
+	FlagContractID = "contract-id"
+	FlagTokenID    = "token-id"
+)
+
+// NewQueryCmd returns the cli query commands for this module
+func NewQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        swap.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", swap.ModuleName),
+		Long:                       "",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		NewQueryCmdOffer(),
+		NewQueryCmdOffers(),
+		NewQueryCmdOffersByMaker(),
+		NewQueryCmdOffersByAsset(),
+	)
+
+	return queryCmd
+}
+
+func NewQueryCmdOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "offer [offer-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "query an offer",
+		Example: fmt.Sprintf(`$ %s query %s offer <offer-id>`, version.AppName, swap.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			offerID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := swap.NewQueryClient(clientCtx)
+			res, err := queryClient.Offer(cmd.Context(), &swap.QueryOfferRequest{
+				OfferId: offerID,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewQueryCmdOffers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "offers",
+		Args:    cobra.NoArgs,
+		Short:   "query all the offers",
+		Example: fmt.Sprintf(`$ %s query %s offers`, version.AppName, swap.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := swap.NewQueryClient(clientCtx)
+			res, err := queryClient.Offers(cmd.Context(), &swap.QueryOffersRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "offers")
+	return cmd
+}
+
+func NewQueryCmdOffersByMaker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "offers-by-maker [maker]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "query the offers made by a maker",
+		Example: fmt.Sprintf(`$ %s query %s offers-by-maker <maker>`, version.AppName, swap.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := swap.NewQueryClient(clientCtx)
+			res, err := queryClient.OffersByMaker(cmd.Context(), &swap.QueryOffersByMakerRequest{
+				Maker:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "offers-by-maker")
+	return cmd
+}
+
+func NewQueryCmdOffersByAsset() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "offers-by-asset [coin|token|collection]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query the offers which offer an asset",
+		Example: fmt.Sprintf(`$ %[1]s query %[2]s offers-by-asset coin --%[3]s <denom>
+$ %[1]s query %[2]s offers-by-asset token --%[4]s <contract-id>
+$ %[1]s query %[2]s offers-by-asset collection --%[4]s <contract-id> --%[5]s <token-id>`,
+			version.AppName, swap.ModuleName, FlagDenom, FlagContractID, FlagTokenID),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			assetType := map[string]swap.AssetType{
+				"coin":       swap.AssetTypeCoin,
+				"token":      swap.AssetTypeToken,
+				"collection": swap.AssetTypeCollection,
+			}[args[0]]
+
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+			contractID, err := cmd.Flags().GetString(FlagContractID)
+			if err != nil {
+				return err
+			}
+			tokenID, err := cmd.Flags().GetString(FlagTokenID)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := swap.NewQueryClient(clientCtx)
+			res, err := queryClient.OffersByAsset(cmd.Context(), &swap.QueryOffersByAssetRequest{
+				Type:       assetType,
+				Denom:      denom,
+				ContractId: contractID,
+				TokenId:    tokenID,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "offers-by-asset")
+	cmd.Flags().String(FlagDenom, "", "denom of the coins")
+	cmd.Flags().String(FlagContractID, "", "contract id of the token or collection")
+	cmd.Flags().String(FlagTokenID, "", "token id of the collection")
+	return cmd
+}
@@ -0,0 +1,208 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Finschia/finschia-sdk/client"
+	"github.com/Finschia/finschia-sdk/client/flags"
+	"github.com/Finschia/finschia-sdk/client/tx"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/version"
+	"github.com/Finschia/finschia-sdk/x/swap"
+)
+
+// NewTxCmd returns the transaction commands for this module
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        swap.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", swap.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewTxCmdOffer(),
+		NewTxCmdAccept(),
+		NewTxCmdCancel(),
+	)
+
+	return txCmd
+}
+
+// parseAsset parses the asset of the following formats:
+// - coin:<amount><denom>
+// - token:<contract-id>:<amount>
+// - collection:<contract-id>:<token-id>:<amount>
+func parseAsset(str string) (*swap.Asset, error) {
+	fields := strings.Split(str, ":")
+	parseAmount := func(amountStr string) (sdk.Int, error) {
+		amount, ok := sdk.NewIntFromString(amountStr)
+		if !ok {
+			return sdk.Int{}, sdkerrors.ErrInvalidType.Wrapf("failed to set amount: %s", amountStr)
+		}
+		return amount, nil
+	}
+
+	switch {
+	case fields[0] == "coin" && len(fields) == 2:
+		coin, err := sdk.ParseCoinNormalized(fields[1])
+		if err != nil {
+			return nil, err
+		}
+		return &swap.Asset{
+			Type:   swap.AssetTypeCoin,
+			Denom:  coin.Denom,
+			Amount: coin.Amount,
+		}, nil
+	case fields[0] == "token" && len(fields) == 3:
+		amount, err := parseAmount(fields[2])
+		if err != nil {
+			return nil, err
+		}
+		return &swap.Asset{
+			Type:       swap.AssetTypeToken,
+			ContractId: fields[1],
+			Amount:     amount,
+		}, nil
+	case fields[0] == "collection" && len(fields) == 4:
+		amount, err := parseAmount(fields[3])
+		if err != nil {
+			return nil, err
+		}
+		return &swap.Asset{
+			Type:       swap.AssetTypeCollection,
+			ContractId: fields[1],
+			TokenId:    fields[2],
+			Amount:     amount,
+		}, nil
+	default:
+		return nil, swap.ErrInvalidAsset.Wrapf("invalid asset format: %s", str)
+	}
+}
+
+func NewTxCmdOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "offer [maker] [offer] [ask] [expiry]",
+		Args:  cobra.ExactArgs(4),
+		Short: "offer an asset in exchange for another asset",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+The assets are of the following formats:
+- coin:<amount><denom>
+- token:<contract-id>:<amount>
+- collection:<contract-id>:<token-id>:<amount>
+
+The expiry is of RFC3339 format.
+
+Example:
+$ %s tx %s offer <maker> coin:100stake token:deadbeef:10 2030-01-01T00:00:00Z`, version.AppName, swap.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			offer, err := parseAsset(args[1])
+			if err != nil {
+				return err
+			}
+			ask, err := parseAsset(args[2])
+			if err != nil {
+				return err
+			}
+			expiry, err := time.Parse(time.RFC3339, args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := &swap.MsgOffer{
+				Maker:  args[0],
+				Offer:  *offer,
+				Ask:    *ask,
+				Expiry: expiry,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdAccept() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept [taker] [offer-id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "accept an offer",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s accept <taker> <offer-id>`, version.AppName, swap.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			offerID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &swap.MsgAccept{
+				Taker:   args[0],
+				OfferId: offerID,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdCancel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel [maker] [offer-id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "cancel an offer",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s cancel <maker> <offer-id>`, version.AppName, swap.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			offerID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &swap.MsgCancel{
+				Maker:   args[0],
+				OfferId: offerID,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
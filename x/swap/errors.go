@@ -0,0 +1,12 @@
+package swap
+
+import (
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+)
+
+const swapCodespace = ModuleName
+
+var (
+	ErrInvalidAsset = sdkerrors.Register(swapCodespace, 2, "invalid asset")
+	ErrOfferExpired = sdkerrors.Register(swapCodespace, 3, "offer expired")
+)
@@ -0,0 +1,34 @@
+package swap
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/collection"
+)
+
+type (
+	// AccountKeeper defines the account module interface contract needed by the
+	// swap module.
+	AccountKeeper interface {
+		GetModuleAddress(moduleName string) sdk.AccAddress
+	}
+
+	// BankKeeper defines the bank module interface contract needed by the
+	// swap module.
+	BankKeeper interface {
+		SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+	}
+
+	// TokenKeeper defines the token module interface contract needed by the
+	// swap module.
+	TokenKeeper interface {
+		Send(ctx sdk.Context, contractID string, from, to sdk.AccAddress, amount sdk.Int) error
+	}
+
+	// CollectionKeeper defines the collection module interface contract needed by the
+	// swap module.
+	CollectionKeeper interface {
+		SendCoins(ctx sdk.Context, contractID string, from, to sdk.AccAddress, amount []collection.Coin) error
+		GetNFT(ctx sdk.Context, contractID string, tokenID string) (*collection.NFT, error)
+		GetParent(ctx sdk.Context, contractID string, tokenID string) (*string, error)
+	}
+)
@@ -0,0 +1,37 @@
+package swap
+
+import (
+	"fmt"
+)
+
+// ValidateGenesis check the given genesis state has no integrity issues
+func ValidateGenesis(data GenesisState) error {
+	if data.NextOfferId == 0 {
+		return fmt.Errorf("next offer id must be positive")
+	}
+
+	seen := map[uint64]bool{}
+	for _, offer := range data.Offers {
+		if err := offer.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if offer.Id >= data.NextOfferId {
+			return fmt.Errorf("offer id %d must be less than the next offer id %d", offer.Id, data.NextOfferId)
+		}
+
+		if seen[offer.Id] {
+			return fmt.Errorf("duplicate offer id: %d", offer.Id)
+		}
+		seen[offer.Id] = true
+	}
+
+	return nil
+}
+
+// DefaultGenesisState - Return a default genesis state
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		NextOfferId: 1,
+	}
+}
@@ -0,0 +1,86 @@
+package swap_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/crypto/keys/secp256k1"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/swap"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	newOffer := func(id uint64) swap.Offer {
+		return swap.Offer{
+			Id:    id,
+			Maker: addr.String(),
+			Offer: swap.Asset{
+				Type:   swap.AssetTypeCoin,
+				Denom:  "stake",
+				Amount: sdk.OneInt(),
+			},
+			Ask: swap.Asset{
+				Type:       swap.AssetTypeToken,
+				ContractId: "deadbeef",
+				Amount:     sdk.OneInt(),
+			},
+			Expiry: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		}
+	}
+
+	testCases := map[string]struct {
+		gs    *swap.GenesisState
+		valid bool
+	}{
+		"default genesis": {
+			swap.DefaultGenesisState(),
+			true,
+		},
+		"valid genesis": {
+			&swap.GenesisState{
+				NextOfferId: 3,
+				Offers:      []swap.Offer{newOffer(1), newOffer(2)},
+			},
+			true,
+		},
+		"zero next offer id": {
+			&swap.GenesisState{},
+			false,
+		},
+		"invalid offer": {
+			&swap.GenesisState{
+				NextOfferId: 2,
+				Offers:      []swap.Offer{{Id: 1}},
+			},
+			false,
+		},
+		"offer id not less than the next offer id": {
+			&swap.GenesisState{
+				NextOfferId: 2,
+				Offers:      []swap.Offer{newOffer(2)},
+			},
+			false,
+		},
+		"duplicate offer id": {
+			&swap.GenesisState{
+				NextOfferId: 2,
+				Offers:      []swap.Offer{newOffer(1), newOffer(1)},
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := swap.ValidateGenesis(*tc.gs)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
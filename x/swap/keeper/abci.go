@@ -0,0 +1,16 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/Finschia/finschia-sdk/telemetry"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/swap"
+)
+
+// EndBlocker refunds the expired offers to their makers.
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	defer telemetry.ModuleMeasureSince(swap.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.RefundExpiredOffers(ctx)
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/swap"
+)
+
+// InitGenesis new swap genesis
+func (k Keeper) InitGenesis(ctx sdk.Context, data *swap.GenesisState) {
+	k.setNextOfferID(ctx, data.NextOfferId)
+
+	for _, offer := range data.Offers {
+		k.setOffer(ctx, offer)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *swap.GenesisState {
+	var offers []swap.Offer
+	k.iterateOffers(ctx, func(offer swap.Offer) (stop bool) {
+		offers = append(offers, offer)
+		return false
+	})
+
+	return &swap.GenesisState{
+		NextOfferId: k.GetNextOfferID(ctx),
+		Offers:      offers,
+	}
+}
@@ -0,0 +1,31 @@
+package keeper_test
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/swap"
+)
+
+func (s *KeeperTestSuite) TestImportExportGenesis() {
+	// export
+	genesis := s.keeper.ExportGenesis(s.ctx)
+	s.Require().Equal(s.offerID+1, genesis.NextOfferId)
+	s.Require().Len(genesis.Offers, 1)
+	s.Require().NoError(swap.ValidateGenesis(*genesis))
+
+	// forge
+	ctx, _ := s.ctx.CacheContext()
+	err := s.keeper.Cancel(ctx, s.offerID, s.maker)
+	s.Require().NoError(err)
+
+	// restore
+	s.keeper.InitGenesis(ctx, genesis)
+
+	// export again and compare
+	newGenesis := s.keeper.ExportGenesis(ctx)
+	s.Require().Equal(genesis, newGenesis)
+
+	// the indexes are restored as well
+	res, err := s.queryServer.OffersByMaker(sdk.WrapSDKContext(ctx), &swap.QueryOffersByMakerRequest{Maker: s.maker.String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.Offers, 1)
+}
@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Finschia/finschia-sdk/store/prefix"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/query"
+	"github.com/Finschia/finschia-sdk/x/swap"
+)
+
+type queryServer struct {
+	keeper Keeper
+}
+
+// NewQueryServer returns an implementation of the swap QueryServer interface
+// for the provided Keeper.
+func NewQueryServer(keeper Keeper) swap.QueryServer {
+	return &queryServer{
+		keeper: keeper,
+	}
+}
+
+var _ swap.QueryServer = queryServer{}
+
+// Offer queries an offer by its id.
+func (s queryServer) Offer(c context.Context, req *swap.QueryOfferRequest) (*swap.QueryOfferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	offer, err := s.keeper.GetOffer(ctx, req.OfferId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &swap.QueryOfferResponse{Offer: *offer}, nil
+}
+
+// Offers queries all the offers.
+func (s queryServer) Offers(c context.Context, req *swap.QueryOffersRequest) (*swap.QueryOffersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	offerStore := prefix.NewStore(store, offerKeyPrefix)
+	var offers []swap.Offer
+	pageRes, err := query.Paginate(offerStore, req.Pagination, func(_ []byte, value []byte) error {
+		var offer swap.Offer
+		s.keeper.cdc.MustUnmarshal(value, &offer)
+
+		offers = append(offers, offer)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &swap.QueryOffersResponse{Offers: offers, Pagination: pageRes}, nil
+}
+
+// OffersByMaker queries the offers made by a maker.
+func (s queryServer) OffersByMaker(c context.Context, req *swap.QueryOffersByMakerRequest) (*swap.QueryOffersByMakerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	maker, err := sdk.AccAddressFromBech32(req.Maker)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid maker address: %s", req.Maker)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	offers, pageRes, err := s.paginateOffersByIndex(ctx, makerIndexKeyPrefixByMaker(maker), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &swap.QueryOffersByMakerResponse{Offers: offers, Pagination: pageRes}, nil
+}
+
+// OffersByAsset queries the offers which offer an asset.
+func (s queryServer) OffersByAsset(c context.Context, req *swap.QueryOffersByAssetRequest) (*swap.QueryOffersByAssetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	asset := swap.Asset{
+		Type:       req.Type,
+		Denom:      req.Denom,
+		ContractId: req.ContractId,
+		TokenId:    req.TokenId,
+	}
+	if err := asset.ValidateID(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	offers, pageRes, err := s.paginateOffersByIndex(ctx, assetIndexKeyPrefixByAsset(asset), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &swap.QueryOffersByAssetResponse{Offers: offers, Pagination: pageRes}, nil
+}
+
+// paginateOffersByIndex paginates the offers under the index prefix, whose
+// keys end with the offer ids.
+func (s queryServer) paginateOffersByIndex(ctx sdk.Context, indexPrefix []byte, pagination *query.PageRequest) ([]swap.Offer, *query.PageResponse, error) {
+	store := ctx.KVStore(s.keeper.storeKey)
+	indexStore := prefix.NewStore(store, indexPrefix)
+	var offers []swap.Offer
+	pageRes, err := query.Paginate(indexStore, pagination, func(key []byte, _ []byte) error {
+		offer, err := s.keeper.GetOffer(ctx, binary.BigEndian.Uint64(key))
+		if err != nil {
+			return err
+		}
+
+		offers = append(offers, *offer)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return offers, pageRes, nil
+}
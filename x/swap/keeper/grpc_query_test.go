@@ -0,0 +1,180 @@
+package keeper_test
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/query"
+	"github.com/Finschia/finschia-sdk/x/swap"
+)
+
+func (s *KeeperTestSuite) TestQueryOffer() {
+	testCases := map[string]struct {
+		id       uint64
+		valid    bool
+		postTest func(res *swap.QueryOfferResponse)
+	}{
+		"valid request": {
+			id:    s.offerID,
+			valid: true,
+			postTest: func(res *swap.QueryOfferResponse) {
+				s.Require().Equal(s.offerID, res.Offer.Id)
+				s.Require().Equal(s.maker.String(), res.Offer.Maker)
+			},
+		},
+		"offer not found": {
+			id: s.offerID + 1,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			req := &swap.QueryOfferRequest{
+				OfferId: tc.id,
+			}
+			res, err := s.queryServer.Offer(s.goCtx, req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryOffers() {
+	_, err := s.keeper.Offer(s.ctx, s.taker, s.tokenAsset(sdk.OneInt()), s.coinAsset(sdk.OneInt()), s.expiry)
+	s.Require().NoError(err)
+
+	testCases := map[string]struct {
+		pagination *query.PageRequest
+		postTest   func(res *swap.QueryOffersResponse)
+	}{
+		"valid request": {
+			postTest: func(res *swap.QueryOffersResponse) {
+				s.Require().Len(res.Offers, 2)
+			},
+		},
+		"valid request with limit": {
+			pagination: &query.PageRequest{
+				Limit: 1,
+			},
+			postTest: func(res *swap.QueryOffersResponse) {
+				s.Require().Len(res.Offers, 1)
+				s.Require().Equal(s.offerID, res.Offers[0].Id)
+				s.Require().NotNil(res.Pagination.NextKey)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			req := &swap.QueryOffersRequest{
+				Pagination: tc.pagination,
+			}
+			res, err := s.queryServer.Offers(s.goCtx, req)
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryOffersByMaker() {
+	_, err := s.keeper.Offer(s.ctx, s.taker, s.tokenAsset(sdk.OneInt()), s.coinAsset(sdk.OneInt()), s.expiry)
+	s.Require().NoError(err)
+
+	testCases := map[string]struct {
+		maker    string
+		valid    bool
+		postTest func(res *swap.QueryOffersByMakerResponse)
+	}{
+		"valid request": {
+			maker: s.maker.String(),
+			valid: true,
+			postTest: func(res *swap.QueryOffersByMakerResponse) {
+				s.Require().Len(res.Offers, 1)
+				s.Require().Equal(s.offerID, res.Offers[0].Id)
+			},
+		},
+		"no offers": {
+			maker: s.stranger.String(),
+			valid: true,
+			postTest: func(res *swap.QueryOffersByMakerResponse) {
+				s.Require().Empty(res.Offers)
+			},
+		},
+		"invalid maker": {},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			req := &swap.QueryOffersByMakerRequest{
+				Maker: tc.maker,
+			}
+			res, err := s.queryServer.OffersByMaker(s.goCtx, req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryOffersByAsset() {
+	_, err := s.keeper.Offer(s.ctx, s.maker, s.collectionAsset(s.nftID, sdk.OneInt()), s.coinAsset(sdk.OneInt()), s.expiry)
+	s.Require().NoError(err)
+
+	testCases := map[string]struct {
+		asset    swap.Asset
+		valid    bool
+		postTest func(res *swap.QueryOffersByAssetResponse)
+	}{
+		"coins": {
+			asset: s.coinAsset(sdk.Int{}),
+			valid: true,
+			postTest: func(res *swap.QueryOffersByAssetResponse) {
+				s.Require().Len(res.Offers, 1)
+				s.Require().Equal(s.offerID, res.Offers[0].Id)
+			},
+		},
+		"nft": {
+			asset: s.collectionAsset(s.nftID, sdk.Int{}),
+			valid: true,
+			postTest: func(res *swap.QueryOffersByAssetResponse) {
+				s.Require().Len(res.Offers, 1)
+				s.Require().Equal(s.nftID, res.Offers[0].Offer.TokenId)
+			},
+		},
+		"no offers": {
+			asset: s.tokenAsset(sdk.Int{}),
+			valid: true,
+			postTest: func(res *swap.QueryOffersByAssetResponse) {
+				s.Require().Empty(res.Offers)
+			},
+		},
+		"invalid asset": {},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			req := &swap.QueryOffersByAssetRequest{
+				Type:       tc.asset.Type,
+				Denom:      tc.asset.Denom,
+				ContractId: tc.asset.ContractId,
+				TokenId:    tc.asset.TokenId,
+			}
+			res, err := s.queryServer.OffersByAsset(s.goCtx, req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"github.com/Finschia/ostracon/libs/log"
+
+	"github.com/Finschia/finschia-sdk/codec"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/swap"
+)
+
+// Keeper defines the swap module Keeper
+type Keeper struct {
+	accountKeeper    swap.AccountKeeper
+	bankKeeper       swap.BankKeeper
+	tokenKeeper      swap.TokenKeeper
+	collectionKeeper swap.CollectionKeeper
+
+	// The (unexposed) keys used to access the stores from the Context.
+	storeKey sdk.StoreKey
+
+	// The codec for binary encoding/decoding.
+	cdc codec.Codec
+}
+
+// NewKeeper returns a swap keeper
+func NewKeeper(
+	cdc codec.Codec,
+	key sdk.StoreKey,
+	ak swap.AccountKeeper,
+	bk swap.BankKeeper,
+	tk swap.TokenKeeper,
+	ck swap.CollectionKeeper,
+) Keeper {
+	// ensure the module account is set
+	if addr := ak.GetModuleAddress(swap.ModuleName); addr == nil {
+		panic("the swap module account has not been set")
+	}
+
+	return Keeper{
+		accountKeeper:    ak,
+		bankKeeper:       bk,
+		tokenKeeper:      tk,
+		collectionKeeper: ck,
+		storeKey:         key,
+		cdc:              cdc,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+swap.ModuleName)
+}
+
+// escrowAddress returns the address which escrows the offered assets.
+func (k Keeper) escrowAddress() sdk.AccAddress {
+	return k.accountKeeper.GetModuleAddress(swap.ModuleName)
+}
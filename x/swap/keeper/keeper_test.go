@@ -0,0 +1,158 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/Finschia/finschia-sdk/crypto/keys/secp256k1"
+	"github.com/Finschia/finschia-sdk/simapp"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	bankkeeper "github.com/Finschia/finschia-sdk/x/bank/keeper"
+	"github.com/Finschia/finschia-sdk/x/collection"
+	collectionkeeper "github.com/Finschia/finschia-sdk/x/collection/keeper"
+	"github.com/Finschia/finschia-sdk/x/swap"
+	"github.com/Finschia/finschia-sdk/x/swap/keeper"
+	"github.com/Finschia/finschia-sdk/x/token"
+	tokenkeeper "github.com/Finschia/finschia-sdk/x/token/keeper"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	goCtx       context.Context
+	keeper      keeper.Keeper
+	queryServer swap.QueryServer
+	msgServer   swap.MsgServer
+
+	bankKeeper       bankkeeper.Keeper
+	tokenKeeper      tokenkeeper.Keeper
+	collectionKeeper collectionkeeper.Keeper
+
+	maker    sdk.AccAddress
+	taker    sdk.AccAddress
+	stranger sdk.AccAddress
+
+	tokenContractID      string
+	collectionContractID string
+	ftID                 string
+	nftID                string
+	childNFTID           string
+
+	balance sdk.Int
+
+	// offer of the maker, which offers coins for tokens
+	offerID uint64
+	expiry  time.Time
+}
+
+func (s *KeeperTestSuite) coinAsset(amount sdk.Int) swap.Asset {
+	return swap.Asset{
+		Type:   swap.AssetTypeCoin,
+		Denom:  sdk.DefaultBondDenom,
+		Amount: amount,
+	}
+}
+
+func (s *KeeperTestSuite) tokenAsset(amount sdk.Int) swap.Asset {
+	return swap.Asset{
+		Type:       swap.AssetTypeToken,
+		ContractId: s.tokenContractID,
+		Amount:     amount,
+	}
+}
+
+func (s *KeeperTestSuite) collectionAsset(tokenID string, amount sdk.Int) swap.Asset {
+	return swap.Asset{
+		Type:       swap.AssetTypeCollection,
+		ContractId: s.collectionContractID,
+		TokenId:    tokenID,
+		Amount:     amount,
+	}
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+	s.ctx = app.BaseApp.NewContext(checkTx, tmproto.Header{Time: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)})
+	s.goCtx = sdk.WrapSDKContext(s.ctx)
+	s.keeper = app.SwapKeeper
+	s.bankKeeper = app.BankKeeper
+	s.tokenKeeper = app.TokenKeeper
+	s.collectionKeeper = app.CollectionKeeper
+
+	s.queryServer = keeper.NewQueryServer(s.keeper)
+	s.msgServer = keeper.NewMsgServer(s.keeper)
+
+	for _, address := range []*sdk.AccAddress{
+		&s.maker,
+		&s.taker,
+		&s.stranger,
+	} {
+		*address = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	s.balance = sdk.NewInt(1000)
+
+	// fund the coins
+	for _, to := range []sdk.AccAddress{s.maker, s.taker} {
+		coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, s.balance))
+		err := simapp.FundAccount(app, s.ctx, to, coins)
+		s.Require().NoError(err)
+	}
+
+	// issue the tokens
+	s.tokenContractID = s.tokenKeeper.Issue(s.ctx, token.Contract{
+		Name:     "Swappable",
+		Symbol:   "SWP",
+		Mintable: true,
+	}, s.maker, s.maker, s.balance)
+	err := s.tokenKeeper.Mint(s.ctx, s.tokenContractID, s.maker, s.taker, s.balance)
+	s.Require().NoError(err)
+
+	// create the collection tokens
+	s.collectionContractID = s.collectionKeeper.CreateContract(s.ctx, s.maker, collection.Contract{
+		Name: "fox",
+	})
+	ftClassID, err := s.collectionKeeper.CreateTokenClass(s.ctx, s.collectionContractID, &collection.FTClass{
+		Name:     "tibetian fox",
+		Mintable: true,
+	})
+	s.Require().NoError(err)
+	s.ftID = collection.NewFTID(*ftClassID)
+	for _, to := range []sdk.AccAddress{s.maker, s.taker} {
+		err := s.collectionKeeper.MintFT(s.ctx, s.collectionContractID, to, collection.NewCoins(collection.NewCoin(s.ftID, s.balance)))
+		s.Require().NoError(err)
+	}
+
+	nftClassID, err := s.collectionKeeper.CreateTokenClass(s.ctx, s.collectionContractID, &collection.NFTClass{
+		Name: "fennec fox",
+	})
+	s.Require().NoError(err)
+	nfts, err := s.collectionKeeper.MintNFT(s.ctx, s.collectionContractID, s.maker, []collection.MintNFTParam{
+		{TokenType: *nftClassID},
+		{TokenType: *nftClassID},
+		{TokenType: *nftClassID},
+	})
+	s.Require().NoError(err)
+	s.nftID = nfts[0].TokenId
+
+	// attach an nft to another
+	s.childNFTID = nfts[1].TokenId
+	err = s.collectionKeeper.Attach(s.ctx, s.collectionContractID, s.maker, s.childNFTID, nfts[2].TokenId)
+	s.Require().NoError(err)
+
+	// make an offer
+	s.expiry = s.ctx.BlockTime().Add(time.Hour)
+	offer, err := s.keeper.Offer(s.ctx, s.maker, s.coinAsset(s.balance.QuoRaw(2)), s.tokenAsset(s.balance.QuoRaw(2)), s.expiry)
+	s.Require().NoError(err)
+	s.offerID = offer.Id
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"time"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/swap"
+)
+
+var (
+	nextOfferIDKey = []byte{0x00}
+
+	offerKeyPrefix       = []byte{0x01}
+	makerIndexKeyPrefix  = []byte{0x02}
+	assetIndexKeyPrefix  = []byte{0x03}
+	expiryQueueKeyPrefix = []byte{0x04}
+)
+
+func offerKey(id uint64) []byte {
+	return concatBytes(offerKeyPrefix, sdk.Uint64ToBigEndian(id))
+}
+
+func makerIndexKeyPrefixByMaker(maker sdk.AccAddress) []byte {
+	return concatBytes(makerIndexKeyPrefix, lengthPrefix(maker))
+}
+
+func makerIndexKey(maker sdk.AccAddress, id uint64) []byte {
+	return concatBytes(makerIndexKeyPrefixByMaker(maker), sdk.Uint64ToBigEndian(id))
+}
+
+// assetIndexKeyPrefixByAsset returns the prefix of the index by the asset,
+// which consists of the fields identifying the asset.
+func assetIndexKeyPrefixByAsset(asset swap.Asset) []byte {
+	return concatBytes(
+		assetIndexKeyPrefix,
+		[]byte{byte(asset.Type)},
+		lengthPrefix([]byte(asset.Denom)),
+		lengthPrefix([]byte(asset.ContractId)),
+		lengthPrefix([]byte(asset.TokenId)),
+	)
+}
+
+func assetIndexKey(asset swap.Asset, id uint64) []byte {
+	return concatBytes(assetIndexKeyPrefixByAsset(asset), sdk.Uint64ToBigEndian(id))
+}
+
+func expiryQueueKeyPrefixByTime(expiry time.Time) []byte {
+	return concatBytes(expiryQueueKeyPrefix, sdk.FormatTimeBytes(expiry))
+}
+
+func expiryQueueKey(expiry time.Time, id uint64) []byte {
+	return concatBytes(expiryQueueKeyPrefixByTime(expiry), sdk.Uint64ToBigEndian(id))
+}
+
+func splitExpiryQueueKey(key []byte) (id uint64) {
+	return binary.BigEndian.Uint64(key[len(key)-8:])
+}
+
+func lengthPrefix(bz []byte) []byte {
+	return concatBytes([]byte{byte(len(bz))}, bz)
+}
+
+func concatBytes(parts ...[]byte) []byte {
+	size := 0
+	for _, part := range parts {
+		size += len(part)
+	}
+
+	key := make([]byte, 0, size)
+	for _, part := range parts {
+		key = append(key, part...)
+	}
+
+	return key
+}
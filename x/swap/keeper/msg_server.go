@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/swap"
+)
+
+type msgServer struct {
+	keeper Keeper
+}
+
+// NewMsgServer returns an implementation of the swap MsgServer interface
+// for the provided Keeper.
+func NewMsgServer(keeper Keeper) swap.MsgServer {
+	return &msgServer{
+		keeper: keeper,
+	}
+}
+
+var _ swap.MsgServer = msgServer{}
+
+// Offer makes an offer to swap the asset of the maker for another asset
+func (s msgServer) Offer(c context.Context, req *swap.MsgOffer) (*swap.MsgOfferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	maker := sdk.MustAccAddressFromBech32(req.Maker)
+
+	offer, err := s.keeper.Offer(ctx, maker, req.Offer, req.Ask, req.Expiry)
+	if err != nil {
+		return nil, err
+	}
+
+	event := swap.EventOffered{
+		Offer: *offer,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+
+	return &swap.MsgOfferResponse{Id: offer.Id}, nil
+}
+
+// Accept accepts an offer, which swaps both of the assets atomically
+func (s msgServer) Accept(c context.Context, req *swap.MsgAccept) (*swap.MsgAcceptResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	taker := sdk.MustAccAddressFromBech32(req.Taker)
+
+	offer, err := s.keeper.Accept(ctx, req.OfferId, taker)
+	if err != nil {
+		return nil, err
+	}
+
+	event := swap.EventAccepted{
+		OfferId: req.OfferId,
+		Maker:   offer.Maker,
+		Taker:   req.Taker,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+
+	return &swap.MsgAcceptResponse{}, nil
+}
+
+// Cancel cancels an offer, which refunds the escrowed asset to the maker
+func (s msgServer) Cancel(c context.Context, req *swap.MsgCancel) (*swap.MsgCancelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	maker := sdk.MustAccAddressFromBech32(req.Maker)
+
+	if err := s.keeper.Cancel(ctx, req.OfferId, maker); err != nil {
+		return nil, err
+	}
+
+	event := swap.EventCancelled{
+		OfferId: req.OfferId,
+		Maker:   req.Maker,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+
+	return &swap.MsgCancelResponse{}, nil
+}
@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/swap"
+)
+
+func (s *KeeperTestSuite) TestMsgOffer() {
+	testCases := map[string]struct {
+		offer swap.Asset
+		err   error
+	}{
+		"valid request": {
+			offer: s.tokenAsset(s.balance),
+		},
+		"insufficient funds": {
+			offer: s.coinAsset(s.balance),
+			err:   sdkerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			req := &swap.MsgOffer{
+				Maker:  s.maker.String(),
+				Offer:  tc.offer,
+				Ask:    s.coinAsset(sdk.OneInt()),
+				Expiry: s.expiry,
+			}
+			res, err := s.msgServer.Offer(sdk.WrapSDKContext(ctx), req)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().NotNil(res)
+			s.Require().Equal(s.offerID+1, res.Id)
+
+			events := ctx.EventManager().Events()
+			s.Require().Equal("lbm.swap.v1.EventOffered", events[len(events)-1].Type)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgAccept() {
+	testCases := map[string]struct {
+		id  uint64
+		err error
+	}{
+		"valid request": {
+			id: s.offerID,
+		},
+		"offer not found": {
+			id:  s.offerID + 1,
+			err: sdkerrors.ErrNotFound,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			req := &swap.MsgAccept{
+				Taker:   s.taker.String(),
+				OfferId: tc.id,
+			}
+			res, err := s.msgServer.Accept(sdk.WrapSDKContext(ctx), req)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().NotNil(res)
+
+			events := ctx.EventManager().Events()
+			s.Require().Equal("lbm.swap.v1.EventAccepted", events[len(events)-1].Type)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgCancel() {
+	testCases := map[string]struct {
+		maker sdk.AccAddress
+		err   error
+	}{
+		"valid request": {
+			maker: s.maker,
+		},
+		"not the maker": {
+			maker: s.stranger,
+			err:   sdkerrors.ErrUnauthorized,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			req := &swap.MsgCancel{
+				Maker:   tc.maker.String(),
+				OfferId: s.offerID,
+			}
+			res, err := s.msgServer.Cancel(sdk.WrapSDKContext(ctx), req)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().NotNil(res)
+
+			events := ctx.EventManager().Events()
+			s.Require().Equal("lbm.swap.v1.EventCancelled", events[len(events)-1].Type)
+		})
+	}
+}
@@ -0,0 +1,232 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/collection"
+	"github.com/Finschia/finschia-sdk/x/swap"
+)
+
+// Offer makes an offer, escrowing the offered asset of the maker.
+func (k Keeper) Offer(ctx sdk.Context, maker sdk.AccAddress, offerAsset, askAsset swap.Asset, expiry time.Time) (*swap.Offer, error) {
+	if !expiry.After(ctx.BlockTime()) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("expiry %s must be after the block time %s", expiry, ctx.BlockTime())
+	}
+
+	if err := k.sendAsset(ctx, offerAsset, maker, k.escrowAddress()); err != nil {
+		return nil, err
+	}
+
+	offer := swap.Offer{
+		Id:     k.nextOfferID(ctx),
+		Maker:  maker.String(),
+		Offer:  offerAsset,
+		Ask:    askAsset,
+		Expiry: expiry,
+	}
+	k.setOffer(ctx, offer)
+
+	return &offer, nil
+}
+
+// Accept accepts an offer, which sends the asked asset of the taker to the
+// maker and releases the offered asset to the taker.
+func (k Keeper) Accept(ctx sdk.Context, id uint64, taker sdk.AccAddress) (*swap.Offer, error) {
+	offer, err := k.GetOffer(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !offer.Expiry.After(ctx.BlockTime()) {
+		return nil, swap.ErrOfferExpired.Wrapf("offer %d expired at %s", id, offer.Expiry)
+	}
+
+	maker := sdk.MustAccAddressFromBech32(offer.Maker)
+	if taker.Equals(maker) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("maker cannot accept its own offer")
+	}
+
+	if err := k.sendAsset(ctx, offer.Ask, taker, maker); err != nil {
+		return nil, err
+	}
+	if err := k.sendAsset(ctx, offer.Offer, k.escrowAddress(), taker); err != nil {
+		return nil, err
+	}
+
+	k.deleteOffer(ctx, *offer)
+
+	return offer, nil
+}
+
+// Cancel cancels an offer, which refunds the escrowed asset to the maker.
+func (k Keeper) Cancel(ctx sdk.Context, id uint64, maker sdk.AccAddress) error {
+	offer, err := k.GetOffer(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if offer.Maker != maker.String() {
+		return sdkerrors.ErrUnauthorized.Wrapf("not the maker of the offer %d", id)
+	}
+
+	return k.refund(ctx, *offer)
+}
+
+// refund releases the escrowed asset of the offer to its maker, and closes
+// the offer.
+func (k Keeper) refund(ctx sdk.Context, offer swap.Offer) error {
+	maker := sdk.MustAccAddressFromBech32(offer.Maker)
+	if err := k.sendAsset(ctx, offer.Offer, k.escrowAddress(), maker); err != nil {
+		return err
+	}
+
+	k.deleteOffer(ctx, offer)
+
+	return nil
+}
+
+// RefundExpiredOffers refunds the offers expired at the block time. If a refund
+// fails (e.g. the contract of the asset has been paused), the offer is kept
+// and would be retried at the next block.
+func (k Keeper) RefundExpiredOffers(ctx sdk.Context) {
+	var expired []uint64
+	k.iterateExpiredOffers(ctx, ctx.BlockTime(), func(id uint64) (stop bool) {
+		expired = append(expired, id)
+		return false
+	})
+
+	for _, id := range expired {
+		offer, err := k.GetOffer(ctx, id)
+		if err != nil {
+			panic(err)
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.refund(cacheCtx, *offer); err != nil {
+			k.Logger(ctx).Error("failed to refund the expired offer", "id", id, "err", err)
+			continue
+		}
+		write()
+
+		event := swap.EventExpired{
+			OfferId: id,
+			Maker:   offer.Maker,
+		}
+		if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// sendAsset sends the asset using the keeper of the module which manages the asset.
+func (k Keeper) sendAsset(ctx sdk.Context, asset swap.Asset, from, to sdk.AccAddress) error {
+	switch asset.Type {
+	case swap.AssetTypeCoin:
+		return k.bankKeeper.SendCoins(ctx, from, to, sdk.NewCoins(sdk.NewCoin(asset.Denom, asset.Amount)))
+	case swap.AssetTypeToken:
+		return k.tokenKeeper.Send(ctx, asset.ContractId, from, to, asset.Amount)
+	case swap.AssetTypeCollection:
+		// legacy
+		if err := collection.ValidateNFTID(asset.TokenId); err == nil {
+			if _, err := k.collectionKeeper.GetNFT(ctx, asset.ContractId, asset.TokenId); err != nil {
+				return err
+			}
+			if _, err := k.collectionKeeper.GetParent(ctx, asset.ContractId, asset.TokenId); err == nil {
+				return collection.ErrTokenCannotTransferChildToken.Wrap(asset.TokenId)
+			}
+		}
+		return k.collectionKeeper.SendCoins(ctx, asset.ContractId, from, to, []collection.Coin{collection.NewCoin(asset.TokenId, asset.Amount)})
+	default:
+		panic(swap.ErrInvalidAsset.Wrapf("invalid asset type: %s", asset.Type))
+	}
+}
+
+func (k Keeper) nextOfferID(ctx sdk.Context) uint64 {
+	id := k.GetNextOfferID(ctx)
+	k.setNextOfferID(ctx, id+1)
+
+	return id
+}
+
+// GetNextOfferID returns the id of the next offer.
+func (k Keeper) GetNextOfferID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(nextOfferIDKey)
+	if bz == nil {
+		panic("next offer id not found")
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setNextOfferID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(nextOfferIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetOffer returns the offer of the id.
+func (k Keeper) GetOffer(ctx sdk.Context, id uint64) (*swap.Offer, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(offerKey(id))
+	if bz == nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("no offer for %d", id)
+	}
+
+	var offer swap.Offer
+	k.cdc.MustUnmarshal(bz, &offer)
+
+	return &offer, nil
+}
+
+func (k Keeper) setOffer(ctx sdk.Context, offer swap.Offer) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(offerKey(offer.Id), k.cdc.MustMarshal(&offer))
+
+	maker := sdk.MustAccAddressFromBech32(offer.Maker)
+	store.Set(makerIndexKey(maker, offer.Id), []byte{})
+	store.Set(assetIndexKey(offer.Offer, offer.Id), []byte{})
+	store.Set(expiryQueueKey(offer.Expiry, offer.Id), []byte{})
+}
+
+func (k Keeper) deleteOffer(ctx sdk.Context, offer swap.Offer) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(offerKey(offer.Id))
+
+	maker := sdk.MustAccAddressFromBech32(offer.Maker)
+	store.Delete(makerIndexKey(maker, offer.Id))
+	store.Delete(assetIndexKey(offer.Offer, offer.Id))
+	store.Delete(expiryQueueKey(offer.Expiry, offer.Id))
+}
+
+func (k Keeper) iterateOffers(ctx sdk.Context, fn func(offer swap.Offer) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, offerKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var offer swap.Offer
+		k.cdc.MustUnmarshal(iterator.Value(), &offer)
+
+		if fn(offer) {
+			break
+		}
+	}
+}
+
+// iterateExpiredOffers iterates over the ids of the offers which expire at or
+// before the given time.
+func (k Keeper) iterateExpiredOffers(ctx sdk.Context, now time.Time, fn func(id uint64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(expiryQueueKeyPrefix, sdk.PrefixEndBytes(expiryQueueKeyPrefixByTime(now)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		id := splitExpiryQueueKey(iterator.Key())
+
+		if fn(id) {
+			break
+		}
+	}
+}
@@ -0,0 +1,258 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/collection"
+	"github.com/Finschia/finschia-sdk/x/swap"
+	"github.com/Finschia/finschia-sdk/x/swap/keeper"
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+func (s *KeeperTestSuite) TestOffer() {
+	testCases := map[string]struct {
+		offer  swap.Asset
+		ask    swap.Asset
+		expiry time.Duration
+		err    error
+	}{
+		"offer coins": {
+			offer:  s.coinAsset(s.balance.QuoRaw(2)),
+			ask:    s.collectionAsset(s.ftID, sdk.OneInt()),
+			expiry: time.Hour,
+		},
+		"offer tokens": {
+			offer:  s.tokenAsset(s.balance),
+			ask:    s.coinAsset(sdk.OneInt()),
+			expiry: time.Hour,
+		},
+		"offer fts": {
+			offer:  s.collectionAsset(s.ftID, s.balance),
+			ask:    s.coinAsset(sdk.OneInt()),
+			expiry: time.Hour,
+		},
+		"offer an nft": {
+			offer:  s.collectionAsset(s.nftID, sdk.OneInt()),
+			ask:    s.coinAsset(sdk.OneInt()),
+			expiry: time.Hour,
+		},
+		"offer a child nft": {
+			offer:  s.collectionAsset(s.childNFTID, sdk.OneInt()),
+			ask:    s.coinAsset(sdk.OneInt()),
+			expiry: time.Hour,
+			err:    collection.ErrTokenCannotTransferChildToken,
+		},
+		"offer a nonexistent nft": {
+			offer:  s.collectionAsset(collection.NewNFTID(collection.SplitTokenID(s.nftID), 100), sdk.OneInt()),
+			ask:    s.coinAsset(sdk.OneInt()),
+			expiry: time.Hour,
+			err:    collection.ErrTokenNotExist,
+		},
+		"past expiry": {
+			offer: s.tokenAsset(s.balance),
+			ask:   s.coinAsset(sdk.OneInt()),
+			err:   sdkerrors.ErrInvalidRequest,
+		},
+		"insufficient coins": {
+			offer:  s.coinAsset(s.balance),
+			ask:    s.tokenAsset(sdk.OneInt()),
+			expiry: time.Hour,
+			err:    sdkerrors.ErrInsufficientFunds,
+		},
+		"insufficient tokens": {
+			offer:  s.tokenAsset(s.balance.Add(sdk.OneInt())),
+			ask:    s.coinAsset(sdk.OneInt()),
+			expiry: time.Hour,
+			err:    token.ErrInsufficientBalance,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			offer, err := s.keeper.Offer(ctx, s.maker, tc.offer, tc.ask, ctx.BlockTime().Add(tc.expiry))
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().Equal(s.offerID+1, offer.Id)
+			s.Require().Equal(s.offerID+2, s.keeper.GetNextOfferID(ctx))
+
+			stored, err := s.keeper.GetOffer(ctx, offer.Id)
+			s.Require().NoError(err)
+			s.Require().Equal(offer, stored)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestAccept() {
+	testCases := map[string]struct {
+		id    uint64
+		taker sdk.AccAddress
+		after time.Duration
+		err   error
+	}{
+		"valid request": {
+			id:    s.offerID,
+			taker: s.taker,
+		},
+		"offer not found": {
+			id:    s.offerID + 1,
+			taker: s.taker,
+			err:   sdkerrors.ErrNotFound,
+		},
+		"expired": {
+			id:    s.offerID,
+			taker: s.taker,
+			after: time.Hour,
+			err:   swap.ErrOfferExpired,
+		},
+		"maker": {
+			id:    s.offerID,
+			taker: s.maker,
+			err:   sdkerrors.ErrInvalidRequest,
+		},
+		"insufficient funds": {
+			id:    s.offerID,
+			taker: s.stranger,
+			err:   token.ErrInsufficientBalance,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(tc.after))
+
+			prevCoins := s.bankKeeper.GetBalance(ctx, tc.taker, sdk.DefaultBondDenom).Amount
+			prevTokens := s.tokenKeeper.GetBalance(ctx, s.tokenContractID, s.maker)
+
+			_, err := s.keeper.Accept(ctx, tc.id, tc.taker)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			amount := s.balance.QuoRaw(2)
+			s.Require().Equal(prevCoins.Add(amount).String(), s.bankKeeper.GetBalance(ctx, tc.taker, sdk.DefaultBondDenom).Amount.String())
+			s.Require().Equal(prevTokens.Add(amount).String(), s.tokenKeeper.GetBalance(ctx, s.tokenContractID, s.maker).String())
+
+			_, err = s.keeper.GetOffer(ctx, tc.id)
+			s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestAcceptNFT() {
+	offer, err := s.keeper.Offer(s.ctx, s.maker, s.collectionAsset(s.nftID, sdk.OneInt()), s.collectionAsset(s.ftID, s.balance), s.expiry)
+	s.Require().NoError(err)
+
+	_, err = s.keeper.Accept(s.ctx, offer.Id, s.taker)
+	s.Require().NoError(err)
+
+	s.Require().Equal(sdk.OneInt().String(), s.collectionKeeper.GetBalance(s.ctx, s.collectionContractID, s.taker, s.nftID).String())
+	s.Require().Equal(s.balance.MulRaw(2).String(), s.collectionKeeper.GetBalance(s.ctx, s.collectionContractID, s.maker, s.ftID).String())
+}
+
+func (s *KeeperTestSuite) TestCancel() {
+	testCases := map[string]struct {
+		id    uint64
+		maker sdk.AccAddress
+		err   error
+	}{
+		"valid request": {
+			id:    s.offerID,
+			maker: s.maker,
+		},
+		"offer not found": {
+			id:    s.offerID + 1,
+			maker: s.maker,
+			err:   sdkerrors.ErrNotFound,
+		},
+		"not the maker": {
+			id:    s.offerID,
+			maker: s.taker,
+			err:   sdkerrors.ErrUnauthorized,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			err := s.keeper.Cancel(ctx, tc.id, tc.maker)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().Equal(s.balance.String(), s.bankKeeper.GetBalance(ctx, tc.maker, sdk.DefaultBondDenom).Amount.String())
+
+			_, err = s.keeper.GetOffer(ctx, tc.id)
+			s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRefundExpiredOffers() {
+	testCases := map[string]struct {
+		after    time.Duration
+		refunded bool
+	}{
+		"not expired": {
+			after: time.Hour - time.Nanosecond,
+		},
+		"expired": {
+			after:    time.Hour,
+			refunded: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(tc.after))
+
+			keeper.EndBlocker(ctx, s.keeper)
+
+			_, err := s.keeper.GetOffer(ctx, s.offerID)
+			if !tc.refunded {
+				s.Require().NoError(err)
+				return
+			}
+			s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+			s.Require().Equal(s.balance.String(), s.bankKeeper.GetBalance(ctx, s.maker, sdk.DefaultBondDenom).Amount.String())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRefundExpiredOffersFailure() {
+	offer, err := s.keeper.Offer(s.ctx, s.maker, s.tokenAsset(sdk.OneInt()), s.coinAsset(sdk.OneInt()), s.expiry)
+	s.Require().NoError(err)
+
+	// the escrowed tokens cannot be refunded while the contract is paused
+	err = s.tokenKeeper.Pause(s.ctx, s.tokenContractID, s.maker)
+	s.Require().NoError(err)
+
+	ctx := s.ctx.WithBlockTime(s.expiry)
+	keeper.EndBlocker(ctx, s.keeper)
+
+	// the others are refunded
+	_, err = s.keeper.GetOffer(ctx, s.offerID)
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+
+	// the failed one is retried at the next block
+	_, err = s.keeper.GetOffer(ctx, offer.Id)
+	s.Require().NoError(err)
+
+	err = s.tokenKeeper.Unpause(ctx, s.tokenContractID, s.maker)
+	s.Require().NoError(err)
+
+	keeper.EndBlocker(ctx, s.keeper)
+	_, err = s.keeper.GetOffer(ctx, offer.Id)
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+}
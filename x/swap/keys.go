@@ -0,0 +1,12 @@
+package swap
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "swap"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+)
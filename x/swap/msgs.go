@@ -0,0 +1,121 @@
+package swap
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+)
+
+var _ sdk.Msg = (*MsgOffer)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgOffer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Maker); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid maker address: %s", m.Maker)
+	}
+
+	if err := m.Offer.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := m.Ask.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if m.Expiry.IsZero() {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty expiry")
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgOffer) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Maker)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgOffer) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgOffer) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgOffer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgAccept)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgAccept) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Taker); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid taker address: %s", m.Taker)
+	}
+
+	if m.OfferId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty offer id")
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgAccept) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Taker)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgAccept) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgAccept) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgAccept) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgCancel)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgCancel) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Maker); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid maker address: %s", m.Maker)
+	}
+
+	if m.OfferId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty offer id")
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgCancel) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Maker)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgCancel) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgCancel) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgCancel) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
@@ -0,0 +1,216 @@
+package swap_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/crypto/keys/secp256k1"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/swap"
+)
+
+func TestAssetValidateBasic(t *testing.T) {
+	testCases := map[string]struct {
+		asset swap.Asset
+		err   error
+	}{
+		"valid coins": {
+			asset: swap.Asset{Type: swap.AssetTypeCoin, Denom: "stake", Amount: sdk.OneInt()},
+		},
+		"valid tokens": {
+			asset: swap.Asset{Type: swap.AssetTypeToken, ContractId: "deadbeef", Amount: sdk.OneInt()},
+		},
+		"valid fts": {
+			asset: swap.Asset{Type: swap.AssetTypeCollection, ContractId: "deadbeef", TokenId: "00bab10c00000000", Amount: sdk.NewInt(10)},
+		},
+		"valid nft": {
+			asset: swap.Asset{Type: swap.AssetTypeCollection, ContractId: "deadbeef", TokenId: "deadbeef00000001", Amount: sdk.OneInt()},
+		},
+		"unspecified type": {
+			asset: swap.Asset{Denom: "stake", Amount: sdk.OneInt()},
+			err:   swap.ErrInvalidAsset,
+		},
+		"invalid denom": {
+			asset: swap.Asset{Type: swap.AssetTypeCoin, Amount: sdk.OneInt()},
+			err:   swap.ErrInvalidAsset,
+		},
+		"coins with contract id": {
+			asset: swap.Asset{Type: swap.AssetTypeCoin, Denom: "stake", ContractId: "deadbeef", Amount: sdk.OneInt()},
+			err:   swap.ErrInvalidAsset,
+		},
+		"invalid contract id": {
+			asset: swap.Asset{Type: swap.AssetTypeToken, Amount: sdk.OneInt()},
+			err:   swap.ErrInvalidAsset,
+		},
+		"invalid token id": {
+			asset: swap.Asset{Type: swap.AssetTypeCollection, ContractId: "deadbeef", Amount: sdk.OneInt()},
+			err:   swap.ErrInvalidAsset,
+		},
+		"empty amount": {
+			asset: swap.Asset{Type: swap.AssetTypeCoin, Denom: "stake"},
+			err:   swap.ErrInvalidAsset,
+		},
+		"zero amount": {
+			asset: swap.Asset{Type: swap.AssetTypeCoin, Denom: "stake", Amount: sdk.ZeroInt()},
+			err:   swap.ErrInvalidAsset,
+		},
+		"multiple nfts": {
+			asset: swap.Asset{Type: swap.AssetTypeCollection, ContractId: "deadbeef", TokenId: "deadbeef00000001", Amount: sdk.NewInt(2)},
+			err:   swap.ErrInvalidAsset,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.asset.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestMsgOffer(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	asset := swap.Asset{Type: swap.AssetTypeCoin, Denom: "stake", Amount: sdk.OneInt()}
+	expiry := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		maker  sdk.AccAddress
+		offer  swap.Asset
+		ask    swap.Asset
+		expiry time.Time
+		err    error
+	}{
+		"valid msg": {
+			maker:  addr,
+			offer:  asset,
+			ask:    asset,
+			expiry: expiry,
+		},
+		"invalid maker": {
+			offer:  asset,
+			ask:    asset,
+			expiry: expiry,
+			err:    sdkerrors.ErrInvalidAddress,
+		},
+		"invalid offer": {
+			maker:  addr,
+			ask:    asset,
+			expiry: expiry,
+			err:    swap.ErrInvalidAsset,
+		},
+		"invalid ask": {
+			maker:  addr,
+			offer:  asset,
+			expiry: expiry,
+			err:    swap.ErrInvalidAsset,
+		},
+		"empty expiry": {
+			maker: addr,
+			offer: asset,
+			ask:   asset,
+			err:   sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := swap.MsgOffer{
+				Maker:  tc.maker.String(),
+				Offer:  tc.offer,
+				Ask:    tc.ask,
+				Expiry: tc.expiry,
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.maker}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgAccept(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	testCases := map[string]struct {
+		taker   sdk.AccAddress
+		offerID uint64
+		err     error
+	}{
+		"valid msg": {
+			taker:   addr,
+			offerID: 1,
+		},
+		"invalid taker": {
+			offerID: 1,
+			err:     sdkerrors.ErrInvalidAddress,
+		},
+		"empty offer id": {
+			taker: addr,
+			err:   sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := swap.MsgAccept{
+				Taker:   tc.taker.String(),
+				OfferId: tc.offerID,
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.taker}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgCancel(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	testCases := map[string]struct {
+		maker   sdk.AccAddress
+		offerID uint64
+		err     error
+	}{
+		"valid msg": {
+			maker:   addr,
+			offerID: 1,
+		},
+		"invalid maker": {
+			offerID: 1,
+			err:     sdkerrors.ErrInvalidAddress,
+		},
+		"empty offer id": {
+			maker: addr,
+			err:   sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := swap.MsgCancel{
+				Maker:   tc.maker.String(),
+				OfferId: tc.offerID,
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.maker}, msg.GetSigners())
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package swap
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/collection"
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+// ValidateBasic validates the asset, including its amount.
+func (a Asset) ValidateBasic() error {
+	if err := a.ValidateID(); err != nil {
+		return err
+	}
+
+	if a.Amount.IsNil() || !a.Amount.IsPositive() {
+		return ErrInvalidAsset.Wrapf("amount must be positive: %s", a.Amount)
+	}
+
+	if a.Type == AssetTypeCollection && a.IsNFT() && !a.Amount.Equal(sdk.OneInt()) {
+		return ErrInvalidAsset.Wrapf("amount of nft must be one: %s", a.Amount)
+	}
+
+	return nil
+}
+
+// ValidateID validates the fields identifying the asset, regardless of its amount.
+func (a Asset) ValidateID() error {
+	switch a.Type {
+	case AssetTypeCoin:
+		if err := sdk.ValidateDenom(a.Denom); err != nil {
+			return ErrInvalidAsset.Wrap(err.Error())
+		}
+		if len(a.ContractId) != 0 || len(a.TokenId) != 0 {
+			return ErrInvalidAsset.Wrap("coins must not have contract id nor token id")
+		}
+	case AssetTypeToken:
+		if err := token.ValidateContractID(a.ContractId); err != nil {
+			return ErrInvalidAsset.Wrap(err.Error())
+		}
+		if len(a.Denom) != 0 || len(a.TokenId) != 0 {
+			return ErrInvalidAsset.Wrap("tokens must not have denom nor token id")
+		}
+	case AssetTypeCollection:
+		if err := collection.ValidateContractID(a.ContractId); err != nil {
+			return ErrInvalidAsset.Wrap(err.Error())
+		}
+		if err := collection.ValidateTokenID(a.TokenId); err != nil {
+			return ErrInvalidAsset.Wrap(err.Error())
+		}
+		if len(a.Denom) != 0 {
+			return ErrInvalidAsset.Wrap("collection tokens must not have denom")
+		}
+	default:
+		return ErrInvalidAsset.Wrapf("invalid asset type: %s", a.Type)
+	}
+
+	return nil
+}
+
+// IsNFT returns whether the asset is a non-fungible token of x/collection.
+func (a Asset) IsNFT() bool {
+	return a.Type == AssetTypeCollection && collection.ValidateNFTID(a.TokenId) == nil
+}
+
+// ValidateBasic validates the offer.
+func (o Offer) ValidateBasic() error {
+	if o.Id == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("offer id must be positive")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(o.Maker); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid maker address: %s", o.Maker)
+	}
+
+	if err := o.Offer.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := o.Ask.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if o.Expiry.IsZero() {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty expiry")
+	}
+
+	return nil
+}
@@ -24,11 +24,18 @@ func NewQueryCmd() *cobra.Command {
 
 	queryCmd.AddCommand(
 		NewQueryCmdBalance(),
+		NewQueryCmdSpendable(),
+		NewQueryCmdLocked(),
 		NewQueryCmdSupply(),
 		NewQueryCmdMinted(),
 		NewQueryCmdBurnt(),
 		NewQueryCmdContract(),
+		NewQueryCmdContracts(),
+		NewQueryCmdHolders(),
+		NewQueryCmdHolderContracts(),
 		NewQueryCmdGranteeGrants(),
+		NewQueryCmdPaused(),
+		NewQueryCmdFrozen(),
 		NewQueryCmdIsOperatorFor(),
 		NewQueryCmdHoldersByOperator(),
 	)
@@ -63,6 +70,60 @@ func NewQueryCmdBalance() *cobra.Command {
 	return cmd
 }
 
+func NewQueryCmdSpendable() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "spendable [class-id] [address]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "query for spendable token balances by a given address",
+		Example: fmt.Sprintf(`$ %s query %s spendable <class-id> <address>`, version.AppName, token.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := token.NewQueryClient(clientCtx)
+			res, err := queryClient.Spendable(cmd.Context(), &token.QuerySpendableRequest{
+				ContractId: args[0],
+				Address:    args[1],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewQueryCmdLocked() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "locked [class-id] [address]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "query for locked token balances by a given address",
+		Example: fmt.Sprintf(`$ %s query %s locked <class-id> <address>`, version.AppName, token.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := token.NewQueryClient(clientCtx)
+			res, err := queryClient.Locked(cmd.Context(), &token.QueryLockedRequest{
+				ContractId: args[0],
+				Address:    args[1],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func NewQueryCmdSupply() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "supply [class-id]",
@@ -167,6 +228,101 @@ func NewQueryCmdContract() *cobra.Command {
 	return cmd
 }
 
+func NewQueryCmdContracts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "tokens",
+		Args:    cobra.NoArgs,
+		Short:   "query all the token metadata",
+		Example: fmt.Sprintf(`$ %s query %s tokens`, version.AppName, token.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := token.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.Contracts(cmd.Context(), &token.QueryContractsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "tokens")
+	return cmd
+}
+
+func NewQueryCmdHolders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "holders [contract-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "query all the holders of a given token along with their balances",
+		Example: fmt.Sprintf(`$ %s query %s holders <contract-id>`, version.AppName, token.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := token.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.Holders(cmd.Context(), &token.QueryHoldersRequest{
+				ContractId: args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "holders")
+	return cmd
+}
+
+func NewQueryCmdHolderContracts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "holder-tokens [address]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "query all the tokens held by a given address along with the balances",
+		Example: fmt.Sprintf(`$ %s query %s holder-tokens <address>`, version.AppName, token.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := token.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.HolderContracts(cmd.Context(), &token.QueryHolderContractsRequest{
+				Address:    args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "holder tokens")
+	return cmd
+}
+
 func NewQueryCmdGranteeGrants() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "grantee-grants [class-id] [grantee]",
@@ -194,6 +350,59 @@ func NewQueryCmdGranteeGrants() *cobra.Command {
 	return cmd
 }
 
+func NewQueryCmdPaused() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "paused [class-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "query whether transfers of the class are paused",
+		Example: fmt.Sprintf(`$ %s query %s paused <class-id>`, version.AppName, token.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := token.NewQueryClient(clientCtx)
+			res, err := queryClient.Paused(cmd.Context(), &token.QueryPausedRequest{
+				ContractId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewQueryCmdFrozen() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "frozen [class-id] [address]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "query whether the tokens of a given address are frozen",
+		Example: fmt.Sprintf(`$ %s query %s frozen <class-id> <address>`, version.AppName, token.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := token.NewQueryClient(clientCtx)
+			res, err := queryClient.Frozen(cmd.Context(), &token.QueryFrozenRequest{
+				ContractId: args[0],
+				Address:    args[1],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func NewQueryCmdIsOperatorFor() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "is-operator-for [class-id] [operator] [holder]",
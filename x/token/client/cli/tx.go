@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -22,6 +23,12 @@ const (
 	FlagMeta     = "meta"
 	FlagImageURI = "image-uri"
 
+	FlagVestingStartTime = "vesting-start-time"
+	FlagVestingEndTime   = "vesting-end-time"
+
+	FlagAllowance  = "allowance"
+	FlagExpiration = "expiration"
+
 	DefaultDecimals = 8
 	DefaultSupply   = "1"
 )
@@ -38,6 +45,7 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		NewTxCmdSend(),
+		NewTxCmdMultiSend(),
 		NewTxCmdOperatorSend(),
 		NewTxCmdAuthorizeOperator(),
 		NewTxCmdRevokeOperator(),
@@ -48,6 +56,13 @@ func NewTxCmd() *cobra.Command {
 		NewTxCmdBurn(),
 		NewTxCmdOperatorBurn(),
 		NewTxCmdModify(),
+		NewTxCmdPause(),
+		NewTxCmdUnpause(),
+		NewTxCmdFreeze(),
+		NewTxCmdUnfreeze(),
+		NewTxCmdProposeAdmin(),
+		NewTxCmdAcceptAdmin(),
+		NewTxCmdRevokeGrant(),
 	)
 
 	return txCmd
@@ -89,6 +104,60 @@ func NewTxCmdSend() *cobra.Command {
 	return cmd
 }
 
+func NewTxCmdMultiSend() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multi-send [contract-id] [from] [to] [amount] [[to] [amount]...]",
+		Args:  validatePairArgs(2),
+		Short: "send tokens to multiple recipients",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s multi-send <contract-id> <from> <to1> <amount1> <to2> <amount2>`, version.AppName, token.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var outputs []token.Output
+			for i := 2; i < len(args); i += 2 {
+				amountStr := args[i+1]
+				amount, ok := sdk.NewIntFromString(amountStr)
+				if !ok {
+					return sdkerrors.ErrInvalidType.Wrapf("failed to set amount: %s", amountStr)
+				}
+				outputs = append(outputs, token.Output{
+					To:     args[i],
+					Amount: amount,
+				})
+			}
+
+			msg := &token.MsgMultiSend{
+				ContractId: args[0],
+				From:       args[1],
+				Outputs:    outputs,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// validatePairArgs checks the positional arguments consist of the given
+// number of leading arguments followed by one or more pairs.
+func validatePairArgs(numLeading int) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		if len(args) < numLeading+2 || (len(args)-numLeading)%2 != 0 {
+			return fmt.Errorf("requires %d args followed by pairs of args, received %d", numLeading, len(args))
+		}
+		return nil
+	}
+}
+
 func NewTxCmdOperatorSend() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "operator-send [contract-id] [operator] [from] [to] [amount]",
@@ -145,6 +214,31 @@ func NewTxCmdAuthorizeOperator() *cobra.Command {
 				Holder:     args[1],
 				Operator:   args[2],
 			}
+
+			allowanceStr, err := cmd.Flags().GetString(FlagAllowance)
+			if err != nil {
+				return err
+			}
+			if len(allowanceStr) != 0 {
+				allowance, ok := sdk.NewIntFromString(allowanceStr)
+				if !ok {
+					return sdkerrors.ErrInvalidType.Wrapf("failed to set allowance: %s", allowanceStr)
+				}
+				msg.Allowance = &allowance
+			}
+
+			expirationStr, err := cmd.Flags().GetString(FlagExpiration)
+			if err != nil {
+				return err
+			}
+			if len(expirationStr) != 0 {
+				expiration, err := time.Parse(time.RFC3339, expirationStr)
+				if err != nil {
+					return err
+				}
+				msg.Expiration = &expiration
+			}
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -153,6 +247,8 @@ func NewTxCmdAuthorizeOperator() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagAllowance, "", "maximum number of tokens which the operator can send or burn; no limit if empty")
+	cmd.Flags().String(FlagExpiration, "", "time (RFC3339) at which the authorization expires; never expires if empty")
 	return cmd
 }
 
@@ -338,11 +434,17 @@ func NewTxCmdMint() *cobra.Command {
 				return sdkerrors.ErrInvalidType.Wrapf("failed to set amount: %s", amountStr)
 			}
 
+			vesting, err := parseVestingSchedule(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := token.MsgMint{
 				ContractId: args[0],
 				From:       args[1],
 				To:         args[2],
 				Amount:     amount,
+				Vesting:    vesting,
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -352,9 +454,47 @@ func NewTxCmdMint() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagVestingStartTime, "", "time (RFC3339) from which the minted tokens start to be unlocked (defaults to the end time)")
+	cmd.Flags().String(FlagVestingEndTime, "", "time (RFC3339) at which all the minted tokens are unlocked; the tokens are not locked if empty")
 	return cmd
 }
 
+// parseVestingSchedule returns the vesting schedule from the flags, or nil if no schedule is provided.
+func parseVestingSchedule(cmd *cobra.Command) (*token.VestingSchedule, error) {
+	endTimeStr, err := cmd.Flags().GetString(FlagVestingEndTime)
+	if err != nil {
+		return nil, err
+	}
+	startTimeStr, err := cmd.Flags().GetString(FlagVestingStartTime)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(endTimeStr) == 0 {
+		if len(startTimeStr) != 0 {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s requires %s", FlagVestingStartTime, FlagVestingEndTime)
+		}
+		return nil, nil
+	}
+
+	endTime, err := time.Parse(time.RFC3339, endTimeStr)
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := endTime
+	if len(startTimeStr) != 0 {
+		if startTime, err = time.Parse(time.RFC3339, startTimeStr); err != nil {
+			return nil, err
+		}
+	}
+
+	return &token.VestingSchedule{
+		StartTime: startTime,
+		EndTime:   endTime,
+	}, nil
+}
+
 func NewTxCmdBurn() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "burn [contract-id] [from] [amount]",
@@ -458,3 +598,211 @@ func NewTxCmdModify() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func NewTxCmdPause() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause [contract-id] [grantee]",
+		Args:  cobra.ExactArgs(2),
+		Short: "pause transfers of a contract",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s pause <contract-id> <grantee>`, version.AppName, token.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := token.MsgPause{
+				ContractId: args[0],
+				From:       args[1],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdUnpause() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unpause [contract-id] [grantee]",
+		Args:  cobra.ExactArgs(2),
+		Short: "resume transfers of a paused contract",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s unpause <contract-id> <grantee>`, version.AppName, token.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := token.MsgUnpause{
+				ContractId: args[0],
+				From:       args[1],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdFreeze() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze [contract-id] [grantee] [holder]",
+		Args:  cobra.ExactArgs(3),
+		Short: "freeze the tokens of a holder",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s freeze <contract-id> <grantee> <holder>`, version.AppName, token.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := token.MsgFreeze{
+				ContractId: args[0],
+				From:       args[1],
+				Holder:     args[2],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdUnfreeze() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze [contract-id] [grantee] [holder]",
+		Args:  cobra.ExactArgs(3),
+		Short: "unfreeze the tokens of a frozen holder",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s unfreeze <contract-id> <grantee> <holder>`, version.AppName, token.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := token.MsgUnfreeze{
+				ContractId: args[0],
+				From:       args[1],
+				Holder:     args[2],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdProposeAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-admin [contract-id] [admin] [proposed-admin]",
+		Args:  cobra.ExactArgs(3),
+		Short: "propose the next admin of a contract",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s propose-admin <contract-id> <admin> <proposed-admin>`, version.AppName, token.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := token.MsgProposeAdmin{
+				ContractId: args[0],
+				From:       args[1],
+				To:         args[2],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdAcceptAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-admin [contract-id] [proposed-admin]",
+		Args:  cobra.ExactArgs(2),
+		Short: "accept the admin role of a contract",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s accept-admin <contract-id> <proposed-admin>`, version.AppName, token.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := token.MsgAcceptAdmin{
+				ContractId: args[0],
+				From:       args[1],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdRevokeGrant() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-grant [contract-id] [admin] [grantee] [permission]",
+		Args:  cobra.ExactArgs(4),
+		Short: "revoke a permission of a grantee by the admin",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s revoke-grant <contract-id> <admin> <grantee> <permission>`, version.AppName, token.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := token.MsgRevokeGrant{
+				ContractId: args[0],
+				From:       args[1],
+				Grantee:    args[2],
+				Permission: args[3],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -167,9 +167,17 @@ func (s *IntegrationTestSuite) TestNewQueryCmdGranteeGrants() {
 						Grantee:    s.vendor.String(),
 						Permission: token.PermissionBurn,
 					},
+					{
+						Grantee:    s.vendor.String(),
+						Permission: token.PermissionPause,
+					},
+					{
+						Grantee:    s.vendor.String(),
+						Permission: token.PermissionFreeze,
+					},
 				},
 				Pagination: &query.PageResponse{
-					Total: 3,
+					Total: 5,
 				},
 			},
 		},
@@ -293,6 +301,10 @@ func (s *IntegrationTestSuite) TestNewQueryCmdHoldersByOperator() {
 			&token.QueryHoldersByOperatorResponse{
 				Holders:    []string{s.customer.String()},
 				Pagination: &query.PageResponse{},
+				Authorizations: []token.Authorization{{
+					Holder:   s.customer.String(),
+					Operator: s.vendor.String(),
+				}},
 			},
 		},
 		"extra args": {
@@ -175,6 +175,34 @@ func (s *IntegrationTestSuite) TestNewTxCmdAuthorizeOperator() {
 			},
 			true,
 		},
+		"valid transaction with limits": {
+			[]string{
+				s.classes[1].Id,
+				s.vendor.String(),
+				s.customer.String(),
+				fmt.Sprintf("--%s=%s", cli.FlagAllowance, s.balance),
+				fmt.Sprintf("--%s=%s", cli.FlagExpiration, "2100-01-01T00:00:00Z"),
+			},
+			true,
+		},
+		"invalid allowance": {
+			[]string{
+				s.classes[1].Id,
+				s.vendor.String(),
+				s.customer.String(),
+				fmt.Sprintf("--%s=%s", cli.FlagAllowance, "0"),
+			},
+			false,
+		},
+		"invalid expiration": {
+			[]string{
+				s.classes[1].Id,
+				s.vendor.String(),
+				s.customer.String(),
+				fmt.Sprintf("--%s=%s", cli.FlagExpiration, "tomorrow"),
+			},
+			false,
+		},
 		"extra args": {
 			[]string{
 				s.classes[0].Id,
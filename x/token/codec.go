@@ -15,6 +15,7 @@ import (
 // RegisterLegacyAminoCodec registers concrete types on the LegacyAmino codec
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgSend{}, "lbm-sdk/MsgSend")
+	legacy.RegisterAminoMsg(cdc, &MsgMultiSend{}, "lbm-sdk/MsgMultiSend")
 	legacy.RegisterAminoMsg(cdc, &MsgOperatorSend{}, "lbm-sdk/MsgOperatorSend")
 	legacy.RegisterAminoMsg(cdc, &MsgRevokeOperator{}, "lbm-sdk/token/MsgRevokeOperator")       // Changed msgName due to conflict with `x/collection`
 	legacy.RegisterAminoMsg(cdc, &MsgAuthorizeOperator{}, "lbm-sdk/token/MsgAuthorizeOperator") // Changed msgName due to conflict with `x/collection`
@@ -25,11 +26,19 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgBurn{}, "lbm-sdk/MsgBurn")
 	legacy.RegisterAminoMsg(cdc, &MsgOperatorBurn{}, "lbm-sdk/MsgOperatorBurn")
 	legacy.RegisterAminoMsg(cdc, &MsgModify{}, "lbm-sdk/token/MsgModify") // Changed msgName due to conflict with `x/collection`
+	legacy.RegisterAminoMsg(cdc, &MsgPause{}, "lbm-sdk/token/MsgPause")
+	legacy.RegisterAminoMsg(cdc, &MsgUnpause{}, "lbm-sdk/token/MsgUnpause")
+	legacy.RegisterAminoMsg(cdc, &MsgFreeze{}, "lbm-sdk/token/MsgFreeze")
+	legacy.RegisterAminoMsg(cdc, &MsgUnfreeze{}, "lbm-sdk/token/MsgUnfreeze")
+	legacy.RegisterAminoMsg(cdc, &MsgProposeAdmin{}, "lbm-sdk/token/MsgProposeAdmin")
+	legacy.RegisterAminoMsg(cdc, &MsgAcceptAdmin{}, "lbm-sdk/token/MsgAcceptAdmin")
+	legacy.RegisterAminoMsg(cdc, &MsgRevokeGrant{}, "lbm-sdk/token/MsgRevokeGrant")
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSend{},
+		&MsgMultiSend{},
 		&MsgRevokeOperator{},
 		&MsgIssue{},
 		&MsgMint{},
@@ -40,6 +49,13 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgOperatorBurn{},
 		&MsgGrantPermission{},
 		&MsgRevokePermission{},
+		&MsgPause{},
+		&MsgUnpause{},
+		&MsgFreeze{},
+		&MsgUnfreeze{},
+		&MsgProposeAdmin{},
+		&MsgAcceptAdmin{},
+		&MsgRevokeGrant{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
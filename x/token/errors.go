@@ -30,4 +30,9 @@ var (
 	ErrApproverProxySame        = sdkerrors.Register(tokenCodespace, 22, "approver is same with proxy")
 	ErrTokenNotApproved         = sdkerrors.Register(tokenCodespace, 23, "proxy is not approved on the token")
 	ErrTokenAlreadyApproved     = sdkerrors.Register(tokenCodespace, 24, "proxy is already approved on the token")
+	ErrTokenPaused              = sdkerrors.Register(tokenCodespace, 25, "token is paused")
+	ErrAccountFrozen            = sdkerrors.Register(tokenCodespace, 26, "account is frozen")
+	ErrInsufficientAllowance    = sdkerrors.Register(tokenCodespace, 27, "insufficient allowance")
+	ErrBatchTooLarge            = sdkerrors.Register(tokenCodespace, 28, "batch size exceeds the limit")
+	ErrTooManyLocks             = sdkerrors.Register(tokenCodespace, 29, "too many locks")
 )
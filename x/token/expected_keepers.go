@@ -2,6 +2,7 @@ package token
 
 import (
 	sdk "github.com/Finschia/finschia-sdk/types"
+	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
 )
 
 type (
@@ -13,4 +14,16 @@ type (
 		InitGenesis(ctx sdk.Context, data *ClassGenesisState)
 		ExportGenesis(ctx sdk.Context) *ClassGenesisState
 	}
+
+	// AccountKeeper defines the auth module interface contract needed by the
+	// token module simulation.
+	AccountKeeper interface {
+		GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
+	}
+
+	// BankKeeper defines the bank module interface contract needed by the
+	// token module simulation.
+	BankKeeper interface {
+		SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	}
 )
@@ -9,6 +9,10 @@ import (
 
 // ValidateGenesis check the given genesis state has no integrity issues
 func ValidateGenesis(data GenesisState) error {
+	if err := validateParams(data.Params); err != nil {
+		return err
+	}
+
 	if data.ClassState != nil {
 		if err := ValidateClassGenesis(*data.ClassState); err != nil {
 			return err
@@ -52,6 +56,16 @@ func ValidateGenesis(data GenesisState) error {
 		if err := validateDecimals(c.Decimals); err != nil {
 			return err
 		}
+		if len(c.Admin) != 0 {
+			if _, err := sdk.AccAddressFromBech32(c.Admin); err != nil {
+				return err
+			}
+		}
+		if len(c.PendingAdmin) != 0 {
+			if _, err := sdk.AccAddressFromBech32(c.PendingAdmin); err != nil {
+				return err
+			}
+		}
 	}
 
 	for _, contractGrants := range data.Grants {
@@ -87,15 +101,90 @@ func ValidateGenesis(data GenesisState) error {
 			if _, err := sdk.AccAddressFromBech32(authorization.Operator); err != nil {
 				return err
 			}
+			if err := validateAllowance(authorization.Allowance); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, contractLocks := range data.Locks {
+		if err := ValidateContractID(contractLocks.ContractId); err != nil {
+			return err
+		}
+
+		if len(contractLocks.Locks) == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrap("locks cannot be empty")
+		}
+		for _, lock := range contractLocks.Locks {
+			if err := lock.ValidateBasic(); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, contractID := range data.Paused {
+		if err := ValidateContractID(contractID); err != nil {
+			return err
+		}
+	}
+
+	for _, contractHolders := range data.Frozen {
+		if err := ValidateContractID(contractHolders.ContractId); err != nil {
+			return err
+		}
+
+		if len(contractHolders.Holders) == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrap("holders cannot be empty")
+		}
+		for _, holder := range contractHolders.Holders {
+			if _, err := sdk.AccAddressFromBech32(holder); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, contractHistory := range data.AdminHistories {
+		if err := ValidateContractID(contractHistory.ContractId); err != nil {
+			return err
+		}
+
+		if len(contractHistory.Entries) == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrap("admin history cannot be empty")
+		}
+		for _, entry := range contractHistory.Entries {
+			if len(entry.PreviousAdmin) != 0 {
+				if _, err := sdk.AccAddressFromBech32(entry.PreviousAdmin); err != nil {
+					return err
+				}
+			}
+			if _, err := sdk.AccAddressFromBech32(entry.Admin); err != nil {
+				return err
+			}
 		}
 	}
 
 	return nil
 }
 
+const (
+	DefaultMaxBatchSize = 100
+)
+
+func validateParams(params Params) error {
+	if params.MaxBatchSize == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("max batch size cannot be zero")
+	}
+	return nil
+}
+
 // DefaultGenesisState - Return a default genesis state
 func DefaultGenesisState() *GenesisState {
-	return &GenesisState{ClassState: DefaultClassGenesisState()}
+	return &GenesisState{
+		Params: Params{
+			MaxBatchSize: DefaultMaxBatchSize,
+		},
+		ClassState: DefaultClassGenesisState(),
+	}
 }
 
 // For Class keeper
@@ -44,6 +44,14 @@ type GenesisState struct {
 	Mints []ContractCoin `protobuf:"bytes,8,rep,name=mints,proto3" json:"mints"`
 	// burns represents the total burns of tokens.
 	Burns []ContractCoin `protobuf:"bytes,9,rep,name=burns,proto3" json:"burns"`
+	// locks defines the locked tokens of the holders.
+	Locks []ContractLocks `protobuf:"bytes,10,rep,name=locks,proto3" json:"locks"`
+	// paused defines the contract ids of the paused contracts.
+	Paused []string `protobuf:"bytes,11,rep,name=paused,proto3" json:"paused,omitempty"`
+	// frozen defines the frozen holders.
+	Frozen []ContractHolders `protobuf:"bytes,12,rep,name=frozen,proto3" json:"frozen"`
+	// admin_histories defines the admin histories of the contracts.
+	AdminHistories []ContractAdminHistory `protobuf:"bytes,13,rep,name=admin_histories,json=adminHistories,proto3" json:"admin_histories"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -142,6 +150,34 @@ func (m *GenesisState) GetBurns() []ContractCoin {
 	return nil
 }
 
+func (m *GenesisState) GetLocks() []ContractLocks {
+	if m != nil {
+		return m.Locks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPaused() []string {
+	if m != nil {
+		return m.Paused
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFrozen() []ContractHolders {
+	if m != nil {
+		return m.Frozen
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAdminHistories() []ContractAdminHistory {
+	if m != nil {
+		return m.AdminHistories
+	}
+	return nil
+}
+
 // ClassGenesisState defines the classs keeper's genesis state.
 type ClassGenesisState struct {
 	// nonce is the next class nonce to issue.
@@ -349,6 +385,171 @@ func (m *ContractAuthorizations) GetAuthorizations() []Authorization {
 	return nil
 }
 
+// ContractLocks defines locks belong to a contract.
+type ContractLocks struct {
+	// contract id associated with the token class.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// locks of the contract.
+	Locks []Lock `protobuf:"bytes,2,rep,name=locks,proto3" json:"locks"`
+}
+
+func (m *ContractLocks) Reset()         { *m = ContractLocks{} }
+func (m *ContractLocks) String() string { return proto.CompactTextString(m) }
+func (*ContractLocks) ProtoMessage()    {}
+func (*ContractLocks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4528f1ba25ef9938, []int{5}
+}
+func (m *ContractLocks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractLocks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractLocks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractLocks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractLocks.Merge(m, src)
+}
+func (m *ContractLocks) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractLocks) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractLocks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractLocks proto.InternalMessageInfo
+
+func (m *ContractLocks) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *ContractLocks) GetLocks() []Lock {
+	if m != nil {
+		return m.Locks
+	}
+	return nil
+}
+
+// ContractHolders defines holders belong to a contract.
+type ContractHolders struct {
+	// contract id associated with the token class.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// addresses of the holders.
+	Holders []string `protobuf:"bytes,2,rep,name=holders,proto3" json:"holders,omitempty"`
+}
+
+func (m *ContractHolders) Reset()         { *m = ContractHolders{} }
+func (m *ContractHolders) String() string { return proto.CompactTextString(m) }
+func (*ContractHolders) ProtoMessage()    {}
+func (*ContractHolders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4528f1ba25ef9938, []int{6}
+}
+func (m *ContractHolders) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractHolders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractHolders.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractHolders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractHolders.Merge(m, src)
+}
+func (m *ContractHolders) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractHolders) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractHolders.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractHolders proto.InternalMessageInfo
+
+func (m *ContractHolders) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *ContractHolders) GetHolders() []string {
+	if m != nil {
+		return m.Holders
+	}
+	return nil
+}
+
+// ContractAdminHistory defines the admin history of a contract.
+type ContractAdminHistory struct {
+	// contract id associated with the token class.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// entries of the history, in chronological order.
+	Entries []AdminHistoryEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *ContractAdminHistory) Reset()         { *m = ContractAdminHistory{} }
+func (m *ContractAdminHistory) String() string { return proto.CompactTextString(m) }
+func (*ContractAdminHistory) ProtoMessage()    {}
+func (*ContractAdminHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4528f1ba25ef9938, []int{7}
+}
+func (m *ContractAdminHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractAdminHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractAdminHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractAdminHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractAdminHistory.Merge(m, src)
+}
+func (m *ContractAdminHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractAdminHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractAdminHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractAdminHistory proto.InternalMessageInfo
+
+func (m *ContractAdminHistory) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *ContractAdminHistory) GetEntries() []AdminHistoryEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
 // ContractGrant defines grants belong to a contract.
 type ContractGrants struct {
 	// contract id associated with the token class.
@@ -361,7 +562,7 @@ func (m *ContractGrants) Reset()         { *m = ContractGrants{} }
 func (m *ContractGrants) String() string { return proto.CompactTextString(m) }
 func (*ContractGrants) ProtoMessage()    {}
 func (*ContractGrants) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4528f1ba25ef9938, []int{5}
+	return fileDescriptor_4528f1ba25ef9938, []int{8}
 }
 func (m *ContractGrants) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -415,7 +616,7 @@ func (m *ContractCoin) Reset()         { *m = ContractCoin{} }
 func (m *ContractCoin) String() string { return proto.CompactTextString(m) }
 func (*ContractCoin) ProtoMessage()    {}
 func (*ContractCoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4528f1ba25ef9938, []int{6}
+	return fileDescriptor_4528f1ba25ef9938, []int{9}
 }
 func (m *ContractCoin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -457,6 +658,9 @@ func init() {
 	proto.RegisterType((*ContractBalances)(nil), "lbm.token.v1.ContractBalances")
 	proto.RegisterType((*Balance)(nil), "lbm.token.v1.Balance")
 	proto.RegisterType((*ContractAuthorizations)(nil), "lbm.token.v1.ContractAuthorizations")
+	proto.RegisterType((*ContractLocks)(nil), "lbm.token.v1.ContractLocks")
+	proto.RegisterType((*ContractHolders)(nil), "lbm.token.v1.ContractHolders")
+	proto.RegisterType((*ContractAdminHistory)(nil), "lbm.token.v1.ContractAdminHistory")
 	proto.RegisterType((*ContractGrants)(nil), "lbm.token.v1.ContractGrants")
 	proto.RegisterType((*ContractCoin)(nil), "lbm.token.v1.ContractCoin")
 }
@@ -464,44 +668,53 @@ func init() {
 func init() { proto.RegisterFile("lbm/token/v1/genesis.proto", fileDescriptor_4528f1ba25ef9938) }
 
 var fileDescriptor_4528f1ba25ef9938 = []byte{
-	// 579 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
-	0x14, 0x85, 0xe3, 0x26, 0x4d, 0x9a, 0x9b, 0xaa, 0x2a, 0x43, 0xa9, 0xac, 0x80, 0x9c, 0xca, 0x62,
-	0x11, 0x81, 0xb0, 0x95, 0x54, 0x2a, 0x52, 0xc5, 0xa2, 0xb8, 0x12, 0x55, 0x58, 0x21, 0x23, 0x36,
-	0x6c, 0xaa, 0xf1, 0x0f, 0xc9, 0xa8, 0xf6, 0x8c, 0xe5, 0x19, 0x57, 0xfc, 0x6c, 0x79, 0x00, 0x1e,
-	0x81, 0x07, 0xe1, 0x01, 0xba, 0xec, 0x12, 0xb1, 0xa8, 0x50, 0xb2, 0xe1, 0x31, 0x90, 0x67, 0xec,
-	0x2a, 0x4e, 0x83, 0x92, 0x05, 0xbb, 0x89, 0xef, 0x39, 0xdf, 0xcd, 0xb1, 0x8f, 0x0d, 0xdd, 0xc8,
-	0x8b, 0x6d, 0xc1, 0x2e, 0x42, 0x6a, 0x5f, 0x0e, 0xec, 0x71, 0x48, 0x43, 0x4e, 0xb8, 0x95, 0xa4,
-	0x4c, 0x30, 0xb4, 0x1d, 0x79, 0xb1, 0x25, 0x67, 0xd6, 0xe5, 0xa0, 0xbb, 0x37, 0x66, 0x63, 0x26,
-	0x07, 0x76, 0x7e, 0x52, 0x9a, 0xae, 0x5e, 0xf1, 0x2b, 0xb1, 0x9c, 0x98, 0x3f, 0x1a, 0xb0, 0x7d,
-	0xa6, 0x78, 0x6f, 0x05, 0x16, 0x21, 0x1a, 0x42, 0x33, 0xc1, 0x29, 0x8e, 0xb9, 0xae, 0x1d, 0x68,
-	0xfd, 0xce, 0x70, 0xcf, 0x9a, 0xe7, 0x5b, 0x6f, 0xe4, 0xcc, 0x69, 0x5c, 0xdd, 0xf4, 0x6a, 0x6e,
-	0xa1, 0x44, 0x27, 0xd0, 0xf1, 0x23, 0xcc, 0xf9, 0x39, 0xcf, 0x11, 0xfa, 0x86, 0x34, 0xf6, 0xaa,
-	0xc6, 0xd3, 0x5c, 0x30, 0xbf, 0xc9, 0x05, 0xe9, 0x51, 0x5b, 0x4f, 0x60, 0xcb, 0xc3, 0x11, 0xa6,
-	0x7e, 0xc8, 0xf5, 0xfa, 0x41, 0xbd, 0xdf, 0x19, 0x1a, 0x0b, 0x76, 0x46, 0x45, 0x8a, 0x7d, 0xe1,
-	0x14, 0xaa, 0xe2, 0x1f, 0xdc, 0xba, 0xd0, 0x11, 0xb4, 0x24, 0x2f, 0xe4, 0x7a, 0x43, 0x02, 0xf6,
-	0xff, 0x01, 0x50, 0xc6, 0x52, 0x8c, 0x8e, 0xa1, 0x39, 0x4e, 0x31, 0x15, 0x5c, 0xdf, 0x94, 0xb6,
-	0x47, 0xcb, 0x6d, 0x67, 0x52, 0x53, 0xe6, 0x56, 0x0e, 0xe4, 0xc2, 0x0e, 0xce, 0xc4, 0x84, 0xa5,
-	0xe4, 0x33, 0x16, 0x84, 0x51, 0xae, 0x37, 0x25, 0xe3, 0xf1, 0x72, 0xc6, 0xcb, 0x8a, 0xb6, 0x60,
-	0x2d, 0x10, 0xd0, 0x0b, 0xd8, 0xe2, 0x59, 0x92, 0x44, 0x24, 0xe4, 0x7a, 0x4b, 0xd2, 0xba, 0xcb,
-	0x69, 0xa7, 0x8c, 0xd0, 0xf2, 0x2e, 0x94, 0x0e, 0x74, 0x04, 0x9b, 0x31, 0xc9, 0xc3, 0x6c, 0xad,
-	0x69, 0x55, 0xf2, 0xdc, 0xe7, 0x65, 0x29, 0xe5, 0x7a, 0x7b, 0x5d, 0x9f, 0x94, 0x9b, 0x09, 0xdc,
-	0xbb, 0xf3, 0x60, 0xd1, 0x08, 0x36, 0x29, 0xa3, 0x7e, 0x28, 0x1b, 0xd4, 0x76, 0x0e, 0x73, 0xc3,
-	0xaf, 0x9b, 0xde, 0xd3, 0x31, 0x11, 0x93, 0xcc, 0xb3, 0x7c, 0x16, 0xdb, 0xaf, 0x08, 0xe5, 0xfe,
-	0x84, 0x60, 0xfb, 0x43, 0x71, 0x78, 0xc6, 0x83, 0x0b, 0x5b, 0x7c, 0x4a, 0x42, 0x6e, 0xbd, 0x23,
-	0x54, 0xb8, 0x8a, 0x80, 0x76, 0xa1, 0x4e, 0x02, 0xae, 0x6f, 0x1c, 0xd4, 0xfb, 0x6d, 0x37, 0x3f,
-	0x9a, 0x11, 0xec, 0x2e, 0x76, 0x01, 0xf5, 0xa0, 0xe3, 0x17, 0xd7, 0xce, 0x49, 0xa0, 0xd6, 0xba,
-	0x50, 0x5e, 0x1a, 0x05, 0xe8, 0xf9, 0x5c, 0xbd, 0x36, 0x64, 0xc2, 0x07, 0xd5, 0x84, 0x05, 0x6a,
-	0xb1, 0x55, 0x66, 0x06, 0xad, 0x62, 0x84, 0x74, 0x68, 0xe1, 0x20, 0x48, 0x43, 0xce, 0x8b, 0x05,
-	0xe5, 0x4f, 0xf4, 0x1a, 0x9a, 0x38, 0x66, 0x19, 0x15, 0xb2, 0xf9, 0x6d, 0x67, 0x58, 0x04, 0x7e,
-	0xb2, 0x66, 0xe0, 0x11, 0x15, 0x6e, 0x41, 0x38, 0x6e, 0xfc, 0xf9, 0xde, 0xd3, 0xcc, 0xaf, 0x1a,
-	0xec, 0x2f, 0x6f, 0xcd, 0xea, 0xac, 0xa3, 0x3b, 0xa5, 0x54, 0x89, 0x1f, 0x56, 0x13, 0x57, 0xb0,
-	0xcb, 0xbb, 0x68, 0x06, 0xb0, 0x53, 0xed, 0xff, 0xea, 0xed, 0x83, 0xdb, 0xd7, 0x49, 0x6d, 0xbd,
-	0x5f, 0xdd, 0x2a, 0x31, 0xd5, 0xb7, 0xc8, 0xfc, 0x02, 0xdb, 0xf3, 0x05, 0x5b, 0xbd, 0xe3, 0x3f,
-	0xde, 0x6f, 0xc7, 0xb9, 0x9a, 0x1a, 0xda, 0xf5, 0xd4, 0xd0, 0x7e, 0x4f, 0x0d, 0xed, 0xdb, 0xcc,
-	0xa8, 0x5d, 0xcf, 0x8c, 0xda, 0xcf, 0x99, 0x51, 0x7b, 0xdf, 0x5f, 0x49, 0xfb, 0xa8, 0xbe, 0xa4,
-	0x5e, 0x53, 0x7e, 0x4a, 0x0f, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x03, 0xc1, 0x84, 0x15, 0xa6,
-	0x05, 0x00, 0x00,
+	// 731 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xcf, 0x4e, 0xdb, 0x4a,
+	0x14, 0xc6, 0xf3, 0x87, 0x24, 0xe4, 0x24, 0xfc, 0xb9, 0x73, 0xb9, 0x68, 0x94, 0x7b, 0x6f, 0x82,
+	0xac, 0x2e, 0xa2, 0x56, 0x75, 0x44, 0x90, 0x40, 0xa2, 0x95, 0x4a, 0x83, 0x5a, 0x48, 0xc5, 0xa2,
+	0x4d, 0xd5, 0x4d, 0x37, 0x74, 0x62, 0x0f, 0xc9, 0x08, 0x67, 0x26, 0xf2, 0x4c, 0x10, 0xd0, 0x6d,
+	0x1f, 0xa0, 0x2f, 0x50, 0xa9, 0x8f, 0xc3, 0x92, 0x65, 0xd5, 0x05, 0xaa, 0x60, 0xd3, 0xc7, 0xa8,
+	0x3c, 0x63, 0xa7, 0x71, 0x30, 0x0a, 0x8b, 0xee, 0x6c, 0xcf, 0xf7, 0xfd, 0x3e, 0x1f, 0xcf, 0x39,
+	0x63, 0xa8, 0x78, 0xdd, 0x41, 0x43, 0x89, 0x63, 0xca, 0x1b, 0x27, 0xeb, 0x8d, 0x1e, 0xe5, 0x54,
+	0x32, 0x69, 0x0f, 0x7d, 0xa1, 0x04, 0x2a, 0x7b, 0xdd, 0x81, 0xad, 0xd7, 0xec, 0x93, 0xf5, 0xca,
+	0x4a, 0x4f, 0xf4, 0x84, 0x5e, 0x68, 0x04, 0x57, 0x46, 0x53, 0xc1, 0x31, 0xbf, 0x11, 0xeb, 0x15,
+	0xeb, 0x4b, 0x1e, 0xca, 0x7b, 0x86, 0xf7, 0x56, 0x11, 0x45, 0x51, 0x13, 0xf2, 0x43, 0xe2, 0x93,
+	0x81, 0xc4, 0xe9, 0xb5, 0x74, 0xbd, 0xd4, 0x5c, 0xb1, 0x27, 0xf9, 0xf6, 0x6b, 0xbd, 0xd6, 0x9a,
+	0xbb, 0xb8, 0xaa, 0xa5, 0x3a, 0xa1, 0x12, 0xed, 0x40, 0xc9, 0xf1, 0x88, 0x94, 0x87, 0x32, 0x40,
+	0xe0, 0x8c, 0x36, 0xd6, 0xe2, 0xc6, 0xdd, 0x40, 0x30, 0x99, 0xd4, 0x01, 0xed, 0x31, 0xa9, 0x3b,
+	0x30, 0xdf, 0x25, 0x1e, 0xe1, 0x0e, 0x95, 0x38, 0xbb, 0x96, 0xad, 0x97, 0x9a, 0xd5, 0x29, 0xbb,
+	0xe0, 0xca, 0x27, 0x8e, 0x6a, 0x85, 0xaa, 0xf0, 0x0d, 0xc6, 0x2e, 0xb4, 0x09, 0x05, 0xcd, 0xa3,
+	0x12, 0xcf, 0x69, 0xc0, 0xea, 0x1d, 0x00, 0x63, 0x8c, 0xc4, 0x68, 0x1b, 0xf2, 0x3d, 0x9f, 0x70,
+	0x25, 0x71, 0x4e, 0xdb, 0xfe, 0x4b, 0xb6, 0xed, 0x69, 0x4d, 0x54, 0xb7, 0x71, 0xa0, 0x0e, 0x2c,
+	0x92, 0x91, 0xea, 0x0b, 0x9f, 0x9d, 0x13, 0xc5, 0x04, 0x97, 0x38, 0xaf, 0x19, 0x0f, 0x92, 0x19,
+	0xcf, 0x63, 0xda, 0x90, 0x35, 0x45, 0x40, 0x4f, 0x61, 0x5e, 0x8e, 0x86, 0x43, 0x8f, 0x51, 0x89,
+	0x0b, 0x9a, 0x56, 0x49, 0xa6, 0xed, 0x0a, 0xc6, 0xa3, 0xaf, 0x10, 0x39, 0xd0, 0x26, 0xe4, 0x06,
+	0x2c, 0x28, 0x66, 0xfe, 0x9e, 0x56, 0x23, 0x0f, 0x7c, 0xdd, 0x91, 0xcf, 0x25, 0x2e, 0xde, 0xd7,
+	0xa7, 0xe5, 0x68, 0x0b, 0x72, 0x9e, 0x70, 0x8e, 0x25, 0x06, 0xed, 0xfb, 0x37, 0xd9, 0x77, 0x10,
+	0x48, 0x22, 0xa3, 0xd6, 0xa3, 0xd5, 0xa0, 0xcd, 0x46, 0x92, 0xba, 0xb8, 0xb4, 0x96, 0xad, 0x17,
+	0x3b, 0xe1, 0x1d, 0x7a, 0x02, 0xf9, 0x23, 0x5f, 0x9c, 0x53, 0x8e, 0xcb, 0x9a, 0xf8, 0x7f, 0x32,
+	0x71, 0x5f, 0x78, 0x2e, 0xf5, 0xc7, 0xfb, 0x61, 0x2c, 0xe8, 0x0d, 0x2c, 0x11, 0x77, 0xc0, 0xf8,
+	0x61, 0x9f, 0x49, 0x25, 0xfc, 0xe0, 0x13, 0x2e, 0x68, 0x8a, 0x75, 0xc7, 0x86, 0x04, 0xe2, 0x7d,
+	0xad, 0x3d, 0x1b, 0x6f, 0xc7, 0xef, 0x67, 0x8c, 0x4a, 0x6b, 0x08, 0x7f, 0xdd, 0xea, 0x5c, 0xd4,
+	0x86, 0x1c, 0x17, 0xdc, 0xa1, 0x7a, 0x44, 0x8a, 0xad, 0x8d, 0xc0, 0xf9, 0xfd, 0xaa, 0xf6, 0xa8,
+	0xc7, 0x54, 0x7f, 0xd4, 0xb5, 0x1d, 0x31, 0x68, 0xbc, 0x64, 0x5c, 0x3a, 0x7d, 0x46, 0x1a, 0x47,
+	0xe1, 0xc5, 0x63, 0xe9, 0x1e, 0x37, 0xd4, 0xd9, 0x90, 0x4a, 0xfb, 0x1d, 0xe3, 0xaa, 0x63, 0x08,
+	0x68, 0x19, 0xb2, 0xcc, 0x95, 0x38, 0xa3, 0x3f, 0x42, 0x70, 0x69, 0x79, 0xb0, 0x3c, 0xdd, 0xec,
+	0xa8, 0x06, 0x25, 0x27, 0x7c, 0x76, 0xc8, 0x5c, 0x13, 0xdb, 0x81, 0xe8, 0x51, 0xdb, 0x45, 0x5b,
+	0x13, 0xf3, 0x93, 0xd1, 0x25, 0xff, 0x13, 0x2f, 0x39, 0x44, 0x4d, 0x8f, 0x8d, 0x35, 0x82, 0x42,
+	0xb8, 0x84, 0x30, 0x14, 0x88, 0xeb, 0xfa, 0x54, 0xca, 0x30, 0x20, 0xba, 0x45, 0xaf, 0x20, 0x4f,
+	0x06, 0x62, 0xc4, 0x95, 0x1e, 0xed, 0x62, 0xab, 0x19, 0x16, 0xfc, 0xf0, 0x9e, 0x05, 0xb7, 0xb9,
+	0xea, 0x84, 0x84, 0xed, 0xb9, 0x9f, 0x5f, 0x6b, 0x69, 0xeb, 0x53, 0x1a, 0x56, 0x93, 0xc7, 0x62,
+	0x76, 0xad, 0xed, 0x5b, 0x53, 0x97, 0x49, 0x6a, 0xbe, 0x18, 0x36, 0x79, 0xd8, 0xac, 0x0f, 0xb0,
+	0x10, 0xeb, 0xd1, 0xd9, 0xe1, 0x76, 0xd4, 0xf0, 0x26, 0x13, 0xc5, 0x33, 0x03, 0x48, 0xac, 0xcf,
+	0xad, 0x03, 0x58, 0x9a, 0xea, 0xd9, 0xd9, 0x19, 0x18, 0x0a, 0x7d, 0xa3, 0x0d, 0xfb, 0x22, 0xba,
+	0xb5, 0x4e, 0x61, 0x25, 0xa9, 0x77, 0x67, 0x23, 0x9f, 0x41, 0x81, 0x72, 0xa5, 0x27, 0xc2, 0xbc,
+	0xf8, 0xd4, 0xe9, 0x3c, 0x49, 0x7b, 0xc1, 0xd5, 0x78, 0x1c, 0x22, 0x97, 0xe5, 0xc2, 0x62, 0xfc,
+	0x28, 0x9c, 0x9d, 0xb9, 0x3e, 0x3e, 0x59, 0x4d, 0xe4, 0xdf, 0xf1, 0x48, 0x8d, 0x89, 0x1f, 0xa8,
+	0xd6, 0x47, 0x28, 0x4f, 0x9e, 0x35, 0xb3, 0x33, 0xfe, 0x60, 0x67, 0xb6, 0x5a, 0x17, 0xd7, 0xd5,
+	0xf4, 0xe5, 0x75, 0x35, 0xfd, 0xe3, 0xba, 0x9a, 0xfe, 0x7c, 0x53, 0x4d, 0x5d, 0xde, 0x54, 0x53,
+	0xdf, 0x6e, 0xaa, 0xa9, 0xf7, 0xf5, 0x99, 0xb4, 0x53, 0xf3, 0x53, 0xed, 0xe6, 0xf5, 0x5f, 0x75,
+	0xe3, 0xd7, 0x00, 0x92, 0x56, 0xa4, 0xa8, 0xb1, 0x07, 0x00, 0x00,
 }
 
 func (this *Balance) Equal(that interface{}) bool {
@@ -551,6 +764,57 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AdminHistories) > 0 {
+		for iNdEx := len(m.AdminHistories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdminHistories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Frozen) > 0 {
+		for iNdEx := len(m.Frozen) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Frozen[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Paused) > 0 {
+		for iNdEx := len(m.Paused) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Paused[iNdEx])
+			copy(dAtA[i:], m.Paused[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Paused[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Locks) > 0 {
+		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.Burns) > 0 {
 		for iNdEx := len(m.Burns) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -844,7 +1108,7 @@ func (m *ContractAuthorizations) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *ContractGrants) Marshal() (dAtA []byte, err error) {
+func (m *ContractLocks) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -854,20 +1118,20 @@ func (m *ContractGrants) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContractGrants) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContractLocks) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContractGrants) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContractLocks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Grants) > 0 {
-		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Locks) > 0 {
+		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -888,7 +1152,7 @@ func (m *ContractGrants) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ContractCoin) Marshal() (dAtA []byte, err error) {
+func (m *ContractHolders) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -898,26 +1162,25 @@ func (m *ContractCoin) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContractCoin) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContractHolders) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContractCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContractHolders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.Holders) > 0 {
+		for iNdEx := len(m.Holders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Holders[iNdEx])
+			copy(dAtA[i:], m.Holders[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Holders[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
 	if len(m.ContractId) > 0 {
 		i -= len(m.ContractId)
 		copy(dAtA[i:], m.ContractId)
@@ -928,55 +1191,183 @@ func (m *ContractCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ContractAdminHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ContractAdminHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractAdminHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if m.ClassState != nil {
-		l = m.ClassState.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if len(m.Balances) > 0 {
-		for _, e := range m.Balances {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.Classes) > 0 {
-		for _, e := range m.Classes {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Grants) > 0 {
-		for _, e := range m.Grants {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.Authorizations) > 0 {
-		for _, e := range m.Authorizations {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractGrants) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Supplies) > 0 {
-		for _, e := range m.Supplies {
+	return dAtA[:n], nil
+}
+
+func (m *ContractGrants) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractGrants) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCoin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCoin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.ClassState != nil {
+		l = m.ClassState.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Classes) > 0 {
+		for _, e := range m.Classes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Authorizations) > 0 {
+		for _, e := range m.Authorizations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Supplies) > 0 {
+		for _, e := range m.Supplies {
 			l = e.Size()
 			n += 1 + l + sovGenesis(uint64(l))
 		}
@@ -993,6 +1384,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Paused) > 0 {
+		for _, s := range m.Paused {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Frozen) > 0 {
+		for _, e := range m.Frozen {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AdminHistories) > 0 {
+		for _, e := range m.AdminHistories {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1066,6 +1481,63 @@ func (m *ContractAuthorizations) Size() (n int) {
 	return n
 }
 
+func (m *ContractLocks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractHolders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Holders) > 0 {
+		for _, s := range m.Holders {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractAdminHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ContractGrants) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1237,12 +1709,400 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Classes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Classes = append(m.Classes, Contract{})
+			if err := m.Classes[len(m.Classes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, ContractGrants{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorizations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authorizations = append(m.Authorizations, ContractAuthorizations{})
+			if err := m.Authorizations[len(m.Authorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supplies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Supplies = append(m.Supplies, ContractCoin{})
+			if err := m.Supplies[len(m.Supplies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mints = append(m.Mints, ContractCoin{})
+			if err := m.Mints[len(m.Mints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burns = append(m.Burns, ContractCoin{})
+			if err := m.Burns[len(m.Burns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locks = append(m.Locks, ContractLocks{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Paused = append(m.Paused, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Frozen = append(m.Frozen, ContractHolders{})
+			if err := m.Frozen[len(m.Frozen)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminHistories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminHistories = append(m.AdminHistories, ContractAdminHistory{})
+			if err := m.AdminHistories[len(m.AdminHistories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClassGenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassGenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassGenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Classes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1252,31 +2112,31 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Classes = append(m.Classes, Contract{})
-			if err := m.Classes[len(m.Classes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Nonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1286,31 +2146,79 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grants = append(m.Grants, ContractGrants{})
-			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 6:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractBalances) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractBalances: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractBalances: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authorizations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1320,29 +2228,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authorizations = append(m.Authorizations, ContractAuthorizations{})
-			if err := m.Authorizations[len(m.Authorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supplies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1369,16 +2275,66 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Supplies = append(m.Supplies, ContractCoin{})
-			if err := m.Supplies[len(m.Supplies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Balances = append(m.Balances, Balance{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Balance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Balance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Balance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mints", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1388,31 +2344,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mints = append(m.Mints, ContractCoin{})
-			if err := m.Mints[len(m.Mints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Burns", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1422,23 +2376,23 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Burns = append(m.Burns, ContractCoin{})
-			if err := m.Burns[len(m.Burns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1463,7 +2417,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClassGenesisState) Unmarshal(dAtA []byte) error {
+func (m *ContractAuthorizations) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1486,15 +2440,15 @@ func (m *ClassGenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClassGenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractAuthorizations: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClassGenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractAuthorizations: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1522,15 +2476,13 @@ func (m *ClassGenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Nonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorizations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1540,23 +2492,25 @@ func (m *ClassGenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
+			m.Authorizations = append(m.Authorizations, Authorization{})
+			if err := m.Authorizations[len(m.Authorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1579,7 +2533,7 @@ func (m *ClassGenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractBalances) Unmarshal(dAtA []byte) error {
+func (m *ContractLocks) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1602,10 +2556,10 @@ func (m *ContractBalances) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractBalances: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractLocks: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractBalances: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractLocks: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1642,7 +2596,7 @@ func (m *ContractBalances) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1669,8 +2623,8 @@ func (m *ContractBalances) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balances = append(m.Balances, Balance{})
-			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Locks = append(m.Locks, Lock{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1695,7 +2649,7 @@ func (m *ContractBalances) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Balance) Unmarshal(dAtA []byte) error {
+func (m *ContractHolders) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1718,15 +2672,15 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Balance: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractHolders: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Balance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractHolders: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1754,11 +2708,11 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1786,9 +2740,7 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Holders = append(m.Holders, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1811,7 +2763,7 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractAuthorizations) Unmarshal(dAtA []byte) error {
+func (m *ContractAdminHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1834,10 +2786,10 @@ func (m *ContractAuthorizations) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractAuthorizations: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractAdminHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractAuthorizations: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractAdminHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1874,7 +2826,7 @@ func (m *ContractAuthorizations) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authorizations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1901,8 +2853,8 @@ func (m *ContractAuthorizations) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authorizations = append(m.Authorizations, Authorization{})
-			if err := m.Authorizations[len(m.Authorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, AdminHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
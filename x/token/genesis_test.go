@@ -3,6 +3,7 @@ package token_test
 import (
 	"math"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -13,6 +14,13 @@ import (
 
 func TestValidateGenesis(t *testing.T) {
 	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	schedule := token.VestingSchedule{
+		StartTime: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	allowance := sdk.OneInt()
+	zero := sdk.ZeroInt()
+	params := token.DefaultGenesisState().Params
 	testCases := map[string]struct {
 		gs    *token.GenesisState
 		valid bool
@@ -21,6 +29,12 @@ func TestValidateGenesis(t *testing.T) {
 			token.DefaultGenesisState(),
 			true,
 		},
+		"zero max batch size": {
+			&token.GenesisState{
+				Params: token.Params{},
+			},
+			false,
+		},
 		"invalid class nonce": {
 			&token.GenesisState{
 				ClassState: &token.ClassGenesisState{
@@ -229,6 +243,215 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			false,
 		},
+		"valid authorization with limits": {
+			&token.GenesisState{
+				Params: params,
+				Authorizations: []token.ContractAuthorizations{{
+					ContractId: "deadbeef",
+					Authorizations: []token.Authorization{{
+						Holder:     addr.String(),
+						Operator:   addr.String(),
+						Allowance:  &allowance,
+						Expiration: &schedule.EndTime,
+					}},
+				}},
+			},
+			true,
+		},
+		"invalid allowance of authorization": {
+			&token.GenesisState{
+				Authorizations: []token.ContractAuthorizations{{
+					ContractId: "deadbeef",
+					Authorizations: []token.Authorization{{
+						Holder:    addr.String(),
+						Operator:  addr.String(),
+						Allowance: &zero,
+					}},
+				}},
+			},
+			false,
+		},
+		"valid locks": {
+			&token.GenesisState{
+				Params: params,
+				Locks: []token.ContractLocks{{
+					ContractId: "deadbeef",
+					Locks: []token.Lock{{
+						Address:  addr.String(),
+						Amount:   sdk.OneInt(),
+						Schedule: schedule,
+					}},
+				}},
+			},
+			true,
+		},
+		"locks of invalid contract id": {
+			&token.GenesisState{
+				Locks: []token.ContractLocks{{
+					Locks: []token.Lock{{
+						Address:  addr.String(),
+						Amount:   sdk.OneInt(),
+						Schedule: schedule,
+					}},
+				}},
+			},
+			false,
+		},
+		"empty locks": {
+			&token.GenesisState{
+				Locks: []token.ContractLocks{{
+					ContractId: "deadbeef",
+				}},
+			},
+			false,
+		},
+		"invalid address of lock": {
+			&token.GenesisState{
+				Locks: []token.ContractLocks{{
+					ContractId: "deadbeef",
+					Locks: []token.Lock{{
+						Amount:   sdk.OneInt(),
+						Schedule: schedule,
+					}},
+				}},
+			},
+			false,
+		},
+		"invalid amount of lock": {
+			&token.GenesisState{
+				Locks: []token.ContractLocks{{
+					ContractId: "deadbeef",
+					Locks: []token.Lock{{
+						Address:  addr.String(),
+						Amount:   sdk.ZeroInt(),
+						Schedule: schedule,
+					}},
+				}},
+			},
+			false,
+		},
+		"invalid schedule of lock": {
+			&token.GenesisState{
+				Locks: []token.ContractLocks{{
+					ContractId: "deadbeef",
+					Locks: []token.Lock{{
+						Address: addr.String(),
+						Amount:  sdk.OneInt(),
+						Schedule: token.VestingSchedule{
+							StartTime: schedule.EndTime,
+							EndTime:   schedule.StartTime,
+						},
+					}},
+				}},
+			},
+			false,
+		},
+		"valid paused and frozen": {
+			&token.GenesisState{
+				Params: params,
+				Paused: []string{"deadbeef"},
+				Frozen: []token.ContractHolders{{
+					ContractId: "deadbeef",
+					Holders:    []string{addr.String()},
+				}},
+			},
+			true,
+		},
+		"paused of invalid contract id": {
+			&token.GenesisState{
+				Paused: []string{""},
+			},
+			false,
+		},
+		"frozen of invalid contract id": {
+			&token.GenesisState{
+				Frozen: []token.ContractHolders{{
+					Holders: []string{addr.String()},
+				}},
+			},
+			false,
+		},
+		"empty frozen holders": {
+			&token.GenesisState{
+				Frozen: []token.ContractHolders{{
+					ContractId: "deadbeef",
+				}},
+			},
+			false,
+		},
+		"invalid frozen holder": {
+			&token.GenesisState{
+				Frozen: []token.ContractHolders{{
+					ContractId: "deadbeef",
+					Holders:    []string{""},
+				}},
+			},
+			false,
+		},
+		"invalid admin of class": {
+			&token.GenesisState{
+				Classes: []token.Contract{{
+					Id:     "deadbeef",
+					Name:   "test",
+					Symbol: "TT",
+					Admin:  "invalid",
+				}},
+			},
+			false,
+		},
+		"invalid pending admin of class": {
+			&token.GenesisState{
+				Classes: []token.Contract{{
+					Id:           "deadbeef",
+					Name:         "test",
+					Symbol:       "TT",
+					Admin:        addr.String(),
+					PendingAdmin: "invalid",
+				}},
+			},
+			false,
+		},
+		"valid admin histories": {
+			&token.GenesisState{
+				Params: params,
+				AdminHistories: []token.ContractAdminHistory{{
+					ContractId: "deadbeef",
+					Entries: []token.AdminHistoryEntry{{
+						Admin: addr.String(),
+					}},
+				}},
+			},
+			true,
+		},
+		"admin history of invalid contract id": {
+			&token.GenesisState{
+				AdminHistories: []token.ContractAdminHistory{{
+					Entries: []token.AdminHistoryEntry{{
+						Admin: addr.String(),
+					}},
+				}},
+			},
+			false,
+		},
+		"empty admin history": {
+			&token.GenesisState{
+				AdminHistories: []token.ContractAdminHistory{{
+					ContractId: "deadbeef",
+				}},
+			},
+			false,
+		},
+		"invalid admin of admin history": {
+			&token.GenesisState{
+				AdminHistories: []token.ContractAdminHistory{{
+					ContractId: "deadbeef",
+					Entries: []token.AdminHistoryEntry{{
+						PreviousAdmin: addr.String(),
+					}},
+				}},
+			},
+			false,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	internaladmin "github.com/Finschia/finschia-sdk/internal/admin"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+// IsAdmin returns whether the address is the admin of the contract.
+func (k Keeper) IsAdmin(ctx sdk.Context, contractID string, addr sdk.AccAddress) bool {
+	class, err := k.GetClass(ctx, contractID)
+	if err != nil {
+		return false
+	}
+
+	return class.Admin == addr.String()
+}
+
+// ProposeAdmin proposes the next admin of the contract, which takes the role on accepting it.
+func (k Keeper) ProposeAdmin(ctx sdk.Context, contractID string, admin, proposed sdk.AccAddress) error {
+	class, err := k.GetClass(ctx, contractID)
+	if err != nil {
+		return err
+	}
+
+	if err := internaladmin.CheckAdmin(contractID, class.Admin, admin); err != nil {
+		return token.ErrTokenNoPermission.Wrap(err.Error())
+	}
+
+	if err := internaladmin.CheckProposed(class.Admin, proposed); err != nil {
+		return err
+	}
+
+	class.PendingAdmin = proposed.String()
+	k.setClass(ctx, *class)
+
+	event := token.EventProposedAdmin{
+		ContractId:    contractID,
+		Admin:         admin.String(),
+		ProposedAdmin: proposed.String(),
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+	return nil
+}
+
+// AcceptAdmin makes the proposed account the admin of the contract.
+// The grants of the previous admin are moved to the new admin.
+func (k Keeper) AcceptAdmin(ctx sdk.Context, contractID string, admin sdk.AccAddress) error {
+	class, err := k.GetClass(ctx, contractID)
+	if err != nil {
+		return err
+	}
+
+	if err := internaladmin.CheckPendingAdmin(contractID, class.PendingAdmin, admin); err != nil {
+		return token.ErrTokenNoPermission.Wrap(err.Error())
+	}
+
+	previous := class.Admin
+	k.setAdmin(ctx, *class, admin)
+
+	if len(previous) != 0 {
+		previousAddr := sdk.MustAccAddressFromBech32(previous)
+
+		var permissions []token.Permission
+		k.iterateGrantsImpl(ctx, grantKeyPrefixByGrantee(contractID, previousAddr), func(_ string, grant token.Grant) (stop bool) {
+			permissions = append(permissions, grant.Permission)
+			return false
+		})
+
+		for _, permission := range permissions {
+			k.Abandon(ctx, contractID, previousAddr, permission)
+			k.Grant(ctx, contractID, previousAddr, admin, permission)
+		}
+	}
+
+	event := token.EventAcceptedAdmin{
+		ContractId:    contractID,
+		PreviousAdmin: previous,
+		Admin:         admin.String(),
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+	return nil
+}
+
+// RevokeGrant revokes the permission of the grantee by the admin of the contract.
+func (k Keeper) RevokeGrant(ctx sdk.Context, contractID string, admin, grantee sdk.AccAddress, permission token.Permission) error {
+	if !k.IsAdmin(ctx, contractID, admin) {
+		return token.ErrTokenNoPermission.Wrapf("%s is not the admin of %s", admin, contractID)
+	}
+
+	if _, err := k.GetGrant(ctx, contractID, grantee, permission); err != nil {
+		return err
+	}
+	k.deleteGrant(ctx, contractID, grantee, permission)
+
+	event := token.EventRevokedPermission{
+		ContractId: contractID,
+		Operator:   admin.String(),
+		Grantee:    grantee.String(),
+		Permission: permission,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+	return nil
+}
+
+// setAdmin sets the admin of the contract, clearing the pending one, and records
+// the change into the admin history.
+func (k Keeper) setAdmin(ctx sdk.Context, class token.Contract, admin sdk.AccAddress) {
+	entry := token.AdminHistoryEntry{
+		PreviousAdmin: class.Admin,
+		Admin:         admin.String(),
+		Height:        ctx.BlockHeight(),
+		Time:          ctx.BlockTime(),
+	}
+	k.addAdminHistoryEntry(ctx, class.Id, entry)
+
+	class.Admin = admin.String()
+	class.PendingAdmin = ""
+	k.setClass(ctx, class)
+}
+
+// GetAdminHistory returns the admin history of the contract, in chronological order.
+func (k Keeper) GetAdminHistory(ctx sdk.Context, contractID string) []token.AdminHistoryEntry {
+	var history []token.AdminHistoryEntry
+	k.iterateContractAdminHistory(ctx, contractID, func(entry token.AdminHistoryEntry) (stop bool) {
+		history = append(history, entry)
+		return false
+	})
+
+	return history
+}
+
+func (k Keeper) addAdminHistoryEntry(ctx sdk.Context, contractID string, entry token.AdminHistoryEntry) {
+	store := ctx.KVStore(k.storeKey)
+
+	sequence := internaladmin.NextHistorySequence(store, adminHistoryKeyPrefixByContractID(contractID))
+	k.setAdminHistoryEntry(ctx, contractID, sequence, entry)
+}
+
+func (k Keeper) setAdminHistoryEntry(ctx sdk.Context, contractID string, sequence uint64, entry token.AdminHistoryEntry) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz, err := entry.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(adminHistoryKey(contractID, sequence), bz)
+}
@@ -37,6 +37,39 @@ func (k Keeper) iterateBalancesImpl(ctx sdk.Context, prefix []byte, fn func(cont
 	}
 }
 
+// iterate through the locks of a contract and perform the provided function
+func (k Keeper) iterateContractLocks(ctx sdk.Context, contractID string, fn func(lock token.Lock) (stop bool)) {
+	k.iterateLocksImpl(ctx, lockKeyPrefixByContractID(contractID), func(_ string, lock token.Lock) (stop bool) {
+		return fn(lock)
+	})
+}
+
+func (k Keeper) iterateLocksImpl(ctx sdk.Context, prefix []byte, fn func(contractID string, lock token.Lock) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		contractID, addr, schedule := splitLockKey(iterator.Key())
+
+		var amount sdk.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		lock := token.Lock{
+			Address:  addr.String(),
+			Amount:   amount,
+			Schedule: schedule,
+		}
+
+		stop := fn(contractID, lock)
+		if stop {
+			break
+		}
+	}
+}
+
 // iterate through the classes and perform the provided function
 func (k Keeper) iterateClasses(ctx sdk.Context, fn func(class token.Contract) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
@@ -95,10 +128,7 @@ func (k Keeper) iterateAuthorizationsImpl(ctx sdk.Context, prefix []byte, fn fun
 
 	for ; iterator.Valid(); iterator.Next() {
 		contractID, operator, holder := splitAuthorizationKey(iterator.Key())
-		authorization := token.Authorization{
-			Holder:   holder.String(),
-			Operator: operator.String(),
-		}
+		authorization := k.unmarshalAuthorization(iterator.Value(), holder, operator)
 
 		stop := fn(contractID, authorization)
 		if stop {
@@ -139,3 +169,55 @@ func (k Keeper) iterateMinteds(ctx sdk.Context, fn func(contractID string, amoun
 func (k Keeper) iterateBurnts(ctx sdk.Context, fn func(contractID string, amount sdk.Int) (stop bool)) {
 	k.iterateStatistics(ctx, burnKeyPrefix, fn)
 }
+
+// iterate through the paused contracts and perform the provided function
+func (k Keeper) iteratePaused(ctx sdk.Context, fn func(contractID string) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, pausedKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		contractID := splitPausedKey(iterator.Key())
+
+		stop := fn(contractID)
+		if stop {
+			break
+		}
+	}
+}
+
+// iterate through the frozen holders of a contract and perform the provided function
+func (k Keeper) iterateContractFrozen(ctx sdk.Context, contractID string, fn func(holder sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, frozenKeyPrefixByContractID(contractID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		_, holder := splitFrozenKey(iterator.Key())
+
+		stop := fn(holder)
+		if stop {
+			break
+		}
+	}
+}
+
+// iterate through the admin history of a contract and perform the provided function
+func (k Keeper) iterateContractAdminHistory(ctx sdk.Context, contractID string, fn func(entry token.AdminHistoryEntry) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, adminHistoryKeyPrefixByContractID(contractID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var entry token.AdminHistoryEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+
+		stop := fn(entry)
+		if stop {
+			break
+		}
+	}
+}
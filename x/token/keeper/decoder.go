@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/Finschia/finschia-sdk/codec"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/kv"
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding token type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], balanceKeyPrefix),
+			bytes.Equal(kvA.Key[:1], supplyKeyPrefix),
+			bytes.Equal(kvA.Key[:1], mintKeyPrefix),
+			bytes.Equal(kvA.Key[:1], burnKeyPrefix),
+			bytes.Equal(kvA.Key[:1], lockKeyPrefix):
+			var amountA, amountB sdk.Int
+			if err := amountA.Unmarshal(kvA.Value); err != nil {
+				panic(err)
+			}
+			if err := amountB.Unmarshal(kvB.Value); err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%v\n%v", amountA, amountB)
+
+		case bytes.Equal(kvA.Key[:1], classKeyPrefix):
+			var classA, classB token.Contract
+			cdc.MustUnmarshal(kvA.Value, &classA)
+			cdc.MustUnmarshal(kvB.Value, &classB)
+			return fmt.Sprintf("%v\n%v", classA, classB)
+
+		case bytes.Equal(kvA.Key[:1], authorizationKeyPrefix):
+			var authorizationA, authorizationB token.Authorization
+			cdc.MustUnmarshal(kvA.Value, &authorizationA)
+			cdc.MustUnmarshal(kvB.Value, &authorizationB)
+			return fmt.Sprintf("%v\n%v", authorizationA, authorizationB)
+
+		case bytes.Equal(kvA.Key[:1], paramsKey):
+			var paramsA, paramsB token.Params
+			cdc.MustUnmarshal(kvA.Value, &paramsA)
+			cdc.MustUnmarshal(kvB.Value, &paramsB)
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+
+		case bytes.Equal(kvA.Key[:1], adminHistoryKeyPrefix):
+			var entryA, entryB token.AdminHistoryEntry
+			cdc.MustUnmarshal(kvA.Value, &entryA)
+			cdc.MustUnmarshal(kvB.Value, &entryB)
+			return fmt.Sprintf("%v\n%v", entryA, entryB)
+
+		case bytes.Equal(kvA.Key[:1], grantKeyPrefix),
+			bytes.Equal(kvA.Key[:1], pausedKeyPrefix),
+			bytes.Equal(kvA.Key[:1], frozenKeyPrefix),
+			bytes.Equal(kvA.Key[:1], holderContractKeyPrefix):
+			// the values are empty, the keys carry the data
+			return fmt.Sprintf("%X\n%X", kvA.Key, kvB.Key)
+
+		default:
+			panic(fmt.Sprintf("invalid token key %X", kvA.Key))
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/Finschia/finschia-sdk/simapp"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/kv"
+	"github.com/Finschia/finschia-sdk/x/token"
+	"github.com/Finschia/finschia-sdk/x/token/keeper"
+)
+
+func TestDecodeStore(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	dec := keeper.NewDecodeStore(app.AppCodec())
+
+	owner := sdk.AccAddress("owner")
+	holder := sdk.AccAddress("holder")
+	class := token.Contract{
+		Name:     "Test",
+		Symbol:   "TT",
+		Mintable: true,
+	}
+	contractID := app.TokenKeeper.Issue(ctx, class, owner, owner, sdk.NewInt(100))
+	require.NoError(t, app.TokenKeeper.Send(ctx, contractID, owner, holder, sdk.OneInt()))
+	require.NoError(t, app.TokenKeeper.AuthorizeOperator(ctx, contractID, holder, owner, nil, nil))
+	require.NoError(t, app.TokenKeeper.Pause(ctx, contractID, owner))
+
+	// every entry in the store must be decodable
+	store := ctx.KVStore(app.GetKey(token.StoreKey))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		pair := kv.Pair{Key: iterator.Key(), Value: iterator.Value()}
+		require.NotPanics(t, func() { dec(pair, pair) }, "%X", pair.Key)
+	}
+
+	invalid := kv.Pair{Key: []byte{0x99}, Value: []byte{0x99}}
+	require.Panics(t, func() { dec(invalid, invalid) })
+}
@@ -7,6 +7,8 @@ import (
 
 // InitGenesis new token genesis
 func (k Keeper) InitGenesis(ctx sdk.Context, data *token.GenesisState) {
+	k.SetParams(ctx, data.Params)
+
 	if data.ClassState == nil {
 		data.ClassState = token.DefaultClassGenesisState()
 	}
@@ -46,7 +48,37 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *token.GenesisState) {
 			if err != nil {
 				panic(err)
 			}
-			k.setAuthorization(ctx, contractAuthorizations.ContractId, holder, operator)
+			k.setAuthorization(ctx, contractAuthorizations.ContractId, holder, operator, authorization.Allowance, authorization.Expiration)
+		}
+	}
+
+	for _, contractLocks := range data.Locks {
+		for _, lock := range contractLocks.Locks {
+			addr, err := sdk.AccAddressFromBech32(lock.Address)
+			if err != nil {
+				panic(err)
+			}
+			k.setLock(ctx, contractLocks.ContractId, addr, lock.Schedule, lock.Amount)
+		}
+	}
+
+	for _, contractID := range data.Paused {
+		k.setPaused(ctx, contractID, true)
+	}
+
+	for _, contractHolders := range data.Frozen {
+		for _, holder := range contractHolders.Holders {
+			addr, err := sdk.AccAddressFromBech32(holder)
+			if err != nil {
+				panic(err)
+			}
+			k.setFrozen(ctx, contractHolders.ContractId, addr, true)
+		}
+	}
+
+	for _, contractHistory := range data.AdminHistories {
+		for i, entry := range contractHistory.Entries {
+			k.setAdminHistoryEntry(ctx, contractHistory.ContractId, uint64(i), entry)
 		}
 	}
 
@@ -150,7 +182,62 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *token.GenesisState {
 		}
 	}
 
+	var locks []token.ContractLocks
+	for _, class := range classes {
+		id := class.Id
+		contractLocks := token.ContractLocks{
+			ContractId: id,
+		}
+
+		k.iterateContractLocks(ctx, id, func(lock token.Lock) (stop bool) {
+			contractLocks.Locks = append(contractLocks.Locks, lock)
+			return false
+		})
+		if len(contractLocks.Locks) != 0 {
+			locks = append(locks, contractLocks)
+		}
+	}
+
+	var paused []string
+	k.iteratePaused(ctx, func(contractID string) (stop bool) {
+		paused = append(paused, contractID)
+		return false
+	})
+
+	var frozen []token.ContractHolders
+	for _, class := range classes {
+		id := class.Id
+		contractHolders := token.ContractHolders{
+			ContractId: id,
+		}
+
+		k.iterateContractFrozen(ctx, id, func(holder sdk.AccAddress) (stop bool) {
+			contractHolders.Holders = append(contractHolders.Holders, holder.String())
+			return false
+		})
+		if len(contractHolders.Holders) != 0 {
+			frozen = append(frozen, contractHolders)
+		}
+	}
+
+	var adminHistories []token.ContractAdminHistory
+	for _, class := range classes {
+		id := class.Id
+		contractHistory := token.ContractAdminHistory{
+			ContractId: id,
+		}
+
+		k.iterateContractAdminHistory(ctx, id, func(entry token.AdminHistoryEntry) (stop bool) {
+			contractHistory.Entries = append(contractHistory.Entries, entry)
+			return false
+		})
+		if len(contractHistory.Entries) != 0 {
+			adminHistories = append(adminHistories, contractHistory)
+		}
+	}
+
 	return &token.GenesisState{
+		Params:         k.GetParams(ctx),
 		ClassState:     k.classKeeper.ExportGenesis(ctx),
 		Balances:       balances,
 		Classes:        classes,
@@ -159,5 +246,9 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *token.GenesisState {
 		Supplies:       supplies,
 		Mints:          mints,
 		Burns:          burns,
+		Locks:          locks,
+		Paused:         paused,
+		Frozen:         frozen,
+		AdminHistories: adminHistories,
 	}
 }
@@ -1,19 +1,48 @@
 package keeper_test
 
 import (
+	"time"
+
 	"github.com/Finschia/finschia-sdk/x/token"
 )
 
 func (s *KeeperTestSuite) TestImportExportGenesis() {
+	// lock some tokens
+	schedule := token.VestingSchedule{
+		StartTime: s.ctx.BlockTime(),
+		EndTime:   s.ctx.BlockTime().Add(time.Hour),
+	}
+	s.Require().NoError(s.keeper.Lock(s.ctx, s.contractID, s.customer, s.balance, schedule))
+
+	// authorize an operator with limits
+	allowance := s.balance
+	expiration := s.ctx.BlockTime().Add(time.Hour)
+	err := s.keeper.AuthorizeOperator(s.ctx, s.contractID, s.customer, s.vendor, &allowance, &expiration)
+	s.Require().NoError(err)
+
+	// pause the contract and freeze the customer
+	err = s.keeper.Pause(s.ctx, s.contractID, s.vendor)
+	s.Require().NoError(err)
+	err = s.keeper.Freeze(s.ctx, s.contractID, s.vendor, s.customer)
+	s.Require().NoError(err)
+
 	// export
 	genesis := s.keeper.ExportGenesis(s.ctx)
+	s.Require().Len(genesis.Locks, 1)
+	s.Require().Len(genesis.Paused, 1)
+	s.Require().Len(genesis.Frozen, 1)
+	s.Require().Len(genesis.Authorizations, 1)
+	s.Require().Len(genesis.Authorizations[0].Authorizations, 3)
 
 	// forge
-	err := s.keeper.Burn(s.ctx, s.contractID, s.vendor, s.balance)
+	err = s.keeper.Burn(s.ctx, s.contractID, s.vendor, s.balance)
 	s.Require().NoError(err)
 	err = s.keeper.Mint(s.ctx, s.contractID, s.vendor, s.customer, s.balance)
 	s.Require().NoError(err)
 	s.keeper.Abandon(s.ctx, s.contractID, s.vendor, token.PermissionMint)
+	s.Require().NoError(s.keeper.Lock(s.ctx, s.contractID, s.customer, s.balance, schedule))
+	err = s.keeper.RevokeOperator(s.ctx, s.contractID, s.customer, s.vendor)
+	s.Require().NoError(err)
 
 	// restore
 	s.keeper.InitGenesis(s.ctx, genesis)
@@ -56,6 +56,47 @@ func (s queryServer) Balance(c context.Context, req *token.QueryBalanceRequest)
 	return &token.QueryBalanceResponse{Amount: balance}, nil
 }
 
+// Spendable queries the number of tokens of a given class owned by the owner, which are not locked.
+func (s queryServer) Spendable(c context.Context, req *token.QuerySpendableRequest) (*token.QuerySpendableResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := token.ValidateContractID(req.ContractId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	addr, err := s.addressFromBech32GRPC(req.Address, "address")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	spendable := s.keeper.GetSpendable(ctx, req.ContractId, addr)
+
+	return &token.QuerySpendableResponse{Amount: spendable}, nil
+}
+
+// Locked queries the number of locked tokens of a given class owned by the owner.
+func (s queryServer) Locked(c context.Context, req *token.QueryLockedRequest) (*token.QueryLockedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := token.ValidateContractID(req.ContractId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	addr, err := s.addressFromBech32GRPC(req.Address, "address")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	locked := s.keeper.GetLocked(ctx, req.ContractId, addr)
+	locks := s.keeper.GetLocks(ctx, req.ContractId, addr)
+
+	return &token.QueryLockedResponse{Amount: locked, Locks: locks}, nil
+}
+
 // Supply queries the number of tokens from the given contract id.
 func (s queryServer) Supply(c context.Context, req *token.QuerySupplyRequest) (*token.QuerySupplyResponse, error) {
 	if req == nil {
@@ -120,7 +161,96 @@ func (s queryServer) Contract(c context.Context, req *token.QueryContractRequest
 		return nil, status.Error(codes.NotFound, err.Error())
 	}
 
-	return &token.QueryContractResponse{Contract: *class}, nil
+	history := s.keeper.GetAdminHistory(ctx, req.ContractId)
+
+	return &token.QueryContractResponse{Contract: *class, AdminHistory: history}, nil
+}
+
+// Contracts queries all the token contracts.
+func (s queryServer) Contracts(c context.Context, req *token.QueryContractsRequest) (*token.QueryContractsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	classStore := prefix.NewStore(store, classKeyPrefix)
+	var contracts []token.Contract
+	pageRes, err := query.Paginate(classStore, req.Pagination, func(_ []byte, value []byte) error {
+		var class token.Contract
+		if err := s.keeper.cdc.Unmarshal(value, &class); err != nil {
+			return err
+		}
+		contracts = append(contracts, class)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &token.QueryContractsResponse{Contracts: contracts, Pagination: pageRes}, nil
+}
+
+// Holders queries all the holders of the tokens of a given contract along with their balances.
+func (s queryServer) Holders(c context.Context, req *token.QueryHoldersRequest) (*token.QueryHoldersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := token.ValidateContractID(req.ContractId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	balanceStore := prefix.NewStore(store, balanceKeyPrefixByContractID(req.ContractId))
+	var holders []token.Balance
+	pageRes, err := query.Paginate(balanceStore, req.Pagination, func(key []byte, value []byte) error {
+		var amount sdk.Int
+		if err := amount.Unmarshal(value); err != nil {
+			return err
+		}
+		holders = append(holders, token.Balance{
+			Address: sdk.AccAddress(key).String(),
+			Amount:  amount,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &token.QueryHoldersResponse{Holders: holders, Pagination: pageRes}, nil
+}
+
+// HolderContracts queries all the contracts whose tokens are held by a given address along with the balances.
+func (s queryServer) HolderContracts(c context.Context, req *token.QueryHolderContractsRequest) (*token.QueryHolderContractsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := s.addressFromBech32GRPC(req.Address, "address")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	indexStore := prefix.NewStore(store, holderContractKeyPrefixByAddress(addr))
+	var holdings []token.Holding
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, _ []byte) error {
+		contractID := string(key)
+		holdings = append(holdings, token.Holding{
+			ContractId: contractID,
+			Amount:     s.keeper.GetBalance(ctx, contractID, addr),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &token.QueryHolderContractsResponse{Holdings: holdings, Pagination: pageRes}, nil
 }
 
 func (s queryServer) GranteeGrants(c context.Context, req *token.QueryGranteeGrantsRequest) (*token.QueryGranteeGrantsResponse, error) {
@@ -155,6 +285,42 @@ func (s queryServer) GranteeGrants(c context.Context, req *token.QueryGranteeGra
 	return &token.QueryGranteeGrantsResponse{Grants: grants, Pagination: pageRes}, nil
 }
 
+// Paused queries whether transfers of a given contract are paused.
+func (s queryServer) Paused(c context.Context, req *token.QueryPausedRequest) (*token.QueryPausedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := token.ValidateContractID(req.ContractId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	paused := s.keeper.IsPaused(ctx, req.ContractId)
+
+	return &token.QueryPausedResponse{Paused: paused}, nil
+}
+
+// Frozen queries whether the tokens of a given contract owned by the address are frozen.
+func (s queryServer) Frozen(c context.Context, req *token.QueryFrozenRequest) (*token.QueryFrozenResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := token.ValidateContractID(req.ContractId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	addr, err := s.addressFromBech32GRPC(req.Address, "address")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	frozen := s.keeper.IsFrozen(ctx, req.ContractId, addr)
+
+	return &token.QueryFrozenResponse{Frozen: frozen}, nil
+}
+
 func (s queryServer) IsOperatorFor(c context.Context, req *token.QueryIsOperatorForRequest) (*token.QueryIsOperatorForResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -173,10 +339,16 @@ func (s queryServer) IsOperatorFor(c context.Context, req *token.QueryIsOperator
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
-	_, err = s.keeper.GetAuthorization(ctx, req.ContractId, holder, operator)
-	authorized := err == nil
+	authorization, err := s.keeper.GetAuthorization(ctx, req.ContractId, holder, operator)
+	if err != nil {
+		return &token.QueryIsOperatorForResponse{Authorized: false}, nil
+	}
 
-	return &token.QueryIsOperatorForResponse{Authorized: authorized}, nil
+	return &token.QueryIsOperatorForResponse{
+		Authorized: true,
+		Allowance:  authorization.Allowance,
+		Expiration: authorization.Expiration,
+	}, nil
 }
 
 func (s queryServer) HoldersByOperator(c context.Context, req *token.QueryHoldersByOperatorRequest) (*token.QueryHoldersByOperatorResponse, error) {
@@ -196,14 +368,22 @@ func (s queryServer) HoldersByOperator(c context.Context, req *token.QueryHolder
 	store := ctx.KVStore(s.keeper.storeKey)
 	authorizationStore := prefix.NewStore(store, authorizationKeyPrefixByOperator(req.ContractId, operator))
 	var holders []string
-	pageRes, err := query.Paginate(authorizationStore, req.Pagination, func(key []byte, value []byte) error {
-		holder := sdk.AccAddress(key)
-		holders = append(holders, holder.String())
-		return nil
+	var authorizations []token.Authorization
+	pageRes, err := query.FilteredPaginate(authorizationStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		authorization := s.keeper.unmarshalAuthorization(value, sdk.AccAddress(key), operator)
+		if authorization.IsExpired(ctx.BlockTime()) {
+			return false, nil
+		}
+
+		if accumulate {
+			holders = append(holders, authorization.Holder)
+			authorizations = append(authorizations, authorization)
+		}
+		return true, nil
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &token.QueryHoldersByOperatorResponse{Holders: holders, Pagination: pageRes}, nil
+	return &token.QueryHoldersByOperatorResponse{Holders: holders, Pagination: pageRes, Authorizations: authorizations}, nil
 }
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/types/query"
 	"github.com/Finschia/finschia-sdk/x/token"
@@ -199,6 +201,9 @@ func (s *KeeperTestSuite) TestQueryContract() {
 			valid:      true,
 			postTest: func(res *token.QueryContractResponse) {
 				s.Require().Equal(s.contractID, res.Contract.Id)
+				s.Require().Equal(s.vendor.String(), res.Contract.Admin)
+				s.Require().Len(res.AdminHistory, 1)
+				s.Require().Equal(s.vendor.String(), res.AdminHistory[0].Admin)
 			},
 		},
 		"invalid contract id": {},
@@ -224,6 +229,146 @@ func (s *KeeperTestSuite) TestQueryContract() {
 	}
 }
 
+func (s *KeeperTestSuite) TestQueryContracts() {
+	// empty request
+	_, err := s.queryServer.Contracts(s.goCtx, nil)
+	s.Require().Error(err)
+
+	testCases := map[string]struct {
+		pagination *query.PageRequest
+		postTest   func(res *token.QueryContractsResponse)
+	}{
+		"valid request": {
+			postTest: func(res *token.QueryContractsResponse) {
+				s.Require().Equal(2, len(res.Contracts))
+			},
+		},
+		"valid request with limit": {
+			pagination: &query.PageRequest{
+				Limit: 1,
+			},
+			postTest: func(res *token.QueryContractsResponse) {
+				s.Require().Equal(1, len(res.Contracts))
+				s.Require().NotNil(res.Pagination.NextKey)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			req := &token.QueryContractsRequest{
+				Pagination: tc.pagination,
+			}
+			res, err := s.queryServer.Contracts(s.goCtx, req)
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryHolders() {
+	// empty request
+	_, err := s.queryServer.Holders(s.goCtx, nil)
+	s.Require().Error(err)
+
+	testCases := map[string]struct {
+		contractID string
+		valid      bool
+		postTest   func(res *token.QueryHoldersResponse)
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			valid:      true,
+			postTest: func(res *token.QueryHoldersResponse) {
+				s.Require().Equal(3, len(res.Holders))
+				for _, holder := range res.Holders {
+					s.Require().Equal(s.balance, holder.Amount)
+				}
+			},
+		},
+		"class not found": {
+			contractID: "fee1dead",
+			valid:      true,
+			postTest: func(res *token.QueryHoldersResponse) {
+				s.Require().Equal(0, len(res.Holders))
+			},
+		},
+		"invalid contract id": {},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			req := &token.QueryHoldersRequest{
+				ContractId: tc.contractID,
+			}
+			res, err := s.queryServer.Holders(s.goCtx, req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryHolderContracts() {
+	// empty request
+	_, err := s.queryServer.HolderContracts(s.goCtx, nil)
+	s.Require().Error(err)
+
+	testCases := map[string]struct {
+		address  sdk.AccAddress
+		valid    bool
+		postTest func(res *token.QueryHolderContractsResponse)
+	}{
+		"valid request": {
+			address: s.vendor,
+			valid:   true,
+			postTest: func(res *token.QueryHolderContractsResponse) {
+				s.Require().Equal(2, len(res.Holdings))
+				for _, holding := range res.Holdings {
+					s.Require().Equal(s.balance, holding.Amount)
+				}
+			},
+		},
+		"valid request (single contract)": {
+			address: s.customer,
+			valid:   true,
+			postTest: func(res *token.QueryHolderContractsResponse) {
+				s.Require().Equal(1, len(res.Holdings))
+				s.Require().Equal(s.contractID, res.Holdings[0].ContractId)
+			},
+		},
+		"no holdings": {
+			address: s.stranger,
+			valid:   true,
+			postTest: func(res *token.QueryHolderContractsResponse) {
+				s.Require().Equal(0, len(res.Holdings))
+			},
+		},
+		"invalid address": {},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			req := &token.QueryHolderContractsRequest{
+				Address: tc.address.String(),
+			}
+			res, err := s.queryServer.HolderContracts(s.goCtx, req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestQueryGranteeGrants() {
 	// empty request
 	_, err := s.queryServer.GranteeGrants(s.goCtx, nil)
@@ -240,7 +385,7 @@ func (s *KeeperTestSuite) TestQueryGranteeGrants() {
 			grantee:    s.vendor,
 			valid:      true,
 			postTest: func(res *token.QueryGranteeGrantsResponse) {
-				s.Require().Equal(3, len(res.Grants))
+				s.Require().Equal(5, len(res.Grants))
 			},
 		},
 		"class not found": {
@@ -277,11 +422,124 @@ func (s *KeeperTestSuite) TestQueryGranteeGrants() {
 	}
 }
 
+func (s *KeeperTestSuite) TestQueryPaused() {
+	// empty request
+	_, err := s.queryServer.Paused(s.goCtx, nil)
+	s.Require().Error(err)
+
+	ctx, _ := s.ctx.CacheContext()
+	err = s.keeper.Pause(ctx, s.contractID, s.vendor)
+	s.Require().NoError(err)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	testCases := map[string]struct {
+		contractID string
+		valid      bool
+		postTest   func(res *token.QueryPausedResponse)
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			valid:      true,
+			postTest: func(res *token.QueryPausedResponse) {
+				s.Require().True(res.Paused)
+			},
+		},
+		"class not found": {
+			contractID: "fee1dead",
+			valid:      true,
+			postTest: func(res *token.QueryPausedResponse) {
+				s.Require().False(res.Paused)
+			},
+		},
+		"invalid contract id": {},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			req := &token.QueryPausedRequest{
+				ContractId: tc.contractID,
+			}
+			res, err := s.queryServer.Paused(goCtx, req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryFrozen() {
+	// empty request
+	_, err := s.queryServer.Frozen(s.goCtx, nil)
+	s.Require().Error(err)
+
+	ctx, _ := s.ctx.CacheContext()
+	err = s.keeper.Freeze(ctx, s.contractID, s.vendor, s.customer)
+	s.Require().NoError(err)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	testCases := map[string]struct {
+		contractID string
+		address    sdk.AccAddress
+		valid      bool
+		postTest   func(res *token.QueryFrozenResponse)
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			address:    s.customer,
+			valid:      true,
+			postTest: func(res *token.QueryFrozenResponse) {
+				s.Require().True(res.Frozen)
+			},
+		},
+		"not frozen": {
+			contractID: s.contractID,
+			address:    s.vendor,
+			valid:      true,
+			postTest: func(res *token.QueryFrozenResponse) {
+				s.Require().False(res.Frozen)
+			},
+		},
+		"invalid contract id": {
+			address: s.customer,
+		},
+		"invalid address": {
+			contractID: s.contractID,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			req := &token.QueryFrozenRequest{
+				ContractId: tc.contractID,
+				Address:    tc.address.String(),
+			}
+			res, err := s.queryServer.Frozen(goCtx, req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestQueryIsOperatorFor() {
 	// empty request
 	_, err := s.queryServer.IsOperatorFor(s.goCtx, nil)
 	s.Require().Error(err)
 
+	ctx, _ := s.ctx.CacheContext()
+	allowance := sdk.OneInt()
+	expiration := ctx.BlockTime().Add(time.Hour)
+	err = s.keeper.AuthorizeOperator(ctx, s.contractID, s.customer, s.vendor, &allowance, &expiration)
+	s.Require().NoError(err)
+
 	testCases := map[string]struct {
 		contractID string
 		operator   sdk.AccAddress
@@ -296,6 +554,19 @@ func (s *KeeperTestSuite) TestQueryIsOperatorFor() {
 			valid:      true,
 			postTest: func(res *token.QueryIsOperatorForResponse) {
 				s.Require().True(res.Authorized)
+				s.Require().Nil(res.Allowance)
+				s.Require().Nil(res.Expiration)
+			},
+		},
+		"valid request with allowance": {
+			contractID: s.contractID,
+			operator:   s.vendor,
+			holder:     s.customer,
+			valid:      true,
+			postTest: func(res *token.QueryIsOperatorForResponse) {
+				s.Require().True(res.Authorized)
+				s.Require().Equal(&allowance, res.Allowance)
+				s.Require().True(expiration.Equal(*res.Expiration))
 			},
 		},
 		"class not found": {
@@ -328,7 +599,7 @@ func (s *KeeperTestSuite) TestQueryIsOperatorFor() {
 				Operator:   tc.operator.String(),
 				Holder:     tc.holder.String(),
 			}
-			res, err := s.queryServer.IsOperatorFor(s.goCtx, req)
+			res, err := s.queryServer.IsOperatorFor(sdk.WrapSDKContext(ctx), req)
 			if !tc.valid {
 				s.Require().Error(err)
 				return
@@ -345,6 +616,17 @@ func (s *KeeperTestSuite) TestQueryHoldersByOperator() {
 	_, err := s.queryServer.HoldersByOperator(s.goCtx, nil)
 	s.Require().Error(err)
 
+	// expired authorizations must be filtered out
+	ctx, _ := s.ctx.CacheContext()
+	expiration := ctx.BlockTime().Add(time.Hour)
+	err = s.keeper.AuthorizeOperator(ctx, s.contractID, s.stranger, s.operator, nil, &expiration)
+	s.Require().NoError(err)
+	ctx = ctx.WithBlockTime(expiration)
+
+	allowance := sdk.OneInt()
+	err = s.keeper.AuthorizeOperator(ctx, s.contractID, s.customer, s.vendor, &allowance, nil)
+	s.Require().NoError(err)
+
 	testCases := map[string]struct {
 		contractID string
 		operator   sdk.AccAddress
@@ -358,6 +640,17 @@ func (s *KeeperTestSuite) TestQueryHoldersByOperator() {
 			valid:      true,
 			postTest: func(res *token.QueryHoldersByOperatorResponse) {
 				s.Require().Equal(2, len(res.Holders))
+				s.Require().Equal(2, len(res.Authorizations))
+			},
+		},
+		"valid request with allowance": {
+			contractID: s.contractID,
+			operator:   s.vendor,
+			valid:      true,
+			postTest: func(res *token.QueryHoldersByOperatorResponse) {
+				s.Require().Equal([]string{s.customer.String()}, res.Holders)
+				s.Require().Equal(1, len(res.Authorizations))
+				s.Require().Equal(&allowance, res.Authorizations[0].Allowance)
 			},
 		},
 		"valid request with limit": {
@@ -396,7 +689,7 @@ func (s *KeeperTestSuite) TestQueryHoldersByOperator() {
 				Operator:   tc.operator.String(),
 				Pagination: pageReq,
 			}
-			res, err := s.queryServer.HoldersByOperator(s.goCtx, req)
+			res, err := s.queryServer.HoldersByOperator(sdk.WrapSDKContext(ctx), req)
 			if !tc.valid {
 				s.Require().Error(err)
 				return
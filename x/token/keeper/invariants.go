@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+const (
+	supplyInvariant = "supply"
+)
+
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	for name, invariant := range map[string]func(k Keeper) sdk.Invariant{
+		supplyInvariant: SupplyInvariant,
+	} {
+		ir.RegisterRoute(token.ModuleName, name, invariant(k))
+	}
+}
+
+// SupplyInvariant checks that the supply of each contract equals the sum of
+// the balances, and the difference between the minted and the burnt.
+func SupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		// cache, we don't want to write changes
+		ctx, _ = ctx.CacheContext()
+
+		msg := ""
+		broken := false
+
+		k.iterateClasses(ctx, func(class token.Contract) (stop bool) {
+			supply := k.GetSupply(ctx, class.Id)
+
+			balances := sdk.ZeroInt()
+			k.iterateContractBalances(ctx, class.Id, func(balance token.Balance) (stop bool) {
+				balances = balances.Add(balance.Amount)
+				return false
+			})
+			if !balances.Equal(supply) {
+				msg += fmt.Sprintf("supply of %s; expected %s, sum of balances %s\n", class.Id, supply, balances)
+				broken = true
+			}
+
+			if issued := k.GetMinted(ctx, class.Id).Sub(k.GetBurnt(ctx, class.Id)); !issued.Equal(supply) {
+				msg += fmt.Sprintf("supply of %s; expected %s, minted minus burnt %s\n", class.Id, supply, issued)
+				broken = true
+			}
+
+			return false
+		})
+
+		return sdk.FormatInvariant(token.ModuleName, supplyInvariant, msg), broken
+	}
+}
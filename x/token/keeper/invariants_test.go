@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/token"
+	"github.com/Finschia/finschia-sdk/x/token/keeper"
+)
+
+func (s *KeeperTestSuite) TestSupplyInvariant() {
+	testCases := map[string]struct {
+		malleate func(ctx sdk.Context)
+		valid    bool
+	}{
+		"invariant not broken": {
+			valid: true,
+		},
+		"tokens moved and burnt": {
+			malleate: func(ctx sdk.Context) {
+				err := s.keeper.Send(ctx, s.contractID, s.customer, s.stranger, s.balance)
+				s.Require().NoError(err)
+
+				err = s.keeper.OperatorBurn(ctx, s.contractID, s.operator, s.vendor, s.balance)
+				s.Require().NoError(err)
+			},
+			valid: true,
+		},
+		"balances differ from the supply": {
+			malleate: func(ctx sdk.Context) {
+				genesis := s.keeper.ExportGenesis(ctx)
+				genesis.Balances = []token.ContractBalances{{
+					ContractId: s.contractID,
+					Balances: []token.Balance{{
+						Address: s.stranger.String(),
+						Amount:  s.balance,
+					}},
+				}}
+				s.keeper.InitGenesis(ctx, genesis)
+			},
+		},
+		"minted differs from the supply": {
+			malleate: func(ctx sdk.Context) {
+				genesis := s.keeper.ExportGenesis(ctx)
+				genesis.Mints = []token.ContractCoin{{
+					ContractId: s.contractID,
+					Amount:     s.balance.MulRaw(10),
+				}}
+				s.keeper.InitGenesis(ctx, genesis)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			if tc.malleate != nil {
+				tc.malleate(ctx)
+			}
+
+			invariant := keeper.SupplyInvariant(s.keeper)
+			_, broken := invariant(ctx)
+			s.Require().Equal(!tc.valid, broken)
+		})
+	}
+}
@@ -114,7 +114,7 @@ func (s *KeeperTestSuite) SetupTest() {
 
 	// authorize operator
 	for _, holder := range []sdk.AccAddress{s.vendor, s.customer} {
-		err := s.keeper.AuthorizeOperator(s.ctx, s.contractID, holder, s.operator)
+		err := s.keeper.AuthorizeOperator(s.ctx, s.contractID, holder, s.operator, nil, nil)
 		s.Require().NoError(err)
 	}
 
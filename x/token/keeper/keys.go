@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"encoding/binary"
+
 	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/x/token"
 )
@@ -15,6 +17,18 @@ var (
 	supplyKeyPrefix = []byte{0x04}
 	mintKeyPrefix   = []byte{0x05}
 	burnKeyPrefix   = []byte{0x06}
+
+	lockKeyPrefix = []byte{0x07}
+
+	pausedKeyPrefix = []byte{0x08}
+	frozenKeyPrefix = []byte{0x09}
+
+	paramsKey = []byte{0x0a}
+
+	// index keys
+	holderContractKeyPrefix = []byte{0x0b}
+
+	adminHistoryKeyPrefix = []byte{0x0c}
 )
 
 func classKey(id string) []byte {
@@ -60,6 +74,56 @@ func splitBalanceKey(key []byte) (contractID string, address sdk.AccAddress) {
 	return
 }
 
+func holderContractKey(address sdk.AccAddress, contractID string) []byte {
+	prefix := holderContractKeyPrefixByAddress(address)
+	key := make([]byte, len(prefix)+len(contractID))
+
+	copy(key, prefix)
+	copy(key[len(prefix):], contractID)
+
+	return key
+}
+
+func holderContractKeyPrefixByAddress(address sdk.AccAddress) []byte {
+	key := make([]byte, len(holderContractKeyPrefix)+1+len(address))
+
+	begin := 0
+	copy(key, holderContractKeyPrefix)
+
+	begin += len(holderContractKeyPrefix)
+	key[begin] = byte(len(address))
+
+	begin++
+	copy(key[begin:], address)
+
+	return key
+}
+
+func adminHistoryKey(contractID string, sequence uint64) []byte {
+	prefix := adminHistoryKeyPrefixByContractID(contractID)
+	key := make([]byte, len(prefix)+8)
+
+	copy(key, prefix)
+	binary.BigEndian.PutUint64(key[len(prefix):], sequence)
+
+	return key
+}
+
+func adminHistoryKeyPrefixByContractID(contractID string) []byte {
+	key := make([]byte, len(adminHistoryKeyPrefix)+1+len(contractID))
+
+	begin := 0
+	copy(key, adminHistoryKeyPrefix)
+
+	begin += len(adminHistoryKeyPrefix)
+	key[begin] = byte(len(contractID))
+
+	begin++
+	copy(key[begin:], contractID)
+
+	return key
+}
+
 func statisticsKey(keyPrefix []byte, contractID string) []byte {
 	key := make([]byte, len(keyPrefix)+len(contractID))
 	copy(key, keyPrefix)
@@ -206,3 +270,130 @@ func splitAuthorizationKey(key []byte) (contractID string, operator, holder sdk.
 
 	return
 }
+
+func lockKey(contractID string, address sdk.AccAddress, schedule token.VestingSchedule) []byte {
+	prefix := lockKeyPrefixByAddress(contractID, address)
+	startTime := sdk.FormatTimeBytes(schedule.StartTime)
+	endTime := sdk.FormatTimeBytes(schedule.EndTime)
+	key := make([]byte, len(prefix)+len(startTime)+len(endTime))
+
+	begin := 0
+	copy(key, prefix)
+
+	begin += len(prefix)
+	copy(key[begin:], startTime)
+
+	begin += len(startTime)
+	copy(key[begin:], endTime)
+
+	return key
+}
+
+func lockKeyPrefixByAddress(contractID string, address sdk.AccAddress) []byte {
+	prefix := lockKeyPrefixByContractID(contractID)
+	key := make([]byte, len(prefix)+1+len(address))
+
+	begin := 0
+	copy(key, prefix)
+
+	begin += len(prefix)
+	key[begin] = byte(len(address))
+
+	begin++
+	copy(key[begin:], address)
+
+	return key
+}
+
+func lockKeyPrefixByContractID(contractID string) []byte {
+	key := make([]byte, len(lockKeyPrefix)+1+len(contractID))
+
+	begin := 0
+	copy(key, lockKeyPrefix)
+
+	begin += len(lockKeyPrefix)
+	key[begin] = byte(len(contractID))
+
+	begin++
+	copy(key[begin:], contractID)
+
+	return key
+}
+
+func splitLockKey(key []byte) (contractID string, address sdk.AccAddress, schedule token.VestingSchedule) {
+	begin := len(lockKeyPrefix) + 1
+	end := begin + int(key[begin-1])
+	contractID = string(key[begin:end])
+
+	begin = end + 1
+	end = begin + int(key[begin-1])
+	address = key[begin:end]
+
+	// both of the timestamps have the same length
+	begin = end
+	end = begin + (len(key)-begin)/2
+	startTime, err := sdk.ParseTimeBytes(key[begin:end])
+	if err != nil {
+		panic(err)
+	}
+
+	begin = end
+	endTime, err := sdk.ParseTimeBytes(key[begin:])
+	if err != nil {
+		panic(err)
+	}
+
+	schedule = token.VestingSchedule{
+		StartTime: startTime,
+		EndTime:   endTime,
+	}
+
+	return
+}
+
+func pausedKey(contractID string) []byte {
+	key := make([]byte, len(pausedKeyPrefix)+len(contractID))
+	copy(key, pausedKeyPrefix)
+	copy(key[len(pausedKeyPrefix):], contractID)
+	return key
+}
+
+func splitPausedKey(key []byte) (contractID string) {
+	return string(key[len(pausedKeyPrefix):])
+}
+
+func frozenKey(contractID string, holder sdk.AccAddress) []byte {
+	prefix := frozenKeyPrefixByContractID(contractID)
+	key := make([]byte, len(prefix)+len(holder))
+
+	copy(key, prefix)
+	copy(key[len(prefix):], holder)
+
+	return key
+}
+
+func frozenKeyPrefixByContractID(contractID string) []byte {
+	key := make([]byte, len(frozenKeyPrefix)+1+len(contractID))
+
+	begin := 0
+	copy(key, frozenKeyPrefix)
+
+	begin += len(frozenKeyPrefix)
+	key[begin] = byte(len(contractID))
+
+	begin++
+	copy(key[begin:], contractID)
+
+	return key
+}
+
+func splitFrozenKey(key []byte) (contractID string, holder sdk.AccAddress) {
+	begin := len(frozenKeyPrefix) + 1
+	end := begin + int(key[begin-1])
+	contractID = string(key[begin:end])
+
+	begin = end
+	holder = key[begin:]
+
+	return
+}
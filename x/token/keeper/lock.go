@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"github.com/Finschia/finschia-sdk/internal/vesting"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+// Lock locks the tokens of the holder, which would be unlocked on the schedule.
+// It fails if the holder has vesting.MaxLocks locks of other schedules already.
+func (k Keeper) Lock(ctx sdk.Context, contractID string, addr sdk.AccAddress, amount sdk.Int, schedule token.VestingSchedule) error {
+	locked := k.getLock(ctx, contractID, addr, schedule)
+	if locked.IsZero() {
+		k.pruneLocks(ctx, contractID, addr)
+		if len(k.GetLocks(ctx, contractID, addr)) >= vesting.MaxLocks {
+			return token.ErrTooManyLocks.Wrapf("%s has %d locks already", addr, vesting.MaxLocks)
+		}
+	}
+	k.setLock(ctx, contractID, addr, schedule, locked.Add(amount))
+
+	event := token.EventLocked{
+		ContractId: contractID,
+		Lock: token.Lock{
+			Address:  addr.String(),
+			Amount:   amount,
+			Schedule: schedule,
+		},
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+
+	return nil
+}
+
+// GetLocked returns the amount of the locked tokens of the holder at the current block time.
+func (k Keeper) GetLocked(ctx sdk.Context, contractID string, addr sdk.AccAddress) sdk.Int {
+	locked := sdk.ZeroInt()
+	k.iterateLocksImpl(ctx, lockKeyPrefixByAddress(contractID, addr), func(_ string, lock token.Lock) (stop bool) {
+		locked = locked.Add(lock.Schedule.LockedAmount(lock.Amount, ctx.BlockTime()))
+		return false
+	})
+
+	return locked
+}
+
+// GetSpendable returns the amount of the tokens of the holder, which are not locked.
+func (k Keeper) GetSpendable(ctx sdk.Context, contractID string, addr sdk.AccAddress) sdk.Int {
+	spendable := k.GetBalance(ctx, contractID, addr).Sub(k.GetLocked(ctx, contractID, addr))
+	if spendable.IsNegative() {
+		return sdk.ZeroInt()
+	}
+
+	return spendable
+}
+
+// GetLocks returns the locks of the holder.
+func (k Keeper) GetLocks(ctx sdk.Context, contractID string, addr sdk.AccAddress) []token.Lock {
+	var locks []token.Lock
+	k.iterateLocksImpl(ctx, lockKeyPrefixByAddress(contractID, addr), func(_ string, lock token.Lock) (stop bool) {
+		locks = append(locks, lock)
+		return false
+	})
+
+	return locks
+}
+
+// pruneLocks deletes the locks of the holder, which have been unlocked entirely.
+func (k Keeper) pruneLocks(ctx sdk.Context, contractID string, addr sdk.AccAddress) {
+	for _, lock := range k.GetLocks(ctx, contractID, addr) {
+		if lock.Schedule.LockedAmount(lock.Amount, ctx.BlockTime()).IsZero() {
+			k.setLock(ctx, contractID, addr, lock.Schedule, sdk.ZeroInt())
+		}
+	}
+}
+
+func (k Keeper) getLock(ctx sdk.Context, contractID string, addr sdk.AccAddress, schedule token.VestingSchedule) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	amount := sdk.ZeroInt()
+	bz := store.Get(lockKey(contractID, addr, schedule))
+	if bz != nil {
+		if err := amount.Unmarshal(bz); err != nil {
+			panic(err)
+		}
+	}
+	return amount
+}
+
+// setLock sets the amount of the lock.
+// The caller must validate `amount`.
+func (k Keeper) setLock(ctx sdk.Context, contractID string, addr sdk.AccAddress, schedule token.VestingSchedule, amount sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	key := lockKey(contractID, addr, schedule)
+	if amount.IsZero() {
+		store.Delete(key)
+	} else {
+		bz, err := amount.Marshal()
+		if err != nil {
+			panic(err)
+		}
+		store.Set(key, bz)
+	}
+}
@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/Finschia/finschia-sdk/internal/vesting"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+func (s *KeeperTestSuite) TestLock() {
+	schedule := token.VestingSchedule{
+		StartTime: s.ctx.BlockTime().Add(time.Hour),
+		EndTime:   s.ctx.BlockTime().Add(5 * time.Hour),
+	}
+
+	testCases := map[string]struct {
+		after  time.Duration
+		locked sdk.Int
+	}{
+		"before the start time": {
+			locked: s.balance,
+		},
+		"at the start time": {
+			after:  time.Hour,
+			locked: s.balance,
+		},
+		"in the middle": {
+			after:  2 * time.Hour,
+			locked: s.balance.QuoRaw(4).MulRaw(3),
+		},
+		"at the end time": {
+			after:  5 * time.Hour,
+			locked: sdk.ZeroInt(),
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			s.Require().NoError(s.keeper.Lock(ctx, s.contractID, s.customer, s.balance, schedule))
+
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(tc.after))
+			locked := s.keeper.GetLocked(ctx, s.contractID, s.customer)
+			s.Require().Equal(tc.locked, locked)
+
+			spendable := s.keeper.GetSpendable(ctx, s.contractID, s.customer)
+			s.Require().Equal(s.balance.Sub(tc.locked), spendable)
+
+			// cannot send the locked tokens
+			err := s.keeper.Send(ctx, s.contractID, s.customer, s.stranger, s.balance)
+			if tc.locked.IsPositive() {
+				s.Require().ErrorIs(err, token.ErrInsufficientBalance)
+				return
+			}
+			s.Require().NoError(err)
+
+			// the lock has been pruned
+			s.Require().Empty(s.keeper.GetLocks(ctx, s.contractID, s.customer))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestTimeLock() {
+	ctx, _ := s.ctx.CacheContext()
+
+	endTime := ctx.BlockTime().Add(time.Hour)
+	schedule := token.VestingSchedule{
+		StartTime: endTime,
+		EndTime:   endTime,
+	}
+	s.Require().NoError(s.keeper.Lock(ctx, s.contractID, s.customer, s.balance, schedule))
+	s.Require().NoError(s.keeper.Lock(ctx, s.contractID, s.customer, s.balance, schedule))
+
+	locks := s.keeper.GetLocks(ctx, s.contractID, s.customer)
+	s.Require().Len(locks, 1)
+	s.Require().Equal(s.balance.MulRaw(2), locks[0].Amount)
+
+	// the locked amount could exceed the balance
+	s.Require().True(s.keeper.GetSpendable(ctx, s.contractID, s.customer).IsZero())
+
+	ctx = ctx.WithBlockTime(endTime.Add(-time.Nanosecond))
+	s.Require().Equal(s.balance.MulRaw(2), s.keeper.GetLocked(ctx, s.contractID, s.customer))
+
+	ctx = ctx.WithBlockTime(endTime)
+	s.Require().True(s.keeper.GetLocked(ctx, s.contractID, s.customer).IsZero())
+}
+
+func (s *KeeperTestSuite) TestMaxLocks() {
+	ctx, _ := s.ctx.CacheContext()
+
+	scheduleAt := func(i int) token.VestingSchedule {
+		endTime := ctx.BlockTime().Add(time.Duration(i+1) * time.Hour)
+		return token.VestingSchedule{
+			StartTime: endTime,
+			EndTime:   endTime,
+		}
+	}
+	for i := 0; i < vesting.MaxLocks; i++ {
+		s.Require().NoError(s.keeper.Lock(ctx, s.contractID, s.customer, sdk.OneInt(), scheduleAt(i)))
+	}
+
+	// the locks of the existing schedules are merged
+	s.Require().NoError(s.keeper.Lock(ctx, s.contractID, s.customer, sdk.OneInt(), scheduleAt(0)))
+
+	// a new schedule is rejected
+	err := s.keeper.Lock(ctx, s.contractID, s.customer, sdk.OneInt(), scheduleAt(vesting.MaxLocks))
+	s.Require().ErrorIs(err, token.ErrTooManyLocks)
+
+	// the unlocked ones do not count
+	ctx = ctx.WithBlockTime(scheduleAt(0).EndTime)
+	s.Require().NoError(s.keeper.Lock(ctx, s.contractID, s.customer, sdk.OneInt(), scheduleAt(vesting.MaxLocks)))
+	s.Require().Len(s.keeper.GetLocks(ctx, s.contractID, s.customer), vesting.MaxLocks)
+}
@@ -0,0 +1,51 @@
+package v2
+
+import (
+	internaladmin "github.com/Finschia/finschia-sdk/internal/admin"
+	storetypes "github.com/Finschia/finschia-sdk/store/types"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+// setAdmins makes the sole grantee of the permission to modify the admin of each contract issued before the admin was introduced,
+// recording it into the admin history. The contracts with more than one of them are left without admin.
+func setAdmins(ctx sdk.Context, store storetypes.KVStore) error {
+	for _, grantee := range internaladmin.SoleGrantees(store, grantKeyPrefix, permissionModify) {
+		key := classKey(grantee.ContractID)
+		bz := store.Get(key)
+		if bz == nil {
+			continue
+		}
+
+		var class token.Contract
+		if err := class.Unmarshal(bz); err != nil {
+			return err
+		}
+		if len(class.Admin) != 0 {
+			continue
+		}
+
+		entry := token.AdminHistoryEntry{
+			Admin:  grantee.Address.String(),
+			Height: ctx.BlockHeight(),
+			Time:   ctx.BlockTime(),
+		}
+		entryBz, err := entry.Marshal()
+		if err != nil {
+			return err
+		}
+		historyPrefix := adminHistoryKeyPrefixByContractID(class.Id)
+		sequence := internaladmin.NextHistorySequence(store, historyPrefix)
+		store.Set(adminHistoryKey(historyPrefix, sequence), entryBz)
+
+		class.Admin = grantee.Address.String()
+		class.PendingAdmin = ""
+		classBz, err := class.Marshal()
+		if err != nil {
+			return err
+		}
+		store.Set(key, classBz)
+	}
+
+	return nil
+}
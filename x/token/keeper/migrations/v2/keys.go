@@ -0,0 +1,86 @@
+package v2
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+)
+
+const (
+	// the values of token.Permission
+	permissionModify = 1
+	permissionPause  = 4
+	permissionFreeze = 5
+)
+
+var (
+	balanceKeyPrefix = []byte{0x00}
+	classKeyPrefix   = []byte{0x01}
+	grantKeyPrefix   = []byte{0x02}
+
+	paramsKey = []byte{0x0a}
+
+	holderContractKeyPrefix = []byte{0x0b}
+
+	adminHistoryKeyPrefix = []byte{0x0c}
+)
+
+func splitBalanceKey(key []byte) (contractID string, address sdk.AccAddress) {
+	begin := len(balanceKeyPrefix) + 1
+	end := begin + int(key[begin-1])
+	contractID = string(key[begin:end])
+
+	begin = end
+	address = key[begin:]
+
+	return
+}
+
+func holderContractKey(address sdk.AccAddress, contractID string) []byte {
+	key := make([]byte, len(holderContractKeyPrefix)+1+len(address)+len(contractID))
+
+	begin := 0
+	copy(key, holderContractKeyPrefix)
+
+	begin += len(holderContractKeyPrefix)
+	key[begin] = byte(len(address))
+
+	begin++
+	copy(key[begin:], address)
+
+	begin += len(address)
+	copy(key[begin:], contractID)
+
+	return key
+}
+
+func classKey(id string) []byte {
+	key := make([]byte, len(classKeyPrefix)+len(id))
+	copy(key, classKeyPrefix)
+	copy(key[len(classKeyPrefix):], id)
+	return key
+}
+
+func adminHistoryKey(prefix []byte, sequence uint64) []byte {
+	key := make([]byte, len(prefix)+8)
+
+	copy(key, prefix)
+	binary.BigEndian.PutUint64(key[len(prefix):], sequence)
+
+	return key
+}
+
+func adminHistoryKeyPrefixByContractID(contractID string) []byte {
+	key := make([]byte, len(adminHistoryKeyPrefix)+1+len(contractID))
+
+	begin := 0
+	copy(key, adminHistoryKeyPrefix)
+
+	begin += len(adminHistoryKeyPrefix)
+	key[begin] = byte(len(contractID))
+
+	begin++
+	copy(key[begin:], contractID)
+
+	return key
+}
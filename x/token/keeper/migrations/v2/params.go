@@ -0,0 +1,22 @@
+package v2
+
+import (
+	storetypes "github.com/Finschia/finschia-sdk/store/types"
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+// setDefaultParams sets the default params, i.e. the max batch size of the batch transfers, as v1 has no params.
+func setDefaultParams(store storetypes.KVStore) error {
+	if store.Has(paramsKey) {
+		return nil
+	}
+
+	params := token.DefaultGenesisState().Params
+	bz, err := params.Marshal()
+	if err != nil {
+		return err
+	}
+	store.Set(paramsKey, bz)
+
+	return nil
+}
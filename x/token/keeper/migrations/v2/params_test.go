@@ -0,0 +1,32 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/testutil"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/token"
+
+	"github.com/Finschia/finschia-sdk/x/token/keeper/migrations/v2"
+)
+
+func TestMigrateStoreParams(t *testing.T) {
+	tokenKey := sdk.NewKVStoreKey(token.StoreKey)
+	newKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(tokenKey, newKey)
+
+	// v1 has no params
+	store := ctx.KVStore(tokenKey)
+	paramsKey := []byte{0x0a}
+	require.False(t, store.Has(paramsKey))
+
+	// migrate
+	err := v2.MigrateStore(ctx, tokenKey)
+	require.NoError(t, err)
+
+	var params token.Params
+	require.NoError(t, params.Unmarshal(store.Get(paramsKey)))
+	require.Equal(t, token.DefaultGenesisState().Params, params)
+}
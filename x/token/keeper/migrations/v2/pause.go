@@ -0,0 +1,31 @@
+package v2
+
+import (
+	storetypes "github.com/Finschia/finschia-sdk/store/types"
+	sdk "github.com/Finschia/finschia-sdk/types"
+)
+
+// grantPauseAndFreeze grants the permissions to pause and freeze to the holders of the permission to modify,
+// as the contracts issued before the pause and freeze controls have nobody able to grant them.
+func grantPauseAndFreeze(store storetypes.KVStore) {
+	iterator := sdk.KVStorePrefixIterator(store, grantKeyPrefix)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		if key[len(key)-1] != permissionModify {
+			continue
+		}
+		for _, permission := range []byte{permissionPause, permissionFreeze} {
+			newKey := make([]byte, len(key))
+			copy(newKey, key)
+			newKey[len(newKey)-1] = permission
+			keys = append(keys, newKey)
+		}
+	}
+
+	for _, key := range keys {
+		store.Set(key, []byte{})
+	}
+}
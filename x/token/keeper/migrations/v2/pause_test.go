@@ -0,0 +1,47 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/crypto/keys/secp256k1"
+	"github.com/Finschia/finschia-sdk/testutil"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/token"
+
+	"github.com/Finschia/finschia-sdk/x/token/keeper/migrations/v2"
+)
+
+func TestMigrateStoreGrants(t *testing.T) {
+	tokenKey := sdk.NewKVStoreKey(token.StoreKey)
+	newKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(tokenKey, newKey)
+
+	contractID := "deadbeef"
+	operator := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	minter := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	grantKey := func(grantee sdk.AccAddress, permission token.Permission) []byte {
+		key := []byte{0x02, byte(len(contractID))}
+		key = append(key, contractID...)
+		key = append(key, byte(len(grantee)))
+		key = append(key, grantee...)
+		return append(key, byte(permission))
+	}
+
+	store := ctx.KVStore(tokenKey)
+	store.Set(grantKey(operator, token.PermissionModify), []byte{})
+	store.Set(grantKey(operator, token.PermissionMint), []byte{})
+	store.Set(grantKey(minter, token.PermissionMint), []byte{})
+
+	// migrate
+	err := v2.MigrateStore(ctx, tokenKey)
+	require.NoError(t, err)
+
+	// the holders of the permission to modify can pause and freeze
+	for _, permission := range []token.Permission{token.PermissionPause, token.PermissionFreeze} {
+		require.True(t, store.Has(grantKey(operator, permission)))
+		require.False(t, store.Has(grantKey(minter, permission)))
+	}
+}
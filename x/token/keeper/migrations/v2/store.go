@@ -0,0 +1,41 @@
+package v2
+
+import (
+	storetypes "github.com/Finschia/finschia-sdk/store/types"
+	sdk "github.com/Finschia/finschia-sdk/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2, which covers the state introduced since v1:
+//   - the index of the contracts per holder, used by the HolderContracts query (see buildHolderContractIndex)
+//   - the permissions to pause and freeze (see grantPauseAndFreeze)
+//   - the params (see setDefaultParams)
+//   - the admins of the contracts (see setAdmins)
+//
+// Each step is independent of the others, so that it could be run alone.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+
+	buildHolderContractIndex(store)
+	grantPauseAndFreeze(store)
+
+	if err := setDefaultParams(store); err != nil {
+		return err
+	}
+
+	return setAdmins(ctx, store)
+}
+
+func buildHolderContractIndex(store storetypes.KVStore) {
+	iterator := sdk.KVStorePrefixIterator(store, balanceKeyPrefix)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		contractID, address := splitBalanceKey(iterator.Key())
+		keys = append(keys, holderContractKey(address, contractID))
+	}
+
+	for _, key := range keys {
+		store.Set(key, []byte{})
+	}
+}
@@ -0,0 +1,57 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/crypto/keys/secp256k1"
+	"github.com/Finschia/finschia-sdk/testutil"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/token"
+
+	"github.com/Finschia/finschia-sdk/x/token/keeper/migrations/v2"
+)
+
+func TestMigrateStore(t *testing.T) {
+	tokenKey := sdk.NewKVStoreKey(token.StoreKey)
+	newKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(tokenKey, newKey)
+
+	addrs := make([]sdk.AccAddress, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	contractIDs := []string{"deadbeef", "fee1dead"}
+
+	balanceKey := func(contractID string, address sdk.AccAddress) []byte {
+		key := []byte{0x00, byte(len(contractID))}
+		key = append(key, contractID...)
+		return append(key, address...)
+	}
+
+	// set the balances without their index
+	store := ctx.KVStore(tokenKey)
+	bz, err := sdk.OneInt().Marshal()
+	require.NoError(t, err)
+	for _, contractID := range contractIDs {
+		store.Set(balanceKey(contractID, addrs[0]), bz)
+	}
+	store.Set(balanceKey(contractIDs[0], addrs[1]), bz)
+
+	// migrate
+	err = v2.MigrateStore(ctx, tokenKey)
+	require.NoError(t, err)
+
+	holderContractKey := func(address sdk.AccAddress, contractID string) []byte {
+		key := []byte{0x0b, byte(len(address))}
+		key = append(key, address...)
+		return append(key, contractID...)
+	}
+	for _, contractID := range contractIDs {
+		require.True(t, store.Has(holderContractKey(addrs[0], contractID)))
+	}
+	require.True(t, store.Has(holderContractKey(addrs[1], contractIDs[0])))
+	require.False(t, store.Has(holderContractKey(addrs[1], contractIDs[1])))
+}
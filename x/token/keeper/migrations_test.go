@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/Finschia/finschia-sdk/crypto/keys/secp256k1"
+	"github.com/Finschia/finschia-sdk/simapp"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/module"
+	"github.com/Finschia/finschia-sdk/x/token"
+	"github.com/Finschia/finschia-sdk/x/token/keeper"
+)
+
+func TestMigrateFromV1(t *testing.T) {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+	ctx := app.BaseApp.NewContext(checkTx, tmproto.Header{})
+	k := app.TokenKeeper
+
+	addrs := make([]sdk.AccAddress, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+	contractID := k.Issue(ctx, token.Contract{Name: "Mintable", Symbol: "OK"}, addrs[0], addrs[0], sdk.NewInt(10))
+
+	// v1 has no params
+	ctx.KVStore(app.GetKey(token.StoreKey)).Delete([]byte{0x0a})
+	require.Panics(t, func() { k.GetParams(ctx) })
+
+	// migrate
+	var migrations []module.MigrationHandler
+	err := keeper.NewMigrator(k).Register(func(moduleName string, fromVersion uint64, handler module.MigrationHandler) error {
+		require.Equal(t, token.ModuleName, moduleName)
+		require.Equal(t, uint64(1), fromVersion)
+		migrations = append(migrations, handler)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, migrations, 1)
+	require.NoError(t, migrations[0](ctx))
+
+	require.Equal(t, token.DefaultGenesisState().Params, k.GetParams(ctx))
+
+	// the batch transfers work
+	req := &token.MsgMultiSend{
+		ContractId: contractID,
+		From:       addrs[0].String(),
+		Outputs: []token.Output{{
+			To:     addrs[1].String(),
+			Amount: sdk.OneInt(),
+		}},
+	}
+	_, err = keeper.NewMsgServer(k).MultiSend(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+	require.Equal(t, sdk.OneInt(), k.GetBalance(ctx, contractID, addrs[1]))
+
+	// and so does the export
+	require.NotPanics(t, func() {
+		require.NoError(t, token.ValidateGenesis(*k.ExportGenesis(ctx)))
+	})
+}
@@ -50,6 +50,42 @@ func (s msgServer) Send(c context.Context, req *token.MsgSend) (*token.MsgSendRe
 	return &token.MsgSendResponse{}, nil
 }
 
+// MultiSend defines a method to send tokens from one account to multiple accounts
+func (s msgServer) MultiSend(c context.Context, req *token.MsgMultiSend) (*token.MsgMultiSendResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	if err := token.ValidateBatchSize(s.keeper.GetParams(ctx), len(req.Outputs)); err != nil {
+		return nil, err
+	}
+
+	from := sdk.MustAccAddressFromBech32(req.From)
+
+	for _, output := range req.Outputs {
+		to := sdk.MustAccAddressFromBech32(output.To)
+
+		if err := s.keeper.Send(ctx, req.ContractId, from, to, output.Amount); err != nil {
+			return nil, err
+		}
+
+		event := token.EventSent{
+			ContractId: req.ContractId,
+			Operator:   req.From,
+			From:       req.From,
+			To:         output.To,
+			Amount:     output.Amount,
+		}
+		if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+			panic(err)
+		}
+	}
+
+	return &token.MsgMultiSendResponse{}, nil
+}
+
 // OperatorSend defines a method to send tokens from one account to another account by the operator
 func (s msgServer) OperatorSend(c context.Context, req *token.MsgOperatorSend) (*token.MsgOperatorSendResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -62,8 +98,8 @@ func (s msgServer) OperatorSend(c context.Context, req *token.MsgOperatorSend) (
 	operator := sdk.MustAccAddressFromBech32(req.Operator)
 	to := sdk.MustAccAddressFromBech32(req.To)
 
-	if _, err := s.keeper.GetAuthorization(ctx, req.ContractId, from, operator); err != nil {
-		return nil, token.ErrTokenNotApproved.Wrap(err.Error())
+	if err := s.keeper.useAuthorization(ctx, req.ContractId, from, operator, req.Amount); err != nil {
+		return nil, err
 	}
 
 	if err := s.keeper.Send(ctx, req.ContractId, from, to, req.Amount); err != nil {
@@ -121,7 +157,7 @@ func (s msgServer) AuthorizeOperator(c context.Context, req *token.MsgAuthorizeO
 	holder := sdk.MustAccAddressFromBech32(req.Holder)
 	operator := sdk.MustAccAddressFromBech32(req.Operator)
 
-	if err := s.keeper.AuthorizeOperator(ctx, req.ContractId, holder, operator); err != nil {
+	if err := s.keeper.AuthorizeOperator(ctx, req.ContractId, holder, operator, req.Allowance, req.Expiration); err != nil {
 		return nil, err
 	}
 
@@ -129,6 +165,8 @@ func (s msgServer) AuthorizeOperator(c context.Context, req *token.MsgAuthorizeO
 		ContractId: req.ContractId,
 		Holder:     req.Holder,
 		Operator:   req.Operator,
+		Allowance:  req.Allowance,
+		Expiration: req.Expiration,
 	}
 	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
 		panic(err)
@@ -167,8 +205,10 @@ func (s msgServer) GrantPermission(c context.Context, req *token.MsgGrantPermiss
 	granter := sdk.MustAccAddressFromBech32(req.From)
 	grantee := sdk.MustAccAddressFromBech32(req.To)
 	permission := token.Permission(token.LegacyPermissionFromString(req.Permission))
-	if _, err := s.keeper.GetGrant(ctx, req.ContractId, granter, permission); err != nil {
-		return nil, token.ErrTokenNoPermission.Wrap(err.Error())
+	if !s.keeper.IsAdmin(ctx, req.ContractId, granter) {
+		if _, err := s.keeper.GetGrant(ctx, req.ContractId, granter, permission); err != nil {
+			return nil, token.ErrTokenNoPermission.Wrap(err.Error())
+		}
 	}
 
 	s.keeper.Grant(ctx, req.ContractId, granter, grantee, permission)
@@ -210,6 +250,12 @@ func (s msgServer) Mint(c context.Context, req *token.MsgMint) (*token.MsgMintRe
 		return nil, err
 	}
 
+	if req.Vesting != nil {
+		if err := s.keeper.Lock(ctx, req.ContractId, to, req.Amount, *req.Vesting); err != nil {
+			return nil, err
+		}
+	}
+
 	return &token.MsgMintResponse{}, nil
 }
 
@@ -270,3 +316,127 @@ func (s msgServer) Modify(c context.Context, req *token.MsgModify) (*token.MsgMo
 
 	return &token.MsgModifyResponse{}, nil
 }
+
+// Pause defines a method to pause transfers of a contract
+func (s msgServer) Pause(c context.Context, req *token.MsgPause) (*token.MsgPauseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	operator := sdk.MustAccAddressFromBech32(req.From)
+
+	if err := s.keeper.Pause(ctx, req.ContractId, operator); err != nil {
+		return nil, err
+	}
+
+	return &token.MsgPauseResponse{}, nil
+}
+
+// Unpause defines a method to resume transfers of a paused contract
+func (s msgServer) Unpause(c context.Context, req *token.MsgUnpause) (*token.MsgUnpauseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	operator := sdk.MustAccAddressFromBech32(req.From)
+
+	if err := s.keeper.Unpause(ctx, req.ContractId, operator); err != nil {
+		return nil, err
+	}
+
+	return &token.MsgUnpauseResponse{}, nil
+}
+
+// Freeze defines a method to freeze the tokens of a holder
+func (s msgServer) Freeze(c context.Context, req *token.MsgFreeze) (*token.MsgFreezeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	operator := sdk.MustAccAddressFromBech32(req.From)
+	holder := sdk.MustAccAddressFromBech32(req.Holder)
+
+	if err := s.keeper.Freeze(ctx, req.ContractId, operator, holder); err != nil {
+		return nil, err
+	}
+
+	return &token.MsgFreezeResponse{}, nil
+}
+
+// Unfreeze defines a method to unfreeze the tokens of a frozen holder
+func (s msgServer) Unfreeze(c context.Context, req *token.MsgUnfreeze) (*token.MsgUnfreezeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	operator := sdk.MustAccAddressFromBech32(req.From)
+	holder := sdk.MustAccAddressFromBech32(req.Holder)
+
+	if err := s.keeper.Unfreeze(ctx, req.ContractId, operator, holder); err != nil {
+		return nil, err
+	}
+
+	return &token.MsgUnfreezeResponse{}, nil
+}
+
+// ProposeAdmin defines a method to propose the next admin of a contract
+func (s msgServer) ProposeAdmin(c context.Context, req *token.MsgProposeAdmin) (*token.MsgProposeAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	admin := sdk.MustAccAddressFromBech32(req.From)
+	proposed := sdk.MustAccAddressFromBech32(req.To)
+
+	if err := s.keeper.ProposeAdmin(ctx, req.ContractId, admin, proposed); err != nil {
+		return nil, err
+	}
+
+	return &token.MsgProposeAdminResponse{}, nil
+}
+
+// AcceptAdmin defines a method to accept the admin role of a contract
+func (s msgServer) AcceptAdmin(c context.Context, req *token.MsgAcceptAdmin) (*token.MsgAcceptAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	admin := sdk.MustAccAddressFromBech32(req.From)
+
+	if err := s.keeper.AcceptAdmin(ctx, req.ContractId, admin); err != nil {
+		return nil, err
+	}
+
+	return &token.MsgAcceptAdminResponse{}, nil
+}
+
+// RevokeGrant defines a method for the admin to revoke a permission of a grantee
+func (s msgServer) RevokeGrant(c context.Context, req *token.MsgRevokeGrant) (*token.MsgRevokeGrantResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	admin := sdk.MustAccAddressFromBech32(req.From)
+	grantee := sdk.MustAccAddressFromBech32(req.Grantee)
+	permission := token.Permission(token.LegacyPermissionFromString(req.Permission))
+
+	if err := s.keeper.RevokeGrant(ctx, req.ContractId, admin, grantee, permission); err != nil {
+		return nil, err
+	}
+
+	return &token.MsgRevokeGrantResponse{}, nil
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+func (k Keeper) GetParams(ctx sdk.Context) token.Params {
+	store := ctx.KVStore(k.storeKey)
+	key := paramsKey
+	bz := store.Get(key)
+	if bz == nil {
+		panic(sdkerrors.ErrNotFound.Wrap("params does not exist"))
+	}
+
+	var params token.Params
+	k.cdc.MustUnmarshal(bz, &params)
+
+	return params
+}
+
+func (k Keeper) SetParams(ctx sdk.Context, params token.Params) {
+	store := ctx.KVStore(k.storeKey)
+	key := paramsKey
+
+	bz, err := params.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(key, bz)
+}
@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+// Pause pauses the transfers of the contract.
+func (k Keeper) Pause(ctx sdk.Context, contractID string, operator sdk.AccAddress) error {
+	if _, err := k.GetGrant(ctx, contractID, operator, token.PermissionPause); err != nil {
+		return token.ErrTokenNoPermission.Wrap(err.Error())
+	}
+
+	if k.IsPaused(ctx, contractID) {
+		return token.ErrTokenPaused.Wrapf("%s has been paused already", contractID)
+	}
+	k.setPaused(ctx, contractID, true)
+
+	event := token.EventPaused{
+		ContractId: contractID,
+		Operator:   operator.String(),
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+	return nil
+}
+
+// Unpause resumes the transfers of the paused contract.
+func (k Keeper) Unpause(ctx sdk.Context, contractID string, operator sdk.AccAddress) error {
+	if _, err := k.GetGrant(ctx, contractID, operator, token.PermissionPause); err != nil {
+		return token.ErrTokenNoPermission.Wrap(err.Error())
+	}
+
+	if !k.IsPaused(ctx, contractID) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("%s has not been paused", contractID)
+	}
+	k.setPaused(ctx, contractID, false)
+
+	event := token.EventUnpaused{
+		ContractId: contractID,
+		Operator:   operator.String(),
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+	return nil
+}
+
+// Freeze freezes the tokens of the holder.
+func (k Keeper) Freeze(ctx sdk.Context, contractID string, operator, holder sdk.AccAddress) error {
+	if _, err := k.GetGrant(ctx, contractID, operator, token.PermissionFreeze); err != nil {
+		return token.ErrTokenNoPermission.Wrap(err.Error())
+	}
+
+	if k.IsFrozen(ctx, contractID, holder) {
+		return token.ErrAccountFrozen.Wrapf("%s has been frozen already", holder)
+	}
+	k.setFrozen(ctx, contractID, holder, true)
+
+	event := token.EventFrozen{
+		ContractId: contractID,
+		Operator:   operator.String(),
+		Holder:     holder.String(),
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+	return nil
+}
+
+// Unfreeze unfreezes the tokens of the frozen holder.
+func (k Keeper) Unfreeze(ctx sdk.Context, contractID string, operator, holder sdk.AccAddress) error {
+	if _, err := k.GetGrant(ctx, contractID, operator, token.PermissionFreeze); err != nil {
+		return token.ErrTokenNoPermission.Wrap(err.Error())
+	}
+
+	if !k.IsFrozen(ctx, contractID, holder) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("%s has not been frozen", holder)
+	}
+	k.setFrozen(ctx, contractID, holder, false)
+
+	event := token.EventUnfrozen{
+		ContractId: contractID,
+		Operator:   operator.String(),
+		Holder:     holder.String(),
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+	return nil
+}
+
+func (k Keeper) IsPaused(ctx sdk.Context, contractID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(pausedKey(contractID))
+}
+
+func (k Keeper) setPaused(ctx sdk.Context, contractID string, paused bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := pausedKey(contractID)
+
+	if paused {
+		store.Set(key, []byte{})
+	} else {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) IsFrozen(ctx sdk.Context, contractID string, holder sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(frozenKey(contractID, holder))
+}
+
+func (k Keeper) setFrozen(ctx sdk.Context, contractID string, holder sdk.AccAddress, frozen bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := frozenKey(contractID, holder)
+
+	if frozen {
+		store.Set(key, []byte{})
+	} else {
+		store.Delete(key)
+	}
+}
+
+// validateTransferable checks whether the holder can move its tokens out.
+func (k Keeper) validateTransferable(ctx sdk.Context, contractID string, holder sdk.AccAddress) error {
+	if k.IsPaused(ctx, contractID) {
+		return token.ErrTokenPaused.Wrapf("%s has been paused", contractID)
+	}
+
+	if k.IsFrozen(ctx, contractID, holder) {
+		return token.ErrAccountFrozen.Wrapf("%s has been frozen", holder)
+	}
+
+	return nil
+}
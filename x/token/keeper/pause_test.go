@@ -0,0 +1,193 @@
+package keeper_test
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+func (s *KeeperTestSuite) TestPause() {
+	testCases := map[string]struct {
+		operator sdk.AccAddress
+		paused   bool
+		err      error
+	}{
+		"valid request": {
+			operator: s.vendor,
+		},
+		"no permission": {
+			operator: s.customer,
+			err:      token.ErrTokenNoPermission,
+		},
+		"already paused": {
+			operator: s.vendor,
+			paused:   true,
+			err:      token.ErrTokenPaused,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			if tc.paused {
+				err := s.keeper.Pause(ctx, s.contractID, s.vendor)
+				s.Require().NoError(err)
+			}
+
+			err := s.keeper.Pause(ctx, s.contractID, tc.operator)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().True(s.keeper.IsPaused(ctx, s.contractID))
+
+			// cannot move the tokens
+			err = s.keeper.Send(ctx, s.contractID, s.customer, s.stranger, sdk.OneInt())
+			s.Require().ErrorIs(err, token.ErrTokenPaused)
+
+			err = s.keeper.OperatorBurn(ctx, s.contractID, s.operator, s.customer, sdk.OneInt())
+			s.Require().ErrorIs(err, token.ErrTokenPaused)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUnpause() {
+	testCases := map[string]struct {
+		operator sdk.AccAddress
+		paused   bool
+		err      error
+	}{
+		"valid request": {
+			operator: s.vendor,
+			paused:   true,
+		},
+		"no permission": {
+			operator: s.customer,
+			paused:   true,
+			err:      token.ErrTokenNoPermission,
+		},
+		"not paused": {
+			operator: s.vendor,
+			err:      sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			if tc.paused {
+				err := s.keeper.Pause(ctx, s.contractID, s.vendor)
+				s.Require().NoError(err)
+			}
+
+			err := s.keeper.Unpause(ctx, s.contractID, tc.operator)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().False(s.keeper.IsPaused(ctx, s.contractID))
+
+			err = s.keeper.Send(ctx, s.contractID, s.customer, s.stranger, sdk.OneInt())
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestFreeze() {
+	testCases := map[string]struct {
+		operator sdk.AccAddress
+		frozen   bool
+		err      error
+	}{
+		"valid request": {
+			operator: s.vendor,
+		},
+		"no permission": {
+			operator: s.customer,
+			err:      token.ErrTokenNoPermission,
+		},
+		"already frozen": {
+			operator: s.vendor,
+			frozen:   true,
+			err:      token.ErrAccountFrozen,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			if tc.frozen {
+				err := s.keeper.Freeze(ctx, s.contractID, s.vendor, s.customer)
+				s.Require().NoError(err)
+			}
+
+			err := s.keeper.Freeze(ctx, s.contractID, tc.operator, s.customer)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().True(s.keeper.IsFrozen(ctx, s.contractID, s.customer))
+
+			// cannot move the tokens of the frozen holder
+			err = s.keeper.Send(ctx, s.contractID, s.customer, s.stranger, sdk.OneInt())
+			s.Require().ErrorIs(err, token.ErrAccountFrozen)
+
+			err = s.keeper.OperatorBurn(ctx, s.contractID, s.operator, s.customer, sdk.OneInt())
+			s.Require().ErrorIs(err, token.ErrAccountFrozen)
+
+			// but the others can
+			err = s.keeper.Send(ctx, s.contractID, s.vendor, s.customer, sdk.OneInt())
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUnfreeze() {
+	testCases := map[string]struct {
+		operator sdk.AccAddress
+		frozen   bool
+		err      error
+	}{
+		"valid request": {
+			operator: s.vendor,
+			frozen:   true,
+		},
+		"no permission": {
+			operator: s.customer,
+			frozen:   true,
+			err:      token.ErrTokenNoPermission,
+		},
+		"not frozen": {
+			operator: s.vendor,
+			err:      sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			if tc.frozen {
+				err := s.keeper.Freeze(ctx, s.contractID, s.vendor, s.customer)
+				s.Require().NoError(err)
+			}
+
+			err := s.keeper.Unfreeze(ctx, s.contractID, tc.operator, s.customer)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().False(s.keeper.IsFrozen(ctx, s.contractID, s.customer))
+
+			err = s.keeper.Send(ctx, s.contractID, s.customer, s.stranger, sdk.OneInt())
+			s.Require().NoError(err)
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/Finschia/finschia-sdk/types"
 	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 	"github.com/Finschia/finschia-sdk/x/token"
@@ -11,6 +13,30 @@ func (k Keeper) Send(ctx sdk.Context, contractID string, from, to sdk.AccAddress
 		panic(sdkerrors.ErrInvalidRequest.Wrap("amount must be positive"))
 	}
 
+	if err := k.validateTransferable(ctx, contractID, from); err != nil {
+		return err
+	}
+
+	return k.send(ctx, contractID, from, to, amount)
+}
+
+// SendEscrowed sends the tokens escrowed by a module, regardless of the pause
+// of the contract and the freeze of the escrow, so that the holders can always
+// redeem what they have escrowed. It must not be exposed to the users.
+func (k Keeper) SendEscrowed(ctx sdk.Context, contractID string, escrow, to sdk.AccAddress, amount sdk.Int) error {
+	if !amount.IsPositive() {
+		panic(sdkerrors.ErrInvalidRequest.Wrap("amount must be positive"))
+	}
+
+	return k.send(ctx, contractID, escrow, to, amount)
+}
+
+func (k Keeper) send(ctx sdk.Context, contractID string, from, to sdk.AccAddress, amount sdk.Int) error {
+	k.pruneLocks(ctx, contractID, from)
+	if spendable := k.GetSpendable(ctx, contractID, from); spendable.LT(amount) {
+		return token.ErrInsufficientBalance.Wrapf("spendable balance %s is smaller than %s", spendable, amount)
+	}
+
 	if err := k.subtractToken(ctx, contractID, from, amount); err != nil {
 		return err
 	}
@@ -19,16 +45,22 @@ func (k Keeper) Send(ctx sdk.Context, contractID string, from, to sdk.AccAddress
 	return nil
 }
 
-func (k Keeper) AuthorizeOperator(ctx sdk.Context, contractID string, holder, operator sdk.AccAddress) error {
+// AuthorizeOperator authorizes the operator to handle the tokens of the holder.
+// The authorization is limited by the allowance and expiration, if provided.
+func (k Keeper) AuthorizeOperator(ctx sdk.Context, contractID string, holder, operator sdk.AccAddress, allowance *sdk.Int, expiration *time.Time) error {
 	if _, err := k.GetClass(ctx, contractID); err != nil {
 		panic(err)
 	}
 
+	if expiration != nil && !expiration.After(ctx.BlockTime()) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("expiration %s must be after the block time", expiration)
+	}
+
 	if _, err := k.GetAuthorization(ctx, contractID, holder, operator); err == nil {
 		return token.ErrTokenAlreadyApproved.Wrap("Already authorized")
 	}
 
-	k.setAuthorization(ctx, contractID, holder, operator)
+	k.setAuthorization(ctx, contractID, holder, operator, allowance, expiration)
 
 	return nil
 }
@@ -42,21 +74,70 @@ func (k Keeper) RevokeOperator(ctx sdk.Context, contractID string, holder, opera
 	return nil
 }
 
+// GetAuthorization returns the authorization of the operator, which has not been expired.
 func (k Keeper) GetAuthorization(ctx sdk.Context, contractID string, holder, operator sdk.AccAddress) (*token.Authorization, error) {
 	store := ctx.KVStore(k.storeKey)
-	if store.Has(authorizationKey(contractID, operator, holder)) {
-		return &token.Authorization{
-			Holder:   holder.String(),
-			Operator: operator.String(),
-		}, nil
+	bz := store.Get(authorizationKey(contractID, operator, holder))
+	if bz == nil {
+		return nil, token.ErrTokenNotApproved.Wrapf("no authorization to %s by %s", operator, holder)
+	}
+
+	authorization := k.unmarshalAuthorization(bz, holder, operator)
+	if authorization.IsExpired(ctx.BlockTime()) {
+		return nil, token.ErrTokenNotApproved.Wrapf("authorization to %s by %s has expired", operator, holder)
 	}
-	return nil, token.ErrTokenNotApproved.Wrapf("no authorization to %s by %s", operator, holder)
+
+	return &authorization, nil
 }
 
-func (k Keeper) setAuthorization(ctx sdk.Context, contractID string, holder, operator sdk.AccAddress) {
+// useAuthorization consumes the allowance of the authorization by the amount.
+// The authorization would be deleted if its allowance has been used up.
+func (k Keeper) useAuthorization(ctx sdk.Context, contractID string, holder, operator sdk.AccAddress, amount sdk.Int) error {
+	authorization, err := k.GetAuthorization(ctx, contractID, holder, operator)
+	if err != nil {
+		return token.ErrTokenNotApproved.Wrap(err.Error())
+	}
+
+	if authorization.Allowance == nil {
+		return nil
+	}
+
+	remaining := authorization.Allowance.Sub(amount)
+	if remaining.IsNegative() {
+		return token.ErrInsufficientAllowance.Wrapf("allowance %s is smaller than %s", authorization.Allowance, amount)
+	}
+
+	if remaining.IsZero() {
+		k.deleteAuthorization(ctx, contractID, holder, operator)
+	} else {
+		k.setAuthorization(ctx, contractID, holder, operator, &remaining, authorization.Expiration)
+	}
+
+	return nil
+}
+
+func (k Keeper) setAuthorization(ctx sdk.Context, contractID string, holder, operator sdk.AccAddress, allowance *sdk.Int, expiration *time.Time) {
 	store := ctx.KVStore(k.storeKey)
 	key := authorizationKey(contractID, operator, holder)
-	store.Set(key, []byte{})
+
+	// the addresses are already in the key
+	value := token.Authorization{
+		Allowance:  allowance,
+		Expiration: expiration,
+	}
+	store.Set(key, k.cdc.MustMarshal(&value))
+}
+
+// unmarshalAuthorization decodes the stored authorization.
+// The legacy authorization has an empty value, which means no limit.
+func (k Keeper) unmarshalAuthorization(bz []byte, holder, operator sdk.AccAddress) token.Authorization {
+	var authorization token.Authorization
+	k.cdc.MustUnmarshal(bz, &authorization)
+
+	authorization.Holder = holder.String()
+	authorization.Operator = operator.String()
+
+	return authorization
 }
 
 func (k Keeper) deleteAuthorization(ctx sdk.Context, contractID string, holder, operator sdk.AccAddress) {
@@ -102,13 +183,16 @@ func (k Keeper) GetBalance(ctx sdk.Context, contractID string, addr sdk.AccAddre
 func (k Keeper) setBalance(ctx sdk.Context, contractID string, addr sdk.AccAddress, balance sdk.Int) {
 	store := ctx.KVStore(k.storeKey)
 	key := balanceKey(contractID, addr)
+	indexKey := holderContractKey(addr, contractID)
 	if balance.IsZero() {
 		store.Delete(key)
+		store.Delete(indexKey)
 	} else {
 		bz, err := balance.Marshal()
 		if err != nil {
 			panic(err)
 		}
 		store.Set(key, bz)
+		store.Set(indexKey, []byte{})
 	}
 }
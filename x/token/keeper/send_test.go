@@ -2,8 +2,10 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 	"github.com/Finschia/finschia-sdk/x/token"
 )
 
@@ -40,6 +42,29 @@ func (s *KeeperTestSuite) TestSend() {
 	}
 }
 
+func (s *KeeperTestSuite) TestSendEscrowed() {
+	ctx, _ := s.ctx.CacheContext()
+
+	err := s.keeper.Pause(ctx, s.contractID, s.vendor)
+	s.Require().NoError(err)
+	err = s.keeper.Freeze(ctx, s.contractID, s.vendor, s.customer)
+	s.Require().NoError(err)
+
+	err = s.keeper.Send(ctx, s.contractID, s.customer, s.operator, sdk.OneInt())
+	s.Require().ErrorIs(err, token.ErrTokenPaused)
+
+	// regardless of the pause and the freeze
+	err = s.keeper.SendEscrowed(ctx, s.contractID, s.customer, s.operator, sdk.OneInt())
+	s.Require().NoError(err)
+
+	customerBalance := s.keeper.GetBalance(ctx, s.contractID, s.customer)
+	s.Require().Equal(s.balance.Sub(sdk.OneInt()), customerBalance)
+
+	// but not beyond the balance
+	err = s.keeper.SendEscrowed(ctx, s.contractID, s.customer, s.operator, s.balance)
+	s.Require().ErrorIs(err, token.ErrInsufficientBalance)
+}
+
 func (s *KeeperTestSuite) TestAuthorizeOperator() {
 	userDescriptions := map[string]string{
 		s.vendor.String():   "vendor",
@@ -58,7 +83,7 @@ func (s *KeeperTestSuite) TestAuthorizeOperator() {
 				ctx, _ := s.ctx.CacheContext()
 
 				_, queryErr := s.keeper.GetAuthorization(ctx, s.contractID, from, operator)
-				err := s.keeper.AuthorizeOperator(ctx, s.contractID, from, operator)
+				err := s.keeper.AuthorizeOperator(ctx, s.contractID, from, operator, nil, nil)
 				if queryErr == nil { // authorize must fail
 					s.Require().ErrorIs(err, token.ErrTokenAlreadyApproved)
 				} else {
@@ -72,6 +97,95 @@ func (s *KeeperTestSuite) TestAuthorizeOperator() {
 	}
 }
 
+func (s *KeeperTestSuite) TestAuthorizeOperatorWithAllowance() {
+	allowance := sdk.NewInt(2)
+	testCases := map[string]struct {
+		amount    sdk.Int
+		remaining sdk.Int
+		err       error
+	}{
+		"within the allowance": {
+			amount:    sdk.OneInt(),
+			remaining: sdk.OneInt(),
+		},
+		"use up the allowance": {
+			amount:    allowance,
+			remaining: sdk.ZeroInt(),
+		},
+		"exceed the allowance": {
+			amount: allowance.Add(sdk.OneInt()),
+			err:    token.ErrInsufficientAllowance,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			err := s.keeper.AuthorizeOperator(ctx, s.contractID, s.customer, s.vendor, &allowance, nil)
+			s.Require().NoError(err)
+
+			err = s.keeper.OperatorBurn(ctx, s.contractID, s.vendor, s.customer, tc.amount)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			authorization, err := s.keeper.GetAuthorization(ctx, s.contractID, s.customer, s.vendor)
+			if tc.remaining.IsZero() {
+				s.Require().ErrorIs(err, token.ErrTokenNotApproved)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(authorization.Allowance)
+			s.Require().Equal(tc.remaining, *authorization.Allowance)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestAuthorizeOperatorWithExpiration() {
+	now := s.ctx.BlockTime()
+	testCases := map[string]struct {
+		expiration time.Time
+		err        error
+	}{
+		"valid request": {
+			expiration: now.Add(time.Hour),
+		},
+		"expiration not after the block time": {
+			expiration: now,
+			err:        sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			err := s.keeper.AuthorizeOperator(ctx, s.contractID, s.customer, s.vendor, nil, &tc.expiration)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			_, err = s.keeper.GetAuthorization(ctx, s.contractID, s.customer, s.vendor)
+			s.Require().NoError(err)
+
+			// the authorization expires
+			ctx = ctx.WithBlockTime(tc.expiration)
+			_, err = s.keeper.GetAuthorization(ctx, s.contractID, s.customer, s.vendor)
+			s.Require().ErrorIs(err, token.ErrTokenNotApproved)
+
+			err = s.keeper.OperatorBurn(ctx, s.contractID, s.vendor, s.customer, sdk.OneInt())
+			s.Require().ErrorIs(err, token.ErrTokenNotApproved)
+
+			// the holder can authorize the operator again
+			err = s.keeper.AuthorizeOperator(ctx, s.contractID, s.customer, s.vendor, nil, nil)
+			s.Require().NoError(err)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestRevokeOperator() {
 	userDescriptions := map[string]string{
 		s.vendor.String():   "vendor",
@@ -9,6 +9,9 @@ import (
 func (k Keeper) Issue(ctx sdk.Context, class token.Contract, owner, to sdk.AccAddress, amount sdk.Int) string {
 	contractID := k.issue(ctx, class)
 
+	class.Id = contractID
+	k.setAdmin(ctx, class, owner)
+
 	event := token.EventIssued{
 		Creator:    owner.String(),
 		ContractId: contractID,
@@ -25,6 +28,8 @@ func (k Keeper) Issue(ctx sdk.Context, class token.Contract, owner, to sdk.AccAd
 
 	permissions := []token.Permission{
 		token.PermissionModify,
+		token.PermissionPause,
+		token.PermissionFreeze,
 	}
 	if class.Mintable {
 		permissions = append(permissions,
@@ -174,8 +179,12 @@ func (k Keeper) operatorBurn(ctx sdk.Context, contractID string, operator, from
 	if err != nil {
 		return token.ErrTokenNoPermission.Wrap(err.Error())
 	}
-	if _, err := k.GetAuthorization(ctx, contractID, from, operator); err != nil {
-		return token.ErrTokenNotApproved.Wrap(err.Error())
+	if err := k.useAuthorization(ctx, contractID, from, operator, amount); err != nil {
+		return err
+	}
+
+	if err := k.validateTransferable(ctx, contractID, from); err != nil {
+		return err
 	}
 
 	if err := k.burnToken(ctx, contractID, from, amount); err != nil {
@@ -49,6 +49,63 @@ func (m MsgSend) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
 }
 
+// ValidateBatchSize checks the number of the outputs of a batch transfer against the params.
+func ValidateBatchSize(params Params, size int) error {
+	if uint64(size) > uint64(params.MaxBatchSize) {
+		return ErrBatchTooLarge.Wrapf("%d > %d", size, params.MaxBatchSize)
+	}
+	return nil
+}
+
+var _ sdk.Msg = (*MsgMultiSend)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgMultiSend) ValidateBasic() error {
+	if err := ValidateContractID(m.ContractId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.From); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", m.From)
+	}
+
+	if len(m.Outputs) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("outputs cannot be empty")
+	}
+	for _, output := range m.Outputs {
+		if _, err := sdk.AccAddressFromBech32(output.To); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %s", output.To)
+		}
+
+		if err := validateAmount(output.Amount); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgMultiSend) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.From)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgMultiSend) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgMultiSend) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgMultiSend) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
 var _ sdk.Msg = (*MsgOperatorSend)(nil)
 
 // ValidateBasic implements Msg.
@@ -157,6 +214,10 @@ func (m MsgAuthorizeOperator) ValidateBasic() error {
 		return ErrApproverProxySame
 	}
 
+	if err := validateAllowance(m.Allowance); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -343,6 +404,12 @@ func (m MsgMint) ValidateBasic() error {
 		return err
 	}
 
+	if m.Vesting != nil {
+		if err := m.Vesting.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -511,3 +578,266 @@ func (m MsgModify) Route() string {
 func (m MsgModify) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
 }
+
+var _ sdk.Msg = (*MsgPause)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgPause) ValidateBasic() error {
+	if err := ValidateContractID(m.ContractId); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(m.From); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", m.From)
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgPause) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.From)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgPause) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgPause) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgPause) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgUnpause)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgUnpause) ValidateBasic() error {
+	if err := ValidateContractID(m.ContractId); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(m.From); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", m.From)
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgUnpause) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.From)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgUnpause) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgUnpause) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgUnpause) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgFreeze)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgFreeze) ValidateBasic() error {
+	if err := ValidateContractID(m.ContractId); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(m.From); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", m.From)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Holder); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid holder address: %s", m.Holder)
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgFreeze) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.From)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgFreeze) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgFreeze) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgFreeze) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgUnfreeze)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgUnfreeze) ValidateBasic() error {
+	if err := ValidateContractID(m.ContractId); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(m.From); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", m.From)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Holder); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid holder address: %s", m.Holder)
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgUnfreeze) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.From)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgUnfreeze) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgUnfreeze) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgUnfreeze) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgProposeAdmin)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgProposeAdmin) ValidateBasic() error {
+	if err := ValidateContractID(m.ContractId); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(m.From); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", m.From)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.To); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid proposed admin address: %s", m.To)
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgProposeAdmin) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.From)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgProposeAdmin) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgProposeAdmin) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgProposeAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgAcceptAdmin)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgAcceptAdmin) ValidateBasic() error {
+	if err := ValidateContractID(m.ContractId); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(m.From); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid proposed admin address: %s", m.From)
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgAcceptAdmin) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.From)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgAcceptAdmin) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgAcceptAdmin) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgAcceptAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgRevokeGrant)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgRevokeGrant) ValidateBasic() error {
+	if err := ValidateContractID(m.ContractId); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(m.From); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", m.From)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Grantee); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", m.Grantee)
+	}
+
+	if err := validateLegacyPermission(m.Permission); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgRevokeGrant) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.From)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgRevokeGrant) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgRevokeGrant) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgRevokeGrant) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -81,6 +82,81 @@ func TestMsgSend(t *testing.T) {
 	}
 }
 
+func TestMsgMultiSend(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 3)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	outputs := []token.Output{
+		{To: addrs[1].String(), Amount: sdk.OneInt()},
+		{To: addrs[2].String(), Amount: sdk.OneInt()},
+	}
+	testCases := map[string]struct {
+		contractID string
+		from       sdk.AccAddress
+		outputs    []token.Output
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			outputs:    outputs,
+		},
+		"invalid contract id": {
+			from:    addrs[0],
+			outputs: outputs,
+			err:     class.ErrInvalidContractID,
+		},
+		"invalid from": {
+			contractID: "deadbeef",
+			outputs:    outputs,
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"empty outputs": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			err:        sdkerrors.ErrInvalidRequest,
+		},
+		"invalid to": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			outputs:    []token.Output{{Amount: sdk.OneInt()}},
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"invalid amount": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			outputs:    []token.Output{{To: addrs[1].String(), Amount: sdk.ZeroInt()}},
+			err:        token.ErrInvalidAmount,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := token.MsgMultiSend{
+				ContractId: tc.contractID,
+				From:       tc.from.String(),
+				Outputs:    tc.outputs,
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.from}, msg.GetSigners())
+		})
+	}
+}
+
+func TestValidateBatchSize(t *testing.T) {
+	params := token.Params{MaxBatchSize: 2}
+	require.NoError(t, token.ValidateBatchSize(params, 2))
+	require.ErrorIs(t, token.ValidateBatchSize(params, 3), token.ErrBatchTooLarge)
+}
+
 func TestMsgOperatorSend(t *testing.T) {
 	addrs := make([]sdk.AccAddress, 3)
 	for i := range addrs {
@@ -226,10 +302,13 @@ func TestMsgAuthorizeOperator(t *testing.T) {
 		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
 	}
 
+	allowance := sdk.OneInt()
+	zero := sdk.ZeroInt()
 	testCases := map[string]struct {
 		contractID string
 		holder     sdk.AccAddress
 		operator   sdk.AccAddress
+		allowance  *sdk.Int
 		err        error
 	}{
 		"valid msg": {
@@ -258,6 +337,19 @@ func TestMsgAuthorizeOperator(t *testing.T) {
 			operator:   addrs[0],
 			err:        token.ErrApproverProxySame,
 		},
+		"valid msg with allowance": {
+			contractID: "deadbeef",
+			holder:     addrs[0],
+			operator:   addrs[1],
+			allowance:  &allowance,
+		},
+		"invalid allowance": {
+			contractID: "deadbeef",
+			holder:     addrs[0],
+			operator:   addrs[1],
+			allowance:  &zero,
+			err:        token.ErrInvalidAmount,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -266,6 +358,7 @@ func TestMsgAuthorizeOperator(t *testing.T) {
 				ContractId: tc.contractID,
 				Holder:     tc.holder.String(),
 				Operator:   tc.operator.String(),
+				Allowance:  tc.allowance,
 			}
 
 			err := msg.ValidateBasic()
@@ -427,6 +520,7 @@ func TestMsgMint(t *testing.T) {
 		grantee    sdk.AccAddress
 		to         sdk.AccAddress
 		amount     sdk.Int
+		vesting    *token.VestingSchedule
 		err        error
 	}{
 		"valid msg": {
@@ -460,6 +554,17 @@ func TestMsgMint(t *testing.T) {
 			amount:     sdk.ZeroInt(),
 			err:        token.ErrInvalidAmount,
 		},
+		"invalid vesting": {
+			contractID: "deadbeef",
+			grantee:    addrs[0],
+			to:         addrs[1],
+			amount:     sdk.OneInt(),
+			vesting: &token.VestingSchedule{
+				StartTime: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+				EndTime:   time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC).Add(-time.Hour),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -469,6 +574,7 @@ func TestMsgMint(t *testing.T) {
 				From:       tc.grantee.String(),
 				To:         tc.to.String(),
 				Amount:     tc.amount,
+				Vesting:    tc.vesting,
 			}
 
 			err := msg.ValidateBasic()
@@ -795,6 +901,359 @@ func TestMsgRevokePermission(t *testing.T) {
 	}
 }
 
+func TestMsgPause(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 1)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		from       sdk.AccAddress
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+		},
+		"invalid contract id": {
+			from: addrs[0],
+			err:  class.ErrInvalidContractID,
+		},
+		"invalid from": {
+			contractID: "deadbeef",
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := token.MsgPause{
+				ContractId: tc.contractID,
+				From:       tc.from.String(),
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.from}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgUnpause(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 1)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		from       sdk.AccAddress
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+		},
+		"invalid contract id": {
+			from: addrs[0],
+			err:  class.ErrInvalidContractID,
+		},
+		"invalid from": {
+			contractID: "deadbeef",
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := token.MsgUnpause{
+				ContractId: tc.contractID,
+				From:       tc.from.String(),
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.from}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgFreeze(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		from       sdk.AccAddress
+		holder     sdk.AccAddress
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			holder:     addrs[1],
+		},
+		"invalid contract id": {
+			from:   addrs[0],
+			holder: addrs[1],
+			err:    class.ErrInvalidContractID,
+		},
+		"invalid from": {
+			contractID: "deadbeef",
+			holder:     addrs[1],
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"invalid holder": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := token.MsgFreeze{
+				ContractId: tc.contractID,
+				From:       tc.from.String(),
+				Holder:     tc.holder.String(),
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.from}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgUnfreeze(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		from       sdk.AccAddress
+		holder     sdk.AccAddress
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			holder:     addrs[1],
+		},
+		"invalid contract id": {
+			from:   addrs[0],
+			holder: addrs[1],
+			err:    class.ErrInvalidContractID,
+		},
+		"invalid from": {
+			contractID: "deadbeef",
+			holder:     addrs[1],
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"invalid holder": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := token.MsgUnfreeze{
+				ContractId: tc.contractID,
+				From:       tc.from.String(),
+				Holder:     tc.holder.String(),
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.from}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgProposeAdmin(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		from       sdk.AccAddress
+		to         sdk.AccAddress
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			to:         addrs[1],
+		},
+		"invalid contract id": {
+			from: addrs[0],
+			to:   addrs[1],
+			err:  class.ErrInvalidContractID,
+		},
+		"invalid from": {
+			contractID: "deadbeef",
+			to:         addrs[1],
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"invalid to": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := token.MsgProposeAdmin{
+				ContractId: tc.contractID,
+				From:       tc.from.String(),
+				To:         tc.to.String(),
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.from}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgAcceptAdmin(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 1)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		from       sdk.AccAddress
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+		},
+		"invalid contract id": {
+			from: addrs[0],
+			err:  class.ErrInvalidContractID,
+		},
+		"invalid from": {
+			contractID: "deadbeef",
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := token.MsgAcceptAdmin{
+				ContractId: tc.contractID,
+				From:       tc.from.String(),
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.from}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgRevokeGrant(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		from       sdk.AccAddress
+		grantee    sdk.AccAddress
+		permission string
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			grantee:    addrs[1],
+			permission: token.LegacyPermissionMint.String(),
+		},
+		"invalid contract id": {
+			from:       addrs[0],
+			grantee:    addrs[1],
+			permission: token.LegacyPermissionMint.String(),
+			err:        class.ErrInvalidContractID,
+		},
+		"invalid from": {
+			contractID: "deadbeef",
+			grantee:    addrs[1],
+			permission: token.LegacyPermissionMint.String(),
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"invalid grantee": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			permission: token.LegacyPermissionMint.String(),
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"invalid permission": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			grantee:    addrs[1],
+			err:        sdkerrors.ErrInvalidPermission,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := token.MsgRevokeGrant{
+				ContractId: tc.contractID,
+				From:       tc.from.String(),
+				Grantee:    tc.grantee.String(),
+				Permission: tc.permission,
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.from}, msg.GetSigners())
+		})
+	}
+}
+
 func TestAminoJSON(t *testing.T) {
 	tx := legacytx.StdTx{}
 	var contractId = "deadbeef"
@@ -920,6 +1379,67 @@ func TestAminoJSON(t *testing.T) {
 			"/lbm.token.v1.MsgModify",
 			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/token/MsgModify\",\"value\":{\"changes\":[{\"key\":\"name\",\"value\":\"New test\"}],\"contract_id\":\"deadbeef\",\"owner\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String()),
 		},
+		"MsgPause": {
+			&token.MsgPause{
+				ContractId: contractId,
+				From:       addrs[0].String(),
+			},
+			"/lbm.token.v1.MsgPause",
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/token/MsgPause\",\"value\":{\"contract_id\":\"deadbeef\",\"from\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String()),
+		},
+		"MsgUnpause": {
+			&token.MsgUnpause{
+				ContractId: contractId,
+				From:       addrs[0].String(),
+			},
+			"/lbm.token.v1.MsgUnpause",
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/token/MsgUnpause\",\"value\":{\"contract_id\":\"deadbeef\",\"from\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String()),
+		},
+		"MsgFreeze": {
+			&token.MsgFreeze{
+				ContractId: contractId,
+				From:       addrs[0].String(),
+				Holder:     addrs[1].String(),
+			},
+			"/lbm.token.v1.MsgFreeze",
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/token/MsgFreeze\",\"value\":{\"contract_id\":\"deadbeef\",\"from\":\"%s\",\"holder\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), addrs[1].String()),
+		},
+		"MsgUnfreeze": {
+			&token.MsgUnfreeze{
+				ContractId: contractId,
+				From:       addrs[0].String(),
+				Holder:     addrs[1].String(),
+			},
+			"/lbm.token.v1.MsgUnfreeze",
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/token/MsgUnfreeze\",\"value\":{\"contract_id\":\"deadbeef\",\"from\":\"%s\",\"holder\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), addrs[1].String()),
+		},
+		"MsgProposeAdmin": {
+			&token.MsgProposeAdmin{
+				ContractId: contractId,
+				From:       addrs[0].String(),
+				To:         addrs[1].String(),
+			},
+			"/lbm.token.v1.MsgProposeAdmin",
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/token/MsgProposeAdmin\",\"value\":{\"contract_id\":\"deadbeef\",\"from\":\"%s\",\"to\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), addrs[1].String()),
+		},
+		"MsgAcceptAdmin": {
+			&token.MsgAcceptAdmin{
+				ContractId: contractId,
+				From:       addrs[0].String(),
+			},
+			"/lbm.token.v1.MsgAcceptAdmin",
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/token/MsgAcceptAdmin\",\"value\":{\"contract_id\":\"deadbeef\",\"from\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String()),
+		},
+		"MsgRevokeGrant": {
+			&token.MsgRevokeGrant{
+				ContractId: contractId,
+				From:       addrs[0].String(),
+				Grantee:    addrs[1].String(),
+				Permission: token.LegacyPermissionMint.String(),
+			},
+			"/lbm.token.v1.MsgRevokeGrant",
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/token/MsgRevokeGrant\",\"value\":{\"contract_id\":\"deadbeef\",\"from\":\"%s\",\"grantee\":\"%s\",\"permission\":\"mint\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), addrs[1].String()),
+		},
 	}
 
 	for name, tc := range testCases {
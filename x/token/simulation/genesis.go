@@ -0,0 +1,32 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/Finschia/finschia-sdk/types/module"
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+// Simulation parameter constants
+const (
+	MaxBatchSize = "max_batch_size"
+)
+
+// GenMaxBatchSize returns a randomized max number of the outputs in a batch transfer.
+func GenMaxBatchSize(r *rand.Rand) uint32 {
+	return uint32(r.Intn(2*token.DefaultMaxBatchSize) + 1)
+}
+
+// RandomizedGenState generates a random GenesisState for token.
+func RandomizedGenState(simState *module.SimulationState) {
+	var maxBatchSize uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxBatchSize, &maxBatchSize, simState.Rand,
+		func(r *rand.Rand) { maxBatchSize = GenMaxBatchSize(r) },
+	)
+
+	genesis := token.DefaultGenesisState()
+	genesis.Params.MaxBatchSize = maxBatchSize
+
+	simState.GenState[token.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
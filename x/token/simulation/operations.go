@@ -0,0 +1,464 @@
+package simulation
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/Finschia/finschia-sdk/baseapp"
+	"github.com/Finschia/finschia-sdk/codec"
+	simappparams "github.com/Finschia/finschia-sdk/simapp/params"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	simtypes "github.com/Finschia/finschia-sdk/types/simulation"
+	"github.com/Finschia/finschia-sdk/x/simulation"
+	"github.com/Finschia/finschia-sdk/x/token"
+	"github.com/Finschia/finschia-sdk/x/token/keeper"
+)
+
+// token message types
+var (
+	TypeMsgIssue             = sdk.MsgTypeURL(&token.MsgIssue{})
+	TypeMsgMint              = sdk.MsgTypeURL(&token.MsgMint{})
+	TypeMsgBurn              = sdk.MsgTypeURL(&token.MsgBurn{})
+	TypeMsgSend              = sdk.MsgTypeURL(&token.MsgSend{})
+	TypeMsgOperatorSend      = sdk.MsgTypeURL(&token.MsgOperatorSend{})
+	TypeMsgAuthorizeOperator = sdk.MsgTypeURL(&token.MsgAuthorizeOperator{})
+	TypeMsgRevokeOperator    = sdk.MsgTypeURL(&token.MsgRevokeOperator{})
+)
+
+// Simulation operation weights constants
+//
+//nolint:gosec
+const (
+	OpWeightMsgIssue             = "op_weight_msg_token_issue"
+	OpWeightMsgMint              = "op_weight_msg_token_mint"
+	OpWeightMsgBurn              = "op_weight_msg_token_burn"
+	OpWeightMsgSend              = "op_weight_msg_token_send"
+	OpWeightMsgOperatorSend      = "op_weight_msg_token_operator_send"
+	OpWeightMsgAuthorizeOperator = "op_weight_msg_token_authorize_operator"
+	OpWeightMsgRevokeOperator    = "op_weight_msg_token_revoke_operator"
+)
+
+// token operations weights
+const (
+	WeightIssue             = 20
+	WeightMint              = 50
+	WeightBurn              = 30
+	WeightSend              = 100
+	WeightOperatorSend      = 50
+	WeightAuthorizeOperator = 50
+	WeightRevokeOperator    = 20
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgIssue             int
+		weightMsgMint              int
+		weightMsgBurn              int
+		weightMsgSend              int
+		weightMsgOperatorSend      int
+		weightMsgAuthorizeOperator int
+		weightMsgRevokeOperator    int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgIssue, &weightMsgIssue, nil,
+		func(_ *rand.Rand) {
+			weightMsgIssue = WeightIssue
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgMint, &weightMsgMint, nil,
+		func(_ *rand.Rand) {
+			weightMsgMint = WeightMint
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgBurn, &weightMsgBurn, nil,
+		func(_ *rand.Rand) {
+			weightMsgBurn = WeightBurn
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgSend, &weightMsgSend, nil,
+		func(_ *rand.Rand) {
+			weightMsgSend = WeightSend
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgOperatorSend, &weightMsgOperatorSend, nil,
+		func(_ *rand.Rand) {
+			weightMsgOperatorSend = WeightOperatorSend
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgAuthorizeOperator, &weightMsgAuthorizeOperator, nil,
+		func(_ *rand.Rand) {
+			weightMsgAuthorizeOperator = WeightAuthorizeOperator
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRevokeOperator, &weightMsgRevokeOperator, nil,
+		func(_ *rand.Rand) {
+			weightMsgRevokeOperator = WeightRevokeOperator
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgIssue,
+			SimulateMsgIssue(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgMint,
+			SimulateMsgMint(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgBurn,
+			SimulateMsgBurn(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSend,
+			SimulateMsgSend(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgOperatorSend,
+			SimulateMsgOperatorSend(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgAuthorizeOperator,
+			SimulateMsgAuthorizeOperator(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRevokeOperator,
+			SimulateMsgRevokeOperator(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgIssue generates a MsgIssue with random values.
+func SimulateMsgIssue(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		owner, _ := simtypes.RandomAcc(r, accs)
+		to := owner
+		if r.Intn(2) == 0 {
+			to, _ = simtypes.RandomAcc(r, accs)
+		}
+
+		msg := &token.MsgIssue{
+			Name:     simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 1, 21)),
+			Symbol:   randSymbol(r),
+			Uri:      simtypes.RandStringOfLength(r, r.Intn(100)),
+			Meta:     simtypes.RandStringOfLength(r, r.Intn(100)),
+			Decimals: int32(r.Intn(19)),
+			Mintable: r.Intn(4) != 0,
+			Owner:    owner.Address.String(),
+			To:       to.Address.String(),
+			Amount:   randPositiveAmount(r, sdk.NewInt(1_000_000_000)),
+		}
+
+		return deliver(r, app, ctx, ak, bk, msg, TypeMsgIssue, owner)
+	}
+}
+
+// SimulateMsgMint generates a MsgMint with random values.
+func SimulateMsgMint(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contractID, ok := randContractID(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgMint, "no contracts"), nil, nil
+		}
+
+		grantee, ok := findGrantee(r, ctx, k, accs, contractID, token.PermissionMint)
+		if !ok {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgMint, "no grantee of mint"), nil, nil
+		}
+		to, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &token.MsgMint{
+			ContractId: contractID,
+			From:       grantee.Address.String(),
+			To:         to.Address.String(),
+			Amount:     randPositiveAmount(r, sdk.NewInt(1_000_000_000)),
+		}
+
+		return deliver(r, app, ctx, ak, bk, msg, TypeMsgMint, grantee)
+	}
+}
+
+// SimulateMsgBurn generates a MsgBurn with random values.
+func SimulateMsgBurn(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contractID, ok := randContractID(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgBurn, "no contracts"), nil, nil
+		}
+
+		grantee, ok := findGrantee(r, ctx, k, accs, contractID, token.PermissionBurn)
+		if !ok {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgBurn, "no grantee of burn"), nil, nil
+		}
+
+		spendable := k.GetSpendable(ctx, contractID, grantee.Address)
+		if !spendable.IsPositive() {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgBurn, "no spendable tokens"), nil, nil
+		}
+
+		msg := &token.MsgBurn{
+			ContractId: contractID,
+			From:       grantee.Address.String(),
+			Amount:     randPositiveAmount(r, spendable),
+		}
+
+		return deliver(r, app, ctx, ak, bk, msg, TypeMsgBurn, grantee)
+	}
+}
+
+// SimulateMsgSend generates a MsgSend with random values.
+func SimulateMsgSend(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		from, contractID, ok := randHolding(r, ctx, k, accs)
+		if !ok {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgSend, "no holdings"), nil, nil
+		}
+
+		if reason := checkTransferable(ctx, k, contractID, from.Address); len(reason) != 0 {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgSend, reason), nil, nil
+		}
+
+		spendable := k.GetSpendable(ctx, contractID, from.Address)
+		if !spendable.IsPositive() {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgSend, "no spendable tokens"), nil, nil
+		}
+		to, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &token.MsgSend{
+			ContractId: contractID,
+			From:       from.Address.String(),
+			To:         to.Address.String(),
+			Amount:     randPositiveAmount(r, spendable),
+		}
+
+		return deliver(r, app, ctx, ak, bk, msg, TypeMsgSend, from)
+	}
+}
+
+// SimulateMsgOperatorSend generates a MsgOperatorSend with random values.
+func SimulateMsgOperatorSend(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		from, contractID, ok := randHolding(r, ctx, k, accs)
+		if !ok {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgOperatorSend, "no holdings"), nil, nil
+		}
+
+		operator, authorization, ok := findOperator(r, ctx, k, accs, contractID, from.Address)
+		if !ok {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgOperatorSend, "no operators"), nil, nil
+		}
+
+		if reason := checkTransferable(ctx, k, contractID, from.Address); len(reason) != 0 {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgOperatorSend, reason), nil, nil
+		}
+
+		limit := k.GetSpendable(ctx, contractID, from.Address)
+		if authorization.Allowance != nil && authorization.Allowance.LT(limit) {
+			limit = *authorization.Allowance
+		}
+		if !limit.IsPositive() {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgOperatorSend, "no spendable tokens"), nil, nil
+		}
+		to, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &token.MsgOperatorSend{
+			ContractId: contractID,
+			Operator:   operator.Address.String(),
+			From:       from.Address.String(),
+			To:         to.Address.String(),
+			Amount:     randPositiveAmount(r, limit),
+		}
+
+		return deliver(r, app, ctx, ak, bk, msg, TypeMsgOperatorSend, operator)
+	}
+}
+
+// SimulateMsgAuthorizeOperator generates a MsgAuthorizeOperator with random values.
+func SimulateMsgAuthorizeOperator(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		holder, contractID, ok := randHolding(r, ctx, k, accs)
+		if !ok {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgAuthorizeOperator, "no holdings"), nil, nil
+		}
+
+		operator, _ := simtypes.RandomAcc(r, accs)
+		if operator.Address.Equals(holder.Address) {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgAuthorizeOperator, "holder and operator cannot be same"), nil, nil
+		}
+
+		if _, err := k.GetAuthorization(ctx, contractID, holder.Address, operator.Address); err == nil {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgAuthorizeOperator, "authorization exists"), nil, nil
+		}
+
+		msg := &token.MsgAuthorizeOperator{
+			ContractId: contractID,
+			Holder:     holder.Address.String(),
+			Operator:   operator.Address.String(),
+		}
+		if r.Intn(2) == 0 {
+			allowance := randPositiveAmount(r, k.GetBalance(ctx, contractID, holder.Address))
+			msg.Allowance = &allowance
+		}
+		if r.Intn(2) == 0 {
+			expiration := ctx.BlockTime().Add(time.Duration(simtypes.RandIntBetween(r, 1, 7*24)) * time.Hour)
+			msg.Expiration = &expiration
+		}
+
+		return deliver(r, app, ctx, ak, bk, msg, TypeMsgAuthorizeOperator, holder)
+	}
+}
+
+// SimulateMsgRevokeOperator generates a MsgRevokeOperator with random values.
+func SimulateMsgRevokeOperator(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		holder, contractID, ok := randHolding(r, ctx, k, accs)
+		if !ok {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgRevokeOperator, "no holdings"), nil, nil
+		}
+
+		operator, _, ok := findOperator(r, ctx, k, accs, contractID, holder.Address)
+		if !ok {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgRevokeOperator, "no operators"), nil, nil
+		}
+
+		msg := &token.MsgRevokeOperator{
+			ContractId: contractID,
+			Holder:     holder.Address.String(),
+			Operator:   operator.Address.String(),
+		}
+
+		return deliver(r, app, ctx, ak, bk, msg, TypeMsgRevokeOperator, holder)
+	}
+}
+
+func deliver(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak token.AccountKeeper, bk token.BankKeeper, msg sdk.Msg, msgType string, signer simtypes.Account) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:             r,
+		App:           app,
+		TxGen:         simappparams.MakeTestEncodingConfig().TxConfig,
+		Cdc:           nil,
+		Msg:           msg,
+		MsgType:       msgType,
+		Context:       ctx,
+		SimAccount:    signer,
+		AccountKeeper: ak,
+		Bankkeeper:    bk,
+		ModuleName:    token.ModuleName,
+	}
+
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
+
+// randSymbol returns a random symbol which matches `[A-Z][A-Z0-9]{1,4}`.
+func randSymbol(r *rand.Rand) string {
+	const (
+		letters  = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+		alphanum = letters + "0123456789"
+	)
+
+	symbol := []byte{letters[r.Intn(len(letters))]}
+	for i := simtypes.RandIntBetween(r, 1, 5); i > 0; i-- {
+		symbol = append(symbol, alphanum[r.Intn(len(alphanum))])
+	}
+
+	return string(symbol)
+}
+
+// randPositiveAmount returns a random amount in [1, max].
+func randPositiveAmount(r *rand.Rand, max sdk.Int) sdk.Int {
+	if !max.IsPositive() {
+		return sdk.OneInt()
+	}
+
+	return sdk.OneInt().Add(simtypes.RandomAmount(r, max.Sub(sdk.OneInt())))
+}
+
+// randContractID returns the id of a random contract.
+func randContractID(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) (string, bool) {
+	res, err := keeper.NewQueryServer(k).Contracts(sdk.WrapSDKContext(ctx), &token.QueryContractsRequest{})
+	if err != nil || len(res.Contracts) == 0 {
+		return "", false
+	}
+
+	return res.Contracts[r.Intn(len(res.Contracts))].Id, true
+}
+
+// randHolding returns a random contract and an account which holds its tokens.
+func randHolding(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) (simtypes.Account, string, bool) {
+	contractID, ok := randContractID(r, ctx, k)
+	if !ok {
+		return simtypes.Account{}, "", false
+	}
+
+	res, err := keeper.NewQueryServer(k).Holders(sdk.WrapSDKContext(ctx), &token.QueryHoldersRequest{
+		ContractId: contractID,
+	})
+	if err != nil || len(res.Holders) == 0 {
+		return simtypes.Account{}, "", false
+	}
+
+	holder := sdk.MustAccAddressFromBech32(res.Holders[r.Intn(len(res.Holders))].Address)
+	acc, ok := simtypes.FindAccount(accs, holder)
+	return acc, contractID, ok
+}
+
+// findGrantee returns an account which has the permission on the contract.
+func findGrantee(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account, contractID string, permission token.Permission) (simtypes.Account, bool) {
+	offset := r.Intn(len(accs))
+	for i := range accs {
+		acc := accs[(offset+i)%len(accs)]
+		if _, err := k.GetGrant(ctx, contractID, acc.Address, permission); err == nil {
+			return acc, true
+		}
+	}
+
+	return simtypes.Account{}, false
+}
+
+// findOperator returns an account which has been authorized by the holder.
+func findOperator(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account, contractID string, holder sdk.AccAddress) (simtypes.Account, *token.Authorization, bool) {
+	offset := r.Intn(len(accs))
+	for i := range accs {
+		acc := accs[(offset+i)%len(accs)]
+		if authorization, err := k.GetAuthorization(ctx, contractID, holder, acc.Address); err == nil {
+			return acc, authorization, true
+		}
+	}
+
+	return simtypes.Account{}, nil, false
+}
+
+// checkTransferable returns the reason why the holder cannot move its tokens out, if any.
+func checkTransferable(ctx sdk.Context, k keeper.Keeper, contractID string, holder sdk.AccAddress) string {
+	if k.IsPaused(ctx, contractID) {
+		return "contract paused"
+	}
+	if k.IsFrozen(ctx, contractID, holder) {
+		return "holder frozen"
+	}
+
+	return ""
+}
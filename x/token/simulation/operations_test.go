@@ -0,0 +1,151 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	ocabci "github.com/Finschia/ostracon/abci/types"
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/Finschia/finschia-sdk/simapp"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	simtypes "github.com/Finschia/finschia-sdk/types/simulation"
+	"github.com/Finschia/finschia-sdk/x/token"
+	"github.com/Finschia/finschia-sdk/x/token/simulation"
+)
+
+type SimTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *simapp.SimApp
+}
+
+func (s *SimTestSuite) SetupTest() {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+	s.app = app
+	s.ctx = app.BaseApp.NewContext(checkTx, tmproto.Header{
+		Time: time.Now(),
+	})
+}
+
+func (s *SimTestSuite) getTestingAccounts(r *rand.Rand, n int) []simtypes.Account {
+	accounts := simtypes.RandomAccounts(r, n)
+
+	initAmt := sdk.TokensFromConsensusPower(200, sdk.DefaultPowerReduction)
+	initCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initAmt))
+
+	// add coins to the accounts
+	for _, account := range accounts {
+		err := simapp.FundAccount(s.app, s.ctx, account.Address, initCoins)
+		s.Require().NoError(err)
+	}
+
+	return accounts
+}
+
+func (s *SimTestSuite) TestWeightedOperations() {
+	app, ctx := s.app, s.ctx
+
+	cdc := app.AppCodec()
+	appParams := make(simtypes.AppParams)
+
+	weightedOps := simulation.WeightedOperations(appParams, cdc, app.AccountKeeper, app.BankKeeper, app.TokenKeeper)
+
+	r := rand.New(rand.NewSource(1))
+	accs := s.getTestingAccounts(r, 3)
+
+	expected := []struct {
+		weight    int
+		opMsgName string
+	}{
+		{simulation.WeightIssue, simulation.TypeMsgIssue},
+		{simulation.WeightMint, simulation.TypeMsgMint},
+		{simulation.WeightBurn, simulation.TypeMsgBurn},
+		{simulation.WeightSend, simulation.TypeMsgSend},
+		{simulation.WeightOperatorSend, simulation.TypeMsgOperatorSend},
+		{simulation.WeightAuthorizeOperator, simulation.TypeMsgAuthorizeOperator},
+		{simulation.WeightRevokeOperator, simulation.TypeMsgRevokeOperator},
+	}
+	s.Require().Len(weightedOps, len(expected))
+
+	// begin a new block
+	app.BeginBlock(ocabci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash}})
+
+	for i, w := range weightedOps {
+		operationMsg, _, err := w.Op()(r, app.BaseApp, ctx, accs, ctx.ChainID())
+		s.Require().NoError(err)
+
+		// the following checks are very much dependent from the ordering of the output given
+		// by WeightedOperations. if the ordering in WeightedOperations changes some tests
+		// will fail
+		s.Require().Equal(expected[i].weight, w.Weight(), "weight should be the same")
+		s.Require().Equal(token.ModuleName, operationMsg.Route, "route should be the same")
+		s.Require().Equal(expected[i].opMsgName, operationMsg.Name, "operation Msg name should be the same")
+	}
+}
+
+func (s *SimTestSuite) TestSimulateMsgIssue() {
+	app, ctx := s.app, s.ctx
+
+	r := rand.New(rand.NewSource(1))
+	accounts := s.getTestingAccounts(r, 3)
+
+	// begin a new block
+	app.BeginBlock(ocabci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash}})
+
+	// execute operation
+	op := simulation.SimulateMsgIssue(app.AccountKeeper, app.BankKeeper, app.TokenKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	s.Require().NoError(err)
+
+	var msg token.MsgIssue
+	err = token.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+	s.Require().NoError(err)
+
+	s.Require().True(operationMsg.OK)
+	s.Require().Equal(simulation.TypeMsgIssue, operationMsg.Name)
+	s.Require().NoError(msg.ValidateBasic())
+	s.Require().Len(futureOperations, 0)
+}
+
+func (s *SimTestSuite) TestSimulateMsgSend() {
+	app, ctx := s.app, s.ctx
+
+	r := rand.New(rand.NewSource(1))
+	accounts := s.getTestingAccounts(r, 3)
+
+	// no contracts yet
+	op := simulation.SimulateMsgSend(app.AccountKeeper, app.BankKeeper, app.TokenKeeper)
+	operationMsg, _, err := op(r, app.BaseApp, ctx, accounts, "")
+	s.Require().NoError(err)
+	s.Require().False(operationMsg.OK)
+
+	class := token.Contract{
+		Name:   "Test",
+		Symbol: "TT",
+	}
+	contractID := app.TokenKeeper.Issue(ctx, class, accounts[0].Address, accounts[0].Address, sdk.NewInt(1000))
+
+	// begin a new block
+	app.BeginBlock(ocabci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash}})
+
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	s.Require().NoError(err)
+
+	var msg token.MsgSend
+	err = token.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+	s.Require().NoError(err)
+
+	s.Require().True(operationMsg.OK)
+	s.Require().Equal(contractID, msg.ContractId)
+	s.Require().Equal(accounts[0].Address.String(), msg.From)
+	s.Require().Len(futureOperations, 0)
+}
+
+func TestSimTestSuite(t *testing.T) {
+	suite.Run(t, new(SimTestSuite))
+}
@@ -2,6 +2,11 @@ package token
 
 import (
 	"strings"
+	"time"
+
+	"github.com/Finschia/finschia-sdk/internal/vesting"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 )
 
 const (
@@ -17,3 +22,30 @@ func LegacyPermissionFromString(name string) LegacyPermission {
 	legacyPermissionName := prefixLegacyPermission + strings.ToUpper(name)
 	return LegacyPermission(LegacyPermission_value[legacyPermissionName])
 }
+
+func (s VestingSchedule) ValidateBasic() error {
+	return vesting.ValidateSchedule(s.StartTime, s.EndTime)
+}
+
+// LockedAmount returns the part of the given amount, which is still locked at the given time.
+func (s VestingSchedule) LockedAmount(amount sdk.Int, now time.Time) sdk.Int {
+	return vesting.LockedAmount(s.StartTime, s.EndTime, amount, now)
+}
+
+func (l Lock) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(l.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", l.Address)
+	}
+	if err := validateAmount(l.Amount); err != nil {
+		return err
+	}
+	if err := l.Schedule.ValidateBasic(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// IsExpired returns whether the authorization has been expired at the given time.
+func (a Authorization) IsExpired(now time.Time) bool {
+	return a.Expiration != nil && !now.Before(*a.Expiration)
+}
@@ -5,17 +5,22 @@ package token
 
 import (
 	fmt "fmt"
+	github_com_Finschia_finschia_sdk_types "github.com/Finschia/finschia-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -35,6 +40,10 @@ const (
 	PermissionMint Permission = 2
 	// PERMISSION_BURN defines a permission to burn tokens of a contract.
 	PermissionBurn Permission = 3
+	// PERMISSION_PAUSE defines a permission to pause transfers of a contract.
+	PermissionPause Permission = 4
+	// PERMISSION_FREEZE defines a permission to freeze the tokens of holders.
+	PermissionFreeze Permission = 5
 )
 
 var Permission_name = map[int32]string{
@@ -42,6 +51,8 @@ var Permission_name = map[int32]string{
 	1: "PERMISSION_MODIFY",
 	2: "PERMISSION_MINT",
 	3: "PERMISSION_BURN",
+	4: "PERMISSION_PAUSE",
+	5: "PERMISSION_FREEZE",
 }
 
 var Permission_value = map[string]int32{
@@ -49,6 +60,8 @@ var Permission_value = map[string]int32{
 	"PERMISSION_MODIFY":      1,
 	"PERMISSION_MINT":        2,
 	"PERMISSION_BURN":        3,
+	"PERMISSION_PAUSE":       4,
+	"PERMISSION_FREEZE":      5,
 }
 
 func (x Permission) String() string {
@@ -73,6 +86,10 @@ const (
 	LegacyPermissionMint LegacyPermission = 2
 	// burn defines a permission to burn tokens of a contract.
 	LegacyPermissionBurn LegacyPermission = 3
+	// pause defines a permission to pause transfers of a contract.
+	LegacyPermissionPause LegacyPermission = 4
+	// freeze defines a permission to freeze the tokens of holders.
+	LegacyPermissionFreeze LegacyPermission = 5
 )
 
 var LegacyPermission_name = map[int32]string{
@@ -80,6 +97,8 @@ var LegacyPermission_name = map[int32]string{
 	1: "LEGACY_PERMISSION_MODIFY",
 	2: "LEGACY_PERMISSION_MINT",
 	3: "LEGACY_PERMISSION_BURN",
+	4: "LEGACY_PERMISSION_PAUSE",
+	5: "LEGACY_PERMISSION_FREEZE",
 }
 
 var LegacyPermission_value = map[string]int32{
@@ -87,6 +106,8 @@ var LegacyPermission_value = map[string]int32{
 	"LEGACY_PERMISSION_MODIFY":      1,
 	"LEGACY_PERMISSION_MINT":        2,
 	"LEGACY_PERMISSION_BURN":        3,
+	"LEGACY_PERMISSION_PAUSE":       4,
+	"LEGACY_PERMISSION_FREEZE":      5,
 }
 
 func (LegacyPermission) EnumDescriptor() ([]byte, []int) {
@@ -95,6 +116,8 @@ func (LegacyPermission) EnumDescriptor() ([]byte, []int) {
 
 // Params defines the parameters for the token module.
 type Params struct {
+	// max number of the outputs in a batch transfer.
+	MaxBatchSize uint32 `protobuf:"varint,1,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -146,6 +169,12 @@ type Contract struct {
 	Decimals int32 `protobuf:"varint,6,opt,name=decimals,proto3" json:"decimals,omitempty"`
 	// mintable represents whether the token is allowed to mint or burn.
 	Mintable bool `protobuf:"varint,7,opt,name=mintable,proto3" json:"mintable,omitempty"`
+	// address of the admin, which can grant or revoke any permission on the contract.
+	// Note: it would be empty for the contracts issued before the admin was introduced,
+	// which had more than one grantee of the modify permission.
+	Admin string `protobuf:"bytes,8,opt,name=admin,proto3" json:"admin,omitempty"`
+	// address of the account proposed as the next admin, which must accept the role (optional).
+	PendingAdmin string `protobuf:"bytes,9,opt,name=pending_admin,json=pendingAdmin,proto3" json:"pending_admin,omitempty"`
 }
 
 func (m *Contract) Reset()         { *m = Contract{} }
@@ -226,6 +255,11 @@ type Authorization struct {
 	Holder string `protobuf:"bytes,1,opt,name=holder,proto3" json:"holder,omitempty"`
 	// address of the operator which the authorization is granted to.
 	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// remaining number of tokens which the operator can send or burn (optional).
+	// if not provided, the operator can handle all the tokens of the holder.
+	Allowance *github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,3,opt,name=allowance,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"allowance,omitempty"`
+	// time at which the authorization expires (optional).
+	Expiration *time.Time `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
 }
 
 func (m *Authorization) Reset()         { *m = Authorization{} }
@@ -261,6 +295,180 @@ func (m *Authorization) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Authorization proto.InternalMessageInfo
 
+// VestingSchedule defines a schedule on which the locked tokens are unlocked.
+// The tokens are locked until start_time, and then unlocked linearly until
+// end_time. If start_time equals to end_time, the tokens are unlocked at once
+// on end_time.
+type VestingSchedule struct {
+	// start_time is the time from which the tokens start to be unlocked.
+	StartTime time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time is the time at which all the tokens are unlocked.
+	EndTime time.Time `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *VestingSchedule) Reset()         { *m = VestingSchedule{} }
+func (m *VestingSchedule) String() string { return proto.CompactTextString(m) }
+func (*VestingSchedule) ProtoMessage()    {}
+func (*VestingSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cc82dfde9e68378, []int{4}
+}
+func (m *VestingSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingSchedule.Merge(m, src)
+}
+func (m *VestingSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingSchedule proto.InternalMessageInfo
+
+// Lock defines tokens of a holder, which cannot be sent until unlocked.
+type Lock struct {
+	// address of the token holder.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// amount of the tokens locked at the beginning of the schedule.
+	Amount github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"amount"`
+	// schedule on which the tokens are unlocked.
+	Schedule VestingSchedule `protobuf:"bytes,3,opt,name=schedule,proto3" json:"schedule"`
+}
+
+func (m *Lock) Reset()         { *m = Lock{} }
+func (m *Lock) String() string { return proto.CompactTextString(m) }
+func (*Lock) ProtoMessage()    {}
+func (*Lock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cc82dfde9e68378, []int{5}
+}
+func (m *Lock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Lock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Lock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Lock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Lock.Merge(m, src)
+}
+func (m *Lock) XXX_Size() int {
+	return m.Size()
+}
+func (m *Lock) XXX_DiscardUnknown() {
+	xxx_messageInfo_Lock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Lock proto.InternalMessageInfo
+
+// Holding defines the balance of an address in a contract.
+type Holding struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// amount of the tokens held.
+	Amount github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"amount"`
+}
+
+func (m *Holding) Reset()         { *m = Holding{} }
+func (m *Holding) String() string { return proto.CompactTextString(m) }
+func (*Holding) ProtoMessage()    {}
+func (*Holding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cc82dfde9e68378, []int{6}
+}
+func (m *Holding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Holding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Holding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Holding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Holding.Merge(m, src)
+}
+func (m *Holding) XXX_Size() int {
+	return m.Size()
+}
+func (m *Holding) XXX_DiscardUnknown() {
+	xxx_messageInfo_Holding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Holding proto.InternalMessageInfo
+
+// AdminHistoryEntry defines an entry of the admin history of a contract.
+type AdminHistoryEntry struct {
+	// address of the previous admin.
+	// Note: it would be empty for the entry recorded on the issuance.
+	PreviousAdmin string `protobuf:"bytes,1,opt,name=previous_admin,json=previousAdmin,proto3" json:"previous_admin,omitempty"`
+	// address of the new admin.
+	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+	// height of the block at which the admin has changed.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// time of the block at which the admin has changed.
+	Time time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *AdminHistoryEntry) Reset()         { *m = AdminHistoryEntry{} }
+func (m *AdminHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*AdminHistoryEntry) ProtoMessage()    {}
+func (*AdminHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cc82dfde9e68378, []int{7}
+}
+func (m *AdminHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminHistoryEntry.Merge(m, src)
+}
+func (m *AdminHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminHistoryEntry proto.InternalMessageInfo
+
 // Grant defines permission given to a grantee.
 type Grant struct {
 	// address of the grantee.
@@ -273,7 +481,7 @@ func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1cc82dfde9e68378, []int{4}
+	return fileDescriptor_1cc82dfde9e68378, []int{8}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -309,50 +517,80 @@ func init() {
 	proto.RegisterType((*Contract)(nil), "lbm.token.v1.Contract")
 	proto.RegisterType((*Attribute)(nil), "lbm.token.v1.Attribute")
 	proto.RegisterType((*Authorization)(nil), "lbm.token.v1.Authorization")
+	proto.RegisterType((*VestingSchedule)(nil), "lbm.token.v1.VestingSchedule")
+	proto.RegisterType((*Lock)(nil), "lbm.token.v1.Lock")
+	proto.RegisterType((*Holding)(nil), "lbm.token.v1.Holding")
+	proto.RegisterType((*AdminHistoryEntry)(nil), "lbm.token.v1.AdminHistoryEntry")
 	proto.RegisterType((*Grant)(nil), "lbm.token.v1.Grant")
 }
 
 func init() { proto.RegisterFile("lbm/token/v1/token.proto", fileDescriptor_1cc82dfde9e68378) }
 
 var fileDescriptor_1cc82dfde9e68378 = []byte{
-	// 581 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0x41, 0x4f, 0x13, 0x41,
-	0x14, 0xde, 0x2d, 0xb4, 0x94, 0x17, 0xc5, 0x75, 0x52, 0x9b, 0x71, 0x8d, 0xeb, 0xa6, 0x17, 0x1b,
-	0x8c, 0x6d, 0x10, 0x4d, 0xb8, 0xd2, 0x52, 0x48, 0x0d, 0x94, 0xa6, 0xc8, 0x01, 0x3d, 0x90, 0xd9,
-	0xee, 0xd0, 0x4e, 0xd8, 0xdd, 0x69, 0x66, 0xa7, 0xc4, 0xfa, 0x03, 0x8c, 0xe9, 0xc9, 0x3f, 0xd0,
-	0x93, 0x1c, 0xf8, 0x29, 0x1c, 0x39, 0x7a, 0xd4, 0xf2, 0x47, 0xcc, 0xec, 0x2e, 0x65, 0x53, 0xea,
-	0xed, 0xfb, 0xde, 0xfb, 0xbe, 0x37, 0xef, 0x9b, 0xc9, 0x00, 0xf6, 0x1c, 0xbf, 0x2a, 0xf9, 0x39,
-	0x0d, 0xaa, 0x17, 0x1b, 0x31, 0xa8, 0x0c, 0x04, 0x97, 0x1c, 0x3d, 0xf2, 0x1c, 0xbf, 0x12, 0x17,
-	0x2e, 0x36, 0xcc, 0x42, 0x8f, 0xf7, 0x78, 0xd4, 0xa8, 0x2a, 0x14, 0x6b, 0x4a, 0x79, 0xc8, 0xb5,
-	0x89, 0x20, 0x7e, 0x58, 0xba, 0xd4, 0x21, 0x5f, 0xe7, 0x81, 0x14, 0xa4, 0x2b, 0xd1, 0x1a, 0x64,
-	0x98, 0x8b, 0x75, 0x5b, 0x2f, 0xaf, 0x76, 0x32, 0xcc, 0x45, 0x08, 0x96, 0x03, 0xe2, 0x53, 0x9c,
-	0x89, 0x2a, 0x11, 0x46, 0x45, 0xc8, 0x85, 0x23, 0xdf, 0xe1, 0x1e, 0x5e, 0x8a, 0xaa, 0x09, 0x43,
-	0x06, 0x2c, 0x0d, 0x05, 0xc3, 0xcb, 0x51, 0x51, 0x41, 0xe5, 0xf6, 0xa9, 0x24, 0x38, 0x1b, 0xbb,
-	0x15, 0x46, 0x26, 0xe4, 0x5d, 0xda, 0x65, 0x3e, 0xf1, 0x42, 0x9c, 0xb3, 0xf5, 0x72, 0xb6, 0x33,
-	0xe3, 0xaa, 0xe7, 0xb3, 0x40, 0x12, 0xc7, 0xa3, 0x78, 0xc5, 0xd6, 0xcb, 0xf9, 0xce, 0x8c, 0x97,
-	0x36, 0x61, 0x75, 0x5b, 0x4a, 0xc1, 0x9c, 0xa1, 0xa4, 0xea, 0xa8, 0x73, 0x3a, 0x4a, 0xf6, 0x54,
-	0x10, 0x15, 0x20, 0x7b, 0x41, 0xbc, 0xe1, 0xdd, 0xa6, 0x31, 0x29, 0xd5, 0xe1, 0xf1, 0xf6, 0x50,
-	0xf6, 0xb9, 0x60, 0xdf, 0x88, 0x64, 0x3c, 0x50, 0xbb, 0xf7, 0xb9, 0xe7, 0x52, 0x91, 0x78, 0x13,
-	0xa6, 0x4e, 0xe6, 0x03, 0x2a, 0x88, 0xe4, 0x22, 0x99, 0x30, 0xe3, 0xa5, 0x2f, 0x90, 0xdd, 0x13,
-	0x24, 0x90, 0x08, 0xc3, 0x4a, 0x4f, 0x01, 0x4a, 0x13, 0xf7, 0x1d, 0x45, 0x5b, 0x00, 0x03, 0x2a,
-	0x7c, 0x16, 0x86, 0x8c, 0x07, 0xd1, 0x80, 0xb5, 0x77, 0xb8, 0x92, 0x7e, 0x86, 0x4a, 0x7b, 0xd6,
-	0xef, 0xa4, 0xb4, 0xeb, 0xd7, 0x3a, 0xc0, 0x7d, 0x0b, 0x7d, 0x80, 0x62, 0xbb, 0xd1, 0x39, 0x68,
-	0x1e, 0x1d, 0x35, 0x0f, 0x5b, 0xa7, 0xc7, 0xad, 0xa3, 0x76, 0xa3, 0xde, 0xdc, 0x6d, 0x36, 0x76,
-	0x0c, 0xcd, 0x7c, 0x3e, 0x9e, 0xd8, 0xcf, 0xee, 0xb5, 0xc7, 0x41, 0x38, 0xa0, 0x5d, 0x76, 0xc6,
-	0xa8, 0x8b, 0xde, 0xc0, 0xd3, 0x94, 0xed, 0xe0, 0x70, 0xa7, 0xb9, 0x7b, 0x62, 0xe8, 0x66, 0x61,
-	0x3c, 0xb1, 0x8d, 0x7b, 0xc7, 0x01, 0x77, 0xd9, 0xd9, 0x08, 0xbd, 0x86, 0x27, 0x69, 0x71, 0xb3,
-	0xf5, 0xc9, 0xc8, 0x98, 0x68, 0x3c, 0xb1, 0xd7, 0x52, 0x52, 0x16, 0xc8, 0x39, 0x61, 0xed, 0xb8,
-	0xd3, 0x32, 0x96, 0xe6, 0x85, 0xb5, 0xa1, 0x08, 0xcc, 0xe5, 0x1f, 0xbf, 0x2c, 0x6d, 0xfd, 0x7b,
-	0x06, 0x8c, 0x7d, 0xda, 0x23, 0xdd, 0x51, 0x2a, 0x50, 0x0d, 0x5e, 0xee, 0x37, 0xf6, 0xb6, 0xeb,
-	0x27, 0xa7, 0xff, 0xcd, 0xf5, 0x6a, 0x3c, 0xb1, 0x5f, 0xcc, 0x1b, 0xd3, 0xe9, 0xb6, 0x00, 0x3f,
-	0x9c, 0x31, 0x0b, 0x69, 0x8e, 0x27, 0x76, 0x71, 0xde, 0x9e, 0x44, 0x7d, 0x0f, 0xc5, 0x05, 0xce,
-	0x38, 0x31, 0x1e, 0x4f, 0xec, 0xc2, 0x03, 0x9f, 0xca, 0xbd, 0xd0, 0x95, 0xc4, 0x5f, 0xe8, 0x8a,
-	0x2e, 0x21, 0xaf, 0x2e, 0xe1, 0xea, 0xd2, 0xd2, 0x6a, 0x1f, 0xaf, 0xff, 0x5a, 0xda, 0xd5, 0xd4,
-	0xd2, 0xae, 0xa7, 0x96, 0x7e, 0x33, 0xb5, 0xf4, 0x3f, 0x53, 0x4b, 0xff, 0x79, 0x6b, 0x69, 0x37,
-	0xb7, 0x96, 0xf6, 0xfb, 0xd6, 0xd2, 0x3e, 0x97, 0x7b, 0x4c, 0xf6, 0x87, 0x4e, 0xa5, 0xcb, 0xfd,
-	0xea, 0x2e, 0x0b, 0xc2, 0x6e, 0x9f, 0x91, 0xea, 0x59, 0x02, 0xde, 0x86, 0xee, 0x79, 0xf5, 0x6b,
-	0xfc, 0xa3, 0x9d, 0x5c, 0xf4, 0x5d, 0x37, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x2c, 0x77, 0x38,
-	0x75, 0xee, 0x03, 0x00, 0x00,
+	// 998 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf7, 0x3a, 0x4e, 0xe2, 0xbc, 0xfc, 0x72, 0xe7, 0x9b, 0x6f, 0x58, 0x8c, 0x6a, 0xaf, 0x0c,
+	0x88, 0x10, 0xc4, 0x5a, 0x4d, 0x01, 0xe5, 0x06, 0x71, 0xea, 0xb4, 0xae, 0x92, 0xd4, 0x5a, 0x37,
+	0x48, 0x2d, 0x07, 0x6b, 0xbc, 0x3b, 0x59, 0x8f, 0xb2, 0x3b, 0x63, 0xed, 0xce, 0x86, 0x38, 0x7f,
+	0x01, 0xf2, 0xa9, 0x37, 0x4e, 0x96, 0x10, 0x70, 0xe8, 0x85, 0xff, 0x23, 0xc7, 0x4a, 0x5c, 0x10,
+	0x87, 0x16, 0x92, 0x7f, 0x82, 0x23, 0x9a, 0xd9, 0xb5, 0xbd, 0x38, 0x41, 0x55, 0x24, 0x6e, 0xef,
+	0xd7, 0xe7, 0xb3, 0x6f, 0x3e, 0xef, 0x3d, 0x69, 0x41, 0xf7, 0x3a, 0x7e, 0x55, 0xf0, 0x13, 0xc2,
+	0xaa, 0xa7, 0xf7, 0x62, 0xc3, 0xec, 0x05, 0x5c, 0x70, 0xb4, 0xe4, 0x75, 0x7c, 0x33, 0x0e, 0x9c,
+	0xde, 0x2b, 0xae, 0xb9, 0xdc, 0xe5, 0x2a, 0x51, 0x95, 0x56, 0x5c, 0x53, 0x2c, 0xbb, 0x9c, 0xbb,
+	0x1e, 0xa9, 0x2a, 0xaf, 0x13, 0x1d, 0x57, 0x05, 0xf5, 0x49, 0x28, 0xb0, 0xdf, 0x8b, 0x0b, 0x2a,
+	0x26, 0xcc, 0x35, 0x71, 0x80, 0xfd, 0x10, 0x7d, 0x00, 0x2b, 0x3e, 0x3e, 0x6b, 0x77, 0xb0, 0xb0,
+	0xbb, 0xed, 0x90, 0x9e, 0x13, 0x5d, 0x33, 0xb4, 0x8d, 0x65, 0x6b, 0xc9, 0xc7, 0x67, 0x35, 0x19,
+	0x6c, 0xd1, 0x73, 0x52, 0x79, 0xa3, 0x41, 0x7e, 0x97, 0x33, 0x11, 0x60, 0x5b, 0xa0, 0x15, 0xc8,
+	0x52, 0x47, 0x95, 0x2d, 0x58, 0x59, 0xea, 0x20, 0x04, 0x39, 0x86, 0x7d, 0xa2, 0x67, 0x55, 0x44,
+	0xd9, 0x68, 0x1d, 0xe6, 0xc2, 0xbe, 0xdf, 0xe1, 0x9e, 0x3e, 0xa3, 0xa2, 0x89, 0x87, 0x0a, 0x30,
+	0x13, 0x05, 0x54, 0xcf, 0xa9, 0xa0, 0x34, 0x25, 0xda, 0x27, 0x02, 0xeb, 0xb3, 0x31, 0x5a, 0xda,
+	0xa8, 0x08, 0x79, 0x87, 0xd8, 0xd4, 0xc7, 0x5e, 0xa8, 0xcf, 0x19, 0xda, 0xc6, 0xac, 0x35, 0xf6,
+	0x65, 0xce, 0xa7, 0x4c, 0xe0, 0x8e, 0x47, 0xf4, 0x79, 0x43, 0xdb, 0xc8, 0x5b, 0x63, 0x1f, 0xad,
+	0xc1, 0x2c, 0x76, 0x7c, 0xca, 0xf4, 0xbc, 0x22, 0x8b, 0x1d, 0xf4, 0x3e, 0x2c, 0xf7, 0x08, 0x73,
+	0x28, 0x73, 0xdb, 0x71, 0x76, 0x41, 0x65, 0x97, 0x92, 0xe0, 0x8e, 0x8c, 0x55, 0xee, 0xc3, 0xc2,
+	0x8e, 0x10, 0x01, 0xed, 0x44, 0x82, 0xc8, 0x2e, 0x4f, 0x48, 0x3f, 0x79, 0xa2, 0x34, 0x25, 0xf3,
+	0x29, 0xf6, 0xa2, 0xd1, 0x23, 0x63, 0xa7, 0xf2, 0xab, 0x06, 0xcb, 0x3b, 0x91, 0xe8, 0xf2, 0x80,
+	0x9e, 0x63, 0x41, 0x39, 0x93, 0xef, 0xee, 0x72, 0xcf, 0x21, 0x41, 0x02, 0x4e, 0x3c, 0xd9, 0x35,
+	0xef, 0x91, 0x00, 0x0b, 0x1e, 0x24, 0x14, 0x63, 0x1f, 0xed, 0xc3, 0x02, 0xf6, 0x3c, 0xfe, 0x2d,
+	0x66, 0x36, 0x89, 0xe5, 0xaa, 0x99, 0xbf, 0xbf, 0x2e, 0x6f, 0xba, 0x54, 0x74, 0xa3, 0x8e, 0x69,
+	0x73, 0xbf, 0xba, 0x47, 0x59, 0x68, 0x77, 0x29, 0xae, 0x1e, 0x27, 0xc6, 0xa7, 0xa1, 0x73, 0x52,
+	0x15, 0xfd, 0x1e, 0x09, 0xcd, 0x06, 0x13, 0xd6, 0x84, 0x00, 0x7d, 0x05, 0x40, 0xce, 0x7a, 0x34,
+	0x50, 0xfd, 0x28, 0xa1, 0x17, 0xb7, 0x8a, 0x66, 0xbc, 0x10, 0xe6, 0x68, 0x21, 0xcc, 0xa7, 0xa3,
+	0x85, 0xa8, 0xe5, 0x5e, 0xbc, 0x29, 0x6b, 0x56, 0x0a, 0x53, 0xf9, 0x5e, 0x83, 0xd5, 0xaf, 0x49,
+	0x28, 0x28, 0x73, 0x5b, 0x76, 0x97, 0x38, 0x91, 0x47, 0xd0, 0x2e, 0x40, 0x28, 0x70, 0x20, 0xda,
+	0x72, 0x93, 0x74, 0xed, 0xad, 0xac, 0xf9, 0x8b, 0xd7, 0xe5, 0x8c, 0x62, 0x5e, 0x50, 0x38, 0x99,
+	0x41, 0x5f, 0x42, 0x9e, 0x30, 0x27, 0xa6, 0xc8, 0xde, 0x82, 0x62, 0x9e, 0x30, 0x47, 0xc6, 0x2b,
+	0xbf, 0x68, 0x90, 0xdb, 0xe7, 0xf6, 0x09, 0xd2, 0x61, 0x1e, 0x3b, 0x4e, 0x40, 0xc2, 0x30, 0xd1,
+	0x79, 0xe4, 0xa2, 0xc7, 0x30, 0x87, 0x7d, 0x1e, 0x31, 0x11, 0xcb, 0x5c, 0xdb, 0x92, 0x2c, 0xb7,
+	0x54, 0x33, 0x61, 0x90, 0xfd, 0x86, 0x89, 0x00, 0x6a, 0x2e, 0x8b, 0x5b, 0x77, 0xcd, 0xf4, 0xf5,
+	0x99, 0x53, 0x2a, 0xd5, 0x72, 0xf2, 0x63, 0xd6, 0x18, 0x54, 0x39, 0x85, 0xf9, 0x47, 0xdc, 0x93,
+	0x4b, 0x86, 0xca, 0xb0, 0x68, 0x27, 0x07, 0xd4, 0x1e, 0x5f, 0x0f, 0x8c, 0x42, 0x0d, 0xe7, 0xbf,
+	0x6c, 0xbc, 0xf2, 0xa3, 0x06, 0x77, 0xd4, 0x5a, 0x3f, 0xa2, 0xa1, 0xe0, 0x41, 0xbf, 0xce, 0x44,
+	0xd0, 0x47, 0x1f, 0xc2, 0x4a, 0x2f, 0x20, 0xa7, 0x94, 0x47, 0x61, 0x72, 0x08, 0x71, 0x17, 0xcb,
+	0xa3, 0xa8, 0x82, 0x4c, 0x8e, 0x28, 0x9b, 0x3e, 0x22, 0xb9, 0xd8, 0x84, 0xba, 0x5d, 0xa1, 0x94,
+	0x98, 0xb1, 0x12, 0x0f, 0x6d, 0x43, 0x4e, 0xcd, 0x33, 0x77, 0x8b, 0x79, 0x2a, 0x44, 0xe5, 0x1b,
+	0x98, 0x7d, 0x18, 0x60, 0x26, 0xe4, 0x30, 0x5d, 0x69, 0x10, 0x32, 0x1a, 0x66, 0xe2, 0xa2, 0x6d,
+	0x80, 0x1e, 0x09, 0x7c, 0x1a, 0x86, 0x94, 0xc7, 0xfd, 0xac, 0x6c, 0xe9, 0xff, 0x1c, 0x41, 0x73,
+	0x9c, 0xb7, 0x52, 0xb5, 0x9b, 0x3f, 0x64, 0x01, 0x26, 0x29, 0xf4, 0x39, 0xac, 0x37, 0xeb, 0xd6,
+	0x41, 0xa3, 0xd5, 0x6a, 0x3c, 0x39, 0x6c, 0x1f, 0x1d, 0xb6, 0x9a, 0xf5, 0xdd, 0xc6, 0x5e, 0xa3,
+	0xfe, 0xa0, 0x90, 0x29, 0xbe, 0x3b, 0x18, 0x1a, 0xff, 0x9f, 0xd4, 0x1e, 0xb1, 0xb0, 0x47, 0x6c,
+	0x7a, 0x4c, 0x89, 0x83, 0x3e, 0x81, 0x3b, 0x29, 0xd8, 0xc1, 0x93, 0x07, 0x8d, 0xbd, 0x67, 0x05,
+	0xad, 0xb8, 0x36, 0x18, 0x1a, 0x85, 0x09, 0xe2, 0x80, 0x3b, 0xf4, 0xb8, 0x8f, 0x3e, 0x82, 0xd5,
+	0x74, 0x71, 0xe3, 0xf0, 0x69, 0x21, 0x5b, 0x44, 0x83, 0xa1, 0xb1, 0x92, 0x2a, 0xa5, 0x4c, 0x4c,
+	0x15, 0xd6, 0x8e, 0xac, 0xc3, 0xc2, 0xcc, 0x74, 0x61, 0x2d, 0x0a, 0x18, 0xfa, 0x18, 0x0a, 0xa9,
+	0xc2, 0xe6, 0xce, 0x51, 0xab, 0x5e, 0xc8, 0x15, 0xff, 0x37, 0x18, 0x1a, 0xab, 0x93, 0xca, 0x26,
+	0x8e, 0x42, 0x32, 0xd5, 0xe9, 0x9e, 0x55, 0xaf, 0x3f, 0xaf, 0x17, 0x66, 0xa7, 0x3b, 0xdd, 0x0b,
+	0x08, 0x39, 0x27, 0xc5, 0xdc, 0x77, 0x3f, 0x95, 0x32, 0x9b, 0x7f, 0x65, 0xa1, 0xb0, 0x4f, 0x5c,
+	0x6c, 0xf7, 0x53, 0x42, 0xd5, 0xe0, 0xee, 0x7e, 0xfd, 0xe1, 0xce, 0xee, 0xb3, 0xf6, 0xbf, 0xea,
+	0x55, 0x1e, 0x0c, 0x8d, 0xf7, 0xa6, 0x81, 0x69, 0xd5, 0xb6, 0x41, 0xbf, 0xce, 0x31, 0x16, 0xaf,
+	0x38, 0x18, 0x1a, 0xeb, 0xd3, 0xf0, 0x44, 0xc2, 0xcf, 0x60, 0xfd, 0x06, 0x64, 0xac, 0xa4, 0x3e,
+	0x18, 0x1a, 0x6b, 0xd7, 0x70, 0x52, 0xcf, 0x1b, 0x51, 0x89, 0xac, 0x37, 0xa2, 0x94, 0xb8, 0x5f,
+	0xc0, 0x3b, 0xd7, 0x51, 0x23, 0x8d, 0xd5, 0x4e, 0x4c, 0xc3, 0x62, 0xa5, 0x6f, 0x7c, 0xdd, 0x58,
+	0xf0, 0x1b, 0x5f, 0x97, 0xc8, 0x9e, 0x97, 0xb2, 0xbf, 0xfc, 0xb9, 0x94, 0xa9, 0x3d, 0xbe, 0xf8,
+	0xb3, 0x94, 0x79, 0x79, 0x59, 0xca, 0x5c, 0x5c, 0x96, 0xb4, 0x57, 0x97, 0x25, 0xed, 0x8f, 0xcb,
+	0x92, 0xf6, 0xe2, 0xaa, 0x94, 0x79, 0x75, 0x55, 0xca, 0xfc, 0x76, 0x55, 0xca, 0x3c, 0xdf, 0x78,
+	0xeb, 0xd5, 0x9f, 0xc5, 0x7f, 0x05, 0x9d, 0x39, 0x75, 0x6a, 0xf7, 0xff, 0x1e, 0x00, 0x59, 0x5f,
+	0xa5, 0x0b, 0x32, 0x08, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -375,6 +613,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxBatchSize != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.MaxBatchSize))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -398,6 +641,20 @@ func (m *Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingAdmin) > 0 {
+		i -= len(m.PendingAdmin)
+		copy(dAtA[i:], m.PendingAdmin)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.PendingAdmin)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.Mintable {
 		i--
 		if m.Mintable {
@@ -508,6 +765,28 @@ func (m *Authorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Expiration != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintToken(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Allowance != nil {
+		{
+			size := m.Allowance.Size()
+			i -= size
+			if _, err := m.Allowance.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintToken(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Operator) > 0 {
 		i -= len(m.Operator)
 		copy(dAtA[i:], m.Operator)
@@ -525,6 +804,185 @@ func (m *Authorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VestingSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintToken(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintToken(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Lock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Lock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Lock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Holding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Holding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Holding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintToken(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PreviousAdmin) > 0 {
+		i -= len(m.PreviousAdmin)
+		copy(dAtA[i:], m.PreviousAdmin)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.PreviousAdmin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Grant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -577,6 +1035,9 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.MaxBatchSize != 0 {
+		n += 1 + sovToken(uint64(m.MaxBatchSize))
+	}
 	return n
 }
 
@@ -612,6 +1073,14 @@ func (m *Contract) Size() (n int) {
 	if m.Mintable {
 		n += 2
 	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.PendingAdmin)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
 	return n
 }
 
@@ -646,32 +1115,603 @@ func (m *Authorization) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovToken(uint64(l))
 	}
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovToken(uint64(l))
+	}
 	return n
 }
 
-func (m *Grant) Size() (n int) {
+func (m *VestingSchedule) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Grantee)
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovToken(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovToken(uint64(l))
+	return n
+}
+
+func (m *Lock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovToken(uint64(l))
 	}
-	if m.Permission != 0 {
-		n += 1 + sovToken(uint64(m.Permission))
-	}
+	l = m.Amount.Size()
+	n += 1 + l + sovToken(uint64(l))
+	l = m.Schedule.Size()
+	n += 1 + l + sovToken(uint64(l))
 	return n
 }
 
-func sovToken(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *Holding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovToken(uint64(l))
+	return n
 }
-func sozToken(x uint64) (n int) {
-	return sovToken(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *AdminHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PreviousAdmin)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovToken(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovToken(uint64(l))
+	return n
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+
+func (m *Grant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if m.Permission != 0 {
+		n += 1 + sovToken(uint64(m.Permission))
+	}
+	return n
+}
+
+func sovToken(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozToken(x uint64) (n int) {
+	return sovToken(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchSize", wireType)
+			}
+			m.MaxBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBatchSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Contract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Contract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Contract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Meta = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mintable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Mintable = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Attribute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Attribute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Attribute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Authorization) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -681,25 +1721,161 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowToken
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Authorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Authorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v github_com_Finschia_finschia_sdk_types.Int
+			m.Allowance = &v
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipToken(dAtA[iNdEx:])
@@ -721,7 +1897,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Contract) Unmarshal(dAtA []byte) error {
+func (m *VestingSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -744,17 +1920,17 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Contract: wiretype end group for non-group")
+			return fmt.Errorf("proto: VestingSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Contract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VestingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowToken
@@ -764,29 +1940,30 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthToken
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthToken
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowToken
@@ -796,27 +1973,78 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthToken
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthToken
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Lock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Lock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Lock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -844,11 +2072,11 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -876,13 +2104,15 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uri = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowToken
@@ -892,63 +2122,25 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthToken
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthToken
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Meta = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
-			}
-			m.Decimals = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowToken
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Decimals |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mintable", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowToken
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Mintable = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipToken(dAtA[iNdEx:])
@@ -970,7 +2162,7 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Attribute) Unmarshal(dAtA []byte) error {
+func (m *Holding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -993,15 +2185,15 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Attribute: wiretype end group for non-group")
+			return fmt.Errorf("proto: Holding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Attribute: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Holding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1029,11 +2221,11 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1061,7 +2253,9 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1084,7 +2278,7 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Authorization) Unmarshal(dAtA []byte) error {
+func (m *AdminHistoryEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1107,15 +2301,15 @@ func (m *Authorization) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Authorization: wiretype end group for non-group")
+			return fmt.Errorf("proto: AdminHistoryEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Authorization: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AdminHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAdmin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1143,11 +2337,11 @@ func (m *Authorization) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holder = string(dAtA[iNdEx:postIndex])
+			m.PreviousAdmin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1175,7 +2369,59 @@ func (m *Authorization) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -69,6 +69,17 @@ func validateAmount(amount sdk.Int) error {
 	return nil
 }
 
+// validateAllowance checks the optional allowance of an authorization.
+func validateAllowance(allowance *sdk.Int) error {
+	if allowance == nil {
+		return nil
+	}
+	if !allowance.IsPositive() {
+		return ErrInvalidAmount.Wrapf("allowance must be positive: %s", allowance)
+	}
+	return nil
+}
+
 func validateLegacyPermission(permission string) error {
 	return ValidatePermission(Permission(LegacyPermissionFromString(permission)))
 }
@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Finschia/finschia-sdk/client"
+	"github.com/Finschia/finschia-sdk/client/flags"
+	"github.com/Finschia/finschia-sdk/version"
+	"github.com/Finschia/finschia-sdk/x/tokenbridge"
+)
+
+// NewQueryCmd returns the cli query commands for this module
+func NewQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        tokenbridge.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", tokenbridge.ModuleName),
+		Long:                       "",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		NewQueryCmdConversion(),
+		NewQueryCmdConversions(),
+	)
+
+	return queryCmd
+}
+
+func NewQueryCmdConversion() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "conversion [contract-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "query the conversion of a token contract",
+		Example: fmt.Sprintf(`$ %s query %s conversion <contract-id>`, version.AppName, tokenbridge.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := tokenbridge.NewQueryClient(clientCtx)
+			res, err := queryClient.Conversion(cmd.Context(), &tokenbridge.QueryConversionRequest{
+				ContractId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewQueryCmdConversions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "conversions",
+		Args:    cobra.NoArgs,
+		Short:   "query all the conversions",
+		Example: fmt.Sprintf(`$ %s query %s conversions`, version.AppName, tokenbridge.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := tokenbridge.NewQueryClient(clientCtx)
+			res, err := queryClient.Conversions(cmd.Context(), &tokenbridge.QueryConversionsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "conversions")
+	return cmd
+}
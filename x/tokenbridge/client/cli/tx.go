@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Finschia/finschia-sdk/client"
+	"github.com/Finschia/finschia-sdk/client/flags"
+	"github.com/Finschia/finschia-sdk/client/tx"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/version"
+	"github.com/Finschia/finschia-sdk/x/tokenbridge"
+)
+
+// NewTxCmd returns the transaction commands for this module
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        tokenbridge.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", tokenbridge.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewTxCmdEnableConversion(),
+		NewTxCmdConvert(),
+		NewTxCmdUnconvert(),
+	)
+
+	return txCmd
+}
+
+func NewTxCmdEnableConversion() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "enable-conversion [contract-id] [operator]",
+		Args:  cobra.ExactArgs(2),
+		Short: "enable the conversion of the tokens into bank coins",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s enable-conversion <contract-id> <operator>`, version.AppName, tokenbridge.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &tokenbridge.MsgEnableConversion{
+				ContractId: args[0],
+				Operator:   args[1],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdConvert() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert [contract-id] [holder] [amount]",
+		Args:  cobra.ExactArgs(3),
+		Short: "convert tokens into bank coins",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s convert <contract-id> <holder> <amount>`, version.AppName, tokenbridge.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amountStr := args[2]
+			amount, ok := sdk.NewIntFromString(amountStr)
+			if !ok {
+				return sdkerrors.ErrInvalidType.Wrapf("failed to set amount: %s", amountStr)
+			}
+			msg := &tokenbridge.MsgConvert{
+				ContractId: args[0],
+				Holder:     args[1],
+				Amount:     amount,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdUnconvert() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unconvert [contract-id] [holder] [amount]",
+		Args:  cobra.ExactArgs(3),
+		Short: "convert bank coins back into tokens",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s unconvert <contract-id> <holder> <amount>`, version.AppName, tokenbridge.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amountStr := args[2]
+			amount, ok := sdk.NewIntFromString(amountStr)
+			if !ok {
+				return sdkerrors.ErrInvalidType.Wrapf("failed to set amount: %s", amountStr)
+			}
+			msg := &tokenbridge.MsgUnconvert{
+				ContractId: args[0],
+				Holder:     args[1],
+				Amount:     amount,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,13 @@
+package tokenbridge
+
+import (
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+)
+
+const tokenbridgeCodespace = ModuleName
+
+var (
+	ErrConversionNotEnabled     = sdkerrors.Register(tokenbridgeCodespace, 2, "conversion is not enabled")
+	ErrConversionAlreadyEnabled = sdkerrors.Register(tokenbridgeCodespace, 3, "conversion is already enabled")
+	ErrInvalidDenom             = sdkerrors.Register(tokenbridgeCodespace, 4, "invalid denom")
+)
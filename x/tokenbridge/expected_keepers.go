@@ -0,0 +1,37 @@
+package tokenbridge
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	banktypes "github.com/Finschia/finschia-sdk/x/bank/types"
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+type (
+	// AccountKeeper defines the account module interface contract needed by the
+	// tokenbridge module.
+	AccountKeeper interface {
+		GetModuleAddress(moduleName string) sdk.AccAddress
+	}
+
+	// BankKeeper defines the bank module interface contract needed by the
+	// tokenbridge module.
+	BankKeeper interface {
+		MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+		BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+		SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+		SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+
+		GetSupply(ctx sdk.Context, denom string) sdk.Coin
+		SetDenomMetaData(ctx sdk.Context, denomMetaData banktypes.Metadata)
+	}
+
+	// TokenKeeper defines the token module interface contract needed by the
+	// tokenbridge module.
+	TokenKeeper interface {
+		GetClass(ctx sdk.Context, contractID string) (*token.Contract, error)
+		GetGrant(ctx sdk.Context, contractID string, grantee sdk.AccAddress, permission token.Permission) (*token.Grant, error)
+		GetBalance(ctx sdk.Context, contractID string, addr sdk.AccAddress) sdk.Int
+		Send(ctx sdk.Context, contractID string, from, to sdk.AccAddress, amount sdk.Int) error
+		SendEscrowed(ctx sdk.Context, contractID string, escrow, to sdk.AccAddress, amount sdk.Int) error
+	}
+)
@@ -0,0 +1,29 @@
+package tokenbridge
+
+import (
+	"fmt"
+
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+// ValidateGenesis check the given genesis state has no integrity issues
+func ValidateGenesis(data GenesisState) error {
+	seen := map[string]bool{}
+	for _, contractID := range data.ContractIds {
+		if err := token.ValidateContractID(contractID); err != nil {
+			return err
+		}
+
+		if seen[contractID] {
+			return fmt.Errorf("duplicate contract id: %s", contractID)
+		}
+		seen[contractID] = true
+	}
+
+	return nil
+}
+
+// DefaultGenesisState - Return a default genesis state
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
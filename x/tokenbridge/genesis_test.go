@@ -0,0 +1,50 @@
+package tokenbridge_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/x/tokenbridge"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	testCases := map[string]struct {
+		gs    *tokenbridge.GenesisState
+		valid bool
+	}{
+		"default genesis": {
+			tokenbridge.DefaultGenesisState(),
+			true,
+		},
+		"valid genesis": {
+			&tokenbridge.GenesisState{
+				ContractIds: []string{"deadbeef", "fee1dead"},
+			},
+			true,
+		},
+		"invalid contract id": {
+			&tokenbridge.GenesisState{
+				ContractIds: []string{""},
+			},
+			false,
+		},
+		"duplicate contract id": {
+			&tokenbridge.GenesisState{
+				ContractIds: []string{"deadbeef", "deadbeef"},
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tokenbridge.ValidateGenesis(*tc.gs)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
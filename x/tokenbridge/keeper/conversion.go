@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	banktypes "github.com/Finschia/finschia-sdk/x/bank/types"
+	"github.com/Finschia/finschia-sdk/x/token"
+	"github.com/Finschia/finschia-sdk/x/tokenbridge"
+)
+
+// EnableConversion enables the conversion of the tokens of the contract into
+// bank coins, and registers the metadata of the bank denom.
+func (k Keeper) EnableConversion(ctx sdk.Context, contractID string, operator sdk.AccAddress) error {
+	class, err := k.tokenKeeper.GetClass(ctx, contractID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := k.tokenKeeper.GetGrant(ctx, contractID, operator, token.PermissionModify); err != nil {
+		return token.ErrTokenNoPermission.Wrap(err.Error())
+	}
+
+	if k.IsConversionEnabled(ctx, contractID) {
+		return tokenbridge.ErrConversionAlreadyEnabled.Wrap(contractID)
+	}
+
+	k.setConversionEnabled(ctx, contractID)
+	k.bankKeeper.SetDenomMetaData(ctx, denomMetadata(*class))
+
+	return nil
+}
+
+// denomMetadata returns the bank metadata of the denom converted from the tokens.
+func denomMetadata(class token.Contract) banktypes.Metadata {
+	base := tokenbridge.DenomFromContractID(class.Id)
+	units := []*banktypes.DenomUnit{
+		{
+			Denom:    base,
+			Exponent: 0,
+		},
+	}
+
+	display := base
+	if class.Decimals > 0 {
+		display = fmt.Sprintf("%s/%s", base, class.Symbol)
+		units = append(units, &banktypes.DenomUnit{
+			Denom:    display,
+			Exponent: uint32(class.Decimals),
+			Aliases:  []string{class.Symbol},
+		})
+	}
+
+	return banktypes.Metadata{
+		Description: class.Meta,
+		DenomUnits:  units,
+		Base:        base,
+		Display:     display,
+		Name:        class.Name,
+		Symbol:      class.Symbol,
+	}
+}
+
+// Convert escrows the tokens of the holder in x/token, and mints the same
+// amount of bank coins to the holder.
+func (k Keeper) Convert(ctx sdk.Context, contractID string, holder sdk.AccAddress, amount sdk.Int) error {
+	if !k.IsConversionEnabled(ctx, contractID) {
+		return tokenbridge.ErrConversionNotEnabled.Wrap(contractID)
+	}
+
+	if err := k.tokenKeeper.Send(ctx, contractID, holder, k.escrowAddress(), amount); err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(tokenbridge.DenomFromContractID(contractID), amount))
+	if err := k.bankKeeper.MintCoins(ctx, tokenbridge.ModuleName, coins); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, tokenbridge.ModuleName, holder, coins); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Unconvert burns the bank coins of the holder, and releases the same amount
+// of the escrowed tokens to the holder.
+func (k Keeper) Unconvert(ctx sdk.Context, contractID string, holder sdk.AccAddress, amount sdk.Int) error {
+	if !k.IsConversionEnabled(ctx, contractID) {
+		return tokenbridge.ErrConversionNotEnabled.Wrap(contractID)
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(tokenbridge.DenomFromContractID(contractID), amount))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, holder, tokenbridge.ModuleName, coins); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, tokenbridge.ModuleName, coins); err != nil {
+		return err
+	}
+
+	// the escrowed tokens are released even if the contract is paused or the
+	// escrow is frozen, or the holders could not redeem their coins.
+	if err := k.tokenKeeper.SendEscrowed(ctx, contractID, k.escrowAddress(), holder, amount); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetEscrowed returns the amount of the tokens escrowed for the conversion.
+func (k Keeper) GetEscrowed(ctx sdk.Context, contractID string) sdk.Int {
+	return k.tokenKeeper.GetBalance(ctx, contractID, k.escrowAddress())
+}
+
+// IsConversionEnabled returns whether the conversion of the contract is enabled or not.
+func (k Keeper) IsConversionEnabled(ctx sdk.Context, contractID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(conversionKey(contractID))
+}
+
+func (k Keeper) setConversionEnabled(ctx sdk.Context, contractID string) {
+	if err := token.ValidateContractID(contractID); err != nil {
+		panic(sdkerrors.ErrInvalidRequest.Wrap(err.Error()))
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(conversionKey(contractID), []byte{})
+}
+
+func (k Keeper) iterateConversions(ctx sdk.Context, fn func(contractID string) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, conversionKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		contractID := splitConversionKey(iterator.Key())
+
+		if fn(contractID) {
+			break
+		}
+	}
+}
+
+func newConversion(contractID string) tokenbridge.Conversion {
+	return tokenbridge.Conversion{
+		ContractId: contractID,
+		Denom:      tokenbridge.DenomFromContractID(contractID),
+	}
+}
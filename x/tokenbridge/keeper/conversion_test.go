@@ -0,0 +1,168 @@
+package keeper_test
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
+	"github.com/Finschia/finschia-sdk/x/token"
+	"github.com/Finschia/finschia-sdk/x/tokenbridge"
+)
+
+func (s *KeeperTestSuite) TestEnableConversion() {
+	testCases := map[string]struct {
+		contractID string
+		operator   sdk.AccAddress
+		err        error
+	}{
+		"valid request": {
+			contractID: s.newContractID,
+			operator:   s.vendor,
+		},
+		"contract not found": {
+			contractID: "fee1dead",
+			operator:   s.vendor,
+			err:        token.ErrTokenNotExist,
+		},
+		"no permission": {
+			contractID: s.newContractID,
+			operator:   s.stranger,
+			err:        token.ErrTokenNoPermission,
+		},
+		"already enabled": {
+			contractID: s.contractID,
+			operator:   s.vendor,
+			err:        tokenbridge.ErrConversionAlreadyEnabled,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			err := s.keeper.EnableConversion(ctx, tc.contractID, tc.operator)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().True(s.keeper.IsConversionEnabled(ctx, tc.contractID))
+
+			denom := tokenbridge.DenomFromContractID(tc.contractID)
+			metadata, found := s.bankKeeper.GetDenomMetaData(ctx, denom)
+			s.Require().True(found)
+			s.Require().NoError(metadata.Validate())
+			s.Require().Equal(denom, metadata.Base)
+			s.Require().Equal("CVT", metadata.Symbol)
+			s.Require().Len(metadata.DenomUnits, 2)
+			s.Require().EqualValues(6, metadata.DenomUnits[1].Exponent)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestConvert() {
+	testCases := map[string]struct {
+		contractID string
+		amount     sdk.Int
+		err        error
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			amount:     s.balance.QuoRaw(2),
+		},
+		"not enabled": {
+			contractID: s.newContractID,
+			amount:     sdk.OneInt(),
+			err:        tokenbridge.ErrConversionNotEnabled,
+		},
+		"insufficient funds": {
+			contractID: s.contractID,
+			amount:     s.balance,
+			err:        token.ErrInsufficientBalance,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			denom := tokenbridge.DenomFromContractID(tc.contractID)
+			prevTokens := s.tokenKeeper.GetBalance(ctx, tc.contractID, s.customer)
+			prevCoins := s.bankKeeper.GetBalance(ctx, s.customer, denom).Amount
+
+			err := s.keeper.Convert(ctx, tc.contractID, s.customer, tc.amount)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().Equal(prevTokens.Sub(tc.amount).String(), s.tokenKeeper.GetBalance(ctx, tc.contractID, s.customer).String())
+			s.Require().Equal(prevCoins.Add(tc.amount).String(), s.bankKeeper.GetBalance(ctx, s.customer, denom).Amount.String())
+			s.Require().Equal(s.keeper.GetEscrowed(ctx, tc.contractID).String(), s.bankKeeper.GetSupply(ctx, denom).Amount.String())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUnconvert() {
+	testCases := map[string]struct {
+		contractID   string
+		amount       sdk.Int
+		paused       bool
+		escrowFrozen bool
+		err          error
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			amount:     s.balance.QuoRaw(2),
+		},
+		"valid request (paused)": {
+			contractID: s.contractID,
+			amount:     s.balance.QuoRaw(2),
+			paused:     true,
+		},
+		"valid request (escrow frozen)": {
+			contractID:   s.contractID,
+			amount:       s.balance.QuoRaw(2),
+			escrowFrozen: true,
+		},
+		"not enabled": {
+			contractID: s.newContractID,
+			amount:     sdk.OneInt(),
+			err:        tokenbridge.ErrConversionNotEnabled,
+		},
+		"insufficient funds": {
+			contractID: s.contractID,
+			amount:     s.balance,
+			err:        sdkerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			if tc.paused {
+				err := s.tokenKeeper.Pause(ctx, tc.contractID, s.vendor)
+				s.Require().NoError(err)
+			}
+			if tc.escrowFrozen {
+				escrow := authtypes.NewModuleAddress(tokenbridge.ModuleName)
+				err := s.tokenKeeper.Freeze(ctx, tc.contractID, s.vendor, escrow)
+				s.Require().NoError(err)
+			}
+
+			denom := tokenbridge.DenomFromContractID(tc.contractID)
+			prevTokens := s.tokenKeeper.GetBalance(ctx, tc.contractID, s.customer)
+			prevCoins := s.bankKeeper.GetBalance(ctx, s.customer, denom).Amount
+
+			err := s.keeper.Unconvert(ctx, tc.contractID, s.customer, tc.amount)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().Equal(prevTokens.Add(tc.amount).String(), s.tokenKeeper.GetBalance(ctx, tc.contractID, s.customer).String())
+			s.Require().Equal(prevCoins.Sub(tc.amount).String(), s.bankKeeper.GetBalance(ctx, s.customer, denom).Amount.String())
+			s.Require().Equal(s.keeper.GetEscrowed(ctx, tc.contractID).String(), s.bankKeeper.GetSupply(ctx, denom).Amount.String())
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/tokenbridge"
+)
+
+// InitGenesis new tokenbridge genesis
+func (k Keeper) InitGenesis(ctx sdk.Context, data *tokenbridge.GenesisState) {
+	for _, contractID := range data.ContractIds {
+		k.setConversionEnabled(ctx, contractID)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *tokenbridge.GenesisState {
+	var contractIDs []string
+	k.iterateConversions(ctx, func(contractID string) (stop bool) {
+		contractIDs = append(contractIDs, contractID)
+		return false
+	})
+
+	return &tokenbridge.GenesisState{
+		ContractIds: contractIDs,
+	}
+}
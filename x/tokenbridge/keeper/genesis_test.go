@@ -0,0 +1,22 @@
+package keeper_test
+
+import (
+	"github.com/Finschia/finschia-sdk/x/tokenbridge"
+)
+
+func (s *KeeperTestSuite) TestImportExportGenesis() {
+	// export
+	genesis := s.keeper.ExportGenesis(s.ctx)
+	s.Require().Equal([]string{s.contractID}, genesis.ContractIds)
+
+	// restore
+	ctx, _ := s.ctx.CacheContext()
+	s.keeper.InitGenesis(ctx, genesis)
+
+	// export again and compare
+	newGenesis := s.keeper.ExportGenesis(ctx)
+	s.Require().Equal(genesis, newGenesis)
+
+	// empty state
+	s.keeper.InitGenesis(ctx, &tokenbridge.GenesisState{})
+}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Finschia/finschia-sdk/store/prefix"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/query"
+	"github.com/Finschia/finschia-sdk/x/token"
+	"github.com/Finschia/finschia-sdk/x/tokenbridge"
+)
+
+type queryServer struct {
+	keeper Keeper
+}
+
+// NewQueryServer returns an implementation of the tokenbridge QueryServer interface
+// for the provided Keeper.
+func NewQueryServer(keeper Keeper) tokenbridge.QueryServer {
+	return &queryServer{
+		keeper: keeper,
+	}
+}
+
+var _ tokenbridge.QueryServer = queryServer{}
+
+// Conversion queries the conversion of a token contract.
+func (s queryServer) Conversion(c context.Context, req *tokenbridge.QueryConversionRequest) (*tokenbridge.QueryConversionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := token.ValidateContractID(req.ContractId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if !s.keeper.IsConversionEnabled(ctx, req.ContractId) {
+		return nil, status.Error(codes.NotFound, tokenbridge.ErrConversionNotEnabled.Wrap(req.ContractId).Error())
+	}
+
+	return &tokenbridge.QueryConversionResponse{Conversion: newConversion(req.ContractId)}, nil
+}
+
+// Conversions queries all the conversions.
+func (s queryServer) Conversions(c context.Context, req *tokenbridge.QueryConversionsRequest) (*tokenbridge.QueryConversionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	conversionStore := prefix.NewStore(store, conversionKeyPrefix)
+	var conversions []tokenbridge.Conversion
+	pageRes, err := query.Paginate(conversionStore, req.Pagination, func(key []byte, _ []byte) error {
+		conversions = append(conversions, newConversion(string(key)))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &tokenbridge.QueryConversionsResponse{Conversions: conversions, Pagination: pageRes}, nil
+}
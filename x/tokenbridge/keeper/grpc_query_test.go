@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"github.com/Finschia/finschia-sdk/types/query"
+	"github.com/Finschia/finschia-sdk/x/tokenbridge"
+)
+
+func (s *KeeperTestSuite) TestQueryConversion() {
+	testCases := map[string]struct {
+		contractID string
+		valid      bool
+		postTest   func(res *tokenbridge.QueryConversionResponse)
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			valid:      true,
+			postTest: func(res *tokenbridge.QueryConversionResponse) {
+				s.Require().Equal(s.contractID, res.Conversion.ContractId)
+				s.Require().Equal(tokenbridge.DenomFromContractID(s.contractID), res.Conversion.Denom)
+			},
+		},
+		"invalid contract id": {},
+		"not enabled": {
+			contractID: s.newContractID,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			req := &tokenbridge.QueryConversionRequest{
+				ContractId: tc.contractID,
+			}
+			res, err := s.queryServer.Conversion(s.goCtx, req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryConversions() {
+	err := s.keeper.EnableConversion(s.ctx, s.newContractID, s.vendor)
+	s.Require().NoError(err)
+
+	testCases := map[string]struct {
+		pagination *query.PageRequest
+		postTest   func(res *tokenbridge.QueryConversionsResponse)
+	}{
+		"valid request": {
+			postTest: func(res *tokenbridge.QueryConversionsResponse) {
+				s.Require().Len(res.Conversions, 2)
+			},
+		},
+		"valid request with limit": {
+			pagination: &query.PageRequest{
+				Limit: 1,
+			},
+			postTest: func(res *tokenbridge.QueryConversionsResponse) {
+				s.Require().Len(res.Conversions, 1)
+				s.Require().NotNil(res.Pagination.NextKey)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			req := &tokenbridge.QueryConversionsRequest{
+				Pagination: tc.pagination,
+			}
+			res, err := s.queryServer.Conversions(s.goCtx, req)
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/tokenbridge"
+)
+
+// RegisterInvariants registers the tokenbridge module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(tokenbridge.ModuleName, "escrowed-supply", EscrowedSupplyInvariant(k))
+}
+
+// EscrowedSupplyInvariant checks that the supply of every converted bank denom
+// is backed by the tokens escrowed in x/token. The escrow may hold more than
+// the supply, because anyone can send tokens to the escrow address directly.
+func EscrowedSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		k.iterateConversions(ctx, func(contractID string) (stop bool) {
+			supply := k.bankKeeper.GetSupply(ctx, tokenbridge.DenomFromContractID(contractID))
+			escrowed := k.GetEscrowed(ctx, contractID)
+			if supply.Amount.GT(escrowed) {
+				count++
+				msg += fmt.Sprintf("\tsupply %s exceeds escrowed tokens %s of %s\n", supply, escrowed, contractID)
+			}
+
+			return false
+		})
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			tokenbridge.ModuleName, "escrowed-supply",
+			fmt.Sprintf("amount of unbacked denoms found %d\n%s", count, msg),
+		), broken
+	}
+}
@@ -0,0 +1,46 @@
+package keeper_test
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
+	"github.com/Finschia/finschia-sdk/x/tokenbridge"
+	"github.com/Finschia/finschia-sdk/x/tokenbridge/keeper"
+)
+
+func (s *KeeperTestSuite) TestEscrowedSupplyInvariant() {
+	testCases := map[string]struct {
+		malleate func(ctx sdk.Context)
+		broken   bool
+	}{
+		"valid": {
+			malleate: func(ctx sdk.Context) {},
+		},
+		"donation to the escrow": {
+			malleate: func(ctx sdk.Context) {
+				escrow := authtypes.NewModuleAddress(tokenbridge.ModuleName)
+				err := s.tokenKeeper.Send(ctx, s.contractID, s.customer, escrow, sdk.OneInt())
+				s.Require().NoError(err)
+			},
+		},
+		"unbacked supply": {
+			malleate: func(ctx sdk.Context) {
+				coins := sdk.NewCoins(sdk.NewCoin(tokenbridge.DenomFromContractID(s.contractID), sdk.OneInt()))
+				err := s.bankKeeper.MintCoins(ctx, tokenbridge.ModuleName, coins)
+				s.Require().NoError(err)
+			},
+			broken: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			tc.malleate(ctx)
+
+			invariant := keeper.EscrowedSupplyInvariant(s.keeper)
+			_, broken := invariant(ctx)
+			s.Require().Equal(tc.broken, broken)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"github.com/Finschia/finschia-sdk/codec"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/tokenbridge"
+)
+
+// Keeper defines the tokenbridge module Keeper
+type Keeper struct {
+	accountKeeper tokenbridge.AccountKeeper
+	bankKeeper    tokenbridge.BankKeeper
+	tokenKeeper   tokenbridge.TokenKeeper
+
+	// The (unexposed) keys used to access the stores from the Context.
+	storeKey sdk.StoreKey
+
+	// The codec for binary encoding/decoding.
+	cdc codec.Codec
+}
+
+// NewKeeper returns a tokenbridge keeper
+func NewKeeper(
+	cdc codec.Codec,
+	key sdk.StoreKey,
+	ak tokenbridge.AccountKeeper,
+	bk tokenbridge.BankKeeper,
+	tk tokenbridge.TokenKeeper,
+) Keeper {
+	// ensure the module account is set
+	if addr := ak.GetModuleAddress(tokenbridge.ModuleName); addr == nil {
+		panic("the tokenbridge module account has not been set")
+	}
+
+	return Keeper{
+		accountKeeper: ak,
+		bankKeeper:    bk,
+		tokenKeeper:   tk,
+		storeKey:      key,
+		cdc:           cdc,
+	}
+}
+
+// escrowAddress returns the address which escrows the converted tokens in x/token.
+func (k Keeper) escrowAddress() sdk.AccAddress {
+	return k.accountKeeper.GetModuleAddress(tokenbridge.ModuleName)
+}
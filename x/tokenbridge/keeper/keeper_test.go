@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/Finschia/finschia-sdk/crypto/keys/secp256k1"
+	"github.com/Finschia/finschia-sdk/simapp"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	bankkeeper "github.com/Finschia/finschia-sdk/x/bank/keeper"
+	"github.com/Finschia/finschia-sdk/x/token"
+	tokenkeeper "github.com/Finschia/finschia-sdk/x/token/keeper"
+	"github.com/Finschia/finschia-sdk/x/tokenbridge"
+	"github.com/Finschia/finschia-sdk/x/tokenbridge/keeper"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	goCtx       context.Context
+	keeper      keeper.Keeper
+	queryServer tokenbridge.QueryServer
+	msgServer   tokenbridge.MsgServer
+
+	bankKeeper  bankkeeper.Keeper
+	tokenKeeper tokenkeeper.Keeper
+
+	vendor   sdk.AccAddress
+	customer sdk.AccAddress
+	stranger sdk.AccAddress
+
+	contractID    string
+	newContractID string
+
+	balance sdk.Int
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+	s.ctx = app.BaseApp.NewContext(checkTx, tmproto.Header{})
+	s.goCtx = sdk.WrapSDKContext(s.ctx)
+	s.keeper = app.TokenBridgeKeeper
+	s.bankKeeper = app.BankKeeper
+	s.tokenKeeper = app.TokenKeeper
+
+	s.queryServer = keeper.NewQueryServer(s.keeper)
+	s.msgServer = keeper.NewMsgServer(s.keeper)
+
+	for _, address := range []*sdk.AccAddress{
+		&s.vendor,
+		&s.customer,
+		&s.stranger,
+	} {
+		*address = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	s.balance = sdk.NewInt(1000)
+
+	class := token.Contract{
+		Name:     "Convertible",
+		Symbol:   "CVT",
+		Meta:     "convertible token",
+		Decimals: 6,
+		Mintable: true,
+	}
+	s.contractID = s.tokenKeeper.Issue(s.ctx, class, s.vendor, s.vendor, s.balance)
+	err := s.tokenKeeper.Mint(s.ctx, s.contractID, s.vendor, s.customer, s.balance)
+	s.Require().NoError(err)
+
+	// enable the conversion of the contract
+	err = s.keeper.EnableConversion(s.ctx, s.contractID, s.vendor)
+	s.Require().NoError(err)
+
+	// convert a half of the customer's tokens
+	err = s.keeper.Convert(s.ctx, s.contractID, s.customer, s.balance.QuoRaw(2))
+	s.Require().NoError(err)
+
+	// create another class, which is not converted yet
+	s.newContractID = s.tokenKeeper.Issue(s.ctx, class, s.vendor, s.customer, s.balance)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
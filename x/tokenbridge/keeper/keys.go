@@ -0,0 +1,17 @@
+package keeper
+
+var (
+	conversionKeyPrefix = []byte{0x00}
+)
+
+func conversionKey(contractID string) []byte {
+	key := make([]byte, len(conversionKeyPrefix)+len(contractID))
+	copy(key, conversionKeyPrefix)
+	copy(key[len(conversionKeyPrefix):], contractID)
+
+	return key
+}
+
+func splitConversionKey(key []byte) (contractID string) {
+	return string(key[len(conversionKeyPrefix):])
+}
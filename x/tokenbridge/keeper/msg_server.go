@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/token"
+	"github.com/Finschia/finschia-sdk/x/tokenbridge"
+)
+
+type msgServer struct {
+	keeper Keeper
+}
+
+// NewMsgServer returns an implementation of the tokenbridge MsgServer interface
+// for the provided Keeper.
+func NewMsgServer(keeper Keeper) tokenbridge.MsgServer {
+	return &msgServer{
+		keeper: keeper,
+	}
+}
+
+var _ tokenbridge.MsgServer = msgServer{}
+
+// EnableConversion enables the conversion of the tokens of a contract into bank coins
+func (s msgServer) EnableConversion(c context.Context, req *tokenbridge.MsgEnableConversion) (*tokenbridge.MsgEnableConversionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	operator := sdk.MustAccAddressFromBech32(req.Operator)
+
+	if err := s.keeper.EnableConversion(ctx, req.ContractId, operator); err != nil {
+		return nil, err
+	}
+
+	event := tokenbridge.EventEnabledConversion{
+		ContractId: req.ContractId,
+		Operator:   req.Operator,
+		Denom:      tokenbridge.DenomFromContractID(req.ContractId),
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+
+	return &tokenbridge.MsgEnableConversionResponse{}, nil
+}
+
+// Convert converts tokens into bank coins
+func (s msgServer) Convert(c context.Context, req *tokenbridge.MsgConvert) (*tokenbridge.MsgConvertResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	holder := sdk.MustAccAddressFromBech32(req.Holder)
+
+	if err := s.keeper.Convert(ctx, req.ContractId, holder, req.Amount); err != nil {
+		return nil, err
+	}
+
+	sent := token.EventSent{
+		ContractId: req.ContractId,
+		Operator:   req.Holder,
+		From:       req.Holder,
+		To:         s.keeper.escrowAddress().String(),
+		Amount:     req.Amount,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&sent); err != nil {
+		panic(err)
+	}
+
+	event := tokenbridge.EventConverted{
+		ContractId: req.ContractId,
+		Holder:     req.Holder,
+		Amount:     req.Amount,
+		Denom:      tokenbridge.DenomFromContractID(req.ContractId),
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+
+	return &tokenbridge.MsgConvertResponse{}, nil
+}
+
+// Unconvert converts bank coins back into tokens
+func (s msgServer) Unconvert(c context.Context, req *tokenbridge.MsgUnconvert) (*tokenbridge.MsgUnconvertResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	holder := sdk.MustAccAddressFromBech32(req.Holder)
+
+	if err := s.keeper.Unconvert(ctx, req.ContractId, holder, req.Amount); err != nil {
+		return nil, err
+	}
+
+	sent := token.EventSent{
+		ContractId: req.ContractId,
+		Operator:   req.Holder,
+		From:       s.keeper.escrowAddress().String(),
+		To:         req.Holder,
+		Amount:     req.Amount,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&sent); err != nil {
+		panic(err)
+	}
+
+	event := tokenbridge.EventUnconverted{
+		ContractId: req.ContractId,
+		Holder:     req.Holder,
+		Amount:     req.Amount,
+		Denom:      tokenbridge.DenomFromContractID(req.ContractId),
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+
+	return &tokenbridge.MsgUnconvertResponse{}, nil
+}
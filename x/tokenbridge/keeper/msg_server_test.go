@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/token"
+	"github.com/Finschia/finschia-sdk/x/tokenbridge"
+)
+
+func (s *KeeperTestSuite) TestMsgEnableConversion() {
+	testCases := map[string]struct {
+		contractID string
+		err        error
+	}{
+		"valid request": {
+			contractID: s.newContractID,
+		},
+		"already enabled": {
+			contractID: s.contractID,
+			err:        tokenbridge.ErrConversionAlreadyEnabled,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			req := &tokenbridge.MsgEnableConversion{
+				ContractId: tc.contractID,
+				Operator:   s.vendor.String(),
+			}
+			res, err := s.msgServer.EnableConversion(sdk.WrapSDKContext(ctx), req)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().NotNil(res)
+
+			events := ctx.EventManager().Events()
+			s.Require().Len(events, 1)
+			s.Require().Equal("lbm.tokenbridge.v1.EventEnabledConversion", events[0].Type)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgConvert() {
+	testCases := map[string]struct {
+		contractID string
+		amount     sdk.Int
+		err        error
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			amount:     s.balance.QuoRaw(2),
+		},
+		"not enabled": {
+			contractID: s.newContractID,
+			amount:     sdk.OneInt(),
+			err:        tokenbridge.ErrConversionNotEnabled,
+		},
+		"insufficient funds": {
+			contractID: s.contractID,
+			amount:     s.balance,
+			err:        token.ErrInsufficientBalance,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			req := &tokenbridge.MsgConvert{
+				ContractId: tc.contractID,
+				Holder:     s.customer.String(),
+				Amount:     tc.amount,
+			}
+			res, err := s.msgServer.Convert(sdk.WrapSDKContext(ctx), req)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().NotNil(res)
+
+			var types []string
+			for _, event := range ctx.EventManager().Events() {
+				types = append(types, event.Type)
+			}
+			s.Require().Contains(types, "lbm.token.v1.EventSent")
+			s.Require().Contains(types, "lbm.tokenbridge.v1.EventConverted")
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgUnconvert() {
+	testCases := map[string]struct {
+		contractID string
+		amount     sdk.Int
+		err        error
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			amount:     s.balance.QuoRaw(2),
+		},
+		"not enabled": {
+			contractID: s.newContractID,
+			amount:     sdk.OneInt(),
+			err:        tokenbridge.ErrConversionNotEnabled,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			req := &tokenbridge.MsgUnconvert{
+				ContractId: tc.contractID,
+				Holder:     s.customer.String(),
+				Amount:     tc.amount,
+			}
+			res, err := s.msgServer.Unconvert(sdk.WrapSDKContext(ctx), req)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().NotNil(res)
+
+			var types []string
+			for _, event := range ctx.EventManager().Events() {
+				types = append(types, event.Type)
+			}
+			s.Require().Contains(types, "lbm.token.v1.EventSent")
+			s.Require().Contains(types, "lbm.tokenbridge.v1.EventUnconverted")
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package tokenbridge
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "tokenbridge"
+
+	// StoreKey defines the primary module store key
+	// NOTE: it must not share a prefix with the store key of x/token.
+	StoreKey = "tkbridge"
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// DenomPrefix is the prefix of the bank denoms converted from the tokens.
+	DenomPrefix = "token/"
+)
+
+// DenomFromContractID returns the bank denom of the tokens of the contract.
+func DenomFromContractID(contractID string) string {
+	return fmt.Sprintf("%s%s", DenomPrefix, contractID)
+}
+
+// ContractIDFromDenom returns the contract id of the bank denom converted from the tokens.
+func ContractIDFromDenom(denom string) (string, error) {
+	if !strings.HasPrefix(denom, DenomPrefix) {
+		return "", ErrInvalidDenom.Wrap(denom)
+	}
+
+	return strings.TrimPrefix(denom, DenomPrefix), nil
+}
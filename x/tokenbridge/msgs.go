@@ -0,0 +1,122 @@
+package tokenbridge
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+func validateAmount(amount sdk.Int) error {
+	if !amount.IsPositive() {
+		return token.ErrInvalidAmount.Wrapf("amount must be positive: %s", amount)
+	}
+	return nil
+}
+
+var _ sdk.Msg = (*MsgEnableConversion)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgEnableConversion) ValidateBasic() error {
+	if err := token.ValidateContractID(m.ContractId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address: %s", m.Operator)
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgEnableConversion) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Operator)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgEnableConversion) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgEnableConversion) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgEnableConversion) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgConvert)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgConvert) ValidateBasic() error {
+	if err := token.ValidateContractID(m.ContractId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Holder); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid holder address: %s", m.Holder)
+	}
+
+	return validateAmount(m.Amount)
+}
+
+// GetSigners implements Msg
+func (m MsgConvert) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Holder)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgConvert) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgConvert) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgConvert) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgUnconvert)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgUnconvert) ValidateBasic() error {
+	if err := token.ValidateContractID(m.ContractId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Holder); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid holder address: %s", m.Holder)
+	}
+
+	return validateAmount(m.Amount)
+}
+
+// GetSigners implements Msg
+func (m MsgUnconvert) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Holder)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgUnconvert) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgUnconvert) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgUnconvert) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
@@ -0,0 +1,156 @@
+package tokenbridge_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/crypto/keys/secp256k1"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/token"
+	"github.com/Finschia/finschia-sdk/x/token/class"
+	"github.com/Finschia/finschia-sdk/x/tokenbridge"
+)
+
+func TestMsgEnableConversion(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	testCases := map[string]struct {
+		contractID string
+		operator   sdk.AccAddress
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			operator:   addr,
+		},
+		"invalid contract id": {
+			operator: addr,
+			err:      class.ErrInvalidContractID,
+		},
+		"invalid operator": {
+			contractID: "deadbeef",
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := tokenbridge.MsgEnableConversion{
+				ContractId: tc.contractID,
+				Operator:   tc.operator.String(),
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.operator}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgConvert(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	testCases := map[string]struct {
+		contractID string
+		holder     sdk.AccAddress
+		amount     sdk.Int
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			holder:     addr,
+			amount:     sdk.OneInt(),
+		},
+		"invalid contract id": {
+			holder: addr,
+			amount: sdk.OneInt(),
+			err:    class.ErrInvalidContractID,
+		},
+		"invalid holder": {
+			contractID: "deadbeef",
+			amount:     sdk.OneInt(),
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"invalid amount": {
+			contractID: "deadbeef",
+			holder:     addr,
+			amount:     sdk.ZeroInt(),
+			err:        token.ErrInvalidAmount,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := tokenbridge.MsgConvert{
+				ContractId: tc.contractID,
+				Holder:     tc.holder.String(),
+				Amount:     tc.amount,
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.holder}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgUnconvert(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	testCases := map[string]struct {
+		contractID string
+		holder     sdk.AccAddress
+		amount     sdk.Int
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			holder:     addr,
+			amount:     sdk.OneInt(),
+		},
+		"invalid contract id": {
+			holder: addr,
+			amount: sdk.OneInt(),
+			err:    class.ErrInvalidContractID,
+		},
+		"invalid holder": {
+			contractID: "deadbeef",
+			amount:     sdk.OneInt(),
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"invalid amount": {
+			contractID: "deadbeef",
+			holder:     addr,
+			amount:     sdk.ZeroInt(),
+			err:        token.ErrInvalidAmount,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := tokenbridge.MsgUnconvert{
+				ContractId: tc.contractID,
+				Holder:     tc.holder.String(),
+				Amount:     tc.amount,
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.holder}, msg.GetSigners())
+		})
+	}
+}
@@ -0,0 +1,1071 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lbm/tokenbridge/v1/query.proto
+
+package tokenbridge
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/Finschia/finschia-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryConversionRequest is the request type for the Query/Conversion RPC method.
+type QueryConversionRequest struct {
+	// contract id associated with the token contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+}
+
+func (m *QueryConversionRequest) Reset()         { *m = QueryConversionRequest{} }
+func (m *QueryConversionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConversionRequest) ProtoMessage()    {}
+func (*QueryConversionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61c6bb0a3cf9ef5e, []int{0}
+}
+func (m *QueryConversionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConversionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConversionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConversionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConversionRequest.Merge(m, src)
+}
+func (m *QueryConversionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConversionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConversionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConversionRequest proto.InternalMessageInfo
+
+func (m *QueryConversionRequest) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+// QueryConversionResponse is the response type for the Query/Conversion RPC method.
+type QueryConversionResponse struct {
+	// conversion of the token contract.
+	Conversion Conversion `protobuf:"bytes,1,opt,name=conversion,proto3" json:"conversion"`
+}
+
+func (m *QueryConversionResponse) Reset()         { *m = QueryConversionResponse{} }
+func (m *QueryConversionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConversionResponse) ProtoMessage()    {}
+func (*QueryConversionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61c6bb0a3cf9ef5e, []int{1}
+}
+func (m *QueryConversionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConversionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConversionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConversionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConversionResponse.Merge(m, src)
+}
+func (m *QueryConversionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConversionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConversionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConversionResponse proto.InternalMessageInfo
+
+func (m *QueryConversionResponse) GetConversion() Conversion {
+	if m != nil {
+		return m.Conversion
+	}
+	return Conversion{}
+}
+
+// QueryConversionsRequest is the request type for the Query/Conversions RPC method.
+type QueryConversionsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryConversionsRequest) Reset()         { *m = QueryConversionsRequest{} }
+func (m *QueryConversionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConversionsRequest) ProtoMessage()    {}
+func (*QueryConversionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61c6bb0a3cf9ef5e, []int{2}
+}
+func (m *QueryConversionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConversionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConversionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConversionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConversionsRequest.Merge(m, src)
+}
+func (m *QueryConversionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConversionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConversionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConversionsRequest proto.InternalMessageInfo
+
+func (m *QueryConversionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryConversionsResponse is the response type for the Query/Conversions RPC method.
+type QueryConversionsResponse struct {
+	// conversions are the conversions enabled.
+	Conversions []Conversion `protobuf:"bytes,1,rep,name=conversions,proto3" json:"conversions"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryConversionsResponse) Reset()         { *m = QueryConversionsResponse{} }
+func (m *QueryConversionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConversionsResponse) ProtoMessage()    {}
+func (*QueryConversionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61c6bb0a3cf9ef5e, []int{3}
+}
+func (m *QueryConversionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConversionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConversionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConversionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConversionsResponse.Merge(m, src)
+}
+func (m *QueryConversionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConversionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConversionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConversionsResponse proto.InternalMessageInfo
+
+func (m *QueryConversionsResponse) GetConversions() []Conversion {
+	if m != nil {
+		return m.Conversions
+	}
+	return nil
+}
+
+func (m *QueryConversionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryConversionRequest)(nil), "lbm.tokenbridge.v1.QueryConversionRequest")
+	proto.RegisterType((*QueryConversionResponse)(nil), "lbm.tokenbridge.v1.QueryConversionResponse")
+	proto.RegisterType((*QueryConversionsRequest)(nil), "lbm.tokenbridge.v1.QueryConversionsRequest")
+	proto.RegisterType((*QueryConversionsResponse)(nil), "lbm.tokenbridge.v1.QueryConversionsResponse")
+}
+
+func init() { proto.RegisterFile("lbm/tokenbridge/v1/query.proto", fileDescriptor_61c6bb0a3cf9ef5e) }
+
+var fileDescriptor_61c6bb0a3cf9ef5e = []byte{
+	// 444 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x8b, 0x13, 0x31,
+	0x18, 0xc6, 0x9b, 0xfa, 0x07, 0x4c, 0x6f, 0x41, 0xb4, 0x14, 0x49, 0xd7, 0x41, 0x5c, 0x59, 0xdd,
+	0x84, 0xae, 0x78, 0xf0, 0xba, 0x4a, 0xd5, 0x9b, 0xf6, 0xe8, 0x65, 0xc9, 0x4c, 0x63, 0x36, 0x6c,
+	0x9b, 0xb7, 0x3b, 0x49, 0x07, 0x45, 0xbc, 0xf8, 0x09, 0x14, 0xaf, 0x7e, 0x03, 0xbf, 0x81, 0x9f,
+	0x60, 0x8f, 0x0b, 0x5e, 0x3c, 0x89, 0xb4, 0x7e, 0x10, 0x69, 0x26, 0x63, 0x23, 0x33, 0xb0, 0x73,
+	0x0b, 0xef, 0xfb, 0x3e, 0xf3, 0xfc, 0xde, 0x27, 0x19, 0x4c, 0x67, 0xe9, 0x9c, 0x3b, 0x38, 0x91,
+	0x26, 0xcd, 0xf5, 0x54, 0x49, 0x5e, 0x8c, 0xf8, 0xe9, 0x52, 0xe6, 0xef, 0xd8, 0x22, 0x07, 0x07,
+	0x84, 0xcc, 0xd2, 0x39, 0x8b, 0xfa, 0xac, 0x18, 0x0d, 0xf6, 0x32, 0xb0, 0x73, 0xb0, 0x3c, 0x15,
+	0x56, 0x96, 0xc3, 0xbc, 0x18, 0xa5, 0xd2, 0x89, 0x11, 0x5f, 0x08, 0xa5, 0x8d, 0x70, 0x1a, 0x4c,
+	0xa9, 0x1f, 0xdc, 0x52, 0x00, 0x6a, 0x26, 0xb9, 0x58, 0x68, 0x2e, 0x8c, 0x01, 0xe7, 0x9b, 0x36,
+	0x74, 0xaf, 0x2b, 0x50, 0xe0, 0x8f, 0x7c, 0x73, 0x0a, 0xd5, 0x3b, 0x0d, 0x4c, 0x31, 0x82, 0x9f,
+	0x4a, 0x1e, 0xe3, 0x1b, 0xaf, 0x36, 0xde, 0x4f, 0xc0, 0x14, 0x32, 0xb7, 0x1a, 0xcc, 0x44, 0x9e,
+	0x2e, 0xa5, 0x75, 0x64, 0x88, 0x7b, 0x19, 0x18, 0x97, 0x8b, 0xcc, 0x1d, 0xe9, 0x69, 0x1f, 0xed,
+	0xa0, 0x7b, 0xd7, 0x26, 0xb8, 0x2a, 0xbd, 0x98, 0x26, 0x47, 0xf8, 0x66, 0x4d, 0x6a, 0x17, 0x60,
+	0xac, 0x24, 0x4f, 0x31, 0xce, 0xfe, 0x55, 0xbd, 0xb4, 0x77, 0x40, 0x59, 0x3d, 0x04, 0xb6, 0xd5,
+	0x1e, 0x5e, 0x3e, 0xfb, 0x35, 0xec, 0x4c, 0x22, 0x5d, 0x22, 0x6a, 0x06, 0xb6, 0x82, 0x1b, 0x63,
+	0xbc, 0x0d, 0x29, 0x18, 0xdc, 0x65, 0x65, 0xa2, 0x6c, 0x93, 0x28, 0x2b, 0xe3, 0x0f, 0x89, 0xb2,
+	0x97, 0x42, 0xc9, 0xa0, 0x9d, 0x44, 0xca, 0xe4, 0x1b, 0xc2, 0xfd, 0xba, 0x47, 0xd8, 0x62, 0xec,
+	0x13, 0xa8, 0xca, 0x7d, 0xb4, 0x73, 0xa9, 0xf5, 0x1a, 0xb1, 0x90, 0x3c, 0xfb, 0x0f, 0xb6, 0xeb,
+	0x61, 0x77, 0x2f, 0x84, 0x2d, 0x21, 0x62, 0xda, 0x83, 0xef, 0x5d, 0x7c, 0xc5, 0xd3, 0x92, 0xaf,
+	0x08, 0xe3, 0xad, 0x29, 0xd9, 0x6b, 0x82, 0x6a, 0xbe, 0xd7, 0xc1, 0xfd, 0x56, 0xb3, 0xa5, 0x7b,
+	0xf2, 0xe8, 0xe3, 0x8f, 0x3f, 0x5f, 0xba, 0x9c, 0xec, 0xf3, 0x86, 0xd7, 0x14, 0xed, 0xc8, 0xdf,
+	0x47, 0x6f, 0xe5, 0x03, 0xf9, 0x8c, 0x70, 0x2f, 0x4a, 0x94, 0xb4, 0xf1, 0xac, 0xee, 0x76, 0xf0,
+	0xa0, 0xdd, 0x70, 0x20, 0xdc, 0xf5, 0x84, 0xb7, 0xc9, 0xf0, 0x02, 0xc2, 0xc3, 0xe7, 0x67, 0x2b,
+	0x8a, 0xce, 0x57, 0x14, 0xfd, 0x5e, 0x51, 0xf4, 0x69, 0x4d, 0x3b, 0xe7, 0x6b, 0xda, 0xf9, 0xb9,
+	0xa6, 0x9d, 0xd7, 0x4c, 0x69, 0x77, 0xbc, 0x4c, 0x59, 0x06, 0x73, 0x3e, 0xd6, 0xc6, 0x66, 0xc7,
+	0x5a, 0xf0, 0x37, 0xe1, 0xb0, 0x6f, 0xa7, 0x27, 0xfc, 0x6d, 0xfc, 0xe1, 0xf4, 0xaa, 0xff, 0x75,
+	0x1e, 0xfe, 0x1d, 0x00, 0x31, 0xd6, 0x7a, 0x1c, 0xf6, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Conversion queries the conversion of a token contract.
+	// Throws:
+	// - ErrInvalidRequest
+	//   - `contract_id` is of invalid format.
+	// - ErrNotFound
+	//   - the conversion is not enabled.
+	Conversion(ctx context.Context, in *QueryConversionRequest, opts ...grpc.CallOption) (*QueryConversionResponse, error)
+	// Conversions queries all the conversions.
+	Conversions(ctx context.Context, in *QueryConversionsRequest, opts ...grpc.CallOption) (*QueryConversionsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Conversion(ctx context.Context, in *QueryConversionRequest, opts ...grpc.CallOption) (*QueryConversionResponse, error) {
+	out := new(QueryConversionResponse)
+	err := c.cc.Invoke(ctx, "/lbm.tokenbridge.v1.Query/Conversion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Conversions(ctx context.Context, in *QueryConversionsRequest, opts ...grpc.CallOption) (*QueryConversionsResponse, error) {
+	out := new(QueryConversionsResponse)
+	err := c.cc.Invoke(ctx, "/lbm.tokenbridge.v1.Query/Conversions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Conversion queries the conversion of a token contract.
+	// Throws:
+	// - ErrInvalidRequest
+	//   - `contract_id` is of invalid format.
+	// - ErrNotFound
+	//   - the conversion is not enabled.
+	Conversion(context.Context, *QueryConversionRequest) (*QueryConversionResponse, error)
+	// Conversions queries all the conversions.
+	Conversions(context.Context, *QueryConversionsRequest) (*QueryConversionsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Conversion(ctx context.Context, req *QueryConversionRequest) (*QueryConversionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Conversion not implemented")
+}
+func (*UnimplementedQueryServer) Conversions(ctx context.Context, req *QueryConversionsRequest) (*QueryConversionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Conversions not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Conversion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConversionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Conversion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.tokenbridge.v1.Query/Conversion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Conversion(ctx, req.(*QueryConversionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Conversions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConversionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Conversions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.tokenbridge.v1.Query/Conversions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Conversions(ctx, req.(*QueryConversionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lbm.tokenbridge.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Conversion",
+			Handler:    _Query_Conversion_Handler,
+		},
+		{
+			MethodName: "Conversions",
+			Handler:    _Query_Conversions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lbm/tokenbridge/v1/query.proto",
+}
+
+func (m *QueryConversionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConversionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConversionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConversionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConversionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConversionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Conversion.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConversionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConversionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConversionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConversionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConversionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConversionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Conversions) > 0 {
+		for iNdEx := len(m.Conversions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conversions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryConversionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConversionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Conversion.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryConversionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConversionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Conversions) > 0 {
+		for _, e := range m.Conversions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryConversionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConversionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConversionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConversionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConversionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConversionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conversion", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Conversion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConversionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConversionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConversionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConversionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConversionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConversionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conversions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conversions = append(m.Conversions, Conversion{})
+			if err := m.Conversions[len(m.Conversions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
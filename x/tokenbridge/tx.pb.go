@@ -0,0 +1,1461 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lbm/tokenbridge/v1/tx.proto
+
+package tokenbridge
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_Finschia_finschia_sdk_types "github.com/Finschia/finschia-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgEnableConversion defines the Msg/EnableConversion request type.
+type MsgEnableConversion struct {
+	// contract id associated with the token contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// address of the grantee which has the modify permission.
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (m *MsgEnableConversion) Reset()         { *m = MsgEnableConversion{} }
+func (m *MsgEnableConversion) String() string { return proto.CompactTextString(m) }
+func (*MsgEnableConversion) ProtoMessage()    {}
+func (*MsgEnableConversion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d4369b26273181c, []int{0}
+}
+func (m *MsgEnableConversion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEnableConversion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEnableConversion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEnableConversion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEnableConversion.Merge(m, src)
+}
+func (m *MsgEnableConversion) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEnableConversion) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEnableConversion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEnableConversion proto.InternalMessageInfo
+
+// MsgEnableConversionResponse defines the Msg/EnableConversion response type.
+type MsgEnableConversionResponse struct {
+}
+
+func (m *MsgEnableConversionResponse) Reset()         { *m = MsgEnableConversionResponse{} }
+func (m *MsgEnableConversionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEnableConversionResponse) ProtoMessage()    {}
+func (*MsgEnableConversionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d4369b26273181c, []int{1}
+}
+func (m *MsgEnableConversionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEnableConversionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEnableConversionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEnableConversionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEnableConversionResponse.Merge(m, src)
+}
+func (m *MsgEnableConversionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEnableConversionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEnableConversionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEnableConversionResponse proto.InternalMessageInfo
+
+// MsgConvert defines the Msg/Convert request type.
+type MsgConvert struct {
+	// contract id associated with the token contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// holder whose tokens are being converted.
+	Holder string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	// amount of the tokens to convert.
+	Amount github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"amount"`
+}
+
+func (m *MsgConvert) Reset()         { *m = MsgConvert{} }
+func (m *MsgConvert) String() string { return proto.CompactTextString(m) }
+func (*MsgConvert) ProtoMessage()    {}
+func (*MsgConvert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d4369b26273181c, []int{2}
+}
+func (m *MsgConvert) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvert.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConvert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvert.Merge(m, src)
+}
+func (m *MsgConvert) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvert) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvert.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvert proto.InternalMessageInfo
+
+// MsgConvertResponse defines the Msg/Convert response type.
+type MsgConvertResponse struct {
+}
+
+func (m *MsgConvertResponse) Reset()         { *m = MsgConvertResponse{} }
+func (m *MsgConvertResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertResponse) ProtoMessage()    {}
+func (*MsgConvertResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d4369b26273181c, []int{3}
+}
+func (m *MsgConvertResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConvertResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertResponse.Merge(m, src)
+}
+func (m *MsgConvertResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertResponse proto.InternalMessageInfo
+
+// MsgUnconvert defines the Msg/Unconvert request type.
+type MsgUnconvert struct {
+	// contract id associated with the token contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// holder whose bank coins are being converted.
+	Holder string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	// amount of the bank coins to convert.
+	Amount github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"amount"`
+}
+
+func (m *MsgUnconvert) Reset()         { *m = MsgUnconvert{} }
+func (m *MsgUnconvert) String() string { return proto.CompactTextString(m) }
+func (*MsgUnconvert) ProtoMessage()    {}
+func (*MsgUnconvert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d4369b26273181c, []int{4}
+}
+func (m *MsgUnconvert) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnconvert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnconvert.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnconvert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnconvert.Merge(m, src)
+}
+func (m *MsgUnconvert) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnconvert) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnconvert.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnconvert proto.InternalMessageInfo
+
+// MsgUnconvertResponse defines the Msg/Unconvert response type.
+type MsgUnconvertResponse struct {
+}
+
+func (m *MsgUnconvertResponse) Reset()         { *m = MsgUnconvertResponse{} }
+func (m *MsgUnconvertResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnconvertResponse) ProtoMessage()    {}
+func (*MsgUnconvertResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d4369b26273181c, []int{5}
+}
+func (m *MsgUnconvertResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnconvertResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnconvertResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnconvertResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnconvertResponse.Merge(m, src)
+}
+func (m *MsgUnconvertResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnconvertResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnconvertResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnconvertResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgEnableConversion)(nil), "lbm.tokenbridge.v1.MsgEnableConversion")
+	proto.RegisterType((*MsgEnableConversionResponse)(nil), "lbm.tokenbridge.v1.MsgEnableConversionResponse")
+	proto.RegisterType((*MsgConvert)(nil), "lbm.tokenbridge.v1.MsgConvert")
+	proto.RegisterType((*MsgConvertResponse)(nil), "lbm.tokenbridge.v1.MsgConvertResponse")
+	proto.RegisterType((*MsgUnconvert)(nil), "lbm.tokenbridge.v1.MsgUnconvert")
+	proto.RegisterType((*MsgUnconvertResponse)(nil), "lbm.tokenbridge.v1.MsgUnconvertResponse")
+}
+
+func init() { proto.RegisterFile("lbm/tokenbridge/v1/tx.proto", fileDescriptor_3d4369b26273181c) }
+
+var fileDescriptor_3d4369b26273181c = []byte{
+	// 384 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x53, 0xcd, 0xca, 0xd3, 0x40,
+	0x14, 0x4d, 0xbe, 0x42, 0xb5, 0x57, 0x17, 0x32, 0x96, 0x52, 0x52, 0x9c, 0x96, 0x2c, 0xb4, 0x08,
+	0xce, 0xd0, 0xfa, 0x06, 0x15, 0x85, 0x0a, 0x01, 0x0d, 0x88, 0xe0, 0x46, 0xf2, 0x33, 0x4e, 0x43,
+	0x93, 0x99, 0x90, 0x99, 0x96, 0xfa, 0x16, 0x8a, 0x0f, 0xe0, 0xd6, 0x47, 0xe9, 0xb2, 0x4b, 0x71,
+	0x51, 0x34, 0x7d, 0x11, 0x69, 0x9b, 0xa4, 0x85, 0xb6, 0xd6, 0xe5, 0xb7, 0x9b, 0x7b, 0xcf, 0xb9,
+	0xe7, 0x1e, 0x72, 0x72, 0xa1, 0x13, 0xfb, 0x09, 0xd5, 0x72, 0xca, 0x84, 0x9f, 0x45, 0x21, 0x67,
+	0x74, 0x3e, 0xa0, 0x7a, 0x41, 0xd2, 0x4c, 0x6a, 0x89, 0x50, 0xec, 0x27, 0xe4, 0x08, 0x24, 0xf3,
+	0x81, 0xd5, 0xe4, 0x92, 0xcb, 0x1d, 0x4c, 0xb7, 0xaf, 0x3d, 0xd3, 0x76, 0xe1, 0xa1, 0xa3, 0xf8,
+	0x4b, 0xe1, 0xf9, 0x31, 0x7b, 0x21, 0xc5, 0x9c, 0x65, 0x2a, 0x92, 0x02, 0x75, 0xe1, 0x5e, 0x20,
+	0x85, 0xce, 0xbc, 0x40, 0x7f, 0x8c, 0xc2, 0xb6, 0xd9, 0x33, 0xfb, 0x0d, 0x17, 0xca, 0xd6, 0x38,
+	0x44, 0x16, 0xdc, 0x95, 0x29, 0xcb, 0x3c, 0x2d, 0xb3, 0xf6, 0xcd, 0x0e, 0xad, 0x6a, 0xfb, 0x11,
+	0x74, 0xce, 0x68, 0xba, 0x4c, 0xa5, 0x52, 0x28, 0x66, 0x7f, 0x35, 0x01, 0x1c, 0xc5, 0xf7, 0x88,
+	0xbe, 0xbe, 0xaa, 0x05, 0xf5, 0x89, 0x8c, 0x43, 0x56, 0x2e, 0x2a, 0x2a, 0xf4, 0x1a, 0xea, 0x5e,
+	0x22, 0x67, 0x42, 0xb7, 0x6b, 0xdb, 0xfe, 0x68, 0xb8, 0x5c, 0x77, 0x8d, 0x5f, 0xeb, 0xee, 0x53,
+	0x1e, 0xe9, 0xc9, 0xcc, 0x27, 0x81, 0x4c, 0xe8, 0xab, 0x48, 0xa8, 0x60, 0x12, 0x79, 0xf4, 0x53,
+	0xf1, 0x78, 0xa6, 0xc2, 0x29, 0xd5, 0x9f, 0x53, 0xa6, 0xc8, 0x58, 0x68, 0xb7, 0x50, 0xb0, 0x9b,
+	0x80, 0x0e, 0x96, 0x2a, 0xa7, 0xdf, 0x4c, 0xb8, 0xef, 0x28, 0xfe, 0x4e, 0x04, 0xb7, 0xc9, 0x6b,
+	0x0b, 0x9a, 0xc7, 0xa6, 0x4a, 0xb7, 0xc3, 0xef, 0x37, 0x50, 0x73, 0x14, 0x47, 0x31, 0x3c, 0x38,
+	0xc9, 0xf3, 0x09, 0x39, 0xfd, 0x23, 0xc8, 0x99, 0x90, 0x2c, 0xfa, 0x9f, 0xc4, 0x72, 0x2b, 0x7a,
+	0x0b, 0x77, 0xca, 0x24, 0xf1, 0x85, 0xd9, 0x02, 0xb7, 0x1e, 0xff, 0x1b, 0xaf, 0x24, 0xdf, 0x43,
+	0xe3, 0xf0, 0xc9, 0x7b, 0x17, 0x86, 0x2a, 0x86, 0xd5, 0xbf, 0xc6, 0x28, 0x85, 0x47, 0x6f, 0x96,
+	0x7f, 0xb0, 0xf1, 0x23, 0xc7, 0xc6, 0x32, 0xc7, 0xe6, 0x2a, 0xc7, 0xe6, 0xef, 0x1c, 0x9b, 0x5f,
+	0x36, 0xd8, 0x58, 0x6d, 0xb0, 0xf1, 0x73, 0x83, 0x8d, 0x0f, 0xe4, 0x6a, 0x1e, 0x8b, 0xe3, 0xa3,
+	0xf3, 0xeb, 0xbb, 0x2b, 0x7a, 0xfe, 0x77, 0x00, 0xdf, 0xd6, 0x26, 0xd8, 0x8e, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// EnableConversion enables the conversion of the tokens of a contract into
+	// bank coins. The operator must have the modify permission on the contract.
+	// Fires:
+	// - EventEnabledConversion
+	// Throws:
+	// - ErrNotFound:
+	//   - the contract does not exist.
+	// - ErrInvalidRequest:
+	//   - the conversion is already enabled.
+	// - ErrTokenNoPermission:
+	//   - the operator does not have the modify permission.
+	EnableConversion(ctx context.Context, in *MsgEnableConversion, opts ...grpc.CallOption) (*MsgEnableConversionResponse, error)
+	// Convert converts tokens into bank coins 1:1. The tokens are escrowed in x/token.
+	// Fires:
+	// - EventConverted
+	// - lbm.token.v1.EventSent
+	// Throws:
+	// - ErrNotFound:
+	//   - the conversion is not enabled.
+	// - ErrInsufficientBalance:
+	//   - the holder does not have enough tokens.
+	Convert(ctx context.Context, in *MsgConvert, opts ...grpc.CallOption) (*MsgConvertResponse, error)
+	// Unconvert converts bank coins back into tokens 1:1. The bank coins are burnt.
+	// Fires:
+	// - EventUnconverted
+	// - lbm.token.v1.EventSent
+	// Throws:
+	// - ErrNotFound:
+	//   - the conversion is not enabled.
+	// - ErrInsufficientFunds:
+	//   - the holder does not have enough bank coins.
+	Unconvert(ctx context.Context, in *MsgUnconvert, opts ...grpc.CallOption) (*MsgUnconvertResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) EnableConversion(ctx context.Context, in *MsgEnableConversion, opts ...grpc.CallOption) (*MsgEnableConversionResponse, error) {
+	out := new(MsgEnableConversionResponse)
+	err := c.cc.Invoke(ctx, "/lbm.tokenbridge.v1.Msg/EnableConversion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Convert(ctx context.Context, in *MsgConvert, opts ...grpc.CallOption) (*MsgConvertResponse, error) {
+	out := new(MsgConvertResponse)
+	err := c.cc.Invoke(ctx, "/lbm.tokenbridge.v1.Msg/Convert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Unconvert(ctx context.Context, in *MsgUnconvert, opts ...grpc.CallOption) (*MsgUnconvertResponse, error) {
+	out := new(MsgUnconvertResponse)
+	err := c.cc.Invoke(ctx, "/lbm.tokenbridge.v1.Msg/Unconvert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// EnableConversion enables the conversion of the tokens of a contract into
+	// bank coins. The operator must have the modify permission on the contract.
+	// Fires:
+	// - EventEnabledConversion
+	// Throws:
+	// - ErrNotFound:
+	//   - the contract does not exist.
+	// - ErrInvalidRequest:
+	//   - the conversion is already enabled.
+	// - ErrTokenNoPermission:
+	//   - the operator does not have the modify permission.
+	EnableConversion(context.Context, *MsgEnableConversion) (*MsgEnableConversionResponse, error)
+	// Convert converts tokens into bank coins 1:1. The tokens are escrowed in x/token.
+	// Fires:
+	// - EventConverted
+	// - lbm.token.v1.EventSent
+	// Throws:
+	// - ErrNotFound:
+	//   - the conversion is not enabled.
+	// - ErrInsufficientBalance:
+	//   - the holder does not have enough tokens.
+	Convert(context.Context, *MsgConvert) (*MsgConvertResponse, error)
+	// Unconvert converts bank coins back into tokens 1:1. The bank coins are burnt.
+	// Fires:
+	// - EventUnconverted
+	// - lbm.token.v1.EventSent
+	// Throws:
+	// - ErrNotFound:
+	//   - the conversion is not enabled.
+	// - ErrInsufficientFunds:
+	//   - the holder does not have enough bank coins.
+	Unconvert(context.Context, *MsgUnconvert) (*MsgUnconvertResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) EnableConversion(ctx context.Context, req *MsgEnableConversion) (*MsgEnableConversionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableConversion not implemented")
+}
+func (*UnimplementedMsgServer) Convert(ctx context.Context, req *MsgConvert) (*MsgConvertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Convert not implemented")
+}
+func (*UnimplementedMsgServer) Unconvert(ctx context.Context, req *MsgUnconvert) (*MsgUnconvertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unconvert not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_EnableConversion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEnableConversion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EnableConversion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.tokenbridge.v1.Msg/EnableConversion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EnableConversion(ctx, req.(*MsgEnableConversion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Convert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvert)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Convert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.tokenbridge.v1.Msg/Convert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Convert(ctx, req.(*MsgConvert))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Unconvert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnconvert)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Unconvert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.tokenbridge.v1.Msg/Unconvert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Unconvert(ctx, req.(*MsgUnconvert))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lbm.tokenbridge.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EnableConversion",
+			Handler:    _Msg_EnableConversion_Handler,
+		},
+		{
+			MethodName: "Convert",
+			Handler:    _Msg_Convert_Handler,
+		},
+		{
+			MethodName: "Unconvert",
+			Handler:    _Msg_Unconvert_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lbm/tokenbridge/v1/tx.proto",
+}
+
+func (m *MsgEnableConversion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEnableConversion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEnableConversion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEnableConversionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEnableConversionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEnableConversionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvert) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvert) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnconvert) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnconvert) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnconvert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnconvertResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnconvertResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnconvertResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgEnableConversion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEnableConversionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConvert) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgConvertResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnconvert) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUnconvertResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgEnableConversion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEnableConversion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEnableConversion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEnableConversionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEnableConversionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEnableConversionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvert) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvert: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvert: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnconvert) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnconvert: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnconvert: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnconvertResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnconvertResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnconvertResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)